@@ -25,6 +25,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blevesearch/bleve/v2"
@@ -51,6 +52,11 @@ type AnalyzerService struct {
 	cfg *config.CGRConfig
 
 	filterS *engine.FilterS
+	connMgr *engine.ConnManager
+
+	replaysMux sync.RWMutex
+	replays    map[string]*replayJob // the replays started, by ID
+	replaysWg  sync.WaitGroup
 }
 
 // SetFilterS will set the filterS used in APIs
@@ -59,6 +65,12 @@ func (aS *AnalyzerService) SetFilterS(fS *engine.FilterS) {
 	aS.filterS = fS
 }
 
+// SetConnManager will set the connManager used to replay the API calls
+// this function is called before the API is registerd
+func (aS *AnalyzerService) SetConnManager(connMgr *engine.ConnManager) {
+	aS.connMgr = connMgr
+}
+
 func (aS *AnalyzerService) initDB() (err error) {
 	if aS.cfg.AnalyzerSCfg().IndexType == utils.MetaInternal {
 		aS.db, err = bleve.NewMemOnly(bleve.NewIndexMapping())
@@ -121,6 +133,7 @@ func (aS *AnalyzerService) ListenAndServe(stopChan <-chan struct{}) (err error)
 // Shutdown is called to shutdown the service
 func (aS *AnalyzerService) Shutdown() error {
 	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown initialized", utils.AnalyzerS))
+	aS.stopReplays()
 	aS.db.Close()
 	utils.Logger.Info(fmt.Sprintf("<%s> service shutdown complete", utils.AnalyzerS))
 	return nil
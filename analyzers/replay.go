@@ -0,0 +1,422 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package analyzers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/rpcclient"
+)
+
+// replayPageSize is the number of captured calls read at once from the index
+var replayPageSize = 1000
+
+const (
+	// replayConcurrentCalls is the default number of calls waiting for the reply at the same time
+	replayConcurrentCalls = 100
+	// replayStatusTTL is how long the status of a finished replay is kept
+	replayStatusTTL = time.Hour
+)
+
+// ReplayArgs selects the captured API calls and where they are replayed
+type ReplayArgs struct {
+	// a string based on the query language(https://blevesearch.com/docs/Query-String-Query/) that we send to bleve
+	HeaderFilters string
+	// a list of filters that we use to filter the call similar to how we filter the events
+	ContentFilters []string
+	// the interval of the captured calls, unlimited if zero
+	StartTime time.Time
+	EndTime   time.Time
+	// Limit is the maximum number of calls replayed, all if 0
+	Limit int
+
+	// ReplayConns are the connections from rpc_conns receiving the calls, only *json transport supported
+	ReplayConns []string
+	// SpeedFactor scales the original timing of the calls(e.g. 2 for twice as fast), 0 to send them one after another
+	SpeedFactor float64
+	// ConcurrentCalls limits the calls waiting for the reply when SpeedFactor is set, replayConcurrentCalls if 0
+	ConcurrentCalls int
+	// IgnoreFields are the reply fields left out of the comparison, at any depth(e.g. dynamic IDs or times)
+	IgnoreFields []string
+}
+
+// ReplayMismatch is a replayed call with the reply different from the captured one
+type ReplayMismatch struct {
+	RequestID     uint64
+	RequestMethod string
+	RequestParams json.RawMessage
+	StartTime     time.Time
+
+	ExpectedReply json.RawMessage
+	ExpectedError string
+	Reply         json.RawMessage
+	Error         string
+	Differences   []string // paths of the fields which differ
+
+	seq int // the order of the call in the replay
+}
+
+// ReplayReport is the regression report of a replay
+type ReplayReport struct {
+	Replayed   int
+	Matched    int
+	Mismatched int
+	Duration   time.Duration
+	Mismatches []*ReplayMismatch
+}
+
+// ReplayStatus is the progress of a replay, with the report of the calls replayed so far
+type ReplayStatus struct {
+	ID        string
+	StartTime time.Time
+	EndTime   time.Time // zero while the replay runs
+	Running   bool
+	Error     string
+	ReplayReport
+}
+
+// ReplayIDArgs identifies a replay
+type ReplayIDArgs struct {
+	ID string
+}
+
+// replayJob is a replay running in the background
+type replayJob struct {
+	mu     sync.RWMutex
+	status ReplayStatus
+	cancel context.CancelFunc
+}
+
+// record adds the result of the call with the seq order to the report
+func (j *replayJob) record(seq int, mm *ReplayMismatch) {
+	j.mu.Lock()
+	j.status.Replayed++
+	if mm == nil {
+		j.status.Matched++
+	} else {
+		j.status.Mismatched++
+		mm.seq = seq
+		j.status.Mismatches = append(j.status.Mismatches, mm)
+	}
+	j.status.Duration = time.Since(j.status.StartTime)
+	j.mu.Unlock()
+}
+
+// finish marks the replay as done, ordering the mismatches as the calls were captured
+func (j *replayJob) finish(err error) {
+	j.mu.Lock()
+	j.status.Running = false
+	j.status.EndTime = time.Now()
+	j.status.Duration = j.status.EndTime.Sub(j.status.StartTime)
+	if err != nil {
+		j.status.Error = err.Error()
+	}
+	sort.Slice(j.status.Mismatches, func(i, k int) bool {
+		return j.status.Mismatches[i].seq < j.status.Mismatches[k].seq
+	})
+	j.mu.Unlock()
+}
+
+// snapshot returns a copy of the status, safe to use while the replay runs
+func (j *replayJob) snapshot() (st ReplayStatus) {
+	j.mu.RLock()
+	st = j.status
+	st.Mismatches = slices.Clone(j.status.Mismatches)
+	j.mu.RUnlock()
+	if st.Mismatches == nil {
+		st.Mismatches = make([]*ReplayMismatch, 0)
+	}
+	return
+}
+
+// capturedCall is one API call read from the index
+type capturedCall struct {
+	id        uint64
+	method    string
+	params    json.RawMessage
+	reply     json.RawMessage
+	err       string
+	startTime time.Time
+}
+
+// V1Replay starts sending the captured API calls matching the args to the replay connections
+// in the background, comparing the replies with the captured ones. The reply is the ID of the
+// replay, used to query its status
+func (aS *AnalyzerService) V1Replay(ctx *context.Context, args *ReplayArgs, reply *string) (err error) {
+	if len(args.ReplayConns) == 0 {
+		return utils.NewErrMandatoryIeMissing("ReplayConns")
+	}
+	if args.SpeedFactor < 0 {
+		return fmt.Errorf("invalid SpeedFactor: %v", args.SpeedFactor)
+	}
+	if args.ConcurrentCalls < 0 {
+		return fmt.Errorf("invalid ConcurrentCalls: %v", args.ConcurrentCalls)
+	}
+	rpcConns := aS.cfg.RPCConns()
+	for _, connID := range args.ReplayConns {
+		rpcConn, has := rpcConns[connID]
+		if !has {
+			return fmt.Errorf("connection with id: <%s> not defined", connID)
+		}
+		for _, rh := range rpcConn.Conns {
+			if rh.Transport != rpcclient.JSONrpc {
+				return fmt.Errorf("unsupported transport <%s> for connection <%s>, only <%s> can replay the calls",
+					rh.Transport, connID, rpcclient.JSONrpc)
+			}
+		}
+	}
+	rCtx, cancel := context.WithCancel(context.Background())
+	job := &replayJob{
+		status: ReplayStatus{
+			ID:        utils.GenUUID(),
+			StartTime: time.Now(),
+			Running:   true,
+		},
+		cancel: cancel,
+	}
+	aS.replaysMux.Lock()
+	if aS.replays == nil {
+		aS.replays = make(map[string]*replayJob)
+	}
+	for id, j := range aS.replays { // forget the old replays
+		if st := j.snapshot(); !st.Running && time.Since(st.EndTime) > replayStatusTTL {
+			delete(aS.replays, id)
+		}
+	}
+	aS.replays[job.status.ID] = job
+	aS.replaysWg.Add(1)
+	aS.replaysMux.Unlock()
+	go func() {
+		job.finish(aS.replay(rCtx, args, job))
+		cancel()
+		aS.replaysWg.Done()
+	}()
+	*reply = job.status.ID
+	return
+}
+
+// V1GetReplayStatus returns the progress of the replay together with the report so far
+func (aS *AnalyzerService) V1GetReplayStatus(ctx *context.Context, args *ReplayIDArgs, reply *ReplayStatus) error {
+	aS.replaysMux.RLock()
+	job, has := aS.replays[args.ID]
+	aS.replaysMux.RUnlock()
+	if !has {
+		return utils.ErrNotFound
+	}
+	*reply = job.snapshot()
+	return nil
+}
+
+// V1StopReplay stops the replay, the calls already sent are kept in its report
+func (aS *AnalyzerService) V1StopReplay(ctx *context.Context, args *ReplayIDArgs, reply *string) error {
+	aS.replaysMux.RLock()
+	job, has := aS.replays[args.ID]
+	aS.replaysMux.RUnlock()
+	if !has {
+		return utils.ErrNotFound
+	}
+	job.cancel()
+	*reply = utils.OK
+	return nil
+}
+
+// stopReplays cancels the running replays and waits for them to finish
+func (aS *AnalyzerService) stopReplays() {
+	aS.replaysMux.RLock()
+	for _, job := range aS.replays {
+		job.cancel()
+	}
+	aS.replaysMux.RUnlock()
+	aS.replaysWg.Wait()
+}
+
+// replay sends the captured calls, recording the results in the job
+func (aS *AnalyzerService) replay(ctx *context.Context, args *ReplayArgs, job *replayJob) error {
+	if args.SpeedFactor == 0 {
+		var seq int
+		return aS.capturedCalls(ctx, args, func(call *capturedCall) error {
+			job.record(seq, aS.replayCall(ctx, args, call))
+			seq++
+			return nil
+		})
+	}
+	concurrent := args.ConcurrentCalls
+	if concurrent == 0 {
+		concurrent = replayConcurrentCalls
+	}
+	sem := make(chan struct{}, concurrent)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	sTime := time.Now()
+	var fstCall time.Time
+	var seq int
+	return aS.capturedCalls(ctx, args, func(call *capturedCall) error {
+		if seq == 0 {
+			fstCall = call.startTime
+		}
+		if wait := time.Duration(float64(call.startTime.Sub(fstCall))/args.SpeedFactor) -
+			time.Since(sTime); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(seq int) {
+			job.record(seq, aS.replayCall(ctx, args, call))
+			<-sem
+			wg.Done()
+		}(seq)
+		seq++
+		return nil
+	})
+}
+
+// capturedCalls calls f with the calls matching the args, ordered by their start time,
+// reading them from the index one page at a time
+func (aS *AnalyzerService) capturedCalls(ctx *context.Context, args *ReplayArgs, f func(*capturedCall) error) (err error) {
+	var q query.Query = bleve.NewMatchAllQuery()
+	if args.HeaderFilters != utils.EmptyString {
+		q = bleve.NewQueryStringQuery(args.HeaderFilters)
+	}
+	if !args.StartTime.IsZero() || !args.EndTime.IsZero() {
+		tq := bleve.NewDateRangeQuery(args.StartTime, args.EndTime)
+		tq.SetField(utils.RequestStartTime)
+		q = bleve.NewConjunctionQuery(q, tq)
+	}
+	s := bleve.NewSearchRequestOptions(q, replayPageSize, 0, false)
+	s.Fields = []string{utils.Meta}
+	s.SortBy([]string{utils.RequestStartTime, utils.RequestID, "_id"}) // the document ID keeps the order strict between the pages
+	var sent int
+	for {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var res *bleve.SearchResult
+		if res, err = aS.db.Search(s); err != nil {
+			return
+		}
+		for _, hit := range res.Hits {
+			var call *capturedCall
+			if call, err = newCapturedCall(hit); err != nil {
+				return
+			}
+			if len(args.ContentFilters) != 0 {
+				var dp utils.MapStorage
+				if dp, err = getDPFromSearchresult(call.params, call.reply, hit.Fields); err != nil {
+					return
+				}
+				var pass bool
+				if pass, err = aS.filterS.Pass(aS.cfg.GeneralCfg().DefaultTenant,
+					args.ContentFilters, dp); err != nil {
+					return
+				} else if !pass {
+					continue
+				}
+			}
+			if err = f(call); err != nil {
+				return
+			}
+			if sent++; args.Limit > 0 && sent == args.Limit {
+				return
+			}
+		}
+		if len(res.Hits) < replayPageSize {
+			return
+		}
+		s.SetSearchAfter(res.Hits[len(res.Hits)-1].Sort)
+	}
+}
+
+func newCapturedCall(hit *search.DocumentMatch) (call *capturedCall, err error) {
+	call = &capturedCall{
+		method: utils.IfaceAsString(hit.Fields[utils.RequestMethod]),
+		params: json.RawMessage(utils.IfaceAsString(hit.Fields[utils.RequestParams])),
+		reply:  json.RawMessage(utils.IfaceAsString(hit.Fields[utils.Reply])),
+		err:    utils.IfaceAsString(hit.Fields[utils.ReplyError]),
+	}
+	var id int64
+	if id, err = utils.IfaceAsTInt64(hit.Fields[utils.RequestID]); err != nil {
+		return
+	}
+	call.id = uint64(id)
+	call.startTime, err = time.Parse(time.RFC3339Nano, utils.IfaceAsString(hit.Fields[utils.RequestStartTime]))
+	return
+}
+
+// replayCall sends the call to the replay connections, returning the mismatch if the replies differ
+func (aS *AnalyzerService) replayCall(ctx *context.Context, args *ReplayArgs, call *capturedCall) *ReplayMismatch {
+	var rply json.RawMessage
+	var errStr string
+	if err := aS.connMgr.Call(ctx, args.ReplayConns, call.method, call.params, &rply); err != nil {
+		errStr = err.Error()
+	}
+	var diffs []string
+	if errStr != call.err {
+		diffs = append(diffs, utils.ReplyError)
+	} else if errStr == utils.EmptyString {
+		diffs = diffReplies(call.reply, rply, utils.NewStringSet(args.IgnoreFields))
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+	return &ReplayMismatch{
+		RequestID:     call.id,
+		RequestMethod: call.method,
+		RequestParams: call.params,
+		StartTime:     call.startTime,
+		ExpectedReply: call.reply,
+		ExpectedError: call.err,
+		Reply:         rply,
+		Error:         errStr,
+		Differences:   diffs,
+	}
+}
+
+// diffReplies returns the paths of the fields which differ between the two JSON replies
+func diffReplies(exp, rcv json.RawMessage, ignore utils.StringSet) (diffs []string) {
+	expVal, err := unmarshalJSON(exp)
+	if err != nil {
+		if !bytes.Equal(exp, rcv) {
+			diffs = []string{utils.Reply}
+		}
+		return
+	}
+	rcvVal, err := unmarshalJSON(rcv)
+	if err != nil {
+		return []string{utils.Reply}
+	}
+	return utils.DiffValues(utils.Reply, expVal, rcvVal, ignore)
+}
@@ -0,0 +1,207 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package analyzers
+
+import (
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/birpc/jsonrpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/rpcclient"
+)
+
+type replayTestSv1 struct{}
+
+func (replayTestSv1) GetAccount(_ *context.Context, args *utils.TenantIDWithAPIOpts, reply *map[string]any) error {
+	switch args.ID {
+	case "1001":
+		*reply = map[string]any{"ID": "1001", "Balance": 10., "UpdateTime": time.Now().String()}
+	case "1002":
+		*reply = map[string]any{"ID": "1002", "Balance": 5., "UpdateTime": time.Now().String()}
+	default:
+		return utils.ErrNotFound
+	}
+	return nil
+}
+
+func TestAnalyzerSV1Replay(t *testing.T) {
+	srv := birpc.NewServer()
+	if err := srv.RegisterName("ReplayTestSv1", new(replayTestSv1)); err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen(utils.TCP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go srv.ServeCodec(jsonrpc.NewServerCodec(conn))
+		}
+	}()
+
+	cfg := config.NewDefaultCGRConfig()
+	cfg.AnalyzerSCfg().IndexType = utils.MetaInternal
+	cfg.RPCConns()["replay"] = &config.RPCConn{
+		Strategy: rpcclient.PoolFirst,
+		Conns: []*config.RemoteHost{{
+			Address:   l.Addr().String(),
+			Transport: rpcclient.JSONrpc,
+		}},
+	}
+	anz, err := NewAnalyzerService(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anz.SetConnManager(engine.NewConnManager(cfg, nil))
+	anz.SetFilterS(engine.NewFilterS(cfg, nil, nil))
+
+	t1 := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for i, call := range []struct {
+		id    string
+		reply any
+		err   error
+	}{
+		{id: "1001", reply: map[string]any{"ID": "1001", "Balance": 10., "UpdateTime": "yesterday"}},
+		{id: "1002", reply: map[string]any{"ID": "1002", "Balance": 7., "UpdateTime": "yesterday"}},
+		{id: "1003", err: utils.ErrNotFound},
+		{id: "1004", reply: map[string]any{"ID": "1004"}},
+	} {
+		if err := anz.logTrafic(uint64(i), "ReplayTestSv1.GetAccount",
+			&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{Tenant: "cgrates.org", ID: call.id}},
+			call.reply, call.err, utils.MetaJSON, "127.0.0.1:5565", "127.0.0.1:2012",
+			t1.Add(time.Duration(i)*time.Second), t1.Add(time.Duration(i)*time.Second+time.Millisecond)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var id string
+	if err := anz.V1Replay(context.Background(), &ReplayArgs{}, &id); err == nil ||
+		err.Error() != utils.NewErrMandatoryIeMissing("ReplayConns").Error() {
+		t.Errorf("Expected error for missing ReplayConns, received %v", err)
+	}
+	if err := anz.V1Replay(context.Background(), &ReplayArgs{
+		ReplayConns: []string{utils.MetaInternal},
+	}, &id); err == nil {
+		t.Error("Expected error for the *internal connection")
+	}
+	if err := anz.V1GetReplayStatus(context.Background(), &ReplayIDArgs{ID: "unknown"},
+		new(ReplayStatus)); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+
+	defer func(ps int) { replayPageSize = ps }(replayPageSize)
+	replayPageSize = 1 // read the calls one page at a time
+	if err := anz.V1Replay(context.Background(), &ReplayArgs{
+		ReplayConns:     []string{"replay"},
+		SpeedFactor:     100,
+		ConcurrentCalls: 2,
+		IgnoreFields:    []string{"UpdateTime"},
+	}, &id); err != nil {
+		t.Fatal(err)
+	}
+	rply := waitReplay(t, anz, id)
+	if rply.Replayed != 4 || rply.Matched != 2 || rply.Mismatched != 2 || rply.Error != utils.EmptyString {
+		t.Fatalf("Unexpected report: %s", utils.ToJSON(rply))
+	}
+	if mm := rply.Mismatches[0]; mm.RequestID != 1 ||
+		!reflect.DeepEqual(mm.Differences, []string{"Reply.Balance"}) {
+		t.Errorf("Unexpected mismatch: %s", utils.ToJSON(mm))
+	}
+	if mm := rply.Mismatches[1]; mm.RequestID != 3 ||
+		mm.Error != utils.ErrNotFound.Error() ||
+		!reflect.DeepEqual(mm.Differences, []string{utils.ReplyError}) {
+		t.Errorf("Unexpected mismatch: %s", utils.ToJSON(mm))
+	}
+
+	// only the calls in the interval and matching the filters
+	if err := anz.V1Replay(context.Background(), &ReplayArgs{
+		StartTime:      t1.Add(time.Second),
+		ContentFilters: []string{"*string:~*req.ID:1002|1003"},
+		ReplayConns:    []string{"replay"},
+	}, &id); err != nil {
+		t.Fatal(err)
+	}
+	if rply = waitReplay(t, anz, id); rply.Replayed != 2 || rply.Matched != 1 || rply.Mismatched != 1 {
+		t.Fatalf("Unexpected report: %s", utils.ToJSON(rply))
+	}
+	if mm := rply.Mismatches[0]; mm.RequestID != 1 ||
+		!reflect.DeepEqual(mm.Differences, []string{"Reply.Balance", "Reply.UpdateTime"}) {
+		t.Errorf("Unexpected mismatch: %s", utils.ToJSON(mm))
+	}
+
+	// stopped while waiting for the timing of the second call
+	if err := anz.V1Replay(context.Background(), &ReplayArgs{
+		ReplayConns: []string{"replay"},
+		SpeedFactor: 0.001,
+	}, &id); err != nil {
+		t.Fatal(err)
+	}
+	var reply string
+	if err := anz.V1StopReplay(context.Background(), &ReplayIDArgs{ID: id}, &reply); err != nil {
+		t.Fatal(err)
+	} else if reply != utils.OK {
+		t.Errorf("Expected %q, received %q", utils.OK, reply)
+	}
+	if rply = waitReplay(t, anz, id); rply.Replayed > 1 || rply.Error != context.Canceled.Error() {
+		t.Errorf("Unexpected report: %s", utils.ToJSON(rply))
+	}
+}
+
+// waitReplay returns the status of the replay once finished
+func waitReplay(t *testing.T, anz *AnalyzerService, id string) (st ReplayStatus) {
+	t.Helper()
+	for range 100 {
+		if err := anz.V1GetReplayStatus(context.Background(), &ReplayIDArgs{ID: id}, &st); err != nil {
+			t.Fatal(err)
+		}
+		if !st.Running {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the replay <%s> did not finish", id)
+	return
+}
+
+func TestAnalyzerSDiffReplies(t *testing.T) {
+	exp := json.RawMessage(`{"ID":"1001","Balances":[{"Value":10,"UUID":"a"},{"Value":5,"UUID":"b"}]}`)
+	rcv := json.RawMessage(`{"ID":"1001","Balances":[{"Value":10,"UUID":"c"},{"Value":3,"UUID":"d"}],"Disabled":false}`)
+	expDiffs := []string{"Reply.Balances[1].Value", "Reply.Disabled"}
+	if rcv := diffReplies(exp, rcv, utils.NewStringSet([]string{"UUID"})); !reflect.DeepEqual(expDiffs, rcv) {
+		t.Errorf("Expected %v, received %v", expDiffs, rcv)
+	}
+	if rcv := diffReplies(json.RawMessage(`"OK"`), json.RawMessage(`"OK"`), nil); len(rcv) != 0 {
+		t.Errorf("Expected no differences, received %v", rcv)
+	}
+	if rcv := diffReplies(json.RawMessage(`-1`), json.RawMessage(`-2`), nil); !reflect.DeepEqual(rcv, []string{utils.Reply}) {
+		t.Errorf("Expected %v, received %v", []string{utils.Reply}, rcv)
+	}
+}
@@ -37,3 +37,18 @@ type AnalyzerSv1 struct {
 func (aSv1 *AnalyzerSv1) StringQuery(ctx *context.Context, search *analyzers.QueryArgs, reply *[]map[string]any) error {
 	return aSv1.aS.V1StringQuery(ctx, search, reply)
 }
+
+// Replay starts sending the captured API calls to another engine, replying with the ID of the replay
+func (aSv1 *AnalyzerSv1) Replay(ctx *context.Context, args *analyzers.ReplayArgs, reply *string) error {
+	return aSv1.aS.V1Replay(ctx, args, reply)
+}
+
+// GetReplayStatus returns the progress of the replay and the replies which differ so far
+func (aSv1 *AnalyzerSv1) GetReplayStatus(ctx *context.Context, args *analyzers.ReplayIDArgs, reply *analyzers.ReplayStatus) error {
+	return aSv1.aS.V1GetReplayStatus(ctx, args, reply)
+}
+
+// StopReplay stops the replay
+func (aSv1 *AnalyzerSv1) StopReplay(ctx *context.Context, args *analyzers.ReplayIDArgs, reply *string) error {
+	return aSv1.aS.V1StopReplay(ctx, args, reply)
+}
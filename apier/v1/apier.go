@@ -1541,10 +1541,18 @@ func (apierSv1 *APIerSv1) GetRatingPlanIDs(ctx *context.Context, args *utils.Pag
 // ListenAndServe listen for storbd reload
 func (apierSv1 *APIerSv1) ListenAndServe(stopChan chan struct{}) {
 	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem", utils.CoreS, utils.ApierS))
+	var healthTick <-chan time.Time // nil channel when the scheduled index health checks are disabled
+	if intvl := apierSv1.Config.ApierCfg().IndexHealthInterval; intvl > 0 {
+		tkr := time.NewTicker(intvl)
+		defer tkr.Stop()
+		healthTick = tkr.C
+	}
 	for {
 		select {
 		case <-stopChan:
 			return
+		case <-healthTick:
+			apierSv1.checkIndexesHealth()
 		case stordb, ok := <-apierSv1.StorDBChan:
 			if !ok { // the chanel was closed by the shutdown of stordbService
 				return
@@ -1563,7 +1571,8 @@ func (apierSv1 *APIerSv1) ExportToFolder(ctx *context.Context, arg *utils.ArgExp
 	if len(arg.Items) == 0 {
 		arg.Items = []string{utils.MetaAttributes, utils.MetaChargers, utils.MetaDispatchers,
 			utils.MetaDispatcherHosts, utils.MetaFilters, utils.MetaResources, utils.MetaStats,
-			utils.MetaRoutes, utils.MetaThresholds, utils.MetaRankings, utils.MetaTrends}
+			utils.MetaRoutes, utils.MetaThresholds, utils.MetaRankings, utils.MetaTrends,
+			utils.MetaLookupTables}
 	}
 	if _, err := os.Stat(arg.Path); os.IsNotExist(err) {
 		os.Mkdir(arg.Path, os.ModeDir)
@@ -1720,6 +1729,43 @@ func (apierSv1 *APIerSv1) ExportToFolder(ctx *context.Context, arg *utils.ArgExp
 				}
 			}
 			csvWriter.Flush()
+		case utils.MetaLookupTables:
+			prfx := utils.LookupTablePrefix
+			keys, err := apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx, utils.EmptyString)
+			if err != nil {
+				return err
+			}
+			if len(keys) == 0 { // if we don't find items we skip
+				continue
+			}
+			f, err := os.Create(path.Join(arg.Path, utils.LookupTablesCsv))
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			csvWriter := csv.NewWriter(f)
+			csvWriter.Comma = utils.CSVSep
+			//write the header of the file
+			if err := csvWriter.Write(engine.LookupTableMdls{}.CSVHeader()); err != nil {
+				return err
+			}
+			for _, key := range keys {
+				tntID := strings.SplitN(key[len(prfx):], utils.InInFieldSep, 2)
+				lt, err := apierSv1.DataManager.GetLookupTable(tntID[0], tntID[1],
+					true, false, utils.NonTransactional)
+				if err != nil {
+					return err
+				}
+				for _, model := range engine.APItoModelTPLookupTable(engine.LookupTableToAPI(lt)) {
+					if record, err := engine.CsvDump(model); err != nil {
+						return err
+					} else if err := csvWriter.Write(record); err != nil {
+						return err
+					}
+				}
+			}
+			csvWriter.Flush()
 		case utils.MetaFilters:
 			prfx := utils.FilterPrefix
 			keys, err := apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx, utils.EmptyString)
@@ -0,0 +1,40 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewAuditSv1 initializes AuditSv1
+func NewAuditSv1(aS *engine.AuditS) *AuditSv1 {
+	return &AuditSv1{aS: aS}
+}
+
+// AuditSv1 exports RPC from AuditS
+type AuditSv1 struct {
+	aS *engine.AuditS
+}
+
+// GetAuditRecords returns the records of the data changing API calls matching the filter
+func (aSv1 *AuditSv1) GetAuditRecords(ctx *context.Context, args *utils.AuditRecordFilterWithAPIOpts,
+	reply *[]*engine.AuditRecord) error {
+	return aSv1.aS.V1GetAuditRecords(ctx, args, reply)
+}
@@ -132,3 +132,13 @@ func (cdrSv1 *CDRsV1) GetCDRsCount(ctx *context.Context, args *utils.RPCCDRsFilt
 func (cdrSv1 *CDRsV1) GetCDRs(ctx *context.Context, args *utils.RPCCDRsFilterWithAPIOpts, reply *[]*engine.CDR) error {
 	return cdrSv1.CDRs.V1GetCDRs(ctx, *args, reply)
 }
+
+// PurgeCDRs applies the CDR retention policies on demand
+func (cdrSv1 *CDRsV1) PurgeCDRs(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *engine.CDRPurgeReport) error {
+	return cdrSv1.CDRs.V1PurgeCDRs(ctx, args, reply)
+}
+
+// EraseSubscriber removes or pseudonymises all the data kept for one subscriber
+func (cdrSv1 *CDRsV1) EraseSubscriber(ctx *context.Context, args *utils.ArgsEraseSubscriber, reply *engine.SubscriberErasure) error {
+	return cdrSv1.CDRs.V1EraseSubscriber(ctx, args, reply)
+}
@@ -64,6 +64,11 @@ func (cS *CoreSv1) StopMemoryProfiling(ctx *context.Context, params utils.Tenant
 
 }
 
+// GetTraceSpans returns the spans of a trace kept by the *memory exporter
+func (cS *CoreSv1) GetTraceSpans(ctx *context.Context, args *utils.TraceSpansArgs, reply *[]*utils.Span) error {
+	return cS.cS.V1GetTraceSpans(ctx, args, reply)
+}
+
 func (cS *CoreSv1) Panic(ctx *context.Context, args *utils.PanicMessageArgs, reply *string) error {
 	return cS.cS.V1Panic(ctx, args, reply)
 }
@@ -0,0 +1,138 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AttrStartDataDBMigration contains the connection to the DataDB we migrate to
+type AttrStartDataDBMigration struct {
+	DBType     string
+	DBHost     string
+	DBPort     string
+	DBName     string
+	DBUser     string
+	DBPassword string
+	APIOpts    map[string]any
+}
+
+// StartDataDBMigration starts the online migration into a new DataDB: the writes go
+// to both DataDBs while the existing keys are copied in the background.
+// Called again for a stopped migration, it resumes the copy from the last checkpoints
+func (apierSv1 *APIerSv1) StartDataDBMigration(ctx *context.Context, attr *AttrStartDataDBMigration, reply *string) (err error) {
+	if _, err = apierSv1.DataManager.DataDBMigration(); err == nil {
+		if err = apierSv1.DataManager.StartDataDBMigration(apierSv1.Config, nil); err != nil {
+			return utils.NewErrServerError(err)
+		}
+		*reply = utils.OK
+		return
+	} else if err != utils.ErrNotFound {
+		return utils.NewErrServerError(err)
+	}
+	if missing := utils.MissingStructFields(attr, []string{"DBType"}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if err = apierSv1.DataManager.StartDataDBMigration(apierSv1.Config,
+		&engine.DataDBMigrationConn{
+			DBType:     attr.DBType,
+			DBHost:     attr.DBHost,
+			DBPort:     attr.DBPort,
+			DBName:     attr.DBName,
+			DBUser:     attr.DBUser,
+			DBPassword: attr.DBPassword,
+		}); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// GetDataDBMigrationStatus returns the progress of the DataDB migration
+func (apierSv1 *APIerSv1) GetDataDBMigrationStatus(ctx *context.Context, ign *string, reply *engine.DataDBMigrationStatus) error {
+	mig, err := apierSv1.DataManager.DataDBMigration()
+	if err != nil {
+		return err
+	}
+	*reply = *mig.Status()
+	return nil
+}
+
+// VerifyDataDBMigration compares the keys and their checksums between the two DataDBs
+func (apierSv1 *APIerSv1) VerifyDataDBMigration(ctx *context.Context, ign *string, reply *engine.DataDBMigrationReport) error {
+	mig, err := apierSv1.DataManager.DataDBMigration()
+	if err != nil {
+		return err
+	}
+	rpl, err := mig.Verify()
+	if err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = *rpl
+	return nil
+}
+
+// AttrSwitchDataDBMigrationReads are the arguments of SwitchDataDBMigrationReads
+type AttrSwitchDataDBMigrationReads struct {
+	Force   bool // switch without a completed copy and a consistent verify
+	APIOpts map[string]any
+}
+
+// SwitchDataDBMigrationReads starts reading from the new DataDB. The writes are still
+// done on the old one so the migration can be aborted
+func (apierSv1 *APIerSv1) SwitchDataDBMigrationReads(ctx *context.Context, attr *AttrSwitchDataDBMigrationReads, reply *string) error {
+	mig, err := apierSv1.DataManager.DataDBMigration()
+	if err != nil {
+		return err
+	}
+	if err = mig.SwitchReads(attr.Force); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// FinishDataDBMigration stops the writes on the old DataDB and closes it. The new
+// DataDB becomes the configured one until the next start
+func (apierSv1 *APIerSv1) FinishDataDBMigration(ctx *context.Context, ign *string, reply *string) error {
+	mig, err := apierSv1.DataManager.DataDBMigration()
+	if err != nil {
+		return err
+	}
+	if err = mig.Finish(); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// AbortDataDBMigration returns to the old DataDB and closes the new one
+func (apierSv1 *APIerSv1) AbortDataDBMigration(ctx *context.Context, ign *string, reply *string) error {
+	mig, err := apierSv1.DataManager.DataDBMigration()
+	if err != nil {
+		return err
+	}
+	if err = mig.Abort(); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = utils.OK
+	return nil
+}
@@ -488,6 +488,21 @@ func (dRoute *DispatcherRouteSv1) GetRoutesList(ctx *context.Context, args *util
 	return dRoute.dRoute.RouteSv1GetRoutesList(ctx, args, reply)
 }
 
+// ProcessEvent records the outcome of a call over a route with its breaker
+func (dRoute *DispatcherRouteSv1) ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *string) error {
+	return dRoute.dRoute.RouteSv1ProcessEvent(ctx, args, reply)
+}
+
+// GetRouteBreakers returns the state of the route breakers
+func (dRoute *DispatcherRouteSv1) GetRouteBreakers(ctx *context.Context, args *utils.ArgsRouteBreakers, reply *[]*engine.RouteBreaker) error {
+	return dRoute.dRoute.RouteSv1GetRouteBreakers(ctx, args, reply)
+}
+
+// ResetRouteBreakers closes the route breakers
+func (dRoute *DispatcherRouteSv1) ResetRouteBreakers(ctx *context.Context, args *utils.ArgsRouteBreakers, reply *string) error {
+	return dRoute.dRoute.RouteSv1ResetRouteBreakers(ctx, args, reply)
+}
+
 func NewDispatcherAttributeSv1(dps *dispatchers.DispatcherService) *DispatcherAttributeSv1 {
 	return &DispatcherAttributeSv1{dA: dps}
 }
@@ -964,6 +979,14 @@ func (dS *DispatcherSCDRsV1) ProcessCDR(ctx *context.Context, args *engine.CDRWi
 	return dS.dS.CDRsV1ProcessCDR(ctx, args, reply)
 }
 
+func (dS *DispatcherSCDRsV1) PurgeCDRs(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *engine.CDRPurgeReport) error {
+	return dS.dS.CDRsV1PurgeCDRs(ctx, args, reply)
+}
+
+func (dS *DispatcherSCDRsV1) EraseSubscriber(ctx *context.Context, args *utils.ArgsEraseSubscriber, reply *engine.SubscriberErasure) error {
+	return dS.dS.CDRsV1EraseSubscriber(ctx, args, reply)
+}
+
 func NewDispatcherSServiceManagerV1(dps *dispatchers.DispatcherService) *DispatcherSServiceManagerV1 {
 	return &DispatcherSServiceManagerV1{dS: dps}
 }
@@ -1054,6 +1077,10 @@ func (dS *DispatcherCoreSv1) Sleep(ctx *context.Context, args *utils.DurationArg
 	return dS.dS.CoreSv1Sleep(ctx, args, reply)
 }
 
+func (dS *DispatcherCoreSv1) GetTraceSpans(ctx *context.Context, args *utils.TraceSpansArgs, reply *[]*utils.Span) error {
+	return dS.dS.CoreSv1GetTraceSpans(ctx, args, reply)
+}
+
 func (dS *DispatcherCoreSv1) StartCPUProfiling(ctx *context.Context, args *utils.DirectoryArgs, reply *string) error {
 	return dS.dS.CoreSv1StartCPUProfiling(ctx, args, reply)
 }
@@ -1136,6 +1163,31 @@ func (dS *DispatcherReplicatorSv1) GetReverseDestination(ctx *context.Context, k
 	return dS.dS.ReplicatorSv1GetReverseDestination(ctx, key, reply)
 }
 
+// GetPortedNumber
+func (dS *DispatcherReplicatorSv1) GetPortedNumber(ctx *context.Context, key *utils.StringWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1GetPortedNumber(ctx, key, reply)
+}
+
+// GetLookupTable
+func (dS *DispatcherReplicatorSv1) GetLookupTable(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.LookupTable) error {
+	return dS.dS.ReplicatorSv1GetLookupTable(ctx, tntID, reply)
+}
+
+// GetDiscountProfile
+func (dS *DispatcherReplicatorSv1) GetDiscountProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.DiscountProfile) error {
+	return dS.dS.ReplicatorSv1GetDiscountProfile(ctx, tntID, reply)
+}
+
+// GetFraudProfile
+func (dS *DispatcherReplicatorSv1) GetFraudProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.FraudProfile) error {
+	return dS.dS.ReplicatorSv1GetFraudProfile(ctx, tntID, reply)
+}
+
+// GetFraudCase
+func (dS *DispatcherReplicatorSv1) GetFraudCase(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.FraudCase) error {
+	return dS.dS.ReplicatorSv1GetFraudCase(ctx, tntID, reply)
+}
+
 // GetStatQueue
 func (dS *DispatcherReplicatorSv1) GetStatQueue(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.StatQueue) error {
 	return dS.dS.ReplicatorSv1GetStatQueue(ctx, tntID, reply)
@@ -1273,6 +1325,36 @@ func (dS *DispatcherReplicatorSv1) SetDestination(ctx *context.Context, args *en
 	return dS.dS.ReplicatorSv1SetDestination(ctx, args, reply)
 }
 
+// SetPortedNumber
+func (dS *DispatcherReplicatorSv1) SetPortedNumber(ctx *context.Context, args *engine.PortedNumberWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetPortedNumber(ctx, args, reply)
+}
+
+// SetPortedNumbers
+func (dS *DispatcherReplicatorSv1) SetPortedNumbers(ctx *context.Context, args *engine.PortedNumbersWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetPortedNumbers(ctx, args, reply)
+}
+
+// SetLookupTable
+func (dS *DispatcherReplicatorSv1) SetLookupTable(ctx *context.Context, args *engine.LookupTableWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetLookupTable(ctx, args, reply)
+}
+
+// SetDiscountProfile
+func (dS *DispatcherReplicatorSv1) SetDiscountProfile(ctx *context.Context, args *engine.DiscountProfileWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetDiscountProfile(ctx, args, reply)
+}
+
+// SetFraudProfile
+func (dS *DispatcherReplicatorSv1) SetFraudProfile(ctx *context.Context, args *engine.FraudProfileWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetFraudProfile(ctx, args, reply)
+}
+
+// SetFraudCase
+func (dS *DispatcherReplicatorSv1) SetFraudCase(ctx *context.Context, args *engine.FraudCaseWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1SetFraudCase(ctx, args, reply)
+}
+
 // SetAccount
 func (dS *DispatcherReplicatorSv1) SetAccount(ctx *context.Context, args *engine.AccountWithAPIOpts, reply *string) error {
 	return dS.dS.ReplicatorSv1SetAccount(ctx, args, reply)
@@ -1398,6 +1480,31 @@ func (dS *DispatcherReplicatorSv1) RemoveDestination(ctx *context.Context, args
 	return dS.dS.ReplicatorSv1RemoveDestination(ctx, args, reply)
 }
 
+// RemovePortedNumber
+func (dS *DispatcherReplicatorSv1) RemovePortedNumber(ctx *context.Context, args *utils.StringWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemovePortedNumber(ctx, args, reply)
+}
+
+// RemoveLookupTable
+func (dS *DispatcherReplicatorSv1) RemoveLookupTable(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveLookupTable(ctx, args, reply)
+}
+
+// RemoveDiscountProfile
+func (dS *DispatcherReplicatorSv1) RemoveDiscountProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveDiscountProfile(ctx, args, reply)
+}
+
+// RemoveFraudProfile
+func (dS *DispatcherReplicatorSv1) RemoveFraudProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveFraudProfile(ctx, args, reply)
+}
+
+// RemoveFraudCase
+func (dS *DispatcherReplicatorSv1) RemoveFraudCase(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
+	return dS.dS.ReplicatorSv1RemoveFraudCase(ctx, args, reply)
+}
+
 // RemoveAccount
 func (dS *DispatcherReplicatorSv1) RemoveAccount(ctx *context.Context, args *utils.StringWithAPIOpts, reply *string) error {
 	return dS.dS.ReplicatorSv1RemoveAccount(ctx, args, reply)
@@ -66,6 +66,10 @@ func (apierSv1 *APIerSv1) RemoveFilterIndexes(ctx *context.Context, arg *AttrRem
 		arg.ItemType = utils.CacheResourceFilterIndexes
 	case utils.MetaChargers:
 		arg.ItemType = utils.CacheChargerFilterIndexes
+	case utils.MetaFrauds:
+		arg.ItemType = utils.CacheFraudFilterIndexes
+	case utils.MetaDiscounts:
+		arg.ItemType = utils.CacheDiscountFilterIndexes
 	case utils.MetaDispatchers:
 		if missing := utils.MissingStructFields(arg, []string{"Context"}); len(missing) != 0 { //Params missing
 			return utils.NewErrMandatoryIeMissing(missing...)
@@ -117,6 +121,10 @@ func (apierSv1 *APIerSv1) GetFilterIndexes(ctx *context.Context, arg *AttrGetFil
 		arg.ItemType = utils.CacheResourceFilterIndexes
 	case utils.MetaChargers:
 		arg.ItemType = utils.CacheChargerFilterIndexes
+	case utils.MetaFrauds:
+		arg.ItemType = utils.CacheFraudFilterIndexes
+	case utils.MetaDiscounts:
+		arg.ItemType = utils.CacheDiscountFilterIndexes
 	case utils.MetaDispatchers:
 		if missing := utils.MissingStructFields(arg, []string{"Context"}); len(missing) != 0 { //Params missing
 			return utils.NewErrMandatoryIeMissing(missing...)
@@ -700,3 +708,54 @@ func (apierSv1 *APIerSv1) GetDispatchersIndexesHealth(ctx *context.Context, args
 	*reply = *rp
 	return nil
 }
+
+// ArgsRepairFilterIndexes selects the filter indexes repaired by RepairFilterIndexes
+type ArgsRepairFilterIndexes struct {
+	IndexType string // one of the *_filter_indexes cache partitions, e.g. *threshold_filter_indexes
+	engine.IndexHealthArgsWith3Ch
+}
+
+// RepairFilterIndexes fixes only the filter indexes found broken by the health check,
+// replying with the report the repair was based on
+func (apierSv1 *APIerSv1) RepairFilterIndexes(ctx *context.Context, args *ArgsRepairFilterIndexes, reply *engine.FilterIHReply) error {
+	if args.IndexType == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.IndexType)
+	}
+	if _, has := utils.CacheIndexesToPrefix[args.IndexType]; !has ||
+		args.IndexType == utils.CacheReverseFilterIndexes {
+		return utils.ErrPrefix(utils.ErrUnsupportedFormat, args.IndexType)
+	}
+	rp, err := engine.RepairFltrIdx(apierSv1.DataManager,
+		ltcache.NewCache(args.FilterCacheLimit, args.FilterCacheTTL, args.FilterCacheStaticTTL, false, nil),
+		ltcache.NewCache(args.IndexCacheLimit, args.IndexCacheTTL, args.IndexCacheStaticTTL, false, nil),
+		ltcache.NewCache(args.ObjectCacheLimit, args.ObjectCacheTTL, args.ObjectCacheStaticTTL, false, nil),
+		args.IndexType,
+	)
+	if err != nil {
+		return err
+	}
+	*reply = *rp
+	return nil
+}
+
+// RepairReverseDestinationsIndex fixes the reverse destinations found broken by the health check
+func (apierSv1 *APIerSv1) RepairReverseDestinationsIndex(ctx *context.Context, args *engine.IndexHealthArgsWith2Ch, reply *engine.ReverseDestinationsIHReply) error {
+	rp, err := engine.RepairReverseDestinationsIndex(apierSv1.DataManager, args.ObjectCacheLimit, args.IndexCacheLimit,
+		args.ObjectCacheTTL, args.IndexCacheTTL, args.ObjectCacheStaticTTL, args.IndexCacheStaticTTL)
+	if err != nil {
+		return err
+	}
+	*reply = *rp
+	return nil
+}
+
+// RepairAccountActionPlansIndex fixes the account action plans found broken by the health check
+func (apierSv1 *APIerSv1) RepairAccountActionPlansIndex(ctx *context.Context, args *engine.IndexHealthArgsWith2Ch, reply *engine.AccountActionPlanIHReply) error {
+	rp, err := engine.RepairAccountActionPlansIndex(apierSv1.DataManager, args.ObjectCacheLimit, args.IndexCacheLimit,
+		args.ObjectCacheTTL, args.IndexCacheTTL, args.ObjectCacheStaticTTL, args.IndexCacheStaticTTL)
+	if err != nil {
+		return err
+	}
+	*reply = *rp
+	return nil
+}
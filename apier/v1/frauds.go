@@ -0,0 +1,158 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetFraudProfile returns a fraud profile
+func (apierSv1 *APIerSv1) GetFraudProfile(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *engine.FraudProfile) error {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	fp, err := apierSv1.DataManager.GetFraudProfile(tnt, arg.ID, true, true, utils.NonTransactional)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *fp
+	return nil
+}
+
+// GetFraudProfileIDs returns list of fraud profile IDs registered for a tenant
+func (apierSv1 *APIerSv1) GetFraudProfileIDs(ctx *context.Context, args *utils.PaginatorWithTenant, fpIDs *[]string) error {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.FraudProfilePrefix + tnt + utils.ConcatenatedKeySep
+	keys, err := apierSv1.DataManager.DataDB().GetKeysForPrefix(prfx, args.Search)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	retIDs := make([]string, len(keys))
+	for i, key := range keys {
+		retIDs[i] = key[len(prfx):]
+	}
+	*fpIDs = args.PaginateStringSlice(retIDs)
+	return nil
+}
+
+// SetFraudProfile adds or overwrites a fraud profile
+func (apierSv1 *APIerSv1) SetFraudProfile(ctx *context.Context, args *engine.FraudProfileWithAPIOpts, reply *string) error {
+	if missing := utils.MissingStructFields(args.FraudProfile, []string{utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	if args.Tenant == utils.EmptyString {
+		args.Tenant = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := args.Validate(); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	if err := apierSv1.DataManager.SetFraudProfile(args.FraudProfile, true); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheFraudProfiles and store it in database
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheFraudProfiles: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for FraudProfile
+	if err := apierSv1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), args.Tenant, utils.CacheFraudProfiles,
+		args.TenantID(), utils.EmptyString, &args.FilterIDs, nil, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// RemoveFraudProfile removes a specific fraud profile
+func (apierSv1 *APIerSv1) RemoveFraudProfile(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *string) error {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = apierSv1.Config.GeneralCfg().DefaultTenant
+	}
+	if err := apierSv1.DataManager.RemoveFraudProfile(tnt, arg.ID, true); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CacheFraudProfiles and store it in database
+	if err := apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CacheFraudProfiles: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for FraudProfile
+	if err := apierSv1.CallCache(utils.IfaceAsString(arg.APIOpts[utils.CacheOpt]), tnt, utils.CacheFraudProfiles,
+		utils.ConcatenatedKey(tnt, arg.ID), utils.EmptyString, nil, nil, arg.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return nil
+}
+
+// NewFraudSv1 initializes FraudSv1
+func NewFraudSv1(frS *engine.FraudS) *FraudSv1 {
+	return &FraudSv1{frS: frS}
+}
+
+// FraudSv1 exports the FraudS API
+type FraudSv1 struct {
+	frS *engine.FraudS
+}
+
+// ProcessEvent scores the event and returns the IDs of the updated cases
+func (frSv1 *FraudSv1) ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *[]string) error {
+	return frSv1.frS.V1ProcessEvent(ctx, args, reply)
+}
+
+// GetFraudCase returns a fraud case together with its evidence
+func (frSv1 *FraudSv1) GetFraudCase(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *engine.FraudCase) error {
+	return frSv1.frS.V1GetFraudCase(ctx, arg, reply)
+}
+
+// GetFraudCaseIDs returns the list of fraud case IDs registered for a tenant
+func (frSv1 *FraudSv1) GetFraudCaseIDs(ctx *context.Context, args *utils.PaginatorWithTenant, reply *[]string) error {
+	return frSv1.frS.V1GetFraudCaseIDs(ctx, args, reply)
+}
+
+// ReviewFraudCase marks a fraud case as under review
+func (frSv1 *FraudSv1) ReviewFraudCase(ctx *context.Context, args *engine.FraudCaseArgs, reply *string) error {
+	return frSv1.frS.V1ReviewFraudCase(ctx, args, reply)
+}
+
+// WhitelistFraudCase releases the containment and stops scoring the subject
+func (frSv1 *FraudSv1) WhitelistFraudCase(ctx *context.Context, args *engine.FraudCaseArgs, reply *string) error {
+	return frSv1.frS.V1WhitelistFraudCase(ctx, args, reply)
+}
+
+// CloseFraudCase releases the containment and closes the fraud case
+func (frSv1 *FraudSv1) CloseFraudCase(ctx *context.Context, args *engine.FraudCaseArgs, reply *string) error {
+	return frSv1.frS.V1CloseFraudCase(ctx, args, reply)
+}
@@ -0,0 +1,134 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/ltcache"
+)
+
+// checkIndexesHealth runs the scheduled health checks over all the indexes, repairing
+// them if configured so, and publishes the findings towards ThresholdS and EEs
+func (apierSv1 *APIerSv1) checkIndexesHealth() {
+	repair := apierSv1.Config.ApierCfg().IndexHealthRepair
+	idxTypes := make([]string, 0, len(utils.CacheIndexesToPrefix))
+	for idxType := range utils.CacheIndexesToPrefix {
+		if idxType != utils.CacheReverseFilterIndexes { // checked together with the filter indexes
+			idxTypes = append(idxTypes, idxType)
+		}
+	}
+	slices.Sort(idxTypes)
+	for _, idxType := range idxTypes {
+		fltrCache := ltcache.NewCache(-1, 0, false, false, nil)
+		idxCache := ltcache.NewCache(-1, 0, false, false, nil)
+		objCache := ltcache.NewCache(-1, 0, false, false, nil)
+		var rp *engine.FilterIHReply
+		var err error
+		if repair {
+			rp, err = engine.RepairFltrIdx(apierSv1.DataManager, fltrCache, idxCache, objCache, idxType)
+		} else {
+			rp, err = engine.GetFltrIdxHealth(apierSv1.DataManager, fltrCache, idxCache, objCache, idxType)
+		}
+		if err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed checking the health of <%s> indexes: %s",
+				utils.ApierS, idxType, err))
+			continue
+		}
+		apierSv1.processIndexHealth(idxType, len(rp.MissingIndexes), len(rp.BrokenIndexes),
+			len(rp.MissingObjects), len(rp.MissingFilters), repair)
+	}
+
+	var rdRp *engine.ReverseDestinationsIHReply
+	var err error
+	if repair {
+		rdRp, err = engine.RepairReverseDestinationsIndex(apierSv1.DataManager, -1, -1, 0, 0, false, false)
+	} else {
+		rdRp, err = engine.GetReverseDestinationsIndexHealth(apierSv1.DataManager, -1, -1, 0, 0, false, false)
+	}
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed checking the health of <%s> indexes: %s",
+			utils.ApierS, utils.CacheReverseDestinations, err))
+	} else {
+		apierSv1.processIndexHealth(utils.CacheReverseDestinations, len(rdRp.MissingReverseDestinations),
+			len(rdRp.BrokenReferences), 0, 0, repair)
+	}
+
+	var aapRp *engine.AccountActionPlanIHReply
+	if repair {
+		aapRp, err = engine.RepairAccountActionPlansIndex(apierSv1.DataManager, -1, -1, 0, 0, false, false)
+	} else {
+		aapRp, err = engine.GetAccountActionPlansIndexHealth(apierSv1.DataManager, -1, -1, 0, 0, false, false)
+	}
+	if err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed checking the health of <%s> indexes: %s",
+			utils.ApierS, utils.CacheAccountActionPlans, err))
+		return
+	}
+	apierSv1.processIndexHealth(utils.CacheAccountActionPlans, len(aapRp.MissingAccountActionPlans),
+		len(aapRp.BrokenReferences), 0, 0, repair)
+}
+
+// processIndexHealth logs the unhealthy indexes of one type and passes the findings
+// as an IndexHealthUpdate event to ThresholdS and EEs
+func (apierSv1 *APIerSv1) processIndexHealth(idxType string, missingIdx, brokenIdx, missingObj, missingFltr int, repaired bool) {
+	if missingIdx+brokenIdx+missingObj+missingFltr == 0 {
+		return
+	}
+	utils.Logger.Warning(fmt.Sprintf(
+		"<%s> unhealthy <%s> indexes: %d missing, %d broken, %d missing objects, %d missing filters, repaired: %t",
+		utils.ApierS, idxType, missingIdx, brokenIdx, missingObj, missingFltr, repaired))
+	ev := &utils.CGREvent{
+		Tenant: apierSv1.Config.GeneralCfg().DefaultTenant,
+		ID:     utils.GenUUID(),
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.IndexHealthUpdate,
+		},
+		Event: map[string]any{
+			utils.IndexType:      idxType,
+			utils.MissingIndexes: missingIdx,
+			utils.BrokenIndexes:  brokenIdx,
+			utils.MissingObjects: missingObj,
+			utils.MissingFilters: missingFltr,
+			utils.Repaired:       repaired,
+		},
+	}
+	if thdConns := apierSv1.Config.ApierCfg().ThresholdSConns; len(thdConns) != 0 {
+		var thIDs []string
+		if err := apierSv1.ConnMgr.Call(context.TODO(), thdConns,
+			utils.ThresholdSv1ProcessEvent, ev, &thIDs); err != nil &&
+			err.Error() != utils.ErrNotFound.Error() {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: %s processing event %+v with ThresholdS.", utils.ApierS, err.Error(), ev))
+		}
+	}
+	if eeConns := apierSv1.Config.ApierCfg().EEsConns; len(eeConns) != 0 {
+		var reply map[string]map[string]any
+		if err := apierSv1.ConnMgr.Call(context.TODO(), eeConns,
+			utils.EeSv1ProcessEvent, &engine.CGREventWithEeIDs{CGREvent: ev}, &reply); err != nil &&
+			err.Error() != utils.ErrNotFound.Error() {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: %q processing event %+v with EEs.", utils.ApierS, err.Error(), ev))
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAPIerSv1CheckIndexesHealthRepair(t *testing.T) {
+	engine.Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.ApierCfg().IndexHealthRepair = true
+	cfg.ApierCfg().ThresholdSConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds)}
+	cfg.ApierCfg().EEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	dataDB, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(dataDB, cfg.CacheCfg(), nil)
+	if err := dm.SetThresholdProfile(&engine.ThresholdProfile{
+		Tenant:    "cgrates.org",
+		ID:        "TH1",
+		FilterIDs: []string{"*string:~*req.Account:1001"},
+	}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	var evs []*utils.CGREvent
+	var eeEvs []*engine.CGREventWithEeIDs
+	ccM := &ccMock{
+		calls: map[string]func(args any, reply any) error{
+			utils.ThresholdSv1ProcessEvent: func(args, reply any) error {
+				evs = append(evs, args.(*utils.CGREvent))
+				return nil
+			},
+			utils.EeSv1ProcessEvent: func(args, reply any) error {
+				ev, canCast := args.(*engine.CGREventWithEeIDs)
+				if !canCast {
+					return fmt.Errorf("unexpected EEs args: %T", args)
+				}
+				eeEvs = append(eeEvs, ev)
+				return nil
+			},
+		},
+	}
+	rpcInternal := make(chan birpc.ClientConnector, 1)
+	rpcInternal <- ccM
+	eesInternal := make(chan birpc.ClientConnector, 1)
+	eesInternal <- ccM
+	cM := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds): rpcInternal,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs):        eesInternal,
+	})
+	apierSv1 := &APIerSv1{
+		Config:      cfg,
+		DataManager: dm,
+		ConnMgr:     cM,
+	}
+	apierSv1.checkIndexesHealth()
+
+	if len(evs) != 1 {
+		t.Fatalf("expected one event, received: %s", utils.ToJSON(evs))
+	}
+	expEv := map[string]any{
+		utils.IndexType:      utils.CacheThresholdFilterIndexes,
+		utils.MissingIndexes: 1,
+		utils.BrokenIndexes:  0,
+		utils.MissingObjects: 0,
+		utils.MissingFilters: 0,
+		utils.Repaired:       true,
+	}
+	if !reflect.DeepEqual(expEv, evs[0].Event) {
+		t.Errorf("expected: %s, received: %s", utils.ToJSON(expEv), utils.ToJSON(evs[0].Event))
+	}
+	if evs[0].APIOpts[utils.MetaEventType] != utils.IndexHealthUpdate {
+		t.Errorf("expected event type %q, received: %v", utils.IndexHealthUpdate, evs[0].APIOpts)
+	}
+	if len(eeEvs) != 1 {
+		t.Fatalf("expected one exported event, received: %s", utils.ToJSON(eeEvs))
+	}
+	if !reflect.DeepEqual(evs[0], eeEvs[0].CGREvent) {
+		t.Errorf("expected: %s, received: %s", utils.ToJSON(evs[0]), utils.ToJSON(eeEvs[0].CGREvent))
+	}
+
+	var rply engine.FilterIHReply
+	if err := apierSv1.GetThresholdsIndexesHealth(context.Background(),
+		&engine.IndexHealthArgsWith3Ch{IndexCacheLimit: -1, ObjectCacheLimit: -1, FilterCacheLimit: -1}, &rply); err != nil {
+		t.Fatal(err)
+	}
+	if len(rply.MissingIndexes) != 0 || len(rply.BrokenIndexes) != 0 || len(rply.MissingObjects) != 0 {
+		t.Errorf("expected healthy indexes after repair, received: %s", utils.ToJSON(rply))
+	}
+
+	// a second run finds nothing to report
+	evs, eeEvs = nil, nil
+	apierSv1.checkIndexesHealth()
+	if len(evs) != 0 || len(eeEvs) != 0 {
+		t.Errorf("expected no events, received: %s, %s", utils.ToJSON(evs), utils.ToJSON(eeEvs))
+	}
+}
+
+func TestAPIerSv1RepairFilterIndexesUnsupported(t *testing.T) {
+	apierSv1 := &APIerSv1{}
+	var rply engine.FilterIHReply
+	if err := apierSv1.RepairFilterIndexes(context.Background(), &ArgsRepairFilterIndexes{},
+		&rply); err == nil || err.Error() != utils.NewErrMandatoryIeMissing(utils.IndexType).Error() {
+		t.Errorf("unexpected error: %v", err)
+	}
+	expErr := "UNSUPPORTED_FORMAT:" + utils.CacheReverseFilterIndexes
+	if err := apierSv1.RepairFilterIndexes(context.Background(), &ArgsRepairFilterIndexes{IndexType: utils.CacheReverseFilterIndexes},
+		&rply); err == nil || err.Error() != expErr {
+		t.Errorf("expected %q, received: %v", expErr, err)
+	}
+}
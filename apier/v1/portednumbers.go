@@ -0,0 +1,158 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"os"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AttrSetPortedNumbers is used to import ported numbers in bulk
+type AttrSetPortedNumbers struct {
+	Tenant        string
+	PortedNumbers []*engine.PortedNumber
+	APIOpts       map[string]any
+}
+
+// AttrRemovePortedNumbers is used to remove ported numbers in bulk
+type AttrRemovePortedNumbers struct {
+	Tenant  string
+	Numbers []string
+	APIOpts map[string]any
+}
+
+// AttrLoadPortedNumbers is used to load the ported numbers from a CSV file
+type AttrLoadPortedNumbers struct {
+	Tenant         string
+	FilePath       string // absolute path to the CSV file with Number,RoutingNumber records
+	FieldSeparator string
+	APIOpts        map[string]any
+}
+
+// GetPortedNumber returns the routing number of a ported number
+func (apierSv1 *APIerSv1) GetPortedNumber(ctx *context.Context, arg *utils.StringWithAPIOpts, reply *string) (err error) {
+	if arg.Arg == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("Arg")
+	}
+	var rn string
+	if rn, err = apierSv1.DataManager.GetPortedNumber(arg.Arg, true, true, utils.NonTransactional); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = rn
+	return
+}
+
+// SetPortedNumbers stores or updates the routing numbers of the given ported numbers
+func (apierSv1 *APIerSv1) SetPortedNumbers(ctx *context.Context, args *AttrSetPortedNumbers, reply *string) (err error) {
+	if len(args.PortedNumbers) == 0 {
+		return utils.NewErrMandatoryIeMissing("PortedNumbers")
+	}
+	numbers := make([]string, len(args.PortedNumbers))
+	for i, pn := range args.PortedNumbers {
+		if missing := utils.MissingStructFields(pn, []string{"Number", "RoutingNumber"}); len(missing) != 0 {
+			return utils.NewErrMandatoryIeMissing(missing...)
+		}
+		numbers[i] = pn.Number
+	}
+	if err = apierSv1.DataManager.SetPortedNumbers(args.PortedNumbers); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	tnt := utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant)
+	//generate a loadID for CachePortedNumbers and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CachePortedNumbers: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//handle caching for PortedNumbers
+	if err = apierSv1.callCacheMultiple(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), tnt,
+		utils.CachePortedNumbers, numbers, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemovePortedNumbers removes the given numbers from the portability database
+func (apierSv1 *APIerSv1) RemovePortedNumbers(ctx *context.Context, args *AttrRemovePortedNumbers, reply *string) (err error) {
+	if len(args.Numbers) == 0 {
+		return utils.NewErrMandatoryIeMissing("Numbers")
+	}
+	for _, number := range args.Numbers {
+		if err = apierSv1.DataManager.RemovePortedNumber(number); err != nil {
+			return utils.APIErrorHandler(err)
+		}
+	}
+	tnt := utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant)
+	//handle caching for PortedNumbers
+	if err = apierSv1.callCacheMultiple(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]), tnt,
+		utils.CachePortedNumbers, args.Numbers, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	//generate a loadID for CachePortedNumbers and store it in database
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CachePortedNumbers: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// LoadPortedNumbersFromFile imports the ported numbers from a CSV file, the
+// ported numbers cache is cleared afterwards instead of reloading each number
+func (apierSv1 *APIerSv1) LoadPortedNumbersFromFile(ctx *context.Context, args *AttrLoadPortedNumbers, reply *string) (err error) {
+	if args.FilePath == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing("FilePath")
+	}
+	sep := utils.CSVSep
+	if args.FieldSeparator != utils.EmptyString {
+		sep = rune(args.FieldSeparator[0])
+	}
+	var f *os.File
+	if f, err = os.Open(args.FilePath); err != nil {
+		if os.IsNotExist(err) {
+			return utils.ErrInvalidPath
+		}
+		return utils.NewErrServerError(err)
+	}
+	defer f.Close()
+	var loaded int
+	if loaded, err = engine.LoadPortedNumbersFromCSV(apierSv1.DataManager, f, sep); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	if loaded == 0 {
+		*reply = utils.OK
+		return
+	}
+	if err = apierSv1.DataManager.SetLoadIDs(map[string]int64{utils.CachePortedNumbers: time.Now().UnixNano()}); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	tnt := utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant)
+	cacheOpt := utils.MetaClear
+	if utils.IfaceAsString(args.APIOpts[utils.CacheOpt]) == utils.MetaNone {
+		cacheOpt = utils.MetaNone
+	}
+	if err = apierSv1.callCacheMultiple(cacheOpt, tnt, utils.CachePortedNumbers,
+		[]string{utils.MetaAny}, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
@@ -0,0 +1,71 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// ImportRateDeck applies the carrier rate deck onto the rating profile of the deck,
+// scheduling the future changes, and returns the change report
+func (apierSv1 *APIerSv1) ImportRateDeck(ctx *context.Context, args *utils.ArgsImportRateDeck, reply *engine.RateDeckReport) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	var rdr io.Reader
+	if args.Content != utils.EmptyString {
+		rdr = strings.NewReader(args.Content)
+	} else {
+		if args.Path == utils.EmptyString {
+			return utils.NewErrMandatoryIeMissing(utils.Path)
+		}
+		var f *os.File
+		if f, err = os.Open(args.Path); err != nil {
+			return utils.NewErrServerError(err)
+		}
+		defer f.Close()
+		rdr = f
+	}
+	rpt, cacheItems, err := engine.NewRateDeckImporter(apierSv1.Config, apierSv1.DataManager).Import(args, rdr)
+	if err != nil {
+		return utils.NewErrServerError(err)
+	}
+	if !args.DryRun {
+		// delay if needed before cache call
+		if apierSv1.Config.GeneralCfg().CachingDelay != 0 {
+			utils.Logger.Info(fmt.Sprintf("<ImportRateDeck> Delaying cache call for %v", apierSv1.Config.GeneralCfg().CachingDelay))
+			time.Sleep(apierSv1.Config.GeneralCfg().CachingDelay)
+		}
+		tnt := utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant)
+		if err = apierSv1.callCacheForComputeIndexes(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+			tnt, cacheItems, args.APIOpts); err != nil {
+			return utils.APIErrorHandler(err)
+		}
+	}
+	*reply = *rpt
+	return
+}
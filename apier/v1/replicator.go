@@ -76,6 +76,61 @@ func (rplSv1 *ReplicatorSv1) GetReverseDestination(ctx *context.Context, key *ut
 	return nil
 }
 
+// GetPortedNumber is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetPortedNumber(ctx *context.Context, key *utils.StringWithAPIOpts, reply *string) error {
+	engine.UpdateReplicationFilters(utils.PortedNumberPrefix, key.Arg, utils.IfaceAsString(key.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetPortedNumberDrv(key.Arg)
+	if err != nil {
+		return err
+	}
+	*reply = rcv
+	return nil
+}
+
+// GetLookupTable is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetLookupTable(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.LookupTable) error {
+	engine.UpdateReplicationFilters(utils.LookupTablePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetLookupTableDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
+// GetDiscountProfile is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetDiscountProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.DiscountProfile) error {
+	engine.UpdateReplicationFilters(utils.DiscountProfilePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetDiscountProfileDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
+// GetFraudProfile is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetFraudProfile(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.FraudProfile) error {
+	engine.UpdateReplicationFilters(utils.FraudProfilePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetFraudProfileDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
+// GetFraudCase is the remote method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) GetFraudCase(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.FraudCase) error {
+	engine.UpdateReplicationFilters(utils.FraudCasePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
+	rcv, err := rplSv1.dm.DataDB().GetFraudCaseDrv(tntID.Tenant, tntID.ID)
+	if err != nil {
+		return err
+	}
+	*reply = *rcv
+	return nil
+}
+
 // GetStatQueue is the remote method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) GetStatQueue(ctx *context.Context, tntID *utils.TenantIDWithAPIOpts, reply *engine.StatQueue) error {
 	engine.UpdateReplicationFilters(utils.StatQueuePrefix, tntID.TenantID.TenantID(), utils.IfaceAsString(tntID.APIOpts[utils.RemoteHostOpt]))
@@ -443,6 +498,88 @@ func (rplSv1 *ReplicatorSv1) SetReverseDestination(ctx *context.Context, dst *en
 	return
 }
 
+// SetPortedNumber is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetPortedNumber(ctx *context.Context, pn *engine.PortedNumberWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetPortedNumberDrv(pn.Number, pn.RoutingNumber); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(pn.APIOpts[utils.CacheOpt]),
+		pn.Tenant, utils.CachePortedNumbers, pn.Number, utils.EmptyString, nil, nil, pn.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetPortedNumbers is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetPortedNumbers(ctx *context.Context, pns *engine.PortedNumbersWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetPortedNumbersDrv(pns.PortedNumbers); err != nil {
+		return
+	}
+	numbers := make([]string, len(pns.PortedNumbers))
+	for i, pn := range pns.PortedNumbers {
+		numbers[i] = pn.Number
+	}
+	if err = rplSv1.v1.callCacheMultiple(utils.IfaceAsString(pns.APIOpts[utils.CacheOpt]),
+		pns.Tenant, utils.CachePortedNumbers, numbers, pns.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetLookupTable is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetLookupTable(ctx *context.Context, lt *engine.LookupTableWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetLookupTableDrv(lt.LookupTable); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(lt.APIOpts[utils.CacheOpt]),
+		lt.Tenant, utils.CacheLookupTables, lt.TenantID(), utils.EmptyString, nil, nil, lt.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetDiscountProfile is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetDiscountProfile(ctx *context.Context, dp *engine.DiscountProfileWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetDiscountProfileDrv(dp.DiscountProfile); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(dp.APIOpts[utils.CacheOpt]),
+		dp.Tenant, utils.CacheDiscountProfiles, dp.TenantID(), utils.EmptyString, &dp.FilterIDs, nil, dp.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetFraudProfile is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetFraudProfile(ctx *context.Context, fp *engine.FraudProfileWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetFraudProfileDrv(fp.FraudProfile); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(fp.APIOpts[utils.CacheOpt]),
+		fp.Tenant, utils.CacheFraudProfiles, fp.TenantID(), utils.EmptyString, &fp.FilterIDs, nil, fp.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// SetFraudCase is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) SetFraudCase(ctx *context.Context, fc *engine.FraudCaseWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().SetFraudCaseDrv(fc.FraudCase); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(fc.APIOpts[utils.CacheOpt]),
+		fc.Tenant, utils.CacheFraudCases, fc.TenantID(), utils.EmptyString, nil, nil, fc.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // SetThresholdProfile is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) SetThresholdProfile(ctx *context.Context, th *engine.ThresholdProfileWithAPIOpts, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().SetThresholdProfileDrv(th.ThresholdProfile); err != nil {
@@ -904,6 +1041,71 @@ func (rplSv1 *ReplicatorSv1) RemoveDestination(ctx *context.Context, id *utils.S
 	return
 }
 
+// RemovePortedNumber is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemovePortedNumber(ctx *context.Context, id *utils.StringWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemovePortedNumberDrv(id.Arg); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(id.APIOpts[utils.CacheOpt]),
+		id.Tenant, utils.CachePortedNumbers, id.Arg, utils.EmptyString, nil, nil, id.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveLookupTable is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveLookupTable(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveLookupTableDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheLookupTables, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveDiscountProfile is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveDiscountProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveDiscountProfileDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheDiscountProfiles, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveFraudProfile is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveFraudProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveFraudProfileDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheFraudProfiles, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// RemoveFraudCase is the replication method coresponding to the dataDb driver method
+func (rplSv1 *ReplicatorSv1) RemoveFraudCase(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) (err error) {
+	if err = rplSv1.dm.DataDB().RemoveFraudCaseDrv(args.Tenant, args.ID); err != nil {
+		return
+	}
+	if err = rplSv1.v1.CallCache(utils.IfaceAsString(args.APIOpts[utils.CacheOpt]),
+		args.Tenant, utils.CacheFraudCases, args.TenantID.TenantID(), utils.EmptyString, nil, nil, args.APIOpts); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // RemoveAccount is the replication method coresponding to the dataDb driver method
 func (rplSv1 *ReplicatorSv1) RemoveAccount(ctx *context.Context, id *utils.StringWithAPIOpts, reply *string) (err error) {
 	if err = rplSv1.dm.DataDB().RemoveAccountDrv(id.Arg); err != nil {
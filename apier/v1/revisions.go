@@ -0,0 +1,128 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package v1
+
+import (
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// GetRevisions returns the previous versions kept for a DataDB object
+func (apierSv1 *APIerSv1) GetRevisions(ctx *context.Context, args *utils.ArgsRevision, reply *engine.ObjectRevisions) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ObjectType, utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	var id string
+	if id, err = engine.RevisionObjectID(args.ObjectType,
+		utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant), args.ID); err != nil {
+		return
+	}
+	rvs, err := apierSv1.DataManager.GetRevisions(args.ObjectType, id)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = *rvs
+	return
+}
+
+// GetRevisionDiff returns the paths of the fields changed between the Revision
+// and the CompareTo versions of a DataDB object, 0 being the current version
+func (apierSv1 *APIerSv1) GetRevisionDiff(ctx *context.Context, args *utils.ArgsRevision, reply *[]string) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ObjectType, utils.ID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	var id string
+	if id, err = engine.RevisionObjectID(args.ObjectType,
+		utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant), args.ID); err != nil {
+		return
+	}
+	diffs, err := apierSv1.DataManager.GetRevisionDiff(args.ObjectType, id, args.Revision, args.CompareTo)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = diffs
+	return
+}
+
+// RollbackRevision restores a DataDB object to the version kept in the revision
+func (apierSv1 *APIerSv1) RollbackRevision(ctx *context.Context, args *utils.ArgsRevision, reply *string) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ObjectType, utils.ID, utils.Revision}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant)
+	var id string
+	if id, err = engine.RevisionObjectID(args.ObjectType, tnt, args.ID); err != nil {
+		return
+	}
+	if err = apierSv1.DataManager.RollbackRevision(args.ObjectType, id, args.Revision); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	if err = apierSv1.afterRollback(map[string][]string{args.ObjectType: {id}},
+		tnt, args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// RollbackLoad restores all the DataDB objects changed by the load to their
+// versions before it
+func (apierSv1 *APIerSv1) RollbackLoad(ctx *context.Context, args *utils.ArgsRollbackLoad, reply *string) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.LoadID}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	restored, err := apierSv1.DataManager.RollbackLoad(args.LoadID)
+	if err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	if err = apierSv1.afterRollback(restored,
+		utils.FirstNonEmpty(args.Tenant, apierSv1.Config.GeneralCfg().DefaultTenant),
+		args.APIOpts); err != nil {
+		return utils.APIErrorHandler(err)
+	}
+	*reply = utils.OK
+	return
+}
+
+// afterRollback generates the load IDs for the restored objects and reloads
+// them in cache, clearing the filter indexes they affect
+func (apierSv1 *APIerSv1) afterRollback(restored map[string][]string, tnt string, opts map[string]any) (err error) {
+	loadID := time.Now().UnixNano()
+	loadIDs := make(map[string]int64, len(restored))
+	idxIDs := make(utils.StringSet)
+	for objType := range restored {
+		loadIDs[objType] = loadID
+		if objType == utils.CacheFilters { // the filters are part of all the indexes
+			for _, idxID := range utils.CacheInstanceToCacheIndex {
+				idxIDs.Add(idxID)
+			}
+		} else if idxID, has := utils.CacheInstanceToCacheIndex[objType]; has {
+			idxIDs.Add(idxID)
+		}
+	}
+	if err = apierSv1.DataManager.SetLoadIDs(loadIDs); err != nil {
+		return
+	}
+	return engine.CallCache(apierSv1.ConnMgr, apierSv1.Config.ApierCfg().CachesConns,
+		utils.FirstNonEmpty(utils.IfaceAsString(opts[utils.CacheOpt]), apierSv1.Config.GeneralCfg().DefaultCaching),
+		restored, idxIDs.AsSlice(), opts, false, tnt)
+}
@@ -148,3 +148,18 @@ func (rS *RouteSv1) GetRouteProfilesForEvent(ctx *context.Context, args *utils.C
 func (rS *RouteSv1) GetRoutesList(ctx *context.Context, args *utils.CGREvent, reply *[]string) error {
 	return rS.rS.V1GetRoutesList(ctx, args, reply)
 }
+
+// ProcessEvent records the outcome of a call over a route with its breaker
+func (rS *RouteSv1) ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *string) error {
+	return rS.rS.V1ProcessEvent(ctx, args, reply)
+}
+
+// GetRouteBreakers returns the state of the route breakers
+func (rS *RouteSv1) GetRouteBreakers(ctx *context.Context, args *utils.ArgsRouteBreakers, reply *[]*engine.RouteBreaker) error {
+	return rS.rS.V1GetRouteBreakers(ctx, args, reply)
+}
+
+// ResetRouteBreakers closes the route breakers
+func (rS *RouteSv1) ResetRouteBreakers(ctx *context.Context, args *utils.ArgsRouteBreakers, reply *string) error {
+	return rS.rS.V1ResetRouteBreakers(ctx, args, reply)
+}
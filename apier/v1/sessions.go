@@ -21,6 +21,7 @@ package v1
 import (
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/dispatchers"
+	"github.com/cgrates/cgrates/engine"
 	"github.com/cgrates/cgrates/sessions"
 	"github.com/cgrates/cgrates/utils"
 )
@@ -176,3 +177,13 @@ func (ssv1 *SessionSv1) BackupActiveSessions(ctx *context.Context, args string,
 func (ssv1 *SessionSv1) ThresholdNotify(ctx *context.Context, args string, rply *string) (err error) {
 	return ssv1.sS.BiRPCv1ThresholdNotify(ctx, args, rply)
 }
+
+// Subscribe pushes the session start and end events towards the BiRPC client, replying with the subscription ID
+func (ssv1 *SessionSv1) Subscribe(ctx *context.Context, args *engine.SubscribeArgs, reply *string) error {
+	return ssv1.sS.BiRPCv1Subscribe(ctx, args, reply)
+}
+
+// Unsubscribe removes the subscription with the given ID
+func (ssv1 *SessionSv1) Unsubscribe(ctx *context.Context, subID string, reply *string) error {
+	return ssv1.sS.BiRPCv1Unsubscribe(ctx, subID, reply)
+}
@@ -174,3 +174,13 @@ func (stsv1 *StatSv1) GetQueueFloatMetrics(ctx *context.Context, args *utils.Ten
 func (stsv1 *StatSv1) ResetStatQueue(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *string) error {
 	return stsv1.sS.V1ResetStatQueue(ctx, args.TenantID, reply)
 }
+
+// Subscribe pushes the metric changes towards the BiRPC client, replying with the subscription ID
+func (stsv1 *StatSv1) Subscribe(ctx *context.Context, args *engine.SubscribeArgs, reply *string) error {
+	return stsv1.sS.BiRPCv1Subscribe(ctx, args, reply)
+}
+
+// Unsubscribe removes the subscription with the given ID
+func (stsv1 *StatSv1) Unsubscribe(ctx *context.Context, subID string, reply *string) error {
+	return stsv1.sS.BiRPCv1Unsubscribe(ctx, subID, reply)
+}
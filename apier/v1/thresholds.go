@@ -77,6 +77,16 @@ func (tSv1 *ThresholdSv1) RemoveClientConnID(ctx *context.Context, args string,
 	return tSv1.tS.BiRPCv1RemoveClientConnID(ctx, args, rply)
 }
 
+// Subscribe pushes the threshold hits towards the BiRPC client, replying with the subscription ID
+func (tSv1 *ThresholdSv1) Subscribe(ctx *context.Context, args *engine.SubscribeArgs, reply *string) error {
+	return tSv1.tS.BiRPCv1Subscribe(ctx, args, reply)
+}
+
+// Unsubscribe removes the subscription with the given ID
+func (tSv1 *ThresholdSv1) Unsubscribe(ctx *context.Context, subID string, reply *string) error {
+	return tSv1.tS.BiRPCv1Unsubscribe(ctx, subID, reply)
+}
+
 // GetThresholdProfile returns a Threshold Profile
 func (apierSv1 *APIerSv1) GetThresholdProfile(ctx *context.Context, arg *utils.TenantID, reply *engine.ThresholdProfile) (err error) {
 	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
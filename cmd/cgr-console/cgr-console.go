@@ -24,10 +24,12 @@ import (
 	"io"
 	"log"
 	"os"
+	"reflect"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/console"
 	"github.com/cgrates/cgrates/utils"
@@ -51,9 +53,20 @@ var (
 	maxReconnectInterval = cgrConsoleFlags.Int(utils.MaxReconnectIntervalCfg, 0, "Maximum reconnect interval")
 	connectTimeout       = cgrConsoleFlags.Int(utils.ConnectTimeoutCfg, 1, "Connect timeout in seconds ")
 	replyTimeout         = cgrConsoleFlags.Int(utils.ReplyTimeoutCfg, 300, "Reply timeout in seconds ")
+	rbacKey              = cgrConsoleFlags.String(utils.RBACKeyCfg, utils.EmptyString, "RBAC key sent in the *rbacKey APIOpts of the commands")
+	script               = cgrConsoleFlags.String(utils.ScriptCgr, utils.EmptyString, "path to a file with the commands to execute, one per line(- for stdin)")
+	stopOnError          = cgrConsoleFlags.Bool(utils.StopOnErrorCgr, true, "Stop the script at the first failed command")
+	output               = cgrConsoleFlags.String(utils.OutputCgr, utils.EmptyString, "Output format of the replies <*json|*yaml|*csv|*table>")
+	fields               = cgrConsoleFlags.String(utils.FieldsCgr, utils.EmptyString, "Comma separated fields(dot separated paths) to keep in the replies")
+	pageSize             = cgrConsoleFlags.Int(utils.PageSizeCgr, 0, "Query the paginated APIs in pages of this size and merge the replies")
+	scriptVars           = make(scriptVariables)
 )
 
-func executeCommand(command string, client *rpcclient.RPCClient) {
+func init() {
+	cgrConsoleFlags.Var(scriptVars, utils.VarCgr, "Script variable as name=value, can be repeated")
+}
+
+func executeCommand(command string, client birpc.ClientConnector) (err error) {
 	if strings.TrimSpace(command) == utils.EmptyString {
 		return
 	}
@@ -94,7 +107,7 @@ func executeCommand(command string, client *rpcclient.RPCClient) {
 	cmd, cmdErr := console.GetCommandValue(command, *verbose)
 	if cmdErr != nil {
 		fmt.Println(cmdErr)
-		return
+		return cmdErr
 	}
 	if cmd.RpcMethod() != utils.EmptyString {
 		res := cmd.RpcResult()
@@ -109,15 +122,67 @@ func executeCommand(command string, client *rpcclient.RPCClient) {
 		case *console.StringMapWrapper:
 			param = p.Items
 		}
+		if *rbacKey != utils.EmptyString &&
+			utils.APIOptFromArgs(param, utils.OptsRBACKey) == nil {
+			utils.SetArgsAPIOpt(param, utils.OptsRBACKey, *rbacKey)
+		}
 
-		if rpcErr := client.Call(context.TODO(), cmd.RpcMethod(), param, res); rpcErr != nil {
+		if rpcErr := callCommand(client, cmd, param, res); rpcErr != nil {
 			fmt.Println("Error executing command: " + rpcErr.Error())
-		} else {
+			return rpcErr
+		}
+		if *output == utils.EmptyString {
 			fmt.Println(cmd.GetFormatedResult(res))
+			return
 		}
+		var out string
+		if out, err = console.FormatResult(res, *output, outputFields()); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(out)
 	} else {
 		fmt.Println(cmd.LocalExecute())
 	}
+	return
+}
+
+// outputFields returns the fields selected with the fields flag
+func outputFields() (flds []string) {
+	for _, fld := range strings.Split(*fields, utils.FieldsSep) {
+		if fld = strings.TrimSpace(fld); fld != utils.EmptyString {
+			flds = append(flds, fld)
+		}
+	}
+	return
+}
+
+// callCommand calls the API of the command, querying it page by page
+// when the page_size is set and the command did not ask for a Limit
+func callCommand(client birpc.ClientConnector, cmd console.Commander, param, res any) (err error) {
+	limit, offset, canPage := console.GetPagination(param)
+	if *pageSize <= 0 || !canPage || limit != 0 {
+		return client.Call(context.TODO(), cmd.RpcMethod(), param, res)
+	}
+	var prevPage any
+	for ; ; offset += *pageSize {
+		console.SetPagination(param, *pageSize, offset)
+		page := cmd.RpcResult()
+		if err = client.Call(context.TODO(), cmd.RpcMethod(), param, page); err != nil {
+			if err.Error() == utils.ErrNotFound.Error() &&
+				reflect.ValueOf(res).Elem().Len() != 0 { // the previous page was the last one
+				err = nil
+			}
+			return
+		}
+		if reflect.DeepEqual(page, prevPage) { // the Offset is ignored by the API
+			return
+		}
+		if n := console.AppendResult(res, page); n < *pageSize {
+			return
+		}
+		prevPage = page
+	}
 }
 
 func main() {
@@ -132,6 +197,9 @@ func main() {
 		}
 		return
 	}
+	if *output != utils.EmptyString && !console.OutputFormats.Has(*output) {
+		log.Fatalf("Unsupported output format: <%s>", *output)
+	}
 
 	client, err := rpcclient.NewRPCClient(context.TODO(), utils.TCP, *server, *tls, *keyPath, *certificatePath, *caPath, *connectAttempts, *reconnects,
 		time.Duration(*maxReconnectInterval)*time.Second, utils.FibDuration, time.Duration(*connectTimeout)*time.Second,
@@ -142,7 +210,24 @@ func main() {
 	}
 
 	if len(cgrConsoleFlags.Args()) != 0 {
-		executeCommand(strings.Join(cgrConsoleFlags.Args(), utils.SepCgr), client)
+		if executeCommand(strings.Join(cgrConsoleFlags.Args(), utils.SepCgr), client) != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *script != utils.EmptyString || !isTerminal(os.Stdin) {
+		in := os.Stdin
+		if *script != utils.EmptyString && *script != utils.MinusChar {
+			if in, err = os.Open(*script); err != nil {
+				log.Fatal(err)
+			}
+			defer in.Close()
+		}
+		if err = runScript(in, client, scriptVars, *stopOnError); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 		return
 	}
 
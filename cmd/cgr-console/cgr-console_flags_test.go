@@ -74,4 +74,18 @@ func TestCgrConsoleFlags(t *testing.T) {
 	} else if *replyTimeout != 200 {
 		t.Errorf("Expected 200 but received %+v", *rpcEncoding)
 	}
+
+	if err := cgrConsoleFlags.Parse([]string{"-output", "*csv", "-fields", "ID,Disabled"}); err != nil {
+		t.Fatal(err)
+	} else if *output != "*csv" || *fields != "ID,Disabled" {
+		t.Errorf("Expected *csv and ID,Disabled but received %+v and %+v", *output, *fields)
+	}
+
+	if err := cgrConsoleFlags.Parse([]string{"-var", "tenant=cgrates.org", "-stop_on_error=false"}); err != nil {
+		t.Fatal(err)
+	} else if scriptVars["tenant"] != "cgrates.org" || *stopOnError {
+		t.Errorf("Unexpected vars: %+v, stop_on_error: %+v", scriptVars, *stopOnError)
+	}
+	*output, *fields, *stopOnError = "", "", true
+	delete(scriptVars, "tenant")
 }
@@ -0,0 +1,134 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// scriptVariables are the variables expanded in the script commands as ${name}
+type scriptVariables map[string]string
+
+// String implements flag.Value
+func (sv scriptVariables) String() string {
+	vars := make([]string, 0, len(sv))
+	for name, val := range sv {
+		vars = append(vars, name+utils.AttrValueSep+val)
+	}
+	sort.Strings(vars)
+	return strings.Join(vars, utils.FieldsSep)
+}
+
+// Set implements flag.Value
+func (sv scriptVariables) Set(nameVal string) error {
+	name, val, has := strings.Cut(nameVal, utils.AttrValueSep)
+	if name = strings.TrimSpace(name); !has || name == utils.EmptyString {
+		return fmt.Errorf("invalid variable: <%s>, expecting name=value", nameVal)
+	}
+	sv[name] = val
+	return nil
+}
+
+// expand replaces the ${name} references of the defined variables in the command,
+// leaving the rest of it unchanged (ie: $ within the regex filters)
+func (sv scriptVariables) expand(command string) string {
+	var out strings.Builder
+	for {
+		start := strings.Index(command, "${")
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(command[start+2:], '}')
+		if end == -1 {
+			break
+		}
+		end += start + 2
+		val, has := sv[command[start+2:end]]
+		if !has { // continue after ${ so the references within it are expanded
+			out.WriteString(command[:start+2])
+			command = command[start+2:]
+			continue
+		}
+		out.WriteString(command[:start])
+		out.WriteString(val)
+		command = command[end+1:]
+	}
+	out.WriteString(command)
+	return out.String()
+}
+
+// runScript executes the commands read from the script, one per line.
+// Empty lines and the ones starting with # are ignored while
+// `var name=value` lines define new variables for the following commands
+func runScript(r io.Reader, client birpc.ClientConnector, vars scriptVariables, stopOnErr bool) (err error) {
+	lclVars := make(scriptVariables, len(vars)) // do not alter the ones received via flags
+	for name, val := range vars {
+		lclVars[name] = val
+	}
+	var failed int
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) // commands with big JSON params
+	for lnNr := 1; scanner.Scan(); lnNr++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == utils.EmptyString || strings.HasPrefix(line, utils.HashtagSep) {
+			continue
+		}
+		if strings.HasPrefix(line, utils.VarCgr+utils.SepCgr) {
+			if err = lclVars.Set(lclVars.expand(strings.TrimPrefix(line, utils.VarCgr+utils.SepCgr))); err != nil {
+				return fmt.Errorf("line %d: %w", lnNr, err)
+			}
+			continue
+		}
+		switch strings.ToLower(line) {
+		case utils.QuitCgr, utils.ExitCgr, utils.ByeCgr, utils.CloseCgr:
+			return scriptErr(failed)
+		}
+		if cmdErr := executeCommand(lclVars.expand(line), client); cmdErr != nil {
+			if stopOnErr {
+				return fmt.Errorf("line %d: %w", lnNr, cmdErr)
+			}
+			failed++
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	}
+	return scriptErr(failed)
+}
+
+func scriptErr(failed int) error {
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d commands failed", failed)
+}
+
+// isTerminal returns true if the file is a character device(not a pipe or a regular file)
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
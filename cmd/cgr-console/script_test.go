@@ -0,0 +1,159 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package main
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/console"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+type scriptClientMock struct {
+	calls []any
+	call  func(args, reply any) error
+}
+
+func (c *scriptClientMock) Call(_ *context.Context, _ string, args, reply any) error {
+	if accArgs, canCast := args.(*utils.AttrGetAccounts); canCast {
+		args = *accArgs // keep a copy of the page arguments
+	}
+	c.calls = append(c.calls, args)
+	return c.call(args, reply)
+}
+
+func TestScriptVariables(t *testing.T) {
+	vars := make(scriptVariables)
+	if err := vars.Set("tenant=cgrates.org"); err != nil {
+		t.Fatal(err)
+	}
+	if err := vars.Set("invalid"); err == nil {
+		t.Error("expected error for variable without value")
+	}
+	t.Setenv("acc", "1001")
+	for cmd, exp := range map[string]string{
+		`accounts Tenant="${tenant}" AccountIDs=["$acc"]`:      `accounts Tenant="cgrates.org" AccountIDs=["$acc"]`,
+		`accounts Tenant="${tenant}" AccountIDs=["${acc}"]`:    `accounts Tenant="cgrates.org" AccountIDs=["${acc}"]`,
+		`filter Values=["^10[0-9]$","$1","$*","$#","$?","${"]`: `filter Values=["^10[0-9]$","$1","$*","$#","$?","${"]`,
+		`filter Values=["${x${tenant}}"]`:                      `filter Values=["${xcgrates.org}"]`,
+	} {
+		if rcv := vars.expand(cmd); rcv != exp {
+			t.Errorf("expected %q, received %q", exp, rcv)
+		}
+	}
+	if rcv := vars.String(); rcv != "tenant=cgrates.org" {
+		t.Errorf("received %q", rcv)
+	}
+}
+
+func TestRunScript(t *testing.T) {
+	defer func(vrbs bool) { *verbose = vrbs }(*verbose)
+	*verbose = false
+	client := &scriptClientMock{
+		call: func(args, reply any) error {
+			if args.(utils.AttrGetAccounts).Tenant == "invalid" {
+				return errors.New("INVALID_TENANT")
+			}
+			*reply.(*[]*engine.Account) = []*engine.Account{{ID: "cgrates.org:1001"}}
+			return nil
+		},
+	}
+	scrpt := `# accounts of the tenant
+var tenant=cgrates.org
+accounts Tenant="${tenant}"
+
+accounts Tenant="invalid"
+accounts Tenant="${tenant}"
+`
+	if err := runScript(strings.NewReader(scrpt), client, nil, true); err == nil ||
+		err.Error() != "line 5: INVALID_TENANT" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if exp := []any{
+		utils.AttrGetAccounts{Tenant: "cgrates.org"},
+		utils.AttrGetAccounts{Tenant: "invalid"},
+	}; !reflect.DeepEqual(client.calls, exp) {
+		t.Errorf("expected %+v, received %+v", exp, client.calls)
+	}
+
+	client.calls = nil
+	if err := runScript(strings.NewReader(scrpt), client,
+		scriptVariables{"tenant": "itsyscom.com"}, false); err == nil ||
+		err.Error() != "1 commands failed" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(client.calls) != 3 {
+		t.Errorf("expected 3 calls, received %+v", client.calls)
+	}
+}
+
+func TestCallCommandPaging(t *testing.T) {
+	defer func(vrbs bool) { *pageSize, *verbose = 0, vrbs }(*verbose)
+	*pageSize, *verbose = 2, false
+	accs := []*engine.Account{{ID: "cgrates.org:1"}, {ID: "cgrates.org:2"},
+		{ID: "cgrates.org:3"}, {ID: "cgrates.org:4"}}
+	client := &scriptClientMock{
+		call: func(args, reply any) error {
+			accArgs := args.(utils.AttrGetAccounts)
+			if accArgs.Offset >= len(accs) {
+				return utils.ErrNotFound
+			}
+			*reply.(*[]*engine.Account) = accs[accArgs.Offset:min(accArgs.Offset+accArgs.Limit, len(accs))]
+			return nil
+		},
+	}
+	if err := executeCommand(`accounts Tenant="cgrates.org"`, client); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []any{
+		utils.AttrGetAccounts{Tenant: "cgrates.org", Limit: 2},
+		utils.AttrGetAccounts{Tenant: "cgrates.org", Limit: 2, Offset: 2},
+		utils.AttrGetAccounts{Tenant: "cgrates.org", Limit: 2, Offset: 4},
+	}; !reflect.DeepEqual(client.calls, exp) {
+		t.Errorf("expected %+v, received %+v", exp, client.calls)
+	}
+
+	client.calls = nil // the paging stops when the Offset is ignored
+	var accsRcv []*engine.Account
+	client.call = func(args, reply any) error {
+		*reply.(*[]*engine.Account) = accs[:args.(utils.AttrGetAccounts).Limit]
+		return nil
+	}
+	if err := callCommand(client, console.GetCommands()["accounts"],
+		&utils.AttrGetAccounts{Tenant: "cgrates.org"}, &accsRcv); err != nil {
+		t.Fatal(err)
+	}
+	if len(client.calls) != 2 || !reflect.DeepEqual(accsRcv, accs[:2]) {
+		t.Errorf("expected the first page once after 2 calls, received %s after %+v",
+			utils.ToJSON(accsRcv), client.calls)
+	}
+
+	client.calls = nil // explicit limit disables the paging
+	if err := executeCommand(`accounts Tenant="cgrates.org" Limit=3`, client); err != nil {
+		t.Fatal(err)
+	}
+	if len(client.calls) != 1 {
+		t.Errorf("expected 1 call, received %+v", client.calls)
+	}
+}
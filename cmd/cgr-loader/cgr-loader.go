@@ -111,8 +111,22 @@ var (
 		"Enable detailed verbose logging output")
 	dryRun = cgrLoaderFlags.Bool(utils.DryRunCfg, false,
 		"When true will not save loaded data to dataDb but just parse it for consistency and errors.")
+	lint = cgrLoaderFlags.Bool(utils.LintCgr, false,
+		"Checks the tariff plan without loading it, reporting the issues found. Exits with error if the tariff plan has errors.")
 	fieldSep = cgrLoaderFlags.String(utils.FieldSepCgr, ",",
 		`Separator for csv file (by default "," is used)`)
+	rateDeck = cgrLoaderFlags.String(utils.RateDeckCgr, utils.EmptyString,
+		"Imports the carrier rate deck out of this file instead of loading the tariff plan.")
+	rateDeckID = cgrLoaderFlags.String(utils.RateDeckIDCgr, utils.EmptyString,
+		"The ID of the imported rate deck, prefixing the IDs of the generated objects.")
+	rateDeckLayout = cgrLoaderFlags.String(utils.RateDeckLayoutCgr, utils.MetaDefault,
+		"The layout of the rate deck, out of the loader configuration.")
+	rateDeckCategory = cgrLoaderFlags.String(utils.RateDeckCategoryCgr, utils.EmptyString,
+		"The category of the rating profile of the rate deck, default_category if empty.")
+	rateDeckSubject = cgrLoaderFlags.String(utils.RateDeckSubjectCgr, utils.EmptyString,
+		"The subject of the rating profile of the rate deck, the deck ID if empty.")
+	rateDeckReport = cgrLoaderFlags.String(utils.RateDeckReportCgr, utils.EmptyString,
+		"Writes the change report of the rate deck to this file instead of the standard output.")
 
 	importID       = cgrLoaderFlags.String(utils.ImportIDCgr, utils.EmptyString, "Uniquely identify an import/load, postpended to some automatic fields")
 	timezone       = cgrLoaderFlags.String(utils.TimezoneCfg, dfltCfg.GeneralCfg().DefaultTimezone, `Timezone for timestamps where not specified <""|UTC|Local|$IANA_TZ_DB>`)
@@ -343,6 +357,66 @@ func getLoader(cfg *config.CGRConfig) (loader engine.LoadReader, err error) {
 	return
 }
 
+// importRateDeck applies the carrier rate deck and writes its change report
+func importRateDeck(cfg *config.CGRConfig, connMgr *engine.ConnManager) (err error) {
+	var f *os.File
+	if f, err = os.Open(*rateDeck); err != nil {
+		return
+	}
+	defer f.Close()
+	args := &utils.ArgsImportRateDeck{
+		Tenant:   *tenant,
+		ID:       *rateDeckID,
+		Layout:   *rateDeckLayout,
+		Category: *rateDeckCategory,
+		Subject:  *rateDeckSubject,
+		DryRun:   *dryRun,
+		APIOpts: map[string]any{
+			utils.OptsAPIKey:  *apiKey,
+			utils.OptsRouteID: *routeID,
+		},
+	}
+	rpt, cacheItems, err := engine.NewRateDeckImporter(cfg,
+		engine.NewDataManager(dataDB, cfg.CacheCfg(), connMgr)).Import(args, f)
+	if err != nil {
+		return
+	}
+	w := os.Stdout
+	if *rateDeckReport != utils.EmptyString {
+		if w, err = os.Create(*rateDeckReport); err != nil {
+			return
+		}
+		defer w.Close()
+	}
+	if err = rpt.WriteCSV(w, cfg.LoaderCgrCfg().FieldSeparator); err != nil {
+		return
+	}
+	if *verbose {
+		for _, rpa := range rpt.Activations {
+			log.Printf("Rating plan <%s> activated at %s", rpa.RatingPlanId, rpa.ActivationTime)
+		}
+		log.Printf("%d changes, %d unchanged codes", len(rpt.Changes), rpt.Unchanged)
+	}
+	if *dryRun || len(cfg.LoaderCgrCfg().CachesConns) == 0 {
+		return
+	}
+	return engine.CallCache(connMgr, cfg.LoaderCgrCfg().CachesConns, cfg.GeneralCfg().DefaultCaching,
+		cacheItems, nil, args.APIOpts, *verbose, *tenant)
+}
+
+// lintTP prints the issues found in the tariff plan, returning false if any of them is an error
+func lintTP(loader engine.LoadReader, tpReader *engine.TpReader) bool {
+	var locs engine.TPLocations
+	if csvStorage, canLocate := loader.(*engine.CSVStorage); canLocate {
+		locs = csvStorage.TrackLocations()
+	}
+	issues := tpReader.Lint(locs)
+	for _, issue := range issues {
+		fmt.Println(issue)
+	}
+	return !issues.HasErrors()
+}
+
 func main() {
 	var err error
 	if err = cgrLoaderFlags.Parse(os.Args[1:]); err != nil {
@@ -359,9 +433,10 @@ func main() {
 
 	ldrCfg := loadConfig()
 	// we initialize connManager here with nil for InternalChannels
-	engine.NewConnManager(ldrCfg, nil)
+	connMgr := engine.NewConnManager(ldrCfg, nil)
 
-	if !*toStorDB {
+	// the lint checks the tariff plan on its own, without connecting to DataDB
+	if (!*toStorDB && !*lint) || *rateDeck != utils.EmptyString {
 		if dataDB, err = engine.NewDataDBConn(ldrCfg.DataDbCfg().Type,
 			ldrCfg.DataDbCfg().Host, ldrCfg.DataDbCfg().Port,
 			ldrCfg.DataDbCfg().Name, ldrCfg.DataDbCfg().User,
@@ -372,7 +447,7 @@ func main() {
 		defer dataDB.Close()
 	}
 
-	if *fromStorDB || *toStorDB {
+	if *fromStorDB || (*toStorDB && !*lint) {
 		if storDB, err = engine.NewStorDBConn(ldrCfg.StorDbCfg().Type,
 			ldrCfg.StorDbCfg().Host, ldrCfg.StorDbCfg().Port,
 			ldrCfg.StorDbCfg().Name, ldrCfg.StorDbCfg().User,
@@ -384,7 +459,14 @@ func main() {
 		defer storDB.Close()
 	}
 
-	if !*dryRun && *toStorDB { // Import files from a directory into storDb
+	if *rateDeck != utils.EmptyString { // Apply a carrier rate deck instead of the tariff plan
+		if err = importRateDeck(ldrCfg, connMgr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if !*dryRun && !*lint && *toStorDB { // Import files from a directory into storDb
 		if err = importData(ldrCfg); err != nil {
 			log.Fatal(err)
 		}
@@ -401,6 +483,12 @@ func main() {
 		ldrCfg.LoaderCgrCfg().SchedulerConns); err != nil {
 		log.Fatal(err)
 	}
+	if *lint {
+		if !lintTP(loader, tpReader) {
+			log.Fatal("the tariff plan contains errors")
+		}
+		return
+	}
 	if err = tpReader.LoadAll(); err != nil {
 		log.Fatal(err)
 	}
@@ -204,12 +204,28 @@ func TestCGRLoaderFlags(t *testing.T) {
 		t.Errorf("Expected /etc/tariffplans, received %+v", *dryRun)
 	}
 
+	if err := cgrLoaderFlags.Parse([]string{"-lint", "true"}); err != nil {
+		t.Error(err)
+	} else if *lint != true {
+		t.Errorf("Expected true, received %+v", *lint)
+	}
+
 	if err := cgrLoaderFlags.Parse([]string{"-field_sep", ","}); err != nil {
 		t.Error(err)
 	} else if *fieldSep != "," {
 		t.Errorf("Expected , , received %+v", *fieldSep)
 	}
 
+	if err := cgrLoaderFlags.Parse([]string{"-rate_deck", "/tmp/carrier1.csv", "-rate_deck_id", "CARRIER1",
+		"-rate_deck_layout", "CARRIER1_LAYOUT", "-rate_deck_category", "call", "-rate_deck_subject", "wholesale",
+		"-rate_deck_report", "/tmp/carrier1_report.csv"}); err != nil {
+		t.Error(err)
+	} else if *rateDeck != "/tmp/carrier1.csv" || *rateDeckID != "CARRIER1" || *rateDeckLayout != "CARRIER1_LAYOUT" ||
+		*rateDeckCategory != "call" || *rateDeckSubject != "wholesale" || *rateDeckReport != "/tmp/carrier1_report.csv" {
+		t.Errorf("received %q %q %q %q %q %q", *rateDeck, *rateDeckID, *rateDeckLayout,
+			*rateDeckCategory, *rateDeckSubject, *rateDeckReport)
+	}
+
 	if err := cgrLoaderFlags.Parse([]string{"-import_id", "unique_id"}); err != nil {
 		t.Error(err)
 	} else if *importID != "unique_id" {
@@ -99,6 +99,7 @@ var (
 	usage       = cgrTesterFlags.String("usage", "1m", "The duration to use in call simulation.")
 	fPath       = cgrTesterFlags.String("file_path", "", "read requests from file with path")
 	reqSep      = cgrTesterFlags.String("req_separator", "\n\n", "separator for requests in file")
+	scnPath     = cgrTesterFlags.String(utils.ScenarioCgr, "", "run the protocol load test described by the scenario file with path")
 	verbose     = cgrTesterFlags.Bool(utils.VerboseCgr, false, "Enable detailed verbose logging output")
 	err         error
 )
@@ -329,6 +330,16 @@ func main() {
 		}
 		return
 	}
+	if *scnPath != "" {
+		sc, err := loadScenario(*scnPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := runScenario(sc); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
 	switch *exec {
 	default: // unsupported task
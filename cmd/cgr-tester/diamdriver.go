@@ -0,0 +1,226 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/agents"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/go-diameter/diam"
+	"github.com/cgrates/go-diameter/diam/avp"
+	"github.com/cgrates/go-diameter/diam/datatype"
+)
+
+// CC-Request-Type values as defined by RFC 4006
+const (
+	ccrInitial     = 1
+	ccrUpdate      = 2
+	ccrTermination = 3
+)
+
+var ccrStepNames = map[int]string{
+	ccrInitial:     "CCR-I",
+	ccrUpdate:      "CCR-U",
+	ccrTermination: "CCR-T",
+}
+
+// diamDriver sends the calls as Diameter credit control sessions
+type diamDriver struct {
+	cfg     *diamScenarioConfig
+	clnt    *agents.DiameterClient
+	timeout time.Duration
+	stop    chan struct{}
+
+	mu      sync.Mutex
+	pending map[uint32]chan *diam.Message // replies expected, indexed on Hop-by-Hop Identifier
+}
+
+func newDiamDriver(cfg *diamScenarioConfig, timeout time.Duration) (dd *diamDriver, err error) {
+	if cfg.Network == utils.EmptyString {
+		cfg.Network = utils.TCP
+	}
+	if cfg.OriginHost == utils.EmptyString {
+		cfg.OriginHost = utils.CGRTester
+	}
+	if cfg.OriginRealm == utils.EmptyString {
+		cfg.OriginRealm = "cgrates.org"
+	}
+	if cfg.DestinationRealm == utils.EmptyString {
+		cfg.DestinationRealm = cfg.OriginRealm
+	}
+	if cfg.ProductName == utils.EmptyString {
+		cfg.ProductName = utils.CGRateS
+	}
+	dd = &diamDriver{
+		cfg:     cfg,
+		timeout: timeout,
+		stop:    make(chan struct{}),
+		pending: make(map[uint32]chan *diam.Message),
+	}
+	if dd.clnt, err = agents.NewDiameterClient(cfg.Address, cfg.OriginHost, cfg.OriginRealm,
+		cfg.VendorID, cfg.ProductName, utils.DiameterFirmwareRevision,
+		cfg.DictionariesPath, cfg.Network); err != nil {
+		return nil, err
+	}
+	go dd.dispatchReplies()
+	return
+}
+
+// dispatchReplies hands the received answers to the requests waiting for them
+func (dd *diamDriver) dispatchReplies() {
+	for {
+		select {
+		case <-dd.stop:
+			return
+		default:
+		}
+		m := dd.clnt.ReceivedMessage(100 * time.Millisecond)
+		if m == nil {
+			continue
+		}
+		dd.mu.Lock()
+		rplyChan, has := dd.pending[m.Header.HopByHopID]
+		delete(dd.pending, m.Header.HopByHopID)
+		dd.mu.Unlock()
+		if has {
+			rplyChan <- m
+		}
+	}
+}
+
+func (dd *diamDriver) close() {
+	close(dd.stop)
+	dd.clnt.Close()
+}
+
+// newCCR builds the Credit-Control-Request of the call
+func (dd *diamDriver) newCCR(cl *callLeg, reqType, reqNr int, requested, used time.Duration) *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, 4, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(cl.originID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(dd.cfg.OriginHost))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(dd.cfg.OriginRealm))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(dd.cfg.DestinationRealm))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(4))
+	m.NewAVP(avp.ServiceContextID, avp.Mbit, 0, datatype.UTF8String(cl.call.ServiceContextID))
+	m.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(reqType))
+	m.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned32(reqNr))
+	m.NewAVP(avp.EventTimestamp, avp.Mbit, 0, datatype.Time(time.Now()))
+	m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(0)), // END_USER_E164
+			diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(cl.account)),
+		}})
+	if reqType != ccrTermination {
+		m.NewAVP(avp.RequestedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.CCTime, avp.Mbit, 0, datatype.Unsigned32(requested.Seconds()))}})
+	}
+	if reqType != ccrInitial {
+		m.NewAVP(avp.UsedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.CCTime, avp.Mbit, 0, datatype.Unsigned32(used.Seconds()))}})
+	}
+	m.NewAVP(873, avp.Mbit, 10415, &diam.GroupedAVP{ // Service-Information
+		AVP: []*diam.AVP{
+			diam.NewAVP(20300, avp.Mbit, 2011, &diam.GroupedAVP{ // IN-Information
+				AVP: []*diam.AVP{
+					diam.NewAVP(831, avp.Mbit, 10415, datatype.UTF8String(cl.account)),      // Calling-Party-Address
+					diam.NewAVP(832, avp.Mbit, 10415, datatype.UTF8String(cl.destination)),  // Called-Party-Address
+					diam.NewAVP(20327, avp.Mbit, 2011, datatype.UTF8String(cl.destination)), // Real-Called-Number
+				},
+			}),
+		}})
+	return m
+}
+
+// sendCCR sends the request of the call, recording its result
+func (dd *diamDriver) sendCCR(cl *callLeg, rep *scenarioReport, reqType, reqNr int,
+	requested, used time.Duration) (err error) {
+	var latency time.Duration
+	latency, err = dd.request(dd.newCCR(cl, reqType, reqNr, requested, used))
+	rep.record(cl.call.ID, ccrStepNames[reqType], latency, err)
+	return
+}
+
+// request sends the message and waits for its answer, checking the Result-Code
+func (dd *diamDriver) request(m *diam.Message) (latency time.Duration, err error) {
+	rplyChan := make(chan *diam.Message, 1)
+	dd.mu.Lock()
+	dd.pending[m.Header.HopByHopID] = rplyChan
+	dd.mu.Unlock()
+	defer func() {
+		dd.mu.Lock()
+		delete(dd.pending, m.Header.HopByHopID)
+		dd.mu.Unlock()
+	}()
+	start := time.Now()
+	if err = dd.clnt.SendMessage(m); err != nil {
+		return
+	}
+	select {
+	case rply := <-rplyChan:
+		return time.Since(start), diamResultCodeErr(rply)
+	case <-time.After(dd.timeout):
+		return 0, utils.ErrReplyTimeout
+	}
+}
+
+// diamResultCodeErr returns an error for answers without DIAMETER_SUCCESS as Result-Code
+func diamResultCodeErr(m *diam.Message) error {
+	rc, err := m.FindAVP(avp.ResultCode, 0)
+	if err != nil {
+		return fmt.Errorf("missing Result-Code")
+	}
+	if code, canCast := rc.Data.(datatype.Unsigned32); !canCast {
+		return fmt.Errorf("invalid Result-Code <%v>", rc.Data)
+	} else if code != diam.Success {
+		return fmt.Errorf("Result-Code %d", code)
+	}
+	return nil
+}
+
+// runCall sends the CCR-I, the CCR-Us for each update_interval and the CCR-T
+func (dd *diamDriver) runCall(cl *callLeg, rep *scenarioReport) {
+	if cl.failed {
+		if dd.sendCCR(cl, rep, ccrInitial, 0, cl.usage, 0) == nil {
+			dd.sendCCR(cl, rep, ccrTermination, 1, 0, 0)
+		}
+		return
+	}
+	updates, rest := splitUsage(cl.usage, cl.call.updateInterval)
+	requested := cl.usage
+	if updates != 0 {
+		requested = cl.call.updateInterval
+	}
+	if dd.sendCCR(cl, rep, ccrInitial, 0, requested, 0) != nil {
+		return
+	}
+	reqNr := 1
+	for ; reqNr <= updates; reqNr++ {
+		time.Sleep(cl.call.updateInterval)
+		if dd.sendCCR(cl, rep, ccrUpdate, reqNr, cl.call.updateInterval,
+			cl.call.updateInterval) != nil {
+			return
+		}
+	}
+	time.Sleep(rest)
+	dd.sendCCR(cl, rep, ccrTermination, reqNr, 0, rest)
+}
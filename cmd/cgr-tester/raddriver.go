@@ -0,0 +1,146 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package main
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/radigo"
+)
+
+// Acct-Status-Type values as defined by RFC 2866
+const (
+	acctStart   = "1"
+	acctStop    = "2"
+	acctInterim = "3"
+)
+
+var acctStepNames = map[string]string{
+	acctStart:   "Accounting-Start",
+	acctInterim: "Accounting-Interim",
+	acctStop:    "Accounting-Stop",
+}
+
+// radDriver sends the calls as RADIUS authorization followed by accounting
+type radDriver struct {
+	auth  *radigo.Client
+	acct  *radigo.Client
+	reqID uint32 // RADIUS Identifier, wrapping at 256
+}
+
+func newRadDriver(cfg *radScenarioConfig) (rd *radDriver, err error) {
+	if cfg.Network == utils.EmptyString {
+		cfg.Network = utils.UDP
+	}
+	dict := radigo.RFC2865Dictionary()
+	if cfg.DictionariesPath != utils.EmptyString {
+		if dict, err = radigo.NewDictionaryFromFoldersWithRFC2865(
+			[]string{cfg.DictionariesPath}); err != nil {
+			return
+		}
+	}
+	rd = new(radDriver)
+	if rd.auth, err = radigo.NewClient(cfg.Network, cfg.AuthAddress, cfg.Secret,
+		dict, 1, nil, nil); err != nil {
+		return nil, err
+	}
+	if rd.acct, err = radigo.NewClient(cfg.Network, cfg.AcctAddress, cfg.Secret,
+		dict, 1, nil, nil); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// close is a no-op since radigo clients are not closable
+func (rd *radDriver) close() {}
+
+func (rd *radDriver) newRequest(clnt *radigo.Client, code radigo.PacketCode,
+	cl *callLeg, avps [][2]string) (req *radigo.Packet, err error) {
+	req = clnt.NewRequest(code, uint8(atomic.AddUint32(&rd.reqID, 1)))
+	for _, a := range append([][2]string{
+		{"User-Name", cl.account},
+		{"Calling-Station-Id", cl.account},
+		{"Called-Station-Id", cl.destination},
+		{"Acct-Session-Id", cl.originID},
+		{"Event-Timestamp", strconv.FormatInt(time.Now().Unix(), 10)},
+	}, avps...) {
+		if err = req.AddAVPWithName(a[0], a[1], utils.EmptyString); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// send dispatches the request, recording the result of the step
+func (rd *radDriver) send(clnt *radigo.Client, req *radigo.Packet, expCode radigo.PacketCode,
+	cl *callLeg, rep *scenarioReport, step string) (err error) {
+	var latency time.Duration
+	start := time.Now()
+	var rply *radigo.Packet
+	if rply, err = clnt.SendRequest(req); err == nil {
+		latency = time.Since(start)
+		if rply.Code != expCode {
+			err = errors.New(rply.Code.String())
+		}
+	}
+	rep.record(cl.call.ID, step, latency, err)
+	return
+}
+
+// account sends one Accounting-Request with the status type and session time
+func (rd *radDriver) account(cl *callLeg, rep *scenarioReport, status string,
+	sessionTime time.Duration) error {
+	req, err := rd.newRequest(rd.acct, radigo.AccountingRequest, cl, [][2]string{
+		{"Acct-Status-Type", status},
+		{"Acct-Session-Time", strconv.FormatInt(int64(sessionTime.Seconds()), 10)},
+	})
+	if err != nil {
+		rep.record(cl.call.ID, acctStepNames[status], 0, err)
+		return err
+	}
+	return rd.send(rd.acct, req, radigo.AccountingResponse, cl, rep, acctStepNames[status])
+}
+
+// runCall sends the Access-Request, then the accounting Start, Interim-Updates and Stop
+func (rd *radDriver) runCall(cl *callLeg, rep *scenarioReport) {
+	const authStep = "Access-Request"
+	req, err := rd.newRequest(rd.auth, radigo.AccessRequest, cl, nil)
+	if err != nil {
+		rep.record(cl.call.ID, authStep, 0, err)
+		return
+	}
+	if rd.send(rd.auth, req, radigo.AccessAccept, cl, rep, authStep) != nil || cl.failed {
+		return
+	}
+	if rd.account(cl, rep, acctStart, 0) != nil {
+		return
+	}
+	updates, rest := splitUsage(cl.usage, cl.call.updateInterval)
+	for i := 1; i <= updates; i++ {
+		time.Sleep(cl.call.updateInterval)
+		if rd.account(cl, rep, acctInterim, time.Duration(i)*cl.call.updateInterval) != nil {
+			return
+		}
+	}
+	time.Sleep(rest)
+	rd.account(cl, rep, acctStop, cl.usage)
+}
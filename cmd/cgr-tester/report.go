@@ -0,0 +1,142 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// stepStats gathers the results of one step of a call (ie: CCR-I)
+type stepStats struct {
+	sent      int
+	latencies []time.Duration // one per reply received
+	errors    map[string]int  // error reasons with their count
+}
+
+// scenarioReport collects the step results of all the calls
+type scenarioReport struct {
+	mu          sync.Mutex
+	calls       int
+	failedCalls int
+	steps       map[string]*stepStats
+	order       []string // steps in the order they were first seen
+}
+
+func newScenarioReport() *scenarioReport {
+	return &scenarioReport{steps: make(map[string]*stepStats)}
+}
+
+func (rep *scenarioReport) callStarted(failed bool) {
+	rep.mu.Lock()
+	rep.calls++
+	if failed {
+		rep.failedCalls++
+	}
+	rep.mu.Unlock()
+}
+
+// record adds the result of a step, latency being zero when no reply was received
+func (rep *scenarioReport) record(callID, step string, latency time.Duration, err error) {
+	key := callID + " " + step
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	st, has := rep.steps[key]
+	if !has {
+		st = &stepStats{errors: make(map[string]int)}
+		rep.steps[key] = st
+		rep.order = append(rep.order, key)
+	}
+	st.sent++
+	if latency > 0 {
+		st.latencies = append(st.latencies, latency)
+	}
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) { // drop the addresses so the same errors are grouped
+			err = opErr.Err
+		}
+		st.errors[err.Error()]++
+	}
+	if *verbose {
+		log.Printf("%s: latency <%v>, error <%v>", key, latency, err)
+	}
+}
+
+// percentile returns the nearest-rank percentile out of the sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted))*p/100+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	} else if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// print writes the latency percentiles and the errors per step
+func (rep *scenarioReport) print(w io.Writer, completed int, elapsed time.Duration) {
+	rep.mu.Lock()
+	defer rep.mu.Unlock()
+	fmt.Fprintf(w, "Calls started: %d (%d failed attempts), completed: %d, elapsed: %s, average cps: %.2f\n\n",
+		rep.calls, rep.failedCalls, completed, elapsed.Round(time.Millisecond),
+		float64(rep.calls)/elapsed.Seconds())
+	fmt.Fprintf(w, "| %-32s | %-8s | %-8s | %-8s | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s |\n",
+		"Step", "Sent", "Replies", "Errors", "Min", "P50", "P90", "P95", "P99", "Max")
+	var withErrors []string
+	for _, key := range rep.order {
+		st := rep.steps[key]
+		sorted := make([]time.Duration, len(st.latencies))
+		copy(sorted, st.latencies)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		var nrErrs int
+		for _, cnt := range st.errors {
+			nrErrs += cnt
+		}
+		if nrErrs != 0 {
+			withErrors = append(withErrors, key)
+		}
+		fmt.Fprintf(w, "| %-32s | %-8d | %-8d | %-8d | %-12s | %-12s | %-12s | %-12s | %-12s | %-12s |\n",
+			key, st.sent, len(sorted), nrErrs,
+			percentile(sorted, 0), percentile(sorted, 50), percentile(sorted, 90),
+			percentile(sorted, 95), percentile(sorted, 99), percentile(sorted, 100))
+	}
+	if len(withErrors) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nErrors:")
+	for _, key := range withErrors {
+		reasons := make([]string, 0, len(rep.steps[key].errors))
+		for reason := range rep.steps[key].errors {
+			reasons = append(reasons, reason)
+		}
+		sort.Strings(reasons)
+		for _, reason := range reasons {
+			fmt.Fprintf(w, "  %s: %s (%d)\n", key, reason, rep.steps[key].errors[reason])
+		}
+	}
+}
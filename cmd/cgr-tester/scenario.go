@@ -0,0 +1,369 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// scenario describes a protocol load test, decoded out of a JSON file
+type scenario struct {
+	ReplyTimeout string              `json:"reply_timeout"`
+	Ramp         []*rampStage        `json:"ramp"`
+	Calls        []*scenarioCall     `json:"calls"`
+	Diameter     *diamScenarioConfig `json:"diameter"`
+	Radius       *radScenarioConfig  `json:"radius"`
+	SIP          *sipScenarioConfig  `json:"sip"`
+
+	replyTimeout time.Duration
+	maxUsage     time.Duration // longest call, used to wait for the calls in progress
+	totalWeight  float64
+}
+
+// rampStage is a period of the test with the call rate changing
+// linearly from CPS to ToCPS
+type rampStage struct {
+	Duration string   `json:"duration"`
+	CPS      float64  `json:"cps"`
+	ToCPS    *float64 `json:"to_cps"`
+
+	duration time.Duration
+}
+
+// scenarioCall is one entry of the call mix
+type scenarioCall struct {
+	ID               string   `json:"id"`
+	Protocol         string   `json:"protocol"`
+	Weight           float64  `json:"weight"`
+	Accounts         []string `json:"accounts"`
+	Destinations     []string `json:"destinations"`
+	MinUsage         string   `json:"min_usage"`
+	MaxUsage         string   `json:"max_usage"`
+	UpdateInterval   string   `json:"update_interval"`
+	FailureRatio     float64  `json:"failure_ratio"`      // share of the calls ending right after the initial request
+	ServiceContextID string   `json:"service_context_id"` // used by the *diameter calls
+
+	minUsage       time.Duration
+	maxUsage       time.Duration
+	updateInterval time.Duration
+}
+
+type diamScenarioConfig struct {
+	Address          string `json:"address"`
+	Network          string `json:"network"`
+	OriginHost       string `json:"origin_host"`
+	OriginRealm      string `json:"origin_realm"`
+	DestinationRealm string `json:"destination_realm"`
+	VendorID         int    `json:"vendor_id"`
+	ProductName      string `json:"product_name"`
+	DictionariesPath string `json:"dictionaries_path"`
+}
+
+type radScenarioConfig struct {
+	AuthAddress      string `json:"auth_address"`
+	AcctAddress      string `json:"acct_address"`
+	Network          string `json:"network"`
+	Secret           string `json:"secret"`
+	DictionariesPath string `json:"dictionaries_path"`
+}
+
+type sipScenarioConfig struct {
+	Address string `json:"address"`
+}
+
+// callLeg is one call generated out of the scenario
+type callLeg struct {
+	call        *scenarioCall
+	originID    string
+	account     string
+	destination string
+	usage       time.Duration
+	failed      bool // the call ends after the initial request, without usage
+}
+
+// callDriver sends the call over the network as one of the agents would receive it
+type callDriver interface {
+	runCall(cl *callLeg, rep *scenarioReport)
+	close()
+}
+
+// loadScenario reads the scenario out of the file at path and validates it
+func loadScenario(path string) (sc *scenario, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rjr, err := config.NewRjReader(f)
+	if err != nil {
+		return nil, err
+	}
+	sc = new(scenario)
+	if err = rjr.Decode(sc); err != nil {
+		return nil, fmt.Errorf("cannot decode scenario <%s>: %v", path, err)
+	}
+	if err = sc.validate(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// validate checks the scenario for consistency and parses the durations
+func (sc *scenario) validate() (err error) {
+	sc.replyTimeout = 2 * time.Second
+	if sc.ReplyTimeout != utils.EmptyString {
+		if sc.replyTimeout, err = utils.ParseDurationWithNanosecs(sc.ReplyTimeout); err != nil {
+			return fmt.Errorf("invalid reply_timeout: %v", err)
+		}
+	}
+	if len(sc.Ramp) == 0 {
+		return fmt.Errorf("no ramp stages defined")
+	}
+	for i, stg := range sc.Ramp {
+		if stg.duration, err = utils.ParseDurationWithNanosecs(stg.Duration); err != nil {
+			return fmt.Errorf("invalid duration for ramp stage %d: %v", i, err)
+		}
+		if stg.duration <= 0 {
+			return fmt.Errorf("ramp stage %d should have a positive duration", i)
+		}
+		if stg.CPS < 0 || (stg.ToCPS != nil && *stg.ToCPS < 0) {
+			return fmt.Errorf("ramp stage %d has negative cps", i)
+		}
+	}
+	if len(sc.Calls) == 0 {
+		return fmt.Errorf("no calls defined")
+	}
+	sc.totalWeight, sc.maxUsage = 0, 0
+	for _, c := range sc.Calls {
+		if err = c.validate(sc); err != nil {
+			return fmt.Errorf("call <%s>: %v", c.ID, err)
+		}
+		sc.totalWeight += c.Weight
+		if c.maxUsage > sc.maxUsage {
+			sc.maxUsage = c.maxUsage
+		}
+	}
+	if sc.totalWeight == 0 {
+		return fmt.Errorf("the calls should have a positive total weight")
+	}
+	return
+}
+
+func (c *scenarioCall) validate(sc *scenario) (err error) {
+	switch c.Protocol {
+	case utils.MetaDiameter:
+		if sc.Diameter == nil || sc.Diameter.Address == utils.EmptyString {
+			return fmt.Errorf("missing diameter address")
+		}
+		if c.ServiceContextID == utils.EmptyString {
+			c.ServiceContextID = "voice@cgrates.org"
+		}
+	case utils.MetaRadius:
+		if sc.Radius == nil || sc.Radius.AuthAddress == utils.EmptyString ||
+			sc.Radius.AcctAddress == utils.EmptyString {
+			return fmt.Errorf("missing radius auth_address or acct_address")
+		}
+	case utils.MetaSIP:
+		if sc.SIP == nil || sc.SIP.Address == utils.EmptyString {
+			return fmt.Errorf("missing sip address")
+		}
+	default:
+		return fmt.Errorf("unsupported protocol <%s>", c.Protocol)
+	}
+	if c.Weight < 0 {
+		return fmt.Errorf("negative weight")
+	}
+	if c.FailureRatio < 0 || c.FailureRatio > 1 {
+		return fmt.Errorf("failure_ratio should be between 0 and 1")
+	}
+	if len(c.Accounts) == 0 {
+		c.Accounts = []string{*subject}
+	}
+	if len(c.Destinations) == 0 {
+		c.Destinations = []string{*destination}
+	}
+	for _, dur := range []struct {
+		val string
+		out *time.Duration
+	}{
+		{c.MinUsage, &c.minUsage},
+		{c.MaxUsage, &c.maxUsage},
+		{c.UpdateInterval, &c.updateInterval},
+	} {
+		if *dur.out, err = utils.ParseDurationWithNanosecs(dur.val); err != nil {
+			return
+		}
+	}
+	if c.maxUsage == 0 {
+		c.maxUsage = c.minUsage
+	}
+	if c.minUsage > c.maxUsage {
+		return fmt.Errorf("min_usage should be equal or smaller than max_usage")
+	}
+	return
+}
+
+// cpsAt returns the call rate at the elapsed time since the start of the test,
+// false when the ramp is over
+func (sc *scenario) cpsAt(elapsed time.Duration) (float64, bool) {
+	for _, stg := range sc.Ramp {
+		if elapsed < stg.duration {
+			if stg.ToCPS == nil {
+				return stg.CPS, true
+			}
+			return stg.CPS + (*stg.ToCPS-stg.CPS)*float64(elapsed)/float64(stg.duration), true
+		}
+		elapsed -= stg.duration
+	}
+	return 0, false
+}
+
+// pickCall selects the call out of the mix based on a number in the [0,1) interval
+func (sc *scenario) pickCall(r float64) *scenarioCall {
+	r *= sc.totalWeight
+	for _, c := range sc.Calls {
+		if r < c.Weight {
+			return c
+		}
+		r -= c.Weight
+	}
+	return sc.Calls[len(sc.Calls)-1]
+}
+
+func (sc *scenario) newCallLeg() *callLeg {
+	c := sc.pickCall(rand.Float64())
+	cl := &callLeg{
+		call:        c,
+		originID:    utils.GenUUID(),
+		account:     c.Accounts[rand.Intn(len(c.Accounts))],
+		destination: c.Destinations[rand.Intn(len(c.Destinations))],
+		usage:       c.minUsage,
+		failed:      rand.Float64() < c.FailureRatio,
+	}
+	if c.maxUsage > c.minUsage {
+		cl.usage = time.Duration(utils.RandomInteger(int64(c.minUsage), int64(c.maxUsage)))
+	}
+	return cl
+}
+
+// splitUsage returns the number of updates sent for the usage and the
+// usage remaining to be reported on termination
+func splitUsage(usage, interval time.Duration) (updates int, rest time.Duration) {
+	if interval <= 0 || usage <= interval {
+		return 0, usage
+	}
+	updates = int((usage - 1) / interval)
+	return updates, usage - time.Duration(updates)*interval
+}
+
+// newDrivers connects the drivers for the protocols used by the calls
+func (sc *scenario) newDrivers() (drvs map[string]callDriver, err error) {
+	drvs = make(map[string]callDriver)
+	defer func() {
+		if err != nil {
+			for _, drv := range drvs {
+				drv.close()
+			}
+		}
+	}()
+	for _, c := range sc.Calls {
+		if _, has := drvs[c.Protocol]; has {
+			continue
+		}
+		var drv callDriver
+		switch c.Protocol {
+		case utils.MetaDiameter:
+			drv, err = newDiamDriver(sc.Diameter, sc.replyTimeout)
+		case utils.MetaRadius:
+			drv, err = newRadDriver(sc.Radius)
+		case utils.MetaSIP:
+			drv = newSIPDriver(sc.SIP, sc.replyTimeout)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot connect the %s driver: %v", c.Protocol, err)
+		}
+		drvs[c.Protocol] = drv
+	}
+	return
+}
+
+// runScenario generates the calls following the ramp and prints the report
+// once all of them are finished or the timeout is reached
+func runScenario(sc *scenario) (err error) {
+	if !*verbose {
+		utils.Logger.SetLogLevel(utils.LOGLEVEL_ERROR)
+	}
+	drvs, err := sc.newDrivers()
+	if err != nil {
+		return
+	}
+	defer func() {
+		for _, drv := range drvs {
+			drv.close()
+		}
+	}()
+	rep := newScenarioReport()
+	var (
+		wg        sync.WaitGroup
+		completed int64
+		pending   float64 // calls due but not yet started
+	)
+	start := time.Now()
+	last := start
+	tick := time.NewTicker(10 * time.Millisecond)
+	for now := range tick.C {
+		cps, running := sc.cpsAt(now.Sub(start))
+		if !running {
+			break
+		}
+		pending += cps * now.Sub(last).Seconds()
+		last = now
+		for ; pending >= 1; pending-- {
+			cl := sc.newCallLeg()
+			rep.callStarted(cl.failed)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				drvs[cl.call.Protocol].runCall(cl, rep)
+				atomic.AddInt64(&completed, 1)
+			}()
+		}
+	}
+	tick.Stop()
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(sc.maxUsage + *timeoutDur):
+		log.Printf("Timed out waiting for the calls in progress")
+	}
+	rep.print(os.Stdout, int(atomic.LoadInt64(&completed)), time.Since(start))
+	return
+}
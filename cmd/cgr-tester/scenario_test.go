@@ -0,0 +1,385 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package main
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/sipingo"
+)
+
+func TestLoadScenarioSample(t *testing.T) {
+	sc, err := loadScenario("../../data/tester/scenario.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sc.Ramp) != 3 || sc.Ramp[0].duration != 30*time.Second ||
+		sc.Ramp[1].duration != 2*time.Minute {
+		t.Errorf("unexpected ramp: %s", utils.ToJSON(sc.Ramp))
+	}
+	if len(sc.Calls) != 3 {
+		t.Fatalf("expected 3 calls, received %d", len(sc.Calls))
+	}
+	if sc.totalWeight != 100 {
+		t.Errorf("expected total weight 100, received %v", sc.totalWeight)
+	}
+	if sc.maxUsage != 3*time.Minute {
+		t.Errorf("expected max usage 3m, received %v", sc.maxUsage)
+	}
+	if sc.replyTimeout != 2*time.Second {
+		t.Errorf("expected reply timeout 2s, received %v", sc.replyTimeout)
+	}
+	if c := sc.Calls[0]; c.updateInterval != 30*time.Second || c.FailureRatio != 0.2 {
+		t.Errorf("unexpected call: %s", utils.ToJSON(c))
+	}
+}
+
+func TestScenarioValidate(t *testing.T) {
+	newScenario := func() *scenario {
+		return &scenario{
+			Ramp: []*rampStage{{Duration: "10s", CPS: 5}},
+			Calls: []*scenarioCall{{
+				ID:       "sip",
+				Protocol: utils.MetaSIP,
+				Weight:   10,
+				MinUsage: "1s",
+			}},
+			SIP: &sipScenarioConfig{Address: "127.0.0.1:5060"},
+		}
+	}
+	sc := newScenario()
+	if err := sc.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if c := sc.Calls[0]; c.maxUsage != time.Second ||
+		len(c.Accounts) != 1 || len(c.Destinations) != 1 {
+		t.Errorf("unexpected defaults: %s", utils.ToJSON(c))
+	}
+	for _, tc := range []struct {
+		name   string
+		modify func(*scenario)
+		errMsg string
+	}{
+		{"no ramp", func(sc *scenario) { sc.Ramp = nil }, "no ramp stages defined"},
+		{"ramp duration", func(sc *scenario) { sc.Ramp[0].Duration = "0" },
+			"ramp stage 0 should have a positive duration"},
+		{"no calls", func(sc *scenario) { sc.Calls = nil }, "no calls defined"},
+		{"protocol", func(sc *scenario) { sc.Calls[0].Protocol = "*mgcp" },
+			"call <sip>: unsupported protocol <*mgcp>"},
+		{"missing connection", func(sc *scenario) { sc.SIP = nil },
+			"call <sip>: missing sip address"},
+		{"failure ratio", func(sc *scenario) { sc.Calls[0].FailureRatio = 1.5 },
+			"call <sip>: failure_ratio should be between 0 and 1"},
+		{"usage", func(sc *scenario) { sc.Calls[0].MaxUsage = "500ms" },
+			"call <sip>: min_usage should be equal or smaller than max_usage"},
+		{"weight", func(sc *scenario) { sc.Calls[0].Weight = 0 },
+			"the calls should have a positive total weight"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sc := newScenario()
+			tc.modify(sc)
+			if err := sc.validate(); err == nil || err.Error() != tc.errMsg {
+				t.Errorf("expected error <%s>, received <%v>", tc.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestScenarioCPSAt(t *testing.T) {
+	to := 30.0
+	sc := &scenario{Ramp: []*rampStage{
+		{duration: 10 * time.Second, CPS: 10, ToCPS: &to},
+		{duration: 5 * time.Second, CPS: 5},
+	}}
+	for _, tc := range []struct {
+		elapsed time.Duration
+		cps     float64
+		running bool
+	}{
+		{0, 10, true},
+		{5 * time.Second, 20, true},
+		{12 * time.Second, 5, true},
+		{15 * time.Second, 0, false},
+	} {
+		if cps, running := sc.cpsAt(tc.elapsed); cps != tc.cps || running != tc.running {
+			t.Errorf("at %v expected <%v, %v>, received <%v, %v>",
+				tc.elapsed, tc.cps, tc.running, cps, running)
+		}
+	}
+}
+
+func TestScenarioPickCall(t *testing.T) {
+	sc := &scenario{
+		Calls: []*scenarioCall{
+			{ID: "first", Weight: 75},
+			{ID: "none", Weight: 0},
+			{ID: "second", Weight: 25},
+		},
+		totalWeight: 100,
+	}
+	for r, expID := range map[float64]string{
+		0:     "first",
+		0.749: "first",
+		0.75:  "second",
+		0.999: "second",
+	} {
+		if rcv := sc.pickCall(r).ID; rcv != expID {
+			t.Errorf("for %v expected <%s>, received <%s>", r, expID, rcv)
+		}
+	}
+}
+
+func TestSplitUsage(t *testing.T) {
+	for _, tc := range []struct {
+		usage, interval time.Duration
+		updates         int
+		rest            time.Duration
+	}{
+		{90 * time.Second, 30 * time.Second, 2, 30 * time.Second},
+		{100 * time.Second, 30 * time.Second, 3, 10 * time.Second},
+		{20 * time.Second, 30 * time.Second, 0, 20 * time.Second},
+		{20 * time.Second, 0, 0, 20 * time.Second},
+	} {
+		if updates, rest := splitUsage(tc.usage, tc.interval); updates != tc.updates || rest != tc.rest {
+			t.Errorf("for <%v, %v> expected <%d, %v>, received <%d, %v>",
+				tc.usage, tc.interval, tc.updates, tc.rest, updates, rest)
+		}
+	}
+}
+
+func TestScenarioReport(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+	for p, exp := range map[float64]time.Duration{
+		0:   time.Millisecond,
+		50:  50 * time.Millisecond,
+		99:  99 * time.Millisecond,
+		100: 100 * time.Millisecond,
+	} {
+		if rcv := percentile(sorted, p); rcv != exp {
+			t.Errorf("p%v: expected %v, received %v", p, exp, rcv)
+		}
+	}
+	rep := newScenarioReport()
+	rep.callStarted(false)
+	rep.callStarted(true)
+	rep.record("diam", "CCR-I", 2*time.Millisecond, nil)
+	rep.record("diam", "CCR-I", 4*time.Millisecond, errors.New("Result-Code 4012"))
+	rep.record("diam", "CCR-T", 0, utils.ErrReplyTimeout)
+	var buf bytes.Buffer
+	rep.print(&buf, 2, time.Second)
+	out := buf.String()
+	for _, exp := range []string{
+		"Calls started: 2 (1 failed attempts), completed: 2",
+		"| diam CCR-I                       | 2        | 2        | 1        | 2ms          | 2ms          | 4ms",
+		"| diam CCR-T                       | 1        | 0        | 1        | 0s",
+		"  diam CCR-I: Result-Code 4012 (1)",
+		"  diam CCR-T: REPLY_TIMEOUT (1)",
+	} {
+		if !strings.Contains(out, exp) {
+			t.Errorf("expected %q in report:\n%s", exp, out)
+		}
+	}
+}
+
+// sipResponder answers the INVITEs with the codes and the BYEs with 200 OK,
+// publishing the methods received
+func sipResponder(t *testing.T, codes []string) (addr string, methods chan string) {
+	t.Helper()
+	conn, err := net.ListenPacket(utils.UDP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	methods = make(chan string, 10)
+	go func() {
+		buf := make([]byte, 65535)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			req, err := sipingo.NewMessage(string(buf[:n]))
+			if err != nil {
+				continue
+			}
+			method := strings.Fields(req[sipRequest])[0]
+			methods <- method
+			rplyCodes := codes
+			switch method {
+			case "ACK":
+				continue
+			case "BYE":
+				rplyCodes = []string{"200 OK"}
+			}
+			for _, code := range rplyCodes {
+				rply := req.Clone()
+				rply[sipRequest] = "SIP/2.0 " + code
+				rply["To"] += ";tag=responder"
+				conn.WriteTo([]byte(rply.String()), from)
+			}
+		}
+	}()
+	return conn.LocalAddr().String(), methods
+}
+
+func TestSIPDriverRunCall(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		codes      []string
+		expMethods []string
+		expSteps   []string
+		expErr     string
+	}{
+		{
+			name:       "redirect",
+			codes:      []string{"100 Trying", "302 Moved Temporarily"},
+			expMethods: []string{"INVITE", "ACK"},
+			expSteps:   []string{"sip INVITE"},
+		},
+		{
+			name:       "answered",
+			codes:      []string{"200 OK"},
+			expMethods: []string{"INVITE", "ACK", "BYE"},
+			expSteps:   []string{"sip INVITE", "sip BYE"},
+		},
+		{
+			name:       "rejected",
+			codes:      []string{"403 Forbidden"},
+			expMethods: []string{"INVITE", "ACK"},
+			expSteps:   []string{"sip INVITE"},
+			expErr:     "403 Forbidden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, methods := sipResponder(t, tc.codes)
+			sd := newSIPDriver(&sipScenarioConfig{Address: addr}, time.Second)
+			rep := newScenarioReport()
+			sd.runCall(&callLeg{
+				call:        &scenarioCall{ID: "sip"},
+				originID:    utils.GenUUID(),
+				account:     "1001",
+				destination: "1002",
+				usage:       time.Millisecond,
+			}, rep)
+			for _, exp := range tc.expMethods {
+				select {
+				case rcv := <-methods:
+					if rcv != exp {
+						t.Errorf("expected %s, received %s", exp, rcv)
+					}
+				case <-time.After(time.Second):
+					t.Fatalf("did not receive %s", exp)
+				}
+			}
+			if strings.Join(rep.order, ",") != strings.Join(tc.expSteps, ",") {
+				t.Errorf("expected steps %v, received %v", tc.expSteps, rep.order)
+			}
+			inv := rep.steps["sip INVITE"]
+			if len(inv.latencies) != 1 {
+				t.Errorf("expected one INVITE latency, received %v", inv.latencies)
+			}
+			if tc.expErr != utils.EmptyString && inv.errors[tc.expErr] != 1 {
+				t.Errorf("expected error %q, received %v", tc.expErr, inv.errors)
+			}
+		})
+	}
+}
+
+func TestSIPDriverRetransmissions(t *testing.T) {
+	conn, err := net.ListenPacket(utils.UDP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	methods := make(chan string, 10)
+	go func() { // drops the first INVITE and the first BYE as if lost in the network
+		buf := make([]byte, 65535)
+		dropped := make(map[string]bool)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			req, err := sipingo.NewMessage(string(buf[:n]))
+			if err != nil {
+				continue
+			}
+			method := strings.Fields(req[sipRequest])[0]
+			methods <- method
+			if method == "ACK" {
+				continue
+			}
+			if !dropped[method] {
+				dropped[method] = true
+				continue
+			}
+			rply := req.Clone()
+			rply[sipRequest] = "SIP/2.0 200 OK"
+			rply["To"] += ";tag=responder"
+			conn.WriteTo([]byte(rply.String()), from)
+		}
+	}()
+	sd := newSIPDriver(&sipScenarioConfig{Address: conn.LocalAddr().String()}, time.Second)
+	sd.t1 = 20 * time.Millisecond
+	rep := newScenarioReport()
+	sd.runCall(&callLeg{
+		call:        &scenarioCall{ID: "sip"},
+		originID:    utils.GenUUID(),
+		account:     "1001",
+		destination: "1002",
+		usage:       time.Millisecond,
+	}, rep)
+	for _, exp := range []string{"INVITE", "INVITE", "ACK", "BYE", "BYE"} {
+		select {
+		case rcv := <-methods:
+			if rcv != exp {
+				t.Errorf("expected %s, received %s", exp, rcv)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("did not receive %s", exp)
+		}
+	}
+	for _, step := range []string{"sip INVITE", "sip BYE"} {
+		if st := rep.steps[step]; st == nil || len(st.latencies) != 1 || len(st.errors) != 0 {
+			t.Errorf("expected %s answered after the retransmission, received %s", step, utils.ToJSON(rep.steps))
+		}
+	}
+}
+
+func TestSIPDriverTimeout(t *testing.T) {
+	conn, err := net.ListenPacket(utils.UDP, "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	sd := newSIPDriver(&sipScenarioConfig{Address: conn.LocalAddr().String()}, 50*time.Millisecond)
+	rep := newScenarioReport()
+	sd.runCall(&callLeg{call: &scenarioCall{ID: "sip"}, originID: "1", account: "1001", destination: "1002"}, rep)
+	if st := rep.steps["sip INVITE"]; st == nil || st.errors[utils.ErrReplyTimeout.Error()] != 1 {
+		t.Errorf("expected REPLY_TIMEOUT, received %s", utils.ToJSON(rep.steps))
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/sipingo"
+)
+
+const sipRequest = "Request" // sipingo key of the request/status line
+
+// RFC 3261 timers of the UDP transactions
+const (
+	sipT1 = 500 * time.Millisecond // RTT estimate, first retransmission interval
+	sipT2 = 4 * time.Second        // maximum retransmission interval of non-INVITE requests
+)
+
+// sipDriver sends the calls as SIP INVITEs over UDP, one socket per call
+type sipDriver struct {
+	cfg     *sipScenarioConfig
+	host    string
+	timeout time.Duration
+	t1      time.Duration
+	t2      time.Duration
+}
+
+func newSIPDriver(cfg *sipScenarioConfig, timeout time.Duration) *sipDriver {
+	host, _, err := net.SplitHostPort(cfg.Address)
+	if err != nil {
+		host = cfg.Address
+	}
+	return &sipDriver{cfg: cfg, host: host, timeout: timeout, t1: sipT1, t2: sipT2}
+}
+
+// close is a no-op since the sockets are opened per call
+func (sd *sipDriver) close() {}
+
+// sipStatusCode returns the status code of a response, 0 for requests
+func sipStatusCode(m sipingo.Message) int {
+	fields := strings.Fields(m[sipRequest])
+	if len(fields) < 2 || fields[0] != "SIP/2.0" {
+		return 0
+	}
+	code, _ := strconv.Atoi(fields[1])
+	return code
+}
+
+// transaction sends the request and waits for its final response, retransmitting
+// the request as per RFC 3261 since UDP does not guarantee the delivery: Timer A
+// doubles the interval for INVITEs until the first response, Timer E doubles it
+// up to T2 for the other requests, continuing at T2 after a provisional response
+func (sd *sipDriver) transaction(conn net.Conn, req sipingo.Message) (rply sipingo.Message,
+	latency time.Duration, err error) {
+	invite := strings.HasPrefix(req[sipRequest], "INVITE ")
+	raw := []byte(req.String())
+	start := time.Now()
+	deadline := start.Add(sd.timeout)
+	if _, err = conn.Write(raw); err != nil {
+		return
+	}
+	retransmit := true
+	intvl := sd.t1
+	nextSend := start.Add(intvl)
+	buf := make([]byte, 65535)
+	for {
+		readDeadline := deadline
+		if retransmit && nextSend.Before(deadline) {
+			readDeadline = nextSend
+		}
+		conn.SetReadDeadline(readDeadline)
+		var n int
+		if n, err = conn.Read(buf); err != nil {
+			var netErr net.Error
+			if !errors.As(err, &netErr) || !netErr.Timeout() {
+				return nil, 0, err
+			}
+			if readDeadline.Before(deadline) { // Timer A/E fired
+				if _, err = conn.Write(raw); err != nil {
+					return nil, 0, err
+				}
+				intvl *= 2
+				if !invite {
+					intvl = min(intvl, sd.t2)
+				}
+				nextSend = time.Now().Add(intvl)
+				continue
+			}
+			return nil, 0, utils.ErrReplyTimeout
+		}
+		if rply, err = sipingo.NewMessage(string(buf[:n])); err != nil {
+			return nil, 0, err
+		}
+		code := sipStatusCode(rply)
+		if code == 0 {
+			return nil, 0, fmt.Errorf("unexpected SIP message <%s>", rply[sipRequest])
+		}
+		if rply["CSeq"] != req["CSeq"] { // late retransmission of a previous transaction
+			continue
+		}
+		if code < 200 { // provisional response, wait for the final one
+			if invite {
+				retransmit = false
+			} else {
+				intvl = sd.t2
+				nextSend = time.Now().Add(intvl)
+			}
+			continue
+		}
+		latency = time.Since(start)
+		if code >= 400 {
+			err = errors.New(strings.TrimPrefix(rply[sipRequest], "SIP/2.0 "))
+		}
+		return
+	}
+}
+
+// runCall sends the INVITE and, if the call is answered, the BYE after the usage
+func (sd *sipDriver) runCall(cl *callLeg, rep *scenarioReport) {
+	const (
+		inviteStep = "INVITE"
+		byeStep    = "BYE"
+	)
+	conn, err := net.Dial(utils.UDP, sd.cfg.Address)
+	if err != nil {
+		rep.record(cl.call.ID, inviteStep, 0, err)
+		return
+	}
+	defer conn.Close()
+	local := conn.LocalAddr().String()
+	uri := "sip:" + cl.destination + "@" + sd.host
+	invite := sipingo.Message{
+		sipRequest:       "INVITE " + uri + " SIP/2.0",
+		"Via":            "SIP/2.0/UDP " + local + ";branch=z9hG4bK-" + utils.GenUUID(),
+		"Max-Forwards":   "70",
+		"From":           fmt.Sprintf(`"%s" <sip:%s@%s>;tag=%s`, cl.account, cl.account, sd.host, utils.UUIDSha1Prefix()),
+		"To":             "<" + uri + ">",
+		"Call-ID":        cl.originID,
+		"CSeq":           "1 INVITE",
+		"Contact":        "<sip:" + cl.account + "@" + local + ">",
+		"User-Agent":     utils.CGRTester,
+		"Content-Length": "0",
+	}
+	rply, latency, err := sd.transaction(conn, invite)
+	rep.record(cl.call.ID, inviteStep, latency, err)
+	if rply == nil {
+		return
+	}
+	answered := sipStatusCode(rply) < 300
+	ack := invite.Clone()
+	ack[sipRequest] = "ACK " + uri + " SIP/2.0"
+	ack["CSeq"] = "1 ACK"
+	ack["To"] = rply["To"]
+	if answered { // the ACK of a 2xx is a transaction on its own
+		ack["Via"] = "SIP/2.0/UDP " + local + ";branch=z9hG4bK-" + utils.GenUUID()
+	}
+	if _, err = conn.Write([]byte(ack.String())); err != nil || !answered {
+		return
+	}
+	if !cl.failed {
+		time.Sleep(cl.usage)
+	}
+	bye := ack.Clone()
+	bye[sipRequest] = "BYE " + uri + " SIP/2.0"
+	bye["CSeq"] = "2 BYE"
+	bye["Via"] = "SIP/2.0/UDP " + local + ";branch=z9hG4bK-" + utils.GenUUID()
+	_, latency, err = sd.transaction(conn, bye)
+	rep.record(cl.call.ID, byeStep, latency, err)
+}
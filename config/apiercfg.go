@@ -19,6 +19,8 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package config
 
 import (
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -29,6 +31,10 @@ type ApierCfg struct {
 	SchedulerConns  []string // connections towards Scheduler
 	AttributeSConns []string // connections towards AttributeS
 	EEsConns        []string // connections towards EEs
+	ThresholdSConns []string // connections towards ThresholdS
+
+	IndexHealthInterval time.Duration // interval of the scheduled index health checks, 0 to disable
+	IndexHealthRepair   bool          // repair the indexes found broken by the scheduled checks
 }
 
 func (aCfg *ApierCfg) loadFromJSONCfg(jsnCfg *ApierJsonCfg) (err error) {
@@ -78,13 +84,36 @@ func (aCfg *ApierCfg) loadFromJSONCfg(jsnCfg *ApierJsonCfg) (err error) {
 			}
 		}
 	}
+	if jsnCfg.Thresholds_conns != nil {
+		aCfg.ThresholdSConns = make([]string, len(*jsnCfg.Thresholds_conns))
+		for idx, connID := range *jsnCfg.Thresholds_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			aCfg.ThresholdSConns[idx] = connID
+			if connID == utils.MetaInternal {
+				aCfg.ThresholdSConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds)
+			}
+		}
+	}
+	if jsnCfg.Index_health_interval != nil {
+		if aCfg.IndexHealthInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Index_health_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Index_health_repair != nil {
+		aCfg.IndexHealthRepair = *jsnCfg.Index_health_repair
+	}
 	return nil
 }
 
 // AsMapInterface returns the config as a map[string]any
 func (aCfg *ApierCfg) AsMapInterface() (initialMap map[string]any) {
 	initialMap = map[string]any{
-		utils.EnabledCfg: aCfg.Enabled,
+		utils.EnabledCfg:             aCfg.Enabled,
+		utils.IndexHealthIntervalCfg: utils.EmptyString,
+		utils.IndexHealthRepairCfg:   aCfg.IndexHealthRepair,
+	}
+	if aCfg.IndexHealthInterval != 0 {
+		initialMap[utils.IndexHealthIntervalCfg] = aCfg.IndexHealthInterval.String()
 	}
 	if aCfg.CachesConns != nil {
 		cachesConns := make([]string, len(aCfg.CachesConns))
@@ -126,6 +155,16 @@ func (aCfg *ApierCfg) AsMapInterface() (initialMap map[string]any) {
 		}
 		initialMap[utils.EEsConnsCfg] = eesConns
 	}
+	if aCfg.ThresholdSConns != nil {
+		thresholdSConns := make([]string, len(aCfg.ThresholdSConns))
+		for i, item := range aCfg.ThresholdSConns {
+			thresholdSConns[i] = item
+			if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds) {
+				thresholdSConns[i] = utils.MetaInternal
+			}
+		}
+		initialMap[utils.ThresholdSConnsCfg] = thresholdSConns
+	}
 	return
 }
 
@@ -135,7 +174,9 @@ func (aCfg *ApierCfg) Clone() (cln *ApierCfg) {
 		return nil
 	}
 	cln = &ApierCfg{
-		Enabled: aCfg.Enabled,
+		Enabled:             aCfg.Enabled,
+		IndexHealthInterval: aCfg.IndexHealthInterval,
+		IndexHealthRepair:   aCfg.IndexHealthRepair,
 	}
 	if aCfg.CachesConns != nil {
 		cln.CachesConns = make([]string, len(aCfg.CachesConns))
@@ -153,5 +194,9 @@ func (aCfg *ApierCfg) Clone() (cln *ApierCfg) {
 		cln.EEsConns = make([]string, len(aCfg.EEsConns))
 		copy(cln.EEsConns, aCfg.EEsConns)
 	}
+	if aCfg.ThresholdSConns != nil {
+		cln.ThresholdSConns = make([]string, len(aCfg.ThresholdSConns))
+		copy(cln.ThresholdSConns, aCfg.ThresholdSConns)
+	}
 	return
 }
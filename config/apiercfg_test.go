@@ -20,17 +20,21 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
 
 func TestApierCfgloadFromJsonCfg(t *testing.T) {
 	jsonCfg := &ApierJsonCfg{
-		Enabled:          utils.BoolPointer(false),
-		Caches_conns:     &[]string{utils.MetaInternal, "*conn1"},
-		Scheduler_conns:  &[]string{utils.MetaInternal, "*conn1"},
-		Attributes_conns: &[]string{utils.MetaInternal, "*conn1"},
-		Ees_conns:        &[]string{utils.MetaInternal, "*conn1"},
+		Enabled:               utils.BoolPointer(false),
+		Caches_conns:          &[]string{utils.MetaInternal, "*conn1"},
+		Scheduler_conns:       &[]string{utils.MetaInternal, "*conn1"},
+		Attributes_conns:      &[]string{utils.MetaInternal, "*conn1"},
+		Ees_conns:             &[]string{utils.MetaInternal, "*conn1"},
+		Thresholds_conns:      &[]string{utils.MetaInternal, "*conn1"},
+		Index_health_interval: utils.StringPointer("1h"),
+		Index_health_repair:   utils.BoolPointer(true),
 	}
 	expected := &ApierCfg{
 		Enabled:         false,
@@ -38,6 +42,10 @@ func TestApierCfgloadFromJsonCfg(t *testing.T) {
 		SchedulerConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler), "*conn1"},
 		AttributeSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes), "*conn1"},
 		EEsConns:        []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
+		ThresholdSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
+
+		IndexHealthInterval: time.Hour,
+		IndexHealthRepair:   true,
 	}
 	jsnCfg := NewDefaultCGRConfig()
 	if err := jsnCfg.apier.loadFromJSONCfg(jsonCfg); err != nil {
@@ -55,11 +63,14 @@ func TestApierCfgAsMapInterface1(t *testing.T) {
 }`
 	sls := make([]string, 0)
 	eMap := map[string]any{
-		utils.EnabledCfg:         false,
-		utils.CachesConnsCfg:     sls,
-		utils.SchedulerConnsCfg:  sls,
-		utils.AttributeSConnsCfg: sls,
-		utils.EEsConnsCfg:        sls,
+		utils.EnabledCfg:             false,
+		utils.CachesConnsCfg:         sls,
+		utils.SchedulerConnsCfg:      sls,
+		utils.AttributeSConnsCfg:     sls,
+		utils.EEsConnsCfg:            sls,
+		utils.ThresholdSConnsCfg:     sls,
+		utils.IndexHealthIntervalCfg: utils.EmptyString,
+		utils.IndexHealthRepairCfg:   false,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -76,14 +87,20 @@ func TestApierCfgAsMapInterface2(t *testing.T) {
        "ees_conns": ["*internal:*ees", "*conn1"],
        "caches_conns": ["*internal:*caches", "*conn1"],
        "scheduler_conns": ["*internal:*scheduler", "*conn1"],
+       "thresholds_conns": ["*internal:*thresholds", "*conn1"],
+       "index_health_interval": "10m",
+       "index_health_repair": true,
     },
 }`
 	expectedMap := map[string]any{
-		utils.EnabledCfg:         true,
-		utils.CachesConnsCfg:     []string{utils.MetaInternal, "*conn1"},
-		utils.SchedulerConnsCfg:  []string{utils.MetaInternal, "*conn1"},
-		utils.AttributeSConnsCfg: []string{utils.MetaInternal, "*conn1"},
-		utils.EEsConnsCfg:        []string{utils.MetaInternal, "*conn1"},
+		utils.EnabledCfg:             true,
+		utils.CachesConnsCfg:         []string{utils.MetaInternal, "*conn1"},
+		utils.SchedulerConnsCfg:      []string{utils.MetaInternal, "*conn1"},
+		utils.AttributeSConnsCfg:     []string{utils.MetaInternal, "*conn1"},
+		utils.EEsConnsCfg:            []string{utils.MetaInternal, "*conn1"},
+		utils.ThresholdSConnsCfg:     []string{utils.MetaInternal, "*conn1"},
+		utils.IndexHealthIntervalCfg: "10m0s",
+		utils.IndexHealthRepairCfg:   true,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(myJSONStr); err != nil {
 		t.Error(err)
@@ -99,6 +116,10 @@ func TestApierCfgClone(t *testing.T) {
 		SchedulerConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler), "*conn1"},
 		AttributeSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes), "*conn1"},
 		EEsConns:        []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
+		ThresholdSConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
+
+		IndexHealthInterval: time.Minute,
+		IndexHealthRepair:   true,
 	}
 	rcv := sa.Clone()
 	if !reflect.DeepEqual(sa, rcv) {
@@ -116,6 +137,9 @@ func TestApierCfgClone(t *testing.T) {
 	if rcv.EEsConns[1] = ""; sa.EEsConns[1] != "*conn1" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.ThresholdSConns[1] = ""; sa.ThresholdSConns[1] != "*conn1" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 
 	sa = nil
 	rcv = sa.Clone()
@@ -0,0 +1,94 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"slices"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AuditCfg the config for the audit trail of the data changing API calls
+type AuditCfg struct {
+	Enabled        bool
+	Methods        []string // patterns of the audited API methods, e.g. APIerSv1.Set*
+	Store          bool     // stores the records in StorDB
+	EEsConns       []string
+	EEsExporterIDs []string
+}
+
+func (aud *AuditCfg) loadFromJSONCfg(jsnCfg *AuditJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Enabled != nil {
+		aud.Enabled = *jsnCfg.Enabled
+	}
+	if jsnCfg.Methods != nil {
+		aud.Methods = slices.Clone(*jsnCfg.Methods)
+	}
+	if jsnCfg.Store != nil {
+		aud.Store = *jsnCfg.Store
+	}
+	if jsnCfg.Ees_conns != nil {
+		aud.EEsConns = make([]string, len(*jsnCfg.Ees_conns))
+		for idx, connID := range *jsnCfg.Ees_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			aud.EEsConns[idx] = connID
+			if connID == utils.MetaInternal {
+				aud.EEsConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)
+			}
+		}
+	}
+	if jsnCfg.Ees_exporter_ids != nil {
+		aud.EEsExporterIDs = slices.Clone(*jsnCfg.Ees_exporter_ids)
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (aud *AuditCfg) AsMapInterface() map[string]any {
+	eesConns := make([]string, len(aud.EEsConns))
+	for i, item := range aud.EEsConns {
+		eesConns[i] = item
+		if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+			eesConns[i] = utils.MetaInternal
+		}
+	}
+	return map[string]any{
+		utils.EnabledCfg:        aud.Enabled,
+		utils.MethodsCfg:        slices.Clone(aud.Methods),
+		utils.StoreCfg:          aud.Store,
+		utils.EEsConnsCfg:       eesConns,
+		utils.EEsExporterIDsCfg: slices.Clone(aud.EEsExporterIDs),
+	}
+}
+
+// Clone returns a deep copy of AuditCfg
+func (aud *AuditCfg) Clone() *AuditCfg {
+	if aud == nil {
+		return nil
+	}
+	return &AuditCfg{
+		Enabled:        aud.Enabled,
+		Methods:        slices.Clone(aud.Methods),
+		Store:          aud.Store,
+		EEsConns:       slices.Clone(aud.EEsConns),
+		EEsExporterIDs: slices.Clone(aud.EEsExporterIDs),
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAuditCfgloadFromJsonCfg(t *testing.T) {
+	audCfg := new(AuditCfg)
+	if err := audCfg.loadFromJSONCfg(nil); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(audCfg, new(AuditCfg)) {
+		t.Errorf("Expected: %+v ,received: %+v", new(AuditCfg), audCfg)
+	}
+	cfgJSONStr := `{
+		"audit": {
+			"enabled": true,
+			"methods": ["APIerSv1.Set*", "ConfigSv1.SetConfig"],
+			"store": false,
+			"ees_conns": ["*internal", "conn1"],
+			"ees_exporter_ids": ["audit_exporter"],
+		},
+}`
+	expected := &AuditCfg{
+		Enabled:        true,
+		Methods:        []string{"APIerSv1.Set*", "ConfigSv1.SetConfig"},
+		EEsConns:       []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "conn1"},
+		EEsExporterIDs: []string{"audit_exporter"},
+	}
+	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
+		t.Error(err)
+	} else if jsnAudCfg, err := jsnCfg.AuditJson(); err != nil {
+		t.Error(err)
+	} else if err = audCfg.loadFromJSONCfg(jsnAudCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, audCfg) {
+		t.Errorf("Expected: %+v , received: %+v", utils.ToJSON(expected), utils.ToJSON(audCfg))
+	}
+}
+
+func TestAuditCfgAsMapInterface(t *testing.T) {
+	audCfg := &AuditCfg{
+		Enabled:  true,
+		Methods:  []string{"APIerSv1.Set*"},
+		Store:    true,
+		EEsConns: []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)},
+	}
+	eMap := map[string]any{
+		utils.EnabledCfg:        true,
+		utils.MethodsCfg:        []string{"APIerSv1.Set*"},
+		utils.StoreCfg:          true,
+		utils.EEsConnsCfg:       []string{utils.MetaInternal},
+		utils.EEsExporterIDsCfg: []string(nil),
+	}
+	if rcv := audCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+}
+
+func TestAuditCfgClone(t *testing.T) {
+	audCfg := &AuditCfg{
+		Enabled:        true,
+		Methods:        []string{"APIerSv1.Set*"},
+		Store:          true,
+		EEsConns:       []string{"conn1"},
+		EEsExporterIDs: []string{"audit_exporter"},
+	}
+	rcv := audCfg.Clone()
+	if !reflect.DeepEqual(audCfg, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(audCfg), utils.ToJSON(rcv))
+	}
+	if rcv.Methods[0] = "*"; audCfg.Methods[0] != "APIerSv1.Set*" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	audCfg = nil
+	if rcv = audCfg.Clone(); rcv != nil {
+		t.Errorf("Expected nil, received: %+v", utils.ToJSON(rcv))
+	}
+}
+
+func TestAuditCfgSanity(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.auditCfg.Enabled = true
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+	cfg.auditCfg.Methods = []string{"APIerSv1.[Set"}
+	expErr := `<AuditS> invalid method pattern "APIerSv1.[Set": syntax error in pattern`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+	cfg.auditCfg.Methods = []string{"APIerSv1.Set*"}
+	cfg.auditCfg.EEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	expErr = `<EEs> not enabled but requested by <AuditS> component`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+	cfg.auditCfg.EEsConns = []string{"conn1"}
+	expErr = `<AuditS> connection with id: <conn1> not defined`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+}
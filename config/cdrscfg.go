@@ -19,6 +19,9 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package config
 
 import (
+	"slices"
+	"time"
+
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -34,9 +37,143 @@ type CdrsCfg struct {
 	AttributeSConns    []string
 	ThresholdSConns    []string
 	StatSConns         []string
+	RouteSConns        []string
 	OnlineCDRExports   []string // list of CDRE templates to use for real-time CDR exports
 	SchedulerConns     []string
 	EEsConns           []string
+	Retention          *CDRRetentionCfg
+}
+
+// CDRRetentionCfg controls how long the CDRs are kept in StorDB and
+// when they are pseudonymised
+type CDRRetentionCfg struct {
+	PurgeInterval      time.Duration // 0 disables the background job
+	Policies           []*CDRRetentionPolicy
+	PseudonymiseMethod string // <*hash|*mask>
+	PseudonymiseFields []string
+	PseudonymiseSalt   string
+	MaskKeepPrefix     int
+}
+
+// CDRRetentionPolicy applies to the CDRs of the Tenants and ToRs, all if empty
+type CDRRetentionPolicy struct {
+	Tenants           []string
+	ToRs              []string
+	MaxAge            time.Duration // 0 keeps the CDRs
+	PseudonymiseAfter time.Duration // 0 disables the pseudonymisation
+}
+
+func (pol *CDRRetentionPolicy) loadFromJSONCfg(jsnCfg *CDRRetentionPolicyJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Tenants != nil {
+		pol.Tenants = slices.Clone(*jsnCfg.Tenants)
+	}
+	if jsnCfg.Tors != nil {
+		pol.ToRs = slices.Clone(*jsnCfg.Tors)
+	}
+	if jsnCfg.Max_age != nil {
+		if pol.MaxAge, err = utils.ParseDurationWithNanosecs(*jsnCfg.Max_age); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Pseudonymise_after != nil {
+		if pol.PseudonymiseAfter, err = utils.ParseDurationWithNanosecs(*jsnCfg.Pseudonymise_after); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (pol *CDRRetentionPolicy) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.Tenants:              slices.Clone(pol.Tenants),
+		utils.TorsCfg:              slices.Clone(pol.ToRs),
+		utils.MaxAgeCfg:            pol.MaxAge.String(),
+		utils.PseudonymiseAfterCfg: pol.PseudonymiseAfter.String(),
+	}
+}
+
+// Clone returns a deep copy of CDRRetentionPolicy
+func (pol *CDRRetentionPolicy) Clone() *CDRRetentionPolicy {
+	return &CDRRetentionPolicy{
+		Tenants:           slices.Clone(pol.Tenants),
+		ToRs:              slices.Clone(pol.ToRs),
+		MaxAge:            pol.MaxAge,
+		PseudonymiseAfter: pol.PseudonymiseAfter,
+	}
+}
+
+func (rtCfg *CDRRetentionCfg) loadFromJSONCfg(jsnCfg *CDRRetentionJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Purge_interval != nil {
+		if rtCfg.PurgeInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Purge_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Policies != nil {
+		rtCfg.Policies = make([]*CDRRetentionPolicy, len(*jsnCfg.Policies))
+		for i, jsnPol := range *jsnCfg.Policies {
+			rtCfg.Policies[i] = new(CDRRetentionPolicy)
+			if err = rtCfg.Policies[i].loadFromJSONCfg(jsnPol); err != nil {
+				return
+			}
+		}
+	}
+	if jsnCfg.Pseudonymise_method != nil {
+		rtCfg.PseudonymiseMethod = *jsnCfg.Pseudonymise_method
+	}
+	if jsnCfg.Pseudonymise_fields != nil {
+		rtCfg.PseudonymiseFields = slices.Clone(*jsnCfg.Pseudonymise_fields)
+	}
+	if jsnCfg.Pseudonymise_salt != nil {
+		rtCfg.PseudonymiseSalt = *jsnCfg.Pseudonymise_salt
+	}
+	if jsnCfg.Mask_keep_prefix != nil {
+		rtCfg.MaskKeepPrefix = *jsnCfg.Mask_keep_prefix
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (rtCfg *CDRRetentionCfg) AsMapInterface() map[string]any {
+	policies := make([]map[string]any, len(rtCfg.Policies))
+	for i, pol := range rtCfg.Policies {
+		policies[i] = pol.AsMapInterface()
+	}
+	return map[string]any{
+		utils.PurgeIntervalCfg:      rtCfg.PurgeInterval.String(),
+		utils.PoliciesCfg:           policies,
+		utils.PseudonymiseMethodCfg: rtCfg.PseudonymiseMethod,
+		utils.PseudonymiseFieldsCfg: slices.Clone(rtCfg.PseudonymiseFields),
+		utils.PseudonymiseSaltCfg:   rtCfg.PseudonymiseSalt,
+		utils.MaskKeepPrefixCfg:     rtCfg.MaskKeepPrefix,
+	}
+}
+
+// Clone returns a deep copy of CDRRetentionCfg
+func (rtCfg *CDRRetentionCfg) Clone() *CDRRetentionCfg {
+	if rtCfg == nil {
+		return nil
+	}
+	cln := &CDRRetentionCfg{
+		PurgeInterval:      rtCfg.PurgeInterval,
+		PseudonymiseMethod: rtCfg.PseudonymiseMethod,
+		PseudonymiseFields: slices.Clone(rtCfg.PseudonymiseFields),
+		PseudonymiseSalt:   rtCfg.PseudonymiseSalt,
+		MaskKeepPrefix:     rtCfg.MaskKeepPrefix,
+	}
+	if rtCfg.Policies != nil {
+		cln.Policies = make([]*CDRRetentionPolicy, len(rtCfg.Policies))
+		for i, pol := range rtCfg.Policies {
+			cln.Policies[i] = pol.Clone()
+		}
+	}
+	return cln
 }
 
 // loadFromJSONCfg loads Cdrs config from JsonCfg
@@ -111,6 +248,9 @@ func (cdrscfg *CdrsCfg) loadFromJSONCfg(jsnCdrsCfg *CdrsJsonCfg) (err error) {
 			}
 		}
 	}
+	if jsnCdrsCfg.Routes_conns != nil {
+		cdrscfg.RouteSConns = tagInternalConns(*jsnCdrsCfg.Routes_conns, utils.MetaRoutes)
+	}
 	if jsnCdrsCfg.Online_cdr_exports != nil {
 		cdrscfg.OnlineCDRExports = make([]string, len(*jsnCdrsCfg.Online_cdr_exports))
 		copy(cdrscfg.OnlineCDRExports, *jsnCdrsCfg.Online_cdr_exports)
@@ -136,6 +276,11 @@ func (cdrscfg *CdrsCfg) loadFromJSONCfg(jsnCdrsCfg *CdrsJsonCfg) (err error) {
 			}
 		}
 	}
+	if jsnCdrsCfg.Retention != nil {
+		if err = cdrscfg.Retention.loadFromJSONCfg(jsnCdrsCfg.Retention); err != nil {
+			return
+		}
+	}
 	return nil
 }
 
@@ -146,6 +291,7 @@ func (cdrscfg *CdrsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.StoreCdrsCfg:          cdrscfg.StoreCdrs,
 		utils.CompressStoredCostCfg: cdrscfg.CompressStoredCost,
 		utils.SMCostRetriesCfg:      cdrscfg.SMCostRetries,
+		utils.RetentionCfg:          cdrscfg.Retention.AsMapInterface(),
 	}
 
 	extraFields := make([]string, len(cdrscfg.ExtraFields))
@@ -209,6 +355,9 @@ func (cdrscfg *CdrsCfg) AsMapInterface() (initialMP map[string]any) {
 		}
 		initialMP[utils.StatSConnsCfg] = statSConns
 	}
+	if cdrscfg.RouteSConns != nil {
+		initialMP[utils.RouteSConnsCfg] = stripInternalConns(cdrscfg.RouteSConns)
+	}
 	if cdrscfg.SchedulerConns != nil {
 		schedulerConns := make([]string, len(cdrscfg.SchedulerConns))
 		for i, item := range cdrscfg.SchedulerConns {
@@ -243,6 +392,7 @@ func (cdrscfg *CdrsCfg) Clone() (cln *CdrsCfg) {
 		StoreCdrs:          cdrscfg.StoreCdrs,
 		SMCostRetries:      cdrscfg.SMCostRetries,
 		CompressStoredCost: cdrscfg.CompressStoredCost,
+		Retention:          cdrscfg.Retention.Clone(),
 	}
 	if cdrscfg.ChargerSConns != nil {
 		cln.ChargerSConns = make([]string, len(cdrscfg.ChargerSConns))
@@ -264,6 +414,10 @@ func (cdrscfg *CdrsCfg) Clone() (cln *CdrsCfg) {
 		cln.StatSConns = make([]string, len(cdrscfg.StatSConns))
 		copy(cln.StatSConns, cdrscfg.StatSConns)
 	}
+	if cdrscfg.RouteSConns != nil {
+		cln.RouteSConns = make([]string, len(cdrscfg.RouteSConns))
+		copy(cln.RouteSConns, cdrscfg.RouteSConns)
+	}
 	if cdrscfg.OnlineCDRExports != nil {
 		cln.OnlineCDRExports = make([]string, len(cdrscfg.OnlineCDRExports))
 		copy(cln.OnlineCDRExports, cdrscfg.OnlineCDRExports)
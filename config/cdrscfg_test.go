@@ -20,6 +20,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -34,9 +35,21 @@ func TestCdrsCfgloadFromJsonCfg(t *testing.T) {
 		Attributes_conns:     &[]string{utils.MetaInternal, "*conn1"},
 		Thresholds_conns:     &[]string{utils.MetaInternal, "*conn1"},
 		Stats_conns:          &[]string{utils.MetaInternal, "*conn1"},
+		Routes_conns:         &[]string{utils.MetaInternal, "*conn1"},
 		Online_cdr_exports:   &[]string{"randomVal"},
 		Scheduler_conns:      &[]string{utils.MetaInternal, "*conn1"},
 		Ees_conns:            &[]string{utils.MetaInternal, "*conn1"},
+		Retention: &CDRRetentionJsonCfg{
+			Purge_interval: utils.StringPointer("1h"),
+			Policies: &[]*CDRRetentionPolicyJsonCfg{{
+				Tenants:            &[]string{"cgrates.org"},
+				Tors:               &[]string{utils.MetaVoice},
+				Max_age:            utils.StringPointer("8760h"),
+				Pseudonymise_after: utils.StringPointer("720h"),
+			}},
+			Pseudonymise_method: utils.StringPointer(utils.MetaMask),
+			Pseudonymise_salt:   utils.StringPointer("s3cr3t"),
+		},
 	}
 	expected := &CdrsCfg{
 		Enabled:          true,
@@ -47,10 +60,24 @@ func TestCdrsCfgloadFromJsonCfg(t *testing.T) {
 		AttributeSConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes), "*conn1"},
 		ThresholdSConns:  []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
 		StatSConns:       []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
+		RouteSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes), "*conn1"},
 		OnlineCDRExports: []string{"randomVal"},
 		SchedulerConns:   []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler), "*conn1"},
 		EEsConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
 		ExtraFields:      RSRParsers{},
+		Retention: &CDRRetentionCfg{
+			PurgeInterval: time.Hour,
+			Policies: []*CDRRetentionPolicy{{
+				Tenants:           []string{"cgrates.org"},
+				ToRs:              []string{utils.MetaVoice},
+				MaxAge:            8760 * time.Hour,
+				PseudonymiseAfter: 720 * time.Hour,
+			}},
+			PseudonymiseMethod: utils.MetaMask,
+			PseudonymiseFields: []string{utils.AccountField, utils.Subject, utils.Destination},
+			PseudonymiseSalt:   "s3cr3t",
+			MaskKeepPrefix:     3,
+		},
 	}
 	jsnCfg := NewDefaultCGRConfig()
 	if err := jsnCfg.cdrsCfg.loadFromJSONCfg(jsonCfg); err != nil {
@@ -86,6 +113,10 @@ func TestCdrsCfgAsMapInterface(t *testing.T) {
 		"online_cdr_exports":["http_localhost", "amqp_localhost", "http_test_file"],
 		"scheduler_conns": ["*internal:*scheduler","*conn1"],		
         "ees_conns": ["*internal:*ees","*conn1"],
+		"retention": {
+			"purge_interval": "1h",
+			"policies": [{"tenants": ["cgrates.org"], "tors": ["*voice"], "max_age": "8760h", "pseudonymise_after": "720h"}],
+		},
 	},
 }`
 	eMap := map[string]any{
@@ -99,9 +130,23 @@ func TestCdrsCfgAsMapInterface(t *testing.T) {
 		utils.AttributeSConnsCfg:    []string{utils.MetaInternal, "*conn1"},
 		utils.ThresholdSConnsCfg:    []string{utils.MetaInternal, "*conn1"},
 		utils.StatSConnsCfg:         []string{utils.MetaInternal, "*conn1"},
+		utils.RouteSConnsCfg:        []string{},
 		utils.OnlineCDRExportsCfg:   []string{"http_localhost", "amqp_localhost", "http_test_file"},
 		utils.SchedulerConnsCfg:     []string{utils.MetaInternal, "*conn1"},
 		utils.EEsConnsCfg:           []string{utils.MetaInternal, "*conn1"},
+		utils.RetentionCfg: map[string]any{
+			utils.PurgeIntervalCfg: "1h0m0s",
+			utils.PoliciesCfg: []map[string]any{{
+				utils.Tenants:              []string{"cgrates.org"},
+				utils.TorsCfg:              []string{utils.MetaVoice},
+				utils.MaxAgeCfg:            "8760h0m0s",
+				utils.PseudonymiseAfterCfg: "720h0m0s",
+			}},
+			utils.PseudonymiseMethodCfg: utils.MetaHash,
+			utils.PseudonymiseFieldsCfg: []string{utils.AccountField, utils.Subject, utils.Destination},
+			utils.PseudonymiseSaltCfg:   "",
+			utils.MaskKeepPrefixCfg:     3,
+		},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -130,9 +175,18 @@ func TestCdrsCfgAsMapInterface2(t *testing.T) {
 		utils.AttributeSConnsCfg:    []string{"*internal"},
 		utils.ThresholdSConnsCfg:    []string{},
 		utils.StatSConnsCfg:         []string{},
+		utils.RouteSConnsCfg:        []string{},
 		utils.OnlineCDRExportsCfg:   []string{},
 		utils.SchedulerConnsCfg:     []string{},
 		utils.EEsConnsCfg:           []string{"conn1"},
+		utils.RetentionCfg: map[string]any{
+			utils.PurgeIntervalCfg:      "0s",
+			utils.PoliciesCfg:           []map[string]any{},
+			utils.PseudonymiseMethodCfg: utils.MetaHash,
+			utils.PseudonymiseFieldsCfg: []string{utils.AccountField, utils.Subject, utils.Destination},
+			utils.PseudonymiseSaltCfg:   "",
+			utils.MaskKeepPrefixCfg:     3,
+		},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -155,6 +209,14 @@ func TestCdrsCfgClone(t *testing.T) {
 		EEsConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "*conn1"},
 		OnlineCDRExports: []string{"randomVal"},
 		ExtraFields:      RSRParsers{},
+		Retention: &CDRRetentionCfg{
+			Policies: []*CDRRetentionPolicy{{
+				Tenants: []string{"cgrates.org"},
+				MaxAge:  time.Hour,
+			}},
+			PseudonymiseMethod: utils.MetaHash,
+			PseudonymiseFields: []string{utils.AccountField},
+		},
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
@@ -185,6 +247,12 @@ func TestCdrsCfgClone(t *testing.T) {
 	if rcv.OnlineCDRExports[0] = ""; ban.OnlineCDRExports[0] != "randomVal" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.Retention.Policies[0].Tenants[0] = ""; ban.Retention.Policies[0].Tenants[0] != "cgrates.org" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	if rcv.Retention.PseudonymiseFields[0] = ""; ban.Retention.PseudonymiseFields[0] != utils.AccountField {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 
 	ban = nil
 	rcv = ban.Clone()
@@ -0,0 +1,180 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// restartCfgSections are the sections read only when the engine starts
+var restartCfgSections = utils.NewStringSet([]string{GENERAL_JSN, LISTEN_JSN, TlsCfgJson, CoreSCfgJson})
+
+// ConfigReload is the outcome of reloading the changed config sections
+type ConfigReload struct {
+	Time    time.Time
+	Applied map[string][]string // changed options per section, hot-applied through the service reloads
+	Pending map[string][]string // changed options of the sections applied only after an engine restart
+	Error   string              // the reason the changes were rejected
+}
+
+// ReloadChangedSections reloads the config from path, applying only the sections that changed.
+// The changes are rejected all together if the new config does not pass the sanity checks.
+func (cfg *CGRConfig) ReloadChangedSections(path string) (rld *ConfigReload) {
+	rld = &ConfigReload{
+		Time:    time.Now(),
+		Applied: make(map[string][]string),
+		Pending: make(map[string][]string),
+	}
+	cfgV := cfg.Clone()
+	if err := cfgV.loadCfgWithLocks(path, utils.MetaAll); err != nil {
+		rld.Error = err.Error()
+		return
+	}
+	cfgV.rLockSections()
+	err := cfgV.checkConfigSanity()
+	cfgV.rUnlockSections()
+	if err != nil {
+		rld.Error = err.Error()
+		return
+	}
+	sections := make([]string, 0, len(sortedCfgSections))
+	for section, opts := range diffCfgSections(cfg.AsMapInterface(cfg.GeneralCfg().RSRSep),
+		cfgV.AsMapInterface(cfgV.GeneralCfg().RSRSep)) {
+		if restartCfgSections.Has(section) {
+			rld.Pending[section] = opts
+			continue
+		}
+		rld.Applied[section] = opts
+		sections = append(sections, section)
+	}
+	if len(sections) == 0 {
+		return
+	}
+	slices.SortFunc(sections, func(a, b string) int { // keep the reload order
+		return slices.Index(sortedCfgSections, a) - slices.Index(sortedCfgSections, b)
+	})
+	cfg.reloadDPCache(sections...)
+	cfg.setSectionsWithLocks(cfgV, sections) // the files may have changed since being validated
+	cfg.reloadSections(sections...)
+	return
+}
+
+// setSectionsWithLocks overwrites the given sections with the ones of src
+func (cfg *CGRConfig) setSectionsWithLocks(src *CGRConfig, sections []string) {
+	setMap := cfg.getSetFunctions(src)
+	for _, section := range sections {
+		cfg.lks[section].Lock()
+		defer cfg.lks[section].Unlock()
+		setMap[section]()
+	}
+}
+
+// getSetFunctions returns per section the function copying it from src in place,
+// so the references already taken to the sections see the new values
+func (cfg *CGRConfig) getSetFunctions(src *CGRConfig) map[string]func() {
+	return map[string]func(){
+		GENERAL_JSN:         func() { *cfg.generalCfg = *src.generalCfg },
+		DATADB_JSN:          func() { *cfg.dataDbCfg = *src.dataDbCfg },
+		STORDB_JSN:          func() { *cfg.storDbCfg = *src.storDbCfg },
+		LISTEN_JSN:          func() { *cfg.listenCfg = *src.listenCfg },
+		TlsCfgJson:          func() { *cfg.tlsCfg = *src.tlsCfg },
+		HTTP_JSN:            func() { *cfg.httpCfg = *src.httpCfg },
+		SCHEDULER_JSN:       func() { *cfg.schedulerCfg = *src.schedulerCfg },
+		CACHE_JSN:           func() { *cfg.cacheCfg = *src.cacheCfg },
+		FilterSjsn:          func() { *cfg.filterSCfg = *src.filterSCfg },
+		RALS_JSN:            func() { *cfg.ralsCfg = *src.ralsCfg },
+		CDRS_JSN:            func() { *cfg.cdrsCfg = *src.cdrsCfg },
+		ERsJson:             func() { *cfg.ersCfg = *src.ersCfg },
+		EEsJson:             func() { *cfg.eesCfg = *src.eesCfg },
+		SessionSJson:        func() { *cfg.sessionSCfg = *src.sessionSCfg },
+		AsteriskAgentJSN:    func() { *cfg.asteriskAgentCfg = *src.asteriskAgentCfg },
+		FreeSWITCHAgentJSN:  func() { *cfg.fsAgentCfg = *src.fsAgentCfg },
+		KamailioAgentJSN:    func() { *cfg.kamAgentCfg = *src.kamAgentCfg },
+		DA_JSN:              func() { *cfg.diameterAgentCfg = *src.diameterAgentCfg },
+		RA_JSN:              func() { *cfg.radiusAgentCfg = *src.radiusAgentCfg },
+		HttpAgentJson:       func() { cfg.httpAgentCfg = src.httpAgentCfg },
+		DNSAgentJson:        func() { *cfg.dnsAgentCfg = *src.dnsAgentCfg },
+		PrometheusAgentJSON: func() { *cfg.prometheusAgentCfg = *src.prometheusAgentCfg },
+		ATTRIBUTE_JSN:       func() { *cfg.attributeSCfg = *src.attributeSCfg },
+		ChargerSCfgJson:     func() { *cfg.chargerSCfg = *src.chargerSCfg },
+		RESOURCES_JSON:      func() { *cfg.resourceSCfg = *src.resourceSCfg },
+		STATS_JSON:          func() { *cfg.statsCfg = *src.statsCfg },
+		TRENDS_JSON:         func() { *cfg.trendsCfg = *src.trendsCfg },
+		RANKINGS_JSON:       func() { *cfg.rankingsCfg = *src.rankingsCfg },
+		FraudSJson:          func() { *cfg.fraudSCfg = *src.fraudSCfg },
+		THRESHOLDS_JSON:     func() { *cfg.thresholdSCfg = *src.thresholdSCfg },
+		RouteSJson:          func() { *cfg.routeSCfg = *src.routeSCfg },
+		MAILER_JSN:          func() { *cfg.mailerCfg = *src.mailerCfg },
+		SURETAX_JSON:        func() { *cfg.sureTaxCfg = *src.sureTaxCfg },
+		CgrLoaderCfgJson:    func() { *cfg.loaderCgrCfg = *src.loaderCgrCfg },
+		CgrMigratorCfgJson:  func() { *cfg.migratorCgrCfg = *src.migratorCgrCfg },
+		DispatcherSJson:     func() { *cfg.dispatcherSCfg = *src.dispatcherSCfg },
+		RegistrarCJson:      func() { *cfg.registrarCCfg = *src.registrarCCfg },
+		AnalyzerCfgJson:     func() { *cfg.analyzerSCfg = *src.analyzerSCfg },
+		ApierS:              func() { *cfg.apier = *src.apier },
+		RPCConnsJsonName:    func() { clear(cfg.rpcConns); maps.Copy(cfg.rpcConns, src.rpcConns) },
+		SIPAgentJson:        func() { *cfg.sipAgentCfg = *src.sipAgentCfg },
+		JanusAgentJson:      func() { *cfg.janusAgentCfg = *src.janusAgentCfg },
+		TemplatesJson:       func() { clear(cfg.templates); maps.Copy(cfg.templates, src.templates) },
+		ConfigSJson:         func() { *cfg.configSCfg = *src.configSCfg },
+		APIBanCfgJson:       func() { *cfg.apiBanCfg = *src.apiBanCfg },
+		SentryPeerCfgJson:   func() { *cfg.sentryPeerCfg = *src.sentryPeerCfg },
+		GeoIPCfgJson:        func() { *cfg.geoIPCfg = *src.geoIPCfg },
+		TracingCfgJson:      func() { *cfg.tracingCfg = *src.tracingCfg },
+		RBACCfgJson:         func() { *cfg.rbacCfg = *src.rbacCfg },
+		AuditCfgJson:        func() { *cfg.auditCfg = *src.auditCfg },
+		CoreSCfgJson:        func() { *cfg.coreSCfg = *src.coreSCfg },
+		IPsJSON:             func() { *cfg.ipsCfg = *src.ipsCfg },
+	}
+}
+
+// diffCfgSections returns the changed options of the changed sections
+func diffCfgSections(crnt, updt map[string]any) (diff map[string][]string) {
+	diff = make(map[string][]string)
+	for _, section := range sortedCfgSections {
+		if reflect.DeepEqual(crnt[section], updt[section]) {
+			continue
+		}
+		crntMp, isMp := crnt[section].(map[string]any)
+		updtMp, isUpdtMp := updt[section].(map[string]any)
+		if !isMp || !isUpdtMp {
+			diff[section] = []string{}
+			continue
+		}
+		opts := []string{}
+		for opt, val := range crntMp {
+			if !reflect.DeepEqual(val, updtMp[opt]) {
+				opts = append(opts, opt)
+			}
+		}
+		for opt := range updtMp {
+			if _, has := crntMp[opt]; !has {
+				opts = append(opts, opt)
+			}
+		}
+		slices.Sort(opts)
+		diff[section] = opts
+	}
+	return
+}
@@ -0,0 +1,129 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReloadChangedSections(t *testing.T) {
+	cfgDir := t.TempDir()
+	cfgFile := filepath.Join(cfgDir, "cgrates.json")
+	writeCfg := func(cfgJSON string) {
+		if err := os.WriteFile(cfgFile, []byte(cfgJSON), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeCfg(`{
+"listen": {"rpc_json": ":2012"},
+"thresholds": {"enabled": true, "store_interval": "1s"},
+}`)
+	cfg, err := NewCGRConfigFromPath(cfgDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thCfg := cfg.ThresholdSCfg()
+	rldChs := []chan struct{}{cfg.GetReloadChan(DATADB_JSN), cfg.GetReloadChan(THRESHOLDS_JSON)}
+	reloaded := make(chan string, len(rldChs))
+	for i, rldCh := range rldChs {
+		go func() {
+			<-rldCh
+			reloaded <- []string{DATADB_JSN, THRESHOLDS_JSON}[i]
+		}()
+	}
+
+	writeCfg(`{
+"listen": {"rpc_json": ":2022"},
+"thresholds": {"enabled": true, "store_interval": "2s"},
+}`)
+	rld := cfg.ReloadChangedSections(cfgDir)
+	if rld.Error != "" {
+		t.Fatal(rld.Error)
+	}
+	if exp := map[string][]string{THRESHOLDS_JSON: {"store_interval"}}; !reflect.DeepEqual(exp, rld.Applied) {
+		t.Errorf("expected applied %v, received %v", exp, rld.Applied)
+	}
+	if exp := map[string][]string{LISTEN_JSN: {"rpc_json"}}; !reflect.DeepEqual(exp, rld.Pending) {
+		t.Errorf("expected pending %v, received %v", exp, rld.Pending)
+	}
+	for range rldChs {
+		select {
+		case <-reloaded:
+		case <-time.After(time.Second):
+			t.Fatal("section not reloaded")
+		}
+	}
+	if thCfg.StoreInterval != 2*time.Second {
+		t.Errorf("expected the thresholds change to be applied, received %v", thCfg.StoreInterval)
+	}
+	if cfg.ListenCfg().RPCJSONListen != ":2012" {
+		t.Errorf("expected the listen change to wait for restart, received %q", cfg.ListenCfg().RPCJSONListen)
+	}
+
+	// the sessions need ChargerS, rejecting the thresholds change too
+	writeCfg(`{
+"listen": {"rpc_json": ":2012"},
+"thresholds": {"enabled": true, "store_interval": "3s"},
+"sessions": {"enabled": true, "chargers_conns": ["*internal"]},
+}`)
+	if rld = cfg.ReloadChangedSections(cfgDir); rld.Error == "" {
+		t.Error("expected sanity error")
+	} else if len(rld.Applied) != 0 || len(rld.Pending) != 0 {
+		t.Errorf("unexpected changes: %v %v", rld.Applied, rld.Pending)
+	}
+	if cfg.ThresholdSCfg().StoreInterval != 2*time.Second || cfg.SessionSCfg().Enabled {
+		t.Error("expected the rejected changes not to be applied")
+	}
+}
+
+func TestGetSetFunctions(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	setMap := cfg.getSetFunctions(cfg.Clone())
+	for _, section := range sortedCfgSections {
+		if _, has := setMap[section]; !has {
+			t.Errorf("missing the set function of section %q", section)
+		}
+	}
+}
+
+func TestDiffCfgSections(t *testing.T) {
+	crnt := map[string]any{
+		GENERAL_JSN:      map[string]any{"node_id": "A", "log_level": 6},
+		RPCConnsJsonName: map[string]any{"conn1": 1},
+		THRESHOLDS_JSON:  map[string]any{"enabled": false},
+	}
+	updt := map[string]any{
+		GENERAL_JSN:      map[string]any{"node_id": "B", "log_level": 6},
+		RPCConnsJsonName: map[string]any{"conn1": 1, "conn2": 2},
+		THRESHOLDS_JSON:  map[string]any{"enabled": false},
+		ERsJson:          []any{},
+	}
+	exp := map[string][]string{
+		GENERAL_JSN:      {"node_id"},
+		RPCConnsJsonName: {"conn2"},
+		ERsJson:          {},
+	}
+	if rcv := diffCfgSections(crnt, updt); !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %v, received %v", exp, rcv)
+	}
+}
@@ -138,7 +138,7 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 	cfg.ralsCfg.MaxComputedUsage = make(map[string]time.Duration)
 	cfg.ralsCfg.BalanceRatingSubject = make(map[string]string)
 	cfg.schedulerCfg = new(SchedulerCfg)
-	cfg.cdrsCfg = new(CdrsCfg)
+	cfg.cdrsCfg = &CdrsCfg{Retention: new(CDRRetentionCfg)}
 	cfg.analyzerSCfg = new(AnalyzerSCfg)
 	cfg.sessionSCfg = new(SessionSCfg)
 	cfg.sessionSCfg.STIRCfg = new(STIRcfg)
@@ -156,8 +156,9 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 	cfg.statsCfg = &StatSCfg{Opts: &StatsOpts{}}
 	cfg.trendsCfg = new(TrendSCfg)
 	cfg.rankingsCfg = new(RankingSCfg)
+	cfg.fraudSCfg = new(FraudSCfg)
 	cfg.thresholdSCfg = &ThresholdSCfg{Opts: &ThresholdsOpts{}}
-	cfg.routeSCfg = &RouteSCfg{Opts: &RoutesOpts{}}
+	cfg.routeSCfg = &RouteSCfg{Breaker: &RouteBreakerCfg{}, Opts: &RoutesOpts{}}
 	cfg.sureTaxCfg = new(SureTaxCfg)
 	cfg.dispatcherSCfg = new(DispatcherSCfg)
 	cfg.registrarCCfg = new(RegistrarCCfgs)
@@ -181,6 +182,13 @@ func newCGRConfig(config []byte) (cfg *CGRConfig, err error) {
 	cfg.configSCfg = new(ConfigSCfg)
 	cfg.apiBanCfg = new(APIBanCfg)
 	cfg.sentryPeerCfg = new(SentryPeerCfg)
+	cfg.geoIPCfg = new(GeoIPCfg)
+	cfg.tracingCfg = new(TracingCfg)
+	cfg.rbacCfg = &RBACCfg{
+		Roles:   make(map[string]*RBACRoleCfg),
+		APIKeys: make(map[string]*RBACAPIKeyCfg),
+	}
+	cfg.auditCfg = new(AuditCfg)
 	cfg.coreSCfg = new(CoreSCfg)
 	cfg.ipsCfg = &IPsCfg{Opts: &IPsOpts{}}
 	cfg.dfltEvExp = &EventExporterCfg{Opts: &EventExporterOpts{
@@ -318,6 +326,7 @@ type CGRConfig struct {
 	statsCfg           *StatSCfg           // StatS config
 	trendsCfg          *TrendSCfg          // TrendS config
 	rankingsCfg        *RankingSCfg        // Rankings config
+	fraudSCfg          *FraudSCfg          // FraudS config
 	thresholdSCfg      *ThresholdSCfg      // ThresholdS config
 	routeSCfg          *RouteSCfg          // RouteS config
 	sureTaxCfg         *SureTaxCfg         // SureTax config
@@ -335,6 +344,10 @@ type CGRConfig struct {
 	configSCfg         *ConfigSCfg         // ConfigS config
 	apiBanCfg          *APIBanCfg          // APIBan config
 	sentryPeerCfg      *SentryPeerCfg      //SentryPeer config
+	geoIPCfg           *GeoIPCfg           // GeoIP config
+	tracingCfg         *TracingCfg         // Tracing config
+	rbacCfg            *RBACCfg            // RBAC config
+	auditCfg           *AuditCfg           // Audit config
 	coreSCfg           *CoreSCfg           // CoreS config
 	ipsCfg             *IPsCfg             // IPs config
 
@@ -370,13 +383,13 @@ func (cfg *CGRConfig) loadFromJSONCfg(jsnCfg *CgrJsonCfg) (err error) {
 		cfg.loadAsteriskAgentCfg, cfg.loadDiameterAgentCfg, cfg.loadRadiusAgentCfg,
 		cfg.loadDNSAgentCfg, cfg.loadHTTPAgentCfg, cfg.loadPrometheusAgentCfg, cfg.loadAttributeSCfg,
 		cfg.loadChargerSCfg, cfg.loadResourceSCfg, cfg.loadStatSCfg, cfg.loadTrendSCfg,
-		cfg.loadRankingSCfg, cfg.loadThresholdSCfg, cfg.loadRouteSCfg,
+		cfg.loadRankingSCfg, cfg.loadFraudSCfg, cfg.loadThresholdSCfg, cfg.loadRouteSCfg,
 		cfg.loadMailerCfg, cfg.loadSureTaxCfg, cfg.loadDispatcherSCfg,
 		cfg.loadLoaderCgrCfg, cfg.loadMigratorCgrCfg, cfg.loadTLSCgrCfg,
 		cfg.loadAnalyzerCgrCfg, cfg.loadApierCfg, cfg.loadErsCfg, cfg.loadEesCfg,
 		cfg.loadSIPAgentCfg, cfg.loadRegistrarCCfg, cfg.loadJanusAgentCfg,
 		cfg.loadConfigSCfg, cfg.loadAPIBanCgrCfg, cfg.loadSentryPeerCgrCfg,
-		cfg.loadCoreSCfg, cfg.loadIPsCfg,
+		cfg.loadGeoIPCfg, cfg.loadTracingCfg, cfg.loadRBACCfg, cfg.loadAuditCfg, cfg.loadCoreSCfg, cfg.loadIPsCfg,
 	} {
 		if err = loadFunc(jsnCfg); err != nil {
 			return
@@ -659,6 +672,15 @@ func (cfg *CGRConfig) loadTrendSCfg(jsnCfg *CgrJsonCfg) (err error) {
 	return cfg.trendsCfg.loadFromJSONCfg(jsnTrendSCfg)
 }
 
+// loadFraudSCfg loads the FraudS section of the configuration
+func (cfg *CGRConfig) loadFraudSCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnFraudSCfg *FraudSJsonCfg
+	if jsnFraudSCfg, err = jsnCfg.FraudSJsonCfg(); err != nil {
+		return
+	}
+	return cfg.fraudSCfg.loadFromJSONCfg(jsnFraudSCfg)
+}
+
 // loadRankingSCfg loads the RankingS section of the configuration
 func (cfg *CGRConfig) loadRankingSCfg(jsnCfg *CgrJsonCfg) (err error) {
 	var jsnRankingSCfg *RankingsJsonCfg
@@ -728,7 +750,7 @@ func (cfg *CGRConfig) loadLoaderCgrCfg(jsnCfg *CgrJsonCfg) (err error) {
 	if jsnLoaderCgrCfg, err = jsnCfg.LoaderCfgJson(); err != nil {
 		return
 	}
-	return cfg.loaderCgrCfg.loadFromJSONCfg(jsnLoaderCgrCfg)
+	return cfg.loaderCgrCfg.loadFromJSONCfg(jsnLoaderCgrCfg, cfg.generalCfg.RSRSep)
 }
 
 // loadMigratorCgrCfg loads the Migrator section of the configuration
@@ -774,6 +796,42 @@ func (cfg *CGRConfig) loadSentryPeerCgrCfg(jsnCfg *CgrJsonCfg) (err error) {
 	return cfg.sentryPeerCfg.loadFromJSONCfg(jsnSentryPeerCfg)
 }
 
+// loadTracingCfg loads the Tracing section of the configuration
+func (cfg *CGRConfig) loadTracingCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnTracingCfg *TracingJsonCfg
+	if jsnTracingCfg, err = jsnCfg.TracingJson(); err != nil {
+		return
+	}
+	return cfg.tracingCfg.loadFromJSONCfg(jsnTracingCfg)
+}
+
+// loadRBACCfg loads the RBAC section of the configuration
+func (cfg *CGRConfig) loadRBACCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnRBACCfg *RBACJsonCfg
+	if jsnRBACCfg, err = jsnCfg.RBACJson(); err != nil {
+		return
+	}
+	return cfg.rbacCfg.loadFromJSONCfg(jsnRBACCfg)
+}
+
+// loadAuditCfg loads the Audit section of the configuration
+func (cfg *CGRConfig) loadAuditCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnAuditCfg *AuditJsonCfg
+	if jsnAuditCfg, err = jsnCfg.AuditJson(); err != nil {
+		return
+	}
+	return cfg.auditCfg.loadFromJSONCfg(jsnAuditCfg)
+}
+
+// loadGeoIPCfg loads the GeoIP section of the configuration
+func (cfg *CGRConfig) loadGeoIPCfg(jsnCfg *CgrJsonCfg) (err error) {
+	var jsnGeoIPCfg *GeoIPJsonCfg
+	if jsnGeoIPCfg, err = jsnCfg.GeoIPJson(); err != nil {
+		return
+	}
+	return cfg.geoIPCfg.loadFromJSONCfg(jsnGeoIPCfg)
+}
+
 // loadApierCfg loads the Apier section of the configuration
 func (cfg *CGRConfig) loadApierCfg(jsnCfg *CgrJsonCfg) (err error) {
 	var jsnApierCfg *ApierJsonCfg
@@ -928,6 +986,13 @@ func (cfg *CGRConfig) TrendSCfg() *TrendSCfg {
 	return cfg.trendsCfg
 }
 
+// FraudSCfg returns the config for FraudS
+func (cfg *CGRConfig) FraudSCfg() *FraudSCfg {
+	cfg.lks[FraudSJson].Lock()
+	defer cfg.lks[FraudSJson].Unlock()
+	return cfg.fraudSCfg
+}
+
 // RankingSCfg returns the config for RankingS
 func (cfg *CGRConfig) RankingSCfg() *RankingSCfg {
 	cfg.lks[RANKINGS_JSON].Lock()
@@ -1176,6 +1241,34 @@ func (cfg *CGRConfig) SentryPeerCfg() *SentryPeerCfg {
 	return cfg.sentryPeerCfg
 }
 
+// TracingCfg reads the Tracing configuration
+func (cfg *CGRConfig) TracingCfg() *TracingCfg {
+	cfg.lks[TracingCfgJson].Lock()
+	defer cfg.lks[TracingCfgJson].Unlock()
+	return cfg.tracingCfg
+}
+
+// RBACCfg reads the RBAC configuration
+func (cfg *CGRConfig) RBACCfg() *RBACCfg {
+	cfg.lks[RBACCfgJson].Lock()
+	defer cfg.lks[RBACCfgJson].Unlock()
+	return cfg.rbacCfg
+}
+
+// AuditCfg reads the Audit configuration
+func (cfg *CGRConfig) AuditCfg() *AuditCfg {
+	cfg.lks[AuditCfgJson].Lock()
+	defer cfg.lks[AuditCfgJson].Unlock()
+	return cfg.auditCfg
+}
+
+// GeoIPCfg reads the GeoIP configuration
+func (cfg *CGRConfig) GeoIPCfg() *GeoIPCfg {
+	cfg.lks[GeoIPCfgJson].Lock()
+	defer cfg.lks[GeoIPCfgJson].Unlock()
+	return cfg.geoIPCfg
+}
+
 // CoreSCfg reads the CoreS configuration
 func (cfg *CGRConfig) CoreSCfg() *CoreSCfg {
 	cfg.lks[CoreSCfgJson].Lock()
@@ -1281,6 +1374,7 @@ func (cfg *CGRConfig) getLoadFunctions() map[string]func(*CgrJsonCfg) error {
 		STATS_JSON:          cfg.loadStatSCfg,
 		TRENDS_JSON:         cfg.loadTrendSCfg,
 		RANKINGS_JSON:       cfg.loadRankingSCfg,
+		FraudSJson:          cfg.loadFraudSCfg,
 		THRESHOLDS_JSON:     cfg.loadThresholdSCfg,
 		RouteSJson:          cfg.loadRouteSCfg,
 		MAILER_JSN:          cfg.loadMailerCfg,
@@ -1298,6 +1392,10 @@ func (cfg *CGRConfig) getLoadFunctions() map[string]func(*CgrJsonCfg) error {
 		ConfigSJson:         cfg.loadConfigSCfg,
 		APIBanCfgJson:       cfg.loadAPIBanCgrCfg,
 		SentryPeerCfgJson:   cfg.loadSentryPeerCgrCfg,
+		GeoIPCfgJson:        cfg.loadGeoIPCfg,
+		TracingCfgJson:      cfg.loadTracingCfg,
+		RBACCfgJson:         cfg.loadRBACCfg,
+		AuditCfgJson:        cfg.loadAuditCfg,
 		CoreSCfgJson:        cfg.loadCoreSCfg,
 		IPsJSON:             cfg.loadIPsCfg,
 	}
@@ -1458,9 +1556,9 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 	subsystemsThatNeedDataDB := utils.NewStringSet([]string{DATADB_JSN, SCHEDULER_JSN,
 		RALS_JSN, CDRS_JSN, SessionSJson, ATTRIBUTE_JSN,
 		ChargerSCfgJson, RESOURCES_JSON, STATS_JSON, THRESHOLDS_JSON,
-		RouteSJson, DispatcherSJson, ApierS, IPsJSON,
+		RouteSJson, DispatcherSJson, ApierS, IPsJSON, AuditCfgJson, FraudSJson,
 	})
-	subsystemsThatNeedStorDB := utils.NewStringSet([]string{STORDB_JSN, RALS_JSN, CDRS_JSN, ApierS})
+	subsystemsThatNeedStorDB := utils.NewStringSet([]string{STORDB_JSN, RALS_JSN, CDRS_JSN, ApierS, AuditCfgJson})
 	needsDataDB := false
 	needsStorDB := false
 	for _, section := range sections {
@@ -1497,6 +1595,13 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 		case APIBanCfgJson: // nothing to reload
 		case SentryPeerCfgJson:
 		case CoreSCfgJson: // nothing to reload
+		case GeoIPCfgJson:
+			cfg.rldChans[GeoIPCfgJson] <- struct{}{}
+		case TracingCfgJson:
+			cfg.rldChans[TracingCfgJson] <- struct{}{}
+		case RBACCfgJson: // nothing to reload
+		case AuditCfgJson:
+			cfg.rldChans[AuditCfgJson] <- struct{}{}
 		case HTTP_JSN:
 			cfg.rldChans[HTTP_JSN] <- struct{}{}
 		case SCHEDULER_JSN:
@@ -1537,6 +1642,8 @@ func (cfg *CGRConfig) reloadSections(sections ...string) {
 			cfg.rldChans[TRENDS_JSON] <- struct{}{}
 		case RANKINGS_JSON:
 			cfg.rldChans[RANKINGS_JSON] <- struct{}{}
+		case FraudSJson:
+			cfg.rldChans[FraudSJson] <- struct{}{}
 		case THRESHOLDS_JSON:
 			cfg.rldChans[THRESHOLDS_JSON] <- struct{}{}
 		case RouteSJson:
@@ -1591,12 +1698,13 @@ func (cfg *CGRConfig) AsMapInterface(separator string) (mp map[string]any) {
 		STATS_JSON:          cfg.statsCfg.AsMapInterface(),
 		TRENDS_JSON:         cfg.trendsCfg.AsMapInterface(),
 		RANKINGS_JSON:       cfg.rankingsCfg.AsMapInterface(),
+		FraudSJson:          cfg.fraudSCfg.AsMapInterface(),
 		THRESHOLDS_JSON:     cfg.thresholdSCfg.AsMapInterface(),
 		RouteSJson:          cfg.routeSCfg.AsMapInterface(),
 		SURETAX_JSON:        cfg.sureTaxCfg.AsMapInterface(separator),
 		DispatcherSJson:     cfg.dispatcherSCfg.AsMapInterface(),
 		RegistrarCJson:      cfg.registrarCCfg.AsMapInterface(),
-		CgrLoaderCfgJson:    cfg.loaderCgrCfg.AsMapInterface(),
+		CgrLoaderCfgJson:    cfg.loaderCgrCfg.AsMapInterface(separator),
 		CgrMigratorCfgJson:  cfg.migratorCgrCfg.AsMapInterface(),
 		MAILER_JSN:          cfg.mailerCfg.AsMapInterface(),
 		AnalyzerCfgJson:     cfg.analyzerSCfg.AsMapInterface(),
@@ -1604,6 +1712,10 @@ func (cfg *CGRConfig) AsMapInterface(separator string) (mp map[string]any) {
 		ERsJson:             cfg.ersCfg.AsMapInterface(separator),
 		APIBanCfgJson:       cfg.apiBanCfg.AsMapInterface(),
 		SentryPeerCfgJson:   cfg.sentryPeerCfg.AsMapInterface(),
+		GeoIPCfgJson:        cfg.geoIPCfg.AsMapInterface(),
+		TracingCfgJson:      cfg.tracingCfg.AsMapInterface(),
+		RBACCfgJson:         cfg.rbacCfg.AsMapInterface(),
+		AuditCfgJson:        cfg.auditCfg.AsMapInterface(),
 		EEsJson:             cfg.eesCfg.AsMapInterface(separator),
 		SIPAgentJson:        cfg.sipAgentCfg.AsMapInterface(separator),
 		TemplatesJson:       cfg.templates.AsMapInterface(separator),
@@ -1736,6 +1848,8 @@ func (cfg *CGRConfig) V1GetConfig(ctx *context.Context, args *SectionWithAPIOpts
 		mp = cfg.TrendSCfg().AsMapInterface()
 	case RANKINGS_JSON:
 		mp = cfg.RankingSCfg().AsMapInterface()
+	case FraudSJson:
+		mp = cfg.FraudSCfg().AsMapInterface()
 	case THRESHOLDS_JSON:
 		mp = cfg.ThresholdSCfg().AsMapInterface()
 	case RouteSJson:
@@ -1747,7 +1861,7 @@ func (cfg *CGRConfig) V1GetConfig(ctx *context.Context, args *SectionWithAPIOpts
 	case RegistrarCJson:
 		mp = cfg.RegistrarCCfg().AsMapInterface()
 	case CgrLoaderCfgJson:
-		mp = cfg.LoaderCgrCfg().AsMapInterface()
+		mp = cfg.LoaderCgrCfg().AsMapInterface(cfg.GeneralCfg().RSRSep)
 	case CgrMigratorCfgJson:
 		mp = cfg.MigratorCgrCfg().AsMapInterface()
 	case ApierS:
@@ -1768,6 +1882,14 @@ func (cfg *CGRConfig) V1GetConfig(ctx *context.Context, args *SectionWithAPIOpts
 		mp = cfg.APIBanCfg().AsMapInterface()
 	case SentryPeerCfgJson:
 		mp = cfg.SentryPeerCfg().AsMapInterface()
+	case GeoIPCfgJson:
+		mp = cfg.GeoIPCfg().AsMapInterface()
+	case TracingCfgJson:
+		mp = cfg.TracingCfg().AsMapInterface()
+	case RBACCfgJson:
+		mp = cfg.RBACCfg().AsMapInterface()
+	case AuditCfgJson:
+		mp = cfg.AuditCfg().AsMapInterface()
 	case HttpAgentJson:
 		mp = cfg.HTTPAgentCfg().AsMapInterface(cfg.GeneralCfg().RSRSep)
 	case MAILER_JSN:
@@ -1907,6 +2029,8 @@ func (cfg *CGRConfig) V1GetConfigAsJSON(ctx *context.Context, args *SectionWithA
 		mp = cfg.TrendSCfg().AsMapInterface()
 	case RANKINGS_JSON:
 		mp = cfg.RankingSCfg().AsMapInterface()
+	case FraudSJson:
+		mp = cfg.FraudSCfg().AsMapInterface()
 	case THRESHOLDS_JSON:
 		mp = cfg.ThresholdSCfg().AsMapInterface()
 	case RouteSJson:
@@ -1918,7 +2042,7 @@ func (cfg *CGRConfig) V1GetConfigAsJSON(ctx *context.Context, args *SectionWithA
 	case RegistrarCJson:
 		mp = cfg.RegistrarCCfg().AsMapInterface()
 	case CgrLoaderCfgJson:
-		mp = cfg.LoaderCgrCfg().AsMapInterface()
+		mp = cfg.LoaderCgrCfg().AsMapInterface(cfg.GeneralCfg().RSRSep)
 	case CgrMigratorCfgJson:
 		mp = cfg.MigratorCgrCfg().AsMapInterface()
 	case ApierS:
@@ -1935,6 +2059,14 @@ func (cfg *CGRConfig) V1GetConfigAsJSON(ctx *context.Context, args *SectionWithA
 		mp = cfg.APIBanCfg().AsMapInterface()
 	case SentryPeerCfgJson:
 		mp = cfg.SentryPeerCfg().AsMapInterface()
+	case GeoIPCfgJson:
+		mp = cfg.GeoIPCfg().AsMapInterface()
+	case TracingCfgJson:
+		mp = cfg.TracingCfg().AsMapInterface()
+	case RBACCfgJson:
+		mp = cfg.RBACCfg().AsMapInterface()
+	case AuditCfgJson:
+		mp = cfg.AuditCfg().AsMapInterface()
 	case RPCConnsJsonName:
 		mp = cfg.RPCConns().AsMapInterface()
 	case TemplatesJson:
@@ -2032,6 +2164,7 @@ func (cfg *CGRConfig) Clone() (cln *CGRConfig) {
 		statsCfg:           cfg.statsCfg.Clone(),
 		trendsCfg:          cfg.trendsCfg.Clone(),
 		rankingsCfg:        cfg.rankingsCfg.Clone(),
+		fraudSCfg:          cfg.fraudSCfg.Clone(),
 		thresholdSCfg:      cfg.thresholdSCfg.Clone(),
 		routeSCfg:          cfg.routeSCfg.Clone(),
 		sureTaxCfg:         cfg.sureTaxCfg.Clone(),
@@ -2048,6 +2181,10 @@ func (cfg *CGRConfig) Clone() (cln *CGRConfig) {
 		configSCfg:         cfg.configSCfg.Clone(),
 		apiBanCfg:          cfg.apiBanCfg.Clone(),
 		sentryPeerCfg:      cfg.sentryPeerCfg.Clone(),
+		geoIPCfg:           cfg.geoIPCfg.Clone(),
+		tracingCfg:         cfg.tracingCfg.Clone(),
+		rbacCfg:            cfg.rbacCfg.Clone(),
+		auditCfg:           cfg.auditCfg.Clone(),
 		coreSCfg:           cfg.coreSCfg.Clone(),
 		ipsCfg:             cfg.ipsCfg.Clone(),
 
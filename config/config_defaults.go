@@ -52,7 +52,8 @@ const CGRATES_CFG_JSON = `
 	"digest_separator": ",",				// separator to use in replies containing data digests
 	"digest_equal": ":",					// equal symbol used in case of digests
 	"rsr_separator": ";",					// separator used within RSR fields
-	"max_parallel_conns": 100				// the maximum number of connection used by the *parallel strategy
+	"max_parallel_conns": 100,				// the maximum number of connection used by the *parallel strategy
+	"subscriber_queue_len": 1000			// notifications buffered per subscriber before dropping them
 },
 
 
@@ -60,7 +61,9 @@ const CGRATES_CFG_JSON = `
 	"caps": 0,			// maximum concurrent request allowed ( 0 to disabled )
 	"caps_strategy": "*busy",	// strategy in case of concurrent requests reached	
 	"caps_stats_interval": "0",	// the interval duration we sample for caps stats ( 0 to disabled )
-	"shutdown_timeout": "1s"	// the duration to wait until all services are stopped
+	"shutdown_timeout": "1s",	// the duration to wait until all services are stopped
+	"config_watch": false,		// watch the config directory, hot-applying the changed sections
+	"config_watch_delay": "1s"	// wait for the config files to settle before reloading them
 },
 
 
@@ -99,9 +102,21 @@ const CGRATES_CFG_JSON = `
 	"replication_cache": "", 		// the caching action that is executed on the replication_conns when the items are replicated 
 	"replication_failed_dir": "", 		// directory for failed batch replications (used when interval > 0)
 	"replication_interval": "", 		// interval between batched replications (0 for immediate)
-	"items":{
+	"cdc_ees_conns": [],			// connections to EEs for the changes of the items with "cdc": true, empty to disable the export: <""|*internal|$rpc_conns_id>
+	"cdc_ees_exporter_ids": [],		// list of EventExporter profiles to use for the changes
+	"cdc_retry_interval": "1s",		// wait between the attempts to export a change
+	"cdc_queue_len": 10000,			// changes kept in memory by each of the export queues, the next ones going to the cdc_failed_dir or being dropped without it
+	"cdc_failed_dir": "",			// directory where the changes waiting for a failed export are kept, also across the restarts
+	"items":{				// "revisions": N on a profile item keeps its previous N versions for the rollback, "cdc": true exports its changes
 		"*accounts": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*reverse_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*ported_numbers": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*lookup_tables": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*discount_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*fraud_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*fraud_cases": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*fraud_filter_indexes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*discount_filter_indexes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*rating_plans": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*rating_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -141,6 +156,8 @@ const CGRATES_CFG_JSON = `
 		"*dispatcher_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*reverse_filter_indexes" : {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*sessions_backup": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false}, 
+		"*revisions": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*datadb_migration": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 	},
 	"opts":{
 		"internalDBDumpPath": "/var/lib/cgrates/internal_db/datadb",		// the path where datadb will be dumped
@@ -204,6 +221,7 @@ const CGRATES_CFG_JSON = `
 	"items":{
 		"*session_costs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*cdrs": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*audit_records": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_timings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_rates": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -219,6 +237,7 @@ const CGRATES_CFG_JSON = `
 		"*tp_ips": {"limit": -1, "ttl": "", "static_ttl": false, "remote": false, "replicate": false},
 		"*tp_stats": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_rankings": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
+		"*tp_lookup_tables": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_trends": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_thresholds": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
 		"*tp_filters": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate":false},
@@ -241,6 +260,8 @@ const CGRATES_CFG_JSON = `
 	"http_tls": "127.0.0.1:2280",		// HTTP TLS listening address
 	"birpc_json": "127.0.0.1:2014",		// address where to listen for bidirectional JSON-RPC requests(for agents<->sessions and sessions<->thresholds)
 	"birpc_gob": "",					// address where to listen for bidirectional GOB-RPC requests (for agents<->sessions and sessions<->thresholds)
+	"grpc": "",				// gRPC listening address, empty to disable
+	"grpc_tls": "",				// gRPC TLS listening address, empty to disable
 },
 
 
@@ -301,6 +322,13 @@ const CGRATES_CFG_JSON = `
 	"partitions": {
 		"*destinations": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// destination caching
 		"*reverse_destinations": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// reverse destinations index caching
+		"*ported_numbers": {"limit": 100000, "ttl": "1h", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control ported numbers caching
+		"*lookup_tables": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control lookup tables caching
+		"*discount_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control discount profiles caching
+		"*fraud_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control fraud profiles caching
+		"*fraud_cases": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// control fraud cases caching
+		"*fraud_filter_indexes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},	// control fraud filter indexes caching
+		"*discount_filter_indexes": {"limit": -1, "ttl": "", "static_ttl": false, "remote":false, "replicate": false},	// control discount filter indexes caching
 		"*rating_plans": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// rating plans caching
 		"*rating_profiles": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},	// rating profiles caching
 		"*actions": {"limit": -1, "ttl": "", "static_ttl": false, "precache": false, "remote":false, "replicate": false},		// actions caching
@@ -389,7 +417,14 @@ const CGRATES_CFG_JSON = `
 	"balance_rating_subject":{		// default rating subject in case that balance rating subject is empty
 		"*any": "*zero1ns",
 		"*voice": "*zero1s"
-	}
+	},
+	"discounts": false,			// apply the matching DiscountProfiles on debits and cost queries
+	"discounts_indexed_selects": true,	// enable profile matching exclusively on indexes for the DiscountProfiles
+	//"discounts_string_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"discounts_prefix_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"discounts_suffix_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"discounts_exists_indexed_fields": [],	// query indexes based on these fields for faster processing
+	"discounts_nested_fields": false,	// determines which field is checked when matching indexed filters(true: all; false: only the one on the first level)
 
 },
 
@@ -405,9 +440,25 @@ const CGRATES_CFG_JSON = `
 	"attributes_conns": [],		// connection to AttributeS for altering *raw CDRs, empty to disable attributes functionality: <""|*internal|$rpc_conns_id>
 	"thresholds_conns": [],		// connection to ThresholdS for CDR reporting, empty to disable thresholds functionality: <""|*internal|$rpc_conns_id>
 	"stats_conns": [],		// connections to StatS for CDR reporting, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
+	"routes_conns": [],		// connections to RouteS for feeding the route breakers, empty to disable: <""|*internal|$rpc_conns_id>
 	"online_cdr_exports":[],	// list of CDRE profiles to use for real-time CDR exports
 	"scheduler_conns": [],		// connections to SchedulerS in case of *dynaprepaid request
-	"ees_conns": []			// connections to EventExporter
+	"ees_conns": [],		// connections to EventExporter
+	"retention": {			// retention of the CDRs and their SMCosts within StorDB
+		"purge_interval": "0s",				// interval of the job purging and pseudonymising the CDRs, 0 to disable the job
+		"policies": [					// the CDRs matching more policies get the shortest of their durations
+			// {
+			// 	"tenants": [],			// tenants of the CDRs, empty for all
+			// 	"tors": [],			// ToRs of the CDRs, empty for all
+			// 	"max_age": "0s",		// CDRs with the SetupTime older than this are removed with their SMCosts, 0 to keep them
+			// 	"pseudonymise_after": "0s",	// grace period after the SetupTime before pseudonymising the CDRs, 0 to disable
+			// },
+		],
+		"pseudonymise_method": "*hash",			// pseudonymisation of the fields: <*hash|*mask>
+		"pseudonymise_fields": ["Account", "Subject", "Destination"],	// CDR fields pseudonymised, other names select the extra fields
+		"pseudonymise_salt": "",				// secret salting the *hash values
+		"mask_keep_prefix": 3,				// characters left visible at the start of the *mask values
+	},
 },
 
 
@@ -676,6 +727,7 @@ const CGRATES_CFG_JSON = `
 	"resources_conns": [],			// connections to ResourceS for resources monitoring <""|*internal|$rpc_conns_id>
 	"thresholds_conns": [],			// connections to ThresholdS for reporting session events <""|*internal|$rpc_conns_id>
 	"stats_conns": [],			// connections to StatS for reporting session events <""|*internal|$rpc_conns_id>
+	"frauds_conns": [],			// connections to FraudS for scoring session events <""|*internal|$rpc_conns_id>
 	"routes_conns": [],			// connections to RouteS for querying routes for event <""|*internal|$rpc_conns_id>
 	"attributes_conns": [],			// connections to AttributeS for altering event fields <""|*internal|$rpc_conns_id>
 	"replication_conns": [],		// replicate sessions towards these session services
@@ -966,6 +1018,21 @@ const CGRATES_CFG_JSON = `
 },
 
 
+"frauds": {					// FraudS config
+	"enabled": false,			// starts FraudS service: <true|false>.
+	"indexed_selects": true,		// enable profile matching exclusively on indexes
+	//"string_indexed_fields": [],		// query indexes based on these fields for faster processing
+	"prefix_indexed_fields": [],		// query indexes based on these fields for faster processing
+	"suffix_indexed_fields": [],		// query indexes based on these fields for faster processing
+	"exists_indexed_fields": [],		// query indexes based on these fields for faster processing
+	"nested_fields": false,			// determines which field is checked when matching indexed filters(true: all; false: only the one on the first level)
+	"resources_conns": [],			// connections to ResourceS for the *throttle action <""|*internal|$rpc_conns_id>
+	"sessions_conns": [],			// connections to SessionS for *concurrent_calls and *terminate_sessions <""|*internal|$rpc_conns_id>
+	"max_evidence": 100,			// scored events kept on each fraud case, 0 for unlimited
+	"trackers_ttl": "24h"			// drop the *velocity and *cost_spike history of the subjects without events for this long, 0 to keep it: <""|$dur>
+},
+
+
 "thresholds": {					// ThresholdS
 	"enabled": false,			// starts ThresholdS service: <true|false>.
 	"store_interval": "",			// dump cache regularly to dataDB, 0 - dump at start/shutdown: <""|$dur>
@@ -999,6 +1066,12 @@ const CGRATES_CFG_JSON = `
 	"stats_conns": [],		// connections to StatS for *stats sorting, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
 	"rals_conns": [],		// connections to Rater for calculating cost, empty to disable stats functionality: <""|*internal|$rpc_conns_id>
 	"default_ratio":1,		// default ratio used in case of *load strategy
+	"breaker": {			// circuit breakers for the routes, fed by the events processed through RouteSv1.ProcessEvent
+		"failure_threshold": 0,				// consecutive failures opening the breaker of a route, 0 to disable the breakers
+		"cooldown": "30s",				// duration an open route is skipped before letting probe traffic through
+		"half_open_probes": 1,				// number of route queries a half-open route is offered to before waiting for their outcome
+		"failure_filters": ["*prefix:~*req.DisconnectCause:5|408"],	// filters matching the events reporting a failed call
+	},
 	"opts": {
 		"*context": "*routes",
 		// "*profileCount": 1,
@@ -1055,7 +1128,26 @@ const CGRATES_CFG_JSON = `
 	"caches_conns":["*localhost"],
 	"scheduler_conns": ["*localhost"],
 	"gapi_credentials": ".gapi/credentials.json", 	// the path to the credentials for google API or the credentials.json file content
-	"gapi_token": ".gapi/token.json" 		// the path to the token for google API or the token.json file content
+	"gapi_token": ".gapi/token.json", 		// the path to the token for google API or the token.json file content
+	"rate_decks": {					// layouts of the carrier rate decks, imported via APIerSv1.ImportRateDeck or cgr-loader -rate_deck
+		"*default": {
+			"field_separator": ",",			// separator used within the deck file
+			"header_lines": 1,			// number of lines skipped at the beginning, the last one names the columns
+			"timezone": "",				// timezone of the effective dates, empty for the general one
+			"full_deck": false,			// delete the codes missing from the deck
+			"deleted_values": [],			// values of the change field marking the deleted codes
+			"rounding_method": "*up",		// rounding method of the generated rates
+			"rounding_decimals": 4,			// rounding decimals of the generated rates
+			"prefix": "~*req.0",			// the dialing code <RSRParsers>
+			"destination": "~*req.1",		// the destination name, used in the change report <RSRParsers>
+			"rate": "~*req.2",			// the rate per rate unit <RSRParsers>
+			"connect_fee": "0",			// <RSRParsers>
+			"rate_unit": "60s",			// <RSRParsers>
+			"rate_increment": "60s",		// <RSRParsers>
+			"effective_date": "~*req.3",		// the date the rate applies from, empty for immediately <RSRParsers>
+			"change": "",				// the change indicator of the carrier, checked against deleted_values <RSRParsers>
+		},
+	},
 },
 
 
@@ -1141,6 +1233,9 @@ const CGRATES_CFG_JSON = `
 	"scheduler_conns": [],		// connections to SchedulerS for reloads
 	"attributes_conns": [],		// connections to AttributeS for CDRExporter
 	"ees_conns": [],		// connections to EEs
+	"thresholds_conns": [],		// connections to ThresholdS for the index health findings, empty to disable: <""|*internal|$rpc_conns_id>
+	"index_health_interval": "0",	// interval to check the health of the indexes, findings being published to ThresholdS/EEs: <""|0 to disable|$dur>
+	"index_health_repair": false,	// repair the broken indexes found by the scheduled checks instead of only reporting them
 },
 
 
@@ -1352,6 +1447,46 @@ const CGRATES_CFG_JSON = `
 },
 
 
+"geoip": {
+	"city_db_path": "",		// path to the MaxMind Country or City database(.mmdb) used by *geoip_country and *geoip_city
+	"asn_db_path": "",		// path to the MaxMind ASN database(.mmdb) used by *geoip_asn
+},
+
+
+"tracing": {
+	"enabled": false,					// starts recording the API calls as spans of distributed traces
+	"sample_ratio": 1,					// ratio of the traces started by this engine which are recorded <0-1>
+	"exporters": ["*memory"],			// where the spans are sent <*memory|*file|*otlp>
+	"memory_limit": 10000,				// number of spans kept by the *memory exporter(<=0 for no limit)
+	"file_path": "/var/log/cgrates/traces.json",	// file where the *file exporter appends the spans as JSON lines
+	"otlp_url": "http://127.0.0.1:4318/v1/traces",	// OTLP/HTTP endpoint of the collector used by the *otlp exporter
+	"export_interval": "1s",			// interval between the batches sent by the *otlp exporter
+	"db_spans": false,					// creates spans for the DataDB and StorDB queries, read at start
+},
+
+
+"rbac": {
+	"enabled": false,					// checks the API keys of the requests received on the JSON, GOB, HTTP, BiRPC and gRPC listeners
+	"default_role": "",					// role of the requests without API key, empty to deny them
+	"roles": {},						// roles of the API keys, e.g.: "reseller": {"methods": ["APIerSv1.Get*", "SessionSv1.*"], "tenants": ["cgrates.org"]}
+	"api_keys": {},						// API keys received in the *apiKey APIOpts, e.g.: "key1": {"role": "reseller", "tenants": []}
+},
+
+
+"audit": {
+	"enabled": false,					// records the data changing API calls received on the JSON, GOB, HTTP, BiRPC and gRPC listeners
+	"methods": [						// patterns of the audited API methods
+		"APIerSv1.Set*", "APIerSv1.Remove*", "APIerSv1.Add*", "APIerSv1.Debit*",
+		"APIerSv1.Load*", "APIerSv1.Import*", "APIerSv1.ExecuteAction",
+		"APIerSv2.Set*", "APIerSv2.Remove*", "APIerSv2.Load*",
+		"ConfigSv1.SetConfig*", "ConfigSv1.ReloadConfig"
+	],
+	"store": true,						// stores the audit records in StorDB
+	"ees_conns": [],					// connections to EEs for the audit records, empty to disable the export: <""|*internal|$rpc_conns_id>
+	"ees_exporter_ids": [],				// list of EventExporter profiles to use for the audit records
+},
+
+
 "ips": {
 	"enabled": false,		// enables the IPs service: <true|false>
 	"store_interval": "",		// dump cache regularly to dataDB, 0 - dump at start/shutdown: <""|$dur>
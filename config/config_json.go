@@ -47,6 +47,7 @@ const (
 	THRESHOLDS_JSON     = "thresholds"
 	TRENDS_JSON         = "trends"
 	RANKINGS_JSON       = "rankings"
+	FraudSJson          = "frauds"
 	RouteSJson          = "routes"
 	MAILER_JSN          = "mailer"
 	SURETAX_JSON        = "suretax"
@@ -68,6 +69,10 @@ const (
 	ConfigSJson         = "configs"
 	APIBanCfgJson       = "apiban"
 	SentryPeerCfgJson   = "sentrypeer"
+	GeoIPCfgJson        = "geoip"
+	TracingCfgJson      = "tracing"
+	RBACCfgJson         = "rbac"
+	AuditCfgJson        = "audit"
 	CoreSCfgJson        = "cores"
 	IPsJSON             = "ips"
 )
@@ -75,9 +80,9 @@ const (
 var (
 	sortedCfgSections = []string{GENERAL_JSN, RPCConnsJsonName, DATADB_JSN, STORDB_JSN, LISTEN_JSN, TlsCfgJson, HTTP_JSN, SCHEDULER_JSN,
 		CACHE_JSN, FilterSjsn, RALS_JSN, CDRS_JSN, ERsJson, SessionSJson, AsteriskAgentJSN, FreeSWITCHAgentJSN, KamailioAgentJSN,
-		DA_JSN, RA_JSN, HttpAgentJson, DNSAgentJson, PrometheusAgentJSON, ATTRIBUTE_JSN, ChargerSCfgJson, RESOURCES_JSON, STATS_JSON, TRENDS_JSON, RANKINGS_JSON,
+		DA_JSN, RA_JSN, HttpAgentJson, DNSAgentJson, PrometheusAgentJSON, ATTRIBUTE_JSN, ChargerSCfgJson, RESOURCES_JSON, STATS_JSON, TRENDS_JSON, RANKINGS_JSON, FraudSJson,
 		THRESHOLDS_JSON, RouteSJson, MAILER_JSN, SURETAX_JSON, CgrLoaderCfgJson, CgrMigratorCfgJson, DispatcherSJson, JanusAgentJson,
-		AnalyzerCfgJson, ApierS, EEsJson, SIPAgentJson, RegistrarCJson, TemplatesJson, ConfigSJson, APIBanCfgJson, SentryPeerCfgJson, CoreSCfgJson, IPsJSON}
+		AnalyzerCfgJson, ApierS, EEsJson, SIPAgentJson, RegistrarCJson, TemplatesJson, ConfigSJson, APIBanCfgJson, SentryPeerCfgJson, GeoIPCfgJson, TracingCfgJson, RBACCfgJson, AuditCfgJson, CoreSCfgJson, IPsJSON}
 )
 
 // Loads the json config out of io.Reader, eg other sources than file, maybe over http
@@ -396,6 +401,18 @@ func (jsnCfg CgrJsonCfg) TrendsJsonCfg() (*TrendsJsonCfg, error) {
 	return cfg, nil
 }
 
+func (jsnCfg CgrJsonCfg) FraudSJsonCfg() (*FraudSJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[FraudSJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(FraudSJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func (jsnCfg CgrJsonCfg) RankingsJsonCfg() (*RankingsJsonCfg, error) {
 	rawCfg, hasKey := jsnCfg[RANKINGS_JSON]
 	if !hasKey {
@@ -612,6 +629,54 @@ func (jsnCfg CgrJsonCfg) SentryPeerJson() (*SentryPeerJsonCfg, error) {
 	return cfg, nil
 }
 
+func (jsnCfg CgrJsonCfg) GeoIPJson() (*GeoIPJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[GeoIPCfgJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(GeoIPJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (jsnCfg CgrJsonCfg) AuditJson() (*AuditJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[AuditCfgJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(AuditJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (jsnCfg CgrJsonCfg) RBACJson() (*RBACJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[RBACCfgJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(RBACJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (jsnCfg CgrJsonCfg) TracingJson() (*TracingJsonCfg, error) {
+	rawCfg, hasKey := jsnCfg[TracingCfgJson]
+	if !hasKey {
+		return nil, nil
+	}
+	cfg := new(TracingJsonCfg)
+	if err := json.Unmarshal(*rawCfg, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func (jsnCfg CgrJsonCfg) CoreSCfgJson() (*CoreSJsonCfg, error) {
 	rawCfg, hasKey := jsnCfg[CoreSCfgJson]
 	if !hasKey {
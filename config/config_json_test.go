@@ -52,6 +52,7 @@ func TestDfGeneralJsonCfg(t *testing.T) {
 		Digest_equal:           utils.StringPointer(":"),
 		Rsr_separator:          utils.StringPointer(";"),
 		Max_parallel_conns:     utils.IntPointer(100),
+		Subscriber_queue_len:   utils.IntPointer(1000),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -70,6 +71,8 @@ func TestDfCoreSJsonCfg(t *testing.T) {
 		Caps_strategy:       utils.StringPointer(utils.MetaBusy),
 		Caps_stats_interval: utils.StringPointer("0"),
 		Shutdown_timeout:    utils.StringPointer("1s"),
+		Config_watch:        utils.BoolPointer(false),
+		Config_watch_delay:  utils.StringPointer("1s"),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -91,6 +94,27 @@ func TestCacheJsonCfg(t *testing.T) {
 			utils.CacheReverseDestinations: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CachePortedNumbers: {Limit: utils.IntPointer(100000),
+				Ttl: utils.StringPointer("1h"), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheLookupTables: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheDiscountProfiles: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheFraudProfiles: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheFraudCases: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheFraudFilterIndexes: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
+			utils.CacheDiscountFilterIndexes: {Limit: utils.IntPointer(-1),
+				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
+				Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
 			utils.CacheRatingPlans: {Limit: utils.IntPointer(-1),
 				Ttl: utils.StringPointer(""), Static_ttl: utils.BoolPointer(false),
 				Precache: utils.BoolPointer(false), Remote: utils.BoolPointer(false), Replicate: utils.BoolPointer(false)},
@@ -280,6 +304,8 @@ func TestDfListenJsonCfg(t *testing.T) {
 		Http_tls:     utils.StringPointer("127.0.0.1:2280"),
 		Birpc_json:   utils.StringPointer("127.0.0.1:2014"),
 		Birpc_gob:    utils.StringPointer(""),
+		Grpc:         utils.StringPointer(""),
+		Grpc_tls:     utils.StringPointer(""),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -307,6 +333,11 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 		Replication_cache:    utils.StringPointer(""),
 		RplFailedDir:         utils.StringPointer(""),
 		RplInterval:          utils.StringPointer(""),
+		Cdc_ees_conns:        &[]string{},
+		Cdc_ees_exporter_ids: &[]string{},
+		Cdc_retry_interval:   utils.StringPointer("1s"),
+		Cdc_queue_len:        utils.IntPointer(10000),
+		Cdc_failed_dir:       utils.StringPointer(""),
 		Opts: &DBOptsJson{
 			InternalDBDumpPath:        utils.StringPointer("/var/lib/cgrates/internal_db/datadb"),
 			InternalDBBackupPath:      utils.StringPointer("/var/lib/cgrates/internal_db/backup/datadb"),
@@ -346,6 +377,55 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaPortedNumbers: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaLookupTables: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaDiscountProfiles: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaFraudProfiles: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaFraudCases: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.CacheFraudFilterIndexes: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.CacheDiscountFilterIndexes: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.MetaDestinations: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -620,6 +700,20 @@ func TestDfDataDbJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.MetaRevisions: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
+			utils.MetaDataDBMigration: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 		},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
@@ -767,6 +861,13 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheTBLTPLookupTables: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheTBLTPThresholds: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -837,6 +938,13 @@ func TestDfStorDBJsonCfg(t *testing.T) {
 				Ttl:        utils.StringPointer(utils.EmptyString),
 				Static_ttl: utils.BoolPointer(false),
 			},
+			utils.CacheAuditRecordsTBL: {
+				Replicate:  utils.BoolPointer(false),
+				Remote:     utils.BoolPointer(false),
+				Limit:      utils.IntPointer(-1),
+				Ttl:        utils.StringPointer(utils.EmptyString),
+				Static_ttl: utils.BoolPointer(false),
+			},
 			utils.CacheVersions: {
 				Replicate:  utils.BoolPointer(false),
 				Remote:     utils.BoolPointer(false),
@@ -878,6 +986,13 @@ func TestDfRalsJsonCfg(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		Discounts: utils.BoolPointer(false),
+
+		Discounts_indexed_selects:       utils.BoolPointer(true),
+		Discounts_prefix_indexed_fields: &[]string{},
+		Discounts_suffix_indexed_fields: &[]string{},
+		Discounts_exists_indexed_fields: &[]string{},
+		Discounts_nested_fields:         utils.BoolPointer(false),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -922,9 +1037,18 @@ func TestDfCdrsJsonCfg(t *testing.T) {
 		Attributes_conns:     &[]string{},
 		Thresholds_conns:     &[]string{},
 		Stats_conns:          &[]string{},
+		Routes_conns:         &[]string{},
 		Online_cdr_exports:   &[]string{},
 		Scheduler_conns:      &[]string{},
 		Ees_conns:            &[]string{},
+		Retention: &CDRRetentionJsonCfg{
+			Purge_interval:      utils.StringPointer("0s"),
+			Policies:            &[]*CDRRetentionPolicyJsonCfg{},
+			Pseudonymise_method: utils.StringPointer(utils.MetaHash),
+			Pseudonymise_fields: &[]string{utils.AccountField, utils.Subject, utils.Destination},
+			Pseudonymise_salt:   utils.StringPointer(""),
+			Mask_keep_prefix:    utils.IntPointer(3),
+		},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -948,6 +1072,7 @@ func TestSmgJsonCfg(t *testing.T) {
 		ResourceSConns:         &[]string{},
 		ThresholdSConns:        &[]string{},
 		StatSConns:             &[]string{},
+		FraudSConns:            &[]string{},
 		RouteSConns:            &[]string{},
 		AttributeSConns:        &[]string{},
 		ReplicationConns:       &[]string{},
@@ -1367,6 +1492,12 @@ func TestDfRouteSJsonCfg(t *testing.T) {
 		Rals_conns:            &[]string{},
 		Default_ratio:         utils.IntPointer(1),
 		Nested_fields:         utils.BoolPointer(false),
+		Breaker: &RouteBreakerJsonCfg{
+			Failure_threshold: utils.IntPointer(0),
+			Cooldown:          utils.StringPointer("30s"),
+			Half_open_probes:  utils.IntPointer(1),
+			Failure_filters:   &[]string{"*prefix:~*req.DisconnectCause:5|408"},
+		},
 		Opts: &RoutesOptsJson{
 			Context:      utils.StringPointer(utils.MetaRoutes),
 			IgnoreErrors: utils.BoolPointer(false),
@@ -1515,6 +1646,25 @@ func TestDfLoaderCfg(t *testing.T) {
 		Scheduler_conns:  &[]string{utils.MetaLocalHost},
 		Gapi_credentials: &cred,
 		Gapi_token:       &tok,
+		Rate_decks: map[string]*RateDeckJsonCfg{
+			utils.MetaDefault: {
+				Field_separator:   utils.StringPointer(","),
+				Header_lines:      utils.IntPointer(1),
+				Timezone:          utils.StringPointer(""),
+				Full_deck:         utils.BoolPointer(false),
+				Deleted_values:    &[]string{},
+				Rounding_method:   utils.StringPointer(utils.MetaRoundingUp),
+				Rounding_decimals: utils.IntPointer(4),
+				Prefix:            utils.StringPointer("~*req.0"),
+				Destination:       utils.StringPointer("~*req.1"),
+				Rate:              utils.StringPointer("~*req.2"),
+				Connect_fee:       utils.StringPointer("0"),
+				Rate_unit:         utils.StringPointer("60s"),
+				Rate_increment:    utils.StringPointer("60s"),
+				Effective_date:    utils.StringPointer("~*req.3"),
+				Change:            utils.StringPointer(""),
+			},
+		},
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -1617,11 +1767,14 @@ func TestDfAnalyzerCfg(t *testing.T) {
 
 func TestDfApierCfg(t *testing.T) {
 	eCfg := &ApierJsonCfg{
-		Enabled:          utils.BoolPointer(false),
-		Caches_conns:     &[]string{utils.MetaInternal},
-		Scheduler_conns:  &[]string{},
-		Attributes_conns: &[]string{},
-		Ees_conns:        &[]string{},
+		Enabled:               utils.BoolPointer(false),
+		Caches_conns:          &[]string{utils.MetaInternal},
+		Scheduler_conns:       &[]string{},
+		Attributes_conns:      &[]string{},
+		Ees_conns:             &[]string{},
+		Thresholds_conns:      &[]string{},
+		Index_health_interval: utils.StringPointer("0"),
+		Index_health_repair:   utils.BoolPointer(false),
 	}
 	dfCgrJSONCfg, err := NewCgrJsonCfgFromBytes([]byte(CGRATES_CFG_JSON))
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path"
 	"slices"
 	"strings"
 
@@ -101,6 +102,14 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.CDRs, connID)
 			}
 		}
+		for _, connID := range cfg.cdrsCfg.RouteSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.routeSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.RouteS, utils.CDRs)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.CDRs, connID)
+			}
+		}
 		for _, connID := range cfg.cdrsCfg.ThresholdSConns {
 			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.thresholdSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ThresholdS, utils.CDRs)
@@ -127,6 +136,25 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.CDRs, connID)
 			}
 		}
+		if rtCfg := cfg.cdrsCfg.Retention; rtCfg != nil {
+			if rtCfg.PurgeInterval < 0 {
+				return fmt.Errorf("<%s> negative %s in %s", utils.CDRs, utils.PurgeIntervalCfg, utils.RetentionCfg)
+			}
+			if rtCfg.PseudonymiseMethod != utils.MetaHash && rtCfg.PseudonymiseMethod != utils.MetaMask {
+				return fmt.Errorf("<%s> unsupported %s <%s>", utils.CDRs, utils.PseudonymiseMethodCfg, rtCfg.PseudonymiseMethod)
+			}
+			if rtCfg.MaskKeepPrefix < 0 {
+				return fmt.Errorf("<%s> negative %s in %s", utils.CDRs, utils.MaskKeepPrefixCfg, utils.RetentionCfg)
+			}
+			for _, pol := range rtCfg.Policies {
+				if pol.MaxAge < 0 || pol.PseudonymiseAfter < 0 {
+					return fmt.Errorf("<%s> negative duration in %s policy", utils.CDRs, utils.RetentionCfg)
+				}
+				if pol.PseudonymiseAfter != 0 && len(rtCfg.PseudonymiseFields) == 0 {
+					return fmt.Errorf("<%s> %s required to pseudonymise the CDRs", utils.CDRs, utils.PseudonymiseFieldsCfg)
+				}
+			}
+		}
 	}
 	// SessionS checks
 	if cfg.sessionSCfg.Enabled {
@@ -190,6 +218,14 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.SessionS, connID)
 			}
 		}
+		for _, connID := range cfg.sessionSCfg.FraudSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.fraudSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.FraudS, utils.SessionS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.SessionS, connID)
+			}
+		}
 		for _, connID := range cfg.sessionSCfg.RouteSConns {
 			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.routeSCfg.Enabled {
 				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.RouteS, utils.SessionS)
@@ -746,6 +782,37 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			}
 		}
 	}
+	// FraudS checks
+	if cfg.fraudSCfg.Enabled {
+		for _, connID := range cfg.fraudSCfg.ResourceSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.resourceSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ResourceS, utils.FraudS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.FraudS, connID)
+			}
+		}
+		for _, connID := range cfg.fraudSCfg.SessionSConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.sessionSCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.SessionS, utils.FraudS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.FraudS, connID)
+			}
+		}
+		if cfg.fraudSCfg.MaxEvidence < 0 {
+			return fmt.Errorf("<%s> negative %s: %d", utils.FraudS, utils.MaxEvidenceCfg, cfg.fraudSCfg.MaxEvidence)
+		}
+	}
+	// rate deck layouts checks
+	for id, rd := range cfg.loaderCgrCfg.RateDecks {
+		if len(rd.FieldSeparator) != 1 {
+			return fmt.Errorf("<%s> rate deck <%s> invalid %s: %q", utils.CgrLoader, id, utils.FieldSepCfg, rd.FieldSeparator)
+		}
+		if len(rd.Prefix) == 0 || len(rd.Rate) == 0 {
+			return fmt.Errorf("<%s> rate deck <%s> needs the %s and %s fields", utils.CgrLoader, id, utils.PrefixCfg, utils.RateCfg)
+		}
+	}
 	//TrendS checks
 	if cfg.trendsCfg.Enabled {
 		for _, connID := range cfg.trendsCfg.StatSConns {
@@ -791,6 +858,17 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.RouteS, connID)
 			}
 		}
+		if cfg.routeSCfg.Breaker.FailureThreshold < 0 {
+			return fmt.Errorf("<%s> negative %s in %s", utils.RouteS, utils.FailureThresholdCfg, utils.BreakerCfg)
+		}
+		if cfg.routeSCfg.Breaker.FailureThreshold != 0 {
+			if cfg.routeSCfg.Breaker.HalfOpenProbes < 1 {
+				return fmt.Errorf("<%s> %s in %s should be at least 1", utils.RouteS, utils.HalfOpenProbesCfg, utils.BreakerCfg)
+			}
+			if len(cfg.routeSCfg.Breaker.FailureFilters) == 0 {
+				return fmt.Errorf("<%s> %s in %s required to detect the failed calls", utils.RouteS, utils.FailureFiltersCfg, utils.BreakerCfg)
+			}
+		}
 	}
 	// Scheduler check connection with CDR Server
 	if cfg.schedulerCfg.Enabled {
@@ -1187,6 +1265,32 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 		if val.Replicate && len(cfg.dataDbCfg.RplConns) == 0 {
 			return fmt.Errorf("replicate connections required by: <%s>", item)
 		}
+		if val.Revisions < 0 {
+			return fmt.Errorf("<%s> negative revisions for item: <%s>", utils.DataDB, item)
+		}
+		if val.Revisions != 0 && !utils.RevisionedItems.Has(item) {
+			return fmt.Errorf("<%s> revisions not supported for item: <%s>", utils.DataDB, item)
+		}
+		if val.CDC && len(cfg.dataDbCfg.CDCEEsConns) == 0 {
+			return fmt.Errorf("<%s> cdc connections required by: <%s>", utils.DataDB, item)
+		}
+		if val.CDC && !utils.CDCItems.Has(item) {
+			return fmt.Errorf("<%s> cdc not supported for item: <%s>", utils.DataDB, item)
+		}
+	}
+	if len(cfg.dataDbCfg.CDCEEsConns) != 0 && cfg.dataDbCfg.CDCRetryInterval <= 0 {
+		return fmt.Errorf("<%s> cdc_retry_interval needs to be positive", utils.DataDB)
+	}
+	if len(cfg.dataDbCfg.CDCEEsConns) != 0 && cfg.dataDbCfg.CDCQueueLen <= 0 {
+		return fmt.Errorf("<%s> cdc_queue_len needs to be positive", utils.DataDB)
+	}
+	for _, connID := range cfg.dataDbCfg.CDCEEsConns {
+		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+			return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, utils.DataDB)
+		}
+		if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.DataDB, connID)
+		}
 	}
 	for _, connID := range cfg.dataDbCfg.RplConns {
 		conn, has := cfg.rpcConns[connID]
@@ -1227,6 +1331,14 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.APIerSv1, connID)
 		}
 	}
+	for _, connID := range cfg.apier.ThresholdSConns {
+		if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.thresholdSCfg.Enabled {
+			return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.ThresholdS, utils.APIerSv1)
+		}
+		if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+			return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.APIerSv1, connID)
+		}
+	}
 	// Dispatcher sanity check
 	if cfg.dispatcherSCfg.Enabled {
 		for _, connID := range cfg.dispatcherSCfg.AttributeSConns {
@@ -1364,6 +1476,63 @@ func (cfg *CGRConfig) checkConfigSanity() error {
 			return fmt.Errorf("<%s> the CleanupInterval needs to be bigger than 0", utils.AnalyzerS)
 		}
 	}
+	if cfg.tracingCfg.Enabled {
+		if cfg.tracingCfg.SampleRatio < 0 || cfg.tracingCfg.SampleRatio > 1 {
+			return fmt.Errorf("<%s> the sample_ratio needs to be between 0 and 1", utils.TracingLog)
+		}
+		if len(cfg.tracingCfg.Exporters) == 0 {
+			return fmt.Errorf("<%s> no exporters defined", utils.TracingLog)
+		}
+		for _, exp := range cfg.tracingCfg.Exporters {
+			switch exp {
+			case utils.MetaMemory:
+			case utils.MetaFile:
+				if cfg.tracingCfg.FilePath == utils.EmptyString {
+					return fmt.Errorf("<%s> empty file_path for exporter <%s>", utils.TracingLog, exp)
+				}
+			case utils.MetaOTLP:
+				if cfg.tracingCfg.OTLPURL == utils.EmptyString {
+					return fmt.Errorf("<%s> empty otlp_url for exporter <%s>", utils.TracingLog, exp)
+				}
+			default:
+				return fmt.Errorf("<%s> unsupported exporter: %q", utils.TracingLog, exp)
+			}
+		}
+	}
+	if cfg.rbacCfg.Enabled {
+		if cfg.rbacCfg.DefaultRole != utils.EmptyString {
+			if _, has := cfg.rbacCfg.Roles[cfg.rbacCfg.DefaultRole]; !has {
+				return fmt.Errorf("<%s> undefined default_role: %q", utils.RBACLog, cfg.rbacCfg.DefaultRole)
+			}
+		}
+		for name, rl := range cfg.rbacCfg.Roles {
+			for _, mth := range rl.Methods {
+				if _, err := path.Match(mth, utils.EmptyString); err != nil {
+					return fmt.Errorf("<%s> invalid method pattern %q for role <%s>: %v", utils.RBACLog, mth, name, err)
+				}
+			}
+		}
+		for key, ak := range cfg.rbacCfg.APIKeys {
+			if _, has := cfg.rbacCfg.Roles[ak.Role]; !has {
+				return fmt.Errorf("<%s> undefined role %q for API key <%s>", utils.RBACLog, ak.Role, key)
+			}
+		}
+	}
+	if cfg.auditCfg.Enabled {
+		for _, mth := range cfg.auditCfg.Methods {
+			if _, err := path.Match(mth, utils.EmptyString); err != nil {
+				return fmt.Errorf("<%s> invalid method pattern %q: %v", utils.AuditS, mth, err)
+			}
+		}
+		for _, connID := range cfg.auditCfg.EEsConns {
+			if strings.HasPrefix(connID, utils.MetaInternal) && !cfg.eesCfg.Enabled {
+				return fmt.Errorf("<%s> not enabled but requested by <%s> component", utils.EEs, utils.AuditS)
+			}
+			if _, has := cfg.rpcConns[connID]; !has && !strings.HasPrefix(connID, utils.MetaInternal) {
+				return fmt.Errorf("<%s> connection with id: <%s> not defined", utils.AuditS, connID)
+			}
+		}
+	}
 	if cfg.prometheusAgentCfg.Enabled {
 		if len(cfg.prometheusAgentCfg.StatSConns) > 0 &&
 			len(cfg.prometheusAgentCfg.StatQueueIDs) == 0 &&
@@ -139,6 +139,50 @@ func TestConfigSanityCDRServer(t *testing.T) {
 	}
 }
 
+func TestConfigSanityCDRsRetention(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.cdrsCfg.Enabled = true
+	rtCfg := cfg.cdrsCfg.Retention
+
+	rtCfg.PurgeInterval = -time.Second
+	expected := "<CDRs> negative purge_interval in retention"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	rtCfg.PurgeInterval = time.Hour
+
+	rtCfg.PseudonymiseMethod = "*encrypt"
+	expected = "<CDRs> unsupported pseudonymise_method <*encrypt>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	rtCfg.PseudonymiseMethod = utils.MetaMask
+
+	rtCfg.MaskKeepPrefix = -1
+	expected = "<CDRs> negative mask_keep_prefix in retention"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	rtCfg.MaskKeepPrefix = 3
+
+	rtCfg.Policies = []*CDRRetentionPolicy{{MaxAge: -time.Hour}}
+	expected = "<CDRs> negative duration in retention policy"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+
+	rtCfg.Policies = []*CDRRetentionPolicy{{PseudonymiseAfter: time.Hour}}
+	rtCfg.PseudonymiseFields = nil
+	expected = "<CDRs> pseudonymise_fields required to pseudonymise the CDRs"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	rtCfg.PseudonymiseFields = []string{utils.AccountField}
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConfigSanitySessionS(t *testing.T) {
 	cfg := NewDefaultCGRConfig()
 	cfg.sessionSCfg = &SessionSCfg{
@@ -2317,6 +2361,62 @@ func TestConfigSanityDataDB(t *testing.T) {
 	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
 		t.Errorf("Expecting: %+q  received: %+q", expected, err)
 	}
+	cfg.dataDbCfg.Items = map[string]*ItemOpt{
+		utils.MetaAccounts: {
+			Revisions: 2,
+		},
+	}
+	expected = "<data_db> revisions not supported for item: <*accounts>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.Items = map[string]*ItemOpt{
+		utils.MetaAttributeProfiles: {
+			Revisions: -1,
+		},
+	}
+	expected = "<data_db> negative revisions for item: <*attribute_profiles>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.Items = map[string]*ItemOpt{
+		utils.MetaAccounts: {
+			CDC: true,
+		},
+	}
+	expected = "<data_db> cdc connections required by: <*accounts>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.CDCEEsConns = []string{"test1"}
+	cfg.dataDbCfg.Items = map[string]*ItemOpt{
+		utils.MetaLoadIDs: {
+			CDC: true,
+		},
+	}
+	expected = "<data_db> cdc not supported for item: <*load_ids>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.Items = map[string]*ItemOpt{}
+	cfg.dataDbCfg.CDCRetryInterval = 0
+	expected = "<data_db> cdc_retry_interval needs to be positive"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.CDCRetryInterval = time.Second
+	cfg.dataDbCfg.CDCQueueLen = 0
+	expected = "<data_db> cdc_queue_len needs to be positive"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.CDCQueueLen = 10000
+	expected = "<data_db> connection with id: <test1> not defined"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expected {
+		t.Errorf("Expecting: %+q  received: %+q", expected, err)
+	}
+	cfg.dataDbCfg.CDCEEsConns = nil
+	cfg.dataDbCfg.Items = map[string]*ItemOpt{}
 	//RpcConns
 	cfg.dataDbCfg.RplConns = []string{"test1"}
 	expected = "<data_db> connection with id: <test1> not defined"
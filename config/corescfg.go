@@ -30,6 +30,8 @@ type CoreSCfg struct {
 	CapsStrategy      string
 	CapsStatsInterval time.Duration
 	ShutdownTimeout   time.Duration
+	ConfigWatch       bool          // watch the config directory for changes
+	ConfigWatchDelay  time.Duration // wait for the changes to settle before reloading
 }
 
 func (cS *CoreSCfg) loadFromJSONCfg(jsnCfg *CoreSJsonCfg) (err error) {
@@ -52,6 +54,14 @@ func (cS *CoreSCfg) loadFromJSONCfg(jsnCfg *CoreSJsonCfg) (err error) {
 			return
 		}
 	}
+	if jsnCfg.Config_watch != nil {
+		cS.ConfigWatch = *jsnCfg.Config_watch
+	}
+	if jsnCfg.Config_watch_delay != nil {
+		if cS.ConfigWatchDelay, err = utils.ParseDurationWithNanosecs(*jsnCfg.Config_watch_delay); err != nil {
+			return
+		}
+	}
 	return
 }
 
@@ -62,6 +72,8 @@ func (cS *CoreSCfg) AsMapInterface() map[string]any {
 		utils.CapsStrategyCfg:      cS.CapsStrategy,
 		utils.CapsStatsIntervalCfg: cS.CapsStatsInterval.String(),
 		utils.ShutdownTimeoutCfg:   cS.ShutdownTimeout.String(),
+		utils.ConfigWatchCfg:       cS.ConfigWatch,
+		utils.ConfigWatchDelayCfg:  cS.ConfigWatchDelay.String(),
 	}
 	if cS.CapsStatsInterval == 0 {
 		mp[utils.CapsStatsIntervalCfg] = "0"
@@ -69,6 +81,9 @@ func (cS *CoreSCfg) AsMapInterface() map[string]any {
 	if cS.ShutdownTimeout == 0 {
 		mp[utils.ShutdownTimeoutCfg] = "0"
 	}
+	if cS.ConfigWatchDelay == 0 {
+		mp[utils.ConfigWatchDelayCfg] = "0"
+	}
 	return mp
 }
 
@@ -82,5 +97,7 @@ func (cS *CoreSCfg) Clone() *CoreSCfg {
 		CapsStrategy:      cS.CapsStrategy,
 		CapsStatsInterval: cS.CapsStatsInterval,
 		ShutdownTimeout:   cS.ShutdownTimeout,
+		ConfigWatch:       cS.ConfigWatch,
+		ConfigWatchDelay:  cS.ConfigWatchDelay,
 	}
 }
@@ -90,6 +90,8 @@ func TestCoreSAsMapInterface(t *testing.T) {
 		utils.CapsStrategyCfg:      utils.MetaBusy,
 		utils.CapsStatsIntervalCfg: "0",
 		utils.ShutdownTimeoutCfg:   "0",
+		utils.ConfigWatchCfg:       false,
+		utils.ConfigWatchDelayCfg:  "0",
 	}
 	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
 		t.Error(err)
@@ -102,7 +104,11 @@ func TestCoreSAsMapInterface(t *testing.T) {
 	}
 	eMap[utils.CapsStatsIntervalCfg] = "1s"
 	eMap[utils.ShutdownTimeoutCfg] = "1s"
+	eMap[utils.ConfigWatchCfg] = true
+	eMap[utils.ConfigWatchDelayCfg] = "1s"
 	alS = CoreSCfg{
+		ConfigWatch:       true,
+		ConfigWatchDelay:  time.Second,
 		Caps:              0,
 		CapsStatsInterval: time.Second,
 		ShutdownTimeout:   time.Second,
@@ -20,6 +20,7 @@ package config
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -55,21 +56,26 @@ type DataDBOpts struct {
 
 // DataDbCfg Database config
 type DataDbCfg struct {
-	Type         string
-	Host         string   // The host to connect to. Values that start with / are for UNIX domain sockets.
-	Port         string   // The port to bind to.
-	Name         string   // The name of the database to connect to.
-	User         string   // The user to sign in as.
-	Password     string   // The user's password.
-	RmtConns     []string // Remote DataDB  connIDs
-	RmtConnID    string
-	RplConns     []string // Replication connIDs
-	RplFiltered  bool
-	RplCache     string
-	RplFailedDir string
-	RplInterval  time.Duration
-	Items        map[string]*ItemOpt
-	Opts         *DataDBOpts
+	Type              string
+	Host              string   // The host to connect to. Values that start with / are for UNIX domain sockets.
+	Port              string   // The port to bind to.
+	Name              string   // The name of the database to connect to.
+	User              string   // The user to sign in as.
+	Password          string   // The user's password.
+	RmtConns          []string // Remote DataDB  connIDs
+	RmtConnID         string
+	RplConns          []string // Replication connIDs
+	RplFiltered       bool
+	RplCache          string
+	RplFailedDir      string
+	RplInterval       time.Duration
+	CDCEEsConns       []string // EEs connIDs for the changes of the items
+	CDCEEsExporterIDs []string
+	CDCRetryInterval  time.Duration
+	CDCQueueLen       int // changes kept in memory by each export queue
+	CDCFailedDir      string
+	Items             map[string]*ItemOpt
+	Opts              *DataDBOpts
 }
 
 func (dbOpts *DataDBOpts) loadFromJSONCfg(jsnCfg *DBOptsJson) (err error) {
@@ -244,6 +250,30 @@ func (dbcfg *DataDbCfg) loadFromJSONCfg(jsnDbCfg *DbJsonCfg) (err error) {
 			return
 		}
 	}
+	if jsnDbCfg.Cdc_ees_conns != nil {
+		dbcfg.CDCEEsConns = make([]string, len(*jsnDbCfg.Cdc_ees_conns))
+		for idx, connID := range *jsnDbCfg.Cdc_ees_conns {
+			// if we have the connection internal we change the name so we can have internal rpc for each subsystem
+			dbcfg.CDCEEsConns[idx] = connID
+			if connID == utils.MetaInternal {
+				dbcfg.CDCEEsConns[idx] = utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)
+			}
+		}
+	}
+	if jsnDbCfg.Cdc_ees_exporter_ids != nil {
+		dbcfg.CDCEEsExporterIDs = slices.Clone(*jsnDbCfg.Cdc_ees_exporter_ids)
+	}
+	if jsnDbCfg.Cdc_retry_interval != nil {
+		if dbcfg.CDCRetryInterval, err = utils.ParseDurationWithNanosecs(*jsnDbCfg.Cdc_retry_interval); err != nil {
+			return
+		}
+	}
+	if jsnDbCfg.Cdc_queue_len != nil {
+		dbcfg.CDCQueueLen = *jsnDbCfg.Cdc_queue_len
+	}
+	if jsnDbCfg.Cdc_failed_dir != nil {
+		dbcfg.CDCFailedDir = *jsnDbCfg.Cdc_failed_dir
+	}
 	if jsnDbCfg.Opts != nil {
 		err = dbcfg.Opts.loadFromJSONCfg(jsnDbCfg.Opts)
 	}
@@ -280,19 +310,24 @@ func (dbOpts *DataDBOpts) Clone() *DataDBOpts {
 // Clone returns the cloned object
 func (dbcfg *DataDbCfg) Clone() (cln *DataDbCfg) {
 	cln = &DataDbCfg{
-		Type:         dbcfg.Type,
-		Host:         dbcfg.Host,
-		Port:         dbcfg.Port,
-		Name:         dbcfg.Name,
-		User:         dbcfg.User,
-		Password:     dbcfg.Password,
-		RplFiltered:  dbcfg.RplFiltered,
-		RplCache:     dbcfg.RplCache,
-		RmtConnID:    dbcfg.RmtConnID,
-		RplFailedDir: dbcfg.RplFailedDir,
-		RplInterval:  dbcfg.RplInterval,
-		Items:        make(map[string]*ItemOpt),
-		Opts:         dbcfg.Opts.Clone(),
+		Type:              dbcfg.Type,
+		Host:              dbcfg.Host,
+		Port:              dbcfg.Port,
+		Name:              dbcfg.Name,
+		User:              dbcfg.User,
+		Password:          dbcfg.Password,
+		RplFiltered:       dbcfg.RplFiltered,
+		RplCache:          dbcfg.RplCache,
+		RmtConnID:         dbcfg.RmtConnID,
+		RplFailedDir:      dbcfg.RplFailedDir,
+		RplInterval:       dbcfg.RplInterval,
+		CDCEEsConns:       slices.Clone(dbcfg.CDCEEsConns),
+		CDCEEsExporterIDs: slices.Clone(dbcfg.CDCEEsExporterIDs),
+		CDCRetryInterval:  dbcfg.CDCRetryInterval,
+		CDCQueueLen:       dbcfg.CDCQueueLen,
+		CDCFailedDir:      dbcfg.CDCFailedDir,
+		Items:             make(map[string]*ItemOpt),
+		Opts:              dbcfg.Opts.Clone(),
 	}
 	for k, itm := range dbcfg.Items {
 		cln.Items[k] = itm.Clone()
@@ -348,7 +383,19 @@ func (dbcfg *DataDbCfg) AsMapInterface() (mp map[string]any) {
 		utils.ReplicationFailedDirCfg: dbcfg.RplFailedDir,
 		utils.ReplicationIntervalCfg:  dbcfg.RplInterval.String(),
 		utils.OptsCfg:                 opts,
+		utils.CDCEEsExporterIDsCfg:    slices.Clone(dbcfg.CDCEEsExporterIDs),
+		utils.CDCRetryIntervalCfg:     dbcfg.CDCRetryInterval.String(),
+		utils.CDCQueueLenCfg:          dbcfg.CDCQueueLen,
+		utils.CDCFailedDirCfg:         dbcfg.CDCFailedDir,
+	}
+	eesConns := make([]string, len(dbcfg.CDCEEsConns))
+	for i, item := range dbcfg.CDCEEsConns {
+		eesConns[i] = item
+		if item == utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs) {
+			eesConns[i] = utils.MetaInternal
+		}
 	}
+	mp[utils.CDCEEsConnsCfg] = eesConns
 	if dbcfg.Items != nil {
 		items := make(map[string]any)
 		for key, item := range dbcfg.Items {
@@ -384,6 +431,8 @@ type ItemOpt struct {
 	StaticTTL bool
 	Remote    bool
 	Replicate bool
+	Revisions int  // previous versions kept for rollback, 0 to disable
+	CDC       bool // export the changes to EEs
 	// used for ArgDispatcher in case we send this to a dispatcher engine
 	RouteID string
 	APIKey  string
@@ -406,6 +455,12 @@ func (itm *ItemOpt) AsMapInterface() (initialMP map[string]any) {
 	if itm.TTL != 0 {
 		initialMP[utils.TTLCfg] = itm.TTL.String()
 	}
+	if itm.Revisions != 0 {
+		initialMP[utils.RevisionsCfg] = itm.Revisions
+	}
+	if itm.CDC {
+		initialMP[utils.CDCCfg] = itm.CDC
+	}
 	return
 }
 
@@ -425,6 +480,12 @@ func (itm *ItemOpt) loadFromJSONCfg(jsonItm *ItemOptJson) (err error) {
 	if jsonItm.Replicate != nil {
 		itm.Replicate = *jsonItm.Replicate
 	}
+	if jsonItm.Revisions != nil {
+		itm.Revisions = *jsonItm.Revisions
+	}
+	if jsonItm.Cdc != nil {
+		itm.CDC = *jsonItm.Cdc
+	}
 	if jsonItm.Route_id != nil {
 		itm.RouteID = *jsonItm.Route_id
 	}
@@ -445,6 +506,8 @@ func (itm *ItemOpt) Clone() *ItemOpt {
 		StaticTTL: itm.StaticTTL,
 		Remote:    itm.Remote,
 		Replicate: itm.Replicate,
+		Revisions: itm.Revisions,
+		CDC:       itm.CDC,
 		APIKey:    itm.APIKey,
 		RouteID:   itm.RouteID,
 	}
@@ -184,10 +184,14 @@ func TestItemCfgloadFromJson(t *testing.T) {
 		Replicate: utils.BoolPointer(true),
 		Api_key:   utils.StringPointer("randomVal"),
 		Route_id:  utils.StringPointer("randomID"),
+		Revisions: utils.IntPointer(5),
+		Cdc:       utils.BoolPointer(true),
 	}
 	expected := &ItemOpt{
 		Remote:    true,
 		Replicate: true,
+		Revisions: 5,
+		CDC:       true,
 		APIKey:    "randomVal",
 		RouteID:   "randomID",
 	}
@@ -197,6 +201,12 @@ func TestItemCfgloadFromJson(t *testing.T) {
 	if !reflect.DeepEqual(rcv, expected) {
 		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(expected), utils.ToJSON(rcv))
 	}
+	if cln := rcv.Clone(); !reflect.DeepEqual(cln, expected) {
+		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(expected), utils.ToJSON(cln))
+	}
+	if mp := rcv.AsMapInterface(); mp[utils.RevisionsCfg] != 5 || mp[utils.CDCCfg] != true {
+		t.Errorf("Expected 5 revisions and cdc, received %+v", mp)
+	}
 }
 
 func TestDataDbCfgloadFromJsonCfgPort(t *testing.T) {
@@ -690,3 +700,39 @@ func TestDataDBOptsToTransCacheOpts(t *testing.T) {
 		})
 	}
 }
+
+func TestDataDbCfgloadFromJsonCfgCDC(t *testing.T) {
+	jsnCfg := &DbJsonCfg{
+		Cdc_ees_conns:        &[]string{utils.MetaInternal, "conn1"},
+		Cdc_ees_exporter_ids: &[]string{"CRM"},
+		Cdc_retry_interval:   utils.StringPointer("2s"),
+		Cdc_queue_len:        utils.IntPointer(100),
+		Cdc_failed_dir:       utils.StringPointer("/tmp/cdc"),
+	}
+	dbcfg := &DataDbCfg{Items: make(map[string]*ItemOpt), Opts: &DataDBOpts{}}
+	if err := dbcfg.loadFromJSONCfg(jsnCfg); err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs), "conn1"}; !reflect.DeepEqual(exp, dbcfg.CDCEEsConns) {
+		t.Errorf("Expected %+v, received %+v", exp, dbcfg.CDCEEsConns)
+	}
+	if dbcfg.CDCRetryInterval != 2*time.Second || dbcfg.CDCFailedDir != "/tmp/cdc" ||
+		dbcfg.CDCQueueLen != 100 ||
+		!reflect.DeepEqual(dbcfg.CDCEEsExporterIDs, []string{"CRM"}) {
+		t.Errorf("Unexpected cdc config: %s", utils.ToJSON(dbcfg))
+	}
+	if cln := dbcfg.Clone(); !reflect.DeepEqual(cln, dbcfg) {
+		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(dbcfg), utils.ToJSON(cln))
+	}
+	mp := dbcfg.AsMapInterface()
+	if exp := []string{utils.MetaInternal, "conn1"}; !reflect.DeepEqual(exp, mp[utils.CDCEEsConnsCfg]) {
+		t.Errorf("Expected %+v, received %+v", exp, mp[utils.CDCEEsConnsCfg])
+	}
+	if mp[utils.CDCRetryIntervalCfg] != "2s" {
+		t.Errorf("Expected 2s, received %+v", mp[utils.CDCRetryIntervalCfg])
+	}
+	jsnCfg = &DbJsonCfg{Cdc_retry_interval: utils.StringPointer("inv")}
+	if err := dbcfg.loadFromJSONCfg(jsnCfg); err == nil || err.Error() != `time: invalid duration "inv"` {
+		t.Errorf("Expected invalid duration, received %v", err)
+	}
+}
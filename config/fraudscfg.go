@@ -0,0 +1,148 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// FraudSCfg is the configuration of FraudS
+type FraudSCfg struct {
+	Enabled             bool
+	IndexedSelects      bool
+	StringIndexedFields *[]string
+	PrefixIndexedFields *[]string
+	SuffixIndexedFields *[]string
+	ExistsIndexedFields *[]string
+	NestedFields        bool
+	ResourceSConns      []string
+	SessionSConns       []string
+	MaxEvidence         int
+	TrackersTTL         time.Duration // signal history of the subjects without events is dropped after this
+}
+
+func (fsCfg *FraudSCfg) loadFromJSONCfg(jsnCfg *FraudSJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Enabled != nil {
+		fsCfg.Enabled = *jsnCfg.Enabled
+	}
+	if jsnCfg.Indexed_selects != nil {
+		fsCfg.IndexedSelects = *jsnCfg.Indexed_selects
+	}
+	if jsnCfg.String_indexed_fields != nil {
+		sif := slices.Clone(*jsnCfg.String_indexed_fields)
+		fsCfg.StringIndexedFields = &sif
+	}
+	if jsnCfg.Prefix_indexed_fields != nil {
+		pif := slices.Clone(*jsnCfg.Prefix_indexed_fields)
+		fsCfg.PrefixIndexedFields = &pif
+	}
+	if jsnCfg.Suffix_indexed_fields != nil {
+		sif := slices.Clone(*jsnCfg.Suffix_indexed_fields)
+		fsCfg.SuffixIndexedFields = &sif
+	}
+	if jsnCfg.Exists_indexed_fields != nil {
+		eif := slices.Clone(*jsnCfg.Exists_indexed_fields)
+		fsCfg.ExistsIndexedFields = &eif
+	}
+	if jsnCfg.Nested_fields != nil {
+		fsCfg.NestedFields = *jsnCfg.Nested_fields
+	}
+	if jsnCfg.Resources_conns != nil {
+		fsCfg.ResourceSConns = tagInternalConns(*jsnCfg.Resources_conns, utils.MetaResources)
+	}
+	if jsnCfg.Sessions_conns != nil {
+		fsCfg.SessionSConns = tagInternalConns(*jsnCfg.Sessions_conns, utils.MetaSessionS)
+	}
+	if jsnCfg.Max_evidence != nil {
+		fsCfg.MaxEvidence = *jsnCfg.Max_evidence
+	}
+	if jsnCfg.Trackers_ttl != nil {
+		if fsCfg.TrackersTTL, err = utils.ParseDurationWithNanosecs(*jsnCfg.Trackers_ttl); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (fsCfg *FraudSCfg) AsMapInterface() (mp map[string]any) {
+	mp = map[string]any{
+		utils.EnabledCfg:        fsCfg.Enabled,
+		utils.IndexedSelectsCfg: fsCfg.IndexedSelects,
+		utils.NestedFieldsCfg:   fsCfg.NestedFields,
+		utils.ResourceSConnsCfg: stripInternalConns(fsCfg.ResourceSConns),
+		utils.SessionSConnsCfg:  stripInternalConns(fsCfg.SessionSConns),
+		utils.MaxEvidenceCfg:    fsCfg.MaxEvidence,
+		utils.TrackersTTLCfg:    utils.EmptyString,
+	}
+	if fsCfg.TrackersTTL != 0 {
+		mp[utils.TrackersTTLCfg] = fsCfg.TrackersTTL.String()
+	}
+	if fsCfg.StringIndexedFields != nil {
+		mp[utils.StringIndexedFieldsCfg] = slices.Clone(*fsCfg.StringIndexedFields)
+	}
+	if fsCfg.PrefixIndexedFields != nil {
+		mp[utils.PrefixIndexedFieldsCfg] = slices.Clone(*fsCfg.PrefixIndexedFields)
+	}
+	if fsCfg.SuffixIndexedFields != nil {
+		mp[utils.SuffixIndexedFieldsCfg] = slices.Clone(*fsCfg.SuffixIndexedFields)
+	}
+	if fsCfg.ExistsIndexedFields != nil {
+		mp[utils.ExistsIndexedFieldsCfg] = slices.Clone(*fsCfg.ExistsIndexedFields)
+	}
+	return
+}
+
+// Clone returns a deep copy of FraudSCfg
+func (fsCfg *FraudSCfg) Clone() *FraudSCfg {
+	if fsCfg == nil {
+		return nil
+	}
+	cln := &FraudSCfg{
+		Enabled:        fsCfg.Enabled,
+		IndexedSelects: fsCfg.IndexedSelects,
+		NestedFields:   fsCfg.NestedFields,
+		ResourceSConns: slices.Clone(fsCfg.ResourceSConns),
+		SessionSConns:  slices.Clone(fsCfg.SessionSConns),
+		MaxEvidence:    fsCfg.MaxEvidence,
+		TrackersTTL:    fsCfg.TrackersTTL,
+	}
+	if fsCfg.StringIndexedFields != nil {
+		idx := slices.Clone(*fsCfg.StringIndexedFields)
+		cln.StringIndexedFields = &idx
+	}
+	if fsCfg.PrefixIndexedFields != nil {
+		idx := slices.Clone(*fsCfg.PrefixIndexedFields)
+		cln.PrefixIndexedFields = &idx
+	}
+	if fsCfg.SuffixIndexedFields != nil {
+		idx := slices.Clone(*fsCfg.SuffixIndexedFields)
+		cln.SuffixIndexedFields = &idx
+	}
+	if fsCfg.ExistsIndexedFields != nil {
+		idx := slices.Clone(*fsCfg.ExistsIndexedFields)
+		cln.ExistsIndexedFields = &idx
+	}
+	return cln
+}
@@ -0,0 +1,119 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestFraudSCfgloadFromJsonCfg(t *testing.T) {
+	fsCfg := new(FraudSCfg)
+	if err := fsCfg.loadFromJSONCfg(nil); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(fsCfg, new(FraudSCfg)) {
+		t.Errorf("Expected: %+v ,received: %+v", new(FraudSCfg), fsCfg)
+	}
+	cfgJSONStr := `{
+		"frauds": {
+			"enabled": true,
+			"indexed_selects": true,
+			"string_indexed_fields": ["*req.Account"],
+			"prefix_indexed_fields": ["*req.Destination"],
+			"suffix_indexed_fields": [],
+			"exists_indexed_fields": [],
+			"nested_fields": true,
+			"resources_conns": ["*internal", "conn1"],
+			"sessions_conns": ["*internal"],
+			"max_evidence": 10,
+			"trackers_ttl": "2h",
+		},
+}`
+	expected := &FraudSCfg{
+		Enabled:             true,
+		IndexedSelects:      true,
+		StringIndexedFields: &[]string{"*req.Account"},
+		PrefixIndexedFields: &[]string{"*req.Destination"},
+		SuffixIndexedFields: &[]string{},
+		ExistsIndexedFields: &[]string{},
+		NestedFields:        true,
+		ResourceSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "conn1"},
+		SessionSConns:       []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaSessionS)},
+		MaxEvidence:         10,
+		TrackersTTL:         2 * time.Hour,
+	}
+	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
+		t.Error(err)
+	} else if jsnFsCfg, err := jsnCfg.FraudSJsonCfg(); err != nil {
+		t.Error(err)
+	} else if err = fsCfg.loadFromJSONCfg(jsnFsCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, fsCfg) {
+		t.Errorf("Expected: %+v , received: %+v", utils.ToJSON(expected), utils.ToJSON(fsCfg))
+	}
+}
+
+func TestFraudSCfgAsMapInterface(t *testing.T) {
+	fsCfg := &FraudSCfg{
+		Enabled:             true,
+		IndexedSelects:      true,
+		PrefixIndexedFields: &[]string{"*req.Destination"},
+		ResourceSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources)},
+		SessionSConns:       []string{"conn1"},
+		MaxEvidence:         100,
+		TrackersTTL:         time.Hour,
+	}
+	eMap := map[string]any{
+		utils.EnabledCfg:             true,
+		utils.IndexedSelectsCfg:      true,
+		utils.NestedFieldsCfg:        false,
+		utils.PrefixIndexedFieldsCfg: []string{"*req.Destination"},
+		utils.ResourceSConnsCfg:      []string{utils.MetaInternal},
+		utils.SessionSConnsCfg:       []string{"conn1"},
+		utils.MaxEvidenceCfg:         100,
+		utils.TrackersTTLCfg:         "1h0m0s",
+	}
+	if rcv := fsCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+}
+
+func TestFraudSCfgClone(t *testing.T) {
+	fsCfg := &FraudSCfg{
+		Enabled:             true,
+		StringIndexedFields: &[]string{"*req.Account"},
+		ResourceSConns:      []string{"conn1"},
+		SessionSConns:       []string{"conn2"},
+		MaxEvidence:         100,
+		TrackersTTL:         time.Hour,
+	}
+	rcv := fsCfg.Clone()
+	if !reflect.DeepEqual(fsCfg, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(fsCfg), utils.ToJSON(rcv))
+	}
+	if rcv.ResourceSConns[0] = ""; fsCfg.ResourceSConns[0] != "conn1" {
+		t.Error("Expected clone to not modify the cloned")
+	}
+	if (*rcv.StringIndexedFields)[0] = ""; (*fsCfg.StringIndexedFields)[0] != "*req.Account" {
+		t.Error("Expected clone to not modify the cloned")
+	}
+}
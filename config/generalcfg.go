@@ -50,6 +50,7 @@ type GeneralCfg struct {
 	DigestEqual          string        //
 	RSRSep               string        // separator used to split RSRParser (by default is used ";")
 	MaxParallelConns     int           // the maximum number of connections used by the *parallel strategy
+	SubscriberQueueLen   int           // notifications buffered per subscriber before dropping them
 }
 
 // loadFromJSONCfg loads General config from JsonCfg
@@ -137,6 +138,9 @@ func (gencfg *GeneralCfg) loadFromJSONCfg(jsnGeneralCfg *GeneralJsonCfg) (err er
 	if jsnGeneralCfg.Max_parallel_conns != nil {
 		gencfg.MaxParallelConns = *jsnGeneralCfg.Max_parallel_conns
 	}
+	if jsnGeneralCfg.Subscriber_queue_len != nil {
+		gencfg.SubscriberQueueLen = *jsnGeneralCfg.Subscriber_queue_len
+	}
 
 	return nil
 }
@@ -164,6 +168,7 @@ func (gencfg *GeneralCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.DigestEqualCfg:          gencfg.DigestEqual,
 		utils.RSRSepCfg:               gencfg.RSRSep,
 		utils.MaxParallelConnsCfg:     gencfg.MaxParallelConns,
+		utils.SubscriberQueueLenCfg:   gencfg.SubscriberQueueLen,
 		utils.LockingTimeoutCfg:       "0",
 		utils.ConnectTimeoutCfg:       "0",
 		utils.ReplyTimeoutCfg:         "0",
@@ -219,5 +224,6 @@ func (gencfg *GeneralCfg) Clone() *GeneralCfg {
 		DigestEqual:          gencfg.DigestEqual,
 		RSRSep:               gencfg.RSRSep,
 		MaxParallelConns:     gencfg.MaxParallelConns,
+		SubscriberQueueLen:   gencfg.SubscriberQueueLen,
 	}
 }
@@ -47,27 +47,28 @@ func TestGeneralCfgloadFromJsonCfg(t *testing.T) {
 	}
 
 	expected := &GeneralCfg{
-		NodeID:           "randomID",
-		Logger:           utils.MetaSysLog,
-		LogLevel:         6,
-		RoundingDecimals: 5,
-		DBDataEncoding:   "msgpack",
-		TpExportPath:     "/var/spool/cgrates/tpe",
-		PosterAttempts:   3,
-		DefaultReqType:   utils.MetaRated,
-		DefaultCategory:  utils.Call,
-		DefaultTenant:    "cgrates.org",
-		DefaultTimezone:  "Local",
-		ConnectAttempts:  3,
-		Reconnects:       -1,
-		ConnectTimeout:   time.Second,
-		ReplyTimeout:     2 * time.Second,
-		DigestSeparator:  ",",
-		DigestEqual:      ":",
-		MaxParallelConns: 100,
-		RSRSep:           ";",
-		DefaultCaching:   utils.MetaReload,
-		CachingDelay:     5 * time.Second,
+		NodeID:             "randomID",
+		Logger:             utils.MetaSysLog,
+		LogLevel:           6,
+		RoundingDecimals:   5,
+		DBDataEncoding:     "msgpack",
+		TpExportPath:       "/var/spool/cgrates/tpe",
+		PosterAttempts:     3,
+		DefaultReqType:     utils.MetaRated,
+		DefaultCategory:    utils.Call,
+		DefaultTenant:      "cgrates.org",
+		DefaultTimezone:    "Local",
+		ConnectAttempts:    3,
+		Reconnects:         -1,
+		ConnectTimeout:     time.Second,
+		ReplyTimeout:       2 * time.Second,
+		DigestSeparator:    ",",
+		DigestEqual:        ":",
+		MaxParallelConns:   100,
+		SubscriberQueueLen: 1000,
+		RSRSep:             ";",
+		DefaultCaching:     utils.MetaReload,
+		CachingDelay:       5 * time.Second,
 	}
 	jsnCfg := NewDefaultCGRConfig()
 	if err := jsnCfg.generalCfg.loadFromJSONCfg(cfgJSON); err != nil {
@@ -171,6 +172,7 @@ func TestGeneralCfgAsMapInterface(t *testing.T) {
 		utils.DigestEqualCfg:          ":",
 		utils.RSRSepCfg:               ";",
 		utils.MaxParallelConnsCfg:     100,
+		utils.SubscriberQueueLenCfg:   1000,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -213,6 +215,7 @@ func TestGeneralCfgAsMapInterface1(t *testing.T) {
 		utils.DigestEqualCfg:          ":",
 		utils.RSRSepCfg:               ";",
 		utils.MaxParallelConnsCfg:     100,
+		utils.SubscriberQueueLenCfg:   1000,
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -0,0 +1,59 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import "github.com/cgrates/cgrates/utils"
+
+// GeoIPCfg the config for the MaxMind databases used by the *geoip filters and converters
+type GeoIPCfg struct {
+	CityDBPath string // path to the GeoLite2/GeoIP2 Country or City database
+	ASNDBPath  string // path to the GeoLite2/GeoIP2 ASN database
+}
+
+func (geo *GeoIPCfg) loadFromJSONCfg(jsnCfg *GeoIPJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.CityDBPath != nil {
+		geo.CityDBPath = *jsnCfg.CityDBPath
+	}
+	if jsnCfg.ASNDBPath != nil {
+		geo.ASNDBPath = *jsnCfg.ASNDBPath
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (geo *GeoIPCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.CityDBPathCfg: geo.CityDBPath,
+		utils.ASNDBPathCfg:  geo.ASNDBPath,
+	}
+}
+
+// Clone returns a deep copy of GeoIPCfg
+func (geo *GeoIPCfg) Clone() *GeoIPCfg {
+	if geo == nil {
+		return nil
+	}
+	return &GeoIPCfg{
+		CityDBPath: geo.CityDBPath,
+		ASNDBPath:  geo.ASNDBPath,
+	}
+}
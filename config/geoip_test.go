@@ -0,0 +1,85 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestGeoIPCfgloadFromJsonCfg(t *testing.T) {
+	var geoCfg, expected GeoIPCfg
+	if err := geoCfg.loadFromJSONCfg(nil); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(geoCfg, expected) {
+		t.Errorf("Expected: %+v ,received: %+v", expected, geoCfg)
+	}
+	cfgJSONStr := `{
+		"geoip": {
+			"city_db_path": "/usr/share/GeoIP/GeoLite2-City.mmdb",
+			"asn_db_path": "/usr/share/GeoIP/GeoLite2-ASN.mmdb",
+		},
+}`
+	expected = GeoIPCfg{
+		CityDBPath: "/usr/share/GeoIP/GeoLite2-City.mmdb",
+		ASNDBPath:  "/usr/share/GeoIP/GeoLite2-ASN.mmdb",
+	}
+	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
+		t.Error(err)
+	} else if jsnGeoCfg, err := jsnCfg.GeoIPJson(); err != nil {
+		t.Error(err)
+	} else if err = geoCfg.loadFromJSONCfg(jsnGeoCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, geoCfg) {
+		t.Errorf("Expected: %+v , received: %+v", expected, geoCfg)
+	}
+}
+
+func TestGeoIPCfgAsMapInterface(t *testing.T) {
+	geoCfg := &GeoIPCfg{
+		CityDBPath: "/usr/share/GeoIP/GeoLite2-City.mmdb",
+	}
+	eMap := map[string]any{
+		utils.CityDBPathCfg: "/usr/share/GeoIP/GeoLite2-City.mmdb",
+		utils.ASNDBPathCfg:  "",
+	}
+	if rcv := geoCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+}
+
+func TestGeoIPCfgClone(t *testing.T) {
+	geoCfg := &GeoIPCfg{
+		CityDBPath: "/usr/share/GeoIP/GeoLite2-City.mmdb",
+		ASNDBPath:  "/usr/share/GeoIP/GeoLite2-ASN.mmdb",
+	}
+	rcv := geoCfg.Clone()
+	if !reflect.DeepEqual(geoCfg, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(geoCfg), utils.ToJSON(rcv))
+	}
+	if rcv.CityDBPath = ""; geoCfg.CityDBPath != "/usr/share/GeoIP/GeoLite2-City.mmdb" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	geoCfg = nil
+	if rcv = geoCfg.Clone(); rcv != nil {
+		t.Errorf("Expected nil, received: %+v", utils.ToJSON(rcv))
+	}
+}
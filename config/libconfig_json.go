@@ -50,6 +50,7 @@ type GeneralJsonCfg struct {
 	Digest_equal           *string
 	Rsr_separator          *string
 	Max_parallel_conns     *int
+	Subscriber_queue_len   *int
 }
 
 // Listen config section
@@ -62,6 +63,8 @@ type ListenJsonCfg struct {
 	Http_tls     *string
 	Birpc_json   *string
 	Birpc_gob    *string
+	Grpc         *string
+	Grpc_tls     *string
 }
 
 type HTTPClientOptsJson struct {
@@ -159,6 +162,11 @@ type DbJsonCfg struct {
 	Replication_cache     *string
 	RplFailedDir          *string `json:"replication_failed_dir"`
 	RplInterval           *string `json:"replication_interval"`
+	Cdc_ees_conns         *[]string
+	Cdc_ees_exporter_ids  *[]string
+	Cdc_retry_interval    *string
+	Cdc_queue_len         *int
+	Cdc_failed_dir        *string
 	Items                 *map[string]*ItemOptJson
 	Opts                  *DBOptsJson
 }
@@ -169,6 +177,8 @@ type ItemOptJson struct {
 	Static_ttl *bool
 	Remote     *bool
 	Replicate  *bool
+	Revisions  *int
+	Cdc        *bool
 	// used for ArgDispatcher in case we send this to a dispatcher engine
 	Route_id *string
 	Api_key  *string
@@ -195,6 +205,14 @@ type RalsJsonCfg struct {
 	Max_increments             *int
 	Fallback_depth             *int
 	Balance_rating_subject     *map[string]string
+	Discounts                  *bool
+
+	Discounts_indexed_selects       *bool
+	Discounts_string_indexed_fields *[]string
+	Discounts_prefix_indexed_fields *[]string
+	Discounts_suffix_indexed_fields *[]string
+	Discounts_exists_indexed_fields *[]string
+	Discounts_nested_fields         *bool
 }
 
 // Scheduler config section
@@ -219,9 +237,29 @@ type CdrsJsonCfg struct {
 	Attributes_conns     *[]string
 	Thresholds_conns     *[]string
 	Stats_conns          *[]string
+	Routes_conns         *[]string
 	Online_cdr_exports   *[]string
 	Scheduler_conns      *[]string
 	Ees_conns            *[]string
+	Retention            *CDRRetentionJsonCfg
+}
+
+// CDRRetentionJsonCfg is the retention config of the CDRs
+type CDRRetentionJsonCfg struct {
+	Purge_interval      *string
+	Policies            *[]*CDRRetentionPolicyJsonCfg
+	Pseudonymise_method *string
+	Pseudonymise_fields *[]string
+	Pseudonymise_salt   *string
+	Mask_keep_prefix    *int
+}
+
+// CDRRetentionPolicyJsonCfg is a retention policy of the CDRs
+type CDRRetentionPolicyJsonCfg struct {
+	Tenants            *[]string
+	Tors               *[]string
+	Max_age            *string
+	Pseudonymise_after *string
 }
 
 // EventReaderSJsonCfg contains the configuration of EventReaderService
@@ -426,6 +464,7 @@ type SessionSJsonCfg struct {
 	ResourceSConns         *[]string          `json:"resources_conns"`
 	ThresholdSConns        *[]string          `json:"thresholds_conns"`
 	StatSConns             *[]string          `json:"stats_conns"`
+	FraudSConns            *[]string          `json:"frauds_conns"`
 	RouteSConns            *[]string          `json:"routes_conns"`
 	CDRsConns              *[]string          `json:"cdrs_conns"`
 	ReplicationConns       *[]string          `json:"replication_conns"`
@@ -747,6 +786,20 @@ type TrendsJsonCfg struct {
 	Ees_exporter_ids         *[]string
 }
 
+type FraudSJsonCfg struct {
+	Enabled               *bool
+	Indexed_selects       *bool
+	String_indexed_fields *[]string
+	Prefix_indexed_fields *[]string
+	Suffix_indexed_fields *[]string
+	Exists_indexed_fields *[]string
+	Nested_fields         *bool // applies when indexed fields is not defined
+	Resources_conns       *[]string
+	Sessions_conns        *[]string
+	Max_evidence          *int
+	Trackers_ttl          *string
+}
+
 type RankingsJsonCfg struct {
 	Enabled          *bool
 	Stats_conns      *[]string
@@ -802,9 +855,18 @@ type RouteSJsonCfg struct {
 	Stats_conns           *[]string
 	Rals_conns            *[]string
 	Default_ratio         *int
+	Breaker               *RouteBreakerJsonCfg
 	Opts                  *RoutesOptsJson
 }
 
+// RouteBreakerJsonCfg is the breaker config of RouteS
+type RouteBreakerJsonCfg struct {
+	Failure_threshold *int
+	Cooldown          *string
+	Half_open_probes  *int
+	Failure_filters   *[]string
+}
+
 // Mailer config section
 type MailerJsonCfg struct {
 	Server        *string
@@ -876,6 +938,26 @@ type LoaderCfgJson struct {
 	Scheduler_conns  *[]string
 	Gapi_credentials *json.RawMessage
 	Gapi_token       *json.RawMessage
+	Rate_decks       map[string]*RateDeckJsonCfg
+}
+
+// RateDeckJsonCfg is the layout of a carrier rate deck
+type RateDeckJsonCfg struct {
+	Field_separator   *string
+	Header_lines      *int
+	Timezone          *string
+	Full_deck         *bool
+	Deleted_values    *[]string
+	Rounding_method   *string
+	Rounding_decimals *int
+	Prefix            *string
+	Destination       *string
+	Rate              *string
+	Connect_fee       *string
+	Rate_unit         *string
+	Rate_increment    *string
+	Effective_date    *string
+	Change            *string
 }
 
 type MigratorCfgJson struct {
@@ -928,11 +1010,14 @@ type AnalyzerSJsonCfg struct {
 }
 
 type ApierJsonCfg struct {
-	Enabled          *bool
-	Caches_conns     *[]string
-	Scheduler_conns  *[]string
-	Attributes_conns *[]string
-	Ees_conns        *[]string
+	Enabled               *bool
+	Caches_conns          *[]string
+	Scheduler_conns       *[]string
+	Attributes_conns      *[]string
+	Ees_conns             *[]string
+	Thresholds_conns      *[]string
+	Index_health_interval *string
+	Index_health_repair   *bool
 }
 
 type STIRJsonCfg struct {
@@ -992,11 +1077,54 @@ type SentryPeerJsonCfg struct {
 	GrantType    *string `json:"grant_type"`
 }
 
+type TracingJsonCfg struct {
+	Enabled         *bool
+	Sample_ratio    *float64
+	Exporters       *[]string
+	Memory_limit    *int
+	File_path       *string
+	Otlp_url        *string
+	Export_interval *string
+	Db_spans        *bool
+}
+
+type RBACRoleJsonCfg struct {
+	Methods *[]string
+	Tenants *[]string
+}
+
+type RBACAPIKeyJsonCfg struct {
+	Role    *string
+	Tenants *[]string
+}
+
+type RBACJsonCfg struct {
+	Enabled      *bool
+	Default_role *string
+	Roles        map[string]*RBACRoleJsonCfg
+	Api_keys     map[string]*RBACAPIKeyJsonCfg
+}
+
+type AuditJsonCfg struct {
+	Enabled          *bool
+	Methods          *[]string
+	Store            *bool
+	Ees_conns        *[]string
+	Ees_exporter_ids *[]string
+}
+
+type GeoIPJsonCfg struct {
+	CityDBPath *string `json:"city_db_path"`
+	ASNDBPath  *string `json:"asn_db_path"`
+}
+
 type CoreSJsonCfg struct {
 	Caps                *int
 	Caps_strategy       *string
 	Caps_stats_interval *string
 	Shutdown_timeout    *string
+	Config_watch        *bool
+	Config_watch_delay  *string
 }
 
 type IPsOptsJson struct {
@@ -30,6 +30,8 @@ type ListenCfg struct {
 	HTTPTLSListen    string // HTTP TLS listening address
 	BiJSONListen     string // Bidirectional RPC JSON listening address
 	BiGobListen      string // Bidirectional RPC GOB listening address
+	GRPCListen       string // gRPC listening address
+	GRPCTLSListen    string // gRPC TLS listening address
 }
 
 // loadFromJSONCfg loads Database config from JsonCfg
@@ -61,6 +63,12 @@ func (lstcfg *ListenCfg) loadFromJSONCfg(jsnListenCfg *ListenJsonCfg) (err error
 	if jsnListenCfg.Birpc_gob != nil {
 		lstcfg.BiGobListen = *jsnListenCfg.Birpc_gob
 	}
+	if jsnListenCfg.Grpc != nil {
+		lstcfg.GRPCListen = *jsnListenCfg.Grpc
+	}
+	if jsnListenCfg.Grpc_tls != nil {
+		lstcfg.GRPCTLSListen = *jsnListenCfg.Grpc_tls
+	}
 	return nil
 }
 
@@ -75,6 +83,8 @@ func (lstcfg *ListenCfg) AsMapInterface() map[string]any {
 		utils.HTTPTLSListenCfg:    lstcfg.HTTPTLSListen,
 		utils.BijsonListenCfg:     lstcfg.BiJSONListen,
 		utils.BigobListenCfg:      lstcfg.BiGobListen,
+		utils.GRPCListenCfg:       lstcfg.GRPCListen,
+		utils.GRPCTLSListenCfg:    lstcfg.GRPCTLSListen,
 	}
 }
 
@@ -92,5 +102,7 @@ func (lstcfg *ListenCfg) Clone() *ListenCfg {
 		HTTPTLSListen:    lstcfg.HTTPTLSListen,
 		BiJSONListen:     lstcfg.BiJSONListen,
 		BiGobListen:      lstcfg.BiGobListen,
+		GRPCListen:       lstcfg.GRPCListen,
+		GRPCTLSListen:    lstcfg.GRPCTLSListen,
 	}
 }
@@ -64,6 +64,8 @@ func TestListenCfgAsMapInterface(t *testing.T) {
 		utils.HTTPTLSListenCfg:    "127.0.0.1:2280",
 		utils.BijsonListenCfg:     "127.0.0.1:2014",
 		utils.BigobListenCfg:      "",
+		utils.GRPCListenCfg:       "",
+		utils.GRPCTLSListenCfg:    "",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
@@ -81,6 +83,7 @@ func TestListenCfgAsMapInterface1(t *testing.T) {
         "rpc_gob_tls": "127.0.0.1:2001",		
         "http_tls": "127.0.0.1:2288",			
         "birpc_json": "127.0.0.1:2014",			
+        "grpc": "127.0.0.1:2015",
 	}
 }`
 	eMap := map[string]any{
@@ -92,6 +95,8 @@ func TestListenCfgAsMapInterface1(t *testing.T) {
 		utils.HTTPTLSListenCfg:    "127.0.0.1:2288",
 		utils.BijsonListenCfg:     "127.0.0.1:2014",
 		utils.BigobListenCfg:      "",
+		utils.GRPCListenCfg:       "127.0.0.1:2015",
+		utils.GRPCTLSListenCfg:    "",
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
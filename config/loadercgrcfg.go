@@ -34,9 +34,10 @@ type LoaderCgrCfg struct {
 	SchedulerConns  []string
 	GapiCredentials json.RawMessage
 	GapiToken       json.RawMessage
+	RateDecks       map[string]*RateDeckCfg // rate deck layouts, by ID
 }
 
-func (ld *LoaderCgrCfg) loadFromJSONCfg(jsnCfg *LoaderCfgJson) (err error) {
+func (ld *LoaderCgrCfg) loadFromJSONCfg(jsnCfg *LoaderCfgJson, sep string) (err error) {
 	if jsnCfg == nil {
 		return
 	}
@@ -79,11 +80,43 @@ func (ld *LoaderCgrCfg) loadFromJSONCfg(jsnCfg *LoaderCfgJson) (err error) {
 	if jsnCfg.Gapi_token != nil {
 		ld.GapiToken = *jsnCfg.Gapi_token
 	}
+	if jsnCfg.Rate_decks != nil {
+		if ld.RateDecks == nil {
+			ld.RateDecks = make(map[string]*RateDeckCfg)
+		}
+		// the *default layout is loaded first since the others start from it
+		if err = ld.loadRateDeck(utils.MetaDefault, jsnCfg.Rate_decks[utils.MetaDefault], sep); err != nil {
+			return
+		}
+		for id, jsnRd := range jsnCfg.Rate_decks {
+			if id == utils.MetaDefault {
+				continue
+			}
+			if err = ld.loadRateDeck(id, jsnRd, sep); err != nil {
+				return
+			}
+		}
+	}
 	return nil
 }
 
+func (ld *LoaderCgrCfg) loadRateDeck(id string, jsnRd *RateDeckJsonCfg, sep string) (err error) {
+	if jsnRd == nil {
+		return
+	}
+	rd, has := ld.RateDecks[id]
+	if !has {
+		if rd = ld.RateDecks[utils.MetaDefault].Clone(); rd == nil {
+			rd = new(RateDeckCfg)
+		}
+		rd.ID = id
+		ld.RateDecks[id] = rd
+	}
+	return rd.loadFromJSONCfg(jsnRd, sep)
+}
+
 // AsMapInterface returns the config as a map[string]any
-func (ld *LoaderCgrCfg) AsMapInterface() (initialMP map[string]any) {
+func (ld *LoaderCgrCfg) AsMapInterface(separator string) (initialMP map[string]any) {
 	initialMP = map[string]any{
 		utils.TpIDCfg:           ld.TpID,
 		utils.DataPathCfg:       ld.DataPath,
@@ -116,6 +149,13 @@ func (ld *LoaderCgrCfg) AsMapInterface() (initialMP map[string]any) {
 	if ld.GapiToken != nil {
 		initialMP[utils.GapiTokenCfg] = ld.GapiToken
 	}
+	if ld.RateDecks != nil {
+		rateDecks := make(map[string]any, len(ld.RateDecks))
+		for id, rd := range ld.RateDecks {
+			rateDecks[id] = rd.AsMapInterface(separator)
+		}
+		initialMP[utils.RateDecksCfg] = rateDecks
+	}
 	return
 }
 
@@ -141,5 +181,11 @@ func (ld *LoaderCgrCfg) Clone() (cln *LoaderCgrCfg) {
 		cln.SchedulerConns = make([]string, len(ld.SchedulerConns))
 		copy(cln.SchedulerConns, ld.SchedulerConns)
 	}
+	if ld.RateDecks != nil {
+		cln.RateDecks = make(map[string]*RateDeckCfg, len(ld.RateDecks))
+		for id, rd := range ld.RateDecks {
+			cln.RateDecks[id] = rd.Clone()
+		}
+	}
 	return
 }
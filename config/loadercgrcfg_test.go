@@ -35,7 +35,24 @@ func TestLoaderCgrCfgloadFromJsonCfg(t *testing.T) {
 		Scheduler_conns:  &[]string{utils.MetaInternal},
 		Gapi_credentials: &json.RawMessage{12, 13, 60},
 		Gapi_token:       &json.RawMessage{13, 16},
+		Rate_decks: map[string]*RateDeckJsonCfg{
+			"CARRIER1": {
+				Field_separator: utils.StringPointer(";"),
+				Header_lines:    utils.IntPointer(0),
+				Deleted_values:  &[]string{"Closed"},
+				Rate:            utils.StringPointer("~*req.Price"),
+				Change:          utils.StringPointer("~*req.4"),
+			},
+		},
 	}
+	jsnCfg := NewDefaultCGRConfig()
+	rdCfg := jsnCfg.loaderCgrCfg.RateDecks[utils.MetaDefault].Clone()
+	rdCfg.ID = "CARRIER1"
+	rdCfg.FieldSeparator = ";"
+	rdCfg.HeaderLines = 0
+	rdCfg.DeletedValues = []string{"Closed"}
+	rdCfg.Rate = NewRSRParsersMustCompile("~*req.Price", utils.InfieldSep)
+	rdCfg.Change = NewRSRParsersMustCompile("~*req.4", utils.InfieldSep)
 	expected := &LoaderCgrCfg{
 		TpID:            "randomID",
 		DataPath:        "./",
@@ -45,9 +62,12 @@ func TestLoaderCgrCfgloadFromJsonCfg(t *testing.T) {
 		SchedulerConns:  []string{"*internal:*scheduler"},
 		GapiCredentials: json.RawMessage{12, 13, 60},
 		GapiToken:       json.RawMessage{13, 16},
+		RateDecks: map[string]*RateDeckCfg{
+			utils.MetaDefault: jsnCfg.loaderCgrCfg.RateDecks[utils.MetaDefault].Clone(),
+			"CARRIER1":        rdCfg,
+		},
 	}
-	jsnCfg := NewDefaultCGRConfig()
-	if err := jsnCfg.loaderCgrCfg.loadFromJSONCfg(cfgJSON); err != nil {
+	if err := jsnCfg.loaderCgrCfg.loadFromJSONCfg(cfgJSON, utils.InfieldSep); err != nil {
 		t.Error(err)
 	} else if !reflect.DeepEqual(expected, jsnCfg.loaderCgrCfg) {
 		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(expected), utils.ToJSON(jsnCfg.loaderCgrCfg))
@@ -64,7 +84,13 @@ func TestLoaderCgrCfgAsMapInterface(t *testing.T) {
 		"caches_conns":["*internal","*localhost"],
 		"scheduler_conns": ["*internal","*localhost"],
 		"gapi_credentials": ".gapi/credentials.json",
-		"gapi_token": ".gapi/token.json"
+		"gapi_token": ".gapi/token.json",
+		"rate_decks": {
+			"CARRIER1": {
+				"header_lines": 0,
+				"rate": "~*req.Price",
+			},
+		},
 	},
 }`
 	eMap := map[string]any{
@@ -76,10 +102,46 @@ func TestLoaderCgrCfgAsMapInterface(t *testing.T) {
 		utils.SchedulerConnsCfg:  []string{"*internal", "*localhost"},
 		utils.GapiCredentialsCfg: json.RawMessage(`".gapi/credentials.json"`),
 		utils.GapiTokenCfg:       json.RawMessage(`".gapi/token.json"`),
+		utils.RateDecksCfg: map[string]any{
+			utils.MetaDefault: map[string]any{
+				utils.FieldSepCfg:         ",",
+				utils.HeaderLinesCfg:      1,
+				utils.TimezoneCfg:         "",
+				utils.FullDeckCfg:         false,
+				utils.DeletedValuesCfg:    []string{},
+				utils.RoundingMethodCfg:   utils.MetaRoundingUp,
+				utils.RoundingDecimalsCfg: 4,
+				utils.PrefixCfg:           "~*req.0",
+				utils.DestinationCfg:      "~*req.1",
+				utils.RateCfg:             "~*req.2",
+				utils.ConnectFeeCfg:       "0",
+				utils.RateUnitCfg:         "60s",
+				utils.RateIncrementCfg:    "60s",
+				utils.EffectiveDateCfg:    "~*req.3",
+				utils.ChangeCfg:           "",
+			},
+			"CARRIER1": map[string]any{
+				utils.FieldSepCfg:         ",",
+				utils.HeaderLinesCfg:      0,
+				utils.TimezoneCfg:         "",
+				utils.FullDeckCfg:         false,
+				utils.DeletedValuesCfg:    []string{},
+				utils.RoundingMethodCfg:   utils.MetaRoundingUp,
+				utils.RoundingDecimalsCfg: 4,
+				utils.PrefixCfg:           "~*req.0",
+				utils.DestinationCfg:      "~*req.1",
+				utils.RateCfg:             "~*req.Price",
+				utils.ConnectFeeCfg:       "0",
+				utils.RateUnitCfg:         "60s",
+				utils.RateIncrementCfg:    "60s",
+				utils.EffectiveDateCfg:    "~*req.3",
+				utils.ChangeCfg:           "",
+			},
+		},
 	}
 	if cgrCfg, err := NewCGRConfigFromJSONStringWithDefaults(cfgJSONStr); err != nil {
 		t.Error(err)
-	} else if rcv := cgrCfg.loaderCgrCfg.AsMapInterface(); !reflect.DeepEqual(rcv, eMap) {
+	} else if rcv := cgrCfg.loaderCgrCfg.AsMapInterface(utils.InfieldSep); !reflect.DeepEqual(rcv, eMap) {
 		t.Errorf("Expected %+v \n, received %+v", utils.ToJSON(eMap), utils.ToJSON(rcv))
 	}
 }
@@ -94,6 +156,15 @@ func TestLoaderCgrCfgClone(t *testing.T) {
 		SchedulerConns:  []string{"*internal:*scheduler"},
 		GapiCredentials: json.RawMessage{12, 13, 60},
 		GapiToken:       json.RawMessage{13, 16},
+		RateDecks: map[string]*RateDeckCfg{
+			utils.MetaDefault: {
+				ID:             utils.MetaDefault,
+				FieldSeparator: ",",
+				DeletedValues:  []string{"Closed"},
+				Prefix:         NewRSRParsersMustCompile("~*req.0", utils.InfieldSep),
+				Rate:           NewRSRParsersMustCompile("~*req.2", utils.InfieldSep),
+			},
+		},
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
@@ -111,6 +182,9 @@ func TestLoaderCgrCfgClone(t *testing.T) {
 	if rcv.GapiToken[0] = 0; ban.GapiToken[0] != 13 {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.RateDecks[utils.MetaDefault].DeletedValues[0] = ""; ban.RateDecks[utils.MetaDefault].DeletedValues[0] != "Closed" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 
 	ban = nil
 	rcv = ban.Clone()
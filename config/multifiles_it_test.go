@@ -54,27 +54,28 @@ func TestMfGeneralItems(t *testing.T) {
 
 func TestMfEnvReaderITRead(t *testing.T) {
 	expected := GeneralCfg{
-		NodeID:           "d80fac5",
-		Logger:           "*syslog",
-		LogLevel:         6,
-		RoundingDecimals: 5,
-		DBDataEncoding:   "msgpack",
-		TpExportPath:     "/var/spool/cgrates/tpe",
-		PosterAttempts:   3,
-		DefaultReqType:   utils.MetaPseudoPrepaid,
-		DefaultCategory:  "call",
-		DefaultTenant:    "cgrates.org",
-		DefaultCaching:   utils.MetaReload,
-		DefaultTimezone:  "Local",
-		ConnectAttempts:  3,
-		Reconnects:       -1,
-		ConnectTimeout:   time.Second,
-		ReplyTimeout:     2 * time.Second,
-		LockingTimeout:   0,
-		DigestSeparator:  ",",
-		DigestEqual:      ":",
-		RSRSep:           ";",
-		MaxParallelConns: 100,
+		NodeID:             "d80fac5",
+		Logger:             "*syslog",
+		LogLevel:           6,
+		RoundingDecimals:   5,
+		DBDataEncoding:     "msgpack",
+		TpExportPath:       "/var/spool/cgrates/tpe",
+		PosterAttempts:     3,
+		DefaultReqType:     utils.MetaPseudoPrepaid,
+		DefaultCategory:    "call",
+		DefaultTenant:      "cgrates.org",
+		DefaultCaching:     utils.MetaReload,
+		DefaultTimezone:    "Local",
+		ConnectAttempts:    3,
+		Reconnects:         -1,
+		ConnectTimeout:     time.Second,
+		ReplyTimeout:       2 * time.Second,
+		LockingTimeout:     0,
+		DigestSeparator:    ",",
+		DigestEqual:        ":",
+		RSRSep:             ";",
+		MaxParallelConns:   100,
+		SubscriberQueueLen: 1000,
 	}
 	if !reflect.DeepEqual(expected, *mfCgrCfg.generalCfg) {
 		t.Errorf("Expected: %+v\n, received: %+v", utils.ToJSON(expected), utils.ToJSON(*mfCgrCfg.generalCfg))
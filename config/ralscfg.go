@@ -19,6 +19,7 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package config
 
 import (
+	"slices"
 	"strconv"
 	"time"
 
@@ -37,6 +38,15 @@ type RalsCfg struct {
 	BalanceRatingSubject    map[string]string
 	MaxIncrements           int
 	FallbackDepth           int
+	Discounts               bool // apply the matching DiscountProfiles on debits
+
+	// filter indexes used when matching the DiscountProfiles
+	DiscountsIndexedSelects      bool
+	DiscountsStringIndexedFields *[]string
+	DiscountsPrefixIndexedFields *[]string
+	DiscountsSuffixIndexedFields *[]string
+	DiscountsExistsIndexedFields *[]string
+	DiscountsNestedFields        bool
 }
 
 // loadFromJSONCfg loads Rals config from JsonCfg
@@ -96,6 +106,31 @@ func (ralsCfg *RalsCfg) loadFromJSONCfg(jsnRALsCfg *RalsJsonCfg) (err error) {
 	if jsnRALsCfg.Fallback_depth != nil {
 		ralsCfg.FallbackDepth = *jsnRALsCfg.Fallback_depth
 	}
+	if jsnRALsCfg.Discounts != nil {
+		ralsCfg.Discounts = *jsnRALsCfg.Discounts
+	}
+	if jsnRALsCfg.Discounts_indexed_selects != nil {
+		ralsCfg.DiscountsIndexedSelects = *jsnRALsCfg.Discounts_indexed_selects
+	}
+	if jsnRALsCfg.Discounts_string_indexed_fields != nil {
+		sif := slices.Clone(*jsnRALsCfg.Discounts_string_indexed_fields)
+		ralsCfg.DiscountsStringIndexedFields = &sif
+	}
+	if jsnRALsCfg.Discounts_prefix_indexed_fields != nil {
+		pif := slices.Clone(*jsnRALsCfg.Discounts_prefix_indexed_fields)
+		ralsCfg.DiscountsPrefixIndexedFields = &pif
+	}
+	if jsnRALsCfg.Discounts_suffix_indexed_fields != nil {
+		sif := slices.Clone(*jsnRALsCfg.Discounts_suffix_indexed_fields)
+		ralsCfg.DiscountsSuffixIndexedFields = &sif
+	}
+	if jsnRALsCfg.Discounts_exists_indexed_fields != nil {
+		eif := slices.Clone(*jsnRALsCfg.Discounts_exists_indexed_fields)
+		ralsCfg.DiscountsExistsIndexedFields = &eif
+	}
+	if jsnRALsCfg.Discounts_nested_fields != nil {
+		ralsCfg.DiscountsNestedFields = *jsnRALsCfg.Discounts_nested_fields
+	}
 	if jsnRALsCfg.Balance_rating_subject != nil {
 		for k, v := range *jsnRALsCfg.Balance_rating_subject {
 			ralsCfg.BalanceRatingSubject[k] = v
@@ -113,6 +148,21 @@ func (ralsCfg *RalsCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.RemoveExpiredCfg:           ralsCfg.RemoveExpired,
 		utils.MaxIncrementsCfg:           ralsCfg.MaxIncrements,
 		utils.FallbackDepthCfg:           ralsCfg.FallbackDepth,
+		utils.DiscountsCfg:               ralsCfg.Discounts,
+		utils.DiscountsIndexedSelectsCfg: ralsCfg.DiscountsIndexedSelects,
+		utils.DiscountsNestedFieldsCfg:   ralsCfg.DiscountsNestedFields,
+	}
+	if ralsCfg.DiscountsStringIndexedFields != nil {
+		initialMP[utils.DiscountsStringIndexedFieldsCfg] = slices.Clone(*ralsCfg.DiscountsStringIndexedFields)
+	}
+	if ralsCfg.DiscountsPrefixIndexedFields != nil {
+		initialMP[utils.DiscountsPrefixIndexedFieldsCfg] = slices.Clone(*ralsCfg.DiscountsPrefixIndexedFields)
+	}
+	if ralsCfg.DiscountsSuffixIndexedFields != nil {
+		initialMP[utils.DiscountsSuffixIndexedFieldsCfg] = slices.Clone(*ralsCfg.DiscountsSuffixIndexedFields)
+	}
+	if ralsCfg.DiscountsExistsIndexedFields != nil {
+		initialMP[utils.DiscountsExistsIndexedFieldsCfg] = slices.Clone(*ralsCfg.DiscountsExistsIndexedFields)
 	}
 	if ralsCfg.ThresholdSConns != nil {
 		threSholds := make([]string, len(ralsCfg.ThresholdSConns))
@@ -172,6 +222,9 @@ func (ralsCfg *RalsCfg) Clone() (cln *RalsCfg) {
 		RemoveExpired:           ralsCfg.RemoveExpired,
 		MaxIncrements:           ralsCfg.MaxIncrements,
 		FallbackDepth:           ralsCfg.FallbackDepth,
+		Discounts:               ralsCfg.Discounts,
+		DiscountsIndexedSelects: ralsCfg.DiscountsIndexedSelects,
+		DiscountsNestedFields:   ralsCfg.DiscountsNestedFields,
 
 		MaxComputedUsage:     make(map[string]time.Duration),
 		BalanceRatingSubject: make(map[string]string),
@@ -188,6 +241,22 @@ func (ralsCfg *RalsCfg) Clone() (cln *RalsCfg) {
 		cln.SessionSConns = make([]string, len(ralsCfg.SessionSConns))
 		copy(cln.SessionSConns, ralsCfg.SessionSConns)
 	}
+	if ralsCfg.DiscountsStringIndexedFields != nil {
+		idx := slices.Clone(*ralsCfg.DiscountsStringIndexedFields)
+		cln.DiscountsStringIndexedFields = &idx
+	}
+	if ralsCfg.DiscountsPrefixIndexedFields != nil {
+		idx := slices.Clone(*ralsCfg.DiscountsPrefixIndexedFields)
+		cln.DiscountsPrefixIndexedFields = &idx
+	}
+	if ralsCfg.DiscountsSuffixIndexedFields != nil {
+		idx := slices.Clone(*ralsCfg.DiscountsSuffixIndexedFields)
+		cln.DiscountsSuffixIndexedFields = &idx
+	}
+	if ralsCfg.DiscountsExistsIndexedFields != nil {
+		idx := slices.Clone(*ralsCfg.DiscountsExistsIndexedFields)
+		cln.DiscountsExistsIndexedFields = &idx
+	}
 
 	for k, u := range ralsCfg.MaxComputedUsage {
 		cln.MaxComputedUsage[k] = u
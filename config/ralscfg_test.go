@@ -45,6 +45,7 @@ func TestRalsCfgFromJsonCfgCase1(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		Discounts_string_indexed_fields: &[]string{"*req.Account"},
 	}
 	expected := &RalsCfg{
 		Enabled:                 true,
@@ -66,6 +67,11 @@ func TestRalsCfgFromJsonCfgCase1(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		DiscountsIndexedSelects:      true,
+		DiscountsStringIndexedFields: &[]string{"*req.Account"},
+		DiscountsPrefixIndexedFields: &[]string{},
+		DiscountsSuffixIndexedFields: &[]string{},
+		DiscountsExistsIndexedFields: &[]string{},
 	}
 	cfg := NewDefaultCGRConfig()
 	if err := cfg.ralsCfg.loadFromJSONCfg(cfgJSON); err != nil {
@@ -119,6 +125,13 @@ func TestRalsCfgAsMapInterfaceCase1(t *testing.T) {
 		},
 		utils.MaxIncrementsCfg: 1000000,
 		utils.FallbackDepthCfg: 3,
+		utils.DiscountsCfg:     false,
+
+		utils.DiscountsIndexedSelectsCfg:      true,
+		utils.DiscountsNestedFieldsCfg:        false,
+		utils.DiscountsPrefixIndexedFieldsCfg: []string{},
+		utils.DiscountsSuffixIndexedFieldsCfg: []string{},
+		utils.DiscountsExistsIndexedFieldsCfg: []string{},
 		utils.BalanceRatingSubjectCfg: map[string]string{
 			"*any":   "*zero1ns",
 			"*voice": "*zero1s",
@@ -154,6 +167,13 @@ func TestRalsCfgAsMapInterfaceCase2(t *testing.T) {
 		},
 		utils.MaxIncrementsCfg: 1000000,
 		utils.FallbackDepthCfg: 3,
+		utils.DiscountsCfg:     false,
+
+		utils.DiscountsIndexedSelectsCfg:      true,
+		utils.DiscountsNestedFieldsCfg:        false,
+		utils.DiscountsPrefixIndexedFieldsCfg: []string{},
+		utils.DiscountsSuffixIndexedFieldsCfg: []string{},
+		utils.DiscountsExistsIndexedFieldsCfg: []string{},
 		utils.BalanceRatingSubjectCfg: map[string]string{
 			"*any":   "*zero1ns",
 			"*voice": "*zero1s",
@@ -187,6 +207,8 @@ func TestRalsCfgClone(t *testing.T) {
 			utils.MetaAny:   "*zero1ns",
 			utils.MetaVoice: "*zero1s",
 		},
+		DiscountsIndexedSelects:      true,
+		DiscountsStringIndexedFields: &[]string{"*req.Account"},
 	}
 	rcv := ban.Clone()
 	if !reflect.DeepEqual(ban, rcv) {
@@ -207,6 +229,9 @@ func TestRalsCfgClone(t *testing.T) {
 	if rcv.BalanceRatingSubject[utils.MetaAny] = ""; ban.BalanceRatingSubject[utils.MetaAny] != "*zero1ns" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if (*rcv.DiscountsStringIndexedFields)[0] = ""; (*ban.DiscountsStringIndexedFields)[0] != "*req.Account" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 
 	ban = nil
 	rcv = ban.Clone()
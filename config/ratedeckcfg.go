@@ -0,0 +1,143 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"slices"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// RateDeckCfg is the layout of a carrier rate deck
+type RateDeckCfg struct {
+	ID               string
+	FieldSeparator   string
+	HeaderLines      int // the last header line names the columns
+	Timezone         string
+	FullDeck         bool // the codes missing from the deck are deleted
+	DeletedValues    []string
+	RoundingMethod   string
+	RoundingDecimals int
+	Prefix           RSRParsers
+	Destination      RSRParsers
+	Rate             RSRParsers
+	ConnectFee       RSRParsers
+	RateUnit         RSRParsers
+	RateIncrement    RSRParsers
+	EffectiveDate    RSRParsers
+	Change           RSRParsers
+}
+
+func (rd *RateDeckCfg) loadFromJSONCfg(jsnCfg *RateDeckJsonCfg, sep string) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Field_separator != nil {
+		rd.FieldSeparator = *jsnCfg.Field_separator
+	}
+	if jsnCfg.Header_lines != nil {
+		rd.HeaderLines = *jsnCfg.Header_lines
+	}
+	if jsnCfg.Timezone != nil {
+		rd.Timezone = *jsnCfg.Timezone
+	}
+	if jsnCfg.Full_deck != nil {
+		rd.FullDeck = *jsnCfg.Full_deck
+	}
+	if jsnCfg.Deleted_values != nil {
+		rd.DeletedValues = slices.Clone(*jsnCfg.Deleted_values)
+	}
+	if jsnCfg.Rounding_method != nil {
+		rd.RoundingMethod = *jsnCfg.Rounding_method
+	}
+	if jsnCfg.Rounding_decimals != nil {
+		rd.RoundingDecimals = *jsnCfg.Rounding_decimals
+	}
+	for _, fld := range []struct {
+		rsr  *RSRParsers
+		rule *string
+	}{
+		{&rd.Prefix, jsnCfg.Prefix},
+		{&rd.Destination, jsnCfg.Destination},
+		{&rd.Rate, jsnCfg.Rate},
+		{&rd.ConnectFee, jsnCfg.Connect_fee},
+		{&rd.RateUnit, jsnCfg.Rate_unit},
+		{&rd.RateIncrement, jsnCfg.Rate_increment},
+		{&rd.EffectiveDate, jsnCfg.Effective_date},
+		{&rd.Change, jsnCfg.Change},
+	} {
+		if fld.rule == nil {
+			continue
+		}
+		if *fld.rsr, err = NewRSRParsers(*fld.rule, sep); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (rd *RateDeckCfg) AsMapInterface(sep string) map[string]any {
+	deletedValues := []string{}
+	if rd.DeletedValues != nil {
+		deletedValues = slices.Clone(rd.DeletedValues)
+	}
+	return map[string]any{
+		utils.FieldSepCfg:         rd.FieldSeparator,
+		utils.HeaderLinesCfg:      rd.HeaderLines,
+		utils.TimezoneCfg:         rd.Timezone,
+		utils.FullDeckCfg:         rd.FullDeck,
+		utils.DeletedValuesCfg:    deletedValues,
+		utils.RoundingMethodCfg:   rd.RoundingMethod,
+		utils.RoundingDecimalsCfg: rd.RoundingDecimals,
+		utils.PrefixCfg:           rd.Prefix.GetRule(sep),
+		utils.DestinationCfg:      rd.Destination.GetRule(sep),
+		utils.RateCfg:             rd.Rate.GetRule(sep),
+		utils.ConnectFeeCfg:       rd.ConnectFee.GetRule(sep),
+		utils.RateUnitCfg:         rd.RateUnit.GetRule(sep),
+		utils.RateIncrementCfg:    rd.RateIncrement.GetRule(sep),
+		utils.EffectiveDateCfg:    rd.EffectiveDate.GetRule(sep),
+		utils.ChangeCfg:           rd.Change.GetRule(sep),
+	}
+}
+
+// Clone returns a deep copy of RateDeckCfg
+func (rd *RateDeckCfg) Clone() *RateDeckCfg {
+	if rd == nil {
+		return nil
+	}
+	return &RateDeckCfg{
+		ID:               rd.ID,
+		FieldSeparator:   rd.FieldSeparator,
+		HeaderLines:      rd.HeaderLines,
+		Timezone:         rd.Timezone,
+		FullDeck:         rd.FullDeck,
+		DeletedValues:    slices.Clone(rd.DeletedValues),
+		RoundingMethod:   rd.RoundingMethod,
+		RoundingDecimals: rd.RoundingDecimals,
+		Prefix:           rd.Prefix.Clone(),
+		Destination:      rd.Destination.Clone(),
+		Rate:             rd.Rate.Clone(),
+		ConnectFee:       rd.ConnectFee.Clone(),
+		RateUnit:         rd.RateUnit.Clone(),
+		RateIncrement:    rd.RateIncrement.Clone(),
+		EffectiveDate:    rd.EffectiveDate.Clone(),
+		Change:           rd.Change.Clone(),
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"slices"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// RBACRoleCfg is one role of the API keys
+type RBACRoleCfg struct {
+	Methods []string        // patterns of the allowed API methods, e.g. APIerSv1.Get*
+	Tenants utils.StringSet // allowed tenants, empty for any
+}
+
+func (rl *RBACRoleCfg) loadFromJSONCfg(jsnCfg *RBACRoleJsonCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Methods != nil {
+		rl.Methods = slices.Clone(*jsnCfg.Methods)
+	}
+	if jsnCfg.Tenants != nil {
+		rl.Tenants = utils.NewStringSet(*jsnCfg.Tenants)
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (rl *RBACRoleCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.MethodsCfg: slices.Clone(rl.Methods),
+		utils.Tenants:    rl.Tenants.AsOrderedSlice(),
+	}
+}
+
+// Clone returns a deep copy of RBACRoleCfg
+func (rl *RBACRoleCfg) Clone() *RBACRoleCfg {
+	return &RBACRoleCfg{
+		Methods: slices.Clone(rl.Methods),
+		Tenants: rl.Tenants.Clone(),
+	}
+}
+
+// RBACAPIKeyCfg is the role of one API key
+type RBACAPIKeyCfg struct {
+	Role    string
+	Tenants utils.StringSet // restricts further the tenants of the role, empty for the role ones
+}
+
+func (ak *RBACAPIKeyCfg) loadFromJSONCfg(jsnCfg *RBACAPIKeyJsonCfg) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Role != nil {
+		ak.Role = *jsnCfg.Role
+	}
+	if jsnCfg.Tenants != nil {
+		ak.Tenants = utils.NewStringSet(*jsnCfg.Tenants)
+	}
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (ak *RBACAPIKeyCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.RoleCfg: ak.Role,
+		utils.Tenants: ak.Tenants.AsOrderedSlice(),
+	}
+}
+
+// Clone returns a deep copy of RBACAPIKeyCfg
+func (ak *RBACAPIKeyCfg) Clone() *RBACAPIKeyCfg {
+	return &RBACAPIKeyCfg{
+		Role:    ak.Role,
+		Tenants: ak.Tenants.Clone(),
+	}
+}
+
+// RBACCfg the config for the role based access to the API
+type RBACCfg struct {
+	Enabled     bool
+	DefaultRole string // role of the requests without API key, empty to deny them
+	Roles       map[string]*RBACRoleCfg
+	APIKeys     map[string]*RBACAPIKeyCfg
+}
+
+func (rb *RBACCfg) loadFromJSONCfg(jsnCfg *RBACJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Enabled != nil {
+		rb.Enabled = *jsnCfg.Enabled
+	}
+	if jsnCfg.Default_role != nil {
+		rb.DefaultRole = *jsnCfg.Default_role
+	}
+	for name, jsnRl := range jsnCfg.Roles {
+		if rb.Roles[name] == nil {
+			rb.Roles[name] = new(RBACRoleCfg)
+		}
+		rb.Roles[name].loadFromJSONCfg(jsnRl)
+	}
+	for key, jsnAk := range jsnCfg.Api_keys {
+		if rb.APIKeys[key] == nil {
+			rb.APIKeys[key] = new(RBACAPIKeyCfg)
+		}
+		rb.APIKeys[key].loadFromJSONCfg(jsnAk)
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (rb *RBACCfg) AsMapInterface() map[string]any {
+	roles := make(map[string]any, len(rb.Roles))
+	for name, rl := range rb.Roles {
+		roles[name] = rl.AsMapInterface()
+	}
+	apiKeys := make(map[string]any, len(rb.APIKeys))
+	for key, ak := range rb.APIKeys {
+		apiKeys[key] = ak.AsMapInterface()
+	}
+	return map[string]any{
+		utils.EnabledCfg:     rb.Enabled,
+		utils.DefaultRoleCfg: rb.DefaultRole,
+		utils.RolesCfg:       roles,
+		utils.APIKeysCfg:     apiKeys,
+	}
+}
+
+// Clone returns a deep copy of RBACCfg
+func (rb *RBACCfg) Clone() *RBACCfg {
+	if rb == nil {
+		return nil
+	}
+	cln := &RBACCfg{
+		Enabled:     rb.Enabled,
+		DefaultRole: rb.DefaultRole,
+		Roles:       make(map[string]*RBACRoleCfg, len(rb.Roles)),
+		APIKeys:     make(map[string]*RBACAPIKeyCfg, len(rb.APIKeys)),
+	}
+	for name, rl := range rb.Roles {
+		cln.Roles[name] = rl.Clone()
+	}
+	for key, ak := range rb.APIKeys {
+		cln.APIKeys[key] = ak.Clone()
+	}
+	return cln
+}
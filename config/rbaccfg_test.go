@@ -0,0 +1,162 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestRBACCfgloadFromJsonCfg(t *testing.T) {
+	rbCfg := &RBACCfg{
+		Roles:   make(map[string]*RBACRoleCfg),
+		APIKeys: make(map[string]*RBACAPIKeyCfg),
+	}
+	expected := &RBACCfg{
+		Roles:   make(map[string]*RBACRoleCfg),
+		APIKeys: make(map[string]*RBACAPIKeyCfg),
+	}
+	if err := rbCfg.loadFromJSONCfg(nil); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(rbCfg, expected) {
+		t.Errorf("Expected: %+v ,received: %+v", expected, rbCfg)
+	}
+	cfgJSONStr := `{
+		"rbac": {
+			"enabled": true,
+			"default_role": "monitor",
+			"roles": {
+				"monitor": {"methods": ["CoreSv1.Status"]},
+				"reseller": {"methods": ["APIerSv1.Get*", "SessionSv1.*"], "tenants": ["cgrates.org", "itsyscom.com"]},
+			},
+			"api_keys": {
+				"key1": {"role": "reseller", "tenants": ["cgrates.org"]},
+			},
+		},
+}`
+	expected = &RBACCfg{
+		Enabled:     true,
+		DefaultRole: "monitor",
+		Roles: map[string]*RBACRoleCfg{
+			"monitor": {Methods: []string{"CoreSv1.Status"}},
+			"reseller": {
+				Methods: []string{"APIerSv1.Get*", "SessionSv1.*"},
+				Tenants: utils.NewStringSet([]string{"cgrates.org", "itsyscom.com"}),
+			},
+		},
+		APIKeys: map[string]*RBACAPIKeyCfg{
+			"key1": {Role: "reseller", Tenants: utils.NewStringSet([]string{"cgrates.org"})},
+		},
+	}
+	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
+		t.Error(err)
+	} else if jsnRbCfg, err := jsnCfg.RBACJson(); err != nil {
+		t.Error(err)
+	} else if err = rbCfg.loadFromJSONCfg(jsnRbCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, rbCfg) {
+		t.Errorf("Expected: %+v , received: %+v", utils.ToJSON(expected), utils.ToJSON(rbCfg))
+	}
+}
+
+func TestRBACCfgAsMapInterface(t *testing.T) {
+	rbCfg := &RBACCfg{
+		Enabled: true,
+		Roles: map[string]*RBACRoleCfg{
+			"reseller": {
+				Methods: []string{"APIerSv1.Get*"},
+				Tenants: utils.NewStringSet([]string{"itsyscom.com", "cgrates.org"}),
+			},
+		},
+		APIKeys: map[string]*RBACAPIKeyCfg{
+			"key1": {Role: "reseller"},
+		},
+	}
+	eMap := map[string]any{
+		utils.EnabledCfg:     true,
+		utils.DefaultRoleCfg: utils.EmptyString,
+		utils.RolesCfg: map[string]any{
+			"reseller": map[string]any{
+				utils.MethodsCfg: []string{"APIerSv1.Get*"},
+				utils.Tenants:    []string{"cgrates.org", "itsyscom.com"},
+			},
+		},
+		utils.APIKeysCfg: map[string]any{
+			"key1": map[string]any{
+				utils.RoleCfg: "reseller",
+				utils.Tenants: []string(nil),
+			},
+		},
+	}
+	if rcv := rbCfg.AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+}
+
+func TestRBACCfgClone(t *testing.T) {
+	rbCfg := &RBACCfg{
+		Enabled:     true,
+		DefaultRole: "reseller",
+		Roles: map[string]*RBACRoleCfg{
+			"reseller": {
+				Methods: []string{"APIerSv1.Get*"},
+				Tenants: utils.NewStringSet([]string{"cgrates.org"}),
+			},
+		},
+		APIKeys: map[string]*RBACAPIKeyCfg{
+			"key1": {Role: "reseller"},
+		},
+	}
+	rcv := rbCfg.Clone()
+	if !reflect.DeepEqual(rbCfg, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(rbCfg), utils.ToJSON(rcv))
+	}
+	if rcv.Roles["reseller"].Methods[0] = "*"; rbCfg.Roles["reseller"].Methods[0] != "APIerSv1.Get*" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	rbCfg = nil
+	if rcv = rbCfg.Clone(); rcv != nil {
+		t.Errorf("Expected nil, received: %+v", utils.ToJSON(rcv))
+	}
+}
+
+func TestRBACCfgSanity(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.rbacCfg.Enabled = true
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+	cfg.rbacCfg.DefaultRole = "monitor"
+	expErr := `<RBAC> undefined default_role: "monitor"`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+	cfg.rbacCfg.Roles["monitor"] = &RBACRoleCfg{Methods: []string{"CoreSv1.[Status"}}
+	expErr = `<RBAC> invalid method pattern "CoreSv1.[Status" for role <monitor>: syntax error in pattern`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+	cfg.rbacCfg.Roles["monitor"].Methods = []string{"CoreSv1.*"}
+	cfg.rbacCfg.APIKeys["key1"] = &RBACAPIKeyCfg{Role: "reseller"}
+	expErr = `<RBAC> undefined role "reseller" for API key <key1>`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+}
@@ -20,6 +20,7 @@ package config
 
 import (
 	"slices"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -47,9 +48,62 @@ type RouteSCfg struct {
 	RALsConns           []string
 	DefaultRatio        int
 	NestedFields        bool
+	Breaker             *RouteBreakerCfg
 	Opts                *RoutesOpts
 }
 
+// RouteBreakerCfg is the configuration of the route circuit breakers
+type RouteBreakerCfg struct {
+	FailureThreshold int // consecutive failures opening the breaker, 0 disables the breakers
+	Cooldown         time.Duration
+	HalfOpenProbes   int
+	FailureFilters   []string
+}
+
+func (brkCfg *RouteBreakerCfg) loadFromJSONCfg(jsnCfg *RouteBreakerJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Failure_threshold != nil {
+		brkCfg.FailureThreshold = *jsnCfg.Failure_threshold
+	}
+	if jsnCfg.Cooldown != nil {
+		if brkCfg.Cooldown, err = utils.ParseDurationWithNanosecs(*jsnCfg.Cooldown); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Half_open_probes != nil {
+		brkCfg.HalfOpenProbes = *jsnCfg.Half_open_probes
+	}
+	if jsnCfg.Failure_filters != nil {
+		brkCfg.FailureFilters = slices.Clone(*jsnCfg.Failure_filters)
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (brkCfg *RouteBreakerCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.FailureThresholdCfg: brkCfg.FailureThreshold,
+		utils.CooldownCfg:         brkCfg.Cooldown.String(),
+		utils.HalfOpenProbesCfg:   brkCfg.HalfOpenProbes,
+		utils.FailureFiltersCfg:   slices.Clone(brkCfg.FailureFilters),
+	}
+}
+
+// Clone returns a deep copy of RouteBreakerCfg
+func (brkCfg *RouteBreakerCfg) Clone() *RouteBreakerCfg {
+	if brkCfg == nil {
+		return nil
+	}
+	return &RouteBreakerCfg{
+		FailureThreshold: brkCfg.FailureThreshold,
+		Cooldown:         brkCfg.Cooldown,
+		HalfOpenProbes:   brkCfg.HalfOpenProbes,
+		FailureFilters:   slices.Clone(brkCfg.FailureFilters),
+	}
+}
+
 func (rtsOpts *RoutesOpts) loadFromJSONCfg(jsnCfg *RoutesOptsJson) {
 	if jsnCfg == nil {
 		return
@@ -149,6 +203,11 @@ func (rts *RouteSCfg) loadFromJSONCfg(jsnCfg *RouteSJsonCfg) (err error) {
 	if jsnCfg.Nested_fields != nil {
 		rts.NestedFields = *jsnCfg.Nested_fields
 	}
+	if jsnCfg.Breaker != nil {
+		if err = rts.Breaker.loadFromJSONCfg(jsnCfg.Breaker); err != nil {
+			return
+		}
+	}
 	if jsnCfg.Opts != nil {
 		rts.Opts.loadFromJSONCfg(jsnCfg.Opts)
 	}
@@ -178,6 +237,7 @@ func (rts *RouteSCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.IndexedSelectsCfg: rts.IndexedSelects,
 		utils.DefaultRatioCfg:   rts.DefaultRatio,
 		utils.NestedFieldsCfg:   rts.NestedFields,
+		utils.BreakerCfg:        rts.Breaker.AsMapInterface(),
 		utils.OptsCfg:           opts,
 	}
 	if rts.StringIndexedFields != nil {
@@ -276,6 +336,7 @@ func (rts *RouteSCfg) Clone() (cln *RouteSCfg) {
 		IndexedSelects: rts.IndexedSelects,
 		DefaultRatio:   rts.DefaultRatio,
 		NestedFields:   rts.NestedFields,
+		Breaker:        rts.Breaker.Clone(),
 		Opts:           rts.Opts.Clone(),
 	}
 	if rts.AttributeSConns != nil {
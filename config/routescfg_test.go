@@ -20,6 +20,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cgrates/cgrates/utils"
 )
@@ -38,6 +39,12 @@ func TestRouteSCfgloadFromJsonCfg(t *testing.T) {
 		Rals_conns:            &[]string{utils.MetaInternal, "conn1"},
 		Default_ratio:         utils.IntPointer(10),
 		Nested_fields:         utils.BoolPointer(true),
+		Breaker: &RouteBreakerJsonCfg{
+			Failure_threshold: utils.IntPointer(3),
+			Cooldown:          utils.StringPointer("1m"),
+			Half_open_probes:  utils.IntPointer(2),
+			Failure_filters:   &[]string{"*string:~*req.DisconnectCause:503"},
+		},
 		Opts: &RoutesOptsJson{
 			MaxCost:      utils.IntPointer(3),
 			Limit:        utils.IntPointer(3),
@@ -58,6 +65,12 @@ func TestRouteSCfgloadFromJsonCfg(t *testing.T) {
 		RALsConns:           []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResponder), "conn1"},
 		DefaultRatio:        10,
 		NestedFields:        true,
+		Breaker: &RouteBreakerCfg{
+			FailureThreshold: 3,
+			Cooldown:         time.Minute,
+			HalfOpenProbes:   2,
+			FailureFilters:   []string{"*string:~*req.DisconnectCause:503"},
+		},
 		Opts: &RoutesOpts{
 			Context:      utils.MetaRoutes,
 			IgnoreErrors: false,
@@ -133,6 +146,10 @@ func TestRouteSCfgAsMapInterface1(t *testing.T) {
 			"stats_conns": ["*internal:*stats", "conn1"],
 			"rals_conns": ["*internal:*responder", "conn1"],
 			"default_ratio":2,
+			"breaker": {
+				"failure_threshold": 5,
+				"cooldown": "2m",
+			},
 		},
 	}`
 	eMap := map[string]any{
@@ -148,6 +165,12 @@ func TestRouteSCfgAsMapInterface1(t *testing.T) {
 		utils.StatSConnsCfg:          []string{utils.MetaInternal, "conn1"},
 		utils.RALsConnsCfg:           []string{utils.MetaInternal, "conn1"},
 		utils.DefaultRatioCfg:        2,
+		utils.BreakerCfg: map[string]any{
+			utils.FailureThresholdCfg: 5,
+			utils.CooldownCfg:         "2m0s",
+			utils.HalfOpenProbesCfg:   1,
+			utils.FailureFiltersCfg:   []string{"*prefix:~*req.DisconnectCause:5|408"},
+		},
 		utils.OptsCfg: map[string]any{
 			utils.OptsContext:         utils.MetaRoutes,
 			utils.MetaIgnoreErrorsCfg: false,
@@ -174,6 +197,12 @@ func TestRouteSCfgClone(t *testing.T) {
 		RALsConns:           []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResponder), "conn1"},
 		DefaultRatio:        10,
 		NestedFields:        true,
+		Breaker: &RouteBreakerCfg{
+			FailureThreshold: 3,
+			Cooldown:         time.Minute,
+			HalfOpenProbes:   1,
+			FailureFilters:   []string{"*string:~*req.DisconnectCause:503"},
+		},
 		Opts: &RoutesOpts{
 			ProfileCount: utils.IntPointer(0),
 			Limit:        utils.IntPointer(0),
@@ -196,6 +225,9 @@ func TestRouteSCfgClone(t *testing.T) {
 	if rcv.RALsConns[1] = ""; ban.RALsConns[1] != "conn1" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
+	if rcv.Breaker.FailureFilters[0] = ""; ban.Breaker.FailureFilters[0] != "*string:~*req.DisconnectCause:503" {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
 	if (*rcv.StringIndexedFields)[0] = ""; (*ban.StringIndexedFields)[0] != "*req.index1" {
 		t.Errorf("Expected clone to not modify the cloned")
 	}
@@ -112,6 +112,7 @@ type SessionSCfg struct {
 	ResourceSConns         []string
 	ThresholdSConns        []string
 	StatSConns             []string
+	FraudSConns            []string
 	RouteSConns            []string
 	AttributeSConns        []string
 	CDRsConns              []string
@@ -193,6 +194,9 @@ func (scfg *SessionSCfg) loadFromJSONCfg(jsnCfg *SessionSJsonCfg) (err error) {
 	if jsnCfg.StatSConns != nil {
 		scfg.StatSConns = tagInternalConns(*jsnCfg.StatSConns, utils.MetaStats)
 	}
+	if jsnCfg.FraudSConns != nil {
+		scfg.FraudSConns = tagInternalConns(*jsnCfg.FraudSConns, utils.MetaFrauds)
+	}
 	if jsnCfg.RouteSConns != nil {
 		scfg.RouteSConns = tagInternalConns(*jsnCfg.RouteSConns, utils.MetaRoutes)
 	}
@@ -328,6 +332,7 @@ func (scfg *SessionSCfg) AsMapInterface() (initialMP map[string]any) {
 		utils.ResourceSConnsCfg:         stripInternalConns(scfg.ResourceSConns),
 		utils.ThresholdSConnsCfg:        stripInternalConns(scfg.ThresholdSConns),
 		utils.StatSConnsCfg:             stripInternalConns(scfg.StatSConns),
+		utils.FraudSConnsCfg:            stripInternalConns(scfg.FraudSConns),
 		utils.RouteSConnsCfg:            stripInternalConns(scfg.RouteSConns),
 		utils.AttributeSConnsCfg:        stripInternalConns(scfg.AttributeSConns),
 		utils.CDRsConnsCfg:              stripInternalConns(scfg.CDRsConns),
@@ -455,6 +460,9 @@ func (scfg *SessionSCfg) Clone() (cln *SessionSCfg) {
 		cln.StatSConns = make([]string, len(scfg.StatSConns))
 		copy(cln.StatSConns, scfg.StatSConns)
 	}
+	if scfg.FraudSConns != nil {
+		cln.FraudSConns = slices.Clone(scfg.FraudSConns)
+	}
 	if scfg.RouteSConns != nil {
 		cln.RouteSConns = make([]string, len(scfg.RouteSConns))
 		copy(cln.RouteSConns, scfg.RouteSConns)
@@ -106,6 +106,7 @@ func TestSessionSCfgloadFromJsonCfgCase1(t *testing.T) {
 		ResourceSConns:      []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources), "*conn1"},
 		ThresholdSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds), "*conn1"},
 		StatSConns:          []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats), "*conn1"},
+		FraudSConns:         []string{},
 		RouteSConns:         []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes), "*conn1"},
 		AttributeSConns:     []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes), "*conn1"},
 		CDRsConns:           []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaCDRs), "*conn1"},
@@ -268,6 +269,7 @@ func TestSessionSCfgloadFromJsonCfgCase10(t *testing.T) {
 		ResourceSConns:      []string{},
 		ThresholdSConns:     []string{},
 		StatSConns:          []string{},
+		FraudSConns:         []string{},
 		RouteSConns:         []string{},
 		AttributeSConns:     []string{},
 		CDRsConns:           []string{},
@@ -381,6 +383,7 @@ func TestSessionSCfgAsMapInterfaceCase1(t *testing.T) {
 		utils.ResourceSConnsCfg:         []string{},
 		utils.ThresholdSConnsCfg:        []string{},
 		utils.StatSConnsCfg:             []string{},
+		utils.FraudSConnsCfg:            []string{},
 		utils.RouteSConnsCfg:            []string{},
 		utils.AttributeSConnsCfg:        []string{},
 		utils.ReplicationConnsCfg:       []string{},
@@ -464,6 +467,7 @@ func TestSessionSCfgAsMapInterfaceCase2(t *testing.T) {
 		utils.ResourceSConnsCfg:         []string{utils.MetaInternal, "*conn1"},
 		utils.ThresholdSConnsCfg:        []string{utils.MetaInternal, "*conn1"},
 		utils.StatSConnsCfg:             []string{utils.MetaInternal, "*conn1"},
+		utils.FraudSConnsCfg:            []string{},
 		utils.RouteSConnsCfg:            []string{utils.MetaInternal, "*conn1"},
 		utils.AttributeSConnsCfg:        []string{utils.MetaInternal, "*conn1"},
 		utils.ReplicationConnsCfg:       []string{utils.MetaLocalHost},
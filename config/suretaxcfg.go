@@ -212,16 +212,16 @@ func (st *SureTaxCfg) Clone() (cln *SureTaxCfg) {
 	if st == nil {
 		return nil
 	}
-	loc := *time.UTC
+	loc := time.UTC
 	if st.Timezone != nil {
-		loc = *st.Timezone
+		loc = st.Timezone // shared since locations are immutable, copying time.Local would lose its name
 	}
 	return &SureTaxCfg{
 		URL:              st.URL,
 		ClientNumber:     st.ClientNumber,
 		ValidationKey:    st.ValidationKey,
 		BusinessUnit:     st.BusinessUnit,
-		Timezone:         &loc,
+		Timezone:         loc,
 		IncludeLocalCost: st.IncludeLocalCost,
 		ReturnFileCode:   st.ReturnFileCode,
 		ResponseGroup:    st.ResponseGroup,
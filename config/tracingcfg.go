@@ -0,0 +1,102 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// TracingCfg the config for the distributed tracing of the API calls
+type TracingCfg struct {
+	Enabled        bool
+	SampleRatio    float64  // ratio of the traces started by this engine which are recorded
+	Exporters      []string // <*memory|*file|*otlp>
+	MemoryLimit    int      // spans kept by the *memory exporter
+	FilePath       string   // file where the *file exporter writes the spans
+	OTLPURL        string   // OTLP/HTTP endpoint of the collector used by the *otlp exporter
+	ExportInterval time.Duration
+	DBSpans        bool // create spans for the DataDB and StorDB queries
+}
+
+func (tr *TracingCfg) loadFromJSONCfg(jsnCfg *TracingJsonCfg) (err error) {
+	if jsnCfg == nil {
+		return
+	}
+	if jsnCfg.Enabled != nil {
+		tr.Enabled = *jsnCfg.Enabled
+	}
+	if jsnCfg.Sample_ratio != nil {
+		tr.SampleRatio = *jsnCfg.Sample_ratio
+	}
+	if jsnCfg.Exporters != nil {
+		tr.Exporters = slices.Clone(*jsnCfg.Exporters)
+	}
+	if jsnCfg.Memory_limit != nil {
+		tr.MemoryLimit = *jsnCfg.Memory_limit
+	}
+	if jsnCfg.File_path != nil {
+		tr.FilePath = *jsnCfg.File_path
+	}
+	if jsnCfg.Otlp_url != nil {
+		tr.OTLPURL = *jsnCfg.Otlp_url
+	}
+	if jsnCfg.Export_interval != nil {
+		if tr.ExportInterval, err = utils.ParseDurationWithNanosecs(*jsnCfg.Export_interval); err != nil {
+			return
+		}
+	}
+	if jsnCfg.Db_spans != nil {
+		tr.DBSpans = *jsnCfg.Db_spans
+	}
+	return
+}
+
+// AsMapInterface returns the config as a map[string]any
+func (tr *TracingCfg) AsMapInterface() map[string]any {
+	return map[string]any{
+		utils.EnabledCfg:        tr.Enabled,
+		utils.SampleRatioCfg:    tr.SampleRatio,
+		utils.ExportersCfg:      slices.Clone(tr.Exporters),
+		utils.MemoryLimitCfg:    tr.MemoryLimit,
+		utils.FilePathCfg:       tr.FilePath,
+		utils.OTLPURLCfg:        tr.OTLPURL,
+		utils.ExportIntervalCfg: tr.ExportInterval.String(),
+		utils.DBSpansCfg:        tr.DBSpans,
+	}
+}
+
+// Clone returns a deep copy of TracingCfg
+func (tr *TracingCfg) Clone() *TracingCfg {
+	if tr == nil {
+		return nil
+	}
+	return &TracingCfg{
+		Enabled:        tr.Enabled,
+		SampleRatio:    tr.SampleRatio,
+		Exporters:      slices.Clone(tr.Exporters),
+		MemoryLimit:    tr.MemoryLimit,
+		FilePath:       tr.FilePath,
+		OTLPURL:        tr.OTLPURL,
+		ExportInterval: tr.ExportInterval,
+		DBSpans:        tr.DBSpans,
+	}
+}
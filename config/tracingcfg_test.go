@@ -0,0 +1,135 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTracingCfgloadFromJsonCfg(t *testing.T) {
+	var trCfg, expected TracingCfg
+	if err := trCfg.loadFromJSONCfg(nil); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(trCfg, expected) {
+		t.Errorf("Expected: %+v ,received: %+v", expected, trCfg)
+	}
+	cfgJSONStr := `{
+		"tracing": {
+			"enabled": true,
+			"sample_ratio": 0.1,
+			"exporters": ["*file", "*otlp"],
+			"memory_limit": 100,
+			"file_path": "/tmp/traces.json",
+			"otlp_url": "http://collector:4318/v1/traces",
+			"export_interval": "5s",
+			"db_spans": true,
+		},
+}`
+	expected = TracingCfg{
+		Enabled:        true,
+		SampleRatio:    0.1,
+		Exporters:      []string{utils.MetaFile, utils.MetaOTLP},
+		MemoryLimit:    100,
+		FilePath:       "/tmp/traces.json",
+		OTLPURL:        "http://collector:4318/v1/traces",
+		ExportInterval: 5 * time.Second,
+		DBSpans:        true,
+	}
+	if jsnCfg, err := NewCgrJsonCfgFromBytes([]byte(cfgJSONStr)); err != nil {
+		t.Error(err)
+	} else if jsnTrCfg, err := jsnCfg.TracingJson(); err != nil {
+		t.Error(err)
+	} else if err = trCfg.loadFromJSONCfg(jsnTrCfg); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expected, trCfg) {
+		t.Errorf("Expected: %+v , received: %+v", expected, trCfg)
+	}
+	if err := trCfg.loadFromJSONCfg(&TracingJsonCfg{
+		Export_interval: utils.StringPointer("1ss"),
+	}); err == nil {
+		t.Error("Expected error for invalid export_interval")
+	}
+}
+
+func TestTracingCfgAsMapInterface(t *testing.T) {
+	cgrCfg := NewDefaultCGRConfig()
+	eMap := map[string]any{
+		utils.EnabledCfg:        false,
+		utils.SampleRatioCfg:    1.,
+		utils.ExportersCfg:      []string{utils.MetaMemory},
+		utils.MemoryLimitCfg:    10000,
+		utils.FilePathCfg:       "/var/log/cgrates/traces.json",
+		utils.OTLPURLCfg:        "http://127.0.0.1:4318/v1/traces",
+		utils.ExportIntervalCfg: "1s",
+		utils.DBSpansCfg:        false,
+	}
+	if rcv := cgrCfg.TracingCfg().AsMapInterface(); !reflect.DeepEqual(eMap, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(eMap), utils.ToJSON(rcv))
+	}
+}
+
+func TestTracingCfgClone(t *testing.T) {
+	trCfg := &TracingCfg{
+		Enabled:        true,
+		SampleRatio:    0.5,
+		Exporters:      []string{utils.MetaMemory},
+		MemoryLimit:    10,
+		ExportInterval: time.Second,
+	}
+	rcv := trCfg.Clone()
+	if !reflect.DeepEqual(trCfg, rcv) {
+		t.Errorf("Expected: %+v\nReceived: %+v", utils.ToJSON(trCfg), utils.ToJSON(rcv))
+	}
+	if rcv.Exporters[0] = utils.MetaFile; trCfg.Exporters[0] != utils.MetaMemory {
+		t.Errorf("Expected clone to not modify the cloned")
+	}
+	trCfg = nil
+	if rcv = trCfg.Clone(); rcv != nil {
+		t.Errorf("Expected nil, received: %+v", utils.ToJSON(rcv))
+	}
+}
+
+func TestTracingCfgSanity(t *testing.T) {
+	cfg := NewDefaultCGRConfig()
+	cfg.tracingCfg.Enabled = true
+	if err := cfg.checkConfigSanity(); err != nil {
+		t.Error(err)
+	}
+	cfg.tracingCfg.SampleRatio = 2
+	expErr := "<Tracing> the sample_ratio needs to be between 0 and 1"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+	cfg.tracingCfg.SampleRatio = 1
+	cfg.tracingCfg.Exporters = []string{utils.MetaOTLP}
+	cfg.tracingCfg.OTLPURL = utils.EmptyString
+	expErr = "<Tracing> empty otlp_url for exporter <*otlp>"
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+	cfg.tracingCfg.Exporters = []string{"*jaeger"}
+	expErr = `<Tracing> unsupported exporter: "*jaeger"`
+	if err := cfg.checkConfigSanity(); err == nil || err.Error() != expErr {
+		t.Errorf("Expected %q, received %v", expErr, err)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package console
+
+import (
+	"github.com/cgrates/cgrates/analyzers"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdAnalyzerReplayStatus{
+		name:      "analyzer_replay_status",
+		rpcMethod: utils.AnalyzerSv1GetReplayStatus,
+		rpcParams: &analyzers.ReplayIDArgs{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+type CmdAnalyzerReplayStatus struct {
+	name      string
+	rpcMethod string
+	rpcParams *analyzers.ReplayIDArgs
+	*CommandExecuter
+}
+
+func (self *CmdAnalyzerReplayStatus) Name() string {
+	return self.name
+}
+
+func (self *CmdAnalyzerReplayStatus) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdAnalyzerReplayStatus) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = &analyzers.ReplayIDArgs{}
+	}
+	return self.rpcParams
+}
+
+func (self *CmdAnalyzerReplayStatus) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdAnalyzerReplayStatus) RpcResult() any {
+	var s analyzers.ReplayStatus
+	return &s
+}
@@ -0,0 +1,55 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestCmdAnalyzerReplay(t *testing.T) {
+	for _, name := range []string{"analyzer_replay", "analyzer_replay_status", "analyzer_replay_stop"} {
+		// commands map is initiated in init function
+		command := commands[name]
+		// verify if AnalyzerSv1 object has method on it
+		m, ok := reflect.TypeOf(new(v1.AnalyzerSv1)).MethodByName(strings.Split(command.RpcMethod(), utils.NestingSep)[1])
+		if !ok {
+			t.Fatalf("method not found for %s", name)
+		}
+		if m.Type.NumIn() != 4 { // expecting 4 inputs
+			t.Fatalf("invalid number of input parameters ")
+		}
+		// verify the type of input parameter
+		if ok := m.Type.In(2).AssignableTo(reflect.TypeOf(command.RpcParams(true))); !ok {
+			t.Fatalf("cannot assign input parameter for %s", name)
+		}
+		// verify the type of output parameter
+		if ok := m.Type.In(3).AssignableTo(reflect.TypeOf(command.RpcResult())); !ok {
+			t.Fatalf("cannot assign output parameter for %s", name)
+		}
+		// for coverage purpose
+		if err := command.PostprocessRpcParams(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdStartDataDBMigration{
+		name:      "datadb_migration_start",
+		rpcMethod: utils.APIerSv1StartDataDBMigration,
+		rpcParams: &v1.AttrStartDataDBMigration{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// CmdStartDataDBMigration implements the Commander interface
+type CmdStartDataDBMigration struct {
+	name      string
+	rpcMethod string
+	rpcParams *v1.AttrStartDataDBMigration
+	*CommandExecuter
+}
+
+func (self *CmdStartDataDBMigration) Name() string {
+	return self.name
+}
+
+func (self *CmdStartDataDBMigration) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdStartDataDBMigration) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = &v1.AttrStartDataDBMigration{}
+	}
+	return self.rpcParams
+}
+
+func (self *CmdStartDataDBMigration) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdStartDataDBMigration) RpcResult() any {
+	var s string
+	return &s
+}
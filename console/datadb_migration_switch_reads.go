@@ -0,0 +1,66 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdSwitchDataDBMigrationReads{
+		name:      "datadb_migration_switch_reads",
+		rpcMethod: utils.APIerSv1SwitchDataDBMigrationReads,
+		rpcParams: &v1.AttrSwitchDataDBMigrationReads{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// CmdSwitchDataDBMigrationReads implements the Commander interface
+type CmdSwitchDataDBMigrationReads struct {
+	name      string
+	rpcMethod string
+	rpcParams *v1.AttrSwitchDataDBMigrationReads
+	*CommandExecuter
+}
+
+func (self *CmdSwitchDataDBMigrationReads) Name() string {
+	return self.name
+}
+
+func (self *CmdSwitchDataDBMigrationReads) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdSwitchDataDBMigrationReads) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = &v1.AttrSwitchDataDBMigrationReads{}
+	}
+	return self.rpcParams
+}
+
+func (self *CmdSwitchDataDBMigrationReads) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdSwitchDataDBMigrationReads) RpcResult() any {
+	var s string
+	return &s
+}
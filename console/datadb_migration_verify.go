@@ -0,0 +1,66 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdVerifyDataDBMigration{
+		name:      "datadb_migration_verify",
+		rpcMethod: utils.APIerSv1VerifyDataDBMigration,
+		rpcParams: &EmptyWrapper{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// CmdVerifyDataDBMigration implements the Commander interface
+type CmdVerifyDataDBMigration struct {
+	name      string
+	rpcMethod string
+	rpcParams *EmptyWrapper
+	*CommandExecuter
+}
+
+func (self *CmdVerifyDataDBMigration) Name() string {
+	return self.name
+}
+
+func (self *CmdVerifyDataDBMigration) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdVerifyDataDBMigration) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = &EmptyWrapper{}
+	}
+	return self.rpcParams
+}
+
+func (self *CmdVerifyDataDBMigration) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdVerifyDataDBMigration) RpcResult() any {
+	var s engine.DataDBMigrationReport
+	return &s
+}
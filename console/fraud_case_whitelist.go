@@ -0,0 +1,65 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package console
+
+import (
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdWhitelistFraudCase{
+		name:      "fraud_case_whitelist",
+		rpcMethod: utils.FraudSv1WhitelistFraudCase,
+		rpcParams: &engine.FraudCaseArgs{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+// Commander implementation
+type CmdWhitelistFraudCase struct {
+	name      string
+	rpcMethod string
+	rpcParams *engine.FraudCaseArgs
+	*CommandExecuter
+}
+
+func (self *CmdWhitelistFraudCase) Name() string {
+	return self.name
+}
+
+func (self *CmdWhitelistFraudCase) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdWhitelistFraudCase) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = &engine.FraudCaseArgs{}
+	}
+	return self.rpcParams
+}
+
+func (self *CmdWhitelistFraudCase) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdWhitelistFraudCase) RpcResult() any {
+	var s string
+	return &s
+}
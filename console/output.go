@@ -0,0 +1,385 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// OutputFormats are the formats in which the replies can be rendered by FormatResult
+var OutputFormats = utils.NewStringSet([]string{utils.MetaJSON, utils.MetaYAML,
+	utils.MetaCSV, utils.MetaTable})
+
+// FormatResult renders the reply of a command in the given format,
+// keeping only the fields (dot separated paths) when these are provided
+func FormatResult(result any, format string, fields []string) (out string, err error) {
+	if !OutputFormats.Has(format) {
+		return utils.EmptyString, fmt.Errorf("unsupported output format: <%s>", format)
+	}
+	var v any
+	if v, err = toGeneric(result); err != nil {
+		return
+	}
+	if len(fields) != 0 {
+		v = selectFields(v, fields)
+	}
+	switch format {
+	case utils.MetaJSON:
+		var b []byte
+		if b, err = json.MarshalIndent(v, utils.EmptyString, " "); err != nil {
+			return
+		}
+		return string(b), nil
+	case utils.MetaYAML:
+		return strings.Join(yamlLines(v), "\n"), nil
+	case utils.MetaCSV:
+		return formatCSV(v, fields)
+	default: // utils.MetaTable
+		return formatTable(v, fields)
+	}
+}
+
+// toGeneric converts the reply into the generic structures(maps, slices and scalars) of its JSON representation
+func toGeneric(result any) (v any, err error) {
+	var b []byte
+	if b, err = json.Marshal(result); err != nil {
+		return
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err = dec.Decode(&v)
+	return
+}
+
+// selectFields keeps only the given fields of the record or of each record in the list
+func selectFields(v any, fields []string) any {
+	switch val := v.(type) {
+	case []any:
+		recs := make([]any, len(val))
+		for i, rec := range val {
+			recs[i] = selectFields(rec, fields)
+		}
+		return recs
+	case map[string]any:
+		rec := make(map[string]any, len(fields))
+		for _, fld := range fields {
+			rec[fld] = fieldValue(val, strings.Split(fld, utils.NestingSep))
+		}
+		return rec
+	}
+	return v
+}
+
+// fieldValue returns the value found at the path, nil if the path is missing
+func fieldValue(v any, path []string) any {
+	for _, key := range path {
+		switch val := v.(type) {
+		case map[string]any:
+			v = val[key]
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(val) {
+				return nil
+			}
+			v = val[idx]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// records returns the rows and the columns used when rendering the reply as CSV or table
+func records(v any, fields []string) (columns []string, rows [][]string) {
+	recs, isList := v.([]any)
+	if !isList {
+		recs = []any{v}
+	}
+	columns = fields
+	if len(columns) == 0 {
+		keys := utils.NewStringSet(nil)
+		for _, rec := range recs {
+			if m, isMap := rec.(map[string]any); isMap {
+				for k := range m {
+					keys.Add(k)
+				}
+			}
+		}
+		columns = keys.AsSlice()
+		sort.Strings(columns)
+	}
+	rows = make([][]string, len(recs))
+	for i, rec := range recs {
+		m, isMap := rec.(map[string]any)
+		if !isMap || len(columns) == 0 {
+			rows[i] = []string{cellValue(rec)}
+			continue
+		}
+		rows[i] = make([]string, len(columns))
+		for j, col := range columns {
+			rows[i][j] = cellValue(m[col])
+		}
+	}
+	return
+}
+
+// cellValue returns the value as a single line string
+func cellValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return utils.EmptyString
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	case bool:
+		return strconv.FormatBool(val)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func formatCSV(v any, fields []string) (string, error) {
+	columns, rows := records(v, fields)
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if len(columns) != 0 {
+		w.Write(columns)
+	}
+	w.WriteAll(rows) // flushes the writer
+	return strings.TrimSuffix(b.String(), "\n"), w.Error()
+}
+
+func formatTable(v any, fields []string) (string, error) {
+	columns, rows := records(v, fields)
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	if len(columns) != 0 {
+		fmt.Fprintln(w, strings.Join(columns, "\t"))
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(cell)
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return utils.EmptyString, err
+	}
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	for i, ln := range lines { // padding of the empty trailing cells
+		lines[i] = strings.TrimRight(ln, " ")
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// yamlLines renders the generic value as YAML lines
+func yamlLines(v any) (lines []string) {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 {
+			return []string{"{}"}
+		}
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := yamlLines(val[k])
+			if !isYAMLCollection(val[k]) {
+				lines = append(lines, yamlScalar(k)+": "+child[0])
+				continue
+			}
+			lines = append(lines, yamlScalar(k)+":")
+			for _, ln := range child {
+				lines = append(lines, "  "+ln)
+			}
+		}
+	case []any:
+		if len(val) == 0 {
+			return []string{"[]"}
+		}
+		for _, item := range val {
+			child := yamlLines(item)
+			lines = append(lines, "- "+child[0])
+			for _, ln := range child[1:] {
+				lines = append(lines, "  "+ln)
+			}
+		}
+	case nil:
+		return []string{"null"}
+	case string:
+		return []string{yamlScalar(val)}
+	default:
+		return []string{cellValue(val)}
+	}
+	return
+}
+
+// isYAMLCollection returns true for the values rendered on multiple lines
+func isYAMLCollection(v any) bool {
+	switch val := v.(type) {
+	case map[string]any:
+		return len(val) != 0
+	case []any:
+		return len(val) != 0
+	}
+	return false
+}
+
+// yamlScalar quotes the strings which would not be read back as plain strings
+func yamlScalar(s string) string {
+	if s == utils.EmptyString ||
+		strings.ContainsAny(s, ":#{}[],&*?|<>=!%@`\"'\\\n\t") ||
+		strings.TrimSpace(s) != s ||
+		strings.HasPrefix(s, "-") {
+		b, _ := json.Marshal(s)
+		return string(b)
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// GetPagination returns the Limit and Offset of the command parameters,
+// ok is false if the parameters do not support pagination
+func GetPagination(params any) (limit, offset int, ok bool) {
+	lmtFld, offFld := paginationFields(params, false)
+	if !lmtFld.IsValid() || !offFld.IsValid() {
+		return
+	}
+	return intField(lmtFld), intField(offFld), true
+}
+
+// SetPagination populates the Limit and Offset of the command parameters,
+// returning false if the parameters do not support pagination
+func SetPagination(params any, limit, offset int) bool {
+	lmtFld, offFld := paginationFields(params, true)
+	if !lmtFld.IsValid() || !offFld.IsValid() {
+		return false
+	}
+	setIntField(lmtFld, limit)
+	setIntField(offFld, offset)
+	return true
+}
+
+// paginationFields finds the Limit and Offset fields of the parameters, including the embedded structs,
+// allocating the nil embedded ones if alloc is true
+func paginationFields(params any, alloc bool) (lmtFld, offFld reflect.Value) {
+	v := reflect.ValueOf(params)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	if lmtFld, offFld = directField(v, utils.Limit), directField(v, utils.Offset); isIntField(lmtFld) && isIntField(offFld) {
+		return
+	}
+	lmtFld, offFld = reflect.Value{}, reflect.Value{}
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).Anonymous {
+			continue
+		}
+		fld := v.Field(i)
+		if fld.Kind() == reflect.Pointer && fld.IsNil() {
+			if !alloc || !fld.CanSet() || fld.Type().Elem().Kind() != reflect.Struct {
+				continue
+			}
+			fld.Set(reflect.New(fld.Type().Elem()))
+		}
+		if !fld.CanAddr() {
+			continue
+		}
+		if fld.Kind() != reflect.Pointer {
+			fld = fld.Addr()
+		}
+		if lmtFld, offFld = paginationFields(fld.Interface(), alloc); lmtFld.IsValid() {
+			return
+		}
+	}
+	return
+}
+
+// directField returns the field declared in the struct, ignoring the promoted ones
+func directField(v reflect.Value, name string) reflect.Value {
+	if sf, has := v.Type().FieldByName(name); has && len(sf.Index) == 1 {
+		return v.Field(sf.Index[0])
+	}
+	return reflect.Value{}
+}
+
+func isIntField(fld reflect.Value) bool {
+	if !fld.IsValid() || !fld.CanSet() {
+		return false
+	}
+	if fld.Kind() == reflect.Pointer {
+		return fld.Type().Elem().Kind() == reflect.Int
+	}
+	return fld.Kind() == reflect.Int
+}
+
+func intField(fld reflect.Value) int {
+	if fld.Kind() == reflect.Pointer {
+		if fld.IsNil() {
+			return 0
+		}
+		fld = fld.Elem()
+	}
+	return int(fld.Int())
+}
+
+func setIntField(fld reflect.Value, val int) {
+	if fld.Kind() == reflect.Pointer {
+		fld.Set(reflect.ValueOf(&val))
+		return
+	}
+	fld.SetInt(int64(val))
+}
+
+// AppendResult appends the list reply of a page to the one collected so far,
+// returning the number of items in the page or -1 if the replies are not lists
+func AppendResult(dst, page any) int {
+	dstV, pageV := reflect.ValueOf(dst), reflect.ValueOf(page)
+	if dstV.Kind() != reflect.Pointer || pageV.Kind() != reflect.Pointer ||
+		dstV.Elem().Kind() != reflect.Slice || dstV.Elem().Type() != pageV.Elem().Type() {
+		return -1
+	}
+	dstV.Elem().Set(reflect.AppendSlice(dstV.Elem(), pageV.Elem()))
+	return pageV.Elem().Len()
+}
@@ -0,0 +1,125 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+type testOutputRecord struct {
+	ID    string
+	Value float64
+	Opts  map[string]any
+}
+
+func TestFormatResult(t *testing.T) {
+	rply := &[]*testOutputRecord{
+		{ID: "ACC1", Value: 10, Opts: map[string]any{"Tenant": "cgrates.org"}},
+		{ID: "ACC2", Value: 2.5},
+	}
+	flds := []string{"ID", "Opts.Tenant"}
+	if out, err := FormatResult(rply, utils.MetaCSV, flds); err != nil {
+		t.Fatal(err)
+	} else if exp := "ID,Opts.Tenant\nACC1,cgrates.org\nACC2,"; out != exp {
+		t.Errorf("expected %q, received %q", exp, out)
+	}
+	if out, err := FormatResult(rply, utils.MetaTable, flds); err != nil {
+		t.Fatal(err)
+	} else if exp := "ID    Opts.Tenant\nACC1  cgrates.org\nACC2"; out != exp {
+		t.Errorf("expected %q, received %q", exp, out)
+	}
+	if out, err := FormatResult(rply, utils.MetaJSON, []string{"Value"}); err != nil {
+		t.Fatal(err)
+	} else if exp := "[\n {\n  \"Value\": 10\n },\n {\n  \"Value\": 2.5\n }\n]"; out != exp {
+		t.Errorf("expected %q, received %q", exp, out)
+	}
+	if out, err := FormatResult(rply, utils.MetaYAML, nil); err != nil {
+		t.Fatal(err)
+	} else if exp := "- ID: ACC1\n  Opts:\n    Tenant: cgrates.org\n  Value: 10\n- ID: ACC2\n  Opts: null\n  Value: 2.5"; out != exp {
+		t.Errorf("expected %q, received %q", exp, out)
+	}
+	if out, err := FormatResult(utils.OK, utils.MetaTable, nil); err != nil {
+		t.Fatal(err)
+	} else if out != utils.OK {
+		t.Errorf("expected %q, received %q", utils.OK, out)
+	}
+	if _, err := FormatResult(rply, "*xml", nil); err == nil ||
+		err.Error() != "unsupported output format: <*xml>" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestYAMLScalar(t *testing.T) {
+	for s, exp := range map[string]string{
+		"cgrates.org": "cgrates.org",
+		"*req":        `"*req"`,
+		"true":        `"true"`,
+		"1001":        `"1001"`,
+		"":            `""`,
+		"a: b":        `"a: b"`,
+	} {
+		if rcv := yamlScalar(s); rcv != exp {
+			t.Errorf("for %q expected %s, received %s", s, exp, rcv)
+		}
+	}
+}
+
+func TestPagination(t *testing.T) {
+	accArgs := &utils.AttrGetAccounts{Tenant: "cgrates.org", Limit: 5}
+	if lmt, off, ok := GetPagination(accArgs); !ok || lmt != 5 || off != 0 {
+		t.Errorf("received limit: %d, offset: %d, ok: %v", lmt, off, ok)
+	}
+	if !SetPagination(accArgs, 10, 20) || accArgs.Limit != 10 || accArgs.Offset != 20 {
+		t.Errorf("unexpected args: %+v", accArgs)
+	}
+
+	cdrArgs := &utils.RPCCDRsFilterWithAPIOpts{}
+	if lmt, off, ok := GetPagination(cdrArgs); ok {
+		t.Errorf("received limit: %d, offset: %d, ok: %v", lmt, off, ok)
+	}
+	if !SetPagination(cdrArgs, 10, 20) ||
+		cdrArgs.RPCCDRsFilter == nil ||
+		*cdrArgs.Limit != 10 || *cdrArgs.Offset != 20 {
+		t.Errorf("unexpected args: %+v", cdrArgs.RPCCDRsFilter)
+	}
+	if lmt, off, ok := GetPagination(cdrArgs); !ok || lmt != 10 || off != 20 {
+		t.Errorf("received limit: %d, offset: %d, ok: %v", lmt, off, ok)
+	}
+
+	if SetPagination(&utils.TenantID{}, 10, 0) {
+		t.Error("expected no pagination")
+	}
+}
+
+func TestAppendResult(t *testing.T) {
+	rply := &[]string{"ACC1"}
+	if n := AppendResult(rply, &[]string{"ACC2", "ACC3"}); n != 2 {
+		t.Errorf("expected 2, received %d", n)
+	}
+	if exp := []string{"ACC1", "ACC2", "ACC3"}; !reflect.DeepEqual(*rply, exp) {
+		t.Errorf("expected %v, received %v", exp, *rply)
+	}
+	var s string
+	if n := AppendResult(&s, &s); n != -1 {
+		t.Errorf("expected -1, received %d", n)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdRouteBreakersReset{
+		name:      "route_breakers_reset",
+		rpcMethod: utils.RouteSv1ResetRouteBreakers,
+		rpcParams: &utils.ArgsRouteBreakers{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+type CmdRouteBreakersReset struct {
+	name      string
+	rpcMethod string
+	rpcParams *utils.ArgsRouteBreakers
+	*CommandExecuter
+}
+
+func (self *CmdRouteBreakersReset) Name() string {
+	return self.name
+}
+
+func (self *CmdRouteBreakersReset) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdRouteBreakersReset) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = new(utils.ArgsRouteBreakers)
+	}
+	return self.rpcParams
+}
+
+func (self *CmdRouteBreakersReset) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdRouteBreakersReset) RpcResult() any {
+	var reply string
+	return &reply
+}
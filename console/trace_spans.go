@@ -0,0 +1,66 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package console
+
+import (
+	"github.com/cgrates/cgrates/utils"
+)
+
+func init() {
+	c := &CmdGetTraceSpans{
+		name:      "trace_spans",
+		rpcMethod: utils.CoreSv1GetTraceSpans,
+		rpcParams: &utils.TraceSpansArgs{},
+	}
+	commands[c.Name()] = c
+	c.CommandExecuter = &CommandExecuter{c}
+}
+
+type CmdGetTraceSpans struct {
+	name      string
+	rpcMethod string
+	rpcParams *utils.TraceSpansArgs
+	*CommandExecuter
+}
+
+func (self *CmdGetTraceSpans) Name() string {
+	return self.name
+}
+
+func (self *CmdGetTraceSpans) RpcMethod() string {
+	return self.rpcMethod
+}
+
+func (self *CmdGetTraceSpans) RpcParams(reset bool) any {
+	if reset || self.rpcParams == nil {
+		self.rpcParams = &utils.TraceSpansArgs{
+			APIOpts: make(map[string]any),
+		}
+	}
+	return self.rpcParams
+}
+
+func (self *CmdGetTraceSpans) PostprocessRpcParams() error {
+	return nil
+}
+
+func (self *CmdGetTraceSpans) RpcResult() any {
+	var s []*utils.Span
+	return &s
+}
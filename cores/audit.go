@@ -0,0 +1,161 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package cores
+
+import (
+	"sync"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// auditRequests keeps the records of the audited requests in progress on one connection
+type auditRequests struct {
+	aS         *engine.AuditS
+	remoteAddr string
+	method     string // of the request being read
+	seq        uint64
+
+	mux     sync.Mutex
+	records map[uint64]*engine.AuditRecord
+}
+
+func newAuditRequests(aS *engine.AuditS, conn conn) *auditRequests {
+	ar := &auditRequests{
+		aS:      aS,
+		records: make(map[uint64]*engine.AuditRecord),
+	}
+	if addr := conn.RemoteAddr(); addr != nil {
+		ar.remoteAddr = addr.String()
+	}
+	return ar
+}
+
+// start records the object changed by the request with the body x before the call
+func (ar *auditRequests) start(x any) {
+	if x == nil || // body discarded
+		!ar.aS.Audited(ar.method) {
+		return
+	}
+	rec := ar.aS.StartRecord(ar.method, ar.remoteAddr, x)
+	ar.mux.Lock()
+	ar.records[ar.seq] = rec
+	ar.mux.Unlock()
+}
+
+// end completes the record of the request answered by r
+func (ar *auditRequests) end(r *birpc.Response) {
+	ar.mux.Lock()
+	rec, has := ar.records[r.Seq]
+	delete(ar.records, r.Seq)
+	ar.mux.Unlock()
+	if has {
+		ar.aS.EndRecord(rec, r.Error)
+	}
+}
+
+func newAuditServerCodec(sc birpc.ServerCodec, aS *engine.AuditS, conn conn) birpc.ServerCodec {
+	if aS == nil {
+		return sc
+	}
+	return &auditServerCodec{
+		sc:  sc,
+		rqs: newAuditRequests(aS, conn),
+	}
+}
+
+// auditServerCodec records the data changing requests
+type auditServerCodec struct {
+	sc  birpc.ServerCodec
+	rqs *auditRequests
+}
+
+func (c *auditServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	if err = c.sc.ReadRequestHeader(r); err == nil {
+		c.rqs.method, c.rqs.seq = r.ServiceMethod, r.Seq
+	}
+	return
+}
+
+func (c *auditServerCodec) ReadRequestBody(x any) (err error) {
+	if err = c.sc.ReadRequestBody(x); err == nil {
+		c.rqs.start(x)
+	}
+	return
+}
+
+func (c *auditServerCodec) WriteResponse(r *birpc.Response, x any) error {
+	c.rqs.end(r) // before answering so the next requests of the caller find the record complete
+	return c.sc.WriteResponse(r, x)
+}
+
+func (c *auditServerCodec) Close() error { return c.sc.Close() }
+
+func newAuditBiRPCCodec(sc birpc.BirpcCodec, aS *engine.AuditS, conn conn) birpc.BirpcCodec {
+	if aS == nil {
+		return sc
+	}
+	return &auditBiRPCCodec{
+		sc:  sc,
+		rqs: newAuditRequests(aS, conn),
+	}
+}
+
+// auditBiRPCCodec records the data changing requests received
+type auditBiRPCCodec struct {
+	sc  birpc.BirpcCodec
+	rqs *auditRequests
+}
+
+// ReadHeader must read a message and populate either the request
+// or the response by inspecting the incoming message.
+func (c *auditBiRPCCodec) ReadHeader(req *birpc.Request, resp *birpc.Response) (err error) {
+	if err = c.sc.ReadHeader(req, resp); err == nil &&
+		req.ServiceMethod != utils.EmptyString { // not a reply
+		c.rqs.method, c.rqs.seq = req.ServiceMethod, req.Seq
+	}
+	return
+}
+
+// ReadRequestBody into args argument of handler function.
+func (c *auditBiRPCCodec) ReadRequestBody(x any) (err error) {
+	if err = c.sc.ReadRequestBody(x); err == nil {
+		c.rqs.start(x)
+	}
+	return
+}
+
+// ReadResponseBody into reply argument of handler function.
+func (c *auditBiRPCCodec) ReadResponseBody(x any) error {
+	return c.sc.ReadResponseBody(x)
+}
+
+// WriteRequest must be safe for concurrent use by multiple goroutines.
+func (c *auditBiRPCCodec) WriteRequest(req *birpc.Request, x any) error {
+	return c.sc.WriteRequest(req, x)
+}
+
+// WriteResponse must be safe for concurrent use by multiple goroutines.
+func (c *auditBiRPCCodec) WriteResponse(r *birpc.Response, x any) error {
+	c.rqs.end(r)
+	return c.sc.WriteResponse(r, x)
+}
+
+// Close is called when client/server finished with the connection.
+func (c *auditBiRPCCodec) Close() error { return c.sc.Close() }
@@ -0,0 +1,77 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package cores
+
+import (
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAuditServerCodec(t *testing.T) {
+	sc := &mockRBACServerCodec{
+		method: utils.APIerSv1GetAttributeProfile,
+		apiKey: "key1",
+	}
+	if rcv := newAuditServerCodec(sc, nil, new(mockConn)); rcv != sc {
+		t.Error("Expected the codec to not be wrapped without AuditS")
+	}
+	cfg := config.NewDefaultCGRConfig()
+	cfg.AuditCfg().Enabled = true
+	data, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storDB, err := engine.NewInternalDB(nil, nil, false, nil, cfg.StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storDBChan := make(chan engine.StorDB, 1)
+	storDBChan <- storDB
+	aS := engine.NewAuditS(cfg, storDBChan, engine.NewDataManager(data, cfg.CacheCfg(), nil), nil)
+	codec := newAuditServerCodec(sc, aS, new(mockConn))
+	for _, method := range []string{utils.APIerSv1GetAttributeProfile, utils.APIerSv1SetAttributeProfile} {
+		sc.method = method
+		if err := codec.ReadRequestHeader(new(birpc.Request)); err != nil {
+			t.Fatal(err)
+		}
+		if err := codec.ReadRequestBody(&utils.CGREvent{ID: "ATTR_1"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := codec.WriteResponse(&birpc.Response{Seq: 1}, utils.OK); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stopChan := make(chan struct{})
+	close(stopChan)
+	aS.ListenAndServe(stopChan) // stores the queued records before returning
+	var rcv []*engine.AuditRecord
+	if err := aS.V1GetAuditRecords(nil, &utils.AuditRecordFilterWithAPIOpts{
+		AuditRecordFilter: new(utils.AuditRecordFilter),
+	}, &rcv); err != nil {
+		t.Fatal(err)
+	}
+	if len(rcv) != 1 || rcv[0].Method != utils.APIerSv1SetAttributeProfile ||
+		rcv[0].APIKey != "key1" || rcv[0].ObjectID != "ATTR_1" ||
+		rcv[0].Address != utils.LocalAddr().String() {
+		t.Errorf("Unexpected records: %s", utils.ToJSON(rcv))
+	}
+}
@@ -38,7 +38,7 @@ type conn interface {
 }
 
 func newCapsGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
-	r = newCapsServerCodec(birpc.NewServerCodec(conn), caps)
+	r = newCapsServerCodec(newTracingServerCodec(newRBACServerCodec(birpc.NewServerCodec(conn), conn), utils.MetaGOB, conn), caps)
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -56,7 +56,7 @@ func newCapsGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerServic
 }
 
 func newCapsJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
-	r = newCapsServerCodec(jsonrpc.NewServerCodec(conn), caps)
+	r = newCapsServerCodec(newTracingServerCodec(newRBACServerCodec(jsonrpc.NewServerCodec(conn), conn), utils.MetaJSON, conn), caps)
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -73,6 +73,15 @@ func newCapsJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerServi
 	return
 }
 
+func newCapsGRPCCodec(conn *grpcCodec, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.ServerCodec) {
+	r = newCapsServerCodec(newTracingServerCodec(newRBACServerCodec(conn, conn), utils.MetaGRPC, conn), caps)
+	if anz != nil {
+		return analyzers.NewAnalyzerServerCodec(r, anz, utils.MetaGRPC,
+			conn.RemoteAddr().String(), conn.LocalAddr().String())
+	}
+	return
+}
+
 func newCapsServerCodec(sc birpc.ServerCodec, caps *engine.Caps) birpc.ServerCodec {
 	if !caps.IsLimited() {
 		return sc
@@ -109,7 +118,7 @@ func (c *capsServerCodec) WriteResponse(r *birpc.Response, x any) error {
 func (c *capsServerCodec) Close() error { return c.sc.Close() }
 
 func newCapsBiRPCGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.BirpcCodec) {
-	r = newCapsBiRPCCodec(birpc.NewGobBirpcCodec(conn), caps)
+	r = newCapsBiRPCCodec(newTracingBiRPCCodec(newRBACBiRPCCodec(birpc.NewGobBirpcCodec(conn), conn), rpcclient.BiRPCGOB, conn), caps)
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -127,7 +136,7 @@ func newCapsBiRPCGOBCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerS
 }
 
 func newCapsBiRPCJSONCodec(conn conn, caps *engine.Caps, anz *analyzers.AnalyzerService) (r birpc.BirpcCodec) {
-	r = newCapsBiRPCCodec(jsonrpc.NewJSONBirpcCodec(conn), caps)
+	r = newCapsBiRPCCodec(newTracingBiRPCCodec(newRBACBiRPCCodec(jsonrpc.NewJSONBirpcCodec(conn), conn), rpcclient.BiRPCJSON, conn), caps)
 	if anz != nil {
 		from := conn.RemoteAddr()
 		var fromstr string
@@ -0,0 +1,120 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchConfig hot-applies the changed config sections each time the config files change
+func (cS *CoreService) WatchConfig(stopChan chan struct{}) (err error) {
+	cfgPath := cS.cfg.ConfigPath
+	if utils.IsURL(cfgPath) {
+		return fmt.Errorf("cannot watch the remote config <%s>", cfgPath)
+	}
+	var watcher *fsnotify.Watcher
+	if watcher, err = fsnotify.NewWatcher(); err != nil {
+		return
+	}
+	if err = filepath.WalkDir(cfgPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		return watcher.Add(path)
+	}); err != nil {
+		watcher.Close()
+		return
+	}
+	utils.Logger.Info(fmt.Sprintf("<%s> watching the config from <%s>", utils.CoreS, cfgPath))
+	go cS.watchConfig(cfgPath, watcher, stopChan)
+	return
+}
+
+// watchConfig reloads the config once the files did not change for config_watch_delay
+func (cS *CoreService) watchConfig(cfgPath string, watcher *fsnotify.Watcher, stopChan chan struct{}) {
+	defer watcher.Close()
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-stopChan:
+			return
+		case ev := <-watcher.Events:
+			if ev.Op&fsnotify.Create != 0 {
+				if fi, err := os.Stat(ev.Name); err == nil && fi.IsDir() {
+					if err = watcher.Add(ev.Name); err != nil {
+						utils.Logger.Warning(fmt.Sprintf("<%s> cannot watch the config directory <%s>: %s",
+							utils.CoreS, ev.Name, err.Error()))
+					}
+					reload = time.After(cS.cfg.CoreSCfg().ConfigWatchDelay)
+					continue
+				}
+			}
+			if strings.HasSuffix(ev.Name, utils.JSNSuffix) {
+				reload = time.After(cS.cfg.CoreSCfg().ConfigWatchDelay)
+			}
+		case <-reload:
+			reload = nil
+			cS.reloadConfig(cfgPath)
+		case err := <-watcher.Errors:
+			utils.Logger.Err(fmt.Sprintf("<%s> watching the config from <%s>, error: <%s>, exiting!",
+				utils.CoreS, cfgPath, err.Error()))
+			return
+		}
+	}
+}
+
+// reloadConfig applies the config changes, logging them and keeping them for the status
+func (cS *CoreService) reloadConfig(cfgPath string) {
+	rld := cS.cfg.ReloadChangedSections(cfgPath)
+	switch {
+	case rld.Error != utils.EmptyString:
+		utils.Logger.Warning(fmt.Sprintf("<%s> rejected the config changes from <%s>: %s",
+			utils.CoreS, cfgPath, rld.Error))
+	case len(rld.Applied) == 0 && len(rld.Pending) == 0:
+		return // only the formatting changed
+	default:
+		if len(rld.Applied) != 0 {
+			utils.Logger.Info(fmt.Sprintf("<%s> applied the config changes from <%s>: %s",
+				utils.CoreS, cfgPath, utils.ToJSON(rld.Applied)))
+		}
+		if len(rld.Pending) != 0 {
+			utils.Logger.Warning(fmt.Sprintf("<%s> the config changes from <%s> need an engine restart: %s",
+				utils.CoreS, cfgPath, utils.ToJSON(rld.Pending)))
+		}
+	}
+	cS.cfgRldMux.Lock()
+	cS.cfgReload = rld
+	cS.cfgRldMux.Unlock()
+}
+
+// lastConfigReload returns the outcome of the last config change
+func (cS *CoreService) lastConfigReload() *config.ConfigReload {
+	cS.cfgRldMux.RLock()
+	defer cS.cfgRldMux.RUnlock()
+	return cS.cfgReload
+}
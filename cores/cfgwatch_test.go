@@ -0,0 +1,102 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestCoreSWatchConfig(t *testing.T) {
+	cfgDir := t.TempDir()
+	cfgFile := filepath.Join(cfgDir, "cgrates.json")
+	if err := os.WriteFile(cfgFile, []byte(`{"thresholds": {"enabled": true}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.NewCGRConfigFromPath(cfgDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.CoreSCfg().ConfigWatchDelay = 10 * time.Millisecond
+	stopChan := make(chan struct{})
+	defer close(stopChan)
+	for _, section := range []string{config.DATADB_JSN, config.THRESHOLDS_JSON} {
+		go func(rldCh chan struct{}) {
+			for {
+				select {
+				case <-rldCh:
+				case <-stopChan:
+					return
+				}
+			}
+		}(cfg.GetReloadChan(section))
+	}
+	cS := NewCoreService(cfg, engine.NewCaps(0, utils.MetaBusy), nil, stopChan,
+		new(sync.WaitGroup), utils.NewSyncedChan())
+	if err = cS.WatchConfig(stopChan); err != nil {
+		t.Fatal(err)
+	}
+	writeCfg := func(cfgJSON string) { // replace the file at once so the watcher does not read it half written
+		if err := os.WriteFile(cfgFile+".tmp", []byte(cfgJSON), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(cfgFile+".tmp", cfgFile); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitReload := func(prev *config.ConfigReload) (rld *config.ConfigReload) {
+		for range 100 {
+			if rld = cS.lastConfigReload(); rld != prev {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("config not reloaded")
+		return
+	}
+
+	writeCfg(`{"thresholds": {"enabled": true, "store_interval": "1s"}}`)
+	rld := waitReload(nil)
+	if exp := map[string][]string{config.THRESHOLDS_JSON: {utils.StoreIntervalCfg}}; rld.Error != "" ||
+		!reflect.DeepEqual(exp, rld.Applied) {
+		t.Errorf("unexpected reload: %s", utils.ToJSON(rld))
+	}
+	var status map[string]any
+	if err = cS.V1Status(nil, nil, &status); err != nil {
+		t.Fatal(err)
+	} else if status[utils.FieldConfigReload] != rld {
+		t.Errorf("expected %s in status, received %s", utils.ToJSON(rld), utils.ToJSON(status[utils.FieldConfigReload]))
+	}
+
+	writeCfg(`{"thresholds": {"enabled": true, "store_interval": "2s"}, "sessions": {"enabled": true, "chargers_conns": ["*internal"]}}`)
+	if rld = waitReload(rld); rld.Error == "" {
+		t.Errorf("expected the changes to be rejected, received: %s", utils.ToJSON(rld))
+	}
+	if cfg.ThresholdSCfg().StoreInterval != time.Second {
+		t.Errorf("expected the rejected changes not to be applied, received %v", cfg.ThresholdSCfg().StoreInterval)
+	}
+}
@@ -65,6 +65,9 @@ type CoreService struct {
 	fileCPU    *os.File
 
 	caps *engine.Caps
+
+	cfgRldMux sync.RWMutex
+	cfgReload *config.ConfigReload // last change of the watched config
 }
 
 // Shutdown is called to shutdown the service
@@ -311,6 +314,9 @@ func (cS *CoreService) V1Status(_ *context.Context, params *V1StatusParams, repl
 	if err != nil {
 		return fmt.Errorf("could not convert StatusMetrics to map[string]any: %v", err)
 	}
+	if rld := cS.lastConfigReload(); rld != nil {
+		metricsMap[utils.FieldConfigReload] = rld
+	}
 	*reply = metricsMap
 	return nil
 }
@@ -322,6 +328,16 @@ func (cS *CoreService) V1Sleep(_ *context.Context, arg *utils.DurationArgs, repl
 	return nil
 }
 
+// V1GetTraceSpans returns the spans kept in memory by the tracer
+func (cS *CoreService) V1GetTraceSpans(_ *context.Context, args *utils.TraceSpansArgs, reply *[]*utils.Span) (err error) {
+	var spans []*utils.Span
+	if spans, err = utils.Tracer.Spans(args.TraceID); err != nil {
+		return
+	}
+	*reply = spans
+	return
+}
+
 // StartCPUProfiling is used to start CPUProfiling in the given path
 // V1StartCPUProfiling starts CPU profiling and saves the profile to the specified path.
 func (cS *CoreService) V1StartCPUProfiling(_ *context.Context, args *utils.DirectoryArgs, reply *string) error {
@@ -0,0 +1,515 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgrates/birpc"
+	bircontext "github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/cores/grpcpb"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/sessions"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/rpcclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ServeGRPC serves the APIs of data/grpc/cgrates.proto over gRPC
+func (s *Server) ServeGRPC(addr string, shdChan *utils.SyncedChan) {
+	s.RLock()
+	enabled := s.rpcEnabled
+	s.RUnlock()
+	if !enabled {
+		return
+	}
+	s.serveGRPC(addr, utils.GRPCCaps, nil, shdChan)
+}
+
+// ServeGRPCTLS serves the APIs of data/grpc/cgrates.proto over gRPC with TLS
+func (s *Server) ServeGRPCTLS(addr, serverCrt, serverKey, caCert string,
+	serverPolicy int, serverName string, shdChan *utils.SyncedChan) {
+	s.RLock()
+	enabled := s.rpcEnabled
+	s.RUnlock()
+	if !enabled {
+		return
+	}
+	config, err := loadTLSConfig(serverCrt, serverKey, caCert, serverPolicy, serverName)
+	if err != nil {
+		shdChan.CloseOnce()
+		return
+	}
+	s.serveGRPC(addr, utils.GRPCCaps+" "+utils.TLS,
+		[]grpc.ServerOption{grpc.Creds(credentials.NewTLS(config))}, shdChan)
+}
+
+func (s *Server) serveGRPC(addr, srvName string, opts []grpc.ServerOption, shdChan *utils.SyncedChan) {
+	l, err := net.Listen(utils.TCP, addr)
+	if err != nil {
+		utils.Logger.Err(fmt.Sprintf("Serve%s listen error: %s", srvName, err))
+		shdChan.CloseOnce()
+		return
+	}
+	utils.Logger.Info(fmt.Sprintf("Starting CGRateS %s server at <%s>.", srvName, addr))
+	if err = s.newGRPCServer(opts...).Serve(l); err != nil {
+		utils.Logger.Err(fmt.Sprintf("<CGRServer> %s serve error: <%s>", srvName, err))
+		shdChan.CloseOnce()
+	}
+}
+
+// newGRPCServer returns the gRPC server with the services of data/grpc/cgrates.proto registered
+func (s *Server) newGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	grpcpb.RegisterSessionSv1Server(srv, &grpcSessionSv1{srv: s})
+	grpcpb.RegisterCDRsV1Server(srv, &grpcCDRsV1{srv: s})
+	grpcpb.RegisterAPIerSv2Server(srv, &grpcAPIerSv2{srv: s})
+	return srv
+}
+
+// grpcCall executes the API call with the typed args and reply, passing through the
+// same caps, RBAC, tracing, analyzer and audit as the other listeners. The clnt
+// receives the requests pushed back by the service, e.g. the subscription notifications
+func (s *Server) grpcCall(ctx context.Context, clnt bircontext.ClientConnector,
+	method string, args, reply any) error {
+	var rmtAddr net.Addr = utils.LocalAddr()
+	if p, has := peer.FromContext(ctx); has {
+		rmtAddr = p.Addr
+	}
+	c := &grpcCodec{
+		method:     method,
+		args:       args,
+		reply:      reply,
+		remoteAddr: rmtAddr,
+	}
+	err := s.rpcSrv.ServeRequestContext(&bircontext.Context{Context: ctx, Client: clnt},
+		newAuditServerCodec(newCapsGRPCCodec(c, s.caps, s.anz), s.auditS(), c))
+	if c.err != nil { // the error replied, already converted by the codecs
+		err = c.err
+	}
+	if err != nil {
+		return grpcError(err.Error())
+	}
+	return nil
+}
+
+// grpcCodec is the birpc.ServerCodec of one gRPC call, passing the typed args
+// towards the API and collecting its reply
+type grpcCodec struct {
+	method     string
+	args       any // pointer to the API args
+	reply      any // pointer to the API reply
+	err        error
+	read       bool
+	remoteAddr net.Addr
+}
+
+func (c *grpcCodec) ReadRequestHeader(r *birpc.Request) error {
+	if c.read {
+		return io.EOF
+	}
+	c.read = true
+	r.ServiceMethod = c.method
+	return nil
+}
+
+func (c *grpcCodec) ReadRequestBody(x any) error {
+	if x == nil { // body discarded
+		return nil
+	}
+	return grpcCopy(x, c.args)
+}
+
+func (c *grpcCodec) WriteResponse(r *birpc.Response, x any) error {
+	if r.Error != utils.EmptyString {
+		c.err = errors.New(r.Error)
+		return nil
+	}
+	c.err = grpcCopy(c.reply, x)
+	return nil
+}
+
+func (c *grpcCodec) Close() error { return nil }
+
+// Read, Write, LocalAddr and RemoteAddr make the grpcCodec a conn for the other codecs
+func (c *grpcCodec) Read([]byte) (int, error)    { return 0, io.EOF }
+func (c *grpcCodec) Write(b []byte) (int, error) { return len(b), nil }
+func (c *grpcCodec) LocalAddr() net.Addr         { return utils.LocalAddr() }
+func (c *grpcCodec) RemoteAddr() net.Addr        { return c.remoteAddr }
+
+// grpcCopy copies the value pointed by src into the one pointed by dst
+func grpcCopy(dst, src any) error {
+	dv, sv := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if dv.Kind() != reflect.Pointer || dv.Type() != sv.Type() {
+		return fmt.Errorf("unexpected type <%T> instead of <%T>", src, dst)
+	}
+	dv.Elem().Set(sv.Elem())
+	return nil
+}
+
+// grpcError converts the API error to a gRPC status
+func grpcError(errMsg string) error {
+	code := codes.Unknown
+	switch {
+	case errMsg == utils.ErrNotFound.Error():
+		code = codes.NotFound
+	case errMsg == utils.ErrMaxConcurrentRPCExceeded.Error():
+		code = codes.ResourceExhausted
+	case errMsg == utils.ErrUnauthorizedApi.Error() ||
+		errMsg == utils.ErrUnknownApiKey.Error():
+		code = codes.PermissionDenied
+	case strings.HasPrefix(errMsg, utils.MandatoryInfoMissing):
+		code = codes.InvalidArgument
+	case strings.HasPrefix(errMsg, "rpc: can't find"):
+		code = codes.Unimplemented
+	}
+	return status.Error(code, errMsg)
+}
+
+// grpcSubscriber receives the notifications pushed by a service towards one gRPC stream
+type grpcSubscriber struct {
+	ntfs chan *engine.SubscriptionNotification
+	done <-chan struct{} // closed with the stream
+}
+
+// Call receives the SubscriberV1.Notify requests
+func (sbr *grpcSubscriber) Call(ctx *bircontext.Context, serviceMethod string, args, reply any) error {
+	n, canCast := args.(*engine.SubscriptionNotification)
+	if serviceMethod != utils.SubscriberV1Notify || !canCast {
+		return rpcclient.ErrUnsupporteServiceMethod
+	}
+	select {
+	case sbr.ntfs <- n:
+	case <-sbr.done:
+		return utils.ErrDisconnected
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if rply, canCast := reply.(*string); canCast {
+		*rply = utils.OK
+	}
+	return nil
+}
+
+// grpcSubscribe subscribes the stream to the events of a service, sending them with send
+// until the client cancels the stream. The onSubscribed runs once the subscription is active
+func (s *Server) grpcSubscribe(ctx context.Context, subscribe, unsubscribe string,
+	args *engine.SubscribeArgs, onSubscribed func() error,
+	send func(*engine.SubscriptionNotification) error) (err error) {
+	sbr := &grpcSubscriber{
+		ntfs: make(chan *engine.SubscriptionNotification),
+		done: ctx.Done(),
+	}
+	var subID string
+	if err = s.grpcCall(ctx, sbr, subscribe, args, &subID); err != nil {
+		return
+	}
+	defer func() {
+		var rply string
+		if errUnsub := s.grpcCall(context.Background(), sbr, unsubscribe, &subID, &rply); errUnsub != nil {
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> error: %s removing the subscription <%s> of a gRPC stream",
+					utils.CoreS, errUnsub.Error(), subID))
+		}
+	}()
+	if onSubscribed != nil {
+		if err = onSubscribed(); err != nil {
+			return
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-sbr.ntfs:
+			if err = send(n); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// grpcSessionSv1 serves the cgrates.SessionSv1 gRPC service
+type grpcSessionSv1 struct {
+	grpcpb.UnimplementedSessionSv1Server
+	srv *Server
+}
+
+func (g *grpcSessionSv1) GetActiveSessions(ctx context.Context,
+	args *grpcpb.SessionFilter) (*grpcpb.ExternalSessions, error) {
+	var aSs []*sessions.ExternalSession
+	if err := g.srv.grpcCall(ctx, nil, utils.SessionSv1GetActiveSessions,
+		sessionFilterFromGRPC(args), &aSs); err != nil {
+		return nil, err
+	}
+	return externalSessionsToGRPC(aSs), nil
+}
+
+func (g *grpcSessionSv1) GetActiveSessionsCount(ctx context.Context,
+	args *grpcpb.SessionFilter) (*grpcpb.Count, error) {
+	var count int
+	if err := g.srv.grpcCall(ctx, nil, utils.SessionSv1GetActiveSessionsCount,
+		sessionFilterFromGRPC(args), &count); err != nil {
+		return nil, err
+	}
+	return &grpcpb.Count{Count: int64(count)}, nil
+}
+
+func (g *grpcSessionSv1) Subscribe(args *grpcpb.SubscribeArgs,
+	stream grpc.ServerStreamingServer[grpcpb.SubscriptionNotification]) error {
+	return g.srv.grpcSubscribe(stream.Context(), utils.SessionSv1Subscribe, utils.SessionSv1Unsubscribe,
+		&engine.SubscribeArgs{
+			Tenant:     args.GetTenant(),
+			ID:         args.GetId(),
+			EventTypes: args.GetEventTypes(),
+			FilterIDs:  args.GetFilterIds(),
+			APIOpts:    args.GetApiOpts().AsMap(),
+		}, nil,
+		func(n *engine.SubscriptionNotification) error {
+			return stream.Send(notificationToGRPC(n))
+		})
+}
+
+func (g *grpcSessionSv1) WatchActiveSessions(args *grpcpb.SessionFilter,
+	stream grpc.ServerStreamingServer[grpcpb.ActiveSessionsUpdate]) error {
+	sf := sessionFilterFromGRPC(args)
+	return g.srv.grpcSubscribe(stream.Context(), utils.SessionSv1Subscribe, utils.SessionSv1Unsubscribe,
+		&engine.SubscribeArgs{
+			Tenant:    sf.Tenant,
+			FilterIDs: sf.Filters,
+			APIOpts:   sf.APIOpts,
+		},
+		func() error {
+			var aSs []*sessions.ExternalSession
+			if err := g.srv.grpcCall(stream.Context(), nil, utils.SessionSv1GetActiveSessions,
+				sf, &aSs); err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+			return stream.Send(&grpcpb.ActiveSessionsUpdate{
+				Update: &grpcpb.ActiveSessionsUpdate_Snapshot{Snapshot: externalSessionsToGRPC(aSs)},
+			})
+		},
+		func(n *engine.SubscriptionNotification) error {
+			return stream.Send(&grpcpb.ActiveSessionsUpdate{
+				Update: &grpcpb.ActiveSessionsUpdate_Notification{Notification: notificationToGRPC(n)},
+			})
+		})
+}
+
+// grpcCDRsV1 serves the cgrates.CDRsV1 gRPC service
+type grpcCDRsV1 struct {
+	grpcpb.UnimplementedCDRsV1Server
+	srv *Server
+}
+
+func (g *grpcCDRsV1) ProcessEvent(ctx context.Context,
+	args *grpcpb.ArgV1ProcessEvent) (*grpcpb.Reply, error) {
+	var rply string
+	if err := g.srv.grpcCall(ctx, nil, utils.CDRsV1ProcessEvent,
+		&engine.ArgV1ProcessEvent{
+			Flags:    args.GetFlags(),
+			CGREvent: *cgrEventFromGRPC(args.GetCgrEvent()),
+		}, &rply); err != nil {
+		return nil, err
+	}
+	return &grpcpb.Reply{Reply: rply}, nil
+}
+
+// grpcAPIerSv2 serves the cgrates.APIerSv2 gRPC service
+type grpcAPIerSv2 struct {
+	grpcpb.UnimplementedAPIerSv2Server
+	srv *Server
+}
+
+func (g *grpcAPIerSv2) GetAccount(ctx context.Context,
+	args *grpcpb.AttrGetAccount) (*grpcpb.Account, error) {
+	var acnt engine.Account
+	if err := g.srv.grpcCall(ctx, nil, utils.APIerSv2GetAccount,
+		&utils.AttrGetAccount{
+			Tenant:  args.GetTenant(),
+			Account: args.GetAccount(),
+		}, &acnt); err != nil {
+		return nil, err
+	}
+	return accountToGRPC(&acnt), nil
+}
+
+// cgrEventFromGRPC converts the gRPC event, the numbers of its fields being float64 as in the JSON-RPC requests
+func cgrEventFromGRPC(ev *grpcpb.CGREvent) (cgrEv *utils.CGREvent) {
+	cgrEv = &utils.CGREvent{
+		Tenant:  ev.GetTenant(),
+		ID:      ev.GetId(),
+		Event:   ev.GetEvent().AsMap(),
+		APIOpts: ev.GetApiOpts().AsMap(),
+	}
+	if ev.GetTime() != nil {
+		cgrEv.Time = utils.TimePointer(ev.GetTime().AsTime())
+	}
+	return
+}
+
+func cgrEventToGRPC(cgrEv *utils.CGREvent) (ev *grpcpb.CGREvent) {
+	if cgrEv == nil {
+		return
+	}
+	ev = &grpcpb.CGREvent{
+		Tenant:  cgrEv.Tenant,
+		Id:      cgrEv.ID,
+		Event:   structToGRPC(cgrEv.Event),
+		ApiOpts: structToGRPC(cgrEv.APIOpts),
+	}
+	if cgrEv.Time != nil {
+		ev.Time = timeToGRPC(*cgrEv.Time)
+	}
+	return
+}
+
+// structToGRPC converts the map, the values not supported by the protobuf Struct
+// (e.g. time.Time or time.Duration) being sent as strings
+func structToGRPC(m map[string]any) (s *structpb.Struct) {
+	s = &structpb.Struct{Fields: make(map[string]*structpb.Value, len(m))}
+	for k, v := range m {
+		val, err := structpb.NewValue(v)
+		if err != nil {
+			val = structpb.NewStringValue(utils.IfaceAsString(v))
+		}
+		s.Fields[k] = val
+	}
+	return
+}
+
+// timeToGRPC returns nil for the zero time
+func timeToGRPC(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func sessionFilterFromGRPC(args *grpcpb.SessionFilter) (sf *utils.SessionFilter) {
+	sf = &utils.SessionFilter{
+		Tenant:  args.GetTenant(),
+		Filters: args.GetFilters(),
+		APIOpts: args.GetApiOpts().AsMap(),
+	}
+	if args.GetLimit() > 0 {
+		sf.Limit = utils.IntPointer(int(args.GetLimit()))
+	}
+	return
+}
+
+func externalSessionsToGRPC(aSs []*sessions.ExternalSession) (ss *grpcpb.ExternalSessions) {
+	ss = &grpcpb.ExternalSessions{Sessions: make([]*grpcpb.ExternalSession, len(aSs))}
+	for i, aS := range aSs {
+		ss.Sessions[i] = &grpcpb.ExternalSession{
+			Cgrid:         aS.CGRID,
+			RunId:         aS.RunID,
+			Tor:           aS.ToR,
+			OriginId:      aS.OriginID,
+			OriginHost:    aS.OriginHost,
+			Source:        aS.Source,
+			RequestType:   aS.RequestType,
+			Tenant:        aS.Tenant,
+			Category:      aS.Category,
+			Account:       aS.Account,
+			Subject:       aS.Subject,
+			Destination:   aS.Destination,
+			SetupTime:     timeToGRPC(aS.SetupTime),
+			AnswerTime:    timeToGRPC(aS.AnswerTime),
+			Usage:         durationpb.New(aS.Usage),
+			ExtraFields:   aS.ExtraFields,
+			NodeId:        aS.NodeID,
+			LoopIndex:     aS.LoopIndex,
+			DurationIndex: durationpb.New(aS.DurationIndex),
+			MaxRate:       aS.MaxRate,
+			MaxRateUnit:   durationpb.New(aS.MaxRateUnit),
+			MaxCostSoFar:  aS.MaxCostSoFar,
+			DebitInterval: durationpb.New(aS.DebitInterval),
+			NextAutoDebit: timeToGRPC(aS.NextAutoDebit),
+		}
+	}
+	return
+}
+
+func notificationToGRPC(n *engine.SubscriptionNotification) *grpcpb.SubscriptionNotification {
+	return &grpcpb.SubscriptionNotification{
+		SubscriptionId: n.SubscriptionID,
+		Sequence:       n.Sequence,
+		Event:          cgrEventToGRPC(n.Event),
+	}
+}
+
+func accountToGRPC(acnt *engine.Account) (a *grpcpb.Account) {
+	a = &grpcpb.Account{
+		Id:            acnt.ID,
+		BalanceMap:    make(map[string]*grpcpb.Balances, len(acnt.BalanceMap)),
+		AllowNegative: acnt.AllowNegative,
+		Disabled:      acnt.Disabled,
+		UpdateTime:    timeToGRPC(acnt.UpdateTime),
+	}
+	for blcType, blcs := range acnt.BalanceMap {
+		gBlcs := &grpcpb.Balances{Balances: make([]*grpcpb.Balance, len(blcs))}
+		for i, blc := range blcs {
+			gBlcs.Balances[i] = &grpcpb.Balance{
+				Uuid:           blc.Uuid,
+				Id:             blc.ID,
+				Value:          blc.Value,
+				ExpirationDate: timeToGRPC(blc.ExpirationDate),
+				Weight:         blc.Weight,
+				DestinationIds: stringMapToGRPC(blc.DestinationIDs),
+				RatingSubject:  blc.RatingSubject,
+				Categories:     stringMapToGRPC(blc.Categories),
+				SharedGroups:   stringMapToGRPC(blc.SharedGroups),
+				TimingIds:      stringMapToGRPC(blc.TimingIDs),
+				Disabled:       blc.Disabled,
+				Blocker:        blc.Blocker,
+			}
+		}
+		a.BalanceMap[blcType] = gBlcs
+	}
+	return
+}
+
+// stringMapToGRPC returns the sorted keys, the excluded ones with the ! prefix
+func stringMapToGRPC(sm utils.StringMap) (keys []string) {
+	keys = make([]string, 0, len(sm))
+	for k, included := range sm {
+		if !included {
+			k = utils.NegativePrefix + k
+		}
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return
+}
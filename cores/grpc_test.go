@@ -0,0 +1,174 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	bircontext "github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/cores/grpcpb"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/sessions"
+	"github.com/cgrates/cgrates/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// grpcTestSessionSv1 replaces the SessionSv1 APIs
+type grpcTestSessionSv1 struct {
+	clnts chan bircontext.ClientConnector // the subscribed clients
+	unsub chan string
+}
+
+func (grpcTestSessionSv1) GetActiveSessions(_ *bircontext.Context, args *utils.SessionFilter,
+	reply *[]*sessions.ExternalSession) error {
+	if args.Tenant != "cgrates.org" {
+		return utils.ErrNotFound
+	}
+	*reply = []*sessions.ExternalSession{
+		{CGRID: "SESS1", Account: "1001", Usage: time.Minute},
+		{CGRID: "SESS2", Account: "1002"},
+	}
+	return nil
+}
+
+func (t grpcTestSessionSv1) Subscribe(ctx *bircontext.Context, args *engine.SubscribeArgs, reply *string) error {
+	t.clnts <- ctx.Client
+	*reply = "SUB1"
+	return nil
+}
+
+func (t grpcTestSessionSv1) Unsubscribe(_ *bircontext.Context, subID string, reply *string) error {
+	t.unsub <- subID
+	*reply = utils.OK
+	return nil
+}
+
+func TestGRPCServer(t *testing.T) {
+	srv := NewServer(engine.NewCaps(0, utils.MetaBusy))
+	sSv1 := grpcTestSessionSv1{
+		clnts: make(chan bircontext.ClientConnector, 1),
+		unsub: make(chan string, 1),
+	}
+	srv.RpcRegisterName(utils.SessionSv1, sSv1)
+	l := bufconn.Listen(1 << 20)
+	gSrv := srv.newGRPCServer()
+	go gSrv.Serve(l)
+	defer gSrv.Stop()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	sClnt := grpcpb.NewSessionSv1Client(conn)
+
+	rply, err := sClnt.GetActiveSessions(context.Background(), &grpcpb.SessionFilter{Tenant: "cgrates.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss := rply.GetSessions(); len(ss) != 2 ||
+		ss[0].GetCgrid() != "SESS1" || ss[0].GetAccount() != "1001" ||
+		ss[0].GetUsage().AsDuration() != time.Minute || ss[0].GetSetupTime() != nil ||
+		ss[1].GetCgrid() != "SESS2" {
+		t.Errorf("unexpected reply: %v", rply)
+	}
+	if _, err = sClnt.GetActiveSessions(context.Background(),
+		&grpcpb.SessionFilter{Tenant: "itsyscom.com"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, received %v", err)
+	}
+	if _, err = sClnt.GetActiveSessionsCount(context.Background(),
+		new(grpcpb.SessionFilter)); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented, received %v", err)
+	}
+	if _, err = grpcpb.NewCDRsV1Client(conn).ProcessEvent(context.Background(),
+		new(grpcpb.ArgV1ProcessEvent)); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected Unimplemented, received %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := sClnt.WatchActiveSessions(ctx, &grpcpb.SessionFilter{Tenant: "cgrates.org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	upd, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(upd.GetSnapshot().GetSessions()) != 2 {
+		t.Errorf("unexpected snapshot: %v", upd)
+	}
+	var clnt bircontext.ClientConnector
+	select {
+	case clnt = <-sSv1.clnts:
+	case <-time.After(time.Second):
+		t.Fatal("the stream did not subscribe")
+	}
+	var ntfRply string
+	if err = clnt.Call(bircontext.Background(), utils.SubscriberV1Notify,
+		&engine.SubscriptionNotification{
+			SubscriptionID: "SUB1",
+			Sequence:       1,
+			Event: &utils.CGREvent{
+				Tenant: "cgrates.org",
+				Event: map[string]any{
+					utils.EventType:    utils.SessionEnd,
+					utils.CGRID:        "SESS1",
+					utils.AnswerTime:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+					utils.Usage:        time.Minute,
+					utils.AccountField: "1001",
+				},
+			},
+		}, &ntfRply); err != nil {
+		t.Fatal(err)
+	}
+	if upd, err = stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	ntf := upd.GetNotification()
+	if ntf.GetSubscriptionId() != "SUB1" || ntf.GetSequence() != 1 {
+		t.Errorf("unexpected notification: %v", upd)
+	}
+	if rcv := ntf.GetEvent().GetEvent().AsMap(); !reflect.DeepEqual(rcv, map[string]any{
+		utils.EventType:    utils.SessionEnd,
+		utils.CGRID:        "SESS1",
+		utils.AnswerTime:   "2026-01-01T00:00:00Z",
+		utils.Usage:        "1m0s",
+		utils.AccountField: "1001",
+	}) {
+		t.Errorf("unexpected event: %v", rcv)
+	}
+	cancel()
+	select {
+	case subID := <-sSv1.unsub:
+		if subID != "SUB1" {
+			t.Errorf("unsubscribed %q", subID)
+		}
+	case <-time.After(time.Second):
+		t.Error("the stream did not unsubscribe")
+	}
+}
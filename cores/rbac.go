@@ -0,0 +1,198 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package cores
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// authorizeRequest checks the RBAC key received in the args against the RBAC config.
+// Returns the role and the tenant of the request alongside the error for the denied ones
+func authorizeRequest(rbacCfg *config.RBACCfg, dfltTnt, method string, args any) (role, tnt string, err error) {
+	var keyTnts utils.StringSet
+	apiKey := utils.IfaceAsString(utils.APIOptFromArgs(args, utils.OptsRBACKey))
+	if apiKey == utils.EmptyString {
+		if role = rbacCfg.DefaultRole; role == utils.EmptyString {
+			return role, tnt, utils.NewErrMandatoryIeMissing(utils.OptsRBACKey)
+		}
+	} else {
+		ak, has := rbacCfg.APIKeys[apiKey]
+		if !has {
+			return role, tnt, utils.ErrUnknownApiKey
+		}
+		role, keyTnts = ak.Role, ak.Tenants
+	}
+	rl, has := rbacCfg.Roles[role]
+	if !has {
+		return role, tnt, utils.ErrUnauthorizedApi
+	}
+	var allowed bool
+	for _, mth := range rl.Methods {
+		if allowed, _ = path.Match(mth, method); allowed {
+			break
+		}
+	}
+	if !allowed {
+		return role, tnt, utils.ErrUnauthorizedApi
+	}
+	if len(keyTnts) == 0 && len(rl.Tenants) == 0 {
+		return
+	}
+	tg, canTnt := args.(utils.TenantGetter)
+	if !canTnt { // the tenant of the request is unknown
+		return role, tnt, utils.ErrUnauthorizedApi
+	}
+	if tnt = tg.GetTenant(); tnt == utils.EmptyString {
+		tnt = dfltTnt
+	}
+	if (len(keyTnts) != 0 && !keyTnts.Has(tnt)) ||
+		(len(rl.Tenants) != 0 && !rl.Tenants.Has(tnt)) {
+		return role, tnt, utils.ErrUnauthorizedApi
+	}
+	return
+}
+
+// rbacRequests checks the requests received on one connection
+type rbacRequests struct {
+	cfg        *config.CGRConfig
+	remoteAddr string
+	method     string // of the request being read
+}
+
+func newRBACRequests(cfg *config.CGRConfig, conn conn) *rbacRequests {
+	rr := &rbacRequests{cfg: cfg}
+	if addr := conn.RemoteAddr(); addr != nil {
+		rr.remoteAddr = addr.String()
+	}
+	return rr
+}
+
+// authorize returns the error replied to the request with the body x if
+// the RBAC key does not allow it, logging the denied request
+func (rr *rbacRequests) authorize(x any) error {
+	if x == nil { // body discarded
+		return nil
+	}
+	rbacCfg := rr.cfg.RBACCfg()
+	if !rbacCfg.Enabled {
+		return nil
+	}
+	role, tnt, err := authorizeRequest(rbacCfg, rr.cfg.GeneralCfg().DefaultTenant, rr.method, x)
+	if err == nil {
+		return nil
+	}
+	utils.Logger.Warning(
+		fmt.Sprintf("<%s> denied call to %s from %s with role: %q, tenant: %q, error: %s",
+			utils.RBACLog, rr.method, rr.remoteAddr, role, tnt, err))
+	return err
+}
+
+func newRBACServerCodec(sc birpc.ServerCodec, conn conn) birpc.ServerCodec {
+	cfg := config.CgrConfig()
+	if !cfg.RBACCfg().Enabled {
+		return sc
+	}
+	return &rbacServerCodec{
+		sc:  sc,
+		rqs: newRBACRequests(cfg, conn),
+	}
+}
+
+// rbacServerCodec denies the requests not allowed by their API key
+type rbacServerCodec struct {
+	sc  birpc.ServerCodec
+	rqs *rbacRequests
+}
+
+func (c *rbacServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	if err = c.sc.ReadRequestHeader(r); err == nil {
+		c.rqs.method = r.ServiceMethod
+	}
+	return
+}
+
+func (c *rbacServerCodec) ReadRequestBody(x any) (err error) {
+	if err = c.sc.ReadRequestBody(x); err != nil {
+		return
+	}
+	return c.rqs.authorize(x)
+}
+
+func (c *rbacServerCodec) WriteResponse(r *birpc.Response, x any) error {
+	return c.sc.WriteResponse(r, x)
+}
+
+func (c *rbacServerCodec) Close() error { return c.sc.Close() }
+
+func newRBACBiRPCCodec(sc birpc.BirpcCodec, conn conn) birpc.BirpcCodec {
+	cfg := config.CgrConfig()
+	if !cfg.RBACCfg().Enabled {
+		return sc
+	}
+	return &rbacBiRPCCodec{
+		sc:  sc,
+		rqs: newRBACRequests(cfg, conn),
+	}
+}
+
+// rbacBiRPCCodec denies the requests not allowed by their API key
+type rbacBiRPCCodec struct {
+	sc  birpc.BirpcCodec
+	rqs *rbacRequests
+}
+
+// ReadHeader must read a message and populate either the request
+// or the response by inspecting the incoming message.
+func (c *rbacBiRPCCodec) ReadHeader(req *birpc.Request, resp *birpc.Response) (err error) {
+	if err = c.sc.ReadHeader(req, resp); err == nil &&
+		req.ServiceMethod != utils.EmptyString { // not a reply
+		c.rqs.method = req.ServiceMethod
+	}
+	return
+}
+
+// ReadRequestBody into args argument of handler function.
+func (c *rbacBiRPCCodec) ReadRequestBody(x any) (err error) {
+	if err = c.sc.ReadRequestBody(x); err != nil {
+		return
+	}
+	return c.rqs.authorize(x)
+}
+
+// ReadResponseBody into reply argument of handler function.
+func (c *rbacBiRPCCodec) ReadResponseBody(x any) error {
+	return c.sc.ReadResponseBody(x)
+}
+
+// WriteRequest must be safe for concurrent use by multiple goroutines.
+func (c *rbacBiRPCCodec) WriteRequest(req *birpc.Request, x any) error {
+	return c.sc.WriteRequest(req, x)
+}
+
+// WriteResponse must be safe for concurrent use by multiple goroutines.
+func (c *rbacBiRPCCodec) WriteResponse(r *birpc.Response, x any) error {
+	return c.sc.WriteResponse(r, x)
+}
+
+// Close is called when client/server finished with the connection.
+func (c *rbacBiRPCCodec) Close() error { return c.sc.Close() }
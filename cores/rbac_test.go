@@ -0,0 +1,281 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package cores
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/birpc/jsonrpc"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func testRBACCfg() *config.RBACCfg {
+	return &config.RBACCfg{
+		Enabled: true,
+		Roles: map[string]*config.RBACRoleCfg{
+			"monitor": {Methods: []string{utils.CoreSv1Status}},
+			"reseller": {
+				Methods: []string{"APIerSv1.Get*", "SessionSv1.*"},
+				Tenants: utils.NewStringSet([]string{"cgrates.org", "itsyscom.com"}),
+			},
+		},
+		APIKeys: map[string]*config.RBACAPIKeyCfg{
+			"resellerKey": {Role: "reseller"},
+			"itsyscomKey": {Role: "reseller", Tenants: utils.NewStringSet([]string{"itsyscom.com"})},
+		},
+	}
+}
+
+func TestAuthorizeRequest(t *testing.T) {
+	rbacCfg := testRBACCfg()
+	newArgs := func(apiKey, tnt string) *utils.CGREvent {
+		ev := &utils.CGREvent{Tenant: tnt, APIOpts: make(map[string]any)}
+		if apiKey != utils.EmptyString {
+			ev.APIOpts[utils.OptsRBACKey] = apiKey
+		}
+		return ev
+	}
+	for _, tc := range []struct {
+		name    string
+		method  string
+		args    any
+		expRole string
+		expTnt  string
+		expErr  error
+	}{
+		{
+			name:   "missing API key",
+			method: utils.CoreSv1Status,
+			args:   newArgs(utils.EmptyString, "cgrates.org"),
+			expErr: utils.NewErrMandatoryIeMissing(utils.OptsRBACKey),
+		},
+		{
+			name:   "unknown API key",
+			method: utils.CoreSv1Status,
+			args:   newArgs("unknownKey", "cgrates.org"),
+			expErr: utils.ErrUnknownApiKey,
+		},
+		{
+			name:    "allowed method and tenant",
+			method:  utils.SessionSv1AuthorizeEvent,
+			args:    newArgs("resellerKey", "cgrates.org"),
+			expRole: "reseller",
+			expTnt:  "cgrates.org",
+		},
+		{
+			name:    "default tenant",
+			method:  utils.APIerSv1GetAttributeProfile,
+			args:    newArgs("resellerKey", utils.EmptyString),
+			expRole: "reseller",
+			expTnt:  "cgrates.org",
+		},
+		{
+			name:   "tenant of the embedded args",
+			method: utils.APIerSv1GetAttributeProfile,
+			args: &utils.TenantIDWithAPIOpts{
+				TenantID: &utils.TenantID{Tenant: "itsyscom.com", ID: "ATTR_1"},
+				APIOpts:  map[string]any{utils.OptsRBACKey: "itsyscomKey"},
+			},
+			expRole: "reseller",
+			expTnt:  "itsyscom.com",
+		},
+		{
+			name:   "args not scoped to a tenant",
+			method: utils.APIerSv1GetCacheStats,
+			args: &utils.ArgsGetCacheItemIDsWithAPIOpts{
+				Tenant:  "cgrates.org",
+				APIOpts: map[string]any{utils.OptsRBACKey: "resellerKey"},
+			},
+			expRole: "reseller",
+			expErr:  utils.ErrUnauthorizedApi,
+		},
+		{
+			name:   "dispatcher API key",
+			method: utils.CoreSv1Status,
+			args:   &utils.TenantWithAPIOpts{APIOpts: map[string]any{utils.OptsAPIKey: "resellerKey"}},
+			expErr: utils.NewErrMandatoryIeMissing(utils.OptsRBACKey),
+		},
+		{
+			name:    "method not allowed",
+			method:  utils.APIerSv1SetAttributeProfile,
+			args:    newArgs("resellerKey", "cgrates.org"),
+			expRole: "reseller",
+			expErr:  utils.ErrUnauthorizedApi,
+		},
+		{
+			name:    "tenant not allowed by role",
+			method:  utils.SessionSv1AuthorizeEvent,
+			args:    newArgs("resellerKey", "cgrates.net"),
+			expRole: "reseller",
+			expTnt:  "cgrates.net",
+			expErr:  utils.ErrUnauthorizedApi,
+		},
+		{
+			name:    "tenant not allowed by API key",
+			method:  utils.SessionSv1AuthorizeEvent,
+			args:    newArgs("itsyscomKey", "cgrates.org"),
+			expRole: "reseller",
+			expTnt:  "cgrates.org",
+			expErr:  utils.ErrUnauthorizedApi,
+		},
+		{
+			name:    "tenant allowed by API key",
+			method:  utils.SessionSv1AuthorizeEvent,
+			args:    newArgs("itsyscomKey", "itsyscom.com"),
+			expRole: "reseller",
+			expTnt:  "itsyscom.com",
+		},
+		{
+			name:    "nil embedded args",
+			method:  "SessionSv1.ProcessCDR",
+			args:    &engine.CGREventWithEeIDs{},
+			expRole: utils.EmptyString,
+			expErr:  utils.NewErrMandatoryIeMissing(utils.OptsRBACKey),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			role, tnt, err := authorizeRequest(rbacCfg, "cgrates.org", tc.method, tc.args)
+			if (err == nil) != (tc.expErr == nil) ||
+				(err != nil && err.Error() != tc.expErr.Error()) {
+				t.Errorf("Expected error %v, received %v", tc.expErr, err)
+			}
+			if role != tc.expRole {
+				t.Errorf("Expected role %q, received %q", tc.expRole, role)
+			}
+			if tnt != tc.expTnt {
+				t.Errorf("Expected tenant %q, received %q", tc.expTnt, tnt)
+			}
+		})
+	}
+	rbacCfg.DefaultRole = "monitor"
+	if role, _, err := authorizeRequest(rbacCfg, "cgrates.org", utils.CoreSv1Status,
+		&utils.TenantWithAPIOpts{}); err != nil {
+		t.Error(err)
+	} else if role != "monitor" {
+		t.Errorf("Expected role %q, received %q", "monitor", role)
+	}
+}
+
+type mockRBACServerCodec struct {
+	method string
+	apiKey string
+}
+
+func (c *mockRBACServerCodec) ReadRequestHeader(r *birpc.Request) error {
+	r.Seq = 1
+	r.ServiceMethod = c.method
+	return nil
+}
+
+func (c *mockRBACServerCodec) ReadRequestBody(x any) error {
+	if x == nil {
+		return nil
+	}
+	ev := x.(*utils.CGREvent)
+	ev.Tenant = "cgrates.org"
+	ev.APIOpts = map[string]any{utils.OptsRBACKey: c.apiKey}
+	return nil
+}
+
+func (c *mockRBACServerCodec) WriteResponse(r *birpc.Response, x any) error { return nil }
+
+func (c *mockRBACServerCodec) Close() error { return nil }
+
+func TestRBACServerCodec(t *testing.T) {
+	sc := &mockRBACServerCodec{
+		method: utils.SessionSv1AuthorizeEvent,
+		apiKey: "resellerKey",
+	}
+	if rcv := newRBACServerCodec(sc, new(mockConn)); rcv != sc {
+		t.Error("Expected the codec to not be wrapped with the RBAC disabled")
+	}
+	cfg := config.NewDefaultCGRConfig()
+	*cfg.RBACCfg() = *testRBACCfg()
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	codec := newRBACServerCodec(sc, new(mockConn))
+	if err := codec.ReadRequestHeader(new(birpc.Request)); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(new(utils.CGREvent)); err != nil {
+		t.Error(err)
+	}
+	sc.method = utils.APIerSv1SetAttributeProfile
+	if err := codec.ReadRequestHeader(new(birpc.Request)); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.ReadRequestBody(new(utils.CGREvent)); err != utils.ErrUnauthorizedApi {
+		t.Errorf("Expected %v, received %v", utils.ErrUnauthorizedApi, err)
+	}
+	// discarded bodies are not checked
+	if err := codec.ReadRequestBody(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+type rbacTestAPIerSv1 struct{}
+
+func (rbacTestAPIerSv1) GetAttributeProfile(_ *context.Context, _ *utils.TenantIDWithAPIOpts, reply *string) error {
+	*reply = utils.OK
+	return nil
+}
+
+func (rbacTestAPIerSv1) SetAttributeProfile(_ *context.Context, _ *utils.TenantIDWithAPIOpts, reply *string) error {
+	*reply = utils.OK
+	return nil
+}
+
+func TestRBACServerCodecDenied(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	*cfg.RBACCfg() = *testRBACCfg()
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	srv := birpc.NewServer()
+	if err := srv.RegisterName(utils.APIerSv1, new(rbacTestAPIerSv1)); err != nil {
+		t.Fatal(err)
+	}
+	sConn, cConn := net.Pipe()
+	go srv.ServeCodec(newRBACServerCodec(jsonrpc.NewServerCodec(sConn), sConn))
+	clnt := birpc.NewClientWithCodec(jsonrpc.NewClientCodec(cConn))
+	defer clnt.Close()
+	args := &utils.TenantIDWithAPIOpts{
+		TenantID: &utils.TenantID{Tenant: "itsyscom.com", ID: "ATTR_1"},
+		APIOpts:  map[string]any{utils.OptsRBACKey: "itsyscomKey"},
+	}
+	var reply string
+	if err := clnt.Call(context.Background(), utils.APIerSv1GetAttributeProfile, args, &reply); err != nil {
+		t.Fatal(err)
+	} else if reply != utils.OK {
+		t.Errorf("Expected %q, received %q", utils.OK, reply)
+	}
+	// the denied calls are answered with the error and the connection is kept
+	if err := clnt.Call(context.Background(), utils.APIerSv1SetAttributeProfile, args, &reply); err == nil ||
+		err.Error() != utils.ErrUnauthorizedApi.Error() {
+		t.Errorf("Expected %v, received %v", utils.ErrUnauthorizedApi, err)
+	}
+	args.Tenant = "cgrates.org"
+	if err := clnt.Call(context.Background(), utils.APIerSv1GetAttributeProfile, args, &reply); err == nil ||
+		err.Error() != utils.ErrUnauthorizedApi.Error() {
+		t.Errorf("Expected %v, received %v", utils.ErrUnauthorizedApi, err)
+	}
+}
@@ -63,12 +63,26 @@ type Server struct {
 	httpMux         *http.ServeMux
 	caps            *engine.Caps
 	anz             *analyzers.AnalyzerService
+	audS            *engine.AuditS
 }
 
 func (s *Server) SetAnalyzer(anz *analyzers.AnalyzerService) {
 	s.anz = anz
 }
 
+// SetAuditS sets the AuditS recording the requests received, nil to stop recording them
+func (s *Server) SetAuditS(audS *engine.AuditS) {
+	s.Lock()
+	s.audS = audS
+	s.Unlock()
+}
+
+func (s *Server) auditS() *engine.AuditS {
+	s.RLock()
+	defer s.RUnlock()
+	return s.audS
+}
+
 func (s *Server) RpcRegister(rcvr any) {
 	utils.RegisterRpcParams(utils.EmptyString, rcvr)
 	s.rpcSrv.Register(rcvr)
@@ -171,7 +185,7 @@ func (s *Server) accept(l net.Listener, codecName string, newCodec func(conn con
 			}
 			continue
 		}
-		go s.rpcSrv.ServeCodec(newCodec(conn, s.caps, s.anz))
+		go s.rpcSrv.ServeCodec(newAuditServerCodec(newCodec(conn, s.caps, s.anz), s.auditS(), conn))
 	}
 }
 
@@ -193,7 +207,7 @@ func (s *Server) handleRequest(w http.ResponseWriter, r *http.Request) {
 	}
 	rmtIP, _ := utils.GetRemoteIP(r)
 	rmtAddr, _ := net.ResolveIPAddr(utils.EmptyString, rmtIP)
-	res := newRPCRequest(s.rpcSrv, r.Body, rmtAddr, s.caps, s.anz).Call()
+	res := newRPCRequest(s.rpcSrv, r.Body, rmtAddr, s.caps, s.anz, s.auditS()).Call()
 	io.Copy(w, res)
 }
 
@@ -275,7 +289,7 @@ func (s *Server) ServeBiRPC(addrJSON, addrGOB string, onConns, onDiss []func(bir
 	if addrJSON != utils.EmptyString {
 		var ljson net.Listener
 		if ljson, err = listenBiRPC(s.birpcSrv, addrJSON, utils.JSONCaps, func(conn conn) birpc.BirpcCodec {
-			return newCapsBiRPCJSONCodec(conn, s.caps, s.anz)
+			return newAuditBiRPCCodec(newCapsBiRPCJSONCodec(conn, s.caps, s.anz), s.auditS(), conn)
 		}, s.stopBiRPCServer); err != nil {
 			return
 		}
@@ -284,7 +298,7 @@ func (s *Server) ServeBiRPC(addrJSON, addrGOB string, onConns, onDiss []func(bir
 	if addrGOB != utils.EmptyString {
 		var lgob net.Listener
 		if lgob, err = listenBiRPC(s.birpcSrv, addrGOB, utils.GOBCaps, func(conn conn) birpc.BirpcCodec {
-			return newCapsBiRPCGOBCodec(conn, s.caps, s.anz)
+			return newAuditBiRPCCodec(newCapsBiRPCGOBCodec(conn, s.caps, s.anz), s.auditS(), conn)
 		}, s.stopBiRPCServer); err != nil {
 			return
 		}
@@ -338,17 +352,20 @@ type rpcRequest struct {
 	remoteAddr net.Addr
 	caps       *engine.Caps
 	anzWarpper *analyzers.AnalyzerService
+	audS       *engine.AuditS
 	srv        *birpc.Server
 }
 
 // newRPCRequest returns a new rpcRequest.
-func newRPCRequest(srv *birpc.Server, r io.ReadCloser, remoteAddr net.Addr, caps *engine.Caps, anz *analyzers.AnalyzerService) *rpcRequest {
+func newRPCRequest(srv *birpc.Server, r io.ReadCloser, remoteAddr net.Addr, caps *engine.Caps, anz *analyzers.AnalyzerService,
+	audS *engine.AuditS) *rpcRequest {
 	return &rpcRequest{
 		r:          r,
 		rw:         new(bytes.Buffer),
 		remoteAddr: remoteAddr,
 		caps:       caps,
 		anzWarpper: anz,
+		audS:       audS,
 		srv:        srv,
 	}
 }
@@ -374,7 +391,7 @@ func (r *rpcRequest) Close() error {
 
 // Call invokes the RPC request, waits for it to complete, and returns the results.
 func (r *rpcRequest) Call() io.Reader {
-	r.srv.ServeCodec(newCapsJSONCodec(r, r.caps, r.anzWarpper))
+	r.srv.ServeCodec(newAuditServerCodec(newCapsJSONCodec(r, r.caps, r.anzWarpper), r.audS, r))
 	return r.rw
 }
 
@@ -452,7 +469,7 @@ func (s *Server) ServeJSONTLS(addr, serverCrt, serverKey, caCert string,
 }
 
 func (s *Server) handleWebSocket(ws *websocket.Conn) {
-	s.rpcSrv.ServeCodec(newCapsJSONCodec(ws, s.caps, s.anz))
+	s.rpcSrv.ServeCodec(newAuditServerCodec(newCapsJSONCodec(ws, s.caps, s.anz), s.auditS(), ws))
 }
 
 func (s *Server) ServeHTTPTLS(addr, serverCrt, serverKey, caCert string, serverPolicy int,
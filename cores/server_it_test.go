@@ -323,7 +323,7 @@ func testServeBiJSON(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	ss := sessions.NewSessionS(cfg, dm, nil)
+	ss := sessions.NewSessionS(cfg, dm, nil, nil)
 
 	go func() {
 		if err := server.ServeBiRPC(":3434", "", []func(birpc.ClientConnector){ss.OnBiJSONConnect}, []func(birpc.ClientConnector){ss.OnBiJSONDisconnect}); err != nil {
@@ -346,7 +346,7 @@ func testServeBiJSONInvalidPort(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	ss := sessions.NewSessionS(cfg, dm, nil)
+	ss := sessions.NewSessionS(cfg, dm, nil, nil)
 
 	expectedErr := "listen tcp: address invalid_port_format: missing port in address"
 	if err := server.ServeBiRPC("invalid_port_format", "", []func(birpc.ClientConnector){ss.OnBiJSONConnect}, []func(birpc.ClientConnector){ss.OnBiJSONDisconnect}); err == nil || err.Error() != expectedErr {
@@ -369,7 +369,7 @@ func testServeBiGoB(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	ss := sessions.NewSessionS(cfg, dm, nil)
+	ss := sessions.NewSessionS(cfg, dm, nil, nil)
 
 	go func() {
 		if err := server.ServeBiRPC("", ":9343", []func(birpc.ClientConnector){ss.OnBiJSONConnect}, []func(birpc.ClientConnector){ss.OnBiJSONDisconnect}); err != nil {
@@ -392,7 +392,7 @@ func testServeBiGoBInvalidPort(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	ss := sessions.NewSessionS(cfg, dm, nil)
+	ss := sessions.NewSessionS(cfg, dm, nil, nil)
 
 	expectedErr := "listen tcp: address invalid_port_format: missing port in address"
 	if err := server.ServeBiRPC("", "invalid_port_format", []func(birpc.ClientConnector){ss.OnBiJSONConnect}, []func(birpc.ClientConnector){ss.OnBiJSONDisconnect}); err == nil || err.Error() != expectedErr {
@@ -753,7 +753,7 @@ func testRpcRegisterActions(t *testing.T) {
 	rmtIP, _ := utils.GetRemoteIP(r)
 	rmtAddr, _ := net.ResolveIPAddr(utils.EmptyString, rmtIP)
 
-	rpcReq := newRPCRequest(server.rpcSrv, r.Body, rmtAddr, server.caps, nil)
+	rpcReq := newRPCRequest(server.rpcSrv, r.Body, rmtAddr, server.caps, nil, nil)
 	rpcReq.remoteAddr = utils.NewNetAddr("network", "127.0.0.1:2012")
 
 	if n, err := rpcReq.Write([]byte(`TEST`)); err != nil {
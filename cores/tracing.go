@@ -0,0 +1,175 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// requestSpans keeps the server spans of the requests in progress on one connection
+type requestSpans struct {
+	codec      string
+	remoteAddr string
+	method     string // of the request being read
+	seq        uint64
+
+	mux   sync.Mutex
+	spans map[uint64]*utils.Span
+}
+
+func newRequestSpans(codec string, conn conn) *requestSpans {
+	rs := &requestSpans{
+		codec: codec,
+		spans: make(map[uint64]*utils.Span),
+	}
+	if addr := conn.RemoteAddr(); addr != nil {
+		rs.remoteAddr = addr.String()
+	}
+	return rs
+}
+
+// start creates the span for the request with the body x, making it the parent
+// of the calls done by the handler through the APIOpts of x
+func (rs *requestSpans) start(x any) {
+	if x == nil { // body discarded
+		return
+	}
+	span := utils.Tracer.StartSpan(utils.TraceParentFromArgs(x), rs.method, utils.MetaServer)
+	if span == nil {
+		return
+	}
+	span.SetAttribute("rpc.system", rs.codec)
+	if rs.remoteAddr != utils.EmptyString {
+		span.SetAttribute("net.peer.address", rs.remoteAddr)
+	}
+	utils.SetArgsTraceParent(x, span.TraceParent())
+	rs.mux.Lock()
+	rs.spans[rs.seq] = span
+	rs.mux.Unlock()
+}
+
+// end closes the span of the request answered by r
+func (rs *requestSpans) end(r *birpc.Response) {
+	rs.mux.Lock()
+	span, has := rs.spans[r.Seq]
+	delete(rs.spans, r.Seq)
+	rs.mux.Unlock()
+	if !has {
+		return
+	}
+	var err error
+	if r.Error != utils.EmptyString {
+		err = errors.New(r.Error)
+	}
+	span.End(err)
+}
+
+func newTracingServerCodec(sc birpc.ServerCodec, codec string, conn conn) birpc.ServerCodec {
+	if !utils.Tracer.Enabled() {
+		return sc
+	}
+	return &tracingServerCodec{
+		sc:  sc,
+		rqs: newRequestSpans(codec, conn),
+	}
+}
+
+// tracingServerCodec creates a server span for each request
+type tracingServerCodec struct {
+	sc  birpc.ServerCodec
+	rqs *requestSpans
+}
+
+func (c *tracingServerCodec) ReadRequestHeader(r *birpc.Request) (err error) {
+	if err = c.sc.ReadRequestHeader(r); err == nil {
+		c.rqs.method, c.rqs.seq = r.ServiceMethod, r.Seq
+	}
+	return
+}
+
+func (c *tracingServerCodec) ReadRequestBody(x any) (err error) {
+	if err = c.sc.ReadRequestBody(x); err == nil {
+		c.rqs.start(x)
+	}
+	return
+}
+
+func (c *tracingServerCodec) WriteResponse(r *birpc.Response, x any) error {
+	defer c.rqs.end(r)
+	return c.sc.WriteResponse(r, x)
+}
+
+func (c *tracingServerCodec) Close() error { return c.sc.Close() }
+
+func newTracingBiRPCCodec(sc birpc.BirpcCodec, codec string, conn conn) birpc.BirpcCodec {
+	if !utils.Tracer.Enabled() {
+		return sc
+	}
+	return &tracingBiRPCCodec{
+		sc:  sc,
+		rqs: newRequestSpans(codec, conn),
+	}
+}
+
+// tracingBiRPCCodec creates a server span for each request received
+type tracingBiRPCCodec struct {
+	sc  birpc.BirpcCodec
+	rqs *requestSpans
+}
+
+// ReadHeader must read a message and populate either the request
+// or the response by inspecting the incoming message.
+func (c *tracingBiRPCCodec) ReadHeader(req *birpc.Request, resp *birpc.Response) (err error) {
+	if err = c.sc.ReadHeader(req, resp); err == nil &&
+		req.ServiceMethod != utils.EmptyString { // not a reply
+		c.rqs.method, c.rqs.seq = req.ServiceMethod, req.Seq
+	}
+	return
+}
+
+// ReadRequestBody into args argument of handler function.
+func (c *tracingBiRPCCodec) ReadRequestBody(x any) (err error) {
+	if err = c.sc.ReadRequestBody(x); err == nil {
+		c.rqs.start(x)
+	}
+	return
+}
+
+// ReadResponseBody into reply argument of handler function.
+func (c *tracingBiRPCCodec) ReadResponseBody(x any) error {
+	return c.sc.ReadResponseBody(x)
+}
+
+// WriteRequest must be safe for concurrent use by multiple goroutines.
+func (c *tracingBiRPCCodec) WriteRequest(req *birpc.Request, x any) error {
+	return c.sc.WriteRequest(req, x)
+}
+
+// WriteResponse must be safe for concurrent use by multiple goroutines.
+func (c *tracingBiRPCCodec) WriteResponse(r *birpc.Response, x any) error {
+	defer c.rqs.end(r)
+	return c.sc.WriteResponse(r, x)
+}
+
+// Close is called when client/server finished with the connection.
+func (c *tracingBiRPCCodec) Close() error { return c.sc.Close() }
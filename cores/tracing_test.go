@@ -0,0 +1,83 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package cores
+
+import (
+	"testing"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/cgrates/utils"
+)
+
+type mockTracingServerCodec struct {
+	traceParent string
+}
+
+func (c *mockTracingServerCodec) ReadRequestHeader(r *birpc.Request) error {
+	r.Seq = 1
+	r.ServiceMethod = utils.SessionSv1AuthorizeEvent
+	return nil
+}
+
+func (c *mockTracingServerCodec) ReadRequestBody(x any) error {
+	x.(*utils.CGREvent).APIOpts = map[string]any{utils.OptsTraceParent: c.traceParent}
+	return nil
+}
+
+func (c *mockTracingServerCodec) WriteResponse(r *birpc.Response, x any) error { return nil }
+
+func (c *mockTracingServerCodec) Close() error { return nil }
+
+func TestTracingServerCodec(t *testing.T) {
+	sc := &mockTracingServerCodec{traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}
+	if rcv := newTracingServerCodec(sc, utils.MetaJSON, new(mockConn)); rcv != sc {
+		t.Error("Expected the codec to not be wrapped with the tracing disabled")
+	}
+	memExp := utils.NewMemorySpanExporter(-1)
+	utils.Tracer.Load(1, false, memExp)
+	defer utils.Tracer.Close()
+	codec := newTracingServerCodec(sc, utils.MetaJSON, new(mockConn))
+	req := new(birpc.Request)
+	if err := codec.ReadRequestHeader(req); err != nil {
+		t.Fatal(err)
+	}
+	ev := new(utils.CGREvent)
+	if err := codec.ReadRequestBody(ev); err != nil {
+		t.Fatal(err)
+	}
+	if err := codec.WriteResponse(&birpc.Response{Seq: 1, Error: utils.ErrNotFound.Error()}, nil); err != nil {
+		t.Fatal(err)
+	}
+	spans := memExp.Spans("4bf92f3577b34da6a3ce929d0e0e4736")
+	if len(spans) != 1 {
+		t.Fatalf("Expected one span, received %s", utils.ToJSON(spans))
+	}
+	span := spans[0]
+	if span.ParentSpanID != "00f067aa0ba902b7" ||
+		span.Name != utils.SessionSv1AuthorizeEvent ||
+		span.Kind != utils.MetaServer ||
+		span.Error != utils.ErrNotFound.Error() ||
+		span.Attributes["rpc.system"] != utils.MetaJSON {
+		t.Errorf("Unexpected span: %s", utils.ToJSON(span))
+	}
+	// the calls done by the handler are children of the server span
+	if rcv := utils.TraceParentFromArgs(ev); rcv != span.TraceParent() {
+		t.Errorf("Expected %q, received %q", span.TraceParent(), rcv)
+	}
+}
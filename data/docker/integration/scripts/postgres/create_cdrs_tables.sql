@@ -43,6 +43,7 @@ CREATE TABLE session_costs (
   origin_host VARCHAR(64) NOT NULL,
   origin_id VARCHAR(128) NOT NULL,
   cost_source VARCHAR(64) NOT NULL,
+  account VARCHAR(128) NOT NULL DEFAULT '',
   usage BIGINT NOT NULL,
   cost_details jsonb,
   created_at TIMESTAMP WITH TIME ZONE,
@@ -57,3 +58,5 @@ DROP INDEX IF EXISTS run_origin_sessionscost_idx;
 CREATE INDEX run_origin_sessionscost_idx ON session_costs (run_id, origin_id);
 DROP INDEX IF EXISTS deleted_at_sessionscost_idx;
 CREATE INDEX deleted_at_sessionscost_idx ON session_costs (deleted_at);
+DROP INDEX IF EXISTS account_sessionscost_idx;
+CREATE INDEX account_sessionscost_idx ON session_costs (account);
@@ -40,6 +40,7 @@ CREATE TABLE session_costs (
   origin_host varchar(64) NOT NULL,
   origin_id varchar(128) NOT NULL,
   cost_source varchar(64) NOT NULL,
+  account varchar(128) NOT NULL DEFAULT '',
   `usage` BIGINT NOT NULL,
   cost_details MEDIUMTEXT,
   created_at TIMESTAMP NULL,
@@ -48,5 +49,6 @@ CREATE TABLE session_costs (
   UNIQUE KEY costid (cgrid, run_id),
   KEY origin_idx (origin_host, origin_id),
   KEY run_origin_idx (run_id, origin_id),
+  KEY account_idx (account),
   KEY deleted_at_idx (deleted_at)
 );
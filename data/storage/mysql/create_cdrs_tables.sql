@@ -40,6 +40,7 @@ CREATE TABLE session_costs (
   origin_host varchar(64) NOT NULL,
   origin_id varchar(128) NOT NULL,
   cost_source varchar(64) NOT NULL,
+  account varchar(128) NOT NULL DEFAULT '',
   `usage` BIGINT NOT NULL,
   cost_details MEDIUMTEXT,
   created_at TIMESTAMP NULL,
@@ -48,5 +49,31 @@ CREATE TABLE session_costs (
   UNIQUE KEY costid (cgrid, run_id),
   KEY origin_idx (origin_host, origin_id),
   KEY run_origin_idx (run_id, origin_id),
+  KEY account_idx (account),
   KEY deleted_at_idx (deleted_at)
 );
+
+--
+-- Table structure for table `audit_records`
+--
+
+DROP TABLE IF EXISTS audit_records;
+CREATE TABLE audit_records (
+  id int(11) NOT NULL AUTO_INCREMENT,
+  record_id varchar(40) NOT NULL,
+  record_time TIMESTAMP(6) NOT NULL,
+  address varchar(64) NOT NULL,
+  api_key varchar(128) NOT NULL,
+  method varchar(128) NOT NULL,
+  tenant varchar(64) NOT NULL,
+  object_type varchar(64) NOT NULL,
+  object_id varchar(256) NOT NULL,
+  object_before MEDIUMTEXT,
+  object_after MEDIUMTEXT,
+  changes TEXT,
+  error TEXT,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY record_id (record_id),
+  KEY object_idx (tenant, object_type, object_id),
+  KEY record_time_idx (record_time)
+);
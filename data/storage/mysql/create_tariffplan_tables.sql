@@ -261,6 +261,7 @@ CREATE TABLE tp_resources (
   `stored` BOOLEAN NOT NULL,
   `weight` decimal(8,2) NOT NULL,
   `threshold_ids` varchar(64) NOT NULL,
+  `weights` varchar(64) NOT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -312,6 +313,7 @@ CREATE TABLE tp_stats (
   `blocker` BOOLEAN NOT NULL,
   `weight` decimal(8,2) NOT NULL,
   `threshold_ids` varchar(64) NOT NULL,
+  `weights` varchar(64) NOT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -389,6 +391,7 @@ CREATE TABLE tp_thresholds (
   `action_ids` varchar(64) NOT NULL,
   `async` BOOLEAN NOT NULL,
   `ee_ids` varchar(64) NOT NULL,
+  `weights` varchar(64) NOT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -441,6 +444,8 @@ CREATE TABLE tp_routes (
   `route_blocker` BOOLEAN NOT NULL,
   `route_parameters` varchar(64) NOT NULL,
   `weight` decimal(8,2) NOT NULL,
+  `route_weights` varchar(64) NOT NULL,
+  `weights` varchar(64) NOT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -468,6 +473,7 @@ CREATE TABLE tp_attributes (
   `value` varchar(64) NOT NULL,
   `blocker` BOOLEAN NOT NULL,
   `weight` decimal(8,2) NOT NULL,
+  `weights` varchar(64) NOT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -490,6 +496,7 @@ CREATE TABLE tp_chargers (
   `run_id` varchar(64) NOT NULL,
   `attribute_ids` varchar(64) NOT NULL,
   `weight` decimal(8,2) NOT NULL,
+  `weights` varchar(64) NOT NULL,
   `created_at` TIMESTAMP,
   PRIMARY KEY (`pk`),
   KEY `tpid` (`tpid`),
@@ -553,6 +560,24 @@ CREATE TABLE tp_dispatcher_hosts (
     `id`,`address`)
 );
 
+--
+-- Table structure for table `tp_lookup_tables`
+--
+
+DROP TABLE IF EXISTS tp_lookup_tables;
+CREATE TABLE tp_lookup_tables (
+  `pk` int(11) NOT NULL AUTO_INCREMENT,
+  `tpid` varchar(64) NOT NULL,
+  `tenant` varchar(64) NOT NULL,
+  `id` varchar(64) NOT NULL,
+  `key` varchar(128) NOT NULL,
+  `value` varchar(256) NOT NULL,
+  `created_at` TIMESTAMP,
+  PRIMARY KEY (`pk`),
+  KEY `tpid` (`tpid`),
+  UNIQUE KEY `unique_tp_lookup_tables` (`tpid`,`tenant`,`id`,`key`)
+);
+
 --
 -- Table structure for table `versions`
 --
@@ -43,6 +43,7 @@ CREATE TABLE session_costs (
   origin_host VARCHAR(64) NOT NULL,
   origin_id VARCHAR(128) NOT NULL,
   cost_source VARCHAR(64) NOT NULL,
+  account VARCHAR(128) NOT NULL DEFAULT '',
   usage BIGINT NOT NULL,
   cost_details jsonb,
   created_at TIMESTAMP WITH TIME ZONE,
@@ -57,3 +58,27 @@ DROP INDEX IF EXISTS run_origin_sessionscost_idx;
 CREATE INDEX run_origin_sessionscost_idx ON session_costs (run_id, origin_id);
 DROP INDEX IF EXISTS deleted_at_sessionscost_idx;
 CREATE INDEX deleted_at_sessionscost_idx ON session_costs (deleted_at);
+DROP INDEX IF EXISTS account_sessionscost_idx;
+CREATE INDEX account_sessionscost_idx ON session_costs (account);
+
+DROP TABLE IF EXISTS audit_records;
+CREATE TABLE audit_records (
+  id SERIAL PRIMARY KEY,
+  record_id VARCHAR(40) NOT NULL,
+  record_time TIMESTAMP WITH TIME ZONE NOT NULL,
+  address VARCHAR(64) NOT NULL,
+  api_key VARCHAR(128) NOT NULL,
+  method VARCHAR(128) NOT NULL,
+  tenant VARCHAR(64) NOT NULL,
+  object_type VARCHAR(64) NOT NULL,
+  object_id VARCHAR(256) NOT NULL,
+  object_before TEXT,
+  object_after TEXT,
+  changes TEXT,
+  error TEXT,
+  UNIQUE (record_id)
+);
+DROP INDEX IF EXISTS object_auditrecords_idx;
+CREATE INDEX object_auditrecords_idx ON audit_records (tenant, object_type, object_id);
+DROP INDEX IF EXISTS time_auditrecords_idx;
+CREATE INDEX time_auditrecords_idx ON audit_records (record_time);
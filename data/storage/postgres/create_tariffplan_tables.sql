@@ -257,6 +257,7 @@ CREATE TABLE tp_resources (
   "stored" BOOLEAN NOT NULL,
   "weight" NUMERIC(8,2) NOT NULL,
   "threshold_ids" varchar(64) NOT NULL,
+  "weights" varchar(64) NOT NULL,
   "created_at" TIMESTAMP WITH TIME ZONE
 );
 CREATE INDEX tp_resources_idx ON tp_resources (tpid);
@@ -308,6 +309,7 @@ CREATE TABLE tp_stats (
   "blocker" BOOLEAN NOT NULL,
   "weight" decimal(8,2) NOT NULL,
   "threshold_ids" varchar(64) NOT NULL,
+  "weights" varchar(64) NOT NULL,
   "created_at" TIMESTAMP WITH TIME ZONE
 );
 CREATE INDEX tp_stats_idx ON tp_stats (tpid);
@@ -381,6 +383,7 @@ CREATE TABLE tp_thresholds (
   "action_ids" varchar(64) NOT NULL,
   "async" BOOLEAN NOT NULL,
   "ee_ids" varchar(64) NOT NULL,
+  "weights" varchar(64) NOT NULL,
   "created_at" TIMESTAMP WITH TIME ZONE
 );
 CREATE INDEX tp_thresholds_idx ON tp_thresholds (tpid);
@@ -429,6 +432,8 @@ CREATE TABLE tp_routes (
   "route_blocker" BOOLEAN NOT NULL,
   "route_parameters" varchar(64) NOT NULL,
   "weight" decimal(8,2) NOT NULL,
+  "route_weights" varchar(64) NOT NULL,
+  "weights" varchar(64) NOT NULL,
   "created_at" TIMESTAMP WITH TIME ZONE
 );
 CREATE INDEX tp_routes_idx ON tp_routes (tpid);
@@ -455,6 +460,7 @@ CREATE INDEX tp_routes_unique ON tp_routes  ("tpid",  "tenant", "id",
     "value" varchar(64) NOT NULL,
     "blocker" BOOLEAN NOT NULL,
     "weight" decimal(8,2) NOT NULL,
+    "weights" varchar(64) NOT NULL,
     "created_at" TIMESTAMP WITH TIME ZONE
   );
   CREATE INDEX tp_attributes_ids ON tp_attributes (tpid);
@@ -476,6 +482,7 @@ CREATE INDEX tp_routes_unique ON tp_routes  ("tpid",  "tenant", "id",
     "run_id" varchar(64) NOT NULL,
     "attribute_ids" varchar(64) NOT NULL,
     "weight" decimal(8,2) NOT NULL,
+    "weights" varchar(64) NOT NULL,
     "created_at" TIMESTAMP WITH TIME ZONE
   );
   CREATE INDEX tp_chargers_ids ON tp_chargers (tpid);
@@ -536,6 +543,23 @@ CREATE INDEX tp_routes_unique ON tp_routes  ("tpid",  "tenant", "id",
   CREATE INDEX tp_dispatcher_hosts_unique ON tp_dispatcher_hosts  ("tpid",  "tenant", "id",
     "address");
 
+--
+-- Table structure for table `tp_lookup_tables`
+--
+
+DROP TABLE IF EXISTS tp_lookup_tables;
+CREATE TABLE tp_lookup_tables (
+  "pk" SERIAL PRIMARY KEY,
+  "tpid" varchar(64) NOT NULL,
+  "tenant" varchar(64) NOT NULL,
+  "id" varchar(64) NOT NULL,
+  "key" varchar(128) NOT NULL,
+  "value" varchar(256) NOT NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX tp_lookup_tables_ids ON tp_lookup_tables (tpid);
+CREATE UNIQUE INDEX tp_lookup_tables_unique ON tp_lookup_tables ("tpid", "tenant", "id", "key");
+
 
 
 --
@@ -165,6 +165,42 @@ func (dS *DispatcherService) CDRsV1ProcessCDR(ctx *context.Context, args *engine
 	}, utils.MetaCDRs, utils.CDRsV1ProcessCDR, args, reply)
 }
 
+// CDRsV1PurgeCDRs applies the CDR retention policies on demand
+func (dS *DispatcherService) CDRsV1PurgeCDRs(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *engine.CDRPurgeReport) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.Tenant != utils.EmptyString {
+		tnt = args.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.CDRsV1PurgeCDRs, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		APIOpts: args.APIOpts,
+	}, utils.MetaCDRs, utils.CDRsV1PurgeCDRs, args, reply)
+}
+
+// CDRsV1EraseSubscriber removes or pseudonymises all the data kept for one subscriber
+func (dS *DispatcherService) CDRsV1EraseSubscriber(ctx *context.Context, args *utils.ArgsEraseSubscriber, reply *engine.SubscriberErasure) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.Tenant != utils.EmptyString {
+		tnt = args.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.CDRsV1EraseSubscriber, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		APIOpts: args.APIOpts,
+	}, utils.MetaCDRs, utils.CDRsV1EraseSubscriber, args, reply)
+}
+
 func (dS *DispatcherService) CDRsV2ProcessEvent(ctx *context.Context, args *engine.ArgV1ProcessEvent, reply *[]*utils.EventWithFlags) (err error) {
 	tnt := args.Tenant
 	if tnt == utils.EmptyString {
@@ -91,6 +91,95 @@ func (dS *DispatcherService) ReplicatorSv1GetReverseDestination(ctx *context.Con
 	}, utils.MetaReplicator, utils.ReplicatorSv1GetReverseDestination, args, rpl)
 }
 
+func (dS *DispatcherService) ReplicatorSv1GetPortedNumber(ctx *context.Context, args *utils.StringWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = new(utils.StringWithAPIOpts)
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetPortedNumber, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetPortedNumber, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1GetLookupTable(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.LookupTable) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetLookupTable, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetLookupTable, args, reply)
+}
+
+func (dS *DispatcherService) ReplicatorSv1GetDiscountProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.DiscountProfile) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetDiscountProfile, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetDiscountProfile, args, reply)
+}
+
+func (dS *DispatcherService) ReplicatorSv1GetFraudProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.FraudProfile) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetFraudProfile, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetFraudProfile, args, reply)
+}
+
+func (dS *DispatcherService) ReplicatorSv1GetFraudCase(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.FraudCase) (err error) {
+	tnt := dS.cfg.GeneralCfg().DefaultTenant
+	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
+		tnt = args.TenantID.Tenant
+	}
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1GetFraudCase, tnt,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  tnt,
+		ID:      args.ID,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1GetFraudCase, args, reply)
+}
+
 func (dS *DispatcherService) ReplicatorSv1GetStatQueue(ctx *context.Context, args *utils.TenantIDWithAPIOpts, reply *engine.StatQueue) (err error) {
 	tnt := dS.cfg.GeneralCfg().DefaultTenant
 	if args.TenantID != nil && args.TenantID.Tenant != utils.EmptyString {
@@ -568,6 +657,116 @@ func (dS *DispatcherService) ReplicatorSv1SetDestination(ctx *context.Context, a
 	}, utils.MetaReplicator, utils.ReplicatorSv1SetDestination, args, rpl)
 }
 
+func (dS *DispatcherService) ReplicatorSv1SetPortedNumber(ctx *context.Context, args *engine.PortedNumberWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.PortedNumberWithAPIOpts{}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetPortedNumber, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetPortedNumber, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetPortedNumbers(ctx *context.Context, args *engine.PortedNumbersWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.PortedNumbersWithAPIOpts{}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetPortedNumbers, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetPortedNumbers, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetLookupTable(ctx *context.Context, args *engine.LookupTableWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.LookupTableWithAPIOpts{
+			LookupTable: &engine.LookupTable{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetLookupTable, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetLookupTable, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetDiscountProfile(ctx *context.Context, args *engine.DiscountProfileWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.DiscountProfileWithAPIOpts{
+			DiscountProfile: &engine.DiscountProfile{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetDiscountProfile, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetDiscountProfile, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetFraudProfile(ctx *context.Context, args *engine.FraudProfileWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.FraudProfileWithAPIOpts{
+			FraudProfile: &engine.FraudProfile{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetFraudProfile, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetFraudProfile, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1SetFraudCase(ctx *context.Context, args *engine.FraudCaseWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &engine.FraudCaseWithAPIOpts{
+			FraudCase: &engine.FraudCase{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1SetFraudCase, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1SetFraudCase, args, rpl)
+}
+
 func (dS *DispatcherService) ReplicatorSv1SetAccount(ctx *context.Context, args *engine.AccountWithAPIOpts, rpl *string) (err error) {
 	if args == nil {
 		args = &engine.AccountWithAPIOpts{
@@ -1004,6 +1203,99 @@ func (dS *DispatcherService) ReplicatorSv1RemoveDestination(ctx *context.Context
 	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveDestination, args, rpl)
 }
 
+func (dS *DispatcherService) ReplicatorSv1RemovePortedNumber(ctx *context.Context, args *utils.StringWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = new(utils.StringWithAPIOpts)
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemovePortedNumber, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemovePortedNumber, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1RemoveLookupTable(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveLookupTable, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveLookupTable, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1RemoveDiscountProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveDiscountProfile, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveDiscountProfile, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1RemoveFraudProfile(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveFraudProfile, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveFraudProfile, args, rpl)
+}
+
+func (dS *DispatcherService) ReplicatorSv1RemoveFraudCase(ctx *context.Context, args *utils.TenantIDWithAPIOpts, rpl *string) (err error) {
+	if args == nil {
+		args = &utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{},
+		}
+	}
+	args.Tenant = utils.FirstNonEmpty(args.Tenant, dS.cfg.GeneralCfg().DefaultTenant)
+	if len(dS.cfg.DispatcherSCfg().AttributeSConns) != 0 {
+		if err = dS.authorize(utils.ReplicatorSv1RemoveFraudCase, args.Tenant,
+			utils.IfaceAsString(args.APIOpts[utils.OptsAPIKey]), utils.TimePointer(time.Now())); err != nil {
+			return
+		}
+	}
+	return dS.Dispatch(&utils.CGREvent{
+		Tenant:  args.Tenant,
+		APIOpts: args.APIOpts,
+	}, utils.MetaReplicator, utils.ReplicatorSv1RemoveFraudCase, args, rpl)
+}
+
 func (dS *DispatcherService) ReplicatorSv1SetLoadIDs(ctx *context.Context, args *utils.LoadIDsWithAPIOpts, rpl *string) (err error) {
 	if args == nil {
 		args = &utils.LoadIDsWithAPIOpts{}
@@ -54,6 +54,7 @@ type Account struct {
 	AllowNegative     bool
 	Disabled          bool
 	UpdateTime        time.Time
+	Discounts         map[string]*DiscountUsage `json:",omitempty"` // usage of the DiscountProfiles, indexed on their ID
 	executingTriggers bool
 }
 
@@ -279,7 +280,7 @@ func (acc *Account) debitBalanceAction(a *Action, reset, resetIfNegative bool, f
 			continue // just to be safe (cleaned expired balances above)
 		}
 		b.account = acc
-		if b.MatchFilter(a.Balance, "", false, false) {
+		if matchBalanceAction(b, a.Balance, false) {
 			if reset || (resetIfNegative && b.Value < 0) {
 				b.SetValue(0)
 			}
@@ -1043,6 +1044,12 @@ func (acc *Account) Clone() *Account {
 			newAcc.ActionTriggers[key] = actionTrigger.Clone()
 		}
 	}
+	if acc.Discounts != nil {
+		newAcc.Discounts = make(map[string]*DiscountUsage, len(acc.Discounts))
+		for key, du := range acc.Discounts {
+			newAcc.Discounts[key] = du.Clone()
+		}
+	}
 	return newAcc
 }
 
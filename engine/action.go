@@ -166,6 +166,7 @@ var accountActions = map[string]bool{
 	utils.MetaDenyNegative:            true,
 	utils.MetaResetAccount:            true,
 	utils.MetaTopUpReset:              true,
+	utils.MetaRollover:                true,
 	utils.MetaDebitReset:              true,
 	utils.MetaTransferBalance:         true,
 	utils.MetaResetCounters:           true,
@@ -191,6 +192,7 @@ func init() {
 	actionFuncMap[utils.MetaResetAccount] = resetAccountAction
 	actionFuncMap[utils.MetaTopUpReset] = topupResetAction
 	actionFuncMap[utils.MetaTopUp] = topupAction
+	actionFuncMap[utils.MetaRollover] = rolloverAction
 	actionFuncMap[utils.MetaDebitReset] = debitResetAction
 	actionFuncMap[utils.MetaDebit] = debitAction
 	actionFuncMap[utils.MetaTransferBalance] = transferBalanceAction
@@ -616,6 +618,127 @@ func topupAction(ub *Account, a *Action, _ Actions, fltrS *FilterS, _ any, _ Sha
 	return
 }
 
+// rolloverBalanceIDSep separates the source balance ID from the rollover date within the rolled balances IDs
+const rolloverBalanceIDSep = "_rollover_"
+
+// isRolledBalance returns true for the balances created by *rollover
+func isRolledBalance(b *Balance) bool {
+	return b.RolledFrom != utils.EmptyString
+}
+
+// matchBalanceAction checks if the action filter selects b. The rolled balances share
+// the fields of their source so they are selected only by their ID or UUID, leaving
+// the carried units alone when the source balances are reset or topped up by filter
+func matchBalanceAction(b *Balance, bf *BalanceFilter, skipExpiry bool) bool {
+	if isRolledBalance(b) &&
+		bf.GetID() == utils.EmptyString && bf.GetUuid() == utils.EmptyString {
+		return false
+	}
+	return b.MatchFilter(bf, utils.EmptyString, false, skipExpiry)
+}
+
+// rolloverAction carries the unused units of the balances matching the filter into new balances,
+// consumed before the source ones and expiring after the configured number of periods.
+// The parameters are obtained from Action's ExtraParameters:
+// {"Cap":0,"Periods":1,"Period":"*monthly","Weight":null}, where Cap limits the rolled units
+// available at once for one source balance (0 for unlimited), Periods with Period give the expiry of the rolled balance
+// (0 periods for no expiry) and Weight defaults to the source balance weight plus one.
+// It needs to run before the action resetting the source balances (ie: *topup_reset).
+func rolloverAction(ub *Account, a *Action, _ Actions, _ *FilterS, _ any, _ SharedActionsData, _ ActionConnCfg) (err error) {
+	if ub == nil {
+		return errors.New("nil account")
+	}
+	if a.Balance == nil || a.Balance.Type == nil {
+		return errors.New("balance type is missing")
+	}
+	params := struct {
+		Cap     float64
+		Periods int
+		Period  string
+		Weight  *float64
+	}{
+		Periods: 1,
+		Period:  utils.MetaMonthly,
+	}
+	if a.ExtraParameters != utils.EmptyString {
+		if err = json.Unmarshal([]byte(a.ExtraParameters), &params); err != nil {
+			return
+		}
+	}
+	now := time.Now()
+	var expDate time.Time
+	if params.Periods > 0 {
+		if expDate, err = addRolloverPeriods(now, params.Period, params.Periods); err != nil {
+			return
+		}
+	}
+	balType := a.Balance.GetType()
+	var rolled Balances
+	for _, b := range ub.BalanceMap[balType] {
+		if isRolledBalance(b) || // rolled units are not carried again
+			b.Disabled || b.IsExpiredAt(now) || b.GetValue() <= 0 ||
+			!b.MatchFilter(a.Balance, utils.EmptyString, false, true) {
+			continue
+		}
+		srcID := b.ID
+		if srcID == utils.EmptyString {
+			srcID = b.Uuid
+		}
+		value := b.GetValue()
+		if params.Cap > 0 {
+			var carried float64 // units still available from the previous rollovers
+			for _, rb := range ub.BalanceMap[balType] {
+				if rb.RolledFrom == srcID && !rb.IsExpiredAt(now) {
+					carried += rb.GetValue()
+				}
+			}
+			if value = min(value, params.Cap-carried); value <= 0 {
+				continue
+			}
+		}
+		rb := b.Clone()
+		rb.Uuid = utils.GenUUID()
+		rb.ID = srcID + rolloverBalanceIDSep + now.Format("20060102150405")
+		rb.RolledFrom = srcID
+		rb.ExpirationDate = expDate
+		rb.Weight = b.Weight + 1
+		if params.Weight != nil {
+			rb.Weight = *params.Weight
+		}
+		rb.SharedGroups = nil // the rolled units belong only to this account
+		rb.Blocker = false
+		rb.Categories = b.Categories.Clone()
+		rb.TimingIDs = b.TimingIDs.Clone()
+		rb.SetValue(value)
+		rolled = append(rolled, rb)
+	}
+	if len(rolled) != 0 {
+		ub.BalanceMap[balType] = append(ub.BalanceMap[balType], rolled...)
+	}
+	return
+}
+
+// addRolloverPeriods adds to t the number of periods, each one being *daily, *monthly,
+// *yearly or a duration
+func addRolloverPeriods(t time.Time, period string, periods int) (time.Time, error) {
+	switch period {
+	case utils.MetaDaily:
+		return t.AddDate(0, 0, periods), nil
+	case utils.MetaMonthly:
+		return t.AddDate(0, periods, 0), nil
+	case utils.MetaYearly:
+		return t.AddDate(periods, 0, 0), nil
+	}
+	dur, err := utils.ParseDurationWithNanosecs(period)
+	if err != nil {
+		return t, err
+	}
+	if dur <= 0 {
+		return t, fmt.Errorf("invalid rollover period: %q", period)
+	}
+	return t.Add(time.Duration(periods) * dur), nil
+}
+
 func debitResetAction(ub *Account, a *Action, _ Actions, fltrS *FilterS, _ any, _ SharedActionsData, _ ActionConnCfg) (err error) {
 	if ub == nil {
 		return errors.New("nil account")
@@ -1138,7 +1261,7 @@ func setExpiryAction(ub *Account, a *Action, _ Actions, _ *FilterS, _ any, _ Sha
 	}
 	balanceType := a.Balance.GetType()
 	for _, b := range ub.BalanceMap[balanceType] {
-		if b.MatchFilter(a.Balance, "", false, true) {
+		if matchBalanceAction(b, a.Balance, true) {
 			b.ExpirationDate = a.Balance.GetExpirationDate()
 		}
 	}
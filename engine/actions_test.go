@@ -8125,3 +8125,205 @@ func TestSetBalanceAction(t *testing.T) {
 		})
 	}
 }
+
+func TestActionRollover(t *testing.T) {
+	ub := &Account{
+		ID: "cgrates.org:rollover",
+		BalanceMap: map[string]Balances{
+			utils.MetaVoice: {
+				&Balance{Uuid: "voice_uuid", ID: "VOICE", Value: float64(30 * time.Second), Weight: 10,
+					DestinationIDs: utils.StringMap{"NAT": true}, RatingSubject: "*zero1s"},
+			},
+			utils.MetaMonetary: {&Balance{Uuid: "mon_uuid", Value: 21}},
+		},
+	}
+	acts := Actions{
+		&Action{
+			ActionType:      utils.MetaRollover,
+			ExtraParameters: `{"Cap":20000000000,"Periods":2}`,
+			Balance: &BalanceFilter{
+				Type: utils.StringPointer(utils.MetaVoice),
+				ID:   utils.StringPointer("VOICE"),
+			},
+		},
+		&Action{
+			ActionType: utils.MetaTopUpReset,
+			Balance: &BalanceFilter{
+				Type:  utils.StringPointer(utils.MetaVoice),
+				ID:    utils.StringPointer("VOICE"),
+				Value: &utils.ValueFormula{Static: float64(60 * time.Second)},
+			},
+		},
+	}
+	execActs := func() {
+		t.Helper()
+		if err := rolloverAction(ub, acts[0], acts, nil, nil, SharedActionsData{}, ActionConnCfg{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := topupResetAction(ub, acts[1], acts, nil, nil, SharedActionsData{}, ActionConnCfg{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	execActs()
+	if len(ub.BalanceMap[utils.MetaVoice]) != 2 {
+		t.Fatalf("expected a rolled balance, received: %s", utils.ToJSON(ub.BalanceMap[utils.MetaVoice]))
+	}
+	rb := ub.BalanceMap[utils.MetaVoice][1]
+	if !strings.HasPrefix(rb.ID, "VOICE"+rolloverBalanceIDSep) || rb.RolledFrom != "VOICE" ||
+		rb.Value != float64(20*time.Second) || rb.Weight != 11 ||
+		rb.RatingSubject != "*zero1s" || !rb.DestinationIDs["NAT"] {
+		t.Errorf("unexpected rolled balance: %s", utils.ToJSON(rb))
+	}
+	if expDate := time.Now().AddDate(0, 2, 0); rb.ExpirationDate.After(expDate) ||
+		rb.ExpirationDate.Before(expDate.Add(-time.Minute)) {
+		t.Errorf("expected expiration around %v, received: %v", expDate, rb.ExpirationDate)
+	}
+	if val := ub.BalanceMap[utils.MetaVoice][0].Value; val != float64(60*time.Second) {
+		t.Errorf("expected source balance reset to 60s, received: %v", val)
+	}
+
+	// the rolled units are consumed first and show up in the cost
+	cd := &CallDescriptor{
+		TimeStart:   time.Date(2013, 9, 24, 10, 48, 0, 0, time.UTC),
+		TimeEnd:     time.Date(2013, 9, 24, 10, 48, 10, 0, time.UTC),
+		Destination: "0723045326",
+		Category:    "0",
+		ToR:         utils.MetaVoice,
+		testCallcost: &CallCost{
+			Destination: "0723045326",
+			ToR:         utils.MetaVoice,
+			Timespans: []*TimeSpan{{
+				TimeStart: time.Date(2013, 9, 24, 10, 48, 0, 0, time.UTC),
+				TimeEnd:   time.Date(2013, 9, 24, 10, 48, 10, 0, time.UTC),
+				RateInterval: &RateInterval{Rating: &RIRate{
+					Rates: RateGroups{&RGRate{Value: 100,
+						RateIncrement: 10 * time.Second, RateUnit: time.Second}}}},
+			}},
+		},
+	}
+	cc, err := ub.debitCreditBalance(cd, false, false, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uuid := cc.Timespans[0].Increments[0].BalanceInfo.Unit.UUID; uuid != rb.Uuid {
+		t.Errorf("expected debit from the rolled balance %q, received: %q", rb.Uuid, uuid)
+	}
+	if rb.Value != float64(10*time.Second) {
+		t.Errorf("expected 10s left on the rolled balance, received: %v", rb.Value)
+	}
+
+	// the cap counts the units still available from the previous rollover
+	ub.BalanceMap[utils.MetaVoice][1].ID = "VOICE" + rolloverBalanceIDSep + "20130924"
+	execActs()
+	if len(ub.BalanceMap[utils.MetaVoice]) != 3 ||
+		ub.BalanceMap[utils.MetaVoice][2].Value != float64(10*time.Second) {
+		t.Errorf("expected a rolled balance of 10s, received: %s", utils.ToJSON(ub.BalanceMap[utils.MetaVoice]))
+	}
+	execActs()
+	if len(ub.BalanceMap[utils.MetaVoice]) != 3 {
+		t.Errorf("expected no rollover over the cap, received: %s", utils.ToJSON(ub.BalanceMap[utils.MetaVoice]))
+	}
+}
+
+func TestActionRolloverResetByFilter(t *testing.T) {
+	ub := &Account{
+		ID: "cgrates.org:rollover",
+		BalanceMap: map[string]Balances{
+			utils.MetaVoice: {
+				&Balance{Uuid: "voice_uuid", ID: "VOICE", Value: float64(30 * time.Second), Weight: 10,
+					DestinationIDs: utils.StringMap{"NAT": true}},
+			},
+		},
+	}
+	fltr := &BalanceFilter{
+		Type:           utils.StringPointer(utils.MetaVoice),
+		DestinationIDs: utils.StringMapPointer(utils.StringMap{"NAT": true}),
+	}
+	rollover := &Action{ActionType: utils.MetaRollover, Balance: fltr}
+	reset := &Action{
+		ActionType: utils.MetaTopUpReset,
+		Balance: &BalanceFilter{
+			Type:           fltr.Type,
+			DestinationIDs: fltr.DestinationIDs,
+			Value:          &utils.ValueFormula{Static: float64(60 * time.Second)},
+		},
+	}
+	if err := rolloverAction(ub, rollover, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := topupResetAction(ub, reset, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err != nil {
+		t.Fatal(err)
+	}
+	blcs := ub.BalanceMap[utils.MetaVoice]
+	if len(blcs) != 2 {
+		t.Fatalf("expected a rolled balance, received: %s", utils.ToJSON(blcs))
+	}
+	if blcs[0].Value != float64(60*time.Second) {
+		t.Errorf("expected source balance reset to 60s, received: %v", blcs[0].Value)
+	}
+	if blcs[1].Value != float64(30*time.Second) {
+		t.Errorf("expected the rolled balance untouched, received: %v", blcs[1].Value)
+	}
+	// the rolled balance is still reachable by its ID
+	byID := &Action{
+		ActionType: utils.MetaTopUpReset,
+		Balance: &BalanceFilter{
+			Type:  fltr.Type,
+			ID:    utils.StringPointer(blcs[1].ID),
+			Value: &utils.ValueFormula{Static: float64(10 * time.Second)},
+		},
+	}
+	if err := topupResetAction(ub, byID, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err != nil {
+		t.Fatal(err)
+	}
+	if blcs[0].Value != float64(60*time.Second) || blcs[1].Value != float64(10*time.Second) {
+		t.Errorf("unexpected balances: %s", utils.ToJSON(blcs))
+	}
+}
+
+func TestActionRolloverUserBalanceID(t *testing.T) {
+	// the rolled balances are not recognised by their ID
+	ub := &Account{
+		ID: "cgrates.org:rollover",
+		BalanceMap: map[string]Balances{
+			utils.MetaVoice: {
+				&Balance{Uuid: "voice_uuid", ID: "VOICE" + rolloverBalanceIDSep + "PROMO",
+					Value: float64(30 * time.Second), DestinationIDs: utils.StringMap{"NAT": true}},
+			},
+		},
+	}
+	reset := &Action{
+		ActionType: utils.MetaTopUpReset,
+		Balance: &BalanceFilter{
+			Type:           utils.StringPointer(utils.MetaVoice),
+			DestinationIDs: utils.StringMapPointer(utils.StringMap{"NAT": true}),
+			Value:          &utils.ValueFormula{Static: float64(60 * time.Second)},
+		},
+	}
+	if err := topupResetAction(ub, reset, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err != nil {
+		t.Fatal(err)
+	}
+	if blcs := ub.BalanceMap[utils.MetaVoice]; len(blcs) != 1 || blcs[0].Value != float64(60*time.Second) {
+		t.Errorf("expected the balance reset to 60s, received: %s", utils.ToJSON(blcs))
+	}
+}
+
+func TestActionRolloverErrors(t *testing.T) {
+	if err := rolloverAction(nil, &Action{}, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err == nil ||
+		err.Error() != "nil account" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	ub := &Account{ID: "cgrates.org:rollover"}
+	if err := rolloverAction(ub, &Action{}, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err == nil ||
+		err.Error() != "balance type is missing" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	a := &Action{
+		ExtraParameters: `{"Period":"-1h"}`,
+		Balance:         &BalanceFilter{Type: utils.StringPointer(utils.MetaVoice)},
+	}
+	if err := rolloverAction(ub, a, nil, nil, nil, SharedActionsData{}, ActionConnCfg{}); err == nil ||
+		err.Error() != `invalid rollover period: "-1h"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
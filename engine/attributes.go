@@ -58,7 +58,10 @@ func (alS *AttributeService) Shutdown() {
 // attributeProfileForEvent returns the matching attribute
 func (alS *AttributeService) attributeProfileForEvent(tnt string, ctx *string, attrsIDs []string, actTime *time.Time, evNm utils.MapStorage,
 	lastID string, processedPrfNo map[string]int, profileRuns int, ignoreFilters bool) (matchAttrPrfl *AttributeProfile, err error) {
+	opts, _ := evNm[utils.MetaOpts].(map[string]any)
+	dm := alS.dm.withAPIOpts(opts)
 	var attrIDs []string
+	var matchWeight float64
 	contextVal := utils.MetaDefault
 	if ctx != nil && *ctx != "" {
 		contextVal = *ctx
@@ -73,7 +76,7 @@ func (alS *AttributeService) attributeProfileForEvent(tnt string, ctx *string, a
 			alS.cgrcfg.AttributeSCfg().PrefixIndexedFields,
 			alS.cgrcfg.AttributeSCfg().SuffixIndexedFields,
 			alS.cgrcfg.AttributeSCfg().ExistsIndexedFields,
-			alS.dm, utils.CacheAttributeFilterIndexes, attrIdxKey,
+			dm, utils.CacheAttributeFilterIndexes, attrIdxKey,
 			alS.cgrcfg.AttributeSCfg().IndexedSelects,
 			alS.cgrcfg.AttributeSCfg().NestedFields,
 		)
@@ -88,7 +91,7 @@ func (alS *AttributeService) attributeProfileForEvent(tnt string, ctx *string, a
 				alS.cgrcfg.AttributeSCfg().PrefixIndexedFields,
 				alS.cgrcfg.AttributeSCfg().SuffixIndexedFields,
 				alS.cgrcfg.AttributeSCfg().ExistsIndexedFields,
-				alS.dm, utils.CacheAttributeFilterIndexes,
+				dm, utils.CacheAttributeFilterIndexes,
 				utils.ConcatenatedKey(tnt, utils.MetaAny),
 				alS.cgrcfg.AttributeSCfg().IndexedSelects,
 				alS.cgrcfg.AttributeSCfg().NestedFields)
@@ -104,7 +107,7 @@ func (alS *AttributeService) attributeProfileForEvent(tnt string, ctx *string, a
 		attrIDs = aPrflIDs.AsSlice()
 	}
 	for _, apID := range attrIDs {
-		aPrfl, err := alS.dm.GetAttributeProfile(tnt, apID, true, true, utils.NonTransactional)
+		aPrfl, err := dm.GetAttributeProfile(tnt, apID, true, true, utils.NonTransactional)
 		if err != nil {
 			if err == utils.ErrNotFound {
 				continue
@@ -129,10 +132,15 @@ func (alS *AttributeService) attributeProfileForEvent(tnt string, ctx *string, a
 				continue
 			}
 		}
-		if (matchAttrPrfl == nil || matchAttrPrfl.Weight < aPrfl.Weight) &&
+		weight, err := weightForEvent(aPrfl.Weights, aPrfl.Weight, alS.filterS, tnt, evNm)
+		if err != nil {
+			return nil, err
+		}
+		if (matchAttrPrfl == nil || matchWeight < weight) &&
 			tntID != lastID &&
 			(profileRuns <= 0 || processedPrfNo[tntID] < profileRuns) {
 			matchAttrPrfl = aPrfl
+			matchWeight = weight
 		}
 	}
 	// All good, convert from Map to Slice so we can sort
@@ -471,6 +479,14 @@ func ParseAttribute(dp utils.DataProvider, attrType, path string, value config.R
 			return
 		}
 		out = dtFld.Format(layout)
+	case utils.MetaLookup:
+		out, err = lookupValue(dp, value)
+	case utils.MetaPortedNumber: // prefix the number with its routing number if ported
+		var val string
+		if val, err = value.ParseDataProvider(dp); err != nil {
+			return
+		}
+		out, err = PortedNumberRoute(val)
 	case utils.MetaPrefix:
 		var pathRsr config.RSRParsers
 		pathRsr, err = config.NewRSRParsers(path, rsrSep)
@@ -0,0 +1,415 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// AuditRecord is the trace of one data changing API call
+type AuditRecord struct {
+	ID         string
+	Time       time.Time
+	Address    string // remote address of the caller
+	APIKey     string
+	Method     string
+	Tenant     string
+	ObjectType string
+	ObjectID   string
+	Before     string   // object as JSON before the call, empty if missing
+	After      string   // object as JSON after the call, empty if missing
+	Changes    []string // paths of the fields changed by the call
+	Error      string   // returned by the call
+}
+
+// AsCGREvent converts the AuditRecord for the export
+func (ar *AuditRecord) AsCGREvent() *utils.CGREvent {
+	return &utils.CGREvent{
+		Tenant: ar.Tenant,
+		ID:     ar.ID,
+		Time:   utils.TimePointer(ar.Time),
+		Event: map[string]any{
+			utils.EventType:  utils.AuditRecord,
+			utils.Address:    ar.Address,
+			utils.APIKey:     ar.APIKey,
+			utils.Method:     ar.Method,
+			utils.ObjectType: ar.ObjectType,
+			utils.ObjectID:   ar.ObjectID,
+			utils.Before:     ar.Before,
+			utils.After:      ar.After,
+			utils.Changes:    strings.Join(ar.Changes, utils.InfieldSep),
+			utils.Error:      ar.Error,
+		},
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.AuditRecord,
+		},
+	}
+}
+
+// passFilter returns if the AuditRecord is selected by the filter
+func (ar *AuditRecord) passFilter(fltr *utils.AuditRecordFilter) bool {
+	for _, fld := range []struct {
+		val  string
+		vals []string
+	}{
+		{ar.Tenant, fltr.Tenants},
+		{ar.ObjectType, fltr.ObjectTypes},
+		{ar.ObjectID, fltr.ObjectIDs},
+		{ar.APIKey, fltr.APIKeys},
+		{ar.Address, fltr.Addresses},
+		{ar.Method, fltr.Methods},
+	} {
+		if len(fld.vals) != 0 && !slices.Contains(fld.vals, fld.val) {
+			return false
+		}
+	}
+	if fltr.Time.Begin != nil && ar.Time.Before(*fltr.Time.Begin) {
+		return false
+	}
+	return fltr.Time.End == nil || ar.Time.Before(*fltr.Time.End)
+}
+
+// auditObjectAliases are the object types changed by the API methods not named after them
+var auditObjectAliases = map[string]string{
+	utils.BalanceField:      utils.AccountField,
+	"Balances":              utils.AccountField,
+	"AccountActionTriggers": utils.AccountField,
+}
+
+// auditObjectGetters read from DataDB the objects changed by the audited API calls
+var auditObjectGetters = map[string]func(dm *DataManager, tnt, id string) (any, error){
+	"AttributeProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetAttributeProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"ChargerProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetChargerProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	utils.Filter: func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetFilter(tnt, id, false, false, utils.NonTransactional)
+	},
+	"ResourceProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetResourceProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"IPProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetIPProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"StatQueueProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetStatQueueProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"ThresholdProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetThresholdProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"TrendProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetTrendProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"RankingProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetRankingProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"RouteProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetRouteProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"DispatcherProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetDispatcherProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"DispatcherHost": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetDispatcherHost(tnt, id, false, false, utils.NonTransactional)
+	},
+	"LookupTable": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetLookupTable(tnt, id, false, false, utils.NonTransactional)
+	},
+	"DiscountProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetDiscountProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"FraudProfile": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetFraudProfile(tnt, id, false, false, utils.NonTransactional)
+	},
+	"FraudCase": func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetFraudCase(tnt, id, false, false, utils.NonTransactional)
+	},
+	utils.AccountField: func(dm *DataManager, tnt, id string) (any, error) {
+		return dm.GetAccount(utils.ConcatenatedKey(tnt, id))
+	},
+	utils.RatingProfile: func(dm *DataManager, _, id string) (any, error) {
+		return dm.GetRatingProfile(id, true, utils.NonTransactional)
+	},
+}
+
+// auditQueueLen is the number of records waiting to be stored and exported,
+// over it the API calls deliver their own records
+const auditQueueLen = 1000
+
+// NewAuditS returns the AuditS
+func NewAuditS(cgrCfg *config.CGRConfig, storDBChan chan StorDB, dm *DataManager,
+	connMgr *ConnManager) *AuditS {
+	return &AuditS{
+		cgrCfg:     cgrCfg,
+		storDB:     <-storDBChan,
+		dm:         dm,
+		connMgr:    connMgr,
+		storDBChan: storDBChan,
+		records:    make(chan *AuditRecord, auditQueueLen),
+	}
+}
+
+// AuditS records the data changing API calls
+type AuditS struct {
+	cgrCfg     *config.CGRConfig
+	storDB     CdrStorage
+	dm         *DataManager
+	connMgr    *ConnManager
+	storDBChan chan StorDB
+	records    chan *AuditRecord // completed, waiting to be delivered
+}
+
+// ListenAndServe delivers the records and listens for storbd reload,
+// the records waiting at stop being delivered before it returns
+func (aS *AuditS) ListenAndServe(stopChan chan struct{}) {
+	for {
+		select {
+		case <-stopChan:
+			for {
+				select {
+				case ar := <-aS.records:
+					aS.deliver(ar)
+				default:
+					return
+				}
+			}
+		case stordb, ok := <-aS.storDBChan:
+			if !ok { // the chanel was closed by the shutdown of stordbService
+				return
+			}
+			aS.storDB = stordb
+		case ar := <-aS.records:
+			aS.deliver(ar)
+		}
+	}
+}
+
+// Audited returns if the API method is recorded
+func (aS *AuditS) Audited(method string) bool {
+	for _, mth := range aS.cgrCfg.AuditCfg().Methods {
+		if matched, _ := path.Match(mth, method); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// StartRecord creates the record of the API call before it is executed,
+// capturing the object it changes
+func (aS *AuditS) StartRecord(method, address string, args any) (ar *AuditRecord) {
+	ar = &AuditRecord{
+		ID:      utils.GenUUID(),
+		Time:    time.Now(),
+		Address: address,
+		APIKey:  utils.IfaceAsString(utils.APIOptFromArgs(args, utils.OptsRBACKey)),
+		Method:  method,
+	}
+	ar.ObjectType, ar.Tenant, ar.ObjectID = aS.auditedObject(method, args)
+	ar.Before = aS.objectAsJSON(ar.ObjectType, ar.Tenant, ar.ObjectID)
+	return
+}
+
+// EndRecord completes the record after the API call was executed
+// and queues it for the StorDB and EEs
+func (aS *AuditS) EndRecord(ar *AuditRecord, callErr string) {
+	ar.Error = callErr
+	ar.After = aS.objectAsJSON(ar.ObjectType, ar.Tenant, ar.ObjectID)
+	ar.Changes = diffObjectsJSON(ar.ObjectType, ar.Before, ar.After)
+	select {
+	case aS.records <- ar:
+	default: // the queue is full, delivered by the caller instead of dropped
+		aS.deliver(ar)
+	}
+}
+
+// deliver sends the record to the StorDB and EEs
+func (aS *AuditS) deliver(ar *AuditRecord) {
+	if aS.cgrCfg.AuditCfg().Store {
+		if aS.storDB == nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> no StorDB connection to store the record of %s",
+				utils.AuditS, ar.Method))
+		} else if err := aS.storDB.SetAuditRecord(ar); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed storing the record of %s, error: %s",
+				utils.AuditS, ar.Method, err))
+		}
+	}
+	if len(aS.cgrCfg.AuditCfg().EEsConns) != 0 {
+		var reply map[string]map[string]any
+		if err := aS.connMgr.Call(context.TODO(), aS.cgrCfg.AuditCfg().EEsConns,
+			utils.EeSv1ProcessEvent, &CGREventWithEeIDs{
+				EeIDs:    aS.cgrCfg.AuditCfg().EEsExporterIDs,
+				CGREvent: ar.AsCGREvent(),
+			}, &reply); err != nil &&
+			err.Error() != utils.ErrNotFound.Error() {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed exporting the record of %s, error: %s",
+				utils.AuditS, ar.Method, err))
+		}
+	}
+}
+
+// auditedObject returns the type, tenant and ID of the object changed by the API call.
+// The type is the method name without its verb, e.g. AttributeProfile for APIerSv1.SetAttributeProfile
+func (aS *AuditS) auditedObject(method string, args any) (objType, tnt, id string) {
+	tnt = utils.FirstNonEmpty(utils.TenantFromArgs(args), aS.cgrCfg.GeneralCfg().DefaultTenant)
+	srv, mthd, _ := strings.Cut(method, utils.NestingSep)
+	if srv == utils.ConfigSv1 {
+		return utils.MetaConfig, tnt, strings.Join(configSections(args), utils.FieldsSep)
+	}
+	for i, r := range mthd {
+		if i != 0 && unicode.IsUpper(r) {
+			objType = mthd[i:]
+			break
+		}
+	}
+	if alias, has := auditObjectAliases[objType]; has {
+		objType = alias
+	}
+	switch objType {
+	case utils.AccountField:
+		if id = utils.StringFieldFromArgs(args, utils.AccountField); id != utils.EmptyString {
+			return
+		}
+		// the replication APIs send the full account ID
+		acntID := utils.FirstNonEmpty(utils.StringFieldFromArgs(args, utils.ID),
+			utils.StringFieldFromArgs(args, "Arg"))
+		if acnt := utils.SplitConcatenatedKey(acntID); len(acnt) == 2 {
+			tnt, id = acnt[0], acnt[1]
+		}
+	case utils.RatingProfile:
+		if id = utils.StringFieldFromArgs(args, utils.ID); id == utils.EmptyString {
+			id = utils.ConcatenatedKey(utils.MetaOut, tnt,
+				utils.StringFieldFromArgs(args, utils.Category),
+				utils.StringFieldFromArgs(args, utils.Subject))
+		}
+	default:
+		id = utils.StringFieldFromArgs(args, utils.ID)
+	}
+	return
+}
+
+// configSections returns the config sections changed by the ConfigSv1 API call
+func configSections(args any) (sections []string) {
+	switch cfgArgs := args.(type) {
+	case *config.SetConfigArgs:
+		for section := range cfgArgs.Config {
+			sections = append(sections, section)
+		}
+	case *config.SetConfigFromJSONArgs:
+		var cfgSections map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(cfgArgs.Config), &cfgSections); err != nil {
+			return
+		}
+		for section := range cfgSections {
+			sections = append(sections, section)
+		}
+	case *config.ReloadArgs:
+		sections = []string{utils.FirstNonEmpty(cfgArgs.Section, utils.MetaAll)}
+	}
+	slices.Sort(sections)
+	return
+}
+
+// objectAsJSON returns the object read from DataDB or config as JSON, empty if missing
+func (aS *AuditS) objectAsJSON(objType, tnt, id string) string {
+	var obj any
+	var err error
+	if objType == utils.MetaConfig {
+		obj, err = aS.configSectionsAsMap(id)
+	} else if getter, has := auditObjectGetters[objType]; has &&
+		id != utils.EmptyString {
+		obj, err = getter(aS.dm, tnt, id)
+	} else {
+		return utils.EmptyString
+	}
+	if err != nil {
+		if err != utils.ErrNotFound {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed reading %s <%s>, error: %s",
+				utils.AuditS, objType, utils.ConcatenatedKey(tnt, id), err))
+		}
+		return utils.EmptyString
+	}
+	return utils.ToJSON(obj)
+}
+
+// configSectionsAsMap returns the config sections listed in the ID
+func (aS *AuditS) configSectionsAsMap(id string) (mp map[string]any, err error) {
+	if id == utils.EmptyString {
+		return nil, utils.ErrNotFound
+	}
+	mp = make(map[string]any)
+	for _, section := range strings.Split(id, utils.FieldsSep) {
+		var sectionMp map[string]any
+		if err = aS.cgrCfg.V1GetConfig(context.TODO(),
+			&config.SectionWithAPIOpts{Section: section}, &sectionMp); err != nil {
+			return
+		}
+		for k, v := range sectionMp {
+			mp[k] = v
+		}
+	}
+	return
+}
+
+// diffObjectsJSON returns the paths of the fields which differ between the two objects as JSON
+func diffObjectsJSON(objType, before, after string) []string {
+	if before == after {
+		return nil
+	}
+	var beforeVal, afterVal any
+	if before != utils.EmptyString {
+		if err := json.Unmarshal([]byte(before), &beforeVal); err != nil {
+			return []string{objType}
+		}
+	}
+	if after != utils.EmptyString {
+		if err := json.Unmarshal([]byte(after), &afterVal); err != nil {
+			return []string{objType}
+		}
+	}
+	return utils.DiffValues(objType, beforeVal, afterVal, nil)
+}
+
+// V1GetAuditRecords returns the stored AuditRecords matching the filter
+func (aS *AuditS) V1GetAuditRecords(ctx *context.Context, args *utils.AuditRecordFilterWithAPIOpts,
+	reply *[]*AuditRecord) (err error) {
+	if aS.storDB == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	fltr := args.AuditRecordFilter
+	if fltr == nil {
+		fltr = new(utils.AuditRecordFilter)
+	}
+	var ars []*AuditRecord
+	if ars, err = aS.storDB.GetAuditRecords(fltr); err != nil {
+		return
+	}
+	*reply = ars
+	return
+}
@@ -0,0 +1,151 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestAuditSRecords(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.AuditCfg().Enabled = true
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	storDB, dErr := NewInternalDB(nil, nil, false, nil, cfg.StorDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	storDBChan := make(chan StorDB, 1)
+	storDBChan <- storDB
+	aS := NewAuditS(cfg, storDBChan, dm, nil)
+
+	if !aS.Audited(utils.APIerSv1SetAttributeProfile) {
+		t.Errorf("Expected %s to be audited", utils.APIerSv1SetAttributeProfile)
+	}
+	if aS.Audited(utils.APIerSv1GetAttributeProfile) {
+		t.Errorf("Expected %s to not be audited", utils.APIerSv1GetAttributeProfile)
+	}
+
+	attr := &AttributeProfile{
+		Tenant:    "cgrates.org",
+		ID:        "ATTR_1",
+		Contexts:  []string{utils.MetaAny},
+		FilterIDs: []string{"*string:~*req.Account:1001"},
+		Weight:    10,
+	}
+	args := &AttributeProfileWithAPIOpts{
+		AttributeProfile: attr,
+		APIOpts:          map[string]any{utils.OptsRBACKey: "key1"},
+	}
+	rec := aS.StartRecord(utils.APIerSv1SetAttributeProfile, "127.0.0.1:5000", args)
+	if err := dm.SetAttributeProfile(attr, false); err != nil {
+		t.Fatal(err)
+	}
+	aS.EndRecord(rec, utils.EmptyString)
+	if rec.APIKey != "key1" || rec.Address != "127.0.0.1:5000" ||
+		rec.ObjectType != "AttributeProfile" || rec.Tenant != "cgrates.org" || rec.ObjectID != "ATTR_1" {
+		t.Errorf("Unexpected record: %s", utils.ToJSON(rec))
+	}
+	if rec.Before != utils.EmptyString || rec.After != utils.ToJSON(attr) {
+		t.Errorf("Unexpected before/after: %s", utils.ToJSON(rec))
+	}
+	if exp := []string{"AttributeProfile"}; !reflect.DeepEqual(exp, rec.Changes) {
+		t.Errorf("Expected %v, received %v", exp, rec.Changes)
+	}
+
+	updAttr := *attr
+	updAttr.Weight = 20
+	rec2 := aS.StartRecord(utils.APIerSv1SetAttributeProfile, "127.0.0.1:5001",
+		&AttributeProfileWithAPIOpts{AttributeProfile: &updAttr})
+	if err := dm.SetAttributeProfile(&updAttr, false); err != nil {
+		t.Fatal(err)
+	}
+	aS.EndRecord(rec2, utils.EmptyString)
+	if exp := []string{"AttributeProfile.Weight"}; !reflect.DeepEqual(exp, rec2.Changes) {
+		t.Errorf("Expected %v, received %v", exp, rec2.Changes)
+	}
+	stopChan := make(chan struct{})
+	close(stopChan)
+	aS.ListenAndServe(stopChan) // stores the queued records before returning
+
+	var rcv []*AuditRecord
+	if err := aS.V1GetAuditRecords(nil, &utils.AuditRecordFilterWithAPIOpts{
+		AuditRecordFilter: &utils.AuditRecordFilter{ObjectIDs: []string{"ATTR_1"}},
+	}, &rcv); err != nil {
+		t.Fatal(err)
+	} else if len(rcv) != 2 || rcv[0].ID != rec.ID || rcv[1].ID != rec2.ID {
+		t.Errorf("Unexpected records: %s", utils.ToJSON(rcv))
+	}
+	if err := aS.V1GetAuditRecords(nil, &utils.AuditRecordFilterWithAPIOpts{
+		AuditRecordFilter: &utils.AuditRecordFilter{APIKeys: []string{"key1"}},
+	}, &rcv); err != nil {
+		t.Fatal(err)
+	} else if len(rcv) != 1 || rcv[0].ID != rec.ID {
+		t.Errorf("Unexpected records: %s", utils.ToJSON(rcv))
+	}
+	if err := aS.V1GetAuditRecords(nil, &utils.AuditRecordFilterWithAPIOpts{
+		AuditRecordFilter: &utils.AuditRecordFilter{
+			Time: utils.TimeInterval{Begin: utils.TimePointer(time.Now().Add(time.Hour))},
+		},
+	}, &rcv); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestAuditSAuditedObject(t *testing.T) {
+	aS := &AuditS{cgrCfg: config.NewDefaultCGRConfig()}
+	for _, tc := range []struct {
+		method  string
+		args    any
+		objType string
+		tnt     string
+		id      string
+	}{
+		{utils.APIerSv1RemoveAttributeProfile,
+			&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "ATTR_1"}},
+			"AttributeProfile", "cgrates.org", "ATTR_1"},
+		{utils.APIerSv1SetBalance,
+			&utils.AttrSetBalance{Tenant: "itsyscom.com", Account: "1001"},
+			utils.AccountField, "itsyscom.com", "1001"},
+		{utils.ReplicatorSv1SetAccount,
+			&AccountWithAPIOpts{Account: &Account{ID: "cgrates.org:1002"}},
+			utils.AccountField, "cgrates.org", "1002"},
+		{utils.APIerSv1SetRatingProfile,
+			&utils.AttrSetRatingProfile{Tenant: "cgrates.org", Category: "call", Subject: "*any"},
+			utils.RatingProfile, "cgrates.org", "*out:cgrates.org:call:*any"},
+		{utils.ConfigSv1SetConfig,
+			&config.SetConfigArgs{Config: map[string]any{"sessions": nil, "cdrs": nil}},
+			utils.MetaConfig, "cgrates.org", "cdrs,sessions"},
+		{utils.ConfigSv1ReloadConfig, &config.ReloadArgs{},
+			utils.MetaConfig, "cgrates.org", utils.MetaAll},
+	} {
+		if objType, tnt, id := aS.auditedObject(tc.method, tc.args); objType != tc.objType ||
+			tnt != tc.tnt || id != tc.id {
+			t.Errorf("%s: expected %q %q %q, received %q %q %q",
+				tc.method, tc.objType, tc.tnt, tc.id, objType, tnt, id)
+		}
+	}
+}
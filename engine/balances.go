@@ -46,6 +46,7 @@ type Balance struct {
 	Disabled       bool
 	Factors        ValueFactors
 	Blocker        bool
+	RolledFrom     string `json:",omitempty"` // the ID of the balance whose units were carried by *rollover
 	precision      int
 	account        *Account // used to store ub reference for shared balances
 	dirty          bool
@@ -193,6 +194,7 @@ func (b *Balance) Clone() *Balance {
 		TimingIDs:      b.TimingIDs,
 		Timings:        b.Timings, // should not be a problem with aliasing
 		Blocker:        b.Blocker,
+		RolledFrom:     b.RolledFrom,
 		Disabled:       b.Disabled,
 		DestinationIDs: b.DestinationIDs,
 		Factors:        b.Factors,
@@ -73,6 +73,21 @@ func init() {
 	gob.Register(new(RankingProfile))
 	gob.Register(new(RankingProfileWithAPIOpts))
 	gob.Register(new(utils.TPRankingProfile))
+	// LookupTables
+	gob.Register(new(LookupTable))
+	gob.Register(new(LookupTableWithAPIOpts))
+	gob.Register(new(utils.TPLookupTable))
+	// DiscountProfiles
+	gob.Register(new(DiscountProfile))
+	gob.Register(new(DiscountProfileWithAPIOpts))
+	// FraudProfiles
+	gob.Register(new(FraudProfile))
+	gob.Register(new(FraudProfileWithAPIOpts))
+	// FraudCases
+	gob.Register(new(FraudCase))
+	gob.Register(new(FraudCaseWithAPIOpts))
+	// DataDB migration
+	gob.Register(new(StoredDataDBMigration))
 	// RouteS
 	gob.Register(new(RouteProfile))
 	gob.Register(new(RouteProfileWithAPIOpts))
@@ -37,6 +37,7 @@ type CallCost struct {
 	Timespans          TimeSpans
 	RatedUsage         float64
 	AccountSummary     *AccountSummary
+	Discounts          DiscountCharges `json:",omitempty"`
 	deductConnectFee   bool
 	negativeConnectFee bool // the connect fee went negative on default balance
 	maxCostDisconect   bool
@@ -45,6 +46,7 @@ type CallCost struct {
 // Merges the received timespan if they are similar (same activation period, same interval, same minute info.
 func (cc *CallCost) Merge(other *CallCost) {
 	cc.Timespans = append(cc.Timespans, other.Timespans...)
+	cc.Discounts = append(cc.Discounts, other.Discounts...)
 	cc.Cost += other.Cost
 }
 
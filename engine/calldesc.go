@@ -733,8 +733,16 @@ func (cd *CallDescriptor) debit(account *Account, dryRun bool, goNegative bool,
 	cc.updateCost()
 	cc.UpdateRatedUsage()
 	cc.Timespans.Compress()
+	if fltrS != nil && config.CgrConfig().RalsCfg().Discounts {
+		if errDsc := applyDiscounts(cd, cc, account, fltrS, !dryRun); errDsc != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> error applying the discounts for account <%s>: %s",
+				utils.RALs, cd.GetAccountKey(), errDsc.Error()))
+		}
+	}
 	if !dryRun {
-		dm.SetAccount(account)
+		if err = dm.SetAccount(account); err != nil {
+			return nil, err
+		}
 	}
 	if cd.PerformRounding {
 		cc.Round()
@@ -0,0 +1,426 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/ltcache"
+)
+
+const (
+	cdcQueues     = 16                      // the changes of one object are always exported by the same queue
+	cdcFailedFile = "cdc_changes_%02d.json" // the changes of one queue kept in the cdc_failed_dir, one per line
+	cdcBadSuffix  = ".bad"                  // the corrupted lines of the file, kept for inspection
+)
+
+// cdcLiveObjects are changed in place by their services, so their version before
+// the change is the last one exported instead of being read from DataDB
+var cdcLiveObjects = utils.NewStringSet([]string{
+	utils.MetaAccounts,
+	utils.MetaResources,
+	utils.MetaIPAllocations,
+	utils.MetaThresholds,
+})
+
+// cdcQueue holds the changes waiting to be exported, in the order they were made.
+// Over the cdc_queue_len, or once an export failed, the changes continue in the
+// file of the queue, the ones kept in memory being always older
+type cdcQueue struct {
+	mu      sync.Mutex
+	changes []*utils.CGREvent // kept in memory, exported first
+	notify  chan struct{}
+
+	fPath    string            // file of the queue, empty without cdc_failed_dir
+	fHas     bool              // the file has changes not exported
+	fOffset  int64             // the changes before it were exported
+	fChanges []*utils.CGREvent // read from the file, waiting to be exported
+	fEnds    []int64           // the offset after each of the fChanges
+}
+
+// CDCStream exports the changes of the DataDB objects to EEs.
+// The changes of one object are exported in order, each being retried until
+// EEs accept it, so a change can reach the exporters more than once. With the
+// cdc_failed_dir the changes waiting for a failed export are kept on disk,
+// surviving the restarts, otherwise the ones over the cdc_queue_len are dropped.
+type CDCStream struct {
+	cm            *ConnManager
+	conns         []string
+	exporterIDs   []string
+	retryInterval time.Duration
+	queueLen      int
+
+	last   *ltcache.Cache // the last exported version of the cdcLiveObjects
+	queues []*cdcQueue
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewCDCStream starts the export of the changes, returns nil if no EEs connection is configured
+func NewCDCStream(cm *ConnManager) *CDCStream {
+	cfg := config.CgrConfig().DataDbCfg()
+	if cm == nil || len(cfg.CDCEEsConns) == 0 {
+		return nil
+	}
+	s := &CDCStream{
+		cm:            cm,
+		conns:         cfg.CDCEEsConns,
+		exporterIDs:   cfg.CDCEEsExporterIDs,
+		retryInterval: cfg.CDCRetryInterval,
+		queueLen:      cfg.CDCQueueLen,
+		last:          ltcache.NewCache(cdcQueues*max(cfg.CDCQueueLen, 1), 0, false, false, nil),
+		queues:        make([]*cdcQueue, cdcQueues),
+		stop:          make(chan struct{}),
+	}
+	for i := range s.queues {
+		q := &cdcQueue{notify: make(chan struct{}, 1)}
+		if cfg.CDCFailedDir != utils.EmptyString {
+			q.fPath = filepath.Join(cfg.CDCFailedDir, fmt.Sprintf(cdcFailedFile, i))
+			if info, err := os.Stat(q.fPath); err == nil {
+				q.fHas = info.Size() != 0 // kept at the last shutdown
+			} else if !os.IsNotExist(err) {
+				utils.Logger.Err(fmt.Sprintf("<%s> failed reading the changes not exported from <%s>, error: %s",
+					utils.DataManager, q.fPath, err))
+			}
+		}
+		s.queues[i] = q
+	}
+	for _, q := range s.queues {
+		s.wg.Add(1)
+		go s.exportLoop(q)
+	}
+	return s
+}
+
+// publish queues the change of the object for export, the unchanged objects are ignored.
+// The before of the cdcLiveObjects is replaced with their last exported version
+func (s *CDCStream) publish(objType, id, before, after string) {
+	key := utils.ConcatenatedKey(objType, id)
+	if cdcLiveObjects.Has(objType) {
+		before = utils.EmptyString
+		if x, has := s.last.Get(key); has {
+			before = x.(string)
+		}
+		s.last.Set(key, after, nil)
+	}
+	if before == after {
+		return
+	}
+	tnt := config.CgrConfig().GeneralCfg().DefaultTenant
+	if rvObj, has := revisionedObjects[objType]; !has || rvObj.tenanted {
+		tnt = utils.NewTenantID(id).Tenant
+	}
+	now := time.Now()
+	s.enqueue(key, &utils.CGREvent{
+		Tenant: tnt,
+		ID:     utils.GenUUID(),
+		Time:   &now,
+		Event: map[string]any{
+			utils.EventType:  utils.DataChange,
+			utils.ObjectType: objType,
+			utils.ObjectID:   id,
+			utils.Before:     before,
+			utils.After:      after,
+			utils.Changes:    strings.Join(diffObjectsJSON(objType, before, after), utils.InfieldSep),
+		},
+		APIOpts: map[string]any{
+			utils.MetaEventType: utils.DataChange,
+		},
+	})
+}
+
+// enqueue adds the change to the queue of its object
+func (s *CDCStream) enqueue(key string, ev *utils.CGREvent) {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	q := s.queues[h.Sum32()%uint32(len(s.queues))]
+	q.mu.Lock()
+	err := q.add(ev, s.queueLen)
+	q.mu.Unlock()
+	if err != nil {
+		utils.Logger.Err(fmt.Sprintf("<%s> dropped the change of <%s> with ID <%s>, error: %s",
+			utils.DataManager, ev.Event[utils.ObjectType], ev.Event[utils.ObjectID], err))
+		return
+	}
+	select {
+	case q.notify <- struct{}{}:
+	default: // the queue was already notified
+	}
+}
+
+// exportLoop exports the changes of the queue, one by one, until the stream is closed
+func (s *CDCStream) exportLoop(q *cdcQueue) {
+	defer s.wg.Done()
+	for {
+		q.mu.Lock()
+		ev, fromFile, err := q.next(s.queueLen)
+		q.mu.Unlock()
+		if err != nil {
+			utils.Logger.Err(fmt.Sprintf("<%s> failed reading the changes from <%s>, error: %s",
+				utils.DataManager, q.fPath, err))
+		}
+		if ev == nil {
+			select {
+			case <-q.notify:
+				continue
+			case <-s.stop:
+				return
+			}
+		}
+		if err = s.export(ev); err != nil {
+			utils.Logger.Warning(fmt.Sprintf("<%s> failed exporting the change of <%s> with ID <%s>, retrying in %s, error: %s",
+				utils.DataManager, ev.Event[utils.ObjectType], ev.Event[utils.ObjectID], s.retryInterval, err))
+			if !fromFile { // keep the waiting changes on disk until EEs are back
+				q.mu.Lock()
+				err = q.spill()
+				q.mu.Unlock()
+				if err != nil {
+					utils.Logger.Err(fmt.Sprintf("<%s> failed keeping the changes not exported in <%s>, error: %s",
+						utils.DataManager, q.fPath, err))
+				}
+			}
+			select {
+			case <-time.After(s.retryInterval):
+				continue
+			case <-s.stop:
+				return
+			}
+		}
+		q.mu.Lock()
+		err = q.pop(fromFile)
+		q.mu.Unlock()
+		if err != nil {
+			utils.Logger.Err(fmt.Sprintf("<%s> failed removing the exported changes from <%s>, error: %s",
+				utils.DataManager, q.fPath, err))
+		}
+	}
+}
+
+// export sends the change to EEs
+func (s *CDCStream) export(ev *utils.CGREvent) (err error) {
+	var reply map[string]map[string]any
+	if err = s.cm.Call(context.TODO(), s.conns, utils.EeSv1ProcessEvent,
+		&CGREventWithEeIDs{
+			EeIDs:    s.exporterIDs,
+			CGREvent: ev,
+		}, &reply); err != nil && err.Error() == utils.ErrNotFound.Error() {
+		err = nil // no exporter for the change
+	}
+	return
+}
+
+// Close stops the export and keeps the changes not exported in the cdc_failed_dir
+func (s *CDCStream) Close() {
+	if s == nil {
+		return
+	}
+	close(s.stop)
+	s.wg.Wait()
+	for _, q := range s.queues {
+		q.mu.Lock()
+		if q.fPath == utils.EmptyString {
+			if len(q.changes) != 0 {
+				utils.Logger.Warning(fmt.Sprintf("<%s> %d changes were not exported",
+					utils.DataManager, len(q.changes)))
+			}
+		} else if err := q.spill(); err != nil {
+			utils.Logger.Err(fmt.Sprintf("<%s> failed keeping %d changes not exported in <%s>, error: %s",
+				utils.DataManager, len(q.changes), q.fPath, err))
+		}
+		q.mu.Unlock()
+	}
+}
+
+// add queues the change in memory or, once the file is used, at its end. Not thread safe
+func (q *cdcQueue) add(ev *utils.CGREvent, queueLen int) error {
+	if !q.fHas && len(q.changes) < queueLen {
+		q.changes = append(q.changes, ev)
+		return nil
+	}
+	if q.fPath == utils.EmptyString {
+		return fmt.Errorf("more than %d changes waiting", queueLen)
+	}
+	line, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(q.fPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	q.fHas = true
+	return f.Close()
+}
+
+// next returns the oldest change, reading the next ones from the file once the memory is empty. Not thread safe
+func (q *cdcQueue) next(queueLen int) (ev *utils.CGREvent, fromFile bool, err error) {
+	if len(q.changes) != 0 {
+		return q.changes[0], false, nil
+	}
+	if len(q.fChanges) == 0 && q.fHas {
+		if err = q.read(max(queueLen, 1)); err != nil {
+			return
+		}
+		if len(q.fChanges) == 0 { // only corrupted changes were left
+			if err = q.removeExported(); err != nil {
+				return
+			}
+		}
+	}
+	if len(q.fChanges) != 0 {
+		return q.fChanges[0], true, nil
+	}
+	return
+}
+
+// read loads up to n changes following the ones exported from the file. Not thread safe
+func (q *cdcQueue) read(n int) error {
+	f, err := os.Open(q.fPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = f.Seek(q.fOffset, io.SeekStart); err != nil {
+		return err
+	}
+	rdr := bufio.NewReader(f)
+	offset := q.fOffset
+	for len(q.fChanges) < n {
+		line, err := rdr.ReadBytes('\n')
+		if err == io.EOF {
+			break // the incomplete line is left for the next read
+		} else if err != nil {
+			return err
+		}
+		offset += int64(len(line))
+		var ev utils.CGREvent
+		if err = json.Unmarshal(line, &ev); err != nil {
+			if len(q.fChanges) != 0 {
+				break // skipped once the changes before it are exported
+			}
+			q.skip(line, err)
+			q.fOffset = offset
+			continue
+		}
+		q.fChanges = append(q.fChanges, &ev)
+		q.fEnds = append(q.fEnds, offset)
+	}
+	return nil
+}
+
+// skip moves the corrupted line out of the way into the .bad file so the
+// changes following it can be exported. Not thread safe
+func (q *cdcQueue) skip(line []byte, err error) {
+	utils.Logger.Err(fmt.Sprintf("<%s> skipping the corrupted change at offset %d in <%s>, error: %s",
+		utils.DataManager, q.fOffset, q.fPath, err))
+	f, err := os.OpenFile(q.fPath+cdcBadSuffix, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err == nil {
+		if _, err = f.Write(line); err == nil {
+			err = f.Close()
+		} else {
+			f.Close()
+		}
+	}
+	if err != nil {
+		utils.Logger.Err(fmt.Sprintf("<%s> failed keeping the corrupted change in <%s>, error: %s",
+			utils.DataManager, q.fPath+cdcBadSuffix, err))
+	}
+}
+
+// pop removes the exported change, the file being removed once all its changes were exported. Not thread safe
+func (q *cdcQueue) pop(fromFile bool) error {
+	if !fromFile {
+		q.changes[0] = nil
+		q.changes = q.changes[1:]
+		return nil
+	}
+	q.fOffset = q.fEnds[0]
+	q.fChanges[0] = nil
+	q.fChanges, q.fEnds = q.fChanges[1:], q.fEnds[1:]
+	if len(q.fChanges) != 0 {
+		return nil
+	}
+	return q.removeExported()
+}
+
+// removeExported removes the file once all its changes were exported. Not thread safe
+func (q *cdcQueue) removeExported() error {
+	info, err := os.Stat(q.fPath)
+	if err != nil {
+		return err
+	}
+	if info.Size() > q.fOffset {
+		return nil // more changes were added meanwhile
+	}
+	q.fHas, q.fOffset = false, 0
+	return os.Remove(q.fPath)
+}
+
+// spill moves the changes kept in memory at the start of the file, dropping
+// the ones already exported from it. Not thread safe
+func (q *cdcQueue) spill() (err error) {
+	if q.fPath == utils.EmptyString ||
+		len(q.changes) == 0 && q.fOffset == 0 {
+		return
+	}
+	var buf bytes.Buffer
+	for _, ev := range q.changes {
+		var line []byte
+		if line, err = json.Marshal(ev); err != nil {
+			return
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	if q.fHas {
+		var content []byte
+		if content, err = os.ReadFile(q.fPath); err != nil {
+			return
+		}
+		buf.Write(content[q.fOffset:])
+	}
+	tmpPath := q.fPath + utils.TmpSuffix
+	if err = os.WriteFile(tmpPath, buf.Bytes(), 0644); err != nil {
+		return
+	}
+	if err = os.Rename(tmpPath, q.fPath); err != nil {
+		return
+	}
+	q.changes = nil
+	q.fChanges, q.fEnds = nil, nil
+	q.fHas, q.fOffset = buf.Len() != 0, 0
+	return
+}
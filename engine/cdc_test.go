@@ -0,0 +1,268 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestCDCObjectTypes(t *testing.T) {
+	for objType := range cdcLiveObjects {
+		if _, has := revisionedObjects[objType]; has {
+			t.Errorf("object type <%s> also in revisionedObjects", objType)
+		}
+		if !utils.CDCItems.Has(objType) {
+			t.Errorf("object type <%s> not in CDCItems", objType)
+		}
+	}
+	if cdcLiveObjects.Size()+len(revisionedObjects) != len(utils.CDCItems) {
+		t.Errorf("expected %d object types, received %d", len(utils.CDCItems),
+			cdcLiveObjects.Size()+len(revisionedObjects))
+	}
+}
+
+func TestCDCQueue(t *testing.T) {
+	ev := func(id string) *utils.CGREvent {
+		return &utils.CGREvent{ID: id, Event: map[string]any{utils.ObjectID: id}}
+	}
+	q := new(cdcQueue)
+	if err := q.add(ev("1"), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.add(ev("2"), 1); err == nil || err.Error() != "more than 1 changes waiting" {
+		t.Errorf("expected the change to be dropped, received %v", err)
+	}
+
+	q = &cdcQueue{fPath: filepath.Join(t.TempDir(), fmt.Sprintf(cdcFailedFile, 0))}
+	for _, id := range []string{"1", "2", "3"} {
+		if err := q.add(ev(id), 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the first export failed, all the changes waiting go to the file
+	if err := q.spill(); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.add(ev("4"), 1); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for {
+		next, fromFile, err := q.next(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next == nil {
+			break
+		}
+		if !fromFile {
+			t.Errorf("expected <%s> from the file", next.ID)
+		}
+		ids = append(ids, next.ID)
+		if err = q.pop(fromFile); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(ids, []string{"1", "2", "3", "4"}) {
+		t.Errorf("unexpected order: %v", ids)
+	}
+	if _, err := os.Stat(q.fPath); !os.IsNotExist(err) {
+		t.Errorf("expected the exported file to be removed, received %v", err)
+	}
+	if q.fHas {
+		t.Error("expected the queue to continue in memory")
+	}
+}
+
+func TestCDCQueueCorruptedChange(t *testing.T) {
+	q := &cdcQueue{fPath: filepath.Join(t.TempDir(), fmt.Sprintf(cdcFailedFile, 0)), fHas: true}
+	content := `{"ID":"1"}
+{"ID":"2"
+{"ID":"3"}
+not a change
+`
+	if err := os.WriteFile(q.fPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for {
+		next, fromFile, err := q.next(10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next == nil {
+			break
+		}
+		ids = append(ids, next.ID)
+		if err = q.pop(fromFile); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(ids, []string{"1", "3"}) {
+		t.Errorf("expected the corrupted changes to be skipped, received: %v", ids)
+	}
+	if _, err := os.Stat(q.fPath); !os.IsNotExist(err) {
+		t.Errorf("expected the exported file to be removed, received %v", err)
+	}
+	if q.fHas {
+		t.Error("expected the queue to continue in memory")
+	}
+	if bad, err := os.ReadFile(q.fPath + cdcBadSuffix); err != nil {
+		t.Error(err)
+	} else if exp := "{\"ID\":\"2\"\nnot a change\n"; string(bad) != exp {
+		t.Errorf("expected %q, received %q", exp, string(bad))
+	}
+}
+
+func TestCDCStream(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DataDbCfg().CDCEEsConns = []string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs)}
+	cfg.DataDbCfg().CDCRetryInterval = time.Millisecond
+	cfg.DataDbCfg().CDCFailedDir = t.TempDir()
+	cfg.DataDbCfg().Items[utils.MetaAccounts].CDC = true
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	Cache.Clear([]string{utils.CacheRPCConnections})
+	defer Cache.Clear([]string{utils.CacheRPCConnections})
+
+	var mu sync.Mutex
+	var exported []*utils.CGREvent
+	failing := true // the first export fails to be retried
+	conn := make(chan context.ClientConnector, 1)
+	conn <- &ccMock{
+		calls: map[string]func(ctx *context.Context, args any, reply any) error{
+			utils.EeSv1ProcessEvent: func(ctx *context.Context, args, reply any) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if failing {
+					failing = false
+					return errors.New("connection refused")
+				}
+				exported = append(exported, args.(*CGREventWithEeIDs).CGREvent)
+				return nil
+			},
+		},
+	}
+	connMgr := NewConnManager(cfg, map[string]chan context.ClientConnector{
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaEEs): conn,
+	})
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmCDC := NewDataManager(data, cfg.CacheCfg(), connMgr)
+	cdc := NewCDCStream(connMgr)
+	dmCDC.SetCDCStream(cdc)
+
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{ID: "MONETARY", Value: 10}},
+		},
+	}
+	if err := dmCDC.SetAccount(acc.Clone()); err != nil {
+		t.Fatal(err)
+	}
+	acc.BalanceMap[utils.MetaMonetary][0].Value = 5
+	if err := dmCDC.SetAccount(acc.Clone()); err != nil {
+		t.Fatal(err)
+	}
+	if err := dmCDC.RemoveAccount(acc.ID); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; ; i++ {
+		mu.Lock()
+		n := len(exported)
+		mu.Unlock()
+		if n == 3 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("expected 3 exported changes, received %d", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	mu.Lock()
+	created, updated, removed := exported[0].Event, exported[1].Event, exported[2].Event
+	mu.Unlock()
+	if created[utils.Before] != utils.EmptyString || created[utils.After] == utils.EmptyString ||
+		created[utils.ObjectType] != utils.MetaAccounts || created[utils.ObjectID] != acc.ID {
+		t.Errorf("unexpected creation: %s", utils.ToJSON(created))
+	}
+	if updated[utils.Changes] != "*accounts.BalanceMap.*monetary[0].Value;*accounts.UpdateTime" {
+		t.Errorf("unexpected update: %s", utils.ToJSON(updated))
+	}
+	if removed[utils.Before] == utils.EmptyString || removed[utils.After] != utils.EmptyString {
+		t.Errorf("unexpected removal: %s", utils.ToJSON(removed))
+	}
+
+	// the changes not exported at shutdown are kept for the next start
+	mu.Lock()
+	failing = true
+	exported = nil
+	mu.Unlock()
+	cdc.retryInterval = time.Hour
+	if err := dmCDC.SetAccount(acc.Clone()); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	cdc.Close()
+	failedFiles := filepath.Join(cfg.DataDbCfg().CDCFailedDir, "cdc_changes_*.json")
+	if kept, err := filepath.Glob(failedFiles); err != nil || len(kept) != 1 {
+		t.Fatalf("expected the change to be kept, received %v, %v", kept, err)
+	}
+	cdc = NewCDCStream(connMgr)
+	defer cdc.Close()
+	for i := 0; ; i++ {
+		mu.Lock()
+		n := len(exported)
+		mu.Unlock()
+		if n == 1 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("expected the kept change to be exported, received %d", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 0; ; i++ { // the file is removed after the export
+		kept, err := filepath.Glob(failedFiles)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kept) == 0 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("expected the kept changes to be removed, received %v", kept)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
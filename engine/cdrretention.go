@@ -0,0 +1,349 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// cdrRetentionBatch is the number of CDRs queried at once from StorDB while
+// applying retention, keeping memory bounded on large tables
+const cdrRetentionBatch = 1000
+
+// CDRPurgeReport is the outcome of applying the retention policies
+type CDRPurgeReport struct {
+	Removed       int // CDRs removed for passing their max_age
+	Pseudonymised int // CDRs pseudonymised for passing their pseudonymise_after
+}
+
+// SubscriberErasure is the outcome of erasing the data of one subscriber
+type SubscriberErasure struct {
+	CDRs            int // CDRs removed or pseudonymised
+	SessionsBackups int // sessions backups removed
+}
+
+// pseudonymiseValue hides the value based on the configured method: *hash
+// replaces it with a salted HMAC-SHA256 so equal values still correlate,
+// *mask keeps a prefix of it visible (never more than half of the value)
+func pseudonymiseValue(val string, rtCfg *config.CDRRetentionCfg) string {
+	if val == utils.EmptyString {
+		return val
+	}
+	if rtCfg.PseudonymiseMethod == utils.MetaMask {
+		runes := []rune(val)
+		keep := min(rtCfg.MaskKeepPrefix, len(runes)/2)
+		return string(runes[:keep]) + strings.Repeat("*", len(runes)-keep)
+	}
+	mac := hmac.New(sha256.New, []byte(rtCfg.PseudonymiseSalt))
+	mac.Write([]byte(val))
+	return hex.EncodeToString(mac.Sum(nil))[:32]
+}
+
+// pseudonymiseCDR hides the personal data out of the CDR fields, marking the
+// CDR as pseudonymised so it is not processed again
+func pseudonymiseCDR(cdr *CDR, flds []string, rtCfg *config.CDRRetentionCfg, now time.Time) {
+	for _, fld := range flds {
+		switch fld {
+		case utils.AccountField:
+			cdr.Account = pseudonymiseValue(cdr.Account, rtCfg)
+		case utils.Subject:
+			cdr.Subject = pseudonymiseValue(cdr.Subject, rtCfg)
+		case utils.Destination:
+			cdr.Destination = pseudonymiseValue(cdr.Destination, rtCfg)
+		default:
+			if val, has := cdr.ExtraFields[fld]; has {
+				cdr.ExtraFields[fld] = pseudonymiseValue(val, rtCfg)
+			}
+		}
+	}
+	if cdr.CostDetails != nil && slices.Contains(flds, utils.AccountField) {
+		cdr.CostDetails.AccountSummary = nil // balances of the account are not needed anymore
+		for _, bc := range cdr.CostDetails.Accounting {
+			bc.AccountID = pseudonymiseValue(bc.AccountID, rtCfg)
+		}
+	}
+	if cdr.ExtraFields == nil {
+		cdr.ExtraFields = make(map[string]string)
+	}
+	cdr.ExtraFields[utils.Pseudonymised] = now.UTC().Format(time.RFC3339)
+}
+
+// removeSMCosts removes the SMCosts matching the filter, having none is not an error
+func (cdrS *CDRServer) removeSMCosts(fltr *utils.SMCostFilter) (err error) {
+	if err = cdrS.cdrDb.RemoveSMCosts(fltr); err == utils.ErrNotFound {
+		err = nil
+	}
+	return
+}
+
+// removeCDRs removes in batches the CDRs matching the filter together with
+// their SMCosts, returning the number of CDRs removed
+func (cdrS *CDRServer) removeCDRs(newFltr func() *utils.CDRsFilter) (n int, err error) {
+	for {
+		fltr := newFltr()
+		fltr.Limit = utils.IntPointer(cdrRetentionBatch)
+		var cdrs []*CDR
+		if cdrs, _, err = cdrS.cdrDb.GetCDRs(fltr, false); err != nil {
+			if err == utils.ErrNotFound {
+				err = nil
+			}
+			return
+		}
+		cgrIDs := make([]string, 0, len(cdrs))
+		for _, cdr := range cdrs {
+			if !slices.Contains(cgrIDs, cdr.CGRID) {
+				cgrIDs = append(cgrIDs, cdr.CGRID)
+			}
+		}
+		if err = cdrS.removeSMCosts(&utils.SMCostFilter{CGRIDs: cgrIDs}); err != nil {
+			return
+		}
+		rmFltr := newFltr()
+		rmFltr.CGRIDs = cgrIDs
+		if _, _, err = cdrS.cdrDb.GetCDRs(rmFltr, true); err != nil {
+			return
+		}
+		n += len(cdrs)
+		if len(cdrs) < cdrRetentionBatch {
+			return
+		}
+	}
+}
+
+// pseudonymiseCDRs pseudonymises in batches the CDRs matching the filter,
+// removing their SMCosts since these carry the same personal data, and returns
+// the number of CDRs pseudonymised. Without a subscriber the CDRs pseudonymised
+// already are skipped, otherwise these still get the identifiers equal to the
+// subscriber pseudonymised since the fields configured might not include them.
+func (cdrS *CDRServer) pseudonymiseCDRs(newFltr func() *utils.CDRsFilter, flds []string, subscriber string, now time.Time) (n int, err error) {
+	rtCfg := cdrS.cgrCfg.CdrsCfg().Retention
+	for {
+		fltr := newFltr()
+		if subscriber == utils.EmptyString {
+			if fltr.NotExtraFields == nil {
+				fltr.NotExtraFields = make(map[string]string)
+			}
+			fltr.NotExtraFields[utils.Pseudonymised] = utils.MetaExists
+		}
+		fltr.Limit = utils.IntPointer(cdrRetentionBatch)
+		var cdrs []*CDR
+		if cdrs, _, err = cdrS.cdrDb.GetCDRs(fltr, false); err != nil {
+			if err == utils.ErrNotFound {
+				err = nil
+			}
+			return
+		}
+		cgrIDs := make([]string, 0, len(cdrs))
+		for _, cdr := range cdrs {
+			cdrFlds := flds
+			if _, done := cdr.ExtraFields[utils.Pseudonymised]; done { // only the identifiers left
+				cdrFlds = nil
+				if cdr.Account == subscriber {
+					cdrFlds = append(cdrFlds, utils.AccountField)
+				}
+				if cdr.Subject == subscriber {
+					cdrFlds = append(cdrFlds, utils.Subject)
+				}
+			}
+			pseudonymiseCDR(cdr, cdrFlds, rtCfg, now)
+			if err = cdrS.cdrDb.SetCDR(cdr, true); err != nil {
+				return
+			}
+			if !slices.Contains(cgrIDs, cdr.CGRID) {
+				cgrIDs = append(cgrIDs, cdr.CGRID)
+			}
+		}
+		if err = cdrS.removeSMCosts(&utils.SMCostFilter{CGRIDs: cgrIDs}); err != nil {
+			return
+		}
+		n += len(cdrs)
+		if len(cdrs) < cdrRetentionBatch {
+			return
+		}
+	}
+}
+
+// purgeCDRs applies the retention policies to the CDRs set up before now
+func (cdrS *CDRServer) purgeCDRs(now time.Time) (rpt *CDRPurgeReport, err error) {
+	rtCfg := cdrS.cgrCfg.CdrsCfg().Retention
+	rpt = new(CDRPurgeReport)
+	err = guardian.Guardian.Guard(func() (err error) {
+		for _, pol := range rtCfg.Policies {
+			policyFltr := func(cutoff time.Time) func() *utils.CDRsFilter {
+				return func() *utils.CDRsFilter {
+					return &utils.CDRsFilter{
+						Tenants:      slices.Clone(pol.Tenants),
+						ToRs:         slices.Clone(pol.ToRs),
+						SetupTimeEnd: &cutoff,
+					}
+				}
+			}
+			var n int
+			if pol.MaxAge > 0 {
+				cutoff := now.Add(-pol.MaxAge)
+				if n, err = cdrS.removeCDRs(policyFltr(cutoff)); err != nil {
+					return
+				}
+				rpt.Removed += n
+				// SMCosts are created after the CDR set up so the ones left are orphans,
+				// only their account is known hence the policies for all the CDRs remove them
+				if len(pol.Tenants) == 0 && len(pol.ToRs) == 0 {
+					if err = cdrS.removeSMCosts(&utils.SMCostFilter{
+						CreatedAt: utils.TimeInterval{End: &cutoff}}); err != nil {
+						return
+					}
+				}
+			}
+			if pol.PseudonymiseAfter > 0 {
+				if n, err = cdrS.pseudonymiseCDRs(policyFltr(now.Add(-pol.PseudonymiseAfter)),
+					rtCfg.PseudonymiseFields, utils.EmptyString, now); err != nil {
+					return
+				}
+				rpt.Pseudonymised += n
+			}
+		}
+		return
+	}, cdrS.cgrCfg.GeneralCfg().LockingTimeout, utils.MetaCDRs+utils.RetentionCfg)
+	return
+}
+
+// runRetention is called periodically out of ListenAndServe
+func (cdrS *CDRServer) runRetention() {
+	rpt, err := cdrS.purgeCDRs(time.Now())
+	if err != nil {
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> failed applying the CDR retention policies: %s",
+				utils.CDRs, err.Error()))
+		return
+	}
+	if rpt.Removed != 0 || rpt.Pseudonymised != 0 {
+		utils.Logger.Info(
+			fmt.Sprintf("<%s> CDR retention removed %d and pseudonymised %d CDRs",
+				utils.CDRs, rpt.Removed, rpt.Pseudonymised))
+	}
+}
+
+// V1PurgeCDRs applies the retention policies on demand
+func (cdrS *CDRServer) V1PurgeCDRs(ctx *context.Context, args *utils.TenantWithAPIOpts, reply *CDRPurgeReport) (err error) {
+	var rpt *CDRPurgeReport
+	if rpt, err = cdrS.purgeCDRs(time.Now()); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	*reply = *rpt
+	return
+}
+
+// V1EraseSubscriber removes or pseudonymises all the data kept for one
+// subscriber: CDRs where it is the Account or the Subject, their SMCosts
+// together with the ones of the account without CDRs and the sessions backups
+// it started
+func (cdrS *CDRServer) V1EraseSubscriber(ctx *context.Context, args *utils.ArgsEraseSubscriber, reply *SubscriberErasure) (err error) {
+	if args.Account == utils.EmptyString {
+		return utils.NewErrMandatoryIeMissing(utils.AccountField)
+	}
+	mode := args.Mode
+	if mode == utils.EmptyString {
+		mode = utils.MetaRemove
+	}
+	if mode != utils.MetaRemove && mode != utils.MetaPseudonymise {
+		return fmt.Errorf("unsupported mode: <%s>", mode)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = cdrS.cgrCfg.GeneralCfg().DefaultTenant
+	}
+	rtCfg := cdrS.cgrCfg.CdrsCfg().Retention
+	fltrs := []func() *utils.CDRsFilter{
+		func() *utils.CDRsFilter {
+			return &utils.CDRsFilter{Tenants: []string{tnt}, Accounts: []string{args.Account}}
+		},
+		func() *utils.CDRsFilter {
+			return &utils.CDRsFilter{Tenants: []string{tnt}, Subjects: []string{args.Account}}
+		},
+	}
+	if mode == utils.MetaRemove &&
+		rtCfg.PseudonymiseMethod == utils.MetaHash { // also the ones already pseudonymised by the retention policies
+		hashed := pseudonymiseValue(args.Account, rtCfg)
+		fltrs = append(fltrs, func() *utils.CDRsFilter {
+			return &utils.CDRsFilter{Tenants: []string{tnt}, Accounts: []string{hashed}}
+		})
+	}
+	flds := rtCfg.PseudonymiseFields
+	for _, fld := range []string{utils.AccountField, utils.Subject} {
+		if !slices.Contains(flds, fld) { // identifying the subscriber regardless of the configuration
+			flds = append(slices.Clone(flds), fld)
+		}
+	}
+	var rpl SubscriberErasure
+	now := time.Now()
+	for _, newFltr := range fltrs {
+		var n int
+		if mode == utils.MetaRemove {
+			n, err = cdrS.removeCDRs(newFltr)
+		} else {
+			n, err = cdrS.pseudonymiseCDRs(newFltr, flds, args.Account, now)
+		}
+		if err != nil {
+			return utils.NewErrServerError(err)
+		}
+		rpl.CDRs += n
+	}
+	// the SMCosts without CDRs
+	if err = cdrS.removeSMCosts(&utils.SMCostFilter{
+		Accounts: []string{utils.ConcatenatedKey(tnt, args.Account)}}); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	nodeIDs := args.NodeIDs
+	if len(nodeIDs) == 0 {
+		nodeIDs = []string{cdrS.cgrCfg.GeneralCfg().NodeID}
+	}
+	for _, nodeID := range nodeIDs {
+		var sessions []*StoredSession
+		if sessions, err = cdrS.dm.GetSessionsBackup(nodeID, tnt); err != nil {
+			if err == utils.ErrNoBackupFound || err == utils.ErrNotFound {
+				err = nil
+				continue
+			}
+			return utils.NewErrServerError(err)
+		}
+		for _, sess := range sessions {
+			if sess.EventStart.GetStringIgnoreErrors(utils.AccountField) != args.Account &&
+				sess.EventStart.GetStringIgnoreErrors(utils.Subject) != args.Account {
+				continue
+			}
+			if err = cdrS.dm.RemoveSessionsBackup(nodeID, tnt, sess.CGRID); err != nil {
+				return utils.NewErrServerError(err)
+			}
+			rpl.SessionsBackups++
+		}
+	}
+	*reply = rpl
+	return
+}
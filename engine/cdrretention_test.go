@@ -0,0 +1,371 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func newRetentionTestCDRS(t *testing.T) (*config.CGRConfig, *CDRServer) {
+	t.Helper()
+	cfg := config.NewDefaultCGRConfig()
+	dataDB, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storDB, err := NewInternalDB(cfg.StorDbCfg().StringIndexedFields, cfg.StorDbCfg().PrefixIndexedFields,
+		false, nil, cfg.StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg, &CDRServer{
+		cgrCfg: cfg,
+		cdrDb:  storDB,
+		dm:     NewDataManager(dataDB, cfg.CacheCfg(), nil),
+	}
+}
+
+func newRetentionTestCDR(cgrID, tnt, tor, acnt string, setupTime time.Time) *CDR {
+	return &CDR{
+		CGRID:       cgrID,
+		RunID:       utils.MetaDefault,
+		OriginID:    cgrID,
+		OriginHost:  "127.0.0.1",
+		ToR:         tor,
+		Tenant:      tnt,
+		Category:    "call",
+		Account:     acnt,
+		Subject:     acnt,
+		Destination: "4986517174963",
+		SetupTime:   setupTime,
+		AnswerTime:  setupTime,
+		Usage:       time.Minute,
+		ExtraFields: map[string]string{"CallerIP": "10.0.0.1"},
+		Cost:        0.1,
+		CostDetails: &EventCost{
+			CGRID: cgrID,
+			AccountSummary: &AccountSummary{
+				Tenant:    tnt,
+				AccountID: acnt,
+			},
+			Accounting: Accounting{
+				"ACNT1": &BalanceCharge{AccountID: utils.ConcatenatedKey(tnt, acnt)},
+			},
+		},
+	}
+}
+
+func TestPseudonymiseValue(t *testing.T) {
+	rtCfg := &config.CDRRetentionCfg{
+		PseudonymiseMethod: utils.MetaHash,
+		PseudonymiseSalt:   "s3cr3t",
+		MaskKeepPrefix:     3,
+	}
+	hashed := pseudonymiseValue("1001", rtCfg)
+	if len(hashed) != 32 {
+		t.Errorf("expected 32 characters, received: %q", hashed)
+	}
+	if rcv := pseudonymiseValue("1001", rtCfg); rcv != hashed {
+		t.Errorf("expected the same value to hash the same, received: %q and %q", hashed, rcv)
+	}
+	if rcv := pseudonymiseValue("1002", rtCfg); rcv == hashed {
+		t.Errorf("expected different values to hash differently, received: %q", rcv)
+	}
+	rtCfg.PseudonymiseSalt = "other"
+	if rcv := pseudonymiseValue("1001", rtCfg); rcv == hashed {
+		t.Errorf("expected the salt to change the hash, received: %q", rcv)
+	}
+	rtCfg.PseudonymiseMethod = utils.MetaMask
+	for val, exp := range map[string]string{
+		"4986517174963": "498**********",
+		"1001":          "10**",
+		"a":             "*",
+		"":              "",
+	} {
+		if rcv := pseudonymiseValue(val, rtCfg); rcv != exp {
+			t.Errorf("masking %q expected: %q, received: %q", val, exp, rcv)
+		}
+	}
+}
+
+func TestPseudonymiseCDR(t *testing.T) {
+	rtCfg := &config.CDRRetentionCfg{
+		PseudonymiseMethod: utils.MetaMask,
+		MaskKeepPrefix:     3,
+	}
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	cdr := newRetentionTestCDR("cgrid1", "cgrates.org", utils.MetaVoice, "1001", now)
+	pseudonymiseCDR(cdr, []string{utils.AccountField, utils.Destination, "CallerIP", "NotExisting"}, rtCfg, now)
+	if cdr.Account != "10**" || cdr.Subject != "1001" || cdr.Destination != "498**********" {
+		t.Errorf("unexpected CDR fields: %s", utils.ToJSON(cdr))
+	}
+	expExtra := map[string]string{
+		"CallerIP":          "10.*****",
+		utils.Pseudonymised: "2026-10-19T10:00:00Z",
+	}
+	if !reflect.DeepEqual(cdr.ExtraFields, expExtra) {
+		t.Errorf("expected: %s, received: %s", utils.ToJSON(expExtra), utils.ToJSON(cdr.ExtraFields))
+	}
+	if cdr.CostDetails.AccountSummary != nil {
+		t.Errorf("expected the account summary removed, received: %s", utils.ToJSON(cdr.CostDetails.AccountSummary))
+	}
+	if rcv := cdr.CostDetails.Accounting["ACNT1"].AccountID; rcv != "cgr*************" {
+		t.Errorf("unexpected AccountID: %q", rcv)
+	}
+}
+
+func TestCDRServerPurgeCDRs(t *testing.T) {
+	cfg, cdrS := newRetentionTestCDRS(t)
+	cfg.CdrsCfg().Retention.Policies = []*config.CDRRetentionPolicy{{
+		Tenants:           []string{"cgrates.org"},
+		ToRs:              []string{utils.MetaVoice},
+		MaxAge:            24 * time.Hour,
+		PseudonymiseAfter: time.Hour,
+	}}
+	now := time.Now()
+	for _, cdr := range []*CDR{
+		newRetentionTestCDR("old", "cgrates.org", utils.MetaVoice, "1001", now.Add(-48*time.Hour)),
+		newRetentionTestCDR("mid", "cgrates.org", utils.MetaVoice, "1001", now.Add(-2*time.Hour)),
+		newRetentionTestCDR("fresh", "cgrates.org", utils.MetaVoice, "1001", now.Add(-10*time.Minute)),
+		newRetentionTestCDR("sms", "cgrates.org", utils.MetaSMS, "1001", now.Add(-48*time.Hour)),
+		newRetentionTestCDR("other", "itsyscom.com", utils.MetaVoice, "1001", now.Add(-48*time.Hour)),
+	} {
+		if err := cdrS.cdrDb.SetCDR(cdr, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := cdrS.cdrDb.SetSMCost(&SMCost{CGRID: cdr.CGRID, RunID: utils.MetaDefault,
+			OriginID: cdr.OriginID, CostDetails: cdr.CostDetails}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var rpl CDRPurgeReport
+	if err := cdrS.V1PurgeCDRs(nil, new(utils.TenantWithAPIOpts), &rpl); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (CDRPurgeReport{Removed: 1, Pseudonymised: 1}); rpl != exp {
+		t.Errorf("expected: %+v, received: %+v", exp, rpl)
+	}
+	cdrs, _, err := cdrS.cdrDb.GetCDRs(&utils.CDRsFilter{OrderBy: utils.OrderID}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cgrIDs := make(map[string]*CDR)
+	for _, cdr := range cdrs {
+		cgrIDs[cdr.CGRID] = cdr
+	}
+	if _, has := cgrIDs["old"]; has || len(cgrIDs) != 4 {
+		t.Errorf("unexpected CDRs left: %s", utils.ToJSON(cdrs))
+	}
+	if cdr := cgrIDs["mid"]; cdr.Account == "1001" || cdr.ExtraFields[utils.Pseudonymised] == utils.EmptyString {
+		t.Errorf("expected the CDR pseudonymised, received: %s", utils.ToJSON(cdr))
+	}
+	for _, cgrID := range []string{"fresh", "sms", "other"} {
+		if cdr := cgrIDs[cgrID]; cdr.Account != "1001" {
+			t.Errorf("expected the CDR untouched, received: %s", utils.ToJSON(cdr))
+		}
+	}
+	for cgrID, has := range map[string]bool{"old": false, "mid": false, "fresh": true} {
+		if smcs, _ := cdrS.cdrDb.GetSMCosts(cgrID, utils.EmptyString, utils.EmptyString, utils.EmptyString); (len(smcs) != 0) != has {
+			t.Errorf("for %s expected SMCost present: %v, received: %s", cgrID, has, utils.ToJSON(smcs))
+		}
+	}
+	// nothing left to do on a second run
+	if err := cdrS.V1PurgeCDRs(nil, new(utils.TenantWithAPIOpts), &rpl); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (CDRPurgeReport{}); rpl != exp {
+		t.Errorf("expected: %+v, received: %+v", exp, rpl)
+	}
+}
+
+func TestCDRServerEraseSubscriber(t *testing.T) {
+	cfg, cdrS := newRetentionTestCDRS(t)
+	now := time.Now()
+	prepaid := newRetentionTestCDR("prepaid", "cgrates.org", utils.MetaVoice, "1002", now)
+	prepaid.Subject = "1001"
+	for _, cdr := range []*CDR{
+		newRetentionTestCDR("call1", "cgrates.org", utils.MetaVoice, "1001", now),
+		newRetentionTestCDR("sms1", "cgrates.org", utils.MetaSMS, "1001", now),
+		prepaid,
+		newRetentionTestCDR("call2", "cgrates.org", utils.MetaVoice, "1003", now),
+	} {
+		if err := cdrS.cdrDb.SetCDR(cdr, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nodeID := cfg.GeneralCfg().NodeID
+	if err := cdrS.dm.SetBackupSessions(nodeID, "cgrates.org", []*StoredSession{
+		{CGRID: "sess1", Tenant: "cgrates.org", EventStart: MapEvent{utils.AccountField: "1001"}},
+		{CGRID: "sess2", Tenant: "cgrates.org", EventStart: MapEvent{utils.AccountField: "1003"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var rpl SubscriberErasure
+	if err := cdrS.V1EraseSubscriber(nil, &utils.ArgsEraseSubscriber{}, &rpl); err == nil ||
+		err.Error() != utils.NewErrMandatoryIeMissing(utils.AccountField).Error() {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := cdrS.V1EraseSubscriber(nil, &utils.ArgsEraseSubscriber{Account: "1001", Mode: "*forget"}, &rpl); err == nil ||
+		err.Error() != "unsupported mode: <*forget>" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := cdrS.V1EraseSubscriber(nil, &utils.ArgsEraseSubscriber{
+		Account: "1001",
+		Mode:    utils.MetaPseudonymise,
+	}, &rpl); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (SubscriberErasure{CDRs: 3, SessionsBackups: 1}); rpl != exp {
+		t.Errorf("expected: %+v, received: %+v", exp, rpl)
+	}
+	if _, _, err := cdrS.cdrDb.GetCDRs(&utils.CDRsFilter{Subjects: []string{"1001"}}, false); err != utils.ErrNotFound {
+		t.Errorf("expected no CDRs left for the subscriber, received: %v", err)
+	}
+	cdrs, _, err := cdrS.cdrDb.GetCDRs(&utils.CDRsFilter{}, false)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(cdrs) != 4 {
+		t.Errorf("expected the CDRs kept, received: %s", utils.ToJSON(cdrs))
+	}
+	sessions, err := cdrS.dm.GetSessionsBackup(nodeID, "cgrates.org")
+	if err != nil {
+		t.Fatal(err)
+	} else if len(sessions) != 1 || sessions[0].CGRID != "sess2" {
+		t.Errorf("unexpected sessions backups: %s", utils.ToJSON(sessions))
+	}
+
+	// the hashed CDRs are found again for removal
+	if err := cdrS.V1EraseSubscriber(nil, &utils.ArgsEraseSubscriber{Account: "1001"}, &rpl); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (SubscriberErasure{CDRs: 2}); rpl != exp {
+		t.Errorf("expected: %+v, received: %+v", exp, rpl)
+	}
+	if cdrs, _, err = cdrS.cdrDb.GetCDRs(&utils.CDRsFilter{OrderBy: utils.OrderID}, false); err != nil {
+		t.Fatal(err)
+	} else if len(cdrs) != 2 {
+		t.Errorf("unexpected CDRs left: %s", utils.ToJSON(cdrs))
+	}
+}
+
+func TestCDRServerPurgeOrphanSMCosts(t *testing.T) {
+	cfg, cdrS := newRetentionTestCDRS(t)
+	now := time.Now()
+	for cgrID, createdAt := range map[string]time.Time{
+		"old":   now.Add(-48 * time.Hour),
+		"fresh": now.Add(-10 * time.Minute),
+	} {
+		if err := cdrS.cdrDb.SetSMCost(&SMCost{CGRID: cgrID, RunID: utils.MetaDefault,
+			Account: "cgrates.org:1001", CostDetails: NewBareEventCost(), CreatedAt: createdAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkSMCosts := func(exp map[string]bool) {
+		t.Helper()
+		for cgrID, has := range exp {
+			if smcs, _ := cdrS.cdrDb.GetSMCosts(cgrID, utils.EmptyString, utils.EmptyString, utils.EmptyString); (len(smcs) != 0) != has {
+				t.Errorf("for %s expected SMCost present: %v, received: %s", cgrID, has, utils.ToJSON(smcs))
+			}
+		}
+	}
+	// the SMCosts might belong to CDRs out of other tenants
+	cfg.CdrsCfg().Retention.Policies = []*config.CDRRetentionPolicy{{
+		Tenants: []string{"cgrates.org"},
+		MaxAge:  24 * time.Hour,
+	}}
+	var rpl CDRPurgeReport
+	if err := cdrS.V1PurgeCDRs(nil, new(utils.TenantWithAPIOpts), &rpl); err != nil {
+		t.Fatal(err)
+	}
+	checkSMCosts(map[string]bool{"old": true, "fresh": true})
+	cfg.CdrsCfg().Retention.Policies = []*config.CDRRetentionPolicy{{
+		MaxAge: 24 * time.Hour,
+	}}
+	if err := cdrS.V1PurgeCDRs(nil, new(utils.TenantWithAPIOpts), &rpl); err != nil {
+		t.Fatal(err)
+	}
+	checkSMCosts(map[string]bool{"old": false, "fresh": true})
+}
+
+func TestCDRServerEraseSubscriberPseudonymised(t *testing.T) {
+	cfg, cdrS := newRetentionTestCDRS(t)
+	cfg.CdrsCfg().Retention.PseudonymiseFields = []string{utils.Destination}
+	cfg.CdrsCfg().Retention.Policies = []*config.CDRRetentionPolicy{{
+		PseudonymiseAfter: time.Hour,
+	}}
+	now := time.Now()
+	for _, cdr := range []*CDR{
+		newRetentionTestCDR("call1", "cgrates.org", utils.MetaVoice, "1001", now.Add(-2*time.Hour)),
+		newRetentionTestCDR("call2", "cgrates.org", utils.MetaVoice, "1003", now.Add(-2*time.Hour)),
+	} {
+		if err := cdrS.cdrDb.SetCDR(cdr, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var rpt CDRPurgeReport
+	if err := cdrS.V1PurgeCDRs(nil, new(utils.TenantWithAPIOpts), &rpt); err != nil {
+		t.Fatal(err)
+	} else if rpt.Pseudonymised != 2 {
+		t.Fatalf("expected 2 CDRs pseudonymised, received: %+v", rpt)
+	}
+	// the SMCosts of the sessions without CDRs
+	for cgrID, acnt := range map[string]string{"orphan1": "cgrates.org:1001", "orphan2": "cgrates.org:1003"} {
+		if err := cdrS.cdrDb.SetSMCost(&SMCost{CGRID: cgrID, RunID: utils.MetaDefault,
+			Account: acnt, CostDetails: NewBareEventCost()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var rpl SubscriberErasure
+	if err := cdrS.V1EraseSubscriber(nil, &utils.ArgsEraseSubscriber{
+		Account: "1001",
+		Mode:    utils.MetaPseudonymise,
+	}, &rpl); err != nil {
+		t.Fatal(err)
+	}
+	if exp := (SubscriberErasure{CDRs: 1}); rpl != exp {
+		t.Errorf("expected: %+v, received: %+v", exp, rpl)
+	}
+	cdrs, _, err := cdrS.cdrDb.GetCDRs(&utils.CDRsFilter{CGRIDs: []string{"call1"}}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashed := pseudonymiseValue("1001", cfg.CdrsCfg().Retention)
+	if cdr := cdrs[0]; cdr.Account != hashed || cdr.Subject != hashed ||
+		cdr.Destination != pseudonymiseValue("4986517174963", cfg.CdrsCfg().Retention) { // not hashed twice
+		t.Errorf("unexpected CDR: %s", utils.ToJSON(cdr))
+	}
+	if cdrs, _, err = cdrS.cdrDb.GetCDRs(&utils.CDRsFilter{CGRIDs: []string{"call2"}}, false); err != nil {
+		t.Fatal(err)
+	} else if cdrs[0].Account != "1003" {
+		t.Errorf("unexpected CDR: %s", utils.ToJSON(cdrs[0]))
+	}
+	for cgrID, has := range map[string]bool{"orphan1": false, "orphan2": true} {
+		if smcs, _ := cdrS.cdrDb.GetSMCosts(cgrID, utils.EmptyString, utils.EmptyString, utils.EmptyString); (len(smcs) != 0) != has {
+			t.Errorf("for %s expected SMCost present: %v, received: %s", cgrID, has, utils.ToJSON(smcs))
+		}
+	}
+}
@@ -114,12 +114,29 @@ type CDRServer struct {
 	storDBChan chan StorDB
 }
 
-// ListenAndServe listen for storbd reload
+// cdrStorage returns the CdrStorage creating its spans as children of the span
+// propagated through the APIOpts of args
+func (cdrS *CDRServer) cdrStorage(args any) CdrStorage {
+	if storDB, isStorDB := cdrS.cdrDb.(StorDB); isStorDB {
+		return StorDBWithTraceParent(storDB, utils.TraceParentFromArgs(args))
+	}
+	return cdrS.cdrDb
+}
+
+// ListenAndServe listen for storbd reload and applies the CDR retention policies
 func (cdrS *CDRServer) ListenAndServe(stopChan chan struct{}) {
+	var purgeTick <-chan time.Time // nil channel blocks when retention is disabled
+	if purgeIntvl := cdrS.cgrCfg.CdrsCfg().Retention.PurgeInterval; purgeIntvl > 0 {
+		ticker := time.NewTicker(purgeIntvl)
+		defer ticker.Stop()
+		purgeTick = ticker.C
+	}
 	for {
 		select {
 		case <-stopChan:
 			return
+		case <-purgeTick:
+			cdrS.runRetention()
 		case stordb, ok := <-cdrS.storDBChan:
 			if !ok { // the chanel was closed by the shutdown of stordbService
 				return
@@ -137,7 +154,11 @@ func (cdrS *CDRServer) RegisterHandlersToServer(server utils.Server) {
 
 // storeSMCost will store a SMCost
 func (cdrS *CDRServer) storeSMCost(smCost *SMCost, checkDuplicate bool) error {
-	smCost.CostDetails.Compute()                                              // make sure the total cost reflect the increment
+	smCost.CostDetails.Compute() // make sure the total cost reflect the increment
+	// the account is needed to remove the costs of one subscriber
+	if smCost.Account == utils.EmptyString {
+		smCost.Account = smCost.CostDetails.AccountID()
+	}
 	lockKey := utils.MetaCDRs + smCost.CGRID + smCost.RunID + smCost.OriginID // Will lock on this ID
 	if checkDuplicate {
 		return cdrS.guard.Guard(func() error {
@@ -429,6 +450,18 @@ func (cdrS *CDRServer) statSProcessEvent(cgrEv *utils.CGREvent) (err error) {
 	return
 }
 
+// routeSProcessEvent will feed the route breakers with the event
+func (cdrS *CDRServer) routeSProcessEvent(cgrEv *utils.CGREvent) (err error) {
+	var reply string
+	if err = cdrS.connMgr.Call(context.TODO(), cdrS.cgrCfg.CdrsCfg().RouteSConns,
+		utils.RouteSv1ProcessEvent,
+		cgrEv.Clone(), &reply); err != nil &&
+		err.Error() == utils.ErrNotFound.Error() {
+		err = nil // NotFound is not considered error
+	}
+	return
+}
+
 // eeSProcessEvent will process the event with the EEs component
 func (cdrS *CDRServer) eeSProcessEvent(cgrEv *CGREventWithEeIDs) (err error) {
 	var reply map[string]map[string]any
@@ -452,6 +485,7 @@ type cdrProcessingArgs struct {
 	export    bool
 	thdS      bool
 	stS       bool
+	rtS       bool
 	reprocess bool
 }
 
@@ -464,6 +498,7 @@ func newCDRProcessingArgs(cfg *config.CdrsCfg, flags utils.FlagsWithParams, opts
 		export: len(cfg.OnlineCDRExports) != 0 || len(cfg.EEsConns) != 0,
 		thdS:   len(cfg.ThresholdSConns) != 0,
 		stS:    len(cfg.StatSConns) != 0,
+		rtS:    len(cfg.RouteSConns) != 0,
 		ralS:   len(cfg.RaterConns) != 0,
 	}
 	var err error
@@ -505,6 +540,14 @@ func newCDRProcessingArgs(cfg *config.CdrsCfg, flags utils.FlagsWithParams, opts
 	if flags.Has(utils.MetaStats) {
 		args.stS = flags.GetBool(utils.MetaStats)
 	}
+	if v, has := opts[utils.OptsRouteS]; has {
+		if args.rtS, err = utils.IfaceAsBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Has(utils.MetaRouteBreakers) {
+		args.rtS = flags.GetBool(utils.MetaRouteBreakers)
+	}
 	if v, has := opts[utils.OptsRerate]; has {
 		if args.reRate, err = utils.IfaceAsBool(v); err != nil {
 			return nil, err
@@ -587,6 +630,14 @@ func newCDRProcessingArgsNoCfg(flags utils.FlagsWithParams, opts map[string]any)
 	if flags.Has(utils.MetaStats) {
 		args.stS = flags.GetBool(utils.MetaStats)
 	}
+	if v, has := opts[utils.OptsRouteS]; has {
+		if args.rtS, err = utils.IfaceAsBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Has(utils.MetaRouteBreakers) {
+		args.rtS = flags.GetBool(utils.MetaRouteBreakers)
+	}
 	if v, has := opts[utils.OptsRerate]; has {
 		if args.reRate, err = utils.IfaceAsBool(v); err != nil {
 			return nil, err
@@ -699,7 +750,7 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent, args *cdrProcessingA
 						cgrID = utils.IfaceAsString(val)
 					}
 					var prevCDRs []*CDR // only one should be returned
-					if prevCDRs, _, err = cdrS.cdrDb.GetCDRs(
+					if prevCDRs, _, err = cdrS.cdrStorage(cgrEv).GetCDRs(
 						&utils.CDRsFilter{CGRIDs: []string{cgrID},
 							RunIDs: []string{utils.IfaceAsString(cgrEv.Event[utils.RunID])}}, false); err != nil {
 						utils.Logger.Err(
@@ -780,13 +831,14 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent, args *cdrProcessingA
 				}
 			}
 		}
-		for _, cdr := range cdrs {
-			if err = cdrS.cdrDb.SetCDR(cdr, false); err != nil {
+		for i, cdr := range cdrs {
+			cdrDb := cdrS.cdrStorage(cgrEvs[i])
+			if err = cdrDb.SetCDR(cdr, false); err != nil {
 				if err != utils.ErrExists || !args.reRate {
 					refundCDRCosts()
 					return
 				}
-				if err = cdrS.cdrDb.SetCDR(cdr, true); err != nil {
+				if err = cdrDb.SetCDR(cdr, true); err != nil {
 					utils.Logger.Warning(
 						fmt.Sprintf("<%s> error: <%s> updating CDR %+v",
 							utils.CDRs, err.Error(), utils.ToJSON(cdr)))
@@ -833,6 +885,16 @@ func (cdrS *CDRServer) processEvents(evs []*utils.CGREvent, args *cdrProcessingA
 			}
 		}
 	}
+	if args.rtS {
+		for _, cgrEv := range cgrEvs {
+			if err = cdrS.routeSProcessEvent(cgrEv); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: <%s> processing event %+v with %s",
+						utils.CDRs, err.Error(), utils.ToJSON(cgrEv), utils.RouteS))
+				partiallyExecuted = true
+			}
+		}
+	}
 	if partiallyExecuted {
 		err = utils.ErrPartiallyExecuted
 	}
@@ -1285,7 +1347,7 @@ func (cdrS *CDRServer) V1GetCDRs(ctx *context.Context, args utils.RPCCDRsFilterW
 		}
 		return err
 	}
-	qryCDRs, _, err := cdrS.cdrDb.GetCDRs(cdrsFltr, false)
+	qryCDRs, _, err := cdrS.cdrStorage(&args).GetCDRs(cdrsFltr, false)
 	if err != nil {
 		return utils.NewErrServerError(err)
 	}
@@ -1303,7 +1365,7 @@ func (cdrS *CDRServer) V1GetCDRsCount(ctx *context.Context, args *utils.RPCCDRsF
 		return err
 	}
 	cdrsFltr.Count = true
-	_, qryCnt, err := cdrS.cdrDb.GetCDRs(cdrsFltr, false)
+	_, qryCnt, err := cdrS.cdrStorage(args).GetCDRs(cdrsFltr, false)
 	if err != nil {
 		return utils.NewErrServerError(err)
 	}
@@ -20,6 +20,7 @@ package engine
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
@@ -48,6 +49,7 @@ func (cS *ChargerService) Shutdown() {
 
 // matchingChargingProfilesForEvent returns ordered list of matching chargers which are active by the time of the function call
 func (cS *ChargerService) matchingChargerProfilesForEvent(tnt string, cgrEv *utils.CGREvent) (cPs ChargerProfiles, err error) {
+	dm := cS.dm.withAPIOpts(cgrEv.APIOpts)
 	evNm := utils.MapStorage{
 		utils.MetaReq:  cgrEv.Event,
 		utils.MetaOpts: cgrEv.APIOpts,
@@ -57,7 +59,7 @@ func (cS *ChargerService) matchingChargerProfilesForEvent(tnt string, cgrEv *uti
 		cS.cfg.ChargerSCfg().PrefixIndexedFields,
 		cS.cfg.ChargerSCfg().SuffixIndexedFields,
 		cS.cfg.ChargerSCfg().ExistsIndexedFields,
-		cS.dm, utils.CacheChargerFilterIndexes, tnt,
+		dm, utils.CacheChargerFilterIndexes, tnt,
 		cS.cfg.ChargerSCfg().IndexedSelects,
 		cS.cfg.ChargerSCfg().NestedFields,
 	)
@@ -65,8 +67,9 @@ func (cS *ChargerService) matchingChargerProfilesForEvent(tnt string, cgrEv *uti
 		return nil, err
 	}
 	matchingCPs := make(map[string]*ChargerProfile)
+	weights := make(map[string]float64) // weights of the matching profiles for this event
 	for cpID := range cpIDs {
-		cP, err := cS.dm.GetChargerProfile(tnt, cpID, true, true, utils.NonTransactional)
+		cP, err := dm.GetChargerProfile(tnt, cpID, true, true, utils.NonTransactional)
 		if err != nil {
 			if err == utils.ErrNotFound {
 				continue
@@ -83,6 +86,10 @@ func (cS *ChargerService) matchingChargerProfilesForEvent(tnt string, cgrEv *uti
 		} else if !pass {
 			continue
 		}
+		if weights[cpID], err = weightForEvent(cP.Weights, cP.Weight,
+			cS.filterS, tnt, evNm); err != nil {
+			return nil, err
+		}
 		matchingCPs[cpID] = cP
 	}
 	if len(matchingCPs) == 0 {
@@ -94,7 +101,7 @@ func (cS *ChargerService) matchingChargerProfilesForEvent(tnt string, cgrEv *uti
 		cPs[i] = cP
 		i++
 	}
-	cPs.Sort()
+	sort.Slice(cPs, func(i, j int) bool { return weights[cPs[i].ID] > weights[cPs[j].ID] })
 	return
 }
 
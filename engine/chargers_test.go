@@ -715,3 +715,55 @@ func TestChargersShutdown(t *testing.T) {
 
 	utils.Logger.SetLogLevel(0)
 }
+
+func TestChargersMatchingDynamicWeights(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	cS := NewChargerService(dm, NewFilterS(cfg, nil, dm), cfg, nil)
+	for _, cP := range []*ChargerProfile{
+		{
+			Tenant:       "cgrates.org",
+			ID:           "CP_STATIC",
+			RunID:        "static",
+			AttributeIDs: []string{utils.MetaNone},
+			Weight:       20,
+		},
+		{
+			Tenant:       "cgrates.org",
+			ID:           "CP_DYNAMIC",
+			RunID:        "dynamic",
+			AttributeIDs: []string{utils.MetaNone},
+			Weights: utils.DynamicWeights{
+				{FilterIDs: []string{"*string:~*req.Account:1001"}, Weight: 30},
+			},
+			Weight: 10,
+		},
+	} {
+		if err := dm.SetChargerProfile(cP, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for acnt, expIDs := range map[string][]string{
+		"1001": {"CP_DYNAMIC", "CP_STATIC"},
+		"1002": {"CP_STATIC", "CP_DYNAMIC"},
+	} {
+		cPs, err := cS.matchingChargerProfilesForEvent("cgrates.org", &utils.CGREvent{
+			Tenant: "cgrates.org",
+			Event:  map[string]any{utils.AccountField: acnt},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rcvIDs := make([]string, len(cPs))
+		for i, cP := range cPs {
+			rcvIDs[i] = cP.ID
+		}
+		if !reflect.DeepEqual(rcvIDs, expIDs) {
+			t.Errorf("account %s: expected %v, received %v", acnt, expIDs, rcvIDs)
+		}
+	}
+}
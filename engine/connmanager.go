@@ -185,6 +185,11 @@ func (cM *ConnManager) Call(ctx *context.Context, connIDs []string, method strin
 	if len(connIDs) == 0 {
 		return utils.NewErrMandatoryIeMissing("connIDs")
 	}
+	if span := startClientSpan(ctx, method, arg); span != nil {
+		span.SetAttribute("rpc.conns", strings.Join(connIDs, utils.InfieldSep))
+		defer utils.SetArgsTraceParent(arg, span.TraceParent())()
+		defer func() { span.End(err) }()
+	}
 	var conn birpc.ClientConnector
 	for _, connID := range connIDs {
 		cM.lkConn(connID)
@@ -200,6 +205,19 @@ func (cM *ConnManager) Call(ctx *context.Context, connIDs []string, method strin
 	return
 }
 
+// startClientSpan creates the span of an outgoing call, child of the span
+// carried by ctx or of the one propagated through the APIOpts of arg
+func startClientSpan(ctx *context.Context, method string, arg any) *utils.Span {
+	if !utils.Tracer.Enabled() {
+		return nil
+	}
+	traceParent := utils.SpanFromContext(ctx).TraceParent()
+	if traceParent == utils.EmptyString {
+		traceParent = utils.TraceParentFromArgs(arg)
+	}
+	return utils.Tracer.StartSpan(traceParent, method, utils.MetaClient)
+}
+
 // CallWithConnIDs will call the method only on specified rpcconns
 func (cM *ConnManager) CallWithConnIDs(connIDs []string, subsHostIDs utils.StringSet, method string, arg, reply any) (err error) {
 	if len(connIDs) == 0 {
@@ -209,6 +227,11 @@ func (cM *ConnManager) CallWithConnIDs(connIDs []string, subsHostIDs utils.Strin
 	if subsHostIDs.Size() == 0 {
 		return
 	}
+	if span := startClientSpan(nil, method, arg); span != nil {
+		span.SetAttribute("rpc.conns", strings.Join(connIDs, utils.InfieldSep))
+		defer utils.SetArgsTraceParent(arg, span.TraceParent())()
+		defer func() { span.End(err) }()
+	}
 	var conn birpc.ClientConnector
 	for _, connID := range connIDs {
 		// recreate the config with only conns that are needed
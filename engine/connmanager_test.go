@@ -528,3 +528,52 @@ func TestCMDeadLock(t *testing.T) {
 	}
 }
 */
+
+func TestCMCallTracing(t *testing.T) {
+	memExp := utils.NewMemorySpanExporter(-1)
+	utils.Tracer.Load(1, false, memExp)
+	defer utils.Tracer.Close()
+	var rcvTraceParent string
+	ccM := &ccMock{
+		calls: map[string]func(ctx *context.Context, args any, reply any) error{
+			utils.AttributeSv1ProcessEvent: func(ctx *context.Context, args, reply any) error {
+				rcvTraceParent = utils.TraceParentFromArgs(args)
+				return utils.ErrNotFound
+			},
+		},
+	}
+	connID := "tracingConn"
+	Cache.Clear([]string{utils.CacheRPCConnections})
+	Cache.SetWithoutReplicate(utils.CacheRPCConnections, connID, ccM, nil, true, utils.NonTransactional)
+	defer Cache.Clear([]string{utils.CacheRPCConnections})
+	cM := &ConnManager{
+		cfg:       config.NewDefaultCGRConfig(),
+		connCache: ltcache.NewCache(-1, 0, true, true, nil),
+	}
+	parent := utils.Tracer.StartSpan(utils.EmptyString, utils.SessionSv1AuthorizeEvent, utils.MetaServer)
+	ev := &utils.CGREvent{
+		Tenant:  "cgrates.org",
+		APIOpts: map[string]any{utils.OptsTraceParent: parent.TraceParent()},
+	}
+	var reply AttrSProcessEventReply
+	if err := cM.Call(context.TODO(), []string{connID}, utils.AttributeSv1ProcessEvent,
+		ev, &reply); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if rcv := utils.TraceParentFromArgs(ev); rcv != parent.TraceParent() {
+		t.Errorf("Expected the APIOpts to be restored, received %q", rcv)
+	}
+	spans := memExp.Spans(parent.TraceID)
+	if len(spans) != 1 {
+		t.Fatalf("Expected one span, received %s", utils.ToJSON(spans))
+	}
+	if spans[0].ParentSpanID != parent.SpanID ||
+		spans[0].Name != utils.AttributeSv1ProcessEvent ||
+		spans[0].Kind != utils.MetaClient ||
+		spans[0].Error != utils.ErrNotFound.Error() {
+		t.Errorf("Unexpected span: %s", utils.ToJSON(spans[0]))
+	}
+	if rcvTraceParent != spans[0].TraceParent() {
+		t.Errorf("Expected %q, received %q", spans[0].TraceParent(), rcvTraceParent)
+	}
+}
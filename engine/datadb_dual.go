@@ -0,0 +1,609 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// dualDataDBState is shared between the DualDataDB instances of the same
+// migration so the writes keep being serialized with the copier after the
+// reads are switched
+type dualDataDBState struct {
+	sync.RWMutex        // writes hold the read lock, the copier the write lock
+	secondaryErrs int64 // failed writes on the secondary DataDB
+}
+
+// DualDataDB reads from the primary DataDB and writes to both the primary
+// and the secondary ones. The writes on the secondary DataDB are best effort,
+// their failures are logged and counted so the migration verifier can catch
+// the differences
+type DualDataDB struct {
+	DataDB           // primary
+	secondary DataDB // kept in sync with the primary
+	state     *dualDataDBState
+}
+
+// NewDualDataDB returns a DualDataDB reading from primary
+func NewDualDataDB(primary, secondary DataDB) *DualDataDB {
+	return &DualDataDB{
+		DataDB:    primary,
+		secondary: secondary,
+		state:     new(dualDataDBState),
+	}
+}
+
+// Primary returns the DataDB used for reads
+func (dDB *DualDataDB) Primary() DataDB {
+	return dDB.DataDB
+}
+
+// Secondary returns the DataDB that only mirrors the writes
+func (dDB *DualDataDB) Secondary() DataDB {
+	return dDB.secondary
+}
+
+// SecondaryErrors returns the number of writes failed on the secondary DataDB
+func (dDB *DualDataDB) SecondaryErrors() int64 {
+	return atomic.LoadInt64(&dDB.state.secondaryErrs)
+}
+
+// swapped returns a DualDataDB reading from the secondary DataDB
+func (dDB *DualDataDB) swapped() *DualDataDB {
+	return &DualDataDB{
+		DataDB:    dDB.secondary,
+		secondary: dDB.DataDB,
+		state:     dDB.state,
+	}
+}
+
+// write executes the write on the primary and, on success, mirrors it on the secondary
+func (dDB *DualDataDB) write(method string, f func(DataDB) error) (err error) {
+	dDB.state.RLock()
+	defer dDB.state.RUnlock()
+	if err = f(dDB.DataDB); err != nil {
+		return
+	}
+	if errSec := f(dDB.secondary); errSec != nil &&
+		errSec != utils.ErrNotFound { // removing items not yet copied
+		atomic.AddInt64(&dDB.state.secondaryErrs, 1)
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed mirroring %s on the secondary DataDB: %s",
+			utils.DataManager, method, errSec))
+	}
+	return
+}
+
+// Close closes both DataDBs
+func (dDB *DualDataDB) Close() {
+	dDB.DataDB.Close()
+	dDB.secondary.Close()
+}
+
+// PopTask pops the task from the primary and discards the one from the secondary
+func (dDB *DualDataDB) PopTask() (t *Task, err error) {
+	dDB.state.RLock()
+	defer dDB.state.RUnlock()
+	if t, err = dDB.DataDB.PopTask(); err != nil {
+		return
+	}
+	if _, errSec := dDB.secondary.PopTask(); errSec != nil &&
+		errSec != utils.ErrNotFound {
+		atomic.AddInt64(&dDB.state.secondaryErrs, 1)
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed mirroring PopTask on the secondary DataDB: %s",
+			utils.DataManager, errSec))
+	}
+	return
+}
+
+func (dDB *DualDataDB) Flush(ignore string) error {
+	return dDB.write("Flush", func(dataDB DataDB) error {
+		return dataDB.Flush(ignore)
+	})
+}
+
+func (dDB *DualDataDB) RemoveKeysForPrefix(prefix string) error {
+	return dDB.write("RemoveKeysForPrefix", func(dataDB DataDB) error {
+		return dataDB.RemoveKeysForPrefix(prefix)
+	})
+}
+
+func (dDB *DualDataDB) SetVersions(vrs Versions, overwrite bool) error {
+	return dDB.write("SetVersions", func(dataDB DataDB) error {
+		return dataDB.SetVersions(vrs, overwrite)
+	})
+}
+
+func (dDB *DualDataDB) RemoveVersions(vrs Versions) error {
+	return dDB.write("RemoveVersions", func(dataDB DataDB) error {
+		return dataDB.RemoveVersions(vrs)
+	})
+}
+
+func (dDB *DualDataDB) SetRatingPlanDrv(rp *RatingPlan) error {
+	return dDB.write("SetRatingPlanDrv", func(dataDB DataDB) error {
+		return dataDB.SetRatingPlanDrv(rp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveRatingPlanDrv(key string) error {
+	return dDB.write("RemoveRatingPlanDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveRatingPlanDrv(key)
+	})
+}
+
+func (dDB *DualDataDB) SetRatingProfileDrv(rpf *RatingProfile) error {
+	return dDB.write("SetRatingProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetRatingProfileDrv(rpf)
+	})
+}
+
+func (dDB *DualDataDB) RemoveRatingProfileDrv(key string) error {
+	return dDB.write("RemoveRatingProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveRatingProfileDrv(key)
+	})
+}
+
+func (dDB *DualDataDB) SetDestinationDrv(dest *Destination, transactionID string) error {
+	return dDB.write("SetDestinationDrv", func(dataDB DataDB) error {
+		return dataDB.SetDestinationDrv(dest, transactionID)
+	})
+}
+
+func (dDB *DualDataDB) RemoveDestinationDrv(destID, transactionID string) error {
+	return dDB.write("RemoveDestinationDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveDestinationDrv(destID, transactionID)
+	})
+}
+
+func (dDB *DualDataDB) RemoveReverseDestinationDrv(dstID, prfx, transactionID string) error {
+	return dDB.write("RemoveReverseDestinationDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveReverseDestinationDrv(dstID, prfx, transactionID)
+	})
+}
+
+func (dDB *DualDataDB) SetReverseDestinationDrv(destID string, prefixes []string, transactionID string) error {
+	return dDB.write("SetReverseDestinationDrv", func(dataDB DataDB) error {
+		return dataDB.SetReverseDestinationDrv(destID, prefixes, transactionID)
+	})
+}
+
+func (dDB *DualDataDB) SetPortedNumberDrv(number, routingNumber string) error {
+	return dDB.write("SetPortedNumberDrv", func(dataDB DataDB) error {
+		return dataDB.SetPortedNumberDrv(number, routingNumber)
+	})
+}
+
+func (dDB *DualDataDB) SetPortedNumbersDrv(pns []*PortedNumber) error {
+	return dDB.write("SetPortedNumbersDrv", func(dataDB DataDB) error {
+		return dataDB.SetPortedNumbersDrv(pns)
+	})
+}
+
+func (dDB *DualDataDB) RemovePortedNumberDrv(number string) error {
+	return dDB.write("RemovePortedNumberDrv", func(dataDB DataDB) error {
+		return dataDB.RemovePortedNumberDrv(number)
+	})
+}
+
+func (dDB *DualDataDB) SetLookupTableDrv(lt *LookupTable) error {
+	return dDB.write("SetLookupTableDrv", func(dataDB DataDB) error {
+		return dataDB.SetLookupTableDrv(lt)
+	})
+}
+
+func (dDB *DualDataDB) RemoveLookupTableDrv(tenant, id string) error {
+	return dDB.write("RemoveLookupTableDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveLookupTableDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetDiscountProfileDrv(dp *DiscountProfile) error {
+	return dDB.write("SetDiscountProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetDiscountProfileDrv(dp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveDiscountProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveDiscountProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveDiscountProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetFraudProfileDrv(fp *FraudProfile) error {
+	return dDB.write("SetFraudProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetFraudProfileDrv(fp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveFraudProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveFraudProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveFraudProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetFraudCaseDrv(fc *FraudCase) error {
+	return dDB.write("SetFraudCaseDrv", func(dataDB DataDB) error {
+		return dataDB.SetFraudCaseDrv(fc)
+	})
+}
+
+func (dDB *DualDataDB) RemoveFraudCaseDrv(tenant, id string) error {
+	return dDB.write("RemoveFraudCaseDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveFraudCaseDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetRevisionsDrv(rvs *ObjectRevisions) error {
+	return dDB.write("SetRevisionsDrv", func(dataDB DataDB) error {
+		return dataDB.SetRevisionsDrv(rvs)
+	})
+}
+
+func (dDB *DualDataDB) SetActionsDrv(key string, as Actions) error {
+	return dDB.write("SetActionsDrv", func(dataDB DataDB) error {
+		return dataDB.SetActionsDrv(key, as)
+	})
+}
+
+func (dDB *DualDataDB) RemoveActionsDrv(key string) error {
+	return dDB.write("RemoveActionsDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveActionsDrv(key)
+	})
+}
+
+func (dDB *DualDataDB) SetSharedGroupDrv(sg *SharedGroup) error {
+	return dDB.write("SetSharedGroupDrv", func(dataDB DataDB) error {
+		return dataDB.SetSharedGroupDrv(sg)
+	})
+}
+
+func (dDB *DualDataDB) RemoveSharedGroupDrv(id string) error {
+	return dDB.write("RemoveSharedGroupDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveSharedGroupDrv(id)
+	})
+}
+
+func (dDB *DualDataDB) SetActionTriggersDrv(key string, atrs ActionTriggers) error {
+	return dDB.write("SetActionTriggersDrv", func(dataDB DataDB) error {
+		return dataDB.SetActionTriggersDrv(key, atrs)
+	})
+}
+
+func (dDB *DualDataDB) RemoveActionTriggersDrv(key string) error {
+	return dDB.write("RemoveActionTriggersDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveActionTriggersDrv(key)
+	})
+}
+
+func (dDB *DualDataDB) SetActionPlanDrv(key string, ats *ActionPlan) error {
+	return dDB.write("SetActionPlanDrv", func(dataDB DataDB) error {
+		return dataDB.SetActionPlanDrv(key, ats)
+	})
+}
+
+func (dDB *DualDataDB) RemoveActionPlanDrv(key string) error {
+	return dDB.write("RemoveActionPlanDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveActionPlanDrv(key)
+	})
+}
+
+func (dDB *DualDataDB) SetAccountActionPlansDrv(acntID string, apIDs []string) error {
+	return dDB.write("SetAccountActionPlansDrv", func(dataDB DataDB) error {
+		return dataDB.SetAccountActionPlansDrv(acntID, apIDs)
+	})
+}
+
+func (dDB *DualDataDB) RemAccountActionPlansDrv(acntID string) error {
+	return dDB.write("RemAccountActionPlansDrv", func(dataDB DataDB) error {
+		return dataDB.RemAccountActionPlansDrv(acntID)
+	})
+}
+
+func (dDB *DualDataDB) PushTask(t *Task) error {
+	return dDB.write("PushTask", func(dataDB DataDB) error {
+		return dataDB.PushTask(t)
+	})
+}
+
+func (dDB *DualDataDB) SetAccountDrv(acc *Account) error {
+	return dDB.write("SetAccountDrv", func(dataDB DataDB) error {
+		return dataDB.SetAccountDrv(acc)
+	})
+}
+
+func (dDB *DualDataDB) RemoveAccountDrv(key string) error {
+	return dDB.write("RemoveAccountDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveAccountDrv(key)
+	})
+}
+
+func (dDB *DualDataDB) SetResourceProfileDrv(rsp *ResourceProfile) error {
+	return dDB.write("SetResourceProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetResourceProfileDrv(rsp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveResourceProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveResourceProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveResourceProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetResourceDrv(r *Resource) error {
+	return dDB.write("SetResourceDrv", func(dataDB DataDB) error {
+		return dataDB.SetResourceDrv(r)
+	})
+}
+
+func (dDB *DualDataDB) RemoveResourceDrv(tenant, id string) error {
+	return dDB.write("RemoveResourceDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveResourceDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetIPProfileDrv(ipp *IPProfile) error {
+	return dDB.write("SetIPProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetIPProfileDrv(ipp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveIPProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveIPProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveIPProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetIPAllocationsDrv(ip *IPAllocations) error {
+	return dDB.write("SetIPAllocationsDrv", func(dataDB DataDB) error {
+		return dataDB.SetIPAllocationsDrv(ip)
+	})
+}
+
+func (dDB *DualDataDB) RemoveIPAllocationsDrv(tenant, id string) error {
+	return dDB.write("RemoveIPAllocationsDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveIPAllocationsDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetTimingDrv(t *utils.TPTiming) error {
+	return dDB.write("SetTimingDrv", func(dataDB DataDB) error {
+		return dataDB.SetTimingDrv(t)
+	})
+}
+
+func (dDB *DualDataDB) RemoveTimingDrv(id string) error {
+	return dDB.write("RemoveTimingDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveTimingDrv(id)
+	})
+}
+
+func (dDB *DualDataDB) AddLoadHistory(ldInst *utils.LoadInstance, loadHistSize int, transactionID string) error {
+	return dDB.write("AddLoadHistory", func(dataDB DataDB) error {
+		return dataDB.AddLoadHistory(ldInst, loadHistSize, transactionID)
+	})
+}
+
+func (dDB *DualDataDB) SetIndexesDrv(idxItmType, tntCtx string,
+	indexes map[string]utils.StringSet, commit bool, transactionID string) error {
+	return dDB.write("SetIndexesDrv", func(dataDB DataDB) error {
+		return dataDB.SetIndexesDrv(idxItmType, tntCtx, indexes, commit, transactionID)
+	})
+}
+
+func (dDB *DualDataDB) RemoveIndexesDrv(idxItmType, tntCtx string, idxKeys ...string) error {
+	return dDB.write("RemoveIndexesDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveIndexesDrv(idxItmType, tntCtx, idxKeys...)
+	})
+}
+
+func (dDB *DualDataDB) SetStatQueueProfileDrv(sq *StatQueueProfile) error {
+	return dDB.write("SetStatQueueProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetStatQueueProfileDrv(sq)
+	})
+}
+
+func (dDB *DualDataDB) RemStatQueueProfileDrv(tenant, id string) error {
+	return dDB.write("RemStatQueueProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemStatQueueProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetStatQueueDrv(ssq *StoredStatQueue, sq *StatQueue) error {
+	return dDB.write("SetStatQueueDrv", func(dataDB DataDB) error {
+		return dataDB.SetStatQueueDrv(ssq, sq)
+	})
+}
+
+func (dDB *DualDataDB) RemStatQueueDrv(tenant, id string) error {
+	return dDB.write("RemStatQueueDrv", func(dataDB DataDB) error {
+		return dataDB.RemStatQueueDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetRankingProfileDrv(rgp *RankingProfile) error {
+	return dDB.write("SetRankingProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetRankingProfileDrv(rgp)
+	})
+}
+
+func (dDB *DualDataDB) RemRankingProfileDrv(tenant, id string) error {
+	return dDB.write("RemRankingProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemRankingProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetRankingDrv(rn *Ranking) error {
+	return dDB.write("SetRankingDrv", func(dataDB DataDB) error {
+		return dataDB.SetRankingDrv(rn)
+	})
+}
+
+func (dDB *DualDataDB) RemoveRankingDrv(tenant, id string) error {
+	return dDB.write("RemoveRankingDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveRankingDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetTrendProfileDrv(trp *TrendProfile) error {
+	return dDB.write("SetTrendProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetTrendProfileDrv(trp)
+	})
+}
+
+func (dDB *DualDataDB) RemTrendProfileDrv(tenant, id string) error {
+	return dDB.write("RemTrendProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemTrendProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetTrendDrv(tr *Trend) error {
+	return dDB.write("SetTrendDrv", func(dataDB DataDB) error {
+		return dataDB.SetTrendDrv(tr)
+	})
+}
+
+func (dDB *DualDataDB) RemoveTrendDrv(tenant, id string) error {
+	return dDB.write("RemoveTrendDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveTrendDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetThresholdProfileDrv(tp *ThresholdProfile) error {
+	return dDB.write("SetThresholdProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetThresholdProfileDrv(tp)
+	})
+}
+
+func (dDB *DualDataDB) RemThresholdProfileDrv(tenant, id string) error {
+	return dDB.write("RemThresholdProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemThresholdProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetThresholdDrv(th *Threshold) error {
+	return dDB.write("SetThresholdDrv", func(dataDB DataDB) error {
+		return dataDB.SetThresholdDrv(th)
+	})
+}
+
+func (dDB *DualDataDB) RemoveThresholdDrv(tenant, id string) error {
+	return dDB.write("RemoveThresholdDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveThresholdDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetFilterDrv(fltr *Filter) error {
+	return dDB.write("SetFilterDrv", func(dataDB DataDB) error {
+		return dataDB.SetFilterDrv(fltr)
+	})
+}
+
+func (dDB *DualDataDB) RemoveFilterDrv(tenant, id string) error {
+	return dDB.write("RemoveFilterDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveFilterDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetRouteProfileDrv(rp *RouteProfile) error {
+	return dDB.write("SetRouteProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetRouteProfileDrv(rp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveRouteProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveRouteProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveRouteProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetAttributeProfileDrv(ap *AttributeProfile) error {
+	return dDB.write("SetAttributeProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetAttributeProfileDrv(ap)
+	})
+}
+
+func (dDB *DualDataDB) RemoveAttributeProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveAttributeProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveAttributeProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetChargerProfileDrv(cp *ChargerProfile) error {
+	return dDB.write("SetChargerProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetChargerProfileDrv(cp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveChargerProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveChargerProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveChargerProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetDispatcherProfileDrv(dpp *DispatcherProfile) error {
+	return dDB.write("SetDispatcherProfileDrv", func(dataDB DataDB) error {
+		return dataDB.SetDispatcherProfileDrv(dpp)
+	})
+}
+
+func (dDB *DualDataDB) RemoveDispatcherProfileDrv(tenant, id string) error {
+	return dDB.write("RemoveDispatcherProfileDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveDispatcherProfileDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetLoadIDsDrv(loadIDs map[string]int64) error {
+	return dDB.write("SetLoadIDsDrv", func(dataDB DataDB) error {
+		return dataDB.SetLoadIDsDrv(loadIDs)
+	})
+}
+
+func (dDB *DualDataDB) RemoveLoadIDsDrv() error {
+	return dDB.write("RemoveLoadIDsDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveLoadIDsDrv()
+	})
+}
+
+func (dDB *DualDataDB) SetDispatcherHostDrv(dph *DispatcherHost) error {
+	return dDB.write("SetDispatcherHostDrv", func(dataDB DataDB) error {
+		return dataDB.SetDispatcherHostDrv(dph)
+	})
+}
+
+func (dDB *DualDataDB) RemoveDispatcherHostDrv(tenant, id string) error {
+	return dDB.write("RemoveDispatcherHostDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveDispatcherHostDrv(tenant, id)
+	})
+}
+
+func (dDB *DualDataDB) SetBackupSessionsDrv(nodeID, tenant string, sessions []*StoredSession) error {
+	return dDB.write("SetBackupSessionsDrv", func(dataDB DataDB) error {
+		return dataDB.SetBackupSessionsDrv(nodeID, tenant, sessions)
+	})
+}
+
+func (dDB *DualDataDB) RemoveSessionsBackupDrv(nodeID, tenant, cgrid string) error {
+	return dDB.write("RemoveSessionsBackupDrv", func(dataDB DataDB) error {
+		return dataDB.RemoveSessionsBackupDrv(nodeID, tenant, cgrid)
+	})
+}
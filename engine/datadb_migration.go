@@ -0,0 +1,777 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// migrationItem knows how to move one DataDB item type between backends
+type migrationItem struct {
+	keys func(dataDB DataDB) ([]string, error) // ids without prefix, GetKeysForPrefix if nil
+	get  func(dataDB DataDB, id string) (any, error)
+	set  func(dataDB DataDB, id string, itm any) error
+}
+
+// tntIDMigrationItem returns a migrationItem for the items identified by tenant and ID
+func tntIDMigrationItem(get func(dataDB DataDB, tnt, id string) (any, error),
+	set func(dataDB DataDB, itm any) error) *migrationItem {
+	return &migrationItem{
+		get: func(dataDB DataDB, id string) (any, error) {
+			tntID := utils.NewTenantID(id)
+			return get(dataDB, tntID.Tenant, tntID.ID)
+		},
+		set: func(dataDB DataDB, _ string, itm any) error {
+			return set(dataDB, itm)
+		},
+	}
+}
+
+// filterIndexMigrationItem returns a migrationItem for the filter indexes of idxItmType
+func filterIndexMigrationItem(idxItmType string, split func(string) (string, string, error)) *migrationItem {
+	return &migrationItem{
+		get: func(dataDB DataDB, id string) (any, error) {
+			tntCtx, idxKey, err := split(id)
+			if err != nil {
+				return nil, err
+			}
+			return dataDB.GetIndexesDrv(idxItmType, tntCtx, idxKey)
+		},
+		set: func(dataDB DataDB, id string, itm any) error {
+			tntCtx, _, err := split(id)
+			if err != nil {
+				return err
+			}
+			return dataDB.SetIndexesDrv(idxItmType, tntCtx, itm.(map[string]utils.StringSet),
+				true, utils.NonTransactional)
+		},
+	}
+}
+
+// splitReverseFilterIndex splits the key of the reverse filter indexes
+func splitReverseFilterIndex(id string) (tntCtx, idxKey string, err error) {
+	idx := strings.LastIndexByte(id, utils.InInFieldSep[0])
+	if idx < 0 {
+		return "", "", fmt.Errorf("WRONG_IDX_KEY_FORMAT<%s>", id)
+	}
+	return id[:idx], id[idx+1:], nil
+}
+
+// dataDBMigrationPrefixes is the order in which the items are copied
+var dataDBMigrationPrefixes = []string{
+	utils.VersionPrefix,
+	utils.DestinationPrefix, utils.ReverseDestinationPrefix, utils.PortedNumberPrefix,
+	utils.LookupTablePrefix, utils.DiscountProfilePrefix, utils.FraudProfilePrefix, utils.FraudCasePrefix,
+	utils.TimingsPrefix, utils.RatingPlanPrefix,
+	utils.RatingProfilePrefix, utils.ActionPrefix, utils.ActionPlanPrefix,
+	utils.AccountActionPlansPrefix, utils.ActionTriggerPrefix, utils.SharedGroupPrefix,
+	utils.AccountPrefix, utils.FilterPrefix,
+	utils.ResourceProfilesPrefix, utils.ResourcesPrefix,
+	utils.IPProfilesPrefix, utils.IPAllocationsPrefix,
+	utils.StatQueueProfilePrefix, utils.StatQueuePrefix,
+	utils.ThresholdProfilePrefix, utils.ThresholdPrefix,
+	utils.RankingsProfilePrefix, utils.RankingPrefix,
+	utils.TrendsProfilePrefix, utils.TrendPrefix,
+	utils.RouteProfilePrefix, utils.AttributeProfilePrefix, utils.ChargerProfilePrefix,
+	utils.DispatcherProfilePrefix, utils.DispatcherHostPrefix,
+	utils.AttributeFilterIndexes, utils.ResourceFilterIndexes, utils.IPFilterIndexes,
+	utils.StatFilterIndexes, utils.ThresholdFilterIndexes, utils.RouteFilterIndexes,
+	utils.ChargerFilterIndexes, utils.DispatcherFilterIndexes, utils.FraudFilterIndexes,
+	utils.DiscountFilterIndexes, utils.FilterIndexPrfx,
+	utils.LoadIDPrefix,
+}
+
+// dataDBMigrationItems contains the migrationItem for each prefix in dataDBMigrationPrefixes
+var dataDBMigrationItems = map[string]*migrationItem{
+	utils.VersionPrefix: {
+		keys: func(dataDB DataDB) ([]string, error) {
+			if _, err := dataDB.GetVersions(utils.EmptyString); err != nil {
+				if err == utils.ErrNotFound {
+					err = nil
+				}
+				return nil, err
+			}
+			return []string{utils.VersionName}, nil
+		},
+		get: func(dataDB DataDB, _ string) (any, error) { return dataDB.GetVersions(utils.EmptyString) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetVersions(itm.(Versions), true) },
+	},
+	utils.DestinationPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) {
+			return dataDB.GetDestinationDrv(id, utils.NonTransactional)
+		},
+		set: func(dataDB DataDB, _ string, itm any) error {
+			return dataDB.SetDestinationDrv(itm.(*Destination), utils.NonTransactional)
+		},
+	},
+	utils.ReverseDestinationPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) {
+			ids, err := dataDB.GetReverseDestinationDrv(id, utils.NonTransactional)
+			if err != nil {
+				return nil, err
+			}
+			ids = slices.Clone(ids)
+			slices.Sort(ids) // the order depends on the backend
+			return ids, nil
+		},
+		set: func(dataDB DataDB, id string, itm any) (err error) {
+			for _, destID := range itm.([]string) {
+				if err = dataDB.SetReverseDestinationDrv(destID, []string{id}, utils.NonTransactional); err != nil {
+					return
+				}
+			}
+			return
+		},
+	},
+	utils.PortedNumberPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetPortedNumberDrv(id) },
+		set: func(dataDB DataDB, id string, itm any) error { return dataDB.SetPortedNumberDrv(id, itm.(string)) },
+	},
+	utils.LookupTablePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetLookupTableDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetLookupTableDrv(itm.(*LookupTable)) }),
+	utils.DiscountProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetDiscountProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetDiscountProfileDrv(itm.(*DiscountProfile)) }),
+	utils.FraudProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetFraudProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetFraudProfileDrv(itm.(*FraudProfile)) }),
+	utils.FraudCasePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetFraudCaseDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetFraudCaseDrv(itm.(*FraudCase)) }),
+	utils.TimingsPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetTimingDrv(id) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetTimingDrv(itm.(*utils.TPTiming)) },
+	},
+	utils.RatingPlanPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetRatingPlanDrv(id) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetRatingPlanDrv(itm.(*RatingPlan)) },
+	},
+	utils.RatingProfilePrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetRatingProfileDrv(id) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetRatingProfileDrv(itm.(*RatingProfile)) },
+	},
+	utils.ActionPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetActionsDrv(id) },
+		set: func(dataDB DataDB, id string, itm any) error { return dataDB.SetActionsDrv(id, itm.(Actions)) },
+	},
+	utils.ActionPlanPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetActionPlanDrv(id) },
+		set: func(dataDB DataDB, id string, itm any) error { return dataDB.SetActionPlanDrv(id, itm.(*ActionPlan)) },
+	},
+	utils.AccountActionPlansPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetAccountActionPlansDrv(id) },
+		set: func(dataDB DataDB, id string, itm any) error {
+			return dataDB.SetAccountActionPlansDrv(id, itm.([]string))
+		},
+	},
+	utils.ActionTriggerPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetActionTriggersDrv(id) },
+		set: func(dataDB DataDB, id string, itm any) error {
+			return dataDB.SetActionTriggersDrv(id, itm.(ActionTriggers))
+		},
+	},
+	utils.SharedGroupPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetSharedGroupDrv(id) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetSharedGroupDrv(itm.(*SharedGroup)) },
+	},
+	utils.AccountPrefix: {
+		get: func(dataDB DataDB, id string) (any, error) { return dataDB.GetAccountDrv(id) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetAccountDrv(itm.(*Account)) },
+	},
+	utils.FilterPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetFilterDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetFilterDrv(itm.(*Filter)) }),
+	utils.ResourceProfilesPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetResourceProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetResourceProfileDrv(itm.(*ResourceProfile)) }),
+	utils.ResourcesPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetResourceDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetResourceDrv(itm.(*Resource)) }),
+	utils.IPProfilesPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetIPProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetIPProfileDrv(itm.(*IPProfile)) }),
+	utils.IPAllocationsPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetIPAllocationsDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetIPAllocationsDrv(itm.(*IPAllocations)) }),
+	utils.StatQueueProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetStatQueueProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetStatQueueProfileDrv(itm.(*StatQueueProfile)) }),
+	utils.StatQueuePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetStatQueueDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetStatQueueDrv(nil, itm.(*StatQueue)) }),
+	utils.ThresholdProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetThresholdProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetThresholdProfileDrv(itm.(*ThresholdProfile)) }),
+	utils.ThresholdPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetThresholdDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetThresholdDrv(itm.(*Threshold)) }),
+	utils.RankingsProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetRankingProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetRankingProfileDrv(itm.(*RankingProfile)) }),
+	utils.RankingPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetRankingDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetRankingDrv(itm.(*Ranking)) }),
+	utils.TrendsProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetTrendProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetTrendProfileDrv(itm.(*TrendProfile)) }),
+	utils.TrendPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetTrendDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetTrendDrv(itm.(*Trend)) }),
+	utils.RouteProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetRouteProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetRouteProfileDrv(itm.(*RouteProfile)) }),
+	utils.AttributeProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetAttributeProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetAttributeProfileDrv(itm.(*AttributeProfile)) }),
+	utils.ChargerProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetChargerProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetChargerProfileDrv(itm.(*ChargerProfile)) }),
+	utils.DispatcherProfilePrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetDispatcherProfileDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetDispatcherProfileDrv(itm.(*DispatcherProfile)) }),
+	utils.DispatcherHostPrefix: tntIDMigrationItem(
+		func(dataDB DataDB, tnt, id string) (any, error) { return dataDB.GetDispatcherHostDrv(tnt, id) },
+		func(dataDB DataDB, itm any) error { return dataDB.SetDispatcherHostDrv(itm.(*DispatcherHost)) }),
+	utils.AttributeFilterIndexes:  filterIndexMigrationItem(utils.CacheAttributeFilterIndexes, splitFilterIndex),
+	utils.ResourceFilterIndexes:   filterIndexMigrationItem(utils.CacheResourceFilterIndexes, splitFilterIndex),
+	utils.IPFilterIndexes:         filterIndexMigrationItem(utils.CacheIPFilterIndexes, splitFilterIndex),
+	utils.StatFilterIndexes:       filterIndexMigrationItem(utils.CacheStatFilterIndexes, splitFilterIndex),
+	utils.ThresholdFilterIndexes:  filterIndexMigrationItem(utils.CacheThresholdFilterIndexes, splitFilterIndex),
+	utils.RouteFilterIndexes:      filterIndexMigrationItem(utils.CacheRouteFilterIndexes, splitFilterIndex),
+	utils.ChargerFilterIndexes:    filterIndexMigrationItem(utils.CacheChargerFilterIndexes, splitFilterIndex),
+	utils.DispatcherFilterIndexes: filterIndexMigrationItem(utils.CacheDispatcherFilterIndexes, splitFilterIndex),
+	utils.FraudFilterIndexes:      filterIndexMigrationItem(utils.CacheFraudFilterIndexes, splitFilterIndex),
+	utils.DiscountFilterIndexes:   filterIndexMigrationItem(utils.CacheDiscountFilterIndexes, splitFilterIndex),
+	utils.FilterIndexPrfx:         filterIndexMigrationItem(utils.CacheReverseFilterIndexes, splitReverseFilterIndex),
+	utils.LoadIDPrefix: {
+		keys: func(dataDB DataDB) ([]string, error) {
+			if loadIDs, err := dataDB.GetItemLoadIDsDrv(utils.EmptyString); err != nil {
+				if err == utils.ErrNotFound {
+					err = nil
+				}
+				return nil, err
+			} else if len(loadIDs) == 0 {
+				return nil, nil
+			}
+			return []string{utils.EmptyString}, nil
+		},
+		get: func(dataDB DataDB, _ string) (any, error) { return dataDB.GetItemLoadIDsDrv(utils.EmptyString) },
+		set: func(dataDB DataDB, _ string, itm any) error { return dataDB.SetLoadIDsDrv(itm.(map[string]int64)) },
+	},
+}
+
+// migrationKeys returns the sorted ids, without prefix, of the items found in dataDB
+func migrationKeys(dataDB DataDB, prfx string) (ids []string, err error) {
+	itm := dataDBMigrationItems[prfx]
+	if itm.keys != nil {
+		return itm.keys(dataDB)
+	}
+	var keys []string
+	if keys, err = dataDB.GetKeysForPrefix(prfx, utils.EmptyString); err != nil {
+		return
+	}
+	ids = make([]string, len(keys))
+	for i, key := range keys {
+		ids[i] = strings.TrimPrefix(key, prfx)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// DataDBMigrationStatus is the progress of the online DataDB migration
+type DataDBMigrationStatus struct {
+	StartTime       time.Time
+	Copying         bool              // the background copier is running
+	Copied          bool              // all the existing keys were copied
+	CopyError       string            // the error which stopped the copier
+	Checkpoints     map[string]string // last id copied for each prefix
+	CopiedKeys      map[string]int    // number of keys copied for each prefix
+	SecondaryErrors int64             // failed writes on the secondary DataDB
+	ReadsSwitched   bool              // reads are served by the destination DataDB
+	LastVerify      *DataDBMigrationReport
+}
+
+// DataDBMigrationItemReport compares one item type between the two DataDBs
+type DataDBMigrationItemReport struct {
+	SourceCount         int
+	DestinationCount    int
+	SourceChecksum      string
+	DestinationChecksum string
+	Consistent          bool
+}
+
+// DataDBMigrationReport is the result of the migration verifier
+type DataDBMigrationReport struct {
+	VerifyTime time.Time
+	Consistent bool
+	Items      map[string]*DataDBMigrationItemReport
+}
+
+// dataDBMigrationStoreInterval is the number of copied keys after which the checkpoints are stored
+var dataDBMigrationStoreInterval = 1000
+
+// DataDBMigrationConn is the connection to the DataDB we migrate to
+type DataDBMigrationConn struct {
+	DBType     string
+	DBHost     string
+	DBPort     string
+	DBName     string
+	DBUser     string
+	DBPassword string
+}
+
+// connect opens the DataDB with the encoding and the options of the configured one
+func (conn *DataDBMigrationConn) connect(cfg *config.CGRConfig) (DataDB, error) {
+	return NewDataDBConn(conn.DBType, conn.DBHost, conn.DBPort,
+		conn.DBName, conn.DBUser, conn.DBPassword, cfg.GeneralCfg().DBDataEncoding,
+		cfg.DataDbCfg().Opts, cfg.DataDbCfg().Items)
+}
+
+// StoredDataDBMigration is the state of the migration kept in the source DataDB
+// so the engine resumes it after a restart
+type StoredDataDBMigration struct {
+	Destination   *DataDBMigrationConn
+	StartTime     time.Time
+	Checkpoints   map[string]string
+	CopiedKeys    map[string]int
+	ReadsSwitched bool
+}
+
+// DataDBMigration moves the data from the current DataDB of the DataManager
+// into a new one without downtime: the DataManager writes into both while a
+// background copier syncs the existing keys
+type DataDBMigration struct {
+	sync.RWMutex
+	dm       *DataManager
+	cfg      *config.CGRConfig
+	conn     *DataDBMigrationConn
+	dual     *DualDataDB
+	src      DataDB // the DataDB in use when the migration started
+	dst      DataDB
+	status   *DataDBMigrationStatus
+	stopChan chan struct{}
+	stopOnce *sync.Once // closes stopChan only once for the concurrent stops
+	copyWg   sync.WaitGroup
+	storeMux sync.Mutex // keeps the stored state in the order of the changes
+}
+
+// StartDataDBMigration connects to the DataDB of conn and starts writing in both
+// DataDBs while copying the existing keys. If a migration is already in progress
+// its stopped copier is resumed from the last checkpoints
+func (dm *DataManager) StartDataDBMigration(cfg *config.CGRConfig, conn *DataDBMigrationConn) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	dm = dm.rootDM()
+	dm.migMux.Lock()
+	defer dm.migMux.Unlock()
+	if dm.migration != nil {
+		return dm.migration.startCopy()
+	}
+	if conn == nil {
+		return utils.ErrNotFound
+	}
+	var dst DataDB
+	if dst, err = conn.connect(cfg); err != nil {
+		return
+	}
+	mig := newDataDBMigration(dm, cfg, conn, dst, &StoredDataDBMigration{StartTime: time.Now()})
+	if err = mig.store(); err != nil {
+		dst.Close()
+		return
+	}
+	dm.migration = mig
+	dm.setDataDB(mig.dual)
+	return mig.startCopy()
+}
+
+// ResumeDataDBMigration resumes the migration stored in the DataDB by a previous
+// run of the engine, utils.ErrNotFound if there is none
+func (dm *DataManager) ResumeDataDBMigration(cfg *config.CGRConfig) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	dm = dm.rootDM()
+	dm.migMux.Lock()
+	defer dm.migMux.Unlock()
+	if dm.migration != nil {
+		return utils.ErrExists
+	}
+	var stored *StoredDataDBMigration
+	if stored, err = dm.db().GetDataDBMigrationDrv(); err != nil {
+		return
+	}
+	var dst DataDB
+	if dst, err = stored.Destination.connect(cfg); err != nil {
+		return
+	}
+	mig := newDataDBMigration(dm, cfg, stored.Destination, dst, stored)
+	dm.migration = mig
+	dm.setDataDB(mig.dual)
+	return mig.startCopy()
+}
+
+// newDataDBMigration returns the migration from the current DataDB of dm into dst,
+// continuing from the stored state
+func newDataDBMigration(dm *DataManager, cfg *config.CGRConfig, conn *DataDBMigrationConn,
+	dst DataDB, stored *StoredDataDBMigration) (mig *DataDBMigration) {
+	src := dm.db()
+	mig = &DataDBMigration{
+		dm:   dm,
+		cfg:  cfg,
+		conn: conn,
+		dual: NewDualDataDB(src, dst),
+		src:  src,
+		dst:  dst,
+		status: &DataDBMigrationStatus{
+			StartTime:     stored.StartTime,
+			Checkpoints:   make(map[string]string),
+			CopiedKeys:    make(map[string]int),
+			ReadsSwitched: stored.ReadsSwitched,
+		},
+	}
+	maps.Copy(mig.status.Checkpoints, stored.Checkpoints)
+	maps.Copy(mig.status.CopiedKeys, stored.CopiedKeys)
+	if stored.ReadsSwitched {
+		mig.dual = mig.dual.swapped()
+	}
+	return
+}
+
+// store saves the state of the migration in the source DataDB
+func (mig *DataDBMigration) store() error {
+	mig.storeMux.Lock()
+	defer mig.storeMux.Unlock()
+	mig.RLock()
+	stored := &StoredDataDBMigration{
+		Destination:   mig.conn,
+		StartTime:     mig.status.StartTime,
+		Checkpoints:   maps.Clone(mig.status.Checkpoints),
+		CopiedKeys:    maps.Clone(mig.status.CopiedKeys),
+		ReadsSwitched: mig.status.ReadsSwitched,
+	}
+	mig.RUnlock()
+	return mig.src.SetDataDBMigrationDrv(stored)
+}
+
+// DataDBMigration returns the migration in progress
+func (dm *DataManager) DataDBMigration() (*DataDBMigration, error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	dm = dm.rootDM()
+	dm.migMux.RLock()
+	defer dm.migMux.RUnlock()
+	if dm.migration == nil {
+		return nil, utils.ErrNotFound
+	}
+	return dm.migration, nil
+}
+
+// startCopy starts the background copier if not already running
+func (mig *DataDBMigration) startCopy() error {
+	mig.Lock()
+	defer mig.Unlock()
+	if mig.status.Copying {
+		return utils.ErrExists
+	}
+	if mig.status.ReadsSwitched { // nothing left to copy
+		return nil
+	}
+	mig.status.Copying = true
+	mig.status.CopyError = utils.EmptyString
+	mig.stopChan = make(chan struct{})
+	mig.stopOnce = new(sync.Once)
+	mig.copyWg.Add(1)
+	go mig.copyKeys(mig.stopChan)
+	return nil
+}
+
+// stopCopy stops the background copier and waits for it to exit
+func (mig *DataDBMigration) stopCopy() {
+	mig.Lock()
+	if mig.status.Copying {
+		stopChan := mig.stopChan
+		mig.stopOnce.Do(func() { close(stopChan) })
+	}
+	mig.Unlock()
+	mig.copyWg.Wait()
+}
+
+// copyKeys copies the keys of all item types, starting after the checkpoints
+func (mig *DataDBMigration) copyKeys(stopChan chan struct{}) {
+	defer mig.copyWg.Done()
+	err := mig.copyPrefixes(stopChan)
+	if storeErr := mig.store(); storeErr != nil && err == nil {
+		err = fmt.Errorf("storing the checkpoints: %s", storeErr)
+	}
+	mig.Lock()
+	mig.status.Copying = false
+	if err != nil {
+		mig.status.CopyError = err.Error()
+		utils.Logger.Warning(fmt.Sprintf("<%s> DataDB migration copier stopped: %s",
+			utils.DataManager, err))
+	} else {
+		select {
+		case <-stopChan:
+		default:
+			mig.status.Copied = true
+		}
+	}
+	mig.Unlock()
+}
+
+func (mig *DataDBMigration) copyPrefixes(stopChan chan struct{}) (err error) {
+	var copied int
+	for _, prfx := range dataDBMigrationPrefixes {
+		var ids []string
+		if ids, err = migrationKeys(mig.src, prfx); err != nil {
+			return fmt.Errorf("querying keys for prefix <%s>: %s", prfx, err)
+		}
+		mig.RLock()
+		checkpoint, hasCheckpoint := mig.status.Checkpoints[prfx]
+		mig.RUnlock()
+		for _, id := range ids {
+			if hasCheckpoint && id <= checkpoint {
+				continue
+			}
+			select {
+			case <-stopChan:
+				return
+			default:
+			}
+			if err = mig.copyKey(prfx, id); err != nil {
+				return fmt.Errorf("copying <%s>: %s", prfx+id, err)
+			}
+			mig.Lock()
+			mig.status.Checkpoints[prfx] = id
+			mig.status.CopiedKeys[prfx]++
+			mig.Unlock()
+			if copied++; copied%dataDBMigrationStoreInterval == 0 {
+				if err = mig.store(); err != nil {
+					return fmt.Errorf("storing the checkpoints: %s", err)
+				}
+			}
+		}
+	}
+	return
+}
+
+// copyKey copies one key while blocking the writes so the live updates are not overwritten
+func (mig *DataDBMigration) copyKey(prfx, id string) (err error) {
+	itm := dataDBMigrationItems[prfx]
+	mig.dual.state.Lock()
+	defer mig.dual.state.Unlock()
+	var val any
+	if val, err = itm.get(mig.src, id); err != nil {
+		if err == utils.ErrNotFound { // removed since we got the keys
+			err = nil
+		}
+		return
+	}
+	return itm.set(mig.dst, id, val)
+}
+
+// Status returns a snapshot of the migration progress
+func (mig *DataDBMigration) Status() (status *DataDBMigrationStatus) {
+	mig.RLock()
+	defer mig.RUnlock()
+	status = &DataDBMigrationStatus{
+		StartTime:       mig.status.StartTime,
+		Copying:         mig.status.Copying,
+		Copied:          mig.status.Copied,
+		CopyError:       mig.status.CopyError,
+		Checkpoints:     make(map[string]string, len(mig.status.Checkpoints)),
+		CopiedKeys:      make(map[string]int, len(mig.status.CopiedKeys)),
+		SecondaryErrors: mig.dual.SecondaryErrors(),
+		ReadsSwitched:   mig.status.ReadsSwitched,
+		LastVerify:      mig.status.LastVerify,
+	}
+	for prfx, id := range mig.status.Checkpoints {
+		status.Checkpoints[prfx] = id
+	}
+	for prfx, cnt := range mig.status.CopiedKeys {
+		status.CopiedKeys[prfx] = cnt
+	}
+	return
+}
+
+// Verify compares the number of keys and their checksums for each item type.
+// The writes are not blocked so a report computed during heavy traffic can
+// show differences which are gone on the next run
+func (mig *DataDBMigration) Verify() (rpl *DataDBMigrationReport, err error) {
+	rpl = &DataDBMigrationReport{
+		VerifyTime: time.Now(),
+		Consistent: true,
+		Items:      make(map[string]*DataDBMigrationItemReport),
+	}
+	for _, prfx := range dataDBMigrationPrefixes {
+		itmRpl := new(DataDBMigrationItemReport)
+		if itmRpl.SourceCount, itmRpl.SourceChecksum, err = migrationChecksum(mig.src, prfx); err != nil {
+			return nil, err
+		}
+		if itmRpl.DestinationCount, itmRpl.DestinationChecksum, err = migrationChecksum(mig.dst, prfx); err != nil {
+			return nil, err
+		}
+		itmRpl.Consistent = itmRpl.SourceCount == itmRpl.DestinationCount &&
+			itmRpl.SourceChecksum == itmRpl.DestinationChecksum
+		if !itmRpl.Consistent {
+			rpl.Consistent = false
+		}
+		if itmRpl.SourceCount == 0 && itmRpl.DestinationCount == 0 {
+			continue
+		}
+		rpl.Items[prfx] = itmRpl
+	}
+	mig.Lock()
+	mig.status.LastVerify = rpl
+	mig.Unlock()
+	return
+}
+
+// migrationChecksum returns the number of keys and the checksum of the items with prfx
+func migrationChecksum(dataDB DataDB, prfx string) (cnt int, checksum string, err error) {
+	var ids []string
+	if ids, err = migrationKeys(dataDB, prfx); err != nil {
+		return
+	}
+	hsh := sha256.New()
+	for _, id := range ids {
+		var val any
+		if val, err = dataDBMigrationItems[prfx].get(dataDB, id); err != nil {
+			if err != utils.ErrNotFound { // removed since we got the keys
+				return
+			}
+			err = nil
+			continue
+		}
+		var b []byte
+		if b, err = json.Marshal(val); err != nil {
+			return
+		}
+		cnt++
+		hsh.Write([]byte(id))
+		hsh.Write([]byte{0})
+		hsh.Write(b)
+		hsh.Write([]byte{'\n'})
+	}
+	return cnt, hex.EncodeToString(hsh.Sum(nil)), nil
+}
+
+// SwitchReads makes the DataManager read from the destination DataDB. Unless
+// forced, the copy needs to be completed and the last verify consistent.
+// The writes continue to be mirrored on the source DataDB until Finish
+func (mig *DataDBMigration) SwitchReads(force bool) (err error) {
+	mig.dm.migMux.Lock()
+	defer mig.dm.migMux.Unlock()
+	if mig.dm.migration != mig { // ended meanwhile
+		return utils.ErrNotFound
+	}
+	mig.Lock()
+	if mig.status.ReadsSwitched {
+		mig.Unlock()
+		return
+	}
+	if !force {
+		if !mig.status.Copied {
+			mig.Unlock()
+			return fmt.Errorf("copy not completed")
+		}
+		if mig.status.LastVerify == nil || !mig.status.LastVerify.Consistent {
+			mig.Unlock()
+			return utils.ErrNotConsistent
+		}
+	}
+	mig.dual = mig.dual.swapped()
+	mig.dm.setDataDB(mig.dual)
+	mig.status.ReadsSwitched = true
+	mig.Unlock()
+	return mig.store()
+}
+
+// Finish stops mirroring the writes, makes the destination the configured
+// DataDB and closes the source one
+func (mig *DataDBMigration) Finish() (err error) {
+	mig.RLock()
+	switched := mig.status.ReadsSwitched
+	mig.RUnlock()
+	if !switched {
+		return fmt.Errorf("reads not switched")
+	}
+	mig.stopCopy()
+	mig.dm.migMux.Lock()
+	defer mig.dm.migMux.Unlock()
+	if mig.dm.migration != mig { // finished or aborted meanwhile
+		return utils.ErrNotFound
+	}
+	mig.dm.migration = nil
+	mig.dual.state.Lock() // wait for the writes in progress
+	mig.dm.setDataDB(mig.dst)
+	mig.dual.state.Unlock()
+	mig.removeStored()
+	mig.switchConfig()
+	mig.src.Close()
+	return
+}
+
+// Abort stops the migration and returns to the source DataDB
+func (mig *DataDBMigration) Abort() (err error) {
+	mig.stopCopy()
+	mig.dm.migMux.Lock()
+	defer mig.dm.migMux.Unlock()
+	if mig.dm.migration != mig { // finished or aborted meanwhile
+		return utils.ErrNotFound
+	}
+	mig.dm.migration = nil
+	mig.dual.state.Lock() // wait for the writes in progress
+	mig.dm.setDataDB(mig.src)
+	mig.dual.state.Unlock()
+	mig.removeStored()
+	mig.dst.Close()
+	return
+}
+
+// removeStored removes the state of the ended migration so it is not resumed at the next start
+func (mig *DataDBMigration) removeStored() {
+	if err := mig.src.RemoveDataDBMigrationDrv(); err != nil && err != utils.ErrNotFound {
+		utils.Logger.Warning(fmt.Sprintf("<%s> removing the DataDB migration state: %s",
+			utils.DataManager, err))
+	}
+}
+
+// switchConfig makes the destination the configured DataDB so the reconnects use it
+func (mig *DataDBMigration) switchConfig() {
+	dbCfg := mig.cfg.DataDbCfg()
+	mig.cfg.LockSections(config.DATADB_JSN)
+	dbCfg.Type, dbCfg.Host, dbCfg.Port = mig.conn.DBType, mig.conn.DBHost, mig.conn.DBPort
+	dbCfg.Name, dbCfg.User, dbCfg.Password = mig.conn.DBName, mig.conn.DBUser, mig.conn.DBPassword
+	mig.cfg.UnlockSections(config.DATADB_JSN)
+}
@@ -0,0 +1,348 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func waitDataDBMigrationCopy(t *testing.T, mig *DataDBMigration) {
+	t.Helper()
+	for range 100 {
+		if status := mig.Status(); !status.Copying {
+			if status.CopyError != utils.EmptyString {
+				t.Fatal(status.CopyError)
+			}
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("copy not finished")
+}
+
+func TestDataDBMigration(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	src, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmMig := NewDataManager(src, cfg.CacheCfg(), nil)
+	if err = dmMig.DataDB().SetVersions(CurrentDataDBVersions(), true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmMig.SetDestination(&Destination{Id: "DST_1001", Prefixes: []string{"1001", "1002"}},
+		utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmMig.SetReverseDestination("DST_1001", []string{"1001", "1002"},
+		utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	fltr := &Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_1",
+		Rules: []*FilterRule{{
+			Type:    utils.MetaString,
+			Element: "~*req.Account",
+			Values:  []string{"1001"},
+		}},
+	}
+	if err = fltr.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmMig.SetFilter(fltr, true); err != nil {
+		t.Fatal(err)
+	}
+	attrPrf := &AttributeProfile{
+		Tenant:    "cgrates.org",
+		ID:        "ATTR_1",
+		Contexts:  []string{utils.MetaAny},
+		FilterIDs: []string{"FLTR_1"},
+		Attributes: []*Attribute{{
+			Path:  utils.MetaReq + utils.NestingSep + utils.Subject,
+			Type:  utils.MetaConstant,
+			Value: config.NewRSRParsersMustCompile("1002", utils.InfieldSep),
+		}},
+	}
+	if err = dmMig.SetAttributeProfile(attrPrf, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmMig.SetLoadIDs(map[string]int64{utils.CacheAttributeProfiles: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = dmMig.DataDBMigration(); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err = dmMig.StartDataDBMigration(cfg, &DataDBMigrationConn{DBType: utils.MetaInternal}); err != nil {
+		t.Fatal(err)
+	}
+	mig, err := dmMig.DataDBMigration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := mig.dst
+	waitDataDBMigrationCopy(t, mig)
+	if stored, err := src.GetDataDBMigrationDrv(); err != nil {
+		t.Error(err)
+	} else if status := mig.Status(); !reflect.DeepEqual(stored.Checkpoints, status.Checkpoints) ||
+		stored.Destination.DBType != utils.MetaInternal {
+		t.Errorf("Expected checkpoints %s, received %s", utils.ToJSON(status.Checkpoints), utils.ToJSON(stored))
+	}
+	if status := mig.Status(); !status.Copied {
+		t.Errorf("Expected the copy to be completed, received %s", utils.ToJSON(status))
+	}
+	if err = mig.SwitchReads(false); err != utils.ErrNotConsistent {
+		t.Errorf("Expected %v, received %v", utils.ErrNotConsistent, err)
+	}
+
+	// written in both DataDBs
+	fltr2 := &Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_2",
+		Rules: []*FilterRule{{
+			Type:    utils.MetaPrefix,
+			Element: "~*req.Destination",
+			Values:  []string{"+49"},
+		}},
+	}
+	if err = fltr2.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmMig.SetFilter(fltr2, true); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := dst.GetFilterDrv("cgrates.org", "FLTR_2"); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(utils.ToJSON(fltr2), utils.ToJSON(rcv)) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(fltr2), utils.ToJSON(rcv))
+	}
+	rpl, err := mig.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rpl.Consistent {
+		t.Fatalf("Expected consistent DataDBs, received %s", utils.ToJSON(rpl))
+	}
+	for _, prfx := range []string{utils.VersionPrefix, utils.DestinationPrefix,
+		utils.ReverseDestinationPrefix, utils.FilterPrefix, utils.AttributeProfilePrefix,
+		utils.AttributeFilterIndexes, utils.FilterIndexPrfx, utils.LoadIDPrefix} {
+		if itm, has := rpl.Items[prfx]; !has {
+			t.Errorf("Expected %q in report, received %s", prfx, utils.ToJSON(rpl))
+		} else if itm.SourceCount == 0 {
+			t.Errorf("Expected keys for %q, received %s", prfx, utils.ToJSON(itm))
+		}
+	}
+
+	// a difference written only in the source breaks the consistency
+	if err = src.SetTimingDrv(&utils.TPTiming{ID: "TM_1", StartTime: "00:00:00"}); err != nil {
+		t.Fatal(err)
+	}
+	if rpl, err = mig.Verify(); err != nil {
+		t.Fatal(err)
+	} else if rpl.Consistent || rpl.Items[utils.TimingsPrefix].Consistent {
+		t.Errorf("Expected inconsistent timings, received %s", utils.ToJSON(rpl))
+	}
+	if err = src.RemoveTimingDrv("TM_1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = mig.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = mig.Finish(); err == nil {
+		t.Error("Expected error when finishing before switching the reads")
+	}
+	if err = mig.SwitchReads(false); err != nil {
+		t.Fatal(err)
+	}
+	if dual, canCast := dmMig.DataDB().(*DualDataDB); !canCast {
+		t.Errorf("Expected *DualDataDB, received %T", dmMig.DataDB())
+	} else if dual.Primary() != dst {
+		t.Error("Expected the reads from the destination DataDB")
+	}
+	if err = mig.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	if dmMig.DataDB() != dst {
+		t.Errorf("Expected the destination DataDB, received %T", dmMig.DataDB())
+	}
+	if _, err = dmMig.DataDBMigration(); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if _, err = src.GetDataDBMigrationDrv(); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if cfg.DataDbCfg().Type != utils.MetaInternal {
+		t.Errorf("Expected the configured DataDB %s, received %s", utils.MetaInternal, cfg.DataDbCfg().Type)
+	}
+}
+
+func TestDataDBMigrationAbort(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	src, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmMig := NewDataManager(src, cfg.CacheCfg(), nil)
+	if err = dmMig.StartDataDBMigration(cfg, &DataDBMigrationConn{DBType: utils.MetaInternal}); err != nil {
+		t.Fatal(err)
+	}
+	mig, err := dmMig.DataDBMigration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitDataDBMigrationCopy(t, mig)
+	// resuming a completed copy has nothing more to do
+	if err = dmMig.StartDataDBMigration(cfg, nil); err != nil {
+		t.Fatal(err)
+	}
+	waitDataDBMigrationCopy(t, mig)
+	if err = mig.SwitchReads(true); err != nil {
+		t.Fatal(err)
+	}
+	if err = mig.Abort(); err != nil {
+		t.Fatal(err)
+	}
+	if err = mig.Abort(); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if dmMig.DataDB() != src {
+		t.Errorf("Expected the source DataDB, received %T", dmMig.DataDB())
+	}
+	if _, err = src.GetDataDBMigrationDrv(); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if cfg.DataDbCfg().Type != utils.MetaRedis {
+		t.Errorf("Expected the configured DataDB %s, received %s", utils.MetaRedis, cfg.DataDbCfg().Type)
+	}
+}
+
+func TestDataDBMigrationResume(t *testing.T) {
+	defer func(intvl int) { dataDBMigrationStoreInterval = intvl }(dataDBMigrationStoreInterval)
+	dataDBMigrationStoreInterval = 1
+	cfg := config.NewDefaultCGRConfig()
+	src, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmMig := NewDataManager(src, cfg.CacheCfg(), nil)
+	if err = dmMig.SetDestination(&Destination{Id: "DST_1001", Prefixes: []string{"1001"}},
+		utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmMig.StartDataDBMigration(cfg, &DataDBMigrationConn{DBType: utils.MetaInternal}); err != nil {
+		t.Fatal(err)
+	}
+	mig, err := dmMig.DataDBMigration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitDataDBMigrationCopy(t, mig)
+	if err = mig.SwitchReads(true); err != nil {
+		t.Fatal(err)
+	}
+	status := mig.Status()
+
+	// the engine restarted on the source DataDB
+	dmRst := NewDataManager(src, cfg.CacheCfg(), nil)
+	if err = dmRst.ResumeDataDBMigration(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err = dmRst.ResumeDataDBMigration(cfg); err != utils.ErrExists {
+		t.Errorf("Expected %v, received %v", utils.ErrExists, err)
+	}
+	migRst, err := dmRst.DataDBMigration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rcv := migRst.Status(); !rcv.ReadsSwitched ||
+		!reflect.DeepEqual(rcv.Checkpoints, status.Checkpoints) ||
+		!reflect.DeepEqual(rcv.CopiedKeys, status.CopiedKeys) ||
+		!rcv.StartTime.Equal(status.StartTime) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(status), utils.ToJSON(rcv))
+	}
+	if dual, canCast := dmRst.DataDB().(*DualDataDB); !canCast {
+		t.Errorf("Expected *DualDataDB, received %T", dmRst.DataDB())
+	} else if dual.Primary() != migRst.dst {
+		t.Error("Expected the reads from the destination DataDB")
+	}
+	if err = migRst.Abort(); err != nil {
+		t.Fatal(err)
+	}
+	if err = NewDataManager(src, cfg.CacheCfg(), nil).ResumeDataDBMigration(cfg); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestDataDBMigrationFinishAbortConcurrent(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	src, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dmMig := NewDataManager(src, cfg.CacheCfg(), nil)
+	if err = dmMig.StartDataDBMigration(cfg, &DataDBMigrationConn{DBType: utils.MetaInternal}); err != nil {
+		t.Fatal(err)
+	}
+	mig, err := dmMig.DataDBMigration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = mig.SwitchReads(true); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	var finishErr, abortErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		finishErr = mig.Finish()
+	}()
+	go func() {
+		defer wg.Done()
+		abortErr = mig.Abort()
+	}()
+	wg.Wait()
+	switch {
+	case finishErr == nil && abortErr == utils.ErrNotFound:
+		if dmMig.DataDB() != mig.dst {
+			t.Errorf("Expected the destination DataDB, received %T", dmMig.DataDB())
+		}
+	case abortErr == nil && finishErr == utils.ErrNotFound:
+		if dmMig.DataDB() != src {
+			t.Errorf("Expected the source DataDB, received %T", dmMig.DataDB())
+		}
+	default:
+		t.Errorf("Expected only one of Finish and Abort to end the migration, received %v and %v",
+			finishErr, abortErr)
+	}
+	if _, err = dmMig.DataDBMigration(); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err = mig.SwitchReads(true); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
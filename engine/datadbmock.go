@@ -141,6 +141,94 @@ func (dbM *DataDBMock) GetReverseDestinationDrv(string, string) ([]string, error
 	return nil, utils.ErrNotImplemented
 }
 
+func (dbM *DataDBMock) GetPortedNumberDrv(string) (string, error) {
+	return utils.EmptyString, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetPortedNumberDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetPortedNumbersDrv([]*PortedNumber) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemovePortedNumberDrv(string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetLookupTableDrv(string, string) (*LookupTable, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetLookupTableDrv(*LookupTable) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveLookupTableDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetDiscountProfileDrv(string, string) (*DiscountProfile, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetDiscountProfileDrv(*DiscountProfile) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveDiscountProfileDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetFraudProfileDrv(string, string) (*FraudProfile, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetFraudProfileDrv(*FraudProfile) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveFraudProfileDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetFraudCaseDrv(string, string) (*FraudCase, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetFraudCaseDrv(*FraudCase) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveFraudCaseDrv(string, string) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetRevisionsDrv(string, string) (*ObjectRevisions, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetRevisionsDrv(*ObjectRevisions) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetLoadRevisionsDrv(int64) ([]*ObjectRevisions, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) GetDataDBMigrationDrv() (*StoredDataDBMigration, error) {
+	return nil, utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) SetDataDBMigrationDrv(*StoredDataDBMigration) error {
+	return utils.ErrNotImplemented
+}
+
+func (dbM *DataDBMock) RemoveDataDBMigrationDrv() error {
+	return utils.ErrNotImplemented
+}
+
 func (dbM *DataDBMock) GetActionsDrv(string) (Actions, error) {
 	return nil, utils.ErrNotImplemented
 }
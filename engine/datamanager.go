@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/cgrates/baningo"
 	"github.com/cgrates/birpc/context"
@@ -40,12 +41,19 @@ var (
 		utils.RouteFilterIndexes:      {},
 		utils.ChargerFilterIndexes:    {},
 		utils.DispatcherFilterIndexes: {},
+		utils.FraudFilterIndexes:      {},
+		utils.DiscountFilterIndexes:   {},
 		utils.ActionPlanIndexes:       {},
 		utils.FilterIndexPrfx:         {},
 	}
 	cachePrefixMap = utils.StringSet{
 		utils.DestinationPrefix:        {},
 		utils.ReverseDestinationPrefix: {},
+		utils.PortedNumberPrefix:       {},
+		utils.LookupTablePrefix:        {},
+		utils.DiscountProfilePrefix:    {},
+		utils.FraudProfilePrefix:       {},
+		utils.FraudCasePrefix:          {},
 		utils.RatingPlanPrefix:         {},
 		utils.RatingProfilePrefix:      {},
 		utils.ActionPrefix:             {},
@@ -79,6 +87,8 @@ var (
 		utils.RouteFilterIndexes:       {},
 		utils.ChargerFilterIndexes:     {},
 		utils.DispatcherFilterIndexes:  {},
+		utils.FraudFilterIndexes:       {},
+		utils.DiscountFilterIndexes:    {},
 		utils.FilterIndexPrfx:          {},
 		utils.MetaAPIBan:               {}, // not realy a prefix as this is not stored in DB
 		utils.MetaNotSentryPeer:        {},
@@ -103,26 +113,99 @@ func NewDataManager(dataDB DataDB, cacheCfg *config.CacheCfg, connMgr *ConnManag
 // DataManager is the data storage manager for CGRateS
 // transparently manages data retrieval, further serialization and caching
 type DataManager struct {
+	dbMux      sync.RWMutex // protects dataDB, swapped by the migration and the reconnect
 	dataDB     DataDB
 	cacheCfg   *config.CacheCfg
 	connMgr    *ConnManager
 	ms         Marshaler
 	replicator *replicator
+
+	migMux    sync.RWMutex // protects the migration
+	migration *DataDBMigration
+
+	rvsMux     sync.Mutex       // protects rvsPending
+	rvsPending pendingRevisions // nil until read from DataDB
+
+	cdc *CDCStream // exports the changes to EEs, nil if disabled
+
+	root        *DataManager // the DataManager seen through the view, nil if not a view
+	traceParent string       // of the DataDB spans created through the view
 }
 
 func (dm *DataManager) Close() {
 	dm.replicator.close()
-	dm.dataDB.Close()
+	dm.db().Close()
+}
+
+// SetCDCStream exports the changes through the stream, owned by the caller.
+// Set before the DataManager is used
+func (dm *DataManager) SetCDCStream(s *CDCStream) {
+	dm.cdc = s
 }
 
 // DataDB exports access to dataDB
 func (dm *DataManager) DataDB() DataDB {
 	if dm != nil {
-		return dm.dataDB
+		return dm.db()
 	}
 	return nil
 }
 
+// WithTraceParent returns a view of the DataManager creating the DataDB spans as
+// children of the span identified by traceParent, e.g. the one of the API call.
+// The view shares the DataDB, the caches and the state of the DataManager
+func (dm *DataManager) WithTraceParent(traceParent string) *DataManager {
+	if dm == nil || traceParent == utils.EmptyString || !utils.Tracer.DBSpans() {
+		return dm
+	}
+	root := dm.rootDM()
+	return &DataManager{
+		cacheCfg:    root.cacheCfg,
+		connMgr:     root.connMgr,
+		ms:          root.ms,
+		replicator:  root.replicator,
+		cdc:         root.cdc,
+		root:        root,
+		traceParent: traceParent,
+	}
+}
+
+// withAPIOpts returns the view of the DataManager for the traceparent propagated
+// through the APIOpts of the API call
+func (dm *DataManager) withAPIOpts(opts map[string]any) *DataManager {
+	tp, _ := opts[utils.OptsTraceParent].(string)
+	return dm.WithTraceParent(tp)
+}
+
+// rootDM returns the DataManager holding the state shared with its views
+func (dm *DataManager) rootDM() *DataManager {
+	if dm.root != nil {
+		return dm.root
+	}
+	return dm
+}
+
+// db returns the DataDB, creating the spans of a view as children of its trace parent
+func (dm *DataManager) db() DataDB {
+	root := dm.rootDM()
+	root.dbMux.RLock()
+	dataDB := root.dataDB
+	root.dbMux.RUnlock()
+	if dm.root == nil {
+		return dataDB
+	}
+	return DataDBWithTraceParent(dataDB, dm.traceParent)
+}
+
+// setDataDB replaces the DataDB of the DataManager and of its views, returning the old one
+func (dm *DataManager) setDataDB(dataDB DataDB) (old DataDB) {
+	root := dm.rootDM()
+	root.dbMux.Lock()
+	old, root.dataDB = root.dataDB, dataDB
+	root.dbMux.Unlock()
+	return
+}
+
 func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached bool) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
@@ -168,6 +251,8 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 			_, err = dm.GetDestination(dataID, false, true, utils.NonTransactional)
 		case utils.ReverseDestinationPrefix:
 			_, err = dm.GetReverseDestination(dataID, false, true, utils.NonTransactional)
+		case utils.PortedNumberPrefix:
+			_, err = dm.GetPortedNumber(dataID, false, true, utils.NonTransactional)
 		case utils.RatingPlanPrefix:
 			_, err = dm.GetRatingPlan(dataID, true, utils.NonTransactional)
 		case utils.RatingProfilePrefix:
@@ -254,6 +339,18 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 		case utils.DispatcherHostPrefix:
 			tntID := utils.NewTenantID(dataID)
 			_, err = dm.GetDispatcherHost(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.LookupTablePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetLookupTable(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.DiscountProfilePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetDiscountProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.FraudProfilePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetFraudProfile(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
+		case utils.FraudCasePrefix:
+			tntID := utils.NewTenantID(dataID)
+			_, err = dm.GetFraudCase(tntID.Tenant, tntID.ID, false, true, utils.NonTransactional)
 		case utils.AttributeFilterIndexes:
 			var tntCtx, idxKey string
 			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
@@ -296,6 +393,18 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 				return
 			}
 			_, err = dm.GetIndexes(utils.CacheDispatcherFilterIndexes, tntCtx, false, true, idxKey)
+		case utils.FraudFilterIndexes:
+			var tntCtx, idxKey string
+			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
+				return
+			}
+			_, err = dm.GetIndexes(utils.CacheFraudFilterIndexes, tntCtx, false, true, idxKey)
+		case utils.DiscountFilterIndexes:
+			var tntCtx, idxKey string
+			if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
+				return
+			}
+			_, err = dm.GetIndexes(utils.CacheDiscountFilterIndexes, tntCtx, false, true, idxKey)
 		case utils.FilterIndexPrfx:
 			idx := strings.LastIndexByte(dataID, utils.InInFieldSep[0])
 			if idx < 0 {
@@ -330,11 +439,11 @@ func (dm *DataManager) CacheDataFromDB(prfx string, ids []string, mustBeCached b
 func (dm *DataManager) RebuildReverseForPrefix(prefix string) (err error) {
 	switch prefix {
 	case utils.ReverseDestinationPrefix:
-		if err = dm.dataDB.RemoveKeysForPrefix(prefix); err != nil {
+		if err = dm.db().RemoveKeysForPrefix(prefix); err != nil {
 			return
 		}
 		var keys []string
-		if keys, err = dm.dataDB.GetKeysForPrefix(utils.DestinationPrefix, utils.EmptyString); err != nil {
+		if keys, err = dm.db().GetKeysForPrefix(utils.DestinationPrefix, utils.EmptyString); err != nil {
 			return
 		}
 		for _, key := range keys {
@@ -347,11 +456,11 @@ func (dm *DataManager) RebuildReverseForPrefix(prefix string) (err error) {
 			}
 		}
 	case utils.AccountActionPlansPrefix:
-		if err = dm.dataDB.RemoveKeysForPrefix(prefix); err != nil {
+		if err = dm.db().RemoveKeysForPrefix(prefix); err != nil {
 			return
 		}
 		var keys []string
-		if keys, err = dm.dataDB.GetKeysForPrefix(utils.ActionPlanPrefix, utils.EmptyString); err != nil {
+		if keys, err = dm.db().GetKeysForPrefix(utils.ActionPlanPrefix, utils.EmptyString); err != nil {
 			return
 		}
 		accIDs := make(map[string][]string)
@@ -389,7 +498,7 @@ func (dm *DataManager) GetDestination(key string, cacheRead, cacheWrite bool, tr
 			return x.(*Destination), nil
 		}
 	}
-	dest, err = dm.dataDB.GetDestinationDrv(key, transactionID)
+	dest, err = dm.db().GetDestinationDrv(key, transactionID)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDestinations]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -400,7 +509,7 @@ func (dm *DataManager) GetDestination(key string, cacheRead, cacheWrite bool, tr
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &dest); err == nil {
-				err = dm.dataDB.SetDestinationDrv(dest, utils.NonTransactional)
+				err = dm.db().SetDestinationDrv(dest, utils.NonTransactional)
 			}
 		}
 		if err != nil {
@@ -427,7 +536,7 @@ func (dm *DataManager) SetDestination(dest *Destination, transactionID string) (
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.SetDestinationDrv(dest, transactionID); err != nil {
+	if err = dm.db().SetDestinationDrv(dest, transactionID); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDestinations]
@@ -453,7 +562,7 @@ func (dm *DataManager) RemoveDestination(destID string, transactionID string) (e
 		return
 	}
 
-	if err = dm.dataDB.RemoveDestinationDrv(destID, transactionID); err != nil {
+	if err = dm.db().RemoveDestinationDrv(destID, transactionID); err != nil {
 		return
 	}
 	if err = Cache.Remove(utils.CacheDestinations, destID,
@@ -464,7 +573,7 @@ func (dm *DataManager) RemoveDestination(destID string, transactionID string) (e
 		return utils.ErrNotFound
 	}
 	for _, prfx := range oldDst.Prefixes {
-		if err = dm.dataDB.RemoveReverseDestinationDrv(destID, prfx, transactionID); err != nil {
+		if err = dm.db().RemoveReverseDestinationDrv(destID, prfx, transactionID); err != nil {
 			return
 		}
 		dm.GetReverseDestination(prfx, false, true, transactionID) // it will recache the destination
@@ -487,7 +596,7 @@ func (dm *DataManager) SetReverseDestination(destID string, prefixes []string, t
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.SetReverseDestinationDrv(destID, prefixes, transactionID); err != nil {
+	if err = dm.db().SetReverseDestinationDrv(destID, prefixes, transactionID); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaReverseDestinations]
@@ -516,7 +625,7 @@ func (dm *DataManager) GetReverseDestination(prefix string,
 			return x.([]string), nil
 		}
 	}
-	ids, err = dm.dataDB.GetReverseDestinationDrv(prefix, transactionID)
+	ids, err = dm.db().GetReverseDestinationDrv(prefix, transactionID)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaReverseDestinations]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -527,7 +636,7 @@ func (dm *DataManager) GetReverseDestination(prefix string,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &ids); err == nil {
-				err = dm.dataDB.SetReverseDestinationDrv(prefix, ids, transactionID)
+				err = dm.db().SetReverseDestinationDrv(prefix, ids, transactionID)
 			}
 		}
 		if err != nil {
@@ -550,13 +659,604 @@ func (dm *DataManager) GetReverseDestination(prefix string,
 	return
 }
 
+// GetPortedNumber returns the routing number of a ported number
+func (dm *DataManager) GetPortedNumber(number string, cacheRead, cacheWrite bool,
+	transactionID string) (rn string, err error) {
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CachePortedNumbers, number); ok {
+			return x.(string), nil
+		}
+	}
+	rn, err = dm.db().GetPortedNumberDrv(number)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaPortedNumbers]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetPortedNumber, &utils.StringWithAPIOpts{
+					Arg:    number,
+					Tenant: config.CgrConfig().GeneralCfg().DefaultTenant,
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &rn); err == nil {
+				err = dm.db().SetPortedNumberDrv(number, rn)
+			}
+		}
+		if err != nil { // the not ported numbers are not cached since most numbers are not ported
+			err = utils.CastRPCErr(err)
+			return
+		}
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CachePortedNumbers, number, rn, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return utils.EmptyString, errCh
+		}
+	}
+	return
+}
+
+// SetPortedNumber stores the routing number of a ported number
+func (dm *DataManager) SetPortedNumber(pn *PortedNumber) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if err = dm.db().SetPortedNumberDrv(pn.Number, pn.RoutingNumber); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaPortedNumbers]
+	return dm.replicator.replicate(
+		utils.PortedNumberPrefix, pn.Number, // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetPortedNumber,
+		&PortedNumberWithAPIOpts{
+			PortedNumber: pn,
+			Tenant:       config.CgrConfig().GeneralCfg().DefaultTenant,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// SetPortedNumbers stores the routing numbers of the ported numbers in one
+// DataDB write and replicates them in one call
+func (dm *DataManager) SetPortedNumbers(pns []*PortedNumber) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if len(pns) == 0 {
+		return
+	}
+	if err = dm.db().SetPortedNumbersDrv(pns); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaPortedNumbers]
+	if config.CgrConfig().DataDbCfg().RplFiltered { // the hosts are selected per number
+		for _, pn := range pns {
+			if err = dm.replicator.replicate(
+				utils.PortedNumberPrefix, pn.Number, // these are used to get the host IDs from cache
+				utils.ReplicatorSv1SetPortedNumber,
+				&PortedNumberWithAPIOpts{
+					PortedNumber: pn,
+					Tenant:       config.CgrConfig().GeneralCfg().DefaultTenant,
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+						config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+				}, itm); err != nil {
+				return
+			}
+		}
+		return
+	}
+	return dm.replicator.replicate(
+		utils.PortedNumberPrefix, utils.GenUUID(), // unique so the batches are not merged when queued
+		utils.ReplicatorSv1SetPortedNumbers,
+		&PortedNumbersWithAPIOpts{
+			PortedNumbers: pns,
+			Tenant:        config.CgrConfig().GeneralCfg().DefaultTenant,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemovePortedNumber removes the number from the ported ones
+func (dm *DataManager) RemovePortedNumber(number string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if _, err = dm.GetPortedNumber(number, true, false, utils.NonTransactional); err != nil {
+		return
+	}
+	if err = dm.db().RemovePortedNumberDrv(number); err != nil {
+		return
+	}
+	if err = Cache.Remove(utils.CachePortedNumbers, number,
+		cacheCommit(utils.NonTransactional), utils.NonTransactional); err != nil {
+		return
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaPortedNumbers]
+	return dm.replicator.replicate(
+		utils.PortedNumberPrefix, number, // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemovePortedNumber,
+		&utils.StringWithAPIOpts{
+			Arg:    number,
+			Tenant: config.CgrConfig().GeneralCfg().DefaultTenant,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// GetLookupTable returns the LookupTable with the given tenant and ID
+func (dm *DataManager) GetLookupTable(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (lt *LookupTable, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheLookupTables, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*LookupTable), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	lt, err = dm.db().GetLookupTableDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaLookupTables]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetLookupTable,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &lt); err == nil {
+				err = dm.db().SetLookupTableDrv(lt)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheLookupTables, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheLookupTables, tntID, lt, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetLookupTable stores the LookupTable and replicates it if configured
+func (dm *DataManager) SetLookupTable(lt *LookupTable) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	var oldLt any
+	if oldLt, err = dm.changedObject(utils.MetaLookupTables, lt.TenantID()); err != nil {
+		return
+	}
+	chg := dm.newChange(utils.MetaLookupTables, lt.TenantID(), oldLt)
+	if err = dm.db().SetLookupTableDrv(lt); err != nil {
+		return
+	}
+	dm.storeChange(chg, lt)
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaLookupTables]
+	return dm.replicator.replicate(
+		utils.LookupTablePrefix, lt.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetLookupTable,
+		&LookupTableWithAPIOpts{
+			LookupTable: lt,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveLookupTable removes the LookupTable and replicates the removal if configured
+func (dm *DataManager) RemoveLookupTable(tenant, id string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	var oldLt *LookupTable
+	if oldLt, err = dm.GetLookupTable(tenant, id, true, false, utils.NonTransactional); err != nil &&
+		err != utils.ErrNotFound {
+		return
+	}
+	chg := dm.newChange(utils.MetaLookupTables, utils.ConcatenatedKey(tenant, id), oldLt)
+	if err = dm.db().RemoveLookupTableDrv(tenant, id); err != nil {
+		return
+	}
+	dm.storeChange(chg, nil)
+	if oldLt == nil {
+		return utils.ErrNotFound
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaLookupTables]
+	return dm.replicator.replicate(
+		utils.LookupTablePrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveLookupTable,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// GetDiscountProfile returns the DiscountProfile with the given tenant and ID
+func (dm *DataManager) GetDiscountProfile(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (dp *DiscountProfile, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheDiscountProfiles, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*DiscountProfile), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	dp, err = dm.db().GetDiscountProfileDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDiscountProfiles]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetDiscountProfile,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &dp); err == nil {
+				err = dm.db().SetDiscountProfileDrv(dp)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheDiscountProfiles, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheDiscountProfiles, tntID, dp, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetDiscountProfile stores the DiscountProfile and replicates it if configured
+func (dm *DataManager) SetDiscountProfile(dp *DiscountProfile, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if withIndex {
+		if err = dm.checkFilters(dp.Tenant, dp.FilterIDs); err != nil {
+			// if we get a broken filter do not set the profile
+			return fmt.Errorf("%+s for item with ID: %+v",
+				err, dp.TenantID())
+		}
+	}
+	oldDp, err := dm.GetDiscountProfile(dp.Tenant, dp.ID, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return
+	}
+	chg := dm.newChange(utils.MetaDiscountProfiles, dp.TenantID(), oldDp)
+	if err = dm.db().SetDiscountProfileDrv(dp); err != nil {
+		return
+	}
+	dm.storeChange(chg, dp)
+	if withIndex {
+		var oldFiltersIDs *[]string
+		if oldDp != nil {
+			oldFiltersIDs = &oldDp.FilterIDs
+		}
+		if err = updatedIndexes(dm, utils.CacheDiscountFilterIndexes, dp.Tenant,
+			utils.EmptyString, dp.ID, oldFiltersIDs, dp.FilterIDs, false); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDiscountProfiles]
+	return dm.replicator.replicate(
+		utils.DiscountProfilePrefix, dp.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetDiscountProfile,
+		&DiscountProfileWithAPIOpts{
+			DiscountProfile: dp,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveDiscountProfile removes the DiscountProfile and replicates the removal if configured
+func (dm *DataManager) RemoveDiscountProfile(tenant, id string, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	var oldDp *DiscountProfile
+	if oldDp, err = dm.GetDiscountProfile(tenant, id, true, false, utils.NonTransactional); err != nil &&
+		err != utils.ErrNotFound {
+		return
+	}
+	chg := dm.newChange(utils.MetaDiscountProfiles, utils.ConcatenatedKey(tenant, id), oldDp)
+	if err = dm.db().RemoveDiscountProfileDrv(tenant, id); err != nil {
+		return
+	}
+	dm.storeChange(chg, nil)
+	if oldDp == nil {
+		return utils.ErrNotFound
+	}
+	if withIndex {
+		if err = removeIndexFiltersItem(dm, utils.CacheDiscountFilterIndexes, tenant, id, oldDp.FilterIDs); err != nil {
+			return
+		}
+		if err = removeItemFromFilterIndex(dm, utils.CacheDiscountFilterIndexes,
+			tenant, utils.EmptyString, id, oldDp.FilterIDs); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDiscountProfiles]
+	return dm.replicator.replicate(
+		utils.DiscountProfilePrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveDiscountProfile,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// GetFraudProfile returns the FraudProfile with the given tenant and ID
+func (dm *DataManager) GetFraudProfile(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (fp *FraudProfile, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheFraudProfiles, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*FraudProfile), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	fp, err = dm.db().GetFraudProfileDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudProfiles]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetFraudProfile,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &fp); err == nil {
+				err = dm.db().SetFraudProfileDrv(fp)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheFraudProfiles, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheFraudProfiles, tntID, fp, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetFraudProfile stores the FraudProfile and replicates it if configured
+func (dm *DataManager) SetFraudProfile(fp *FraudProfile, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	if withIndex {
+		if err = dm.checkFilters(fp.Tenant, fp.FilterIDs); err != nil {
+			// if we get a broken filter do not set the profile
+			return fmt.Errorf("%+s for item with ID: %+v",
+				err, fp.TenantID())
+		}
+	}
+	oldFp, err := dm.GetFraudProfile(fp.Tenant, fp.ID, true, false, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return
+	}
+	chg := dm.newChange(utils.MetaFraudProfiles, fp.TenantID(), oldFp)
+	if err = dm.db().SetFraudProfileDrv(fp); err != nil {
+		return
+	}
+	dm.storeChange(chg, fp)
+	if withIndex {
+		var oldFiltersIDs *[]string
+		if oldFp != nil {
+			oldFiltersIDs = &oldFp.FilterIDs
+		}
+		if err = updatedIndexes(dm, utils.CacheFraudFilterIndexes, fp.Tenant,
+			utils.EmptyString, fp.ID, oldFiltersIDs, fp.FilterIDs, false); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudProfiles]
+	return dm.replicator.replicate(
+		utils.FraudProfilePrefix, fp.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetFraudProfile,
+		&FraudProfileWithAPIOpts{
+			FraudProfile: fp,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveFraudProfile removes the FraudProfile and replicates the removal if configured
+func (dm *DataManager) RemoveFraudProfile(tenant, id string, withIndex bool) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	var oldFp *FraudProfile
+	if oldFp, err = dm.GetFraudProfile(tenant, id, true, false, utils.NonTransactional); err != nil &&
+		err != utils.ErrNotFound {
+		return
+	}
+	chg := dm.newChange(utils.MetaFraudProfiles, utils.ConcatenatedKey(tenant, id), oldFp)
+	if err = dm.db().RemoveFraudProfileDrv(tenant, id); err != nil {
+		return
+	}
+	dm.storeChange(chg, nil)
+	if oldFp == nil {
+		return utils.ErrNotFound
+	}
+	if withIndex {
+		if err = removeIndexFiltersItem(dm, utils.CacheFraudFilterIndexes, tenant, id, oldFp.FilterIDs); err != nil {
+			return
+		}
+		if err = removeItemFromFilterIndex(dm, utils.CacheFraudFilterIndexes,
+			tenant, utils.EmptyString, id, oldFp.FilterIDs); err != nil {
+			return
+		}
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudProfiles]
+	return dm.replicator.replicate(
+		utils.FraudProfilePrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveFraudProfile,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// GetFraudCase returns the FraudCase with the given tenant and ID
+func (dm *DataManager) GetFraudCase(tenant, id string, cacheRead, cacheWrite bool,
+	transactionID string) (fc *FraudCase, err error) {
+	tntID := utils.ConcatenatedKey(tenant, id)
+	if cacheRead {
+		if x, ok := Cache.Get(utils.CacheFraudCases, tntID); ok {
+			if x == nil {
+				return nil, utils.ErrNotFound
+			}
+			return x.(*FraudCase), nil
+		}
+	}
+	if dm == nil {
+		err = utils.ErrNoDatabaseConn
+		return
+	}
+	fc, err = dm.db().GetFraudCaseDrv(tenant, id)
+	if err != nil {
+		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudCases]; err == utils.ErrNotFound && itm.Remote {
+			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
+				utils.ReplicatorSv1GetFraudCase,
+				&utils.TenantIDWithAPIOpts{
+					TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+					APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID, utils.EmptyString,
+						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
+							config.CgrConfig().GeneralCfg().NodeID)),
+				}, &fc); err == nil {
+				err = dm.db().SetFraudCaseDrv(fc)
+			}
+		}
+		if err != nil {
+			err = utils.CastRPCErr(err)
+			if err == utils.ErrNotFound && cacheWrite {
+				if errCh := Cache.Set(utils.CacheFraudCases, tntID, nil, nil,
+					cacheCommit(transactionID), transactionID); errCh != nil {
+					return nil, errCh
+				}
+			}
+			return nil, err
+		}
+	}
+	if cacheWrite {
+		if errCh := Cache.Set(utils.CacheFraudCases, tntID, fc, nil,
+			cacheCommit(transactionID), transactionID); errCh != nil {
+			return nil, errCh
+		}
+	}
+	return
+}
+
+// SetFraudCase stores the FraudCase and replicates it if configured
+func (dm *DataManager) SetFraudCase(fc *FraudCase) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	chg := dm.newChange(utils.MetaFraudCases, fc.TenantID(), nil)
+	if err = dm.db().SetFraudCaseDrv(fc); err != nil {
+		return
+	}
+	dm.storeChange(chg, fc)
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudCases]
+	return dm.replicator.replicate(
+		utils.FraudCasePrefix, fc.TenantID(), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1SetFraudCase,
+		&FraudCaseWithAPIOpts{
+			FraudCase: fc,
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
+// RemoveFraudCase removes the FraudCase and replicates the removal if configured
+func (dm *DataManager) RemoveFraudCase(tenant, id string) (err error) {
+	if dm == nil {
+		return utils.ErrNoDatabaseConn
+	}
+	var oldFc *FraudCase
+	if oldFc, err = dm.GetFraudCase(tenant, id, true, false, utils.NonTransactional); err != nil &&
+		err != utils.ErrNotFound {
+		return
+	}
+	chg := dm.newChange(utils.MetaFraudCases, utils.ConcatenatedKey(tenant, id), oldFc)
+	if err = dm.db().RemoveFraudCaseDrv(tenant, id); err != nil {
+		return
+	}
+	dm.storeChange(chg, nil)
+	if oldFc == nil {
+		return utils.ErrNotFound
+	}
+	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaFraudCases]
+	return dm.replicator.replicate(
+		utils.FraudCasePrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
+		utils.ReplicatorSv1RemoveFraudCase,
+		&utils.TenantIDWithAPIOpts{
+			TenantID: &utils.TenantID{Tenant: tenant, ID: id},
+			APIOpts: utils.GenerateDBItemOpts(itm.APIKey, itm.RouteID,
+				config.CgrConfig().DataDbCfg().RplCache, utils.EmptyString),
+		}, itm)
+}
+
 func (dm *DataManager) UpdateReverseDestination(oldDest, newDest *Destination,
 	transactionID string) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
 	if oldDest == nil {
-		return dm.dataDB.SetReverseDestinationDrv(newDest.Id, newDest.Prefixes, transactionID)
+		return dm.db().SetReverseDestinationDrv(newDest.Id, newDest.Prefixes, transactionID)
 	}
 
 	cCommit := cacheCommit(transactionID)
@@ -570,7 +1270,7 @@ func (dm *DataManager) UpdateReverseDestination(oldDest, newDest *Destination,
 			}
 		}
 		if !found {
-			if err = dm.dataDB.RemoveReverseDestinationDrv(newDest.Id, oldPrefix, transactionID); err != nil {
+			if err = dm.db().RemoveReverseDestinationDrv(newDest.Id, oldPrefix, transactionID); err != nil {
 				return
 			}
 			if err = Cache.Remove(utils.CacheReverseDestinations, oldPrefix,
@@ -600,7 +1300,7 @@ func (dm *DataManager) GetAccount(id string) (acc *Account, err error) {
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	acc, err = dm.dataDB.GetAccountDrv(id)
+	acc, err = dm.db().GetAccountDrv(id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccounts]; err == utils.ErrNotFound &&
 			itm.Remote {
@@ -614,7 +1314,7 @@ func (dm *DataManager) GetAccount(id string) (acc *Account, err error) {
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &acc); err == nil {
-				err = dm.dataDB.SetAccountDrv(acc)
+				err = dm.db().SetAccountDrv(acc)
 			}
 		}
 		if err != nil {
@@ -629,9 +1329,11 @@ func (dm *DataManager) SetAccount(acc *Account) error {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err := dm.dataDB.SetAccountDrv(acc); err != nil {
+	chg := dm.newChange(utils.MetaAccounts, acc.ID, nil)
+	if err := dm.db().SetAccountDrv(acc); err != nil {
 		return err
 	}
+	dm.storeChange(chg, acc)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccounts]
 	return dm.replicator.replicate(utils.AccountPrefix, acc.ID,
 		utils.ReplicatorSv1SetAccount,
@@ -645,9 +1347,11 @@ func (dm *DataManager) RemoveAccount(id string) error {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err := dm.dataDB.RemoveAccountDrv(id); err != nil {
+	chg := dm.newChange(utils.MetaAccounts, id, nil)
+	if err := dm.db().RemoveAccountDrv(id); err != nil {
 		return err
 	}
+	dm.storeChange(chg, nil)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccounts]
 	_ = dm.replicator.replicate(
 		utils.AccountPrefix, id,
@@ -690,7 +1394,7 @@ func (dm *DataManager) GetFilter(tenant, id string, cacheRead, cacheWrite bool,
 							utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 								config.CgrConfig().GeneralCfg().NodeID)),
 					}, &fltr); err == nil {
-					err = dm.dataDB.SetFilterDrv(fltr)
+					err = dm.db().SetFilterDrv(fltr)
 				}
 			}
 			if err != nil {
@@ -729,9 +1433,11 @@ func (dm *DataManager) SetFilter(fltr *Filter, withIndex bool) (err error) {
 		utils.NonTransactional); err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaFilters, fltr.TenantID(), oldFlt)
 	if err = dm.DataDB().SetFilterDrv(fltr); err != nil {
 		return
 	}
+	dm.storeChange(chg, fltr)
 	if withIndex {
 		if err = UpdateFilterIndex(dm, oldFlt, fltr); err != nil {
 			return
@@ -772,9 +1478,11 @@ func (dm *DataManager) RemoveFilter(tenant, id string, withIndex bool) (err erro
 				tntCtx, utils.ToJSON(rcvIndx))
 		}
 	}
+	chg := dm.newChange(utils.MetaFilters, utils.ConcatenatedKey(tenant, id), oldFlt)
 	if err = dm.DataDB().RemoveFilterDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldFlt == nil {
 		return utils.ErrNotFound
 	}
@@ -805,7 +1513,7 @@ func (dm *DataManager) GetThreshold(tenant, id string,
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	th, err = dm.dataDB.GetThresholdDrv(tenant, id)
+	th, err = dm.db().GetThresholdDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholds]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -815,7 +1523,7 @@ func (dm *DataManager) GetThreshold(tenant, id string,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &th); err == nil {
-				err = dm.dataDB.SetThresholdDrv(th)
+				err = dm.db().SetThresholdDrv(th)
 			}
 		}
 		if err != nil {
@@ -842,9 +1550,11 @@ func (dm *DataManager) SetThreshold(th *Threshold) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	chg := dm.newChange(utils.MetaThresholds, th.TenantID(), nil)
 	if err = dm.DataDB().SetThresholdDrv(th); err != nil {
 		return
 	}
+	dm.storeChange(chg, th)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholds]
 	return dm.replicator.replicate(
 		utils.ThresholdPrefix, th.TenantID(), // these are used to get the host IDs from cache
@@ -860,9 +1570,11 @@ func (dm *DataManager) RemoveThreshold(tenant, id string) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	chg := dm.newChange(utils.MetaThresholds, utils.ConcatenatedKey(tenant, id), nil)
 	if err = dm.DataDB().RemoveThresholdDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholds]
 	_ = dm.replicator.replicate(
 		utils.ThresholdPrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
@@ -890,7 +1602,7 @@ func (dm *DataManager) GetThresholdProfile(tenant, id string, cacheRead, cacheWr
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	th, err = dm.dataDB.GetThresholdProfileDrv(tenant, id)
+	th, err = dm.db().GetThresholdProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaThresholdProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -901,7 +1613,7 @@ func (dm *DataManager) GetThresholdProfile(tenant, id string, cacheRead, cacheWr
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &th); err == nil {
-				err = dm.dataDB.SetThresholdProfileDrv(th)
+				err = dm.db().SetThresholdProfileDrv(th)
 			}
 		}
 		if err != nil {
@@ -939,9 +1651,11 @@ func (dm *DataManager) SetThresholdProfile(th *ThresholdProfile, withIndex bool)
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaThresholdProfiles, th.TenantID(), oldTh)
 	if err = dm.DataDB().SetThresholdProfileDrv(th); err != nil {
 		return err
 	}
+	dm.storeChange(chg, th)
 	if withIndex {
 		var oldFiltersIDs *[]string
 		if oldTh != nil {
@@ -992,9 +1706,11 @@ func (dm *DataManager) RemoveThresholdProfile(tenant, id string, withIndex bool)
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaThresholdProfiles, utils.ConcatenatedKey(tenant, id), oldTh)
 	if err = dm.DataDB().RemThresholdProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldTh == nil {
 		return utils.ErrNotFound
 	}
@@ -1036,7 +1752,7 @@ func (dm *DataManager) GetStatQueue(tenant, id string,
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	sq, err = dm.dataDB.GetStatQueueDrv(tenant, id)
+	sq, err = dm.db().GetStatQueueDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueues]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns, utils.ReplicatorSv1GetStatQueue,
@@ -1047,13 +1763,13 @@ func (dm *DataManager) GetStatQueue(tenant, id string,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &sq); err == nil {
 				var ssq *StoredStatQueue
-				if dm.dataDB.GetStorageType() != utils.Internal {
+				if dm.db().GetStorageType() != utils.Internal {
 					// in case of internal we don't marshal
 					if ssq, err = NewStoredStatQueue(sq, dm.ms); err != nil {
 						return nil, err
 					}
 				}
-				err = dm.dataDB.SetStatQueueDrv(ssq, sq)
+				err = dm.db().SetStatQueueDrv(ssq, sq)
 			}
 		}
 		if err != nil {
@@ -1082,13 +1798,13 @@ func (dm *DataManager) SetStatQueue(sq *StatQueue) (err error) {
 		return utils.ErrNoDatabaseConn
 	}
 	var ssq *StoredStatQueue
-	if dm.dataDB.GetStorageType() != utils.Internal {
+	if dm.db().GetStorageType() != utils.Internal {
 		// in case of internal we don't marshal
 		if ssq, err = NewStoredStatQueue(sq, dm.ms); err != nil {
 			return
 		}
 	}
-	if err = dm.dataDB.SetStatQueueDrv(ssq, sq); err != nil {
+	if err = dm.db().SetStatQueueDrv(ssq, sq); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueues]
@@ -1107,7 +1823,7 @@ func (dm *DataManager) RemoveStatQueue(tenant, id string) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.RemStatQueueDrv(tenant, id); err != nil {
+	if err = dm.db().RemStatQueueDrv(tenant, id); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueues]
@@ -1137,7 +1853,7 @@ func (dm *DataManager) GetStatQueueProfile(tenant, id string, cacheRead, cacheWr
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	sqp, err = dm.dataDB.GetStatQueueProfileDrv(tenant, id)
+	sqp, err = dm.db().GetStatQueueProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaStatQueueProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -1148,7 +1864,7 @@ func (dm *DataManager) GetStatQueueProfile(tenant, id string, cacheRead, cacheWr
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &sqp); err == nil {
-				err = dm.dataDB.SetStatQueueProfileDrv(sqp)
+				err = dm.db().SetStatQueueProfileDrv(sqp)
 			}
 		}
 		if err != nil {
@@ -1187,9 +1903,11 @@ func (dm *DataManager) SetStatQueueProfile(sqp *StatQueueProfile, withIndex bool
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaStatQueueProfiles, sqp.TenantID(), oldSts)
 	if err = dm.DataDB().SetStatQueueProfileDrv(sqp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, sqp)
 	if withIndex {
 		var oldFiltersIDs *[]string
 		if oldSts != nil {
@@ -1272,9 +1990,11 @@ func (dm *DataManager) RemoveStatQueueProfile(tenant, id string, withIndex bool)
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaStatQueueProfiles, utils.ConcatenatedKey(tenant, id), oldSts)
 	if err = dm.DataDB().RemStatQueueProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldSts == nil {
 		return utils.ErrNotFound
 	}
@@ -1316,7 +2036,7 @@ func (dm *DataManager) GetTrend(tenant, id string,
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	if tr, err = dm.dataDB.GetTrendDrv(tenant, id); err != nil {
+	if tr, err = dm.db().GetTrendDrv(tenant, id); err != nil {
 		if err != utils.ErrNotFound { // database error
 			return
 		}
@@ -1333,7 +2053,7 @@ func (dm *DataManager) GetTrend(tenant, id string,
 				if err != utils.ErrNotFound { // RPC error
 					return
 				}
-			} else if err = dm.dataDB.SetTrendDrv(tr); err != nil {
+			} else if err = dm.db().SetTrendDrv(tr); err != nil {
 				return
 			}
 		}
@@ -1366,7 +2086,7 @@ func (dm *DataManager) SetTrend(tr *Trend) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if dm.dataDB.GetStorageType() != utils.MetaInternal {
+	if dm.db().GetStorageType() != utils.MetaInternal {
 		if tr, err = tr.compress(dm.ms); err != nil {
 			return
 		}
@@ -1420,7 +2140,7 @@ func (dm *DataManager) GetTrendProfile(tenant, id string, cacheRead, cacheWrite
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	trp, err = dm.dataDB.GetTrendProfileDrv(tenant, id)
+	trp, err = dm.db().GetTrendProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTrendProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -1431,7 +2151,7 @@ func (dm *DataManager) GetTrendProfile(tenant, id string, cacheRead, cacheWrite
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &trp); err == nil {
-				err = dm.dataDB.SetTrendProfileDrv(trp)
+				err = dm.db().SetTrendProfileDrv(trp)
 			}
 		}
 		if err != nil {
@@ -1458,7 +2178,7 @@ func (dm *DataManager) GetTrendProfileIDs(tenants []string) (tps map[string][]st
 	prfx := utils.TrendsProfilePrefix
 	var keys []string
 	if len(tenants) == 0 {
-		keys, err = dm.dataDB.GetKeysForPrefix(prfx, utils.EmptyString)
+		keys, err = dm.db().GetKeysForPrefix(prfx, utils.EmptyString)
 		if err != nil {
 			return
 		}
@@ -1466,7 +2186,7 @@ func (dm *DataManager) GetTrendProfileIDs(tenants []string) (tps map[string][]st
 		for _, tenant := range tenants {
 			var tntkeys []string
 			tntPrfx := prfx + tenant + utils.ConcatenatedKeySep
-			tntkeys, err = dm.dataDB.GetKeysForPrefix(tntPrfx, utils.EmptyString)
+			tntkeys, err = dm.db().GetKeysForPrefix(tntPrfx, utils.EmptyString)
 			if err != nil {
 				return
 			}
@@ -1495,9 +2215,11 @@ func (dm *DataManager) SetTrendProfile(trp *TrendProfile) (err error) {
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaTrendProfiles, trp.TenantID(), oldTrd)
 	if err = dm.DataDB().SetTrendProfileDrv(trp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, trp)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTrendProfiles]
 	if err = dm.replicator.replicate(
 		utils.TrendsProfilePrefix, trp.TenantID(),
@@ -1528,9 +2250,11 @@ func (dm *DataManager) RemoveTrendProfile(tenant, id string) (err error) {
 		return err
 	}
 
+	chg := dm.newChange(utils.MetaTrendProfiles, utils.ConcatenatedKey(tenant, id), oldTrs)
 	if err = dm.DataDB().RemTrendProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldTrs == nil {
 		return utils.ErrNotFound
 	}
@@ -1560,7 +2284,7 @@ func (dm *DataManager) GetRankingProfile(tenant, id string, cacheRead, cacheWrit
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	rgp, err = dm.dataDB.GetRankingProfileDrv(tenant, id)
+	rgp, err = dm.db().GetRankingProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankingProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -1571,7 +2295,7 @@ func (dm *DataManager) GetRankingProfile(tenant, id string, cacheRead, cacheWrit
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rgp); err == nil {
-				err = dm.dataDB.SetRankingProfileDrv(rgp)
+				err = dm.db().SetRankingProfileDrv(rgp)
 			}
 		}
 		if err != nil {
@@ -1598,7 +2322,7 @@ func (dm *DataManager) GetRankingProfileIDs(tenants []string) (rns map[string][]
 	prfx := utils.RankingsProfilePrefix
 	var keys []string
 	if len(tenants) == 0 {
-		keys, err = dm.dataDB.GetKeysForPrefix(prfx, utils.EmptyString)
+		keys, err = dm.db().GetKeysForPrefix(prfx, utils.EmptyString)
 		if err != nil {
 			return
 		}
@@ -1606,7 +2330,7 @@ func (dm *DataManager) GetRankingProfileIDs(tenants []string) (rns map[string][]
 		for _, tenant := range tenants {
 			var tntkeys []string
 			tntPrfx := prfx + tenant + utils.ConcatenatedKeySep
-			tntkeys, err = dm.dataDB.GetKeysForPrefix(tntPrfx, utils.EmptyString)
+			tntkeys, err = dm.db().GetKeysForPrefix(tntPrfx, utils.EmptyString)
 			if err != nil {
 				return
 			}
@@ -1634,9 +2358,11 @@ func (dm *DataManager) SetRankingProfile(rnp *RankingProfile) (err error) {
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaRankingProfiles, rnp.TenantID(), oldRnk)
 	if err = dm.DataDB().SetRankingProfileDrv(rnp); err != nil {
 		return
 	}
+	dm.storeChange(chg, rnp)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRankingProfiles]
 	if err = dm.replicator.replicate(
 		utils.RankingsProfilePrefix, rnp.TenantID(),
@@ -1665,9 +2391,11 @@ func (dm *DataManager) RemoveRankingProfile(tenant, id string) (err error) {
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaRankingProfiles, utils.ConcatenatedKey(tenant, id), oldSgs)
 	if err = dm.DataDB().RemRankingProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldSgs == nil {
 		return utils.ErrNotFound
 	}
@@ -1696,7 +2424,7 @@ func (dm *DataManager) GetRanking(tenant, id string, cacheRead, cacheWrite bool,
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	if rn, err = dm.dataDB.GetRankingDrv(tenant, id); err != nil {
+	if rn, err = dm.db().GetRankingDrv(tenant, id); err != nil {
 		if err != utils.ErrNotFound { // database error
 			return
 		}
@@ -1709,7 +2437,7 @@ func (dm *DataManager) GetRanking(tenant, id string, cacheRead, cacheWrite bool,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rn); err == nil {
-				err = dm.dataDB.SetRankingDrv(rn)
+				err = dm.db().SetRankingDrv(rn)
 			}
 		}
 		if err != nil {
@@ -1783,7 +2511,7 @@ func (dm *DataManager) GetTiming(id string, skipCache bool,
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	t, err = dm.dataDB.GetTimingDrv(id)
+	t, err = dm.db().GetTimingDrv(id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaTimings]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns, utils.ReplicatorSv1GetTiming,
@@ -1794,7 +2522,7 @@ func (dm *DataManager) GetTiming(id string, skipCache bool,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &t); err == nil {
-				err = dm.dataDB.SetTimingDrv(t)
+				err = dm.db().SetTimingDrv(t)
 			}
 		}
 		if err != nil {
@@ -1879,7 +2607,7 @@ func (dm *DataManager) GetResource(tenant, id string, cacheRead, cacheWrite bool
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	rs, err = dm.dataDB.GetResourceDrv(tenant, id)
+	rs, err = dm.db().GetResourceDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResources]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -1890,7 +2618,7 @@ func (dm *DataManager) GetResource(tenant, id string, cacheRead, cacheWrite bool
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rs); err == nil {
-				err = dm.dataDB.SetResourceDrv(rs)
+				err = dm.db().SetResourceDrv(rs)
 			}
 		}
 		if err != nil {
@@ -1918,9 +2646,11 @@ func (dm *DataManager) SetResource(rs *Resource) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	chg := dm.newChange(utils.MetaResources, rs.TenantID(), nil)
 	if err = dm.DataDB().SetResourceDrv(rs); err != nil {
 		return
 	}
+	dm.storeChange(chg, rs)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResources]
 	return dm.replicator.replicate(
 		utils.ResourcesPrefix, rs.TenantID(), // these are used to get the host IDs from cache
@@ -1936,9 +2666,11 @@ func (dm *DataManager) RemoveResource(tenant, id string) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	chg := dm.newChange(utils.MetaResources, utils.ConcatenatedKey(tenant, id), nil)
 	if err = dm.DataDB().RemoveResourceDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResources]
 	_ = dm.replicator.replicate(
 		utils.ResourcesPrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
@@ -1966,7 +2698,7 @@ func (dm *DataManager) GetResourceProfile(tenant, id string, cacheRead, cacheWri
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	rp, err = dm.dataDB.GetResourceProfileDrv(tenant, id)
+	rp, err = dm.db().GetResourceProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaResourceProfile]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -1976,7 +2708,7 @@ func (dm *DataManager) GetResourceProfile(tenant, id string, cacheRead, cacheWri
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rp); err == nil {
-				err = dm.dataDB.SetResourceProfileDrv(rp)
+				err = dm.db().SetResourceProfileDrv(rp)
 			}
 		}
 		if err != nil {
@@ -2015,9 +2747,11 @@ func (dm *DataManager) SetResourceProfile(rp *ResourceProfile, withIndex bool) (
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaResourceProfile, rp.TenantID(), oldRes)
 	if err = dm.DataDB().SetResourceProfileDrv(rp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, rp)
 	if withIndex {
 		var oldFiltersIDs *[]string
 		if oldRes != nil {
@@ -2068,9 +2802,11 @@ func (dm *DataManager) RemoveResourceProfile(tenant, id string, withIndex bool)
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaResourceProfile, utils.ConcatenatedKey(tenant, id), oldRes)
 	if err = dm.DataDB().RemoveResourceProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldRes == nil {
 		return utils.ErrNotFound
 	}
@@ -2114,7 +2850,7 @@ func (dm *DataManager) GetIPAllocations(tenant, id string, cacheRead, cacheWrite
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	ip, err = dm.dataDB.GetIPAllocationsDrv(tenant, id)
+	ip, err = dm.db().GetIPAllocationsDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaIPAllocations]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -2125,7 +2861,7 @@ func (dm *DataManager) GetIPAllocations(tenant, id string, cacheRead, cacheWrite
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &ip); err == nil {
-				err = dm.dataDB.SetIPAllocationsDrv(ip)
+				err = dm.db().SetIPAllocationsDrv(ip)
 			}
 		}
 		if err != nil {
@@ -2155,9 +2891,11 @@ func (dm *DataManager) SetIPAllocations(ip *IPAllocations) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.SetIPAllocationsDrv(ip); err != nil {
+	chg := dm.newChange(utils.MetaIPAllocations, ip.TenantID(), nil)
+	if err = dm.db().SetIPAllocationsDrv(ip); err != nil {
 		return
 	}
+	dm.storeChange(chg, ip)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaIPAllocations]
 	return dm.replicator.replicate(
 		utils.IPAllocationsPrefix, ip.TenantID(), // these are used to get the host IDs from cache
@@ -2172,9 +2910,11 @@ func (dm *DataManager) RemoveIPAllocations(tenant, id string) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.RemoveIPAllocationsDrv(tenant, id); err != nil {
+	chg := dm.newChange(utils.MetaIPAllocations, utils.ConcatenatedKey(tenant, id), nil)
+	if err = dm.db().RemoveIPAllocationsDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaIPAllocations]
 	_ = dm.replicator.replicate(
 		utils.IPAllocationsPrefix, utils.ConcatenatedKey(tenant, id), // these are used to get the host IDs from cache
@@ -2201,7 +2941,7 @@ func (dm *DataManager) GetIPProfile(tenant, id string, cacheRead, cacheWrite boo
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	ipp, err = dm.dataDB.GetIPProfileDrv(tenant, id)
+	ipp, err = dm.db().GetIPProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaIPProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -2211,7 +2951,7 @@ func (dm *DataManager) GetIPProfile(tenant, id string, cacheRead, cacheWrite boo
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &ipp); err == nil {
-				err = dm.dataDB.SetIPProfileDrv(ipp)
+				err = dm.db().SetIPProfileDrv(ipp)
 			}
 		}
 		if err != nil {
@@ -2250,9 +2990,11 @@ func (dm *DataManager) SetIPProfile(ipp *IPProfile, withIndex bool) (err error)
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
-	if err = dm.dataDB.SetIPProfileDrv(ipp); err != nil {
+	chg := dm.newChange(utils.MetaIPProfiles, ipp.TenantID(), oldIPP)
+	if err = dm.db().SetIPProfileDrv(ipp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, ipp)
 	if withIndex {
 		var oldFiltersIDs *[]string
 		if oldIPP != nil {
@@ -2302,9 +3044,11 @@ func (dm *DataManager) RemoveIPProfile(tenant, id string, withIndex bool) (err e
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
-	if err = dm.dataDB.RemoveIPProfileDrv(tenant, id); err != nil {
+	chg := dm.newChange(utils.MetaIPProfiles, utils.ConcatenatedKey(tenant, id), oldIPP)
+	if err = dm.db().RemoveIPProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldIPP == nil {
 		return utils.ErrNotFound
 	}
@@ -2343,7 +3087,7 @@ func (dm *DataManager) GetActionTriggers(id string, skipCache bool,
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	attrs, err = dm.dataDB.GetActionTriggersDrv(id)
+	attrs, err = dm.db().GetActionTriggersDrv(id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionTriggers]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns, utils.ReplicatorSv1GetActionTriggers,
@@ -2354,7 +3098,7 @@ func (dm *DataManager) GetActionTriggers(id string, skipCache bool,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &attrs); err == nil {
-				err = dm.dataDB.SetActionTriggersDrv(id, attrs)
+				err = dm.db().SetActionTriggersDrv(id, attrs)
 			}
 		}
 		if err != nil {
@@ -2455,7 +3199,7 @@ func (dm *DataManager) GetSharedGroup(key string, skipCache bool,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &sg); err == nil {
-				err = dm.dataDB.SetSharedGroupDrv(sg)
+				err = dm.db().SetSharedGroupDrv(sg)
 			}
 		}
 		if err != nil {
@@ -2557,7 +3301,7 @@ func (dm *DataManager) GetActions(key string, skipCache bool, transactionID stri
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &as); err == nil {
-				err = dm.dataDB.SetActionsDrv(key, as)
+				err = dm.db().SetActionsDrv(key, as)
 			}
 		}
 		if err != nil {
@@ -2641,7 +3385,7 @@ func (dm *DataManager) GetActionPlan(key string, cacheRead, cacheWrite bool, tra
 		return
 	}
 
-	ats, err = dm.dataDB.GetActionPlanDrv(key)
+	ats, err = dm.db().GetActionPlanDrv(key)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionPlans]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -2652,7 +3396,7 @@ func (dm *DataManager) GetActionPlan(key string, cacheRead, cacheWrite bool, tra
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &ats); err == nil {
-				err = dm.dataDB.SetActionPlanDrv(key, ats)
+				err = dm.db().SetActionPlanDrv(key, ats)
 			}
 		}
 		if err != nil {
@@ -2702,7 +3446,7 @@ func (dm *DataManager) SetActionPlan(key string, ats *ActionPlan,
 		}
 	}
 
-	if err = dm.dataDB.SetActionPlanDrv(key, ats); err != nil {
+	if err = dm.db().SetActionPlanDrv(key, ats); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionPlans]
@@ -2723,7 +3467,7 @@ func (dm *DataManager) GetAllActionPlans() (ats map[string]*ActionPlan, err erro
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	ats, err = dm.dataDB.GetAllActionPlansDrv()
+	ats, err = dm.db().GetAllActionPlansDrv()
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionPlans]; ((err == nil && len(ats) == 0) || err == utils.ErrNotFound) && itm.Remote {
 		err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
 			utils.ReplicatorSv1GetAllActionPlans,
@@ -2746,7 +3490,7 @@ func (dm *DataManager) RemoveActionPlan(key string, transactionID string) (err e
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.RemoveActionPlanDrv(key); err != nil {
+	if err = dm.db().RemoveActionPlanDrv(key); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaActionPlans]
@@ -2774,7 +3518,7 @@ func (dm *DataManager) GetAccountActionPlans(acntID string, cacheRead, cacheWrit
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	apIDs, err = dm.dataDB.GetAccountActionPlansDrv(acntID)
+	apIDs, err = dm.db().GetAccountActionPlansDrv(acntID)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccountActionPlans]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -2786,7 +3530,7 @@ func (dm *DataManager) GetAccountActionPlans(acntID string, cacheRead, cacheWrit
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &apIDs); err == nil {
-				err = dm.dataDB.SetAccountActionPlansDrv(acntID, apIDs)
+				err = dm.db().SetAccountActionPlansDrv(acntID, apIDs)
 			}
 		}
 		if err != nil {
@@ -2832,7 +3576,7 @@ func (dm *DataManager) SetAccountActionPlans(acntID string, aPlIDs []string, ove
 		}
 	}
 
-	if err = dm.dataDB.SetAccountActionPlansDrv(acntID, aPlIDs); err != nil {
+	if err = dm.db().SetAccountActionPlansDrv(acntID, aPlIDs); err != nil {
 		return
 	}
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccountActionPlans]
@@ -2875,7 +3619,7 @@ func (dm *DataManager) RemAccountActionPlans(acntID string, apIDs []string) (err
 			return dm.SetAccountActionPlans(acntID, remainAAP, true)
 		}
 	}
-	if err = dm.dataDB.RemAccountActionPlansDrv(acntID); err != nil {
+	if err = dm.db().RemAccountActionPlansDrv(acntID); err != nil {
 		return
 	}
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAccountActionPlans]; itm.Replicate {
@@ -2919,7 +3663,7 @@ func (dm *DataManager) GetRatingPlan(key string, skipCache bool,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rp); err == nil {
-				err = dm.dataDB.SetRatingPlanDrv(rp)
+				err = dm.db().SetRatingPlanDrv(rp)
 			}
 		}
 		if err != nil {
@@ -2944,9 +3688,15 @@ func (dm *DataManager) SetRatingPlan(rp *RatingPlan) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	var oldRp any
+	if oldRp, err = dm.changedObject(utils.MetaRatingPlans, rp.Id); err != nil {
+		return
+	}
+	chg := dm.newChange(utils.MetaRatingPlans, rp.Id, oldRp)
 	if err = dm.DataDB().SetRatingPlanDrv(rp); err != nil {
 		return
 	}
+	dm.storeChange(chg, rp)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingPlans]
 	return dm.replicator.replicate(
 		utils.RatingPlanPrefix, rp.Id, // these are used to get the host IDs from cache
@@ -2963,9 +3713,15 @@ func (dm *DataManager) RemoveRatingPlan(key string, transactionID string) (err e
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	var oldRp any
+	if oldRp, err = dm.changedObject(utils.MetaRatingPlans, key); err != nil {
+		return
+	}
+	chg := dm.newChange(utils.MetaRatingPlans, key, oldRp)
 	if err = dm.DataDB().RemoveRatingPlanDrv(key); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingPlans]
 	_ = dm.replicator.replicate(
 		utils.RatingPlanPrefix, key, // these are used to get the host IDs from cache
@@ -3008,7 +3764,7 @@ func (dm *DataManager) GetRatingProfile(key string, skipCache bool,
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rpf); err == nil {
-				err = dm.dataDB.SetRatingProfileDrv(rpf)
+				err = dm.db().SetRatingProfileDrv(rpf)
 			}
 		}
 		if err != nil {
@@ -3033,9 +3789,15 @@ func (dm *DataManager) SetRatingProfile(rpf *RatingProfile) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	var oldRpf any
+	if oldRpf, err = dm.changedObject(utils.MetaRatingProfiles, rpf.Id); err != nil {
+		return
+	}
+	chg := dm.newChange(utils.MetaRatingProfiles, rpf.Id, oldRpf)
 	if err = dm.DataDB().SetRatingProfileDrv(rpf); err != nil {
 		return
 	}
+	dm.storeChange(chg, rpf)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingProfiles]
 	return dm.replicator.replicate(
 		utils.RatingProfilePrefix, rpf.Id, // these are used to get the host IDs from cache
@@ -3052,9 +3814,15 @@ func (dm *DataManager) RemoveRatingProfile(key string) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	var oldRpf any
+	if oldRpf, err = dm.changedObject(utils.MetaRatingProfiles, key); err != nil {
+		return
+	}
+	chg := dm.newChange(utils.MetaRatingProfiles, key, oldRpf)
 	if err = dm.DataDB().RemoveRatingProfileDrv(key); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRatingProfiles]
 	_ = dm.replicator.replicate(
 		utils.RatingProfilePrefix, key, // these are used to get the host IDs from cache
@@ -3091,7 +3859,7 @@ func (dm *DataManager) GetRouteProfile(tenant, id string, cacheRead, cacheWrite
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	rpp, err = dm.dataDB.GetRouteProfileDrv(tenant, id)
+	rpp, err = dm.db().GetRouteProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaRouteProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns, utils.ReplicatorSv1GetRouteProfile,
@@ -3101,7 +3869,7 @@ func (dm *DataManager) GetRouteProfile(tenant, id string, cacheRead, cacheWrite
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &rpp); err == nil {
-				err = dm.dataDB.SetRouteProfileDrv(rpp)
+				err = dm.db().SetRouteProfileDrv(rpp)
 			}
 		}
 		if err != nil {
@@ -3144,9 +3912,11 @@ func (dm *DataManager) SetRouteProfile(rpp *RouteProfile, withIndex bool) (err e
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaRouteProfiles, rpp.TenantID(), oldRpp)
 	if err = dm.DataDB().SetRouteProfileDrv(rpp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, rpp)
 	if withIndex {
 		var oldFiltersIDs *[]string
 		if oldRpp != nil {
@@ -3176,9 +3946,11 @@ func (dm *DataManager) RemoveRouteProfile(tenant, id string, withIndex bool) (er
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaRouteProfiles, utils.ConcatenatedKey(tenant, id), oldRpp)
 	if err = dm.DataDB().RemoveRouteProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldRpp == nil {
 		return utils.ErrNotFound
 	}
@@ -3222,7 +3994,7 @@ func (dm *DataManager) GetAttributeProfile(tenant, id string, cacheRead, cacheWr
 		err = utils.ErrNoDatabaseConn
 		return
 	} else {
-		if attrPrfl, err = dm.dataDB.GetAttributeProfileDrv(tenant, id); err != nil {
+		if attrPrfl, err = dm.db().GetAttributeProfileDrv(tenant, id); err != nil {
 			if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaAttributeProfiles]; err == utils.ErrNotFound && itm.Remote {
 				if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
 					utils.ReplicatorSv1GetAttributeProfile,
@@ -3232,7 +4004,7 @@ func (dm *DataManager) GetAttributeProfile(tenant, id string, cacheRead, cacheWr
 							utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 								config.CgrConfig().GeneralCfg().NodeID)),
 					}, &attrPrfl); err == nil {
-					err = dm.dataDB.SetAttributeProfileDrv(attrPrfl)
+					err = dm.db().SetAttributeProfileDrv(attrPrfl)
 				}
 			}
 			if err != nil {
@@ -3278,9 +4050,11 @@ func (dm *DataManager) SetAttributeProfile(ap *AttributeProfile, withIndex bool)
 	if len(ap.Contexts) == 0 {
 		ap.Contexts = append(ap.Contexts, utils.MetaAny)
 	}
+	chg := dm.newChange(utils.MetaAttributeProfiles, ap.TenantID(), oldAP)
 	if err = dm.DataDB().SetAttributeProfileDrv(ap); err != nil {
 		return err
 	}
+	dm.storeChange(chg, ap)
 	if withIndex {
 		var oldContexes *[]string
 		var oldFiltersIDs *[]string
@@ -3312,9 +4086,11 @@ func (dm *DataManager) RemoveAttributeProfile(tenant, id string, withIndex bool)
 	if err != nil {
 		return err
 	}
+	chg := dm.newChange(utils.MetaAttributeProfiles, utils.ConcatenatedKey(tenant, id), oldAttr)
 	if err = dm.DataDB().RemoveAttributeProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldAttr == nil {
 		return utils.ErrNotFound
 	}
@@ -3356,7 +4132,7 @@ func (dm *DataManager) GetChargerProfile(tenant, id string, cacheRead, cacheWrit
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	cpp, err = dm.dataDB.GetChargerProfileDrv(tenant, id)
+	cpp, err = dm.db().GetChargerProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaChargerProfiles]; err == utils.ErrNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -3367,7 +4143,7 @@ func (dm *DataManager) GetChargerProfile(tenant, id string, cacheRead, cacheWrit
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &cpp); err == nil {
-				err = dm.dataDB.SetChargerProfileDrv(cpp)
+				err = dm.db().SetChargerProfileDrv(cpp)
 			}
 		}
 		if err != nil {
@@ -3406,9 +4182,11 @@ func (dm *DataManager) SetChargerProfile(cpp *ChargerProfile, withIndex bool) (e
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaChargerProfiles, cpp.TenantID(), oldCpp)
 	if err = dm.DataDB().SetChargerProfileDrv(cpp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, cpp)
 	if withIndex {
 		var oldFiltersIDs *[]string
 		if oldCpp != nil {
@@ -3438,9 +4216,11 @@ func (dm *DataManager) RemoveChargerProfile(tenant, id string, withIndex bool) (
 	if err != nil && err != utils.ErrNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaChargerProfiles, utils.ConcatenatedKey(tenant, id), oldCpp)
 	if err = dm.DataDB().RemoveChargerProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldCpp == nil {
 		return utils.ErrNotFound
 	}
@@ -3480,7 +4260,7 @@ func (dm *DataManager) GetDispatcherProfile(tenant, id string, cacheRead, cacheW
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	dpp, err = dm.dataDB.GetDispatcherProfileDrv(tenant, id)
+	dpp, err = dm.db().GetDispatcherProfileDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherProfiles]; err == utils.ErrDSPProfileNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -3491,7 +4271,7 @@ func (dm *DataManager) GetDispatcherProfile(tenant, id string, cacheRead, cacheW
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &dpp); err == nil {
-				err = dm.dataDB.SetDispatcherProfileDrv(dpp)
+				err = dm.db().SetDispatcherProfileDrv(dpp)
 			}
 		}
 		if err != nil {
@@ -3532,9 +4312,11 @@ func (dm *DataManager) SetDispatcherProfile(dpp *DispatcherProfile, withIndex bo
 	if len(dpp.Subsystems) == 0 {
 		dpp.Subsystems = append(dpp.Subsystems, utils.MetaAny)
 	}
+	chg := dm.newChange(utils.MetaDispatcherProfiles, dpp.TenantID(), oldDpp)
 	if err = dm.DataDB().SetDispatcherProfileDrv(dpp); err != nil {
 		return err
 	}
+	dm.storeChange(chg, dpp)
 	if withIndex {
 		var oldContexes *[]string
 		var oldFiltersIDs *[]string
@@ -3566,9 +4348,11 @@ func (dm *DataManager) RemoveDispatcherProfile(tenant, id string, withIndex bool
 	if err != nil && err != utils.ErrDSPProfileNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaDispatcherProfiles, utils.ConcatenatedKey(tenant, id), oldDpp)
 	if err = dm.DataDB().RemoveDispatcherProfileDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldDpp == nil {
 		return utils.ErrDSPProfileNotFound
 	}
@@ -3610,7 +4394,7 @@ func (dm *DataManager) GetDispatcherHost(tenant, id string, cacheRead, cacheWrit
 		err = utils.ErrNoDatabaseConn
 		return
 	}
-	dH, err = dm.dataDB.GetDispatcherHostDrv(tenant, id)
+	dH, err = dm.db().GetDispatcherHostDrv(tenant, id)
 	if err != nil {
 		if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherHosts]; err == utils.ErrDSPHostNotFound && itm.Remote {
 			if err = dm.connMgr.Call(context.TODO(), config.CgrConfig().DataDbCfg().RmtConns,
@@ -3621,7 +4405,7 @@ func (dm *DataManager) GetDispatcherHost(tenant, id string, cacheRead, cacheWrit
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &dH); err == nil {
-				err = dm.dataDB.SetDispatcherHostDrv(dH)
+				err = dm.db().SetDispatcherHostDrv(dH)
 			}
 		}
 		if err != nil {
@@ -3649,9 +4433,15 @@ func (dm *DataManager) SetDispatcherHost(dpp *DispatcherHost) (err error) {
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
+	var oldDH any
+	if oldDH, err = dm.changedObject(utils.MetaDispatcherHosts, dpp.TenantID()); err != nil {
+		return
+	}
+	chg := dm.newChange(utils.MetaDispatcherHosts, dpp.TenantID(), oldDH)
 	if err = dm.DataDB().SetDispatcherHostDrv(dpp); err != nil {
 		return
 	}
+	dm.storeChange(chg, dpp)
 	itm := config.CgrConfig().DataDbCfg().Items[utils.MetaDispatcherHosts]
 	return dm.replicator.replicate(
 		utils.DispatcherHostPrefix, dpp.TenantID(), // these are used to get the host IDs from cache
@@ -3671,9 +4461,11 @@ func (dm *DataManager) RemoveDispatcherHost(tenant, id string) (err error) {
 	if err != nil && err != utils.ErrDSPHostNotFound {
 		return err
 	}
+	chg := dm.newChange(utils.MetaDispatcherHosts, utils.ConcatenatedKey(tenant, id), oldDpp)
 	if err = dm.DataDB().RemoveDispatcherHostDrv(tenant, id); err != nil {
 		return
 	}
+	dm.storeChange(chg, nil)
 	if oldDpp == nil {
 		return utils.ErrDSPHostNotFound
 	}
@@ -3706,7 +4498,7 @@ func (dm *DataManager) GetItemLoadIDs(itemIDPrefix string, cacheWrite bool) (loa
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &loadIDs); err == nil {
-				err = dm.dataDB.SetLoadIDsDrv(loadIDs)
+				err = dm.db().SetLoadIDsDrv(loadIDs)
 			}
 		}
 		if err != nil {
@@ -3742,6 +4534,7 @@ func (dm *DataManager) SetLoadIDs(loadIDs map[string]int64) (err error) {
 	if err = dm.DataDB().SetLoadIDsDrv(loadIDs); err != nil {
 		return
 	}
+	dm.tagRevisions(loadIDs)
 	if itm := config.CgrConfig().DataDbCfg().Items[utils.MetaLoadIDs]; itm.Replicate {
 		objIDs := make([]string, 0, len(loadIDs))
 		for k := range loadIDs {
@@ -3767,9 +4560,14 @@ func (dm *DataManager) Reconnect(marshaller string, newcfg *config.DataDbCfg, it
 	if err != nil {
 		return
 	}
-	// ToDo: consider locking
-	dm.dataDB.Close()
-	dm.dataDB = d
+	dm = dm.rootDM()
+	dm.migMux.RLock()
+	defer dm.migMux.RUnlock()
+	if dm.migration != nil { // would drop the writes mirrored on the other DataDB
+		d.Close()
+		return fmt.Errorf("DataDB migration in progress")
+	}
+	dm.setDataDB(d).Close()
 	return
 }
 
@@ -3820,7 +4618,7 @@ func (dm *DataManager) GetIndexes(idxItmType, tntCtx string, cacheRead, cacheWri
 						utils.FirstNonEmpty(config.CgrConfig().DataDbCfg().RmtConnID,
 							config.CgrConfig().GeneralCfg().NodeID)),
 				}, &indexes); err == nil {
-				err = dm.dataDB.SetIndexesDrv(idxItmType, tntCtx, indexes, true, utils.NonTransactional)
+				err = dm.db().SetIndexesDrv(idxItmType, tntCtx, indexes, true, utils.NonTransactional)
 			}
 		}
 		if err != nil {
@@ -3978,7 +4776,7 @@ func (dm *DataManager) GetSessionsBackup(nodeID, tenant string) ([]*StoredSessio
 	if dm == nil {
 		return nil, utils.ErrNoDatabaseConn
 	}
-	return dm.dataDB.GetSessionsBackupDrv(nodeID, tenant)
+	return dm.db().GetSessionsBackupDrv(nodeID, tenant)
 }
 
 type SetBackupSessionsArgs struct {
@@ -3993,7 +4791,7 @@ func (dm *DataManager) SetBackupSessions(nodeID, tenant string,
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.SetBackupSessionsDrv(nodeID, tenant, storedSessions); err != nil {
+	if err = dm.db().SetBackupSessionsDrv(nodeID, tenant, storedSessions); err != nil {
 		return
 	}
 
@@ -4019,7 +4817,7 @@ func (dm *DataManager) RemoveSessionsBackup(nodeID, tenant, cgrid string) (err e
 	if dm == nil {
 		return utils.ErrNoDatabaseConn
 	}
-	if err = dm.dataDB.RemoveSessionsBackupDrv(nodeID, tenant, cgrid); err != nil {
+	if err = dm.db().RemoveSessionsBackupDrv(nodeID, tenant, cgrid); err != nil {
 		return
 	}
 
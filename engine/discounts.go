@@ -0,0 +1,442 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// maxDiscountEvents limits the events remembered for each discount on the
+// account, enough to recognize the debits of the sessions still running
+const maxDiscountEvents = 50
+
+// DiscountProfile lowers the cost of the events matching its filters
+type DiscountProfile struct {
+	Tenant             string
+	ID                 string
+	FilterIDs          []string
+	ActivationInterval *utils.ActivationInterval // activation interval
+	Type               string                    // <*percent|*fixed|*free_events|*volume_tiers>
+	Value              float64                   // the percent, the amount per event or the number of free events
+	Tiers              []*DiscountTier           // used by *volume_tiers
+	Limit              float64                   // maximum amount discounted per account within the Period, 0 for unlimited
+	Period             string                    // <""|*daily|*monthly|*yearly|$duration> resetting the account usage, empty for never
+	Weight             float64
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (dp *DiscountProfile) GetTenant() string {
+	if dp == nil {
+		return utils.EmptyString
+	}
+	return dp.Tenant
+}
+
+// DiscountTier applies the Percent once the usage of the account reached Usage
+type DiscountTier struct {
+	Usage   time.Duration
+	Percent float64
+}
+
+// DiscountProfileWithAPIOpts is used in replicatorV1 for dispatcher
+type DiscountProfileWithAPIOpts struct {
+	*DiscountProfile
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (dp *DiscountProfile) TenantID() string {
+	return utils.ConcatenatedKey(dp.Tenant, dp.ID)
+}
+
+// Clone method for DiscountProfile
+func (dp *DiscountProfile) Clone() *DiscountProfile {
+	if dp == nil {
+		return nil
+	}
+	cln := &DiscountProfile{
+		Tenant:             dp.Tenant,
+		ID:                 dp.ID,
+		FilterIDs:          slices.Clone(dp.FilterIDs),
+		ActivationInterval: dp.ActivationInterval.Clone(),
+		Type:               dp.Type,
+		Value:              dp.Value,
+		Limit:              dp.Limit,
+		Period:             dp.Period,
+		Weight:             dp.Weight,
+	}
+	if dp.Tiers != nil {
+		cln.Tiers = make([]*DiscountTier, len(dp.Tiers))
+		for i, tr := range dp.Tiers {
+			cln.Tiers[i] = &DiscountTier{Usage: tr.Usage, Percent: tr.Percent}
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of DiscountProfile used by ltcache CacheCloner
+func (dp *DiscountProfile) CacheClone() any {
+	return dp.Clone()
+}
+
+// Validate checks the DiscountProfile before storing it
+func (dp *DiscountProfile) Validate() (err error) {
+	switch dp.Type {
+	case utils.MetaPercent:
+		if dp.Value <= 0 || dp.Value > 100 {
+			return fmt.Errorf("invalid %s value: %v", dp.Type, dp.Value)
+		}
+	case utils.MetaFixed, utils.MetaFreeEvents:
+		if dp.Value <= 0 {
+			return fmt.Errorf("invalid %s value: %v", dp.Type, dp.Value)
+		}
+	case utils.MetaVolumeTiers:
+		if len(dp.Tiers) == 0 {
+			return fmt.Errorf("no tiers defined for %s", dp.Type)
+		}
+		for _, tr := range dp.Tiers {
+			if tr == nil || tr.Usage < 0 || tr.Percent <= 0 || tr.Percent > 100 {
+				return fmt.Errorf("invalid tier: %s", utils.ToJSON(tr))
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported discount type: <%s>", dp.Type)
+	}
+	if dp.Limit < 0 {
+		return fmt.Errorf("invalid limit: %v", dp.Limit)
+	}
+	_, err = discountPeriodStart(dp.Period, time.Time{}, time.Now())
+	return
+}
+
+// amount returns the discount for the cost, ev being the event as recorded
+// on the account and isNew showing if the event was seen before
+func (dp *DiscountProfile) amount(cost float64, du *DiscountUsage, ev *DiscountEvent, isNew bool) (amount float64) {
+	switch dp.Type {
+	case utils.MetaPercent:
+		amount = cost * dp.Value / 100
+	case utils.MetaFixed:
+		amount = dp.Value - ev.Amount // the debits of one event share the amount
+	case utils.MetaFreeEvents:
+		if isNew {
+			ev.Free = float64(du.Events) <= dp.Value
+		}
+		if ev.Free {
+			amount = cost
+		}
+	case utils.MetaVolumeTiers:
+		var tier *DiscountTier
+		for _, tr := range dp.Tiers {
+			if du.Usage >= tr.Usage &&
+				(tier == nil || tr.Usage > tier.Usage) {
+				tier = tr
+			}
+		}
+		if tier != nil {
+			amount = cost * tier.Percent / 100
+		}
+	}
+	return min(amount, cost)
+}
+
+// discountPeriodStart returns the start of the period containing at,
+// prevStart being the start of the period known so far
+func discountPeriodStart(period string, prevStart, at time.Time) (time.Time, error) {
+	switch period {
+	case utils.EmptyString:
+		if prevStart.IsZero() {
+			return at, nil
+		}
+		return prevStart, nil
+	case utils.MetaDaily:
+		return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location()), nil
+	case utils.MetaMonthly:
+		return time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, at.Location()), nil
+	case utils.MetaYearly:
+		return time.Date(at.Year(), 1, 1, 0, 0, 0, 0, at.Location()), nil
+	}
+	dur, err := utils.ParseDurationWithNanosecs(period)
+	if err != nil {
+		return prevStart, err
+	}
+	if dur <= 0 {
+		return prevStart, fmt.Errorf("invalid discount period: %q", period)
+	}
+	if prevStart.IsZero() {
+		return at, nil
+	}
+	if at.Before(prevStart) {
+		return prevStart, nil
+	}
+	return prevStart.Add(at.Sub(prevStart) / dur * dur), nil
+}
+
+// DiscountUsage is what a DiscountProfile gave to an account within the current period
+type DiscountUsage struct {
+	PeriodStart time.Time
+	Events      int64            // matching events within the period
+	Usage       time.Duration    // usage accumulated for *volume_tiers
+	Amount      float64          // amount discounted within the period
+	Recent      []*DiscountEvent // last events, so the debits of one session count once
+}
+
+// DiscountEvent is the discount given to one event
+type DiscountEvent struct {
+	ID     string
+	Amount float64
+	Free   bool
+}
+
+// Clone method for DiscountUsage, returning an empty one for nil
+func (du *DiscountUsage) Clone() (cln *DiscountUsage) {
+	cln = new(DiscountUsage)
+	if du == nil {
+		return
+	}
+	*cln = *du
+	if du.Recent != nil {
+		cln.Recent = make([]*DiscountEvent, len(du.Recent))
+		for i, ev := range du.Recent {
+			cln.Recent[i] = &DiscountEvent{ID: ev.ID, Amount: ev.Amount, Free: ev.Free}
+		}
+	}
+	return
+}
+
+// resetPeriod resets the counters once at is in a new period
+func (du *DiscountUsage) resetPeriod(period string, at time.Time) (err error) {
+	var start time.Time
+	if start, err = discountPeriodStart(period, du.PeriodStart, at); err != nil {
+		return
+	}
+	if du.PeriodStart.IsZero() || start.After(du.PeriodStart) {
+		*du = DiscountUsage{PeriodStart: start}
+	}
+	return
+}
+
+// event returns the event with the given ID, registering it if not known
+func (du *DiscountUsage) event(id string) (ev *DiscountEvent, isNew bool) {
+	for _, ev = range du.Recent {
+		if ev.ID == id {
+			return
+		}
+	}
+	ev = &DiscountEvent{ID: id}
+	du.Events++
+	if du.Recent = append(du.Recent, ev); len(du.Recent) > maxDiscountEvents {
+		du.Recent = slices.Delete(du.Recent, 0, len(du.Recent)-maxDiscountEvents)
+	}
+	return ev, true
+}
+
+// DiscountCharge is the amount a DiscountProfile took off the cost
+type DiscountCharge struct {
+	DiscountID string
+	Amount     float64
+}
+
+// DiscountCharges are the discounts applied on one cost, informative as
+// the amounts are already taken off the cost of the increments
+type DiscountCharges []*DiscountCharge
+
+// Total returns the amount discounted
+func (dcs DiscountCharges) Total() (total float64) {
+	for _, dc := range dcs {
+		total += dc.Amount
+	}
+	return utils.Round(total, globalRoundingDecimals, utils.MetaRoundingMiddle)
+}
+
+// Clone method for DiscountCharges
+func (dcs DiscountCharges) Clone() (cln DiscountCharges) {
+	if dcs == nil {
+		return
+	}
+	cln = make(DiscountCharges, len(dcs))
+	for i, dc := range dcs {
+		cln[i] = &DiscountCharge{DiscountID: dc.DiscountID, Amount: dc.Amount}
+	}
+	return
+}
+
+// matchingDiscountProfiles returns the active DiscountProfiles matching cd, sorted by weight
+func matchingDiscountProfiles(cd *CallDescriptor, fltrS *FilterS) (dps []*DiscountProfile, err error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	ralsCfg := config.CgrConfig().RalsCfg()
+	evDP := utils.MapStorage{utils.MetaReq: cd.AsCGREvent(nil).Event}
+	var dpIDs utils.StringSet
+	if dpIDs, err = MatchingItemIDsForEvent(evDP,
+		ralsCfg.DiscountsStringIndexedFields,
+		ralsCfg.DiscountsPrefixIndexedFields,
+		ralsCfg.DiscountsSuffixIndexedFields,
+		ralsCfg.DiscountsExistsIndexedFields,
+		dm, utils.CacheDiscountFilterIndexes, cd.Tenant,
+		ralsCfg.DiscountsIndexedSelects,
+		ralsCfg.DiscountsNestedFields,
+	); err != nil {
+		if err == utils.ErrNotFound {
+			err = nil
+		}
+		return
+	}
+	for dpID := range dpIDs {
+		var dp *DiscountProfile
+		if dp, err = dm.GetDiscountProfile(cd.Tenant, dpID,
+			true, true, utils.NonTransactional); err != nil {
+			if err == utils.ErrNotFound { // removed in the meantime
+				err = nil
+				continue
+			}
+			return
+		}
+		if dp.ActivationInterval != nil &&
+			!dp.ActivationInterval.IsActiveAtTime(cd.TimeStart) {
+			continue
+		}
+		var pass bool
+		if pass, err = fltrS.Pass(cd.Tenant, dp.FilterIDs, evDP); err != nil {
+			return
+		} else if !pass {
+			continue
+		}
+		dps = append(dps, dp)
+	}
+	sort.Slice(dps, func(i, j int) bool {
+		if dps[i].Weight == dps[j].Weight {
+			return dps[i].ID < dps[j].ID
+		}
+		return dps[i].Weight > dps[j].Weight
+	})
+	return
+}
+
+// discounted reports if the increment can be discounted. With acc only the
+// increments paid out of its monetary balances are, so the discount can be
+// given back to them
+func discounted(incr *Increment, acc *Account) bool {
+	if incr.Cost <= 0 {
+		return false
+	}
+	if acc == nil {
+		return true
+	}
+	bi := incr.BalanceInfo
+	return bi != nil && bi.Monetary != nil && bi.AccountID == acc.ID
+}
+
+// applyDiscounts takes off the cost of cc the discounts of the profiles
+// matching cd, in the order of their weight. The discount lowers the cost of
+// the increments, so a later refund gives back only what was paid. With
+// consume the usage is recorded on the account and the discounted amount is
+// returned to the balances which paid for the event, otherwise the account
+// stays untouched
+func applyDiscounts(cd *CallDescriptor, cc *CallCost, acc *Account, fltrS *FilterS,
+	consume bool) (err error) {
+	if cc.Cost <= 0 || acc == nil {
+		return
+	}
+	var dps []*DiscountProfile
+	if dps, err = matchingDiscountProfiles(cd, fltrS); err != nil || len(dps) == 0 {
+		return
+	}
+	var payer *Account // the account paying for the increments discounted
+	cost := cc.Cost
+	if consume {
+		payer = acc
+		var paid float64
+		for _, ts := range cc.Timespans {
+			for _, incr := range ts.Increments {
+				if discounted(incr, payer) {
+					paid += incr.GetCost() * float64(ts.GetCompressFactor())
+				}
+			}
+		}
+		paid = utils.Round(paid, globalRoundingDecimals, utils.MetaRoundingMiddle)
+		if cost = min(cost, paid); cost <= 0 {
+			return
+		}
+	}
+	evID := utils.GenUUID()
+	if cd.CgrID != utils.EmptyString {
+		evID = utils.ConcatenatedKey(cd.CgrID, cd.RunID)
+	}
+	var total float64
+	for _, dp := range dps {
+		du := acc.Discounts[dp.ID].Clone()
+		if err = du.resetPeriod(dp.Period, cd.TimeStart); err != nil {
+			return
+		}
+		ev, isNew := du.event(evID)
+		amount := dp.amount(cost-total, du, ev, isNew)
+		if dp.Limit > 0 {
+			amount = min(amount, dp.Limit-du.Amount)
+		}
+		if dp.Type == utils.MetaVolumeTiers {
+			du.Usage += cd.GetDuration()
+		}
+		if amount = utils.Round(amount, globalRoundingDecimals, utils.MetaRoundingMiddle); amount > 0 {
+			ev.Amount += amount
+			du.Amount += amount
+			total += amount
+			cc.Discounts = append(cc.Discounts, &DiscountCharge{DiscountID: dp.ID, Amount: amount})
+		}
+		if consume {
+			if acc.Discounts == nil {
+				acc.Discounts = make(map[string]*DiscountUsage)
+			}
+			acc.Discounts[dp.ID] = du
+		}
+		if total >= cost {
+			break
+		}
+	}
+	if total <= 0 {
+		return
+	}
+	factor := max(1-total/cost, 0)
+	for _, ts := range cc.Timespans {
+		if ts.Increments.Length() == 0 {
+			continue
+		}
+		for _, incr := range ts.Increments {
+			if !discounted(incr, payer) {
+				continue
+			}
+			if consume {
+				if blc := acc.BalanceMap[utils.MetaMonetary].GetBalance(
+					incr.BalanceInfo.Monetary.UUID); blc != nil {
+					blc.AddValue(incr.GetCost() * float64(ts.GetCompressFactor()) * (1 - factor))
+				}
+			}
+			incr.Cost *= factor
+		}
+		ts.Cost = ts.CalculateCost()
+	}
+	cc.Cost = utils.Round(cc.Cost-total, globalRoundingDecimals, utils.MetaRoundingMiddle)
+	return
+}
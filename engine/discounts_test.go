@@ -0,0 +1,333 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestDiscountProfileValidate(t *testing.T) {
+	for _, dp := range []*DiscountProfile{
+		{Type: utils.MetaPercent, Value: 10},
+		{Type: utils.MetaFixed, Value: 0.5, Limit: 10, Period: utils.MetaMonthly},
+		{Type: utils.MetaFreeEvents, Value: 3, Period: "24h"},
+		{Type: utils.MetaVolumeTiers, Tiers: []*DiscountTier{{Usage: time.Hour, Percent: 5}}},
+	} {
+		if err := dp.Validate(); err != nil {
+			t.Errorf("%s: %v", utils.ToJSON(dp), err)
+		}
+	}
+	for _, dp := range []*DiscountProfile{
+		{Type: "*unknown", Value: 10},
+		{Type: utils.MetaPercent, Value: 110},
+		{Type: utils.MetaFixed},
+		{Type: utils.MetaVolumeTiers},
+		{Type: utils.MetaVolumeTiers, Tiers: []*DiscountTier{{Usage: time.Hour}}},
+		{Type: utils.MetaPercent, Value: 10, Limit: -1},
+		{Type: utils.MetaPercent, Value: 10, Period: "-1h"},
+		{Type: utils.MetaPercent, Value: 10, Period: "*weekly"},
+	} {
+		if err := dp.Validate(); err == nil {
+			t.Errorf("expected error for %s", utils.ToJSON(dp))
+		}
+	}
+}
+
+func TestDiscountPeriodStart(t *testing.T) {
+	prev := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	at := time.Date(2026, 3, 15, 18, 30, 0, 0, time.UTC)
+	for period, exp := range map[string]time.Time{
+		utils.EmptyString: prev,
+		utils.MetaDaily:   time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		utils.MetaMonthly: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		utils.MetaYearly:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		"168h":            time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC),
+	} {
+		if rcv, err := discountPeriodStart(period, prev, at); err != nil {
+			t.Error(err)
+		} else if !rcv.Equal(exp) {
+			t.Errorf("%q: expected %v, received %v", period, exp, rcv)
+		}
+	}
+	if rcv, err := discountPeriodStart("168h", time.Time{}, at); err != nil {
+		t.Error(err)
+	} else if !rcv.Equal(at) {
+		t.Errorf("expected %v, received %v", at, rcv)
+	}
+}
+
+func TestDiscountUsageRecent(t *testing.T) {
+	du := new(DiscountUsage)
+	for i := range maxDiscountEvents + 5 {
+		if _, isNew := du.event(utils.IfaceAsString(i)); !isNew {
+			t.Fatalf("event %d should be new", i)
+		}
+	}
+	if du.Events != maxDiscountEvents+5 || len(du.Recent) != maxDiscountEvents {
+		t.Errorf("received %d events, %d recent", du.Events, len(du.Recent))
+	}
+	if _, isNew := du.event(utils.IfaceAsString(maxDiscountEvents + 4)); isNew {
+		t.Error("expected to find the last event")
+	}
+	if _, isNew := du.event("0"); !isNew {
+		t.Error("expected the oldest event to be dropped")
+	}
+}
+
+func testDiscountCallCost(cost float64) *CallCost {
+	return &CallCost{
+		Cost: cost,
+		Timespans: TimeSpans{{
+			Increments: Increments{{
+				Cost: cost,
+				BalanceInfo: &DebitInfo{
+					AccountID: "cgrates.org:1001",
+					Monetary:  &MonetaryInfo{UUID: "MONEY1"},
+				},
+				CompressFactor: 1,
+			}},
+		}},
+	}
+}
+
+func TestApplyDiscounts(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	dm = NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CacheDiscountProfiles, utils.CacheDiscountFilterIndexes})
+	fltrS := NewFilterS(cfg, nil, dm)
+	for _, dp := range []*DiscountProfile{
+		{Tenant: "cgrates.org", ID: "DSC_PERCENT", FilterIDs: []string{"*string:~*req.Destination:1002"},
+			Type: utils.MetaPercent, Value: 10, Limit: 1.5, Period: utils.MetaDaily},
+		{Tenant: "cgrates.org", ID: "DSC_FREE", FilterIDs: []string{"*string:~*req.Destination:1003"},
+			Type: utils.MetaFreeEvents, Value: 1},
+		{Tenant: "cgrates.org", ID: "DSC_FIXED", FilterIDs: []string{"*string:~*req.Destination:1004"},
+			Type: utils.MetaFixed, Value: 2},
+		{Tenant: "cgrates.org", ID: "DSC_TIERS", FilterIDs: []string{"*string:~*req.Destination:1005"},
+			Type: utils.MetaVolumeTiers, Tiers: []*DiscountTier{{Usage: time.Minute, Percent: 50}}},
+		{Tenant: "cgrates.org", ID: "DSC_INACTIVE", FilterIDs: []string{"*string:~*req.Destination:1002"},
+			Type: utils.MetaPercent, Value: 50, Weight: 10,
+			ActivationInterval: &utils.ActivationInterval{ExpiryTime: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}},
+	} {
+		if err := dm.SetDiscountProfile(dp, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {{Uuid: "MONEY1", ID: "MONEY1", Value: 5}},
+		},
+	}
+	tStart := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	newCD := func(cgrID, dst string) *CallDescriptor {
+		return &CallDescriptor{Tenant: "cgrates.org", Account: "1001", Subject: "1001",
+			Destination: dst, CgrID: cgrID, RunID: utils.MetaDefault, ToR: utils.MetaVoice,
+			TimeStart: tStart, TimeEnd: tStart.Add(time.Minute)}
+	}
+	apply := func(cd *CallDescriptor, cost float64, consume bool) *CallCost {
+		t.Helper()
+		cc := testDiscountCallCost(cost)
+		if err := applyDiscounts(cd, cc, acc, fltrS, consume); err != nil {
+			t.Fatal(err)
+		}
+		return cc
+	}
+
+	// preview leaves the account untouched
+	if cc := apply(newCD("CGR1", "1002"), 10, false); cc.Cost != 9 {
+		t.Errorf("expected cost 9, received %v", cc.Cost)
+	} else if acc.Discounts != nil || acc.BalanceMap[utils.MetaMonetary][0].Value != 5 {
+		t.Errorf("account changed: %s", utils.ToJSON(acc))
+	}
+	cc := apply(newCD("CGR1", "1002"), 10, true)
+	if exp := (DiscountCharges{{DiscountID: "DSC_PERCENT", Amount: 1}}); cc.Cost != 9 ||
+		!reflect.DeepEqual(exp, cc.Discounts) {
+		t.Errorf("received cost %v, discounts %s", cc.Cost, utils.ToJSON(cc.Discounts))
+	} else if incr := cc.Timespans[0].Increments[0]; incr.Cost != 9 || cc.Timespans[0].Cost != 9 {
+		t.Errorf("expected the increment cost to be discounted, received %s", utils.ToJSON(cc.Timespans[0]))
+	}
+	if blc := acc.BalanceMap[utils.MetaMonetary][0].Value; blc != 6 {
+		t.Errorf("expected balance 6, received %v", blc)
+	}
+	// the next debit of the session is capped by the limit
+	if cc = apply(newCD("CGR1", "1002"), 10, true); cc.Cost != 9.5 {
+		t.Errorf("expected cost 9.5, received %v", cc.Cost)
+	}
+	if du := acc.Discounts["DSC_PERCENT"]; du.Events != 1 || du.Amount != 1.5 {
+		t.Errorf("received usage %s", utils.ToJSON(du))
+	}
+	if cc = apply(newCD("CGR2", "1002"), 10, true); cc.Cost != 10 || len(cc.Discounts) != 0 {
+		t.Errorf("received cost %v, discounts %s", cc.Cost, utils.ToJSON(cc.Discounts))
+	}
+	// the limit is reset with the next day
+	cd := newCD("CGR3", "1002")
+	cd.TimeStart = cd.TimeStart.Add(24 * time.Hour)
+	cd.TimeEnd = cd.TimeEnd.Add(24 * time.Hour)
+	if cc = apply(cd, 10, true); cc.Cost != 9 {
+		t.Errorf("expected cost 9, received %v", cc.Cost)
+	}
+
+	// the first event is free, including its next debits
+	for _, cgrID := range []string{"CGR4", "CGR4"} {
+		if cc = apply(newCD(cgrID, "1003"), 3, true); cc.Cost != 0 {
+			t.Errorf("expected cost 0, received %v", cc.Cost)
+		}
+	}
+	if cc = apply(newCD("CGR5", "1003"), 3, true); cc.Cost != 3 {
+		t.Errorf("expected cost 3, received %v", cc.Cost)
+	}
+
+	// the fixed amount is shared by the debits of the event
+	if cc = apply(newCD("CGR6", "1004"), 1.5, true); cc.Cost != 0 {
+		t.Errorf("expected cost 0, received %v", cc.Cost)
+	}
+	if cc = apply(newCD("CGR6", "1004"), 1.5, true); cc.Cost != 1 {
+		t.Errorf("expected cost 1, received %v", cc.Cost)
+	}
+
+	// the tier applies once the usage was reached
+	if cc = apply(newCD("CGR7", "1005"), 4, true); cc.Cost != 4 {
+		t.Errorf("expected cost 4, received %v", cc.Cost)
+	}
+	if cc = apply(newCD("CGR8", "1005"), 4, true); cc.Cost != 2 {
+		t.Errorf("expected cost 2, received %v", cc.Cost)
+	}
+	if du := acc.Discounts["DSC_TIERS"]; du.Usage != 2*time.Minute {
+		t.Errorf("received usage %s", utils.ToJSON(du))
+	}
+	if _, has := acc.Discounts["DSC_INACTIVE"]; has {
+		t.Error("inactive profile applied")
+	}
+}
+
+func TestApplyDiscountsRefund(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	dm = NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CacheDiscountProfiles, utils.CacheDiscountFilterIndexes})
+	fltrS := NewFilterS(cfg, nil, dm)
+	if err := dm.SetDiscountProfile(&DiscountProfile{Tenant: "cgrates.org", ID: "DSC_PERCENT",
+		FilterIDs: []string{"*string:~*req.Destination:1002"},
+		Type:      utils.MetaPercent, Value: 10}, true); err != nil {
+		t.Fatal(err)
+	}
+	// 10 were already debited out of the 20 on MONEY1
+	acc := &Account{
+		ID: "cgrates.org:1001",
+		BalanceMap: map[string]Balances{
+			utils.MetaMonetary: {
+				{Uuid: "MONEY1", ID: "MONEY1", Value: 10},
+				{Uuid: "MONEY2", ID: utils.MetaDefault, Value: 0},
+			},
+		},
+	}
+	tStart := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	cd := &CallDescriptor{Tenant: "cgrates.org", Account: "1001", Subject: "1001",
+		Destination: "1002", CgrID: "CGR1", RunID: utils.MetaDefault, ToR: utils.MetaVoice,
+		TimeStart: tStart, TimeEnd: tStart.Add(time.Minute)}
+	cc := testDiscountCallCost(10)
+	if err := applyDiscounts(cd, cc, acc, fltrS, true); err != nil {
+		t.Fatal(err)
+	}
+	if cc.Cost != 9 {
+		t.Errorf("expected cost 9, received %v", cc.Cost)
+	}
+	// the balance which paid gets the discount, not the *default one
+	if blc := acc.BalanceMap[utils.MetaMonetary][0].Value; blc != 11 {
+		t.Errorf("expected balance 11, received %v", blc)
+	} else if blc = acc.BalanceMap[utils.MetaMonetary][1].Value; blc != 0 {
+		t.Errorf("expected the *default balance untouched, received %v", blc)
+	}
+	if err := dm.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+	// refunding the whole event reverses the discount
+	rcd := cc.CreateCallDescriptor()
+	rcd.Increments = cc.Timespans[0].Increments
+	if _, err := rcd.refundIncrements(fltrS); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := dm.GetAccount(acc.ID); err != nil {
+		t.Fatal(err)
+	} else if blc := rcv.BalanceMap[utils.MetaMonetary][0].Value; blc != 20 {
+		t.Errorf("expected balance 20, received %v", blc)
+	}
+
+	// increments paid by other accounts are not discounted
+	cc = testDiscountCallCost(10)
+	cc.Timespans[0].Increments[0].BalanceInfo.AccountID = "cgrates.org:1002"
+	if err := applyDiscounts(cd, cc, acc, fltrS, true); err != nil {
+		t.Fatal(err)
+	} else if cc.Cost != 10 || len(cc.Discounts) != 0 {
+		t.Errorf("received cost %v, discounts %s", cc.Cost, utils.ToJSON(cc.Discounts))
+	}
+}
+
+func TestEventCostDiscounts(t *testing.T) {
+	ec := testEC.Clone()
+	cost := ec.GetCost()
+	ec.Discounts = DiscountCharges{{DiscountID: "DSC1", Amount: 0.1}}
+	ec.ResetCounters()
+	// the discounts are already part of the cost of the charges
+	if rcv := ec.GetCost(); rcv != cost {
+		t.Errorf("expected cost %v, received %v", cost, rcv)
+	}
+	if cln := ec.Clone(); !reflect.DeepEqual(ec.Discounts, cln.Discounts) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(ec.Discounts), utils.ToJSON(cln.Discounts))
+	}
+	cc := ec.AsCallCost(utils.EmptyString)
+	if cc.Cost != cost || !reflect.DeepEqual(ec.Discounts, cc.Discounts) {
+		t.Errorf("received cost %v, discounts %s", cc.Cost, utils.ToJSON(cc.Discounts))
+	}
+	if rcv := NewEventCostFromCallCost(cc, ec.CGRID, ec.RunID); !reflect.DeepEqual(ec.Discounts, rcv.Discounts) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(ec.Discounts), utils.ToJSON(rcv.Discounts))
+	}
+	ec.Merge(testEC.Clone())
+	if len(ec.Discounts) != 1 {
+		t.Errorf("received discounts %s", utils.ToJSON(ec.Discounts))
+	}
+	// the discounts stay with the event when trimming
+	if srplus, err := ec.Trim(0); err != nil {
+		t.Fatal(err)
+	} else if len(srplus.Discounts) != 0 || len(ec.Discounts) != 1 {
+		t.Errorf("received %s, surplus %s", utils.ToJSON(ec.Discounts), utils.ToJSON(srplus.Discounts))
+	} else if rcv := ec.GetCost(); rcv != 0 {
+		t.Errorf("expected cost 0, received %v", rcv)
+	}
+}
@@ -112,6 +112,14 @@ type DispatcherProfile struct {
 	Hosts              DispatcherHostProfiles // dispatch to these connections
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (dp *DispatcherProfile) GetTenant() string {
+	if dp == nil {
+		return utils.EmptyString
+	}
+	return dp.Tenant
+}
+
 // Clone method for DispatcherProfile
 func (dp *DispatcherProfile) Clone() *DispatcherProfile {
 	if dp == nil {
@@ -177,6 +185,14 @@ type DispatcherHost struct {
 	rpcConn birpc.ClientConnector
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (dH *DispatcherHost) GetTenant() string {
+	if dH == nil {
+		return utils.EmptyString
+	}
+	return dH.Tenant
+}
+
 // DispatcherHostWithOpts is used in replicatorV1 for dispatcher
 type DispatcherHostWithAPIOpts struct {
 	*DispatcherHost
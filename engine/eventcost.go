@@ -47,6 +47,7 @@ func NewEventCostFromCallCost(cc *CallCost, cgrID, runID string) (ec *EventCost)
 	ec.CGRID = cgrID
 	ec.RunID = runID
 	ec.AccountSummary = cc.AccountSummary
+	ec.Discounts = cc.Discounts.Clone()
 	if len(cc.Timespans) != 0 {
 		ec.Charges = make([]*ChargingInterval, len(cc.Timespans))
 		ec.StartTime = cc.Timespans[0].TimeStart
@@ -167,10 +168,29 @@ type EventCost struct {
 	RatingFilters  RatingFilters
 	Rates          ChargedRates
 	Timings        ChargedTimings
+	Discounts      DiscountCharges `json:",omitempty"` // amounts already taken off the cost of the Charges
 
 	cache *utils.SecureMapStorage
 }
 
+// AccountID returns the tenant:account which paid the costs
+func (ec *EventCost) AccountID() string {
+	if ec == nil {
+		return utils.EmptyString
+	}
+	if ec.AccountSummary != nil && ec.AccountSummary.AccountID != utils.EmptyString {
+		return utils.ConcatenatedKey(ec.AccountSummary.Tenant, ec.AccountSummary.AccountID)
+	}
+	for _, cIl := range ec.Charges {
+		for _, cIt := range cIl.Increments {
+			if bc, has := ec.Accounting[cIt.AccountingID]; has && bc.AccountID != utils.EmptyString {
+				return bc.AccountID
+			}
+		}
+	}
+	return utils.EmptyString
+}
+
 func (ec *EventCost) initCache() {
 	if ec != nil {
 		ec.cache = utils.NewSecureMapStorage()
@@ -288,6 +308,7 @@ func (ec *EventCost) Clone() (cln *EventCost) {
 	if ec.Timings != nil {
 		cln.Timings = ec.Timings.Clone()
 	}
+	cln.Discounts = ec.Discounts.Clone()
 	return
 }
 
@@ -430,6 +451,7 @@ func (ec *EventCost) AsCallCost(tor string) *CallCost {
 		Cost:           ec.GetCost(),
 		RatedUsage:     float64(ec.GetUsage().Nanoseconds()),
 		AccountSummary: ec.AccountSummary,
+		Discounts:      ec.Discounts.Clone(),
 	}
 	cc.Timespans = make(TimeSpans, len(ec.Charges))
 	for i, cIl := range ec.Charges {
@@ -732,6 +754,7 @@ func (ec *EventCost) Merge(ecs ...*EventCost) {
 		// updated AccountSummary information
 		newEC.AccountSummary.UpdateInitialValue(ec.AccountSummary)
 		ec.AccountSummary = newEC.AccountSummary
+		ec.Discounts = append(ec.Discounts, newEC.Discounts.Clone()...)
 		for cIlIdx := range newEC.Charges {
 			ec.appendChargingIntervalFromEventCost(newEC, cIlIdx)
 		}
@@ -842,7 +865,8 @@ func (ec *EventCost) Trim(atUsage time.Duration) (srplusEC *EventCost, err error
 		ec.RunID = srplusEC.RunID
 		ec.StartTime = srplusEC.StartTime
 		ec.AccountSummary = srplusEC.AccountSummary.Clone()
-		return // trim all, fresh EC with 0 usage
+		ec.Discounts, srplusEC.Discounts = srplusEC.Discounts, nil // the discounts stay with the event
+		return                                                     // trim all, fresh EC with 0 usage
 	}
 
 	srplusEC = NewBareEventCost()
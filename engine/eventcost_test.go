@@ -5032,3 +5032,28 @@ func TestConvertToEventCost(t *testing.T) {
 		t.Error("Expected error for invalid JSON")
 	}
 }
+
+func TestEventCostAccountID(t *testing.T) {
+	var ec *EventCost
+	if rcv := ec.AccountID(); rcv != utils.EmptyString {
+		t.Errorf("expected empty account, received: %q", rcv)
+	}
+	ec = &EventCost{
+		Charges: []*ChargingInterval{{
+			Increments: []*ChargingIncrement{
+				{AccountingID: "ACNT_FREE"},
+				{AccountingID: "ACNT1"},
+			},
+		}},
+		Accounting: Accounting{
+			"ACNT1": &BalanceCharge{AccountID: "cgrates.org:1001"},
+		},
+	}
+	if rcv := ec.AccountID(); rcv != "cgrates.org:1001" {
+		t.Errorf("expected cgrates.org:1001, received: %q", rcv)
+	}
+	ec.AccountSummary = &AccountSummary{Tenant: "cgrates.org", AccountID: "1002"}
+	if rcv := ec.AccountID(); rcv != "cgrates.org:1002" {
+		t.Errorf("expected cgrates.org:1002, received: %q", rcv)
+	}
+}
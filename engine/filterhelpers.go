@@ -159,6 +159,20 @@ func WeightFromDynamics(dWs []*utils.DynamicWeight,
 	return 0.0, nil
 }
 
+// weightForEvent returns the weight of the first DynamicWeight matching the event,
+// falling back on the static weight when none matches
+func weightForEvent(dWs utils.DynamicWeights, weight float64,
+	fltrS *FilterS, tnt string, ev utils.DataProvider) (float64, error) {
+	for _, dW := range dWs {
+		if pass, err := fltrS.Pass(tnt, dW.FilterIDs, ev); err != nil {
+			return 0, err
+		} else if pass {
+			return dW.Weight, nil
+		}
+	}
+	return weight, nil
+}
+
 // fail or pass the filter based on sentrypeer server response
 func GetSentryPeer(val string, sentryPeerCfg *config.SentryPeerCfg, dataType string) (found bool, err error) {
 	itemId := utils.ConcatenatedKey(dataType, val)
@@ -23,6 +23,8 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -73,7 +75,7 @@ func (fS *FilterS) Pass(tenant string, filterIDs []string,
 			continue
 		}
 		for _, fltr := range f.Rules {
-			if pass, err = fltr.Pass(dDP); err != nil || !pass {
+			if pass, err = fltr.pass(dDP, []string{fltrID}); err != nil || !pass {
 				return pass, err
 			}
 		}
@@ -140,11 +142,12 @@ func (fS *FilterS) LazyPass(tenant string, filterIDs []string,
 		}
 
 		for _, rule := range f.Rules {
-			if !verifyPrefixes(rule, pathPrfxs) {
+			if rule.IsComposite() || // the referenced filters may need the lazy data
+				!verifyPrefixes(rule, pathPrfxs) {
 				lazyCheckRules = append(lazyCheckRules, rule)
 				continue
 			}
-			if pass, err = rule.Pass(dDP); err != nil || !pass {
+			if pass, err = rule.pass(dDP, []string{fltrID}); err != nil || !pass {
 				return
 			}
 		}
@@ -203,6 +206,14 @@ type Filter struct {
 	ActivationInterval *utils.ActivationInterval
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (fltr *Filter) GetTenant() string {
+	if fltr == nil {
+		return utils.EmptyString
+	}
+	return fltr.Tenant
+}
+
 // Clone method for Filter
 func (fltr *Filter) Clone() *Filter {
 	if fltr == nil {
@@ -243,6 +254,7 @@ func (fltr *Filter) TenantID() string {
 // Compile will compile the underlaying request filters where necessary (ie. regexp rules)
 func (fltr *Filter) Compile() (err error) {
 	for _, rf := range fltr.Rules {
+		rf.tenant = fltr.Tenant // needed by the composite rules to get the referenced filters
 		if err = rf.CompileValues(); err != nil {
 			return
 		}
@@ -250,25 +262,38 @@ func (fltr *Filter) Compile() (err error) {
 	return
 }
 
+// hasCompositeRules returns true if any of the rules is a *or/*nor rule
+func (fltr *Filter) hasCompositeRules() bool {
+	for _, rf := range fltr.Rules {
+		if rf.IsComposite() {
+			return true
+		}
+	}
+	return false
+}
+
 var supportedFiltersType utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaString, utils.MetaContains, utils.MetaPrefix, utils.MetaSuffix,
 	utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations, utils.MetaHTTP,
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessThan, utils.MetaLessOrEqual,
 	utils.MetaGreaterThan, utils.MetaGreaterOrEqual, utils.MetaEqual,
 	utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaOr, utils.MetaNor,
+	utils.MetaGeoIPCountry, utils.MetaGeoIPASN, utils.MetaGeoIPCity, utils.MetaPorted})
 var needsFieldName utils.StringSet = utils.NewStringSet([]string{
 	utils.MetaString, utils.MetaContains, utils.MetaPrefix, utils.MetaSuffix,
 	utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations, utils.MetaLessThan,
 	utils.MetaEmpty, utils.MetaExists, utils.MetaLessOrEqual, utils.MetaGreaterThan,
 	utils.MetaGreaterOrEqual, utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer,
 	utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaGeoIPCountry, utils.MetaGeoIPASN, utils.MetaGeoIPCity,
+	utils.MetaPorted})
 var needsValues utils.StringSet = utils.NewStringSet([]string{utils.MetaString, utils.MetaContains, utils.MetaPrefix,
 	utils.MetaSuffix, utils.MetaTimings, utils.MetaRSR, utils.MetaDestinations,
 	utils.MetaLessThan, utils.MetaLessOrEqual, utils.MetaGreaterThan, utils.MetaGreaterOrEqual,
 	utils.MetaEqual, utils.MetaIPNet, utils.MetaAPIBan, utils.MetaSentryPeer, utils.MetaActivationInterval,
-	utils.MetaRegex})
+	utils.MetaRegex, utils.MetaOr, utils.MetaNor,
+	utils.MetaGeoIPCountry, utils.MetaGeoIPASN, utils.MetaGeoIPCity})
 
 // NewFilterRule returns a new filter
 func NewFilterRule(rfType, fieldName string, vals []string) (*FilterRule, error) {
@@ -313,6 +338,7 @@ type FilterRule struct {
 	rsrFilters  utils.RSRFilters  // Cache here the RSRFilter Values
 	regexValues []*regexp.Regexp
 	negative    *bool
+	tenant      string // tenant of the filters referenced by the composite rules
 }
 
 // Clone method for FilterRule
@@ -323,6 +349,7 @@ func (fltr *FilterRule) Clone() *FilterRule {
 	clone := &FilterRule{
 		Type:    fltr.Type,
 		Element: fltr.Element,
+		tenant:  fltr.tenant,
 	}
 	if fltr.Values != nil {
 		clone.Values = make([]string, len(fltr.Values))
@@ -351,9 +378,20 @@ func (fltr *FilterRule) Clone() *FilterRule {
 	return clone
 }
 
+// IsComposite returns true for the *or/*nor rules (negated included) which reference other filters in their Values
+func (fltr *FilterRule) IsComposite() bool {
+	switch fltr.Type {
+	case utils.MetaOr, utils.MetaNotOr, utils.MetaNor, utils.MetaNotNor:
+		return true
+	}
+	return false
+}
+
 // CompileValues compiles RSR fields
 func (fltr *FilterRule) CompileValues() (err error) {
 	switch fltr.Type {
+	case utils.MetaOr, utils.MetaNotOr, utils.MetaNor, utils.MetaNotNor: // the values are filter IDs and the element is not used
+		return
 	case utils.MetaRegex, utils.MetaNotRegex:
 		fltr.regexValues = make([]*regexp.Regexp, len(fltr.Values))
 		for i, val := range fltr.Values {
@@ -388,6 +426,12 @@ func (fltr *FilterRule) CompileValues() (err error) {
 
 // Pass is the method which should be used from outside.
 func (fltr *FilterRule) Pass(dDP utils.DataProvider) (result bool, err error) {
+	return fltr.pass(dDP, nil)
+}
+
+// pass checks the rule keeping in refs the filters referenced by the composite rules
+// on the current evaluation path in order to detect the circular references
+func (fltr *FilterRule) pass(dDP utils.DataProvider, refs []string) (result bool, err error) {
 	if fltr.negative == nil {
 		fltr.negative = utils.BoolPointer(strings.HasPrefix(fltr.Type, utils.MetaNot))
 	}
@@ -425,6 +469,17 @@ func (fltr *FilterRule) Pass(dDP utils.DataProvider) (result bool, err error) {
 		result, err = fltr.passActivationInterval(dDP)
 	case utils.MetaRegex, utils.MetaNotRegex:
 		result, err = fltr.passRegex(dDP)
+	case utils.MetaGeoIPCountry, utils.MetaNotGeoIPCountry,
+		utils.MetaGeoIPASN, utils.MetaNotGeoIPASN,
+		utils.MetaGeoIPCity, utils.MetaNotGeoIPCity:
+		result, err = fltr.passGeoIP(dDP)
+	case utils.MetaPorted, utils.MetaNotPorted:
+		result, err = fltr.passPorted(dDP)
+	case utils.MetaOr, utils.MetaNotOr:
+		result, err = fltr.passComposite(dDP, refs)
+	case utils.MetaNor, utils.MetaNotNor:
+		result, err = fltr.passComposite(dDP, refs)
+		result = !result
 	default:
 		if strings.HasPrefix(fltr.Type, utils.MetaHTTP) && strings.Index(fltr.Type, "#") == len(utils.MetaHTTP) {
 			result, err = fltr.passHttp(dDP)
@@ -764,6 +819,85 @@ func (fltr *FilterRule) passSentryPeer(dDP utils.DataProvider) (bool, error) {
 	return GetSentryPeer(strVal, config.CgrConfig().SentryPeerCfg(), fltr.Values[0])
 }
 
+// passGeoIP checks the location of the IP from Element against the values using the GeoIP databases
+func (fltr *FilterRule) passGeoIP(dDP utils.DataProvider) (bool, error) {
+	strVal, err := fltr.rsrElement.ParseDataProvider(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if net.ParseIP(strVal) == nil {
+		return false, nil
+	}
+	var location string
+	switch fltr.Type {
+	case utils.MetaGeoIPCountry, utils.MetaNotGeoIPCountry:
+		location, err = utils.GeoIP.Country(strVal)
+	case utils.MetaGeoIPCity, utils.MetaNotGeoIPCity:
+		location, err = utils.GeoIP.City(strVal)
+	default:
+		var asn int64
+		if asn, err = utils.GeoIP.ASN(strVal); err == nil {
+			location = strconv.FormatInt(asn, 10)
+		}
+	}
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	isASN := location != utils.EmptyString &&
+		(fltr.Type == utils.MetaGeoIPASN || fltr.Type == utils.MetaNotGeoIPASN)
+	for _, val := range fltr.rsrValues {
+		sval, err := val.ParseDataProvider(dDP)
+		if err != nil {
+			continue
+		}
+		if isASN { // accept both 15169 and AS15169 formats
+			sval = strings.TrimPrefix(strings.ToUpper(sval), "AS")
+		}
+		if strings.EqualFold(sval, location) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// passPorted checks if the number from Element was ported, optionally only to
+// one of the routing numbers from values
+func (fltr *FilterRule) passPorted(dDP utils.DataProvider) (bool, error) {
+	strVal, err := fltr.rsrElement.ParseDataProvider(dDP)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	rn, err := dm.GetPortedNumber(strVal, true, true, utils.NonTransactional)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if len(fltr.rsrValues) == 0 {
+		return true, nil
+	}
+	for _, val := range fltr.rsrValues {
+		sval, err := val.ParseDataProvider(dDP)
+		if err != nil {
+			continue
+		}
+		if sval == rn {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func parseTime(rsr *config.RSRParser, dDp utils.DataProvider) (_ time.Time, err error) {
 	var str string
 	if str, err = rsr.ParseDataProvider(dDp); err != nil {
@@ -802,6 +936,47 @@ func (fltr *FilterRule) passActivationInterval(dDp utils.DataProvider) (bool, er
 	return startTime.Before(timeVal), nil
 }
 
+// passComposite returns true if any of the filters referenced in Values is passing
+// the values can be filter IDs or inline filters
+func (fltr *FilterRule) passComposite(dDP utils.DataProvider, refs []string) (bool, error) {
+	tnt := fltr.tenant
+	if tnt == utils.EmptyString {
+		tnt = config.CgrConfig().GeneralCfg().DefaultTenant
+		if dynDP, canCast := dDP.(*dynamicDP); canCast && dynDP.tenant != utils.EmptyString {
+			tnt = dynDP.tenant
+		}
+	}
+	for _, fltrID := range fltr.Values {
+		if slices.Contains(refs, fltrID) {
+			return false, fmt.Errorf("circular reference to filter <%s>", fltrID)
+		}
+		f, err := dm.GetFilter(tnt, fltrID,
+			true, true, utils.NonTransactional)
+		if err != nil {
+			if err == utils.ErrNotFound {
+				err = utils.ErrPrefixNotFound(fltrID)
+			}
+			return false, err
+		}
+		if f.ActivationInterval != nil &&
+			!f.ActivationInterval.IsActiveAtTime(time.Now()) { // not active
+			continue
+		}
+		pass := true
+		for _, rule := range f.Rules {
+			if pass, err = rule.pass(dDP, append(refs, fltrID)); err != nil {
+				return false, err
+			} else if !pass {
+				break
+			}
+		}
+		if pass {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func verifyInlineFilterS(fltrs []string) (err error) {
 	for _, fl := range fltrs {
 		if strings.HasPrefix(fl, utils.Meta) {
@@ -815,6 +990,18 @@ func verifyInlineFilterS(fltrs []string) (err error) {
 
 func CheckFilter(fltr *Filter) (err error) {
 	for _, rls := range fltr.Rules {
+		if rls.IsComposite() {
+			if len(rls.Values) == 0 {
+				return fmt.Errorf("no filters referenced by rule <%s> for filter <%v>", rls.Type, fltr)
+			}
+			if slices.Contains(rls.Values, fltr.ID) {
+				return fmt.Errorf("filter <%s> is referencing itself", fltr.ID)
+			}
+			if err = verifyInlineFilterS(rls.Values); err != nil {
+				return fmt.Errorf("%s for filter <%v>", err, fltr) //encapsulated error
+			}
+			continue
+		}
 		valFunc := utils.IsPathValid
 		if rls.Type == utils.MetaEmpty || rls.Type == utils.MetaExists {
 			valFunc = utils.IsPathValidForExporters
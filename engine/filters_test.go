@@ -4220,3 +4220,213 @@ func TestFilterToSQLQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterPassComposite(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	tmpDm := dm
+	defer func() {
+		SetDataStorage(tmpDm)
+		Cache.Clear(nil)
+	}()
+	Cache.Clear(nil)
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmFltr := NewDataManager(data, cfg.CacheCfg(), nil)
+	SetDataStorage(dmFltr)
+	fltrS := NewFilterS(cfg, nil, dmFltr)
+	for _, fltr := range []*Filter{
+		{
+			Tenant: "cgrates.org",
+			ID:     "FLTR_DST_UK",
+			Rules: []*FilterRule{
+				{
+					Type:    utils.MetaPrefix,
+					Element: "~*req.Destination",
+					Values:  []string{"4420", "4430"},
+				},
+			},
+		},
+		{
+			Tenant: "cgrates.org",
+			ID:     "FLTR_ACNT_GRP_X",
+			Rules: []*FilterRule{
+				{
+					Type:    utils.MetaString,
+					Element: "~*req.Account",
+					Values:  []string{"1001", "1002"},
+				},
+				{
+					Type:    utils.MetaString,
+					Element: "~*req.Category",
+					Values:  []string{"call"},
+				},
+			},
+		},
+		{
+			Tenant: "cgrates.org",
+			ID:     "FLTR_COMPOSITE",
+			Rules: []*FilterRule{
+				{
+					Type:   utils.MetaOr,
+					Values: []string{"FLTR_DST_UK", "*string:~*req.Destination:1003"},
+				},
+				{
+					Type:   utils.MetaNor,
+					Values: []string{"FLTR_ACNT_GRP_X"},
+				},
+			},
+		},
+		{
+			Tenant: "cgrates.org",
+			ID:     "FLTR_LOOP1",
+			Rules: []*FilterRule{
+				{
+					Type:   utils.MetaOr,
+					Values: []string{"FLTR_LOOP2"},
+				},
+			},
+		},
+		{
+			Tenant: "cgrates.org",
+			ID:     "FLTR_LOOP2",
+			Rules: []*FilterRule{
+				{
+					Type:   utils.MetaOr,
+					Values: []string{"FLTR_LOOP1"},
+				},
+			},
+		},
+	} {
+		if err := dmFltr.SetFilter(fltr, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		ev   map[string]any
+		pass bool
+	}{
+		{
+			name: "first OR branch",
+			ev:   map[string]any{utils.Destination: "442012345", utils.AccountField: "1005", utils.Category: "call"},
+			pass: true,
+		},
+		{
+			name: "second OR branch",
+			ev:   map[string]any{utils.Destination: "1003", utils.AccountField: "1001", utils.Category: "sms"},
+			pass: true,
+		},
+		{
+			name: "no OR branch",
+			ev:   map[string]any{utils.Destination: "4910", utils.AccountField: "1005", utils.Category: "call"},
+			pass: false,
+		},
+		{
+			name: "excluded by NOR group",
+			ev:   map[string]any{utils.Destination: "443012345", utils.AccountField: "1002", utils.Category: "call"},
+			pass: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if pass, err := fltrS.Pass("cgrates.org", []string{"FLTR_COMPOSITE"},
+				utils.MapStorage{utils.MetaReq: tc.ev}); err != nil {
+				t.Error(err)
+			} else if pass != tc.pass {
+				t.Errorf("expected %v, received %v", tc.pass, pass)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		fltr string
+		pass bool
+	}{
+		{"*nor::FLTR_DST_UK", true},
+		{"*notor::FLTR_DST_UK", true},
+		{"*or::FLTR_DST_UK", false},
+		{"*notnor::FLTR_DST_UK", false},
+	} {
+		if pass, err := fltrS.Pass("cgrates.org", []string{tc.fltr},
+			utils.MapStorage{utils.MetaReq: map[string]any{utils.Destination: "4910"}}); err != nil {
+			t.Errorf("inline filter %s: %v", tc.fltr, err)
+		} else if pass != tc.pass {
+			t.Errorf("inline filter %s expected %v, received %v", tc.fltr, tc.pass, pass)
+		}
+	}
+
+	expErr := "circular reference to filter <FLTR_LOOP1>"
+	if _, err := fltrS.Pass("cgrates.org", []string{"FLTR_LOOP1"},
+		utils.MapStorage{utils.MetaReq: map[string]any{}}); err == nil || err.Error() != expErr {
+		t.Errorf("expected error <%s>, received <%v>", expErr, err)
+	}
+
+	expErr = "filter <FLTR_SELF> is referencing itself"
+	if err := dmFltr.SetFilter(&Filter{
+		Tenant: "cgrates.org",
+		ID:     "FLTR_SELF",
+		Rules: []*FilterRule{
+			{
+				Type:   utils.MetaOr,
+				Values: []string{"FLTR_SELF"},
+			},
+		},
+	}, true); err == nil || err.Error() != expErr {
+		t.Errorf("expected error <%s>, received <%v>", expErr, err)
+	}
+}
+
+func TestFilterPassGeoIP(t *testing.T) {
+	if err := utils.GeoIP.Load("../data/geoip/GeoLite2-City-Test.mmdb",
+		"../data/geoip/GeoLite2-ASN-Test.mmdb"); err != nil {
+		t.Fatal(err)
+	}
+	defer utils.GeoIP.Close()
+	dDP := utils.MapStorage{
+		utils.MetaReq: utils.MapStorage{
+			"IP":      "8.8.8.8",
+			"OtherIP": "200.1.1.1",
+			"WrongIP": "8.8.8.",
+			"Country": "us",
+		},
+	}
+	for _, tc := range []struct {
+		rule    string
+		element string
+		values  []string
+		pass    bool
+	}{
+		{utils.MetaGeoIPCountry, "~*req.IP", []string{"RO", "US"}, true},
+		{utils.MetaGeoIPCountry, "~*req.IP", []string{"~*req.Country"}, true},
+		{utils.MetaGeoIPCountry, "~*req.IP", []string{"RO"}, false},
+		{utils.MetaNotGeoIPCountry, "~*req.IP", []string{"RO"}, true},
+		{utils.MetaGeoIPCountry, "~*req.OtherIP", []string{"US"}, false},
+		{utils.MetaNotGeoIPCountry, "~*req.OtherIP", []string{"US"}, true},
+		{utils.MetaGeoIPCountry, "~*req.WrongIP", []string{"US"}, false},
+		{utils.MetaGeoIPCountry, "~*req.MissingIP", []string{"US"}, false},
+		{utils.MetaGeoIPASN, "~*req.IP", []string{"15169"}, true},
+		{utils.MetaGeoIPASN, "~*req.IP", []string{"AS15169"}, true},
+		{utils.MetaNotGeoIPASN, "~*req.IP", []string{"as15169"}, false},
+		{utils.MetaGeoIPCity, "~*req.IP", []string{"Mountain View"}, true},
+	} {
+		rf, err := NewFilterRule(tc.rule, tc.element, tc.values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pass, err := rf.Pass(dDP); err != nil {
+			t.Errorf("%s%s%v: %v", tc.rule, tc.element, tc.values, err)
+		} else if pass != tc.pass {
+			t.Errorf("%s%s%v: expected %v, received %v", tc.rule, tc.element, tc.values, tc.pass, pass)
+		}
+	}
+	utils.GeoIP.Close()
+	rf, err := NewFilterRule(utils.MetaGeoIPCountry, "~*req.IP", []string{"US"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rf.Pass(dDP); err != utils.ErrNotConnected {
+		t.Errorf("Expected %v, received: %v", utils.ErrNotConnected, err)
+	}
+}
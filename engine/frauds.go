@@ -0,0 +1,879 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package engine
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+const (
+	fraudMinCostSamples = 5   // costs seen before *cost_spike starts scoring
+	fraudMaxCostSamples = 100 // costs weighted into the moving average
+	fraudUsagePrefix    = "fraud_"
+)
+
+// FraudProfile scores the matching events and contains the subjects reaching its levels
+type FraudProfile struct {
+	Tenant             string
+	ID                 string
+	FilterIDs          []string
+	ActivationInterval *utils.ActivationInterval // activation interval
+	Subject            string                    // RSR rule extracting the subject of the cases, defaults to ~*req.Account
+	Signals            []*FraudSignal
+	Levels             []*FraudLevel
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (fp *FraudProfile) GetTenant() string {
+	if fp == nil {
+		return utils.EmptyString
+	}
+	return fp.Tenant
+}
+
+// FraudSignal adds its Score to the case when the event looks suspicious
+type FraudSignal struct {
+	Type      string   // <*destination_risk|*velocity|*concurrent_calls|*cost_spike|*geo_mismatch>
+	FilterIDs []string // the signal is only checked for the events passing these
+	Field     string   // the destination, cost or session field checked, defaults per type
+	Values    []string // destination IDs for *destination_risk, the two fields compared for *geo_mismatch
+	Window    time.Duration
+	Threshold float64 // events per Window, concurrent sessions or cost multiplier
+	Score     float64
+}
+
+// FraudLevel runs its Actions once per case when the case score reaches Score
+type FraudLevel struct {
+	Score         float64
+	Actions       []string // <*log|*throttle|*block_account|*terminate_sessions>
+	ThrottleUnits float64  // units allocated by *throttle on the matching resources, defaults to 1
+}
+
+// FraudProfileWithAPIOpts is used in replicatorV1 for dispatcher
+type FraudProfileWithAPIOpts struct {
+	*FraudProfile
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (fp *FraudProfile) TenantID() string {
+	return utils.ConcatenatedKey(fp.Tenant, fp.ID)
+}
+
+// Clone method for FraudProfile
+func (fp *FraudProfile) Clone() *FraudProfile {
+	if fp == nil {
+		return nil
+	}
+	cln := &FraudProfile{
+		Tenant:             fp.Tenant,
+		ID:                 fp.ID,
+		FilterIDs:          slices.Clone(fp.FilterIDs),
+		ActivationInterval: fp.ActivationInterval.Clone(),
+		Subject:            fp.Subject,
+	}
+	if fp.Signals != nil {
+		cln.Signals = make([]*FraudSignal, len(fp.Signals))
+		for i, sig := range fp.Signals {
+			cln.Signals[i] = &FraudSignal{
+				Type:      sig.Type,
+				FilterIDs: slices.Clone(sig.FilterIDs),
+				Field:     sig.Field,
+				Values:    slices.Clone(sig.Values),
+				Window:    sig.Window,
+				Threshold: sig.Threshold,
+				Score:     sig.Score,
+			}
+		}
+	}
+	if fp.Levels != nil {
+		cln.Levels = make([]*FraudLevel, len(fp.Levels))
+		for i, lvl := range fp.Levels {
+			cln.Levels[i] = &FraudLevel{
+				Score:         lvl.Score,
+				Actions:       slices.Clone(lvl.Actions),
+				ThrottleUnits: lvl.ThrottleUnits,
+			}
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of FraudProfile used by ltcache CacheCloner
+func (fp *FraudProfile) CacheClone() any {
+	return fp.Clone()
+}
+
+// Validate checks the FraudProfile before storing it
+func (fp *FraudProfile) Validate() (err error) {
+	if _, err = config.NewRSRParsers(fp.subjectRule(), utils.InfieldSep); err != nil {
+		return fmt.Errorf("invalid subject <%s>: %s", fp.Subject, err)
+	}
+	if len(fp.Signals) == 0 {
+		return fmt.Errorf("no signals defined")
+	}
+	for _, sig := range fp.Signals {
+		if sig == nil || sig.Score <= 0 {
+			return fmt.Errorf("invalid signal: %s", utils.ToJSON(sig))
+		}
+		switch sig.Type {
+		case utils.MetaDestinationRisk:
+			if len(sig.Values) == 0 {
+				return fmt.Errorf("no destinations defined for %s", sig.Type)
+			}
+		case utils.MetaVelocity:
+			if sig.Window <= 0 || sig.Threshold <= 0 {
+				return fmt.Errorf("%s needs a positive window and threshold", sig.Type)
+			}
+		case utils.MetaConcurrentCalls, utils.MetaCostSpike:
+			if sig.Threshold <= 0 {
+				return fmt.Errorf("%s needs a positive threshold", sig.Type)
+			}
+		case utils.MetaGeoMismatch:
+			if len(sig.Values) != 2 {
+				return fmt.Errorf("%s compares exactly two fields", sig.Type)
+			}
+		default:
+			return fmt.Errorf("unsupported signal type: <%s>", sig.Type)
+		}
+	}
+	for _, lvl := range fp.Levels {
+		if lvl == nil || lvl.Score <= 0 || len(lvl.Actions) == 0 {
+			return fmt.Errorf("invalid level: %s", utils.ToJSON(lvl))
+		}
+		for _, act := range lvl.Actions {
+			switch act {
+			case utils.MetaLog, utils.MetaThrottle, utils.MetaBlockAccount, utils.MetaTerminateSessions:
+			default:
+				return fmt.Errorf("unsupported level action: <%s>", act)
+			}
+		}
+	}
+	return
+}
+
+func (fp *FraudProfile) subjectRule() string {
+	if fp.Subject == utils.EmptyString {
+		return utils.DynamicDataPrefix + utils.MetaReq + utils.NestingSep + utils.AccountField
+	}
+	return fp.Subject
+}
+
+// FraudCase gathers the evidence scored against one subject of a FraudProfile
+type FraudCase struct {
+	Tenant         string
+	ID             string
+	ProfileID      string
+	Subject        string
+	Status         string // <*open|*under_review|*whitelisted|*closed>
+	Score          float64
+	Levels         []float64      // scores of the levels already executed
+	ThrottleEvent  map[string]any // event throttled on ResourceS, released when the case is closed
+	Account        string         // Account of the event which ran *block_account
+	AccountBlocked bool           // the account was disabled by the case
+	Evidence       []*FraudEvidence
+	Notes          []*FraudNote
+	OpenedAt       time.Time
+	UpdatedAt      time.Time
+}
+
+// FraudEvidence records one scored event
+type FraudEvidence struct {
+	Time    time.Time
+	EventID string
+	Signals []*FraudSignalHit
+	Event   map[string]any
+}
+
+// FraudSignalHit is a signal which matched the event
+type FraudSignalHit struct {
+	Type   string
+	Score  float64
+	Detail string
+}
+
+// FraudNote is left on the case by the status changes
+type FraudNote struct {
+	Time   time.Time
+	Status string
+	Note   string
+}
+
+// FraudCaseWithAPIOpts is used in replicatorV1 for dispatcher
+type FraudCaseWithAPIOpts struct {
+	*FraudCase
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (fc *FraudCase) TenantID() string {
+	return utils.ConcatenatedKey(fc.Tenant, fc.ID)
+}
+
+// archived returns the closed case under the ID it is kept with after a new
+// case of the subject is opened: <ProfileID>:<Subject>:<OpenedAt in nanoseconds>
+func (fc *FraudCase) archived() (cln *FraudCase) {
+	cln = fc.Clone()
+	cln.ID = utils.ConcatenatedKey(fc.ID, strconv.FormatInt(fc.OpenedAt.UnixNano(), 10))
+	return
+}
+
+// Clone method for FraudCase
+func (fc *FraudCase) Clone() *FraudCase {
+	if fc == nil {
+		return nil
+	}
+	cln := &FraudCase{
+		Tenant:         fc.Tenant,
+		ID:             fc.ID,
+		ProfileID:      fc.ProfileID,
+		Subject:        fc.Subject,
+		Status:         fc.Status,
+		Score:          fc.Score,
+		Levels:         slices.Clone(fc.Levels),
+		Account:        fc.Account,
+		AccountBlocked: fc.AccountBlocked,
+		OpenedAt:       fc.OpenedAt,
+		UpdatedAt:      fc.UpdatedAt,
+	}
+	if fc.ThrottleEvent != nil {
+		cln.ThrottleEvent = make(map[string]any, len(fc.ThrottleEvent))
+		for k, v := range fc.ThrottleEvent {
+			cln.ThrottleEvent[k] = v
+		}
+	}
+	if fc.Evidence != nil {
+		cln.Evidence = make([]*FraudEvidence, len(fc.Evidence))
+		for i, evd := range fc.Evidence {
+			cln.Evidence[i] = &FraudEvidence{
+				Time:    evd.Time,
+				EventID: evd.EventID,
+				Event:   evd.Event, // events are not changed once recorded
+			}
+			if evd.Signals != nil {
+				cln.Evidence[i].Signals = make([]*FraudSignalHit, len(evd.Signals))
+				for j, hit := range evd.Signals {
+					cln.Evidence[i].Signals[j] = &FraudSignalHit{Type: hit.Type, Score: hit.Score, Detail: hit.Detail}
+				}
+			}
+		}
+	}
+	if fc.Notes != nil {
+		cln.Notes = make([]*FraudNote, len(fc.Notes))
+		for i, nt := range fc.Notes {
+			cln.Notes[i] = &FraudNote{Time: nt.Time, Status: nt.Status, Note: nt.Note}
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of FraudCase used by ltcache CacheCloner
+func (fc *FraudCase) CacheClone() any {
+	return fc.Clone()
+}
+
+// closed returns true if the case does not collect evidence anymore
+func (fc *FraudCase) closed() bool {
+	return fc.Status == utils.MetaClosed || fc.Status == utils.MetaWhitelisted
+}
+
+// addEvidence records the hits and returns the levels reached by the new score
+func (fc *FraudCase) addEvidence(fp *FraudProfile, evd *FraudEvidence, maxEvidence int) (lvls []*FraudLevel) {
+	for _, hit := range evd.Signals {
+		fc.Score += hit.Score
+	}
+	fc.Evidence = append(fc.Evidence, evd)
+	if maxEvidence > 0 && len(fc.Evidence) > maxEvidence {
+		fc.Evidence = fc.Evidence[len(fc.Evidence)-maxEvidence:]
+	}
+	fc.UpdatedAt = evd.Time
+	for _, lvl := range fp.Levels {
+		if fc.Score >= lvl.Score && !slices.Contains(fc.Levels, lvl.Score) {
+			fc.Levels = append(fc.Levels, lvl.Score)
+			lvls = append(lvls, lvl)
+		}
+	}
+	sort.Slice(lvls, func(i, j int) bool { return lvls[i].Score < lvls[j].Score })
+	return
+}
+
+// FraudCaseArgs changes the status of a FraudCase
+type FraudCaseArgs struct {
+	Tenant  string
+	ID      string
+	Note    string
+	APIOpts map[string]any
+}
+
+// fraudTracker keeps the in-memory history of one signal for one subject
+type fraudTracker struct {
+	events   []time.Time // *velocity
+	costAvg  float64     // *cost_spike
+	costSeen int
+	window   time.Duration // the history is kept at least this long after the last event
+	lastSeen time.Time
+}
+
+// NewFraudS returns the FraudS service
+func NewFraudS(cfg *config.CGRConfig, dm *DataManager, fltrS *FilterS, connMgr *ConnManager) *FraudS {
+	return &FraudS{
+		cfg:      cfg,
+		dm:       dm,
+		fltrS:    fltrS,
+		connMgr:  connMgr,
+		trackers: make(map[string]*fraudTracker),
+	}
+}
+
+// FraudS scores the events against the FraudProfiles and manages the FraudCases
+type FraudS struct {
+	cfg     *config.CGRConfig
+	dm      *DataManager
+	fltrS   *FilterS
+	connMgr *ConnManager
+
+	trackersMux   sync.Mutex
+	trackers      map[string]*fraudTracker // per tenant, profile, subject and signal
+	trackersSwept time.Time
+}
+
+// matchingFraudProfiles returns the active FraudProfiles matching the event
+func (fS *FraudS) matchingFraudProfiles(tnt string, evDP utils.MapStorage, at time.Time) (fps []*FraudProfile, err error) {
+	var fpIDs utils.StringSet
+	if fpIDs, err = MatchingItemIDsForEvent(evDP,
+		fS.cfg.FraudSCfg().StringIndexedFields,
+		fS.cfg.FraudSCfg().PrefixIndexedFields,
+		fS.cfg.FraudSCfg().SuffixIndexedFields,
+		fS.cfg.FraudSCfg().ExistsIndexedFields,
+		fS.dm, utils.CacheFraudFilterIndexes, tnt,
+		fS.cfg.FraudSCfg().IndexedSelects,
+		fS.cfg.FraudSCfg().NestedFields,
+	); err != nil {
+		return
+	}
+	for _, fpID := range slices.Sorted(maps.Keys(fpIDs)) {
+		var fp *FraudProfile
+		if fp, err = fS.dm.GetFraudProfile(tnt, fpID,
+			true, true, utils.NonTransactional); err != nil {
+			if err == utils.ErrNotFound { // removed in the meantime
+				err = nil
+				continue
+			}
+			return
+		}
+		if fp.ActivationInterval != nil &&
+			!fp.ActivationInterval.IsActiveAtTime(at) {
+			continue
+		}
+		var pass bool
+		if pass, err = fS.fltrS.Pass(tnt, fp.FilterIDs, evDP); err != nil {
+			return
+		} else if !pass {
+			continue
+		}
+		fps = append(fps, fp)
+	}
+	return
+}
+
+// tracker returns the history of the signal, created on first use
+func (fS *FraudS) tracker(key string, window time.Duration, at time.Time) *fraudTracker {
+	trk, has := fS.trackers[key]
+	if !has {
+		trk = &fraudTracker{window: window}
+		fS.trackers[key] = trk
+	}
+	trk.lastSeen = at
+	return trk
+}
+
+// evictTrackers drops the history of the subjects without events for longer
+// than trackers_ttl or the Window of their signal, sweeping at most once per ttl
+func (fS *FraudS) evictTrackers(at time.Time) {
+	ttl := fS.cfg.FraudSCfg().TrackersTTL
+	if ttl <= 0 {
+		return
+	}
+	fS.trackersMux.Lock()
+	defer fS.trackersMux.Unlock()
+	if at.Sub(fS.trackersSwept) < ttl {
+		return
+	}
+	fS.trackersSwept = at
+	for key, trk := range fS.trackers {
+		if idle := at.Sub(trk.lastSeen); idle > ttl && idle > trk.window {
+			delete(fS.trackers, key)
+		}
+	}
+}
+
+// scoreSignals checks the signals of the profile against the event
+func (fS *FraudS) scoreSignals(ctx *context.Context, fp *FraudProfile, subject string,
+	ev *utils.CGREvent, evDP utils.DataProvider, at time.Time) (hits []*FraudSignalHit, err error) {
+	for i, sig := range fp.Signals {
+		var pass bool
+		if pass, err = fS.fltrS.Pass(ev.Tenant, sig.FilterIDs, evDP); err != nil {
+			return
+		} else if !pass {
+			continue
+		}
+		var detail string
+		switch sig.Type {
+		case utils.MetaDestinationRisk:
+			fld := utils.FirstNonEmpty(sig.Field, utils.DynamicDataPrefix+utils.MetaReq+utils.NestingSep+utils.Destination)
+			if pass, err = fS.fltrS.Pass(ev.Tenant, []string{utils.MetaDestinations + utils.InInFieldSep +
+				fld + utils.InInFieldSep + strings.Join(sig.Values, utils.PipeSep)}, evDP); err != nil {
+				return
+			} else if pass {
+				detail = fmt.Sprintf("destination in %s", strings.Join(sig.Values, utils.InfieldSep))
+			}
+		case utils.MetaVelocity:
+			trkKey := utils.ConcatenatedKey(fp.TenantID(), subject, utils.IfaceAsString(i))
+			fS.trackersMux.Lock()
+			trk := fS.tracker(trkKey, sig.Window, at)
+			trk.events = append(trk.events, at)
+			var idx int
+			for idx < len(trk.events) && at.Sub(trk.events[idx]) > sig.Window {
+				idx++
+			}
+			trk.events = trk.events[idx:]
+			cnt := len(trk.events)
+			fS.trackersMux.Unlock()
+			if float64(cnt) > sig.Threshold {
+				detail = fmt.Sprintf("%d events within %s", cnt, sig.Window)
+			}
+		case utils.MetaConcurrentCalls:
+			if len(fS.cfg.FraudSCfg().SessionSConns) == 0 {
+				return nil, utils.NewErrNotConnected(utils.SessionS)
+			}
+			fld := utils.FirstNonEmpty(sig.Field, fp.subjectRule())
+			var cnt int
+			if err = fS.connMgr.Call(ctx, fS.cfg.FraudSCfg().SessionSConns,
+				utils.SessionSv1GetActiveSessionsCount, &utils.SessionFilter{
+					Tenant:  ev.Tenant,
+					Filters: []string{utils.MetaString + utils.InInFieldSep + fld + utils.InInFieldSep + subject},
+				}, &cnt); err != nil {
+				if err.Error() != utils.ErrNotFound.Error() {
+					return
+				}
+				err = nil
+			}
+			if float64(cnt) >= sig.Threshold {
+				detail = fmt.Sprintf("%d concurrent sessions", cnt)
+			}
+		case utils.MetaCostSpike:
+			fld := utils.FirstNonEmpty(sig.Field, utils.DynamicDataPrefix+utils.MetaReq+utils.NestingSep+utils.Cost)
+			var costIface any
+			if costIface, err = utils.DPDynamicInterface(fld, evDP); err != nil {
+				if err != utils.ErrNotFound {
+					return
+				}
+				err = nil
+				continue
+			}
+			var cost float64
+			if cost, err = utils.IfaceAsFloat64(costIface); err != nil {
+				return
+			}
+			trkKey := utils.ConcatenatedKey(fp.TenantID(), subject, utils.IfaceAsString(i))
+			fS.trackersMux.Lock()
+			trk := fS.tracker(trkKey, 0, at)
+			if trk.costSeen >= fraudMinCostSamples && trk.costAvg > 0 &&
+				cost > trk.costAvg*sig.Threshold {
+				detail = fmt.Sprintf("cost %v over the average of %v", cost, trk.costAvg)
+			}
+			if trk.costSeen < fraudMaxCostSamples {
+				trk.costSeen++
+			}
+			trk.costAvg += (cost - trk.costAvg) / float64(trk.costSeen)
+			fS.trackersMux.Unlock()
+		case utils.MetaGeoMismatch:
+			var vals [2]string
+			for j, rule := range sig.Values {
+				var rsr config.RSRParsers
+				if rsr, err = config.NewRSRParsers(rule, utils.InfieldSep); err != nil {
+					return
+				}
+				if vals[j], err = rsr.ParseDataProvider(evDP); err != nil {
+					if err != utils.ErrNotFound {
+						return
+					}
+					err = nil
+				}
+			}
+			if vals[0] != utils.EmptyString && vals[1] != utils.EmptyString &&
+				vals[0] != vals[1] {
+				detail = fmt.Sprintf("%s differs from %s", vals[0], vals[1])
+			}
+		}
+		if detail != utils.EmptyString {
+			hits = append(hits, &FraudSignalHit{Type: sig.Type, Score: sig.Score, Detail: detail})
+		}
+	}
+	return
+}
+
+// processEvent scores the event against all the matching profiles and
+// returns the IDs of the updated cases
+func (fS *FraudS) processEvent(ctx *context.Context, ev *utils.CGREvent) (caseIDs []string, err error) {
+	at := time.Now()
+	fS.evictTrackers(at)
+	evDP := utils.MapStorage{utils.MetaReq: ev.Event, utils.MetaOpts: ev.APIOpts}
+	var fps []*FraudProfile
+	if fps, err = fS.matchingFraudProfiles(ev.Tenant, evDP, at); err != nil {
+		return
+	}
+	if len(fps) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	for _, fp := range fps {
+		var rsr config.RSRParsers
+		if rsr, err = config.NewRSRParsers(fp.subjectRule(), utils.InfieldSep); err != nil {
+			return
+		}
+		var subject string
+		if subject, err = rsr.ParseDataProvider(evDP); err != nil {
+			if err != utils.ErrNotFound {
+				return
+			}
+			err = nil
+		}
+		if subject == utils.EmptyString {
+			continue
+		}
+		var hits []*FraudSignalHit
+		if hits, err = fS.scoreSignals(ctx, fp, subject, ev, evDP, at); err != nil {
+			return
+		}
+		if len(hits) == 0 {
+			continue
+		}
+		var updated bool
+		caseID := utils.ConcatenatedKey(fp.ID, subject)
+		if err = guardian.Guardian.Guard(func() (err error) {
+			updated, err = fS.scoreCase(ctx, fp, caseID, subject, ev, &FraudEvidence{
+				Time:    at,
+				EventID: ev.ID,
+				Signals: hits,
+				Event:   ev.Clone().Event,
+			})
+			return
+		}, fS.cfg.GeneralCfg().LockingTimeout, utils.FraudCasePrefix+utils.ConcatenatedKey(ev.Tenant, caseID)); err != nil {
+			return
+		}
+		if updated {
+			caseIDs = append(caseIDs, caseID)
+		}
+	}
+	return
+}
+
+// scoreCase adds the evidence to the case of the subject, opening it if needed,
+// and runs the actions of the levels reached
+func (fS *FraudS) scoreCase(ctx *context.Context, fp *FraudProfile, caseID, subject string,
+	ev *utils.CGREvent, evd *FraudEvidence) (updated bool, err error) {
+	fc, err := fS.dm.GetFraudCase(ev.Tenant, caseID, true, true, utils.NonTransactional)
+	if err != nil && err != utils.ErrNotFound {
+		return
+	}
+	err = nil
+	if fc != nil && fc.Status == utils.MetaWhitelisted {
+		return
+	}
+	if fc != nil && fc.Status == utils.MetaClosed { // keep the reviewed case under its own ID
+		if err = fS.storeCase(fc.archived()); err != nil {
+			return
+		}
+		fc = nil
+	}
+	if fc == nil { // a new case
+		fc = &FraudCase{
+			Tenant:    ev.Tenant,
+			ID:        caseID,
+			ProfileID: fp.ID,
+			Subject:   subject,
+			Status:    utils.MetaOpen,
+			OpenedAt:  evd.Time,
+		}
+	}
+	for _, lvl := range fc.addEvidence(fp, evd, fS.cfg.FraudSCfg().MaxEvidence) {
+		for _, act := range lvl.Actions {
+			if errAct := fS.contain(ctx, act, lvl, fp, fc, ev); errAct != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> failed executing action <%s> for case <%s>: %s",
+						utils.FraudS, act, fc.TenantID(), errAct))
+			}
+		}
+	}
+	if err = fS.storeCase(fc); err != nil {
+		return
+	}
+	return true, nil
+}
+
+// storeCase saves the case and keeps the cached one in sync
+func (fS *FraudS) storeCase(fc *FraudCase) (err error) {
+	if err = fS.dm.SetFraudCase(fc); err != nil {
+		return
+	}
+	return Cache.Set(utils.CacheFraudCases, fc.TenantID(), fc, nil,
+		true, utils.NonTransactional)
+}
+
+// contain executes one of the level actions for the case
+func (fS *FraudS) contain(ctx *context.Context, act string, lvl *FraudLevel,
+	fp *FraudProfile, fc *FraudCase, ev *utils.CGREvent) (err error) {
+	switch act {
+	case utils.MetaLog:
+		utils.Logger.Warning(
+			fmt.Sprintf("<%s> case <%s> reached score %v with evidence: %s",
+				utils.FraudS, fc.TenantID(), fc.Score, utils.ToJSON(fc.Evidence[len(fc.Evidence)-1])))
+	case utils.MetaThrottle:
+		if fc.ThrottleEvent != nil { // already throttled by a lower level
+			return
+		}
+		units := lvl.ThrottleUnits
+		if units == 0 {
+			units = 1
+		}
+		var reply string
+		if err = fS.connMgr.Call(ctx, fS.cfg.FraudSCfg().ResourceSConns,
+			utils.ResourceSv1AllocateResources, fS.throttleEvent(fc, ev.Event, units), &reply); err != nil {
+			return
+		}
+		fc.ThrottleEvent = ev.Clone().Event
+	case utils.MetaBlockAccount:
+		if fc.AccountBlocked { // already blocked by a lower level
+			return
+		}
+		var acnt string
+		if acnt, err = ev.FieldAsString(utils.AccountField); err != nil || acnt == utils.EmptyString {
+			return utils.NewErrMandatoryIeMissing(utils.AccountField)
+		}
+		var blocked bool
+		if blocked, err = fS.setAccountDisabled(utils.ConcatenatedKey(fc.Tenant, acnt), true); err != nil {
+			return
+		}
+		if blocked {
+			fc.Account = acnt
+			fc.AccountBlocked = true
+		}
+	case utils.MetaTerminateSessions:
+		fld := fp.subjectRule()
+		var reply string
+		if err = fS.connMgr.Call(ctx, fS.cfg.FraudSCfg().SessionSConns,
+			utils.SessionSv1ForceDisconnect, utils.SessionFilterWithEvent{
+				SessionFilter: &utils.SessionFilter{
+					Tenant:  fc.Tenant,
+					Filters: []string{utils.MetaString + utils.InInFieldSep + fld + utils.InInFieldSep + fc.Subject},
+				},
+			}, &reply); err != nil && err.Error() == utils.ErrNotFound.Error() {
+			err = nil
+		}
+	}
+	return
+}
+
+// throttleEvent builds the ResourceS event for the case usage
+func (fS *FraudS) throttleEvent(fc *FraudCase, ev map[string]any, units float64) *utils.CGREvent {
+	return &utils.CGREvent{
+		Tenant: fc.Tenant,
+		ID:     utils.GenUUID(),
+		Event:  ev,
+		APIOpts: map[string]any{
+			utils.OptsResourcesUsageID: fraudUsagePrefix + fc.ID,
+			utils.OptsResourcesUnits:   units,
+		},
+	}
+}
+
+// setAccountDisabled changes the Disabled flag of the account, returning true if changed
+func (fS *FraudS) setAccountDisabled(acntID string, disabled bool) (changed bool, err error) {
+	err = guardian.Guardian.Guard(func() error {
+		acc, err := fS.dm.GetAccount(acntID)
+		if err != nil {
+			return err
+		}
+		if acc.Disabled == disabled {
+			return nil
+		}
+		acc.Disabled = disabled
+		if err = fS.dm.SetAccount(acc); err != nil {
+			return err
+		}
+		changed = true
+		return nil
+	}, fS.cfg.GeneralCfg().LockingTimeout, utils.AccountPrefix+acntID)
+	return
+}
+
+// release undoes the containment of the case
+func (fS *FraudS) release(ctx *context.Context, fc *FraudCase) (err error) {
+	if fc.ThrottleEvent != nil {
+		var reply string
+		if err = fS.connMgr.Call(ctx, fS.cfg.FraudSCfg().ResourceSConns,
+			utils.ResourceSv1ReleaseResources, fS.throttleEvent(fc, fc.ThrottleEvent, 0), &reply); err != nil &&
+			err.Error() != utils.ErrNotFound.Error() {
+			return
+		}
+		err = nil
+		fc.ThrottleEvent = nil
+	}
+	if fc.AccountBlocked {
+		if _, err = fS.setAccountDisabled(utils.ConcatenatedKey(fc.Tenant, fc.Account), false); err != nil &&
+			err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+		fc.AccountBlocked = false
+	}
+	return
+}
+
+// updateCase moves the case to the status, releasing the containment when closing it
+func (fS *FraudS) updateCase(ctx *context.Context, args *FraudCaseArgs, status string) (err error) {
+	if missing := utils.MissingStructFields(args, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = fS.cfg.GeneralCfg().DefaultTenant
+	}
+	return guardian.Guardian.Guard(func() (err error) {
+		var fc *FraudCase
+		if fc, err = fS.dm.GetFraudCase(tnt, args.ID, true, true, utils.NonTransactional); err != nil {
+			return
+		}
+		if fc.Status == status {
+			return
+		}
+		if status == utils.MetaUnderReview && fc.closed() {
+			return fmt.Errorf("case is %s", fc.Status)
+		}
+		if status != utils.MetaUnderReview {
+			if err = fS.release(ctx, fc); err != nil {
+				return
+			}
+		}
+		now := time.Now()
+		fc.Status = status
+		fc.UpdatedAt = now
+		fc.Notes = append(fc.Notes, &FraudNote{Time: now, Status: status, Note: args.Note})
+		return fS.storeCase(fc)
+	}, fS.cfg.GeneralCfg().LockingTimeout, utils.FraudCasePrefix+utils.ConcatenatedKey(tnt, args.ID))
+}
+
+// V1ProcessEvent scores the event and returns the IDs of the updated cases
+func (fS *FraudS) V1ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *[]string) (err error) {
+	if args == nil || args.Event == nil {
+		return utils.NewErrMandatoryIeMissing(utils.Event)
+	}
+	if args.Tenant == utils.EmptyString {
+		args.Tenant = fS.cfg.GeneralCfg().DefaultTenant
+	}
+	var caseIDs []string
+	if caseIDs, err = fS.processEvent(ctx, args); err != nil {
+		if err != utils.ErrNotFound {
+			err = utils.NewErrServerError(err)
+		}
+		return
+	}
+	*reply = caseIDs
+	return
+}
+
+// V1GetFraudCase returns the FraudCase
+func (fS *FraudS) V1GetFraudCase(ctx *context.Context, arg *utils.TenantIDWithAPIOpts, reply *FraudCase) (err error) {
+	if missing := utils.MissingStructFields(arg, []string{utils.ID}); len(missing) != 0 { //Params missing
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	tnt := arg.Tenant
+	if tnt == utils.EmptyString {
+		tnt = fS.cfg.GeneralCfg().DefaultTenant
+	}
+	return guardian.Guardian.Guard(func() error {
+		fc, err := fS.dm.GetFraudCase(tnt, arg.ID, true, true, utils.NonTransactional)
+		if err != nil {
+			return err
+		}
+		*reply = *fc.Clone()
+		return nil
+	}, fS.cfg.GeneralCfg().LockingTimeout, utils.FraudCasePrefix+utils.ConcatenatedKey(tnt, arg.ID))
+}
+
+// V1GetFraudCaseIDs returns the IDs of the FraudCases of the tenant
+func (fS *FraudS) V1GetFraudCaseIDs(ctx *context.Context, args *utils.PaginatorWithTenant, reply *[]string) (err error) {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = fS.cfg.GeneralCfg().DefaultTenant
+	}
+	prfx := utils.FraudCasePrefix + tnt + utils.ConcatenatedKeySep
+	keys, err := fS.dm.DataDB().GetKeysForPrefix(prfx, args.Search)
+	if err != nil {
+		return
+	}
+	if len(keys) == 0 {
+		return utils.ErrNotFound
+	}
+	retIDs := make([]string, len(keys))
+	for i, key := range keys {
+		retIDs[i] = key[len(prfx):]
+	}
+	sort.Strings(retIDs)
+	*reply = args.PaginateStringSlice(retIDs)
+	return
+}
+
+// V1ReviewFraudCase marks the case as being reviewed, the scoring continues
+func (fS *FraudS) V1ReviewFraudCase(ctx *context.Context, args *FraudCaseArgs, reply *string) (err error) {
+	if err = fS.updateCase(ctx, args, utils.MetaUnderReview); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// V1WhitelistFraudCase releases the containment and stops scoring the subject
+func (fS *FraudS) V1WhitelistFraudCase(ctx *context.Context, args *FraudCaseArgs, reply *string) (err error) {
+	if err = fS.updateCase(ctx, args, utils.MetaWhitelisted); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
+// V1CloseFraudCase releases the containment, the next suspicious event opens a new case
+func (fS *FraudS) V1CloseFraudCase(ctx *context.Context, args *FraudCaseArgs, reply *string) (err error) {
+	if err = fS.updateCase(ctx, args, utils.MetaClosed); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
@@ -0,0 +1,311 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestFraudProfileValidate(t *testing.T) {
+	for _, fp := range []*FraudProfile{
+		{Signals: []*FraudSignal{{Type: utils.MetaDestinationRisk, Values: []string{"DST_PREMIUM"}, Score: 10}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaVelocity, Window: time.Minute, Threshold: 10, Score: 5}},
+			Levels: []*FraudLevel{{Score: 20, Actions: []string{utils.MetaLog, utils.MetaBlockAccount}}}},
+		{Subject: "~*req.Subject", Signals: []*FraudSignal{
+			{Type: utils.MetaGeoMismatch, Values: []string{"~*req.CallerCountry", "~*req.AccountCountry"}, Score: 5}}},
+	} {
+		if err := fp.Validate(); err != nil {
+			t.Errorf("%s: %v", utils.ToJSON(fp), err)
+		}
+	}
+	for _, fp := range []*FraudProfile{
+		{},
+		{Signals: []*FraudSignal{{Type: "*unknown", Score: 10}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaDestinationRisk, Values: []string{"DST_PREMIUM"}}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaDestinationRisk, Score: 10}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaVelocity, Threshold: 10, Score: 5}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaCostSpike, Score: 5}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaGeoMismatch, Values: []string{"~*req.CallerCountry"}, Score: 5}}},
+		{Signals: []*FraudSignal{{Type: utils.MetaVelocity, Window: time.Minute, Threshold: 10, Score: 5}},
+			Levels: []*FraudLevel{{Score: 20, Actions: []string{"*unknown"}}}},
+		{Subject: "~*req.Account{*", Signals: []*FraudSignal{
+			{Type: utils.MetaVelocity, Window: time.Minute, Threshold: 10, Score: 5}}},
+	} {
+		if err := fp.Validate(); err == nil {
+			t.Errorf("expected error for %s", utils.ToJSON(fp))
+		}
+	}
+}
+
+func TestFraudCaseAddEvidence(t *testing.T) {
+	fp := &FraudProfile{Levels: []*FraudLevel{
+		{Score: 30, Actions: []string{utils.MetaBlockAccount}},
+		{Score: 10, Actions: []string{utils.MetaLog}},
+	}}
+	fc := new(FraudCase)
+	newEvd := func(score float64) *FraudEvidence {
+		return &FraudEvidence{Time: time.Now(), Signals: []*FraudSignalHit{{Score: score}}}
+	}
+	if lvls := fc.addEvidence(fp, newEvd(5), 2); len(lvls) != 0 {
+		t.Errorf("received levels %s", utils.ToJSON(lvls))
+	}
+	if lvls := fc.addEvidence(fp, newEvd(30), 2); len(lvls) != 2 ||
+		lvls[0].Score != 10 || lvls[1].Score != 30 {
+		t.Errorf("received levels %s", utils.ToJSON(lvls))
+	}
+	// the levels are reached only once per case
+	if lvls := fc.addEvidence(fp, newEvd(5), 2); len(lvls) != 0 {
+		t.Errorf("received levels %s", utils.ToJSON(lvls))
+	}
+	if fc.Score != 40 || len(fc.Evidence) != 2 || len(fc.Levels) != 2 {
+		t.Errorf("received case %s", utils.ToJSON(fc))
+	}
+}
+
+func TestFraudSProcessEvent(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CacheFraudProfiles, utils.CacheFraudCases, utils.CacheFraudFilterIndexes})
+	fS := NewFraudS(cfg, dm, NewFilterS(cfg, nil, dm), nil)
+	if err := dm.SetFraudProfile(&FraudProfile{
+		Tenant:    "cgrates.org",
+		ID:        "FRAUD_1",
+		FilterIDs: []string{"*string:~*req.ToR:*voice"},
+		Signals: []*FraudSignal{
+			{Type: utils.MetaVelocity, Window: time.Hour, Threshold: 2, Score: 10},
+			{Type: utils.MetaGeoMismatch, Values: []string{"~*req.CallerCountry", "~*req.AccountCountry"}, Score: 15},
+		},
+		Levels: []*FraudLevel{{Score: 20, Actions: []string{utils.MetaLog, utils.MetaBlockAccount}}},
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccount(&Account{ID: "cgrates.org:1001"}); err != nil {
+		t.Fatal(err)
+	}
+	newEv := func(country string) *utils.CGREvent {
+		return &utils.CGREvent{Tenant: "cgrates.org", ID: utils.GenUUID(), Event: map[string]any{
+			utils.ToR:          utils.MetaVoice,
+			utils.AccountField: "1001",
+			"CallerCountry":    country,
+			"AccountCountry":   "DE",
+		}}
+	}
+	var caseIDs []string
+	if err := fS.V1ProcessEvent(context.Background(), &utils.CGREvent{Tenant: "cgrates.org",
+		Event: map[string]any{utils.ToR: utils.MetaSMS}}, &caseIDs); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	for range 2 {
+		if err := fS.V1ProcessEvent(context.Background(), newEv("DE"), &caseIDs); err != nil {
+			t.Fatal(err)
+		} else if len(caseIDs) != 0 {
+			t.Errorf("unexpected cases: %v", caseIDs)
+		}
+	}
+	// the third event trips the velocity and the geo signals
+	if err := fS.V1ProcessEvent(context.Background(), newEv("RO"), &caseIDs); err != nil {
+		t.Fatal(err)
+	} else if len(caseIDs) != 1 || caseIDs[0] != "FRAUD_1:1001" {
+		t.Errorf("received cases: %v", caseIDs)
+	}
+	var fc FraudCase
+	if err := fS.V1GetFraudCase(context.Background(),
+		&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "FRAUD_1:1001"}}, &fc); err != nil {
+		t.Fatal(err)
+	}
+	if fc.Status != utils.MetaOpen || fc.Score != 25 || !fc.AccountBlocked ||
+		len(fc.Evidence) != 1 || len(fc.Evidence[0].Signals) != 2 {
+		t.Errorf("received case %s", utils.ToJSON(fc))
+	}
+	if acc, err := dm.GetAccount("cgrates.org:1001"); err != nil {
+		t.Fatal(err)
+	} else if !acc.Disabled {
+		t.Error("expected the account to be blocked")
+	}
+
+	// whitelisting releases the account and stops the scoring
+	var reply string
+	if err := fS.V1WhitelistFraudCase(context.Background(),
+		&FraudCaseArgs{ID: "FRAUD_1:1001", Note: "known roaming"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if acc, err := dm.GetAccount("cgrates.org:1001"); err != nil {
+		t.Fatal(err)
+	} else if acc.Disabled {
+		t.Error("expected the account to be released")
+	}
+	if err := fS.V1ProcessEvent(context.Background(), newEv("RO"), &caseIDs); err != nil {
+		t.Fatal(err)
+	} else if len(caseIDs) != 0 {
+		t.Errorf("unexpected cases: %v", caseIDs)
+	}
+	if err := fS.V1ReviewFraudCase(context.Background(),
+		&FraudCaseArgs{ID: "FRAUD_1:1001"}, &reply); err == nil {
+		t.Error("expected error reviewing a whitelisted case")
+	}
+
+	// closing the case lets the next suspicious event open a new one
+	if err := fS.V1CloseFraudCase(context.Background(),
+		&FraudCaseArgs{ID: "FRAUD_1:1001"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	var closedFc FraudCase
+	if err := fS.V1GetFraudCase(context.Background(),
+		&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "FRAUD_1:1001"}}, &closedFc); err != nil {
+		t.Fatal(err)
+	}
+	closedID := utils.ConcatenatedKey("FRAUD_1:1001", strconv.FormatInt(closedFc.OpenedAt.UnixNano(), 10))
+	if err := fS.V1ProcessEvent(context.Background(), newEv("FR"), &caseIDs); err != nil {
+		t.Fatal(err)
+	} else if len(caseIDs) != 1 {
+		t.Errorf("received cases: %v", caseIDs)
+	}
+	fc = FraudCase{}
+	if err := fS.V1GetFraudCase(context.Background(),
+		&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "FRAUD_1:1001"}}, &fc); err != nil {
+		t.Fatal(err)
+	} else if fc.Status != utils.MetaOpen || len(fc.Notes) != 0 || len(fc.Evidence) != 1 {
+		t.Errorf("received case %s", utils.ToJSON(fc))
+	}
+	// the closed case is kept with its evidence and notes
+	fc = FraudCase{}
+	if err := fS.V1GetFraudCase(context.Background(),
+		&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: closedID}}, &fc); err != nil {
+		t.Fatal(err)
+	} else if fc.Status != utils.MetaClosed || fc.Score != closedFc.Score ||
+		len(fc.Notes) != 2 || fc.Notes[0].Note != "known roaming" ||
+		!reflect.DeepEqual(utils.ToJSON(fc.Evidence), utils.ToJSON(closedFc.Evidence)) {
+		t.Errorf("received case %s", utils.ToJSON(fc))
+	}
+	var ids []string
+	if err := fS.V1GetFraudCaseIDs(context.Background(), new(utils.PaginatorWithTenant), &ids); err != nil {
+		t.Fatal(err)
+	} else if exp := []string{"FRAUD_1:1001", closedID}; !reflect.DeepEqual(ids, exp) {
+		t.Errorf("expected ids %v, received %v", exp, ids)
+	}
+}
+
+func TestFraudSBlockEventAccount(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CacheFraudProfiles, utils.CacheFraudCases, utils.CacheFraudFilterIndexes})
+	fS := NewFraudS(cfg, dm, NewFilterS(cfg, nil, dm), nil)
+	if err := dm.SetFraudProfile(&FraudProfile{
+		Tenant:    "cgrates.org",
+		ID:        "FRAUD_TRUNK",
+		FilterIDs: []string{"*string:~*req.ToR:*voice"},
+		Subject:   "~*req.Trunk",
+		Signals: []*FraudSignal{
+			{Type: utils.MetaGeoMismatch, Values: []string{"~*req.CallerCountry", "~*req.AccountCountry"}, Score: 20}},
+		Levels: []*FraudLevel{{Score: 20, Actions: []string{utils.MetaBlockAccount}}},
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetAccount(&Account{ID: "cgrates.org:1002"}); err != nil {
+		t.Fatal(err)
+	}
+	var caseIDs []string
+	if err := fS.V1ProcessEvent(context.Background(), &utils.CGREvent{Tenant: "cgrates.org",
+		ID: utils.GenUUID(), Event: map[string]any{
+			utils.ToR:          utils.MetaVoice,
+			utils.AccountField: "1002",
+			"CallerCountry":    "RO",
+			"AccountCountry":   "DE",
+			"Trunk":            "TRUNK_1",
+		}}, &caseIDs); err != nil {
+		t.Fatal(err)
+	} else if len(caseIDs) != 1 || caseIDs[0] != "FRAUD_TRUNK:TRUNK_1" {
+		t.Errorf("received cases: %v", caseIDs)
+	}
+	var fc FraudCase
+	if err := fS.V1GetFraudCase(context.Background(),
+		&utils.TenantIDWithAPIOpts{TenantID: &utils.TenantID{ID: "FRAUD_TRUNK:TRUNK_1"}}, &fc); err != nil {
+		t.Fatal(err)
+	} else if fc.Subject != "TRUNK_1" || fc.Account != "1002" || !fc.AccountBlocked {
+		t.Errorf("received case %s", utils.ToJSON(fc))
+	}
+	// the account of the event is blocked, not the subject
+	if acc, err := dm.GetAccount("cgrates.org:1002"); err != nil {
+		t.Fatal(err)
+	} else if !acc.Disabled {
+		t.Error("expected the account to be blocked")
+	}
+	var reply string
+	if err := fS.V1CloseFraudCase(context.Background(),
+		&FraudCaseArgs{ID: "FRAUD_TRUNK:TRUNK_1"}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if acc, err := dm.GetAccount("cgrates.org:1002"); err != nil {
+		t.Fatal(err)
+	} else if acc.Disabled {
+		t.Error("expected the account to be released")
+	}
+	// the profile is matched through the filter indexes
+	if err := dm.RemoveFraudProfile("cgrates.org", "FRAUD_TRUNK", true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dm.GetIndexes(utils.CacheFraudFilterIndexes, "cgrates.org",
+		true, false); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestFraudSEvictTrackers(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.FraudSCfg().TrackersTTL = time.Hour
+	fS := NewFraudS(cfg, nil, nil, nil)
+	now := time.Now()
+	fS.trackersMux.Lock()
+	fS.tracker("idle", time.Minute, now.Add(-2*time.Hour))
+	fS.tracker("long_window", 3*time.Hour, now.Add(-2*time.Hour))
+	fS.tracker("active", time.Minute, now.Add(-time.Minute))
+	fS.trackersMux.Unlock()
+	fS.evictTrackers(now)
+	if len(fS.trackers) != 2 || fS.trackers["idle"] != nil {
+		t.Errorf("received trackers: %v", fS.trackers)
+	}
+	// the next sweep happens only after the ttl
+	fS.trackers["active"].lastSeen = now.Add(-2 * time.Hour)
+	if fS.evictTrackers(now.Add(time.Minute)); len(fS.trackers) != 2 {
+		t.Errorf("received trackers: %v", fS.trackers)
+	}
+	if fS.evictTrackers(now.Add(time.Hour)); len(fS.trackers) != 1 || fS.trackers["long_window"] == nil {
+		t.Errorf("received trackers: %v", fS.trackers)
+	}
+	cfg.FraudSCfg().TrackersTTL = 0
+	fS.trackers["long_window"].lastSeen = time.Time{}
+	if fS.evictTrackers(now.Add(24 * time.Hour)); len(fS.trackers) != 1 {
+		t.Errorf("received trackers: %v", fS.trackers)
+	}
+}
@@ -49,6 +49,14 @@ type IPProfile struct {
 	lockID string // reference ID of lock used when matching the IPProfile
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (p *IPProfile) GetTenant() string {
+	if p == nil {
+		return utils.EmptyString
+	}
+	return p.Tenant
+}
+
 // IPProfileWithAPIOpts wraps IPProfile with APIOpts.
 type IPProfileWithAPIOpts struct {
 	*IPProfile
@@ -66,6 +66,15 @@ type AttributeProfile struct {
 	Attributes         []*Attribute
 	Blocker            bool // blocker flag to stop processing on multiple runs
 	Weight             float64
+	Weights            utils.DynamicWeights // the first one matching the event overrides Weight
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ap *AttributeProfile) GetTenant() string {
+	if ap == nil {
+		return utils.EmptyString
+	}
+	return ap.Tenant
 }
 
 // Clone method for AttributeProfile struct
@@ -78,6 +87,7 @@ func (ap *AttributeProfile) Clone() *AttributeProfile {
 		ID:      ap.ID,
 		Blocker: ap.Blocker,
 		Weight:  ap.Weight,
+		Weights: ap.Weights.Clone(),
 	}
 	if ap.Contexts != nil {
 		clone.Contexts = make([]string, len(ap.Contexts))
@@ -163,6 +173,15 @@ type APIAttributeProfile struct {
 	Attributes         []*ExternalAttribute
 	Blocker            bool // blocker flag to stop processing on multiple runs
 	Weight             float64
+	Weights            utils.DynamicWeights
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ext *APIAttributeProfile) GetTenant() string {
+	if ext == nil {
+		return utils.EmptyString
+	}
+	return ext.Tenant
 }
 
 // AsAttributeProfile converts the external attribute format to the actual AttributeProfile
@@ -194,6 +213,7 @@ func (ext *APIAttributeProfile) AsAttributeProfile() (attr *AttributeProfile, er
 	attr.ActivationInterval = ext.ActivationInterval
 	attr.Blocker = ext.Blocker
 	attr.Weight = ext.Weight
+	attr.Weights = ext.Weights
 	return
 }
 
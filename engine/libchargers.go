@@ -33,6 +33,15 @@ type ChargerProfile struct {
 	RunID              string
 	AttributeIDs       []string // perform data aliasing based on these Attributes
 	Weight             float64
+	Weights            utils.DynamicWeights
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (cp *ChargerProfile) GetTenant() string {
+	if cp == nil {
+		return utils.EmptyString
+	}
+	return cp.Tenant
 }
 
 // Clone method for ChargerProfile
@@ -41,10 +50,11 @@ func (cp *ChargerProfile) Clone() *ChargerProfile {
 		return nil
 	}
 	clone := &ChargerProfile{
-		Tenant: cp.Tenant,
-		ID:     cp.ID,
-		RunID:  cp.RunID,
-		Weight: cp.Weight,
+		Tenant:  cp.Tenant,
+		ID:      cp.ID,
+		RunID:   cp.RunID,
+		Weight:  cp.Weight,
+		Weights: cp.Weights.Clone(),
 	}
 	if cp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(cp.FilterIDs))
@@ -67,6 +67,7 @@ func newFilterIndex(dm *DataManager, itemType, tnt, ctx, itemID string,
 
 	// Collect all index keys from all filter rules.
 	var allKeys []string
+	var hasComposite bool
 	for _, fltrID := range filterIDs {
 		var fltr *Filter
 
@@ -84,6 +85,7 @@ func newFilterIndex(dm *DataManager, itemType, tnt, ctx, itemID string,
 				return nil, err
 			}
 		}
+		hasComposite = hasComposite || fltr.hasCompositeRules()
 
 		for _, rule := range fltr.Rules {
 			if !FilterIndexTypes.Has(rule.Type) || IsDynamicDPPath(rule.Element) {
@@ -124,6 +126,12 @@ func newFilterIndex(dm *DataManager, itemType, tnt, ctx, itemID string,
 		}
 	}
 
+	// The *or/*nor rules can not be indexed so if nothing else
+	// is indexable fallback on the *none index and let FilterS decide.
+	if len(allKeys) == 0 && hasComposite {
+		allKeys = append(allKeys, utils.ConcatenatedKey(utils.MetaNone, utils.MetaAny, utils.MetaAny))
+	}
+
 	if len(allKeys) > 0 {
 		indexes, err := dm.GetIndexes(itemType, tntCtx, true, false, allKeys...)
 		if err != nil && !errors.Is(err, utils.ErrNotFound) {
@@ -611,6 +619,9 @@ func UpdateFilterIndex(dm *DataManager, oldFlt, newFlt *Filter) (err error) {
 			}
 		}
 	}
+	if oldFlt.hasCompositeRules() != newFlt.hasCompositeRules() { // the *none fallback index may change
+		removeRules.Add(utils.ConcatenatedKey(utils.MetaNone, utils.MetaAny, utils.MetaAny))
+	}
 	needsRebuild := removeRules.Size() != 0 // nothing to remove means nothing to rebuild
 	if !needsRebuild {                      // so check if we added somrthing
 		for key := range newRules {
@@ -697,6 +708,42 @@ func UpdateFilterIndex(dm *DataManager, oldFlt, newFlt *Filter) (err error) {
 				}, newFlt); err != nil && err != utils.ErrNotFound {
 				return utils.APIErrorHandler(err)
 			}
+		case utils.CacheFraudFilterIndexes:
+			if err = removeFilterIndexesForFilter(dm, idxItmType, newFlt.Tenant, // remove the indexes for the filter
+				removeIndexKeys, indx); err != nil {
+				return
+			}
+			idxSlice := indx.AsSlice()
+			if _, err = ComputeIndexes(dm, newFlt.Tenant, utils.EmptyString, idxItmType, // compute all the indexes for afected items
+				&idxSlice, utils.NonTransactional, func(tnt, id, ctx string) (*[]string, error) {
+					fp, e := dm.GetFraudProfile(tnt, id, true, false, utils.NonTransactional)
+					if e != nil {
+						return nil, e
+					}
+					fltrIDs := make([]string, len(fp.FilterIDs))
+					copy(fltrIDs, fp.FilterIDs)
+					return &fltrIDs, nil
+				}, newFlt); err != nil && err != utils.ErrNotFound {
+				return utils.APIErrorHandler(err)
+			}
+		case utils.CacheDiscountFilterIndexes:
+			if err = removeFilterIndexesForFilter(dm, idxItmType, newFlt.Tenant, // remove the indexes for the filter
+				removeIndexKeys, indx); err != nil {
+				return
+			}
+			idxSlice := indx.AsSlice()
+			if _, err = ComputeIndexes(dm, newFlt.Tenant, utils.EmptyString, idxItmType, // compute all the indexes for afected items
+				&idxSlice, utils.NonTransactional, func(tnt, id, ctx string) (*[]string, error) {
+					dp, e := dm.GetDiscountProfile(tnt, id, true, false, utils.NonTransactional)
+					if e != nil {
+						return nil, e
+					}
+					fltrIDs := make([]string, len(dp.FilterIDs))
+					copy(fltrIDs, dp.FilterIDs)
+					return &fltrIDs, nil
+				}, newFlt); err != nil && err != utils.ErrNotFound {
+				return utils.APIErrorHandler(err)
+			}
 		case utils.CacheRouteFilterIndexes:
 			if err = removeFilterIndexesForFilter(dm, idxItmType, newFlt.Tenant, // remove the indexes for the filter
 				removeIndexKeys, indx); err != nil {
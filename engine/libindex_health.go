@@ -316,6 +316,18 @@ func getFiltersAndContexts(dm *DataManager, indxType, tnt, id string) (filterIDs
 			return
 		}
 		filterIDs = rs.FilterIDs
+	case utils.CacheFraudFilterIndexes:
+		var fp *FraudProfile
+		if fp, err = dm.GetFraudProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
+			return
+		}
+		filterIDs = fp.FilterIDs
+	case utils.CacheDiscountFilterIndexes:
+		var dp *DiscountProfile
+		if dp, err = dm.GetDiscountProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
+			return
+		}
+		filterIDs = dp.FilterIDs
 	case utils.CacheStatFilterIndexes:
 		var st *StatQueueProfile
 		if st, err = dm.GetStatQueueProfile(tnt, id, true, false, utils.NonTransactional); err != nil {
@@ -444,6 +456,19 @@ func getFilterAsIndexSet(dm *DataManager, fltrIdxCache *ltcache.Cache, idxItmTyp
 			continue
 		}
 		isDyn := strings.HasPrefix(flt.Element, utils.DynamicDataPrefix)
+		if isDyn && flt.Type == utils.MetaExists { // *exists is indexed only on element
+			idxKey := utils.ConcatenatedKey(flt.Type, flt.Element[1:])
+			var rcvIndx utils.StringSet
+			if rcvIndx, err = getIHFltrIdxFromCache(dm, fltrIdxCache, idxItmType, tntCtx, idxKey); err != nil {
+				if err != utils.ErrNotFound {
+					return
+				}
+				err = nil
+				rcvIndx = make(utils.StringSet)
+			}
+			indexes[idxKey] = rcvIndx
+			continue
+		}
 		for _, fldVal := range flt.Values {
 			if IsDynamicDPPath(fldVal) {
 				continue
@@ -477,28 +502,14 @@ func getFilterAsIndexSet(dm *DataManager, fltrIdxCache *ltcache.Cache, idxItmTyp
 
 // updateFilterIHMisingIndx updates the reply with the missing indexes for a specific object( obj->filter->index relation)
 func updateFilterIHMisingIndx(dm *DataManager, fltrCache, fltrIdxCache *ltcache.Cache, filterIDs []string, indxType, tnt, tntCtx, itmID string, missingFltrs utils.StringSet, rply *FilterIHReply) (_ *FilterIHReply, err error) {
-	if len(filterIDs) == 0 { // no filter so check the *none:*any:*any index
-		idxKey := utils.ConcatenatedKey(utils.MetaNone, utils.MetaAny, utils.MetaAny)
-		var rcvIndx utils.StringSet
-		if rcvIndx, err = getIHFltrIdxFromCache(dm, fltrCache, indxType, tntCtx, idxKey); err != nil {
-			if err != utils.ErrNotFound {
-				return
-			}
-			key := utils.ConcatenatedKey(tntCtx, idxKey)
-			rply.MissingIndexes[key] = append(rply.MissingIndexes[key], itmID)
-		} else if !rcvIndx.Has(itmID) {
-			key := utils.ConcatenatedKey(tntCtx, idxKey)
-			rply.MissingIndexes[key] = append(rply.MissingIndexes[key], itmID)
-		}
-
-		return rply, nil
-	}
+	var hasIndexes, hasComposite bool
 	for _, fltrID := range filterIDs { // parse all the filters
 		var fltr *Filter
 		if fltr, err = getIHFltrFromCache(dm, fltrCache, tnt, fltrID); err != nil {
 			if err != utils.ErrNotFound {
 				return
 			}
+			err = nil
 			fltrID = utils.ConcatenatedKey(tnt, fltrID)
 			if tntIdxFltr := utils.ConcatenatedKey(fltrID, itmID); !missingFltrs.Has(tntIdxFltr) { // tntIdxFltr = tnt:idx:id verification to not set the same ID
 				missingFltrs.Add(tntIdxFltr)
@@ -506,10 +517,12 @@ func updateFilterIHMisingIndx(dm *DataManager, fltrCache, fltrIdxCache *ltcache.
 			}
 			continue
 		}
+		hasComposite = hasComposite || fltr.hasCompositeRules()
 		var indexes map[string]utils.StringSet
 		if indexes, err = getFilterAsIndexSet(dm, fltrIdxCache, indxType, tntCtx, fltr); err != nil { // build the index from filter
 			return
 		}
+		hasIndexes = hasIndexes || len(indexes) != 0
 		for key, idx := range indexes { // check if the item is in the indexes
 			if !idx.Has(itmID) {
 				key = utils.ConcatenatedKey(tntCtx, key)
@@ -517,7 +530,21 @@ func updateFilterIHMisingIndx(dm *DataManager, fltrCache, fltrIdxCache *ltcache.
 			}
 		}
 	}
-
+	if len(filterIDs) == 0 ||
+		(hasComposite && !hasIndexes) { // no filter or only composite rules so check the *none:*any:*any index
+		idxKey := utils.ConcatenatedKey(utils.MetaNone, utils.MetaAny, utils.MetaAny)
+		var rcvIndx utils.StringSet
+		if rcvIndx, err = getIHFltrIdxFromCache(dm, fltrCache, indxType, tntCtx, idxKey); err != nil {
+			if err != utils.ErrNotFound {
+				return
+			}
+			key := utils.ConcatenatedKey(tntCtx, idxKey)
+			rply.MissingIndexes[key] = append(rply.MissingIndexes[key], itmID)
+		} else if !rcvIndx.Has(itmID) {
+			key := utils.ConcatenatedKey(tntCtx, idxKey)
+			rply.MissingIndexes[key] = append(rply.MissingIndexes[key], itmID)
+		}
+	}
 	return rply, nil
 }
 
@@ -531,7 +558,7 @@ func GetFltrIdxHealth(dm *DataManager, fltrCache, fltrIdxCache, objCache *ltcach
 	}
 	objPrfx := utils.CacheIndexesToPrefix[indxType]
 	var ids []string
-	if ids, err = dm.dataDB.GetKeysForPrefix(objPrfx, utils.EmptyString); err != nil {
+	if ids, err = dm.db().GetKeysForPrefix(objPrfx, utils.EmptyString); err != nil {
 		return
 	}
 	missingFltrs := utils.StringSet{} // for checking multiple filters that are missing(to not append the same ID in case)
@@ -560,26 +587,23 @@ func GetFltrIdxHealth(dm *DataManager, fltrCache, fltrIdxCache, objCache *ltcach
 	// check the indexes( index->filter->obj relation)
 	idxPrfx := utils.CacheInstanceToPrefix[indxType]
 	var indexKeys []string
-	if indexKeys, err = dm.dataDB.GetKeysForPrefix(idxPrfx, utils.EmptyString); err != nil {
+	if indexKeys, err = dm.db().GetKeysForPrefix(idxPrfx, utils.EmptyString); err != nil {
 		return
 	}
 	missingObj := utils.StringSet{}
 	for _, dataID := range indexKeys { // get all the indexes
 		dataID = strings.TrimPrefix(dataID, idxPrfx)
 
-		splt := utils.SplitConcatenatedKey(dataID) // tntCtx:filterType:fieldName:fieldVal
-		lsplt := len(splt)
-		if lsplt < 4 {
-			err = fmt.Errorf("WRONG_IDX_KEY_FORMAT<%s>", dataID)
+		var tntCtx, idxKey string // tntCtx:filterType:fieldName[:fieldVal], prefix may contain context/subsystems
+		if tntCtx, idxKey, err = splitFilterIndex(dataID); err != nil {
 			return
 		}
+		splt := utils.SplitConcatenatedKey(tntCtx)
 		tnt := splt[0]
 		var ctx *string
-		if lsplt-3 == 2 {
+		if len(splt) == 2 {
 			ctx = &splt[1]
 		}
-		tntCtx := utils.ConcatenatedKey(splt[:lsplt-3]...) // prefix may contain context/subsystems
-		idxKey := utils.ConcatenatedKey(splt[lsplt-3:]...)
 
 		var idx utils.StringSet
 		if idx, err = getIHFltrIdxFromCache(dm, fltrIdxCache, indxType, tntCtx, idxKey); err != nil {
@@ -607,7 +631,7 @@ func GetFltrIdxHealth(dm *DataManager, fltrCache, fltrIdxCache, objCache *ltcach
 				}
 				continue
 			}
-			var hasIndx bool                       // just one filter needs to be the index
+			var hasIndx, hasComposite bool         // just one filter needs to be the index
 			for _, fltrID := range obj.filterIDs { // get the index for each filter from the object
 				var fltr *Filter
 				if fltr, err = getIHFltrFromCache(dm, fltrCache, tnt, fltrID); err != nil {
@@ -617,6 +641,7 @@ func GetFltrIdxHealth(dm *DataManager, fltrCache, fltrIdxCache, objCache *ltcach
 					err = nil // should be already logged when we parsed all the objects
 					continue
 				}
+				hasComposite = hasComposite || fltr.hasCompositeRules()
 				var indexes map[string]utils.StringSet
 				if indexes, err = getFilterAsIndexSet(dm, fltrIdxCache, indxType, tntCtx, fltr); err != nil {
 					return
@@ -626,6 +651,10 @@ func GetFltrIdxHealth(dm *DataManager, fltrCache, fltrIdxCache, objCache *ltcach
 					break
 				}
 			}
+			if !hasIndx && hasComposite && // items with composite rules can fallback on *none:*any:*any index
+				idxKey == utils.ConcatenatedKey(utils.MetaNone, utils.MetaAny, utils.MetaAny) {
+				continue
+			}
 			if !hasIndx {
 				key := utils.ConcatenatedKey(tnt, idxKey)
 				rply.BrokenIndexes[key] = append(rply.BrokenIndexes[key], itmID)
@@ -646,7 +675,7 @@ func getRevFltrIdxHealthFromObj(dm *DataManager, fltrCache, revFltrIdxCache, obj
 	}
 	objPrfx := utils.CacheIndexesToPrefix[indxType]
 	var ids []string
-	if ids, err = dm.dataDB.GetKeysForPrefix(objPrfx, utils.EmptyString); err != nil {
+	if ids, err = dm.db().GetKeysForPrefix(objPrfx, utils.EmptyString); err != nil {
 		return
 	}
 	for _, id := range ids { // get all the objects
@@ -723,7 +752,7 @@ func getRevFltrIdxHealthFromObj(dm *DataManager, fltrCache, revFltrIdxCache, obj
 // getRevFltrIdxHealthFromReverse parses the reverse indexes and updates the reply
 func getRevFltrIdxHealthFromReverse(dm *DataManager, fltrCache, revFltrIdxCache *ltcache.Cache, objCaches map[string]*ltcache.Cache, rply map[string]*ReverseFilterIHReply) (_ map[string]*ReverseFilterIHReply, err error) {
 	var revIndexKeys []string
-	if revIndexKeys, err = dm.dataDB.GetKeysForPrefix(utils.FilterIndexPrfx, utils.EmptyString); err != nil {
+	if revIndexKeys, err = dm.db().GetKeysForPrefix(utils.FilterIndexPrfx, utils.EmptyString); err != nil {
 		return
 	}
 	missingObj := utils.StringSet{}
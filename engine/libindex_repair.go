@@ -0,0 +1,253 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/ltcache"
+)
+
+// RepairFltrIdx fixes the filter indexes of indxType found unhealthy by GetFltrIdxHealth,
+// returning the report the repair was based on. The missing filters are only reported
+// since they need to be defined before the items referencing them can be indexed
+func RepairFltrIdx(dm *DataManager, fltrCache, fltrIdxCache, objCache *ltcache.Cache, indxType string) (rply *FilterIHReply, err error) {
+	if rply, err = GetFltrIdxHealth(dm, fltrCache, fltrIdxCache, objCache, indxType); err != nil {
+		return
+	}
+	for key, itmIDs := range rply.MissingIndexes {
+		var tntCtx, idxKey string
+		if tntCtx, idxKey, err = splitFilterIndex(key); err != nil {
+			return
+		}
+		splt := utils.SplitConcatenatedKey(tntCtx)
+		var add, rem []string
+		for _, itmID := range itmIDs {
+			var obj *objFIH
+			if obj, err = getIHObjFromCache(dm, objCache, indxType, splt[0], itmID); err != nil {
+				if err != utils.ErrNotFound && err != utils.ErrDSPHostNotFound && err != utils.ErrDSPProfileNotFound {
+					return
+				}
+				err = nil // removed together with the missing objects
+				continue
+			}
+			if len(splt) == 2 &&
+				(obj.contexts == nil || !slices.Contains(*obj.contexts, splt[1])) { // indexed on a context the item does not have
+				rem = append(rem, itmID)
+				continue
+			}
+			add = append(add, itmID)
+		}
+		if err = updateIndexItems(dm, indxType, tntCtx, idxKey, add, rem); err != nil {
+			return
+		}
+	}
+	if len(rply.BrokenIndexes) == 0 && len(rply.MissingObjects) == 0 {
+		return
+	}
+	// the broken indexes do not point to the context so we go through all the indexes of the tenant
+	var idxKeys []string
+	idxPrfx := utils.CacheInstanceToPrefix[indxType]
+	if idxKeys, err = dm.DataDB().GetKeysForPrefix(idxPrfx, utils.EmptyString); err != nil {
+		return
+	}
+	missingObjs := make(map[string]utils.StringSet) // missing item IDs on tenant
+	for _, tntID := range rply.MissingObjects {
+		tID := utils.NewTenantID(tntID)
+		if _, has := missingObjs[tID.Tenant]; !has {
+			missingObjs[tID.Tenant] = make(utils.StringSet)
+		}
+		missingObjs[tID.Tenant].Add(tID.ID)
+	}
+	brokenIdxs := make(map[string][]string) // broken item IDs on tenant:idxKey
+	for key, itmIDs := range rply.BrokenIndexes {
+		var tntCtx, idxKey string
+		if tntCtx, idxKey, err = splitFilterIndex(key); err != nil {
+			return
+		}
+		tntIdxKey := utils.ConcatenatedKey(utils.SplitConcatenatedKey(tntCtx)[0], idxKey)
+		brokenIdxs[tntIdxKey] = append(brokenIdxs[tntIdxKey], itmIDs...)
+	}
+	for _, dataID := range idxKeys {
+		var tntCtx, idxKey string
+		if tntCtx, idxKey, err = splitFilterIndex(strings.TrimPrefix(dataID, idxPrfx)); err != nil {
+			return
+		}
+		tnt := utils.SplitConcatenatedKey(tntCtx)[0]
+		rem := brokenIdxs[utils.ConcatenatedKey(tnt, idxKey)]
+		if missing, has := missingObjs[tnt]; has {
+			var idx utils.StringSet
+			if idx, err = getIHFltrIdxFromCache(dm, fltrIdxCache, indxType, tntCtx, idxKey); err != nil {
+				if err != utils.ErrNotFound {
+					return
+				}
+				err = nil
+			}
+			for itmID := range idx {
+				if missing.Has(itmID) {
+					rem = append(rem, itmID)
+				}
+			}
+		}
+		if err = updateIndexItems(dm, indxType, tntCtx, idxKey, nil, rem); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// updateIndexItems adds and removes the items from one filter index, removing the index if it remains empty
+func updateIndexItems(dm *DataManager, idxItmType, tntCtx, idxKey string, add, rem []string) (err error) {
+	if len(add) == 0 && len(rem) == 0 {
+		return
+	}
+	refID := guardian.Guardian.GuardIDs(utils.EmptyString,
+		config.CgrConfig().GeneralCfg().LockingTimeout, idxItmType+tntCtx)
+	defer guardian.Guardian.UnguardIDs(refID)
+	var indexes map[string]utils.StringSet
+	if indexes, err = dm.GetIndexes(idxItmType, tntCtx, false, false, idxKey); err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+		indexes = map[string]utils.StringSet{idxKey: make(utils.StringSet)}
+	}
+	indexes[idxKey].AddSlice(add)
+	for _, itmID := range rem {
+		indexes[idxKey].Remove(itmID)
+	}
+	if indexes[idxKey].Size() == 0 {
+		indexes[idxKey] = nil // this will not be set in DB(handled by driver)
+	}
+	if err = Cache.Remove(idxItmType, utils.ConcatenatedKey(tntCtx, idxKey), true, utils.NonTransactional); err != nil {
+		return
+	}
+	return dm.SetIndexes(idxItmType, tntCtx, indexes, true, utils.NonTransactional)
+}
+
+// RepairReverseDestinationsIndex fixes the reverse destinations found unhealthy by
+// GetReverseDestinationsIndexHealth, returning the report the repair was based on
+func RepairReverseDestinationsIndex(dm *DataManager, objLimit, indexLimit int, objTTL, indexTTL time.Duration, objStaticTTL, indexStaticTTL bool) (rply *ReverseDestinationsIHReply, err error) {
+	if rply, err = GetReverseDestinationsIndexHealth(dm, objLimit, indexLimit, objTTL, indexTTL, objStaticTTL, indexStaticTTL); err != nil {
+		return
+	}
+	missingDsts := make(map[string][]string) // the missing prefixes on destination
+	for prefix, dstIDs := range rply.MissingReverseDestinations {
+		for _, dstID := range dstIDs {
+			missingDsts[dstID] = append(missingDsts[dstID], prefix)
+		}
+	}
+	for dstID, prefixes := range missingDsts {
+		if err = dm.SetReverseDestination(dstID, prefixes, utils.NonTransactional); err != nil {
+			return
+		}
+		for _, prefix := range prefixes {
+			if err = Cache.Remove(utils.CacheReverseDestinations, prefix, true, utils.NonTransactional); err != nil {
+				return
+			}
+		}
+	}
+	var allPrefixes []string // only queried for the destinations missing from DataDB
+	for dstID, prefixes := range rply.BrokenReferences {
+		if prefixes == nil {
+			if allPrefixes == nil {
+				if allPrefixes, err = dm.DataDB().GetKeysForPrefix(utils.ReverseDestinationPrefix, utils.EmptyString); err != nil {
+					return
+				}
+			}
+			for _, prefix := range allPrefixes {
+				prefix = strings.TrimPrefix(prefix, utils.ReverseDestinationPrefix)
+				var ids []string
+				if ids, err = dm.GetReverseDestination(prefix, false, false, utils.NonTransactional); err != nil {
+					if err != utils.ErrNotFound {
+						return
+					}
+					err = nil
+				}
+				if slices.Contains(ids, dstID) {
+					prefixes = append(prefixes, prefix)
+				}
+			}
+		}
+		for _, prefix := range prefixes {
+			if err = dm.DataDB().RemoveReverseDestinationDrv(dstID, prefix, utils.NonTransactional); err != nil {
+				return
+			}
+			if err = Cache.Remove(utils.CacheReverseDestinations, prefix, true, utils.NonTransactional); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// RepairAccountActionPlansIndex fixes the account action plans found unhealthy by
+// GetAccountActionPlansIndexHealth, returning the report the repair was based on
+func RepairAccountActionPlansIndex(dm *DataManager, objLimit, indexLimit int, objTTL, indexTTL time.Duration, objStaticTTL, indexStaticTTL bool) (rply *AccountActionPlanIHReply, err error) {
+	if rply, err = GetAccountActionPlansIndexHealth(dm, objLimit, indexLimit, objTTL, indexTTL, objStaticTTL, indexStaticTTL); err != nil {
+		return
+	}
+	for acntID, apIDs := range rply.MissingAccountActionPlans {
+		if err = dm.SetAccountActionPlans(acntID, apIDs, false); err != nil {
+			return
+		}
+		if err = Cache.Remove(utils.CacheAccountActionPlans, acntID, true, utils.NonTransactional); err != nil {
+			return
+		}
+	}
+	var allAcntIDs []string // only queried for the action plans missing from DataDB
+	for apID, acntIDs := range rply.BrokenReferences {
+		if acntIDs == nil {
+			if allAcntIDs == nil {
+				if allAcntIDs, err = dm.DataDB().GetKeysForPrefix(utils.AccountActionPlansPrefix, utils.EmptyString); err != nil {
+					return
+				}
+			}
+			for _, acntID := range allAcntIDs {
+				acntID = strings.TrimPrefix(acntID, utils.AccountActionPlansPrefix)
+				var ids []string
+				if ids, err = dm.GetAccountActionPlans(acntID, false, false, utils.NonTransactional); err != nil {
+					if err != utils.ErrNotFound {
+						return
+					}
+					err = nil
+				}
+				if slices.Contains(ids, apID) {
+					acntIDs = append(acntIDs, acntID)
+				}
+			}
+		}
+		for _, acntID := range acntIDs {
+			if err = dm.RemAccountActionPlans(acntID, []string{apID}); err != nil {
+				err = fmt.Errorf("error <%s> removing the actionPlan <%s> from accountActionPlans: <%s>", err.Error(), apID, acntID)
+				return
+			}
+			if err = Cache.Remove(utils.CacheAccountActionPlans, acntID, true, utils.NonTransactional); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
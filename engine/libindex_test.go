@@ -409,6 +409,22 @@ func TestLibIndex_newFilterIndex(t *testing.T) {
 			filterIDs:  []string{"*notstring:~*req.Field1:val2"},
 			want:       make(map[string]utils.StringSet),
 		},
+		{
+			name:       "only composite filter",
+			idxItmType: utils.CacheAttributeFilterIndexes,
+			filterIDs:  []string{"*or::FLTR_1|FLTR_2"},
+			want: map[string]utils.StringSet{
+				"*none:*any:*any": {},
+			},
+		},
+		{
+			name:       "composite and indexable filters",
+			idxItmType: utils.CacheAttributeFilterIndexes,
+			filterIDs:  []string{"*nor::FLTR_1", "*string:~*req.Field1:val1"},
+			want: map[string]utils.StringSet{
+				"*string:*req.Field1:val1": {},
+			},
+		},
 		{
 			name:       "dynamic element, constant value",
 			idxItmType: utils.CacheAttributeFilterIndexes,
@@ -47,6 +47,14 @@ type RankingProfile struct {
 	ThresholdIDs      []string // List of threshold IDs to limit this Ranking to. *none to disable threshold processing for it.
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (rkp *RankingProfile) GetTenant() string {
+	if rkp == nil {
+		return utils.EmptyString
+	}
+	return rkp.Tenant
+}
+
 func (rkp *RankingProfile) TenantID() string {
 	return utils.ConcatenatedKey(rkp.Tenant, rkp.ID)
 }
@@ -43,11 +43,20 @@ type StatQueueProfile struct {
 	Stored             bool
 	Blocker            bool // blocker flag to stop processing on filters matched
 	Weight             float64
+	Weights            utils.DynamicWeights
 	ThresholdIDs       []string // list of thresholds to be checked after changes
 
 	lkID string // holds the reference towards guardian lock key
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (sqp *StatQueueProfile) GetTenant() string {
+	if sqp == nil {
+		return utils.EmptyString
+	}
+	return sqp.Tenant
+}
+
 // Clone clones *StatQueueProfile (lkID excluded)
 func (sqp *StatQueueProfile) Clone() *StatQueueProfile {
 	if sqp == nil {
@@ -62,6 +71,7 @@ func (sqp *StatQueueProfile) Clone() *StatQueueProfile {
 		Stored:      sqp.Stored,
 		Blocker:     sqp.Blocker,
 		Weight:      sqp.Weight,
+		Weights:     sqp.Weights.Clone(),
 	}
 	if sqp.FilterIDs != nil {
 		result.FilterIDs = make([]string, len(sqp.FilterIDs))
@@ -166,7 +166,7 @@ func LoadTariffPlanFromFolder(tpPath, timezone string, dm *DataManager, disable_
 	if err != nil {
 		return utils.NewErrServerError(err)
 	}
-	loader, err := NewTpReader(dm.dataDB, csvStorage, "",
+	loader, err := NewTpReader(dm.db(), csvStorage, "",
 		timezone, cacheConns, schedConns)
 	if err != nil {
 		return utils.NewErrServerError(err)
@@ -284,6 +284,13 @@ func GetDefaultEmptyCacheStats() map[string]*ltcache.CacheStats {
 		utils.CacheIPProfiles:              {},
 		utils.CacheIPAllocations:           {},
 		utils.CacheReverseDestinations:     {},
+		utils.CachePortedNumbers:           {},
+		utils.CacheLookupTables:            {},
+		utils.CacheDiscountProfiles:        {},
+		utils.CacheFraudProfiles:           {},
+		utils.CacheFraudCases:              {},
+		utils.CacheFraudFilterIndexes:      {},
+		utils.CacheDiscountFilterIndexes:   {},
 		utils.CacheRPCResponses:            {},
 		utils.CacheSharedGroups:            {},
 		utils.CacheStatFilterIndexes:       {},
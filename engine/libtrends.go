@@ -44,6 +44,14 @@ type TrendProfile struct {
 	ThresholdIDs    []string
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (tP *TrendProfile) GetTenant() string {
+	if tP == nil {
+		return utils.EmptyString
+	}
+	return tP.Tenant
+}
+
 // Clone will clone the TrendProfile so it can be used by scheduler safely
 func (tP *TrendProfile) Clone() (clnTp *TrendProfile) {
 	if tP == nil {
@@ -0,0 +1,118 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// LookupTable maps the event values (ie: customer IDs, trunk IPs, MCC-MNC)
+// to the values used in processing (ie: billing groups, carrier IDs, zones)
+type LookupTable struct {
+	Tenant  string
+	ID      string
+	Entries map[string]string
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (lt *LookupTable) GetTenant() string {
+	if lt == nil {
+		return utils.EmptyString
+	}
+	return lt.Tenant
+}
+
+// LookupTableWithAPIOpts is used in replicatorV1 for dispatcher
+type LookupTableWithAPIOpts struct {
+	*LookupTable
+	APIOpts map[string]any
+}
+
+// TenantID returns the concatenated key between tenant and ID
+func (lt *LookupTable) TenantID() string {
+	return utils.ConcatenatedKey(lt.Tenant, lt.ID)
+}
+
+// Clone method for LookupTable
+func (lt *LookupTable) Clone() *LookupTable {
+	if lt == nil {
+		return nil
+	}
+	return &LookupTable{
+		Tenant:  lt.Tenant,
+		ID:      lt.ID,
+		Entries: maps.Clone(lt.Entries),
+	}
+}
+
+// CacheClone returns a clone of LookupTable used by ltcache CacheCloner
+func (lt *LookupTable) CacheClone() any {
+	return lt.Clone()
+}
+
+// lookupValue resolves the *lookup attribute value: the first parser is the
+// ID of the table, the second the key and the optional third the default
+// value returned when the key is missing from the table
+func lookupValue(dp utils.DataProvider, value config.RSRParsers) (_ string, err error) {
+	if len(value) != 2 && len(value) != 3 {
+		return utils.EmptyString, fmt.Errorf("invalid arguments <%s> to %s",
+			utils.ToJSON(value), utils.MetaLookup)
+	}
+	var tblID string
+	if tblID, err = value[0].ParseDataProvider(dp); err != nil {
+		return
+	}
+	var dflt *string
+	if len(value) == 3 {
+		var dfltVal string
+		if dfltVal, err = value[2].ParseDataProvider(dp); err != nil {
+			return
+		}
+		dflt = &dfltVal
+	}
+	var key string
+	if key, err = value[1].ParseDataProvider(dp); err != nil {
+		if err == utils.ErrNotFound && dflt != nil {
+			return *dflt, nil
+		}
+		return
+	}
+	tnt := config.CgrConfig().GeneralCfg().DefaultTenant
+	if dynDP, canCast := dp.(*dynamicDP); canCast && dynDP.tenant != utils.EmptyString {
+		tnt = dynDP.tenant
+	}
+	var lt *LookupTable
+	if lt, err = dm.GetLookupTable(tnt, tblID, true, true, utils.NonTransactional); err != nil {
+		if err == utils.ErrNotFound {
+			err = utils.ErrPrefixNotFound(tblID)
+		}
+		return
+	}
+	if val, has := lt.Entries[key]; has {
+		return val, nil
+	}
+	if dflt != nil {
+		return *dflt, nil
+	}
+	return utils.EmptyString, utils.ErrNotFound
+}
@@ -0,0 +1,201 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestLookupTablesDataManager(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmLT := NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CacheLookupTables})
+	lt := &LookupTable{
+		Tenant: "cgrates.org",
+		ID:     "TRUNK_CARRIERS",
+		Entries: map[string]string{
+			"10.0.0.1": "CARRIER1",
+			"10.0.0.2": "CARRIER2",
+		},
+	}
+	if _, err := dmLT.GetLookupTable(lt.Tenant, lt.ID, false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err := dmLT.SetLookupTable(lt); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := dmLT.GetLookupTable(lt.Tenant, lt.ID, true, true, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(lt, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(lt), utils.ToJSON(rcv))
+	}
+	if err := dmLT.RemoveLookupTable(lt.Tenant, lt.ID); err != nil {
+		t.Fatal(err)
+	}
+	// the cache is cleared by the APIs through CacheS
+	Cache.Clear([]string{utils.CacheLookupTables})
+	if _, err := dmLT.GetLookupTable(lt.Tenant, lt.ID, true, true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err := dmLT.RemoveLookupTable(lt.Tenant, lt.ID); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestLookupTablesParseAttribute(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	dm = NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CacheLookupTables})
+	if err := dm.SetLookupTable(&LookupTable{
+		Tenant: "cgrates.org",
+		ID:     "BILLING_GROUPS",
+		Entries: map[string]string{
+			"1001": "GROUP_A",
+			"1002": "GROUP_B",
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	dp := newDynamicDP(nil, nil, nil, nil, nil, "cgrates.org", utils.MapStorage{
+		utils.MetaReq: utils.MapStorage{
+			utils.AccountField: "1001",
+			utils.Subject:      "1003",
+		},
+	})
+	for _, tc := range []struct {
+		value  string
+		exp    string
+		expErr error
+	}{
+		{value: "BILLING_GROUPS;~*req.Account", exp: "GROUP_A"},
+		{value: "BILLING_GROUPS;~*req.Account;DEFAULT_GROUP", exp: "GROUP_A"},
+		{value: "BILLING_GROUPS;~*req.Subject;DEFAULT_GROUP", exp: "DEFAULT_GROUP"},
+		{value: "BILLING_GROUPS;~*req.Missing;DEFAULT_GROUP", exp: "DEFAULT_GROUP"},
+		{value: "BILLING_GROUPS;~*req.Subject", expErr: utils.ErrNotFound},
+		{value: "MISSING_TABLE;~*req.Account;DEFAULT_GROUP", expErr: utils.ErrPrefixNotFound("MISSING_TABLE")},
+	} {
+		value := config.NewRSRParsersMustCompile(tc.value, utils.InfieldSep)
+		out, err := ParseAttribute(dp, utils.MetaLookup, utils.EmptyString, value,
+			0, utils.EmptyString, utils.EmptyString, utils.InfieldSep)
+		if tc.expErr != nil {
+			if err == nil || err.Error() != tc.expErr.Error() {
+				t.Errorf("value %s: expected error %v, received %v", tc.value, tc.expErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("value %s: %v", tc.value, err)
+		} else if out != tc.exp {
+			t.Errorf("value %s: expected %q, received %q", tc.value, tc.exp, out)
+		}
+	}
+	value := config.NewRSRParsersMustCompile("BILLING_GROUPS", utils.InfieldSep)
+	if _, err := ParseAttribute(dp, utils.MetaLookup, utils.EmptyString, value,
+		0, utils.EmptyString, utils.EmptyString, utils.InfieldSep); err == nil {
+		t.Error("Expected error for missing lookup key")
+	}
+}
+
+func TestLookupTablesModelHelpers(t *testing.T) {
+	csvStorage := NewStringCSVStorage(utils.CSVSep, "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "",
+		`#Tenant,ID,Key,Value
+cgrates.org,MCCMNC_ZONES,22610,ZONE_RO
+cgrates.org,MCCMNC_ZONES,26201,ZONE_DE
+cgrates.org,EMPTY_TABLE,,
+`)
+	tpLTs, err := csvStorage.GetTPLookupTables("TP1", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := map[string]*utils.TPLookupTable{
+		"MCCMNC_ZONES": {
+			TPid:   "TP1",
+			Tenant: "cgrates.org",
+			ID:     "MCCMNC_ZONES",
+			Entries: map[string]string{
+				"22610": "ZONE_RO",
+				"26201": "ZONE_DE",
+			},
+		},
+		"EMPTY_TABLE": {
+			TPid:    "TP1",
+			Tenant:  "cgrates.org",
+			ID:      "EMPTY_TABLE",
+			Entries: map[string]string{},
+		},
+	}
+	if len(tpLTs) != len(exp) {
+		t.Fatalf("Expected %d tables, received %s", len(exp), utils.ToJSON(tpLTs))
+	}
+	for _, tpLT := range tpLTs {
+		if !reflect.DeepEqual(exp[tpLT.ID], tpLT) {
+			t.Errorf("Expected %s, received %s", utils.ToJSON(exp[tpLT.ID]), utils.ToJSON(tpLT))
+		}
+	}
+
+	expMdls := LookupTableMdls{
+		{Tpid: "TP1", Tenant: "cgrates.org", ID: "MCCMNC_ZONES", Key: "22610", Value: "ZONE_RO"},
+		{Tpid: "TP1", Tenant: "cgrates.org", ID: "MCCMNC_ZONES", Key: "26201", Value: "ZONE_DE"},
+	}
+	if rcv := APItoModelTPLookupTable(exp["MCCMNC_ZONES"]); !reflect.DeepEqual(expMdls, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expMdls), utils.ToJSON(rcv))
+	}
+	expMdls = LookupTableMdls{{Tpid: "TP1", Tenant: "cgrates.org", ID: "EMPTY_TABLE"}}
+	if rcv := APItoModelTPLookupTable(exp["EMPTY_TABLE"]); !reflect.DeepEqual(expMdls, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expMdls), utils.ToJSON(rcv))
+	}
+
+	lt := APItoLookupTable(exp["MCCMNC_ZONES"])
+	expLT := &LookupTable{
+		Tenant: "cgrates.org",
+		ID:     "MCCMNC_ZONES",
+		Entries: map[string]string{
+			"22610": "ZONE_RO",
+			"26201": "ZONE_DE",
+		},
+	}
+	if !reflect.DeepEqual(expLT, lt) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expLT), utils.ToJSON(lt))
+	}
+	expTP := &utils.TPLookupTable{
+		Tenant:  "cgrates.org",
+		ID:      "MCCMNC_ZONES",
+		Entries: expLT.Entries,
+	}
+	if rcv := LookupTableToAPI(lt); !reflect.DeepEqual(expTP, rcv) {
+		t.Errorf("Expected %s, received %s", utils.ToJSON(expTP), utils.ToJSON(rcv))
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -41,6 +42,10 @@ func csvLoad(s any, values []string) (any, error) {
 		index := field.Tag.Get("index")
 		if index != utils.EmptyString {
 			idx, err := strconv.Atoi(index)
+			if err == nil && len(values) <= idx &&
+				field.Tag.Get("optional") != utils.EmptyString {
+				continue // trailing column missing from the older files
+			}
 			if err != nil || len(values) <= idx {
 				return nil, fmt.Errorf("invalid %v.%v index %v", st.Name(), field.Name, index)
 			}
@@ -132,18 +137,22 @@ func CsvDump(s any) ([]string, error) {
 	return result, nil
 }
 
-func getColumnCount(s any) int {
+// getColumnCount returns the number of csv columns of the model together
+// with how many of them are optional
+func getColumnCount(s any) (count, optCount int) {
 	st := reflect.TypeOf(s)
 	numFields := st.NumField()
-	count := 0
 	for i := 0; i < numFields; i++ {
 		field := st.Field(i)
 		index := field.Tag.Get("index")
 		if index != utils.EmptyString {
 			count++
+			if field.Tag.Get("optional") != utils.EmptyString {
+				optCount++
+			}
 		}
 	}
-	return count
+	return
 }
 
 type DestinationMdls []DestinationMdl
@@ -1029,10 +1038,10 @@ type ResourceMdls []*ResourceMdl
 func (tps ResourceMdls) CSVHeader() (result []string) {
 	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
 		utils.UsageTTL, utils.Limit, utils.AllocationMessage, utils.Blocker, utils.Stored,
-		utils.Weight, utils.ThresholdIDs}
+		utils.Weight, utils.ThresholdIDs, utils.Weights}
 }
 
-func (tps ResourceMdls) AsTPResources() (result []*utils.TPResourceProfile) {
+func (tps ResourceMdls) AsTPResources() (result []*utils.TPResourceProfile, err error) {
 	mrl := make(map[string]*utils.TPResourceProfile)
 	filterMap := make(map[string]utils.StringSet)
 	thresholdMap := make(map[string]utils.StringSet)
@@ -1054,6 +1063,12 @@ func (tps ResourceMdls) AsTPResources() (result []*utils.TPResourceProfile) {
 		if tp.Weight != 0 {
 			rl.Weight = tp.Weight
 		}
+		if tp.Weights != utils.EmptyString {
+			if rl.Weights, err = utils.NewDynamicWeightsFromString(tp.Weights,
+				utils.InfieldSep, utils.ANDSep); err != nil {
+				return nil, err
+			}
+		}
 		if tp.Limit != utils.EmptyString {
 			rl.Limit = tp.Limit
 		}
@@ -1111,6 +1126,7 @@ func APItoModelResource(rl *utils.TPResourceProfile) (mdls ResourceMdls) {
 			Stored:            rl.Stored,
 			UsageTTL:          rl.UsageTTL,
 			Weight:            rl.Weight,
+			Weights:           rl.Weights.String(utils.InfieldSep, utils.ANDSep),
 			Limit:             rl.Limit,
 			AllocationMessage: rl.AllocationMessage,
 		}
@@ -1141,6 +1157,7 @@ func APItoModelResource(rl *utils.TPResourceProfile) (mdls ResourceMdls) {
 		if i == 0 {
 			mdl.UsageTTL = rl.UsageTTL
 			mdl.Weight = rl.Weight
+			mdl.Weights = rl.Weights.String(utils.InfieldSep, utils.ANDSep)
 			mdl.Limit = rl.Limit
 			mdl.AllocationMessage = rl.AllocationMessage
 			if rl.ActivationInterval != nil {
@@ -1194,6 +1211,7 @@ func APItoResource(tpRL *utils.TPResourceProfile, timezone string) (rp *Resource
 			return nil, err
 		}
 	}
+	rp.Weights = tpRL.Weights.Clone()
 	return rp, nil
 }
 
@@ -1208,6 +1226,7 @@ func ResourceProfileToAPI(rp *ResourceProfile) (tpRL *utils.TPResourceProfile) {
 		Blocker:            rp.Blocker,
 		Stored:             rp.Stored,
 		Weight:             rp.Weight,
+		Weights:            rp.Weights.Clone(),
 		ThresholdIDs:       make([]string, len(rp.ThresholdIDs)),
 	}
 	if rp.UsageTTL != time.Duration(0) {
@@ -1499,10 +1518,10 @@ type StatMdls []*StatMdl
 func (tps StatMdls) CSVHeader() (result []string) {
 	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
 		utils.QueueLength, utils.TTL, utils.MinItems, utils.MetricIDs, utils.MetricFilterIDs,
-		utils.Stored, utils.Blocker, utils.Weight, utils.ThresholdIDs}
+		utils.Stored, utils.Blocker, utils.Weight, utils.ThresholdIDs, utils.Weights}
 }
 
-func (models StatMdls) AsTPStats() (result []*utils.TPStatProfile) {
+func (models StatMdls) AsTPStats() (result []*utils.TPStatProfile, err error) {
 	filterMap := make(map[string]utils.StringSet)
 	thresholdMap := make(map[string]utils.StringSet)
 	statMetricsMap := make(map[string]map[string]*utils.MetricWithFilters)
@@ -1532,6 +1551,12 @@ func (models StatMdls) AsTPStats() (result []*utils.TPStatProfile) {
 		if model.Weight != 0 {
 			st.Weight = model.Weight
 		}
+		if model.Weights != utils.EmptyString {
+			if st.Weights, err = utils.NewDynamicWeightsFromString(model.Weights,
+				utils.InfieldSep, utils.ANDSep); err != nil {
+				return nil, err
+			}
+		}
 		if model.MinItems != 0 {
 			st.MinItems = model.MinItems
 		}
@@ -1627,6 +1652,7 @@ func APItoModelStats(st *utils.TPStatProfile) (mdls StatMdls) {
 				mdl.Stored = st.Stored
 				mdl.Blocker = st.Blocker
 				mdl.Weight = st.Weight
+				mdl.Weights = st.Weights.String(utils.InfieldSep, utils.ANDSep)
 				for i, val := range st.ThresholdIDs {
 					if i != 0 {
 						mdl.ThresholdIDs += utils.InfieldSep
@@ -1680,6 +1706,7 @@ func APItoStats(tpST *utils.TPStatProfile, timezone string) (st *StatQueueProfil
 			return nil, err
 		}
 	}
+	st.Weights = tpST.Weights.Clone()
 	return st, nil
 }
 
@@ -1694,6 +1721,7 @@ func StatQueueProfileToAPI(st *StatQueueProfile) (tpST *utils.TPStatProfile) {
 		Blocker:            st.Blocker,
 		Stored:             st.Stored,
 		Weight:             st.Weight,
+		Weights:            st.Weights.Clone(),
 		MinItems:           st.MinItems,
 		ThresholdIDs:       make([]string, len(st.ThresholdIDs)),
 	}
@@ -2078,10 +2106,10 @@ type ThresholdMdls []*ThresholdMdl
 func (tps ThresholdMdls) CSVHeader() (result []string) {
 	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
 		utils.MaxHits, utils.MinHits, utils.MinSleep,
-		utils.Blocker, utils.Weight, utils.ActionIDs, utils.Async, utils.EeIDs}
+		utils.Blocker, utils.Weight, utils.ActionIDs, utils.Async, utils.EeIDs, utils.Weights}
 }
 
-func (tps ThresholdMdls) AsTPThreshold() (result []*utils.TPThresholdProfile) {
+func (tps ThresholdMdls) AsTPThreshold() (result []*utils.TPThresholdProfile, err error) {
 	mst := make(map[string]*utils.TPThresholdProfile)
 	filterMap := make(map[string]utils.StringSet)
 	actionMap := make(map[string]utils.StringSet)
@@ -2116,6 +2144,12 @@ func (tps ThresholdMdls) AsTPThreshold() (result []*utils.TPThresholdProfile) {
 		if tp.Weight != 0 {
 			th.Weight = tp.Weight
 		}
+		if tp.Weights != utils.EmptyString {
+			if th.Weights, err = utils.NewDynamicWeightsFromString(tp.Weights,
+				utils.InfieldSep, utils.ANDSep); err != nil {
+				return nil, err
+			}
+		}
 		if len(tp.ActivationInterval) != 0 {
 			th.ActivationInterval = new(utils.TPActivationInterval)
 			aiSplt := strings.Split(tp.ActivationInterval, utils.InfieldSep)
@@ -2165,6 +2199,7 @@ func APItoModelTPThreshold(th *utils.TPThresholdProfile) (mdls ThresholdMdls) {
 			if i == 0 {
 				mdl.Blocker = th.Blocker
 				mdl.Weight = th.Weight
+				mdl.Weights = th.Weights.String(utils.InfieldSep, utils.ANDSep)
 				mdl.MaxHits = th.MaxHits
 				mdl.MinHits = th.MinHits
 				mdl.MinSleep = th.MinSleep
@@ -2211,6 +2246,7 @@ func APItoModelTPThreshold(th *utils.TPThresholdProfile) (mdls ThresholdMdls) {
 				if min == 0 && i == 0 {
 					mdl.Blocker = th.Blocker
 					mdl.Weight = th.Weight
+					mdl.Weights = th.Weights.String(utils.InfieldSep, utils.ANDSep)
 					mdl.MaxHits = th.MaxHits
 					mdl.MinHits = th.MinHits
 					mdl.MinSleep = th.MinSleep
@@ -2261,6 +2297,7 @@ func APItoThresholdProfile(tpTH *utils.TPThresholdProfile, timezone string) (th
 			return nil, err
 		}
 	}
+	th.Weights = tpTH.Weights.Clone()
 	return th, nil
 }
 
@@ -2274,6 +2311,7 @@ func ThresholdProfileToAPI(th *ThresholdProfile) (tpTH *utils.TPThresholdProfile
 		MinHits:            th.MinHits,
 		Blocker:            th.Blocker,
 		Weight:             th.Weight,
+		Weights:            th.Weights.Clone(),
 		ActionIDs:          make([]string, len(th.ActionIDs)),
 		EeIDs:              make([]string, len(th.EeIDs)),
 		Async:              th.Async,
@@ -2395,7 +2433,8 @@ func APItoFilter(tpTH *utils.TPFilterProfile, timezone string) (th *Filter, err
 		Rules:  make([]*FilterRule, len(tpTH.Filters)),
 	}
 	for i, f := range tpTH.Filters {
-		rf := &FilterRule{Type: f.Type, Element: f.Element, Values: f.Values}
+		rf := &FilterRule{Type: f.Type, Element: f.Element, Values: f.Values,
+			tenant: tpTH.Tenant}
 		if err := rf.CompileValues(); err != nil {
 			return nil, err
 		}
@@ -2443,11 +2482,11 @@ func (tps RouteMdls) CSVHeader() (result []string) {
 		utils.Sorting, utils.SortingParameters, utils.RouteID, utils.RouteFilterIDs,
 		utils.RouteAccountIDs, utils.RouteRatingplanIDs, utils.RouteResourceIDs,
 		utils.RouteStatIDs, utils.RouteWeight, utils.RouteBlocker,
-		utils.RouteParameters, utils.Weight,
+		utils.RouteParameters, utils.Weight, utils.RouteWeights, utils.Weights,
 	}
 }
 
-func (tps RouteMdls) AsTPRouteProfile() (result []*utils.TPRouteProfile) {
+func (tps RouteMdls) AsTPRouteProfile() (result []*utils.TPRouteProfile, err error) {
 	filterMap := make(map[string]utils.StringSet)
 	mst := make(map[string]*utils.TPRouteProfile)
 	routeMap := make(map[string]map[string]*utils.TPRoute)
@@ -2480,6 +2519,12 @@ func (tps RouteMdls) AsTPRouteProfile() (result []*utils.TPRouteProfile) {
 					Blocker:         tp.RouteBlocker,
 					RouteParameters: tp.RouteParameters,
 				}
+				if tp.RouteWeights != utils.EmptyString {
+					if sup.Weights, err = utils.NewDynamicWeightsFromString(tp.RouteWeights,
+						utils.InfieldSep, utils.ANDSep); err != nil {
+						return nil, err
+					}
+				}
 			}
 			if tp.RouteFilterIDs != utils.EmptyString {
 				supFilterSplit := strings.Split(tp.RouteFilterIDs, utils.InfieldSep)
@@ -2515,6 +2560,12 @@ func (tps RouteMdls) AsTPRouteProfile() (result []*utils.TPRouteProfile) {
 		if tp.Weight != 0 {
 			th.Weight = tp.Weight
 		}
+		if tp.Weights != utils.EmptyString {
+			if th.Weights, err = utils.NewDynamicWeightsFromString(tp.Weights,
+				utils.InfieldSep, utils.ANDSep); err != nil {
+				return nil, err
+			}
+		}
 		if tp.ActivationInterval != utils.EmptyString {
 			th.ActivationInterval = new(utils.TPActivationInterval)
 			aiSplt := strings.Split(tp.ActivationInterval, utils.InfieldSep)
@@ -2560,6 +2611,7 @@ func APItoModelTPRoutes(st *utils.TPRouteProfile) (mdls RouteMdls) {
 		if i == 0 {
 			mdl.Sorting = st.Sorting
 			mdl.Weight = st.Weight
+			mdl.Weights = st.Weights.String(utils.InfieldSep, utils.ANDSep)
 			for i, val := range st.FilterIDs {
 				if i != 0 {
 					mdl.FilterIDs += utils.InfieldSep
@@ -2613,6 +2665,7 @@ func APItoModelTPRoutes(st *utils.TPRouteProfile) (mdls RouteMdls) {
 			mdl.RouteStatIDs += val
 		}
 		mdl.RouteWeight = supl.Weight
+		mdl.RouteWeights = supl.Weights.String(utils.InfieldSep, utils.ANDSep)
 		mdl.RouteParameters = supl.RouteParameters
 		mdl.RouteBlocker = supl.Blocker
 		mdls = append(mdls, mdl)
@@ -2651,7 +2704,9 @@ func APItoRouteProfile(tpRp *utils.TPRouteProfile, timezone string) (rp *RoutePr
 			StatIDs:         route.StatIDs,
 			RouteParameters: route.RouteParameters,
 		}
+		rp.Routes[i].Weights = route.Weights.Clone()
 	}
+	rp.Weights = tpRp.Weights.Clone()
 	return rp, nil
 }
 
@@ -2665,6 +2720,7 @@ func RouteProfileToAPI(rp *RouteProfile) (tpRp *utils.TPRouteProfile) {
 		SortingParameters:  make([]string, len(rp.SortingParameters)),
 		Routes:             make([]*utils.TPRoute, len(rp.Routes)),
 		Weight:             rp.Weight,
+		Weights:            rp.Weights.Clone(),
 	}
 
 	for i, route := range rp.Routes {
@@ -2676,6 +2732,7 @@ func RouteProfileToAPI(rp *RouteProfile) (tpRp *utils.TPRouteProfile) {
 			ResourceIDs:     route.ResourceIDs,
 			StatIDs:         route.StatIDs,
 			Weight:          route.Weight,
+			Weights:         route.Weights.Clone(),
 			Blocker:         route.Blocker,
 			RouteParameters: route.RouteParameters,
 		}
@@ -2700,10 +2757,10 @@ type AttributeMdls []*AttributeMdl
 // CSVHeader return the header for csv fields as a slice of string
 func (tps AttributeMdls) CSVHeader() (result []string) {
 	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
-		utils.AttributeFilterIDs, utils.Path, utils.Type, utils.Value, utils.Blocker, utils.Weight}
+		utils.AttributeFilterIDs, utils.Path, utils.Type, utils.Value, utils.Blocker, utils.Weight, utils.Weights}
 }
 
-func (tps AttributeMdls) AsTPAttributes() (result []*utils.TPAttributeProfile) {
+func (tps AttributeMdls) AsTPAttributes() (result []*utils.TPAttributeProfile, err error) {
 	mst := make(map[string]*utils.TPAttributeProfile)
 	filterMap := make(map[string]utils.StringSet)
 	contextMap := make(map[string]utils.StringSet)
@@ -2722,6 +2779,12 @@ func (tps AttributeMdls) AsTPAttributes() (result []*utils.TPAttributeProfile) {
 		if tp.Weight != 0 {
 			th.Weight = tp.Weight
 		}
+		if tp.Weights != utils.EmptyString {
+			if th.Weights, err = utils.NewDynamicWeightsFromString(tp.Weights,
+				utils.InfieldSep, utils.ANDSep); err != nil {
+				return nil, err
+			}
+		}
 		if len(tp.ActivationInterval) != 0 {
 			th.ActivationInterval = new(utils.TPActivationInterval)
 			aiSplt := strings.Split(tp.ActivationInterval, utils.InfieldSep)
@@ -2804,6 +2867,7 @@ func APItoModelTPAttribute(th *utils.TPAttributeProfile) (mdls AttributeMdls) {
 			if th.Weight != 0 {
 				mdl.Weight = th.Weight
 			}
+			mdl.Weights = th.Weights.String(utils.InfieldSep, utils.ANDSep)
 		}
 		mdl.Path = reqAttribute.Path
 		mdl.Value = reqAttribute.Value
@@ -2849,6 +2913,7 @@ func APItoAttributeProfile(tpAttr *utils.TPAttributeProfile, timezone string) (a
 			return nil, err
 		}
 	}
+	attrPrf.Weights = tpAttr.Weights.Clone()
 	return attrPrf, nil
 }
 
@@ -2862,6 +2927,7 @@ func AttributeProfileToAPI(attrPrf *AttributeProfile) (tpAttr *utils.TPAttribute
 		ActivationInterval: new(utils.TPActivationInterval),
 		Blocker:            attrPrf.Blocker,
 		Weight:             attrPrf.Weight,
+		Weights:            attrPrf.Weights.Clone(),
 	}
 
 	copy(tpAttr.FilterIDs, attrPrf.FilterIDs)
@@ -2891,10 +2957,10 @@ type ChargerMdls []*ChargerMdl
 // CSVHeader return the header for csv fields as a slice of string
 func (tps ChargerMdls) CSVHeader() (result []string) {
 	return []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
-		utils.RunID, utils.AttributeIDs, utils.Weight}
+		utils.RunID, utils.AttributeIDs, utils.Weight, utils.Weights}
 }
 
-func (tps ChargerMdls) AsTPChargers() (result []*utils.TPChargerProfile) {
+func (tps ChargerMdls) AsTPChargers() (result []*utils.TPChargerProfile, err error) {
 	mst := make(map[string]*utils.TPChargerProfile)
 	filterMap := make(map[string]utils.StringSet)
 	attributeMap := make(map[string][]string)
@@ -2911,6 +2977,12 @@ func (tps ChargerMdls) AsTPChargers() (result []*utils.TPChargerProfile) {
 		if tp.Weight != 0 {
 			tpCPP.Weight = tp.Weight
 		}
+		if tp.Weights != utils.EmptyString {
+			if tpCPP.Weights, err = utils.NewDynamicWeightsFromString(tp.Weights,
+				utils.InfieldSep, utils.ANDSep); err != nil {
+				return nil, err
+			}
+		}
 		if len(tp.ActivationInterval) != 0 {
 			tpCPP.ActivationInterval = new(utils.TPActivationInterval)
 			aiSplt := strings.Split(tp.ActivationInterval, utils.InfieldSep)
@@ -2979,11 +3051,12 @@ func APItoModelTPCharger(tpCPP *utils.TPChargerProfile) (mdls ChargerMdls) {
 		}
 		if min == 0 {
 			mdl := &ChargerMdl{
-				Tenant: tpCPP.Tenant,
-				Tpid:   tpCPP.TPid,
-				ID:     tpCPP.ID,
-				Weight: tpCPP.Weight,
-				RunID:  tpCPP.RunID,
+				Tenant:  tpCPP.Tenant,
+				Tpid:    tpCPP.TPid,
+				ID:      tpCPP.ID,
+				Weight:  tpCPP.Weight,
+				Weights: tpCPP.Weights.String(utils.InfieldSep, utils.ANDSep),
+				RunID:   tpCPP.RunID,
 			}
 			if tpCPP.ActivationInterval != nil {
 				if tpCPP.ActivationInterval.ActivationTime != utils.EmptyString {
@@ -3009,6 +3082,7 @@ func APItoModelTPCharger(tpCPP *utils.TPChargerProfile) (mdls ChargerMdls) {
 				}
 				if i == 0 {
 					mdl.Weight = tpCPP.Weight
+					mdl.Weights = tpCPP.Weights.String(utils.InfieldSep, utils.ANDSep)
 					mdl.RunID = tpCPP.RunID
 					if tpCPP.ActivationInterval != nil {
 						if tpCPP.ActivationInterval.ActivationTime != utils.EmptyString {
@@ -3069,6 +3143,7 @@ func APItoChargerProfile(tpCPP *utils.TPChargerProfile, timezone string) (cpp *C
 			return nil, err
 		}
 	}
+	cpp.Weights = tpCPP.Weights.Clone()
 	return cpp, nil
 }
 
@@ -3081,6 +3156,7 @@ func ChargerProfileToAPI(chargerPrf *ChargerProfile) (tpCharger *utils.TPCharger
 		RunID:              chargerPrf.RunID,
 		AttributeIDs:       make([]string, len(chargerPrf.AttributeIDs)),
 		Weight:             chargerPrf.Weight,
+		Weights:            chargerPrf.Weights.Clone(),
 	}
 
 	copy(tpCharger.FilterIDs, chargerPrf.FilterIDs)
@@ -3515,3 +3591,91 @@ func DispatcherHostToAPI(dph *DispatcherHost) (tpDPH *utils.TPDispatcherHost) {
 		},
 	}
 }
+
+// LookupTableMdls is used to convert lookup table rows into TP objects
+type LookupTableMdls []*LookupTableMdl
+
+// CSVHeader return the header for csv fields as a slice of string
+func (tps LookupTableMdls) CSVHeader() (result []string) {
+	return []string{"#" + utils.Tenant, utils.ID, utils.Key, utils.Value}
+}
+
+// AsTPLookupTables merges the rows with the same tenant and ID into one table
+func (tps LookupTableMdls) AsTPLookupTables() (result []*utils.TPLookupTable) {
+	tblMap := make(map[string]*utils.TPLookupTable)
+	for _, tp := range tps {
+		tntID := utils.ConcatenatedKey(tp.Tenant, tp.ID)
+		tbl, has := tblMap[tntID]
+		if !has {
+			tbl = &utils.TPLookupTable{
+				TPid:    tp.Tpid,
+				Tenant:  tp.Tenant,
+				ID:      tp.ID,
+				Entries: make(map[string]string),
+			}
+			tblMap[tntID] = tbl
+		}
+		if tp.Key != utils.EmptyString {
+			tbl.Entries[tp.Key] = tp.Value
+		}
+	}
+	result = make([]*utils.TPLookupTable, 0, len(tblMap))
+	for _, tbl := range tblMap {
+		result = append(result, tbl)
+	}
+	return
+}
+
+// APItoModelTPLookupTable returns one row for each entry of the table
+func APItoModelTPLookupTable(tpLT *utils.TPLookupTable) (mdls LookupTableMdls) {
+	if tpLT == nil {
+		return
+	}
+	if len(tpLT.Entries) == 0 {
+		return LookupTableMdls{{
+			Tpid:   tpLT.TPid,
+			Tenant: tpLT.Tenant,
+			ID:     tpLT.ID,
+		}}
+	}
+	keys := make([]string, 0, len(tpLT.Entries))
+	for key := range tpLT.Entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	mdls = make(LookupTableMdls, len(keys))
+	for i, key := range keys {
+		mdls[i] = &LookupTableMdl{
+			Tpid:   tpLT.TPid,
+			Tenant: tpLT.Tenant,
+			ID:     tpLT.ID,
+			Key:    key,
+			Value:  tpLT.Entries[key],
+		}
+	}
+	return
+}
+
+func APItoLookupTable(tpLT *utils.TPLookupTable) (lt *LookupTable) {
+	lt = &LookupTable{
+		Tenant:  tpLT.Tenant,
+		ID:      tpLT.ID,
+		Entries: make(map[string]string, len(tpLT.Entries)),
+	}
+	for key, val := range tpLT.Entries {
+		lt.Entries[key] = val
+	}
+	return
+}
+
+func LookupTableToAPI(lt *LookupTable) (tpLT *utils.TPLookupTable) {
+	tpLT = &utils.TPLookupTable{
+		Tenant:  lt.Tenant,
+		ID:      lt.ID,
+		Entries: make(map[string]string, len(lt.Entries)),
+	}
+	for key, val := range lt.Entries {
+		tpLT.Entries[key] = val
+	}
+	return
+}
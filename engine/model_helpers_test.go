@@ -1836,7 +1836,10 @@ func TestTpResourcesAsTpResources(t *testing.T) {
 			Limit:   tps[2].Limit,
 		},
 	}
-	rcvTPs := ResourceMdls(tps).AsTPResources()
+	rcvTPs, err := ResourceMdls(tps).AsTPResources()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if len(rcvTPs) != len(eTPs) {
 		t.Errorf("Expecting: %+v Received: %+v", utils.ToIJSON(eTPs), utils.ToIJSON(rcvTPs))
 	}
@@ -1981,7 +1984,10 @@ func TestTPStatsAsTPStats(t *testing.T) {
 			Weight:             20.0,
 		},
 	}
-	rcvTPs := tps.AsTPStats()
+	rcvTPs, err := tps.AsTPStats()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if len(rcvTPs) != 2 {
 		t.Errorf("Expecting: 2, received: %+v", len(rcvTPs))
 	}
@@ -2211,7 +2217,10 @@ func TestTPThresholdsAsTPThreshold(t *testing.T) {
 			ActionIDs: []string{"WARN3"},
 		},
 	}
-	rcvTPs := ThresholdMdls(tps).AsTPThreshold()
+	rcvTPs, err := ThresholdMdls(tps).AsTPThreshold()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(eTPs[0], rcvTPs[0]) && !reflect.DeepEqual(eTPs[1], rcvTPs[0]) {
 		t.Errorf("Expecting: %+v , Received: %+v", utils.ToIJSON(eTPs), utils.ToIJSON(rcvTPs))
 	}
@@ -2266,7 +2275,7 @@ func TestAPItoModelAccountActions(t *testing.T) {
 func TestCSVHeader(t *testing.T) {
 	var tps ResourceMdls
 	eOut := []string{
-		"#Tenant", "ID", "FilterIDs", "ActivationInterval", "UsageTTL", "Limit", "AllocationMessage", "Blocker", "Stored", "Weight", "ThresholdIDs",
+		"#Tenant", "ID", "FilterIDs", "ActivationInterval", "UsageTTL", "Limit", "AllocationMessage", "Blocker", "Stored", "Weight", "ThresholdIDs", "Weights",
 	}
 	if rcv := tps.CSVHeader(); !reflect.DeepEqual(eOut, rcv) {
 		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(eOut), utils.ToJSON(rcv))
@@ -2897,7 +2906,7 @@ func TestCsvHeader(t *testing.T) {
 		utils.Sorting, utils.SortingParameters, utils.RouteID, utils.RouteFilterIDs,
 		utils.RouteAccountIDs, utils.RouteRatingplanIDs, utils.RouteResourceIDs,
 		utils.RouteStatIDs, utils.RouteWeight, utils.RouteBlocker,
-		utils.RouteParameters, utils.Weight,
+		utils.RouteParameters, utils.Weight, utils.RouteWeights, utils.Weights,
 	}
 	if rcv := tps.CSVHeader(); !reflect.DeepEqual(eOut, rcv) {
 		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(eOut), utils.ToJSON(rcv))
@@ -3121,10 +3130,10 @@ func TestCsvDumpForAttributeModels(t *testing.T) {
 	if !reflect.DeepEqual(expected, rcv) {
 		t.Errorf("Expecting : %+v,\n received: %+v", utils.ToJSON(expected), utils.ToJSON(rcv))
 	}
-	expRecord := []string{"cgrates.org", "ALS1", "con1", "FLTR_ACNT_dan", "2014-07-14T14:35:00Z", "", "*req.FL1", "", "Al1", "false", "20"}
+	expRecord := []string{"cgrates.org", "ALS1", "con1", "FLTR_ACNT_dan", "2014-07-14T14:35:00Z", "", "*req.FL1", "", "Al1", "false", "20", ""}
 	for i, model := range rcv {
 		if i == 1 {
-			expRecord = []string{"cgrates.org", "ALS1", "", "", "", "", "*req.FL2", "", "Al2", "false", "0"}
+			expRecord = []string{"cgrates.org", "ALS1", "", "", "", "", "*req.FL2", "", "Al2", "false", "0", ""}
 		}
 		if csvRecordRcv, _ := CsvDump(model); !reflect.DeepEqual(expRecord, csvRecordRcv) {
 			t.Errorf("Expecting : %+v, received: %+v", utils.ToJSON(expRecord), utils.ToJSON(csvRecordRcv))
@@ -3185,7 +3194,10 @@ func TestModelAsTPAttribute2(t *testing.T) {
 		},
 		Weight: 20,
 	}
-	rcv := models.AsTPAttributes()
+	rcv, err := models.AsTPAttributes()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Strings(rcv[0].FilterIDs)
 	if !reflect.DeepEqual(expected, rcv[0]) && !reflect.DeepEqual(expected2, rcv[0]) {
 		t.Errorf("Expecting : %+v, received: %+v", utils.ToJSON(expected), utils.ToJSON(rcv[0]))
@@ -3244,7 +3256,10 @@ func TestModelAsTPAttribute(t *testing.T) {
 		},
 		Weight: 20,
 	}
-	rcv := models.AsTPAttributes()
+	rcv, err := models.AsTPAttributes()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Strings(rcv[0].FilterIDs)
 	if !reflect.DeepEqual(expected, rcv[0]) && !reflect.DeepEqual(expected2, rcv[0]) {
 		t.Errorf("Expecting : %+v, received: %+v", utils.ToJSON(expected), utils.ToJSON(rcv[0]))
@@ -3567,7 +3582,10 @@ func TestModelAsTPChargers(t *testing.T) {
 		AttributeIDs: []string{"ATTR1"},
 		Weight:       20,
 	}
-	rcv := models.AsTPChargers()
+	rcv, err := models.AsTPChargers()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(expected, rcv[0]) && !reflect.DeepEqual(expected2, rcv[0]) {
 		t.Errorf("Expecting : %+v, received: %+v", utils.ToJSON(expected), utils.ToJSON(rcv[0]))
 	}
@@ -3599,7 +3617,10 @@ func TestModelAsTPChargers2(t *testing.T) {
 		AttributeIDs: []string{"*constant:*req.RequestType:*rated;*constant:*req.Category:call", "ATTR1", "*constant:*req.Category:call"},
 		Weight:       20,
 	}
-	rcv := models.AsTPChargers()
+	rcv, err := models.AsTPChargers()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Strings(rcv[0].FilterIDs)
 	if !reflect.DeepEqual(expected, rcv[0]) {
 		t.Errorf("Expecting : %+v, received: %+v", utils.ToJSON(expected), utils.ToJSON(rcv[0]))
@@ -3632,7 +3653,10 @@ func TestModelAsTPChargers3(t *testing.T) {
 		AttributeIDs: []string{"*constant:*req.RequestType:*rated;*constant:*req.Category:call", "ATTR1", "*constant:*req.Category:call&<~*req.OriginID;_suf>"},
 		Weight:       20,
 	}
-	rcv := models.AsTPChargers()
+	rcv, err := models.AsTPChargers()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Strings(rcv[0].FilterIDs)
 	if !reflect.DeepEqual(expected, rcv[0]) {
 		t.Errorf("Expecting : %+v, received: %+v", utils.ToJSON(expected), utils.ToJSON(rcv[0]))
@@ -4222,7 +4246,10 @@ func TestTPRoutesAsTPRouteProfile(t *testing.T) {
 			Weight: 10,
 		},
 	}
-	rcv := mdl.AsTPRouteProfile()
+	rcv, err := mdl.AsTPRouteProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Slice(rcv[0].Routes, func(i, j int) bool {
 		return strings.Compare(rcv[0].Routes[i].ID, rcv[0].Routes[j].ID) < 0
 	})
@@ -4299,7 +4326,10 @@ func TestTPRoutesAsTPRouteProfile(t *testing.T) {
 			Weight: 10,
 		},
 	}
-	rcvRev := mdlReverse.AsTPRouteProfile()
+	rcvRev, err := mdlReverse.AsTPRouteProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Slice(rcvRev[0].Routes, func(i, j int) bool {
 		return strings.Compare(rcvRev[0].Routes[i].ID, rcvRev[0].Routes[j].ID) < 0
 	})
@@ -4379,7 +4409,10 @@ func TestTPRoutesAsTPRouteProfile2(t *testing.T) {
 			Weight: 10,
 		},
 	}
-	rcv := mdl.AsTPRouteProfile()
+	rcv, err := mdl.AsTPRouteProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Slice(rcv[0].Routes, func(i, j int) bool {
 		return strings.Compare(rcv[0].Routes[i].ID, rcv[0].Routes[j].ID) < 0
 	})
@@ -4456,7 +4489,10 @@ func TestTPRoutesAsTPRouteProfile2(t *testing.T) {
 			Weight: 10,
 		},
 	}
-	rcvRev := mdlReverse.AsTPRouteProfile()
+	rcvRev, err := mdlReverse.AsTPRouteProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
 	sort.Slice(rcvRev[0].Routes, func(i, j int) bool {
 		return strings.Compare(rcvRev[0].Routes[i].ID, rcvRev[0].Routes[j].ID) < 0
 	})
@@ -4602,7 +4638,9 @@ func TestAPItoModelTPRoutesEmptySlice(t *testing.T) {
 	tpRoute[0].Routes[0].AccountIDs = nil
 	tpRoute[0].Routes[0].RatingPlanIDs = nil
 	tpRoute[0].Routes[0].ResourceIDs = nil
-	if newRcv := mdl.AsTPRouteProfile(); !reflect.DeepEqual(newRcv, tpRoute) {
+	if newRcv, err := mdl.AsTPRouteProfile(); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(newRcv, tpRoute) {
 		t.Errorf("Expected %+v, received %+v", utils.ToJSON(tpRoute), utils.ToJSON(newRcv))
 	}
 }
@@ -5038,7 +5076,7 @@ func TestThresholdMdlsCSVHeader(t *testing.T) {
 	}
 	expStruct := []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
 		utils.MaxHits, utils.MinHits, utils.MinSleep,
-		utils.Blocker, utils.Weight, utils.ActionIDs, utils.Async, utils.EeIDs}
+		utils.Blocker, utils.Weight, utils.ActionIDs, utils.Async, utils.EeIDs, utils.Weights}
 	result := testStruct.CSVHeader()
 	if !reflect.DeepEqual(result, expStruct) {
 		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.ToJSON(expStruct), utils.ToJSON(result))
@@ -5054,7 +5092,7 @@ func TestChargerMdlsCSVHeader(t *testing.T) {
 		},
 	}
 	expStruct := []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
-		utils.RunID, utils.AttributeIDs, utils.Weight}
+		utils.RunID, utils.AttributeIDs, utils.Weight, utils.Weights}
 
 	result := testStruct.CSVHeader()
 	if !reflect.DeepEqual(result, expStruct) {
@@ -5160,7 +5198,7 @@ func TestAttributeMdlsCSVHeader(t *testing.T) {
 		},
 	}
 	expStruct := []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
-		utils.AttributeFilterIDs, utils.Path, utils.Type, utils.Value, utils.Blocker, utils.Weight}
+		utils.AttributeFilterIDs, utils.Path, utils.Type, utils.Value, utils.Blocker, utils.Weight, utils.Weights}
 	result := testStruct.CSVHeader()
 	if !reflect.DeepEqual(result, expStruct) {
 		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.ToJSON(expStruct), utils.ToJSON(result))
@@ -5435,7 +5473,10 @@ func TestThresholdMdlsAsTPThresholdActivationTime(t *testing.T) {
 			Async:    false,
 		},
 	}
-	result := testStruct.AsTPThreshold()
+	result, err := testStruct.AsTPThreshold()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(result, expStruct) {
 		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.ToJSON(expStruct), utils.ToJSON(result))
 	}
@@ -5600,7 +5641,10 @@ func TestStatMdlsAsTPStatsCase2(t *testing.T) {
 			},
 		},
 	}}
-	result := testStruct.AsTPStats()
+	result, err := testStruct.AsTPStats()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(result, expStruct) {
 		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.ToJSON(expStruct), utils.ToJSON(result))
 	}
@@ -5627,7 +5671,7 @@ func TestStatMdlsCSVHeader(t *testing.T) {
 	}}
 	expStruct := []string{"#" + utils.Tenant, utils.ID, utils.FilterIDs, utils.ActivationIntervalString,
 		utils.QueueLength, utils.TTL, utils.MinItems, utils.MetricIDs, utils.MetricFilterIDs,
-		utils.Stored, utils.Blocker, utils.Weight, utils.ThresholdIDs}
+		utils.Stored, utils.Blocker, utils.Weight, utils.ThresholdIDs, utils.Weights}
 	result := testStruct.CSVHeader()
 	if !reflect.DeepEqual(result, expStruct) {
 		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.ToJSON(expStruct), utils.ToJSON(result))
@@ -5762,7 +5806,10 @@ func TestTpResourcesAsTpResources2(t *testing.T) {
 			ThresholdIDs: []string{"WARN_RES1"},
 		},
 	}
-	result := ResourceMdls(testStruct).AsTPResources()
+	result, err := ResourceMdls(testStruct).AsTPResources()
+	if err != nil {
+		t.Fatal(err)
+	}
 	if !reflect.DeepEqual(result, expStruct) {
 		t.Errorf("\nExpecting <%+v>,\n Received <%+v>", utils.ToJSON(expStruct), utils.ToJSON(result))
 	}
@@ -6320,7 +6367,10 @@ func TestAsTPAttributesFilterIDsDuplicate(t *testing.T) {
 				},
 			}
 
-			result := mdls.AsTPAttributes()
+			result, err := mdls.AsTPAttributes()
+			if err != nil {
+				t.Fatal(err)
+			}
 			if len(result) != 1 {
 				t.Errorf("Expected 1 TPAttributeProfile, got %d", len(result))
 				return
@@ -6345,3 +6395,39 @@ func TestAsTPAttributesFilterIDsDuplicate(t *testing.T) {
 		})
 	}
 }
+
+func TestChargerMdlsWeights(t *testing.T) {
+	mdls := ChargerMdls{{
+		Tenant:       "cgrates.org",
+		ID:           "CPP_1",
+		RunID:        utils.MetaDefault,
+		AttributeIDs: utils.MetaNone,
+		Weight:       10,
+		Weights:      "FLTR_1&FLTR_2;30;;20",
+	}}
+	exp := utils.DynamicWeights{
+		{FilterIDs: []string{"FLTR_1", "FLTR_2"}, Weight: 30},
+		{Weight: 20},
+	}
+	tpCPPs, err := mdls.AsTPChargers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(exp, tpCPPs[0].Weights) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(tpCPPs[0].Weights))
+	}
+	cpp, err := APItoChargerProfile(tpCPPs[0], utils.EmptyString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(exp, cpp.Weights) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(cpp.Weights))
+	}
+	if rcv := APItoModelTPCharger(ChargerProfileToAPI(cpp)); rcv[0].Weights != mdls[0].Weights {
+		t.Errorf("expected %q, received %q", mdls[0].Weights, rcv[0].Weights)
+	}
+	mdls[0].Weights = "FLTR_1;30;20"
+	if _, err := mdls.AsTPChargers(); err == nil {
+		t.Error("expected error for invalid Weights")
+	}
+}
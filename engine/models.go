@@ -253,6 +253,7 @@ type ResourceMdl struct {
 	Stored             bool    `index:"8" re:".*"`
 	Weight             float64 `index:"9" re:".*"`
 	ThresholdIDs       string  `index:"10" re:".*"`
+	Weights            string  `index:"11" re:".*" optional:"true"`
 	CreatedAt          time.Time
 }
 
@@ -301,6 +302,7 @@ type StatMdl struct {
 	Blocker            bool    `index:"10" re:".*"`
 	Weight             float64 `index:"11" re:".*"`
 	ThresholdIDs       string  `index:"12" re:".*"`
+	Weights            string  `index:"13" re:".*" optional:"true"`
 	CreatedAt          time.Time
 }
 
@@ -364,6 +366,7 @@ type ThresholdMdl struct {
 	ActionIDs          string  `index:"9" re:".*"`
 	Async              bool    `index:"10" re:".*"`
 	EeIDs              string  `index:"11"  re:".*"`
+	Weights            string  `index:"12" re:".*" optional:"true"`
 
 	CreatedAt time.Time
 }
@@ -456,6 +459,7 @@ type SessionCostsSQL struct {
 	OriginHost  string
 	OriginID    string
 	CostSource  string
+	Account     string
 	Usage       int64
 	CostDetails string
 	CreatedAt   time.Time
@@ -466,6 +470,26 @@ func (t SessionCostsSQL) TableName() string {
 	return utils.SessionCostsTBL
 }
 
+type AuditRecordSQL struct {
+	ID         int64
+	RecordID   string
+	Time       time.Time `gorm:"column:record_time"`
+	Address    string
+	ApiKey     string
+	Method     string
+	Tenant     string
+	ObjectType string
+	ObjectID   string
+	Before     string `gorm:"column:object_before"`
+	After      string `gorm:"column:object_after"`
+	Changes    string
+	Error      string
+}
+
+func (AuditRecordSQL) TableName() string {
+	return utils.AuditRecordsTBL
+}
+
 type TBLVersion struct {
 	ID      uint
 	Item    string
@@ -495,6 +519,8 @@ type RouteMdl struct {
 	RouteBlocker       bool    `index:"13" re:".*"`
 	RouteParameters    string  `index:"14" re:".*"`
 	Weight             float64 `index:"15" re:".*"`
+	RouteWeights       string  `index:"16" re:".*" optional:"true"`
+	Weights            string  `index:"17" re:".*" optional:"true"`
 	CreatedAt          time.Time
 }
 
@@ -516,6 +542,7 @@ type AttributeMdl struct {
 	Value              string  `index:"8" re:".*"`
 	Blocker            bool    `index:"9" re:".*"`
 	Weight             float64 `index:"10" re:".*"`
+	Weights            string  `index:"11" re:".*" optional:"true"`
 	CreatedAt          time.Time
 }
 
@@ -533,6 +560,7 @@ type ChargerMdl struct {
 	RunID              string  `index:"4" re:".*"`
 	AttributeIDs       string  `index:"5" re:".*"`
 	Weight             float64 `index:"6" re:".*"`
+	Weights            string  `index:"7" re:".*" optional:"true"`
 	CreatedAt          time.Time
 }
 
@@ -585,3 +613,17 @@ type DispatcherHostMdl struct {
 func (DispatcherHostMdl) TableName() string {
 	return utils.TBLTPDispatcherHosts
 }
+
+type LookupTableMdl struct {
+	PK        uint   `gorm:"primary_key"`
+	Tpid      string //
+	Tenant    string `index:"0" re:".*"`
+	ID        string `index:"1" re:".*"`
+	Key       string `index:"2" re:".*"`
+	Value     string `index:"3" re:".*"`
+	CreatedAt time.Time
+}
+
+func (LookupTableMdl) TableName() string {
+	return utils.TBLTPLookupTables
+}
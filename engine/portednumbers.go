@@ -0,0 +1,108 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// PortedNumber maps a number moved to another network to the routing number
+// identifying the network now serving it
+type PortedNumber struct {
+	Number        string
+	RoutingNumber string
+}
+
+// PortedNumberWithAPIOpts is used in replicator
+type PortedNumberWithAPIOpts struct {
+	*PortedNumber
+	Tenant  string
+	APIOpts map[string]any
+}
+
+// PortedNumbersWithAPIOpts is used in replicator for the batches of ported numbers
+type PortedNumbersWithAPIOpts struct {
+	PortedNumbers []*PortedNumber
+	Tenant        string
+	APIOpts       map[string]any
+}
+
+// portedNumbersBatchSize is the number of CSV records written to DataDB at once
+var portedNumbersBatchSize = 1000
+
+// RoutedNumber returns the number prefixed with the routing number so it can be
+// matched against the destination prefixes of the recipient network
+func (pn *PortedNumber) RoutedNumber() string {
+	return pn.RoutingNumber + pn.Number
+}
+
+// PortedNumberRoute returns the number prefixed with its routing number when the
+// number is ported, otherwise the number unchanged
+func PortedNumberRoute(number string) (string, error) {
+	rn, err := dm.GetPortedNumber(number, true, true, utils.NonTransactional)
+	if err != nil {
+		if err == utils.ErrNotFound {
+			return number, nil
+		}
+		return utils.EmptyString, err
+	}
+	return (&PortedNumber{Number: number, RoutingNumber: rn}).RoutedNumber(), nil
+}
+
+// LoadPortedNumbersFromCSV stores the ported numbers read from the CSV records
+// (Number,RoutingNumber) in batches and returns the number of loaded entries
+func LoadPortedNumbersFromCSV(dm *DataManager, rdr io.Reader, sep rune) (loaded int, err error) {
+	csvRdr := csv.NewReader(rdr)
+	csvRdr.Comma = sep
+	csvRdr.Comment = '#'
+	csvRdr.FieldsPerRecord = 2
+	csvRdr.ReuseRecord = true
+	batch := make([]*PortedNumber, 0, portedNumbersBatchSize)
+	for {
+		var record []string
+		if record, err = csvRdr.Read(); err != nil {
+			if err != io.EOF {
+				return
+			}
+			if err = dm.SetPortedNumbers(batch); err != nil {
+				return
+			}
+			return loaded + len(batch), nil
+		}
+		if record[0] == utils.EmptyString || record[1] == utils.EmptyString {
+			line, _ := csvRdr.FieldPos(0)
+			return loaded, fmt.Errorf("empty number or routing number at line: %d", line)
+		}
+		batch = append(batch, &PortedNumber{
+			Number:        record[0],
+			RoutingNumber: record[1],
+		})
+		if len(batch) == portedNumbersBatchSize {
+			if err = dm.SetPortedNumbers(batch); err != nil {
+				return
+			}
+			loaded += len(batch)
+			batch = make([]*PortedNumber, 0, portedNumbersBatchSize)
+		}
+	}
+}
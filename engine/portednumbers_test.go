@@ -0,0 +1,196 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestPortedNumbersDataManager(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmPN := NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CachePortedNumbers})
+	if _, err := dmPN.GetPortedNumber("40721000001", true, true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	// the not found was not cached
+	if _, has := Cache.Get(utils.CachePortedNumbers, "40721000001"); has {
+		t.Error("Expected the not found to not be cached")
+	}
+	if err := dmPN.SetPortedNumber(&PortedNumber{Number: "40721000001", RoutingNumber: "D123"}); err != nil {
+		t.Fatal(err)
+	}
+	if rn, err := dmPN.GetPortedNumber("40721000001", true, true, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if rn != "D123" {
+		t.Errorf("Expected D123, received %q", rn)
+	}
+	if x, has := Cache.Get(utils.CachePortedNumbers, "40721000001"); !has || x != "D123" {
+		t.Errorf("Expected D123 cached, received %v", x)
+	}
+	if err := dmPN.RemovePortedNumber("40721000001"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dmPN.GetPortedNumber("40721000001", true, true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err := dmPN.RemovePortedNumber("40721000001"); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestPortedNumbersLoadFromCSV(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmPN := NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CachePortedNumbers})
+	csvContent := `#Number,RoutingNumber
+40721000001,D123
+40721000002,D456
+`
+	if loaded, err := LoadPortedNumbersFromCSV(dmPN, strings.NewReader(csvContent), utils.CSVSep); err != nil {
+		t.Fatal(err)
+	} else if loaded != 2 {
+		t.Errorf("Expected 2 loaded numbers, received %d", loaded)
+	}
+	if rn, err := dmPN.GetPortedNumber("40721000002", true, true, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if rn != "D456" {
+		t.Errorf("Expected D456, received %q", rn)
+	}
+	expErr := "empty number or routing number at line: 2"
+	if _, err := LoadPortedNumbersFromCSV(dmPN, strings.NewReader("40721000003,D123\n40721000004,\n"),
+		utils.CSVSep); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %q, received %v", expErr, err)
+	}
+	if _, err := LoadPortedNumbersFromCSV(dmPN, strings.NewReader("40721000005\n"),
+		utils.CSVSep); err == nil {
+		t.Error("Expected error for missing routing number")
+	}
+}
+
+func TestPortedNumbersLoadFromCSVBatches(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmPN := NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CachePortedNumbers})
+	tmpBatchSize := portedNumbersBatchSize
+	defer func() {
+		portedNumbersBatchSize = tmpBatchSize
+	}()
+	portedNumbersBatchSize = 2
+	csvContent := `40721000001,D123
+40721000002,D456
+40721000003,D789
+40721000004,D123
+40721000005,D456
+`
+	if loaded, err := LoadPortedNumbersFromCSV(dmPN, strings.NewReader(csvContent), utils.CSVSep); err != nil {
+		t.Fatal(err)
+	} else if loaded != 5 {
+		t.Errorf("Expected 5 loaded numbers, received %d", loaded)
+	}
+	for number, exp := range map[string]string{
+		"40721000001": "D123",
+		"40721000003": "D789",
+		"40721000005": "D456",
+	} {
+		if rn, err := dmPN.GetPortedNumber(number, false, false, utils.NonTransactional); err != nil {
+			t.Error(err)
+		} else if rn != exp {
+			t.Errorf("Expected %s for %s, received %q", exp, number, rn)
+		}
+	}
+	// the full batches are stored before the error
+	if loaded, err := LoadPortedNumbersFromCSV(dmPN, strings.NewReader("40721000006,D123\n40721000007,D456\n40721000008,\n"),
+		utils.CSVSep); err == nil {
+		t.Error("Expected error for empty routing number")
+	} else if loaded != 2 {
+		t.Errorf("Expected 2 loaded numbers, received %d", loaded)
+	}
+}
+
+func TestPortedNumbersFilterAndAttribute(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	tmpDm := dm
+	defer func() {
+		dm = tmpDm
+	}()
+	dm = NewDataManager(data, cfg.CacheCfg(), nil)
+	Cache.Clear([]string{utils.CachePortedNumbers})
+	if err := dm.SetPortedNumber(&PortedNumber{Number: "40721000001", RoutingNumber: "D123"}); err != nil {
+		t.Fatal(err)
+	}
+	filterS := &FilterS{cfg: cfg, dm: dm}
+	dp := utils.MapStorage{
+		utils.MetaReq: utils.MapStorage{
+			utils.Destination: "40721000001",
+			utils.Subject:     "40721000002",
+		},
+	}
+	for _, tc := range []struct {
+		fltr string
+		pass bool
+	}{
+		{"*ported:~*req.Destination:", true},
+		{"*ported:~*req.Destination:D456|D123", true},
+		{"*ported:~*req.Destination:D456", false},
+		{"*ported:~*req.Subject:", false},
+		{"*ported:~*req.Missing:", false},
+		{"*notported:~*req.Destination:", false},
+		{"*notported:~*req.Subject:", true},
+	} {
+		if pass, err := filterS.Pass("cgrates.org", []string{tc.fltr}, dp); err != nil {
+			t.Errorf("filter %s: %v", tc.fltr, err)
+		} else if pass != tc.pass {
+			t.Errorf("filter %s: expected %v, received %v", tc.fltr, tc.pass, pass)
+		}
+	}
+
+	for number, exp := range map[string]string{
+		"~*req.Destination": "D12340721000001",
+		"~*req.Subject":     "40721000002",
+	} {
+		value := config.NewRSRParsersMustCompile(number, utils.InfieldSep)
+		if out, err := ParseAttribute(dp, utils.MetaPortedNumber, utils.EmptyString, value,
+			0, utils.EmptyString, utils.EmptyString, utils.InfieldSep); err != nil {
+			t.Error(err)
+		} else if out != exp {
+			t.Errorf("Expected %q, received %q", exp, out)
+		}
+	}
+}
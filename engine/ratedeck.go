@@ -0,0 +1,459 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+const rateDeckTimeLayout = "20060102150405"
+
+// RateDeckEntry is one line of a carrier rate deck
+type RateDeckEntry struct {
+	Prefix        string
+	Destination   string
+	Rate          float64
+	ConnectFee    float64
+	RateUnit      time.Duration
+	RateIncrement time.Duration
+	EffectiveDate time.Time // zero for immediately
+	Deleted       bool
+}
+
+// equalRating compares the rating of the two entries
+func (ent *RateDeckEntry) equalRating(oEnt *RateDeckEntry) bool {
+	return ent.Rate == oEnt.Rate &&
+		ent.ConnectFee == oEnt.ConnectFee &&
+		ent.RateUnit == oEnt.RateUnit &&
+		ent.RateIncrement == oEnt.RateIncrement
+}
+
+// ParseRateDeck reads the entries of the deck based on its layout
+func ParseRateDeck(rdr io.Reader, lyt *config.RateDeckCfg, tz string) (ents []*RateDeckEntry, err error) {
+	csvReader := csv.NewReader(rdr)
+	csvReader.Comma = rune(lyt.FieldSeparator[0])
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+	tz = utils.FirstNonEmpty(lyt.Timezone, tz)
+	var indxAls map[string]int
+	for lnNr := 1; ; lnNr++ {
+		var record []string
+		if record, err = csvReader.Read(); err != nil {
+			if err == io.EOF {
+				return ents, nil
+			}
+			return
+		}
+		if lnNr <= lyt.HeaderLines {
+			if lnNr == lyt.HeaderLines { // the last header line names the columns
+				indxAls = make(map[string]int)
+				for i, hdr := range record {
+					indxAls[strings.TrimSpace(hdr)] = i
+				}
+			}
+			continue
+		}
+		var ent *RateDeckEntry
+		if ent, err = newRateDeckEntry(utils.MapStorage{
+			utils.MetaReq: config.NewSliceDP(record, indxAls)}, lyt, tz); err != nil {
+			return nil, fmt.Errorf("line %d: %s", lnNr, err)
+		}
+		ents = append(ents, ent)
+	}
+}
+
+// newRateDeckEntry builds the entry out of one deck line
+func newRateDeckEntry(dP utils.DataProvider, lyt *config.RateDeckCfg, tz string) (ent *RateDeckEntry, err error) {
+	var flds [8]string
+	for i, rsr := range []config.RSRParsers{lyt.Prefix, lyt.Destination, lyt.Rate, lyt.ConnectFee,
+		lyt.RateUnit, lyt.RateIncrement, lyt.EffectiveDate, lyt.Change} {
+		if flds[i], err = rsr.ParseDataProvider(dP); err != nil {
+			if err != utils.ErrNotFound {
+				return
+			}
+			err = nil
+		}
+		flds[i] = strings.TrimSpace(flds[i])
+	}
+	ent = &RateDeckEntry{
+		Prefix:      flds[0],
+		Destination: flds[1],
+		RateUnit:    time.Minute,
+	}
+	if ent.Prefix == utils.EmptyString {
+		return nil, utils.NewErrMandatoryIeMissing(utils.PrefixCfg)
+	}
+	for _, val := range lyt.DeletedValues {
+		if strings.EqualFold(flds[7], val) {
+			ent.Deleted = true
+			break
+		}
+	}
+	if flds[6] != utils.EmptyString {
+		if ent.EffectiveDate, err = utils.ParseTimeDetectLayout(flds[6], tz); err != nil {
+			return
+		}
+	}
+	if ent.Deleted { // the rating is not needed for a deleted code
+		return
+	}
+	if flds[2] == utils.EmptyString {
+		return nil, utils.NewErrMandatoryIeMissing(utils.RateCfg)
+	}
+	if ent.Rate, err = strconv.ParseFloat(flds[2], 64); err != nil {
+		return
+	}
+	if flds[3] != utils.EmptyString {
+		if ent.ConnectFee, err = strconv.ParseFloat(flds[3], 64); err != nil {
+			return
+		}
+	}
+	if flds[4] != utils.EmptyString {
+		if ent.RateUnit, err = utils.ParseDurationWithNanosecs(flds[4]); err != nil {
+			return
+		}
+	}
+	ent.RateIncrement = ent.RateUnit
+	if flds[5] != utils.EmptyString {
+		if ent.RateIncrement, err = utils.ParseDurationWithNanosecs(flds[5]); err != nil {
+			return
+		}
+	}
+	if ent.Rate < 0 || ent.ConnectFee < 0 || ent.RateUnit <= 0 || ent.RateIncrement <= 0 {
+		return nil, fmt.Errorf("invalid rating for prefix <%s>", ent.Prefix)
+	}
+	return
+}
+
+// RateDeckChange is one line of the change report
+type RateDeckChange struct {
+	Prefix        string
+	Destination   string
+	Type          string // *new, *increase, *decrease or *deleted
+	OldRate       float64
+	NewRate       float64
+	EffectiveDate time.Time
+	Scheduled     bool // the change applies in the future
+}
+
+// RateDeckReport lists the changes brought by one rate deck
+type RateDeckReport struct {
+	DeckID          string
+	RatingProfileID string
+	Activations     []*RatingPlanActivation // the rating plans activated by the deck
+	Changes         []*RateDeckChange
+	Unchanged       int
+}
+
+// WriteCSV writes the changes, as sent to the customers
+func (rpt *RateDeckReport) WriteCSV(w io.Writer, sep rune) (err error) {
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Comma = sep
+	if err = csvWriter.Write([]string{"Prefix", utils.Destination,
+		"Change", "OldRate", "NewRate", "EffectiveDate"}); err != nil {
+		return
+	}
+	for _, chg := range rpt.Changes {
+		if err = csvWriter.Write([]string{chg.Prefix, chg.Destination, chg.Type,
+			strconv.FormatFloat(chg.OldRate, 'f', -1, 64),
+			strconv.FormatFloat(chg.NewRate, 'f', -1, 64),
+			chg.EffectiveDate.Format(time.RFC3339)}); err != nil {
+			return
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// NewRateDeckImporter returns the importer of the carrier rate decks
+func NewRateDeckImporter(cfg *config.CGRConfig, dm *DataManager) *RateDeckImporter {
+	return &RateDeckImporter{cfg: cfg, dm: dm}
+}
+
+// RateDeckImporter applies the carrier rate decks onto the rating profiles.
+// Each deck has its own rating profile and one rating plan for each of the
+// effective dates, the destinations being created for each of the codes.
+type RateDeckImporter struct {
+	cfg *config.CGRConfig
+	dm  *DataManager
+}
+
+// Import parses the deck and applies the changes which are already effective,
+// scheduling the future ones as rating plan activations. It returns the report
+// together with the cache items to be reloaded
+func (rdi *RateDeckImporter) Import(args *utils.ArgsImportRateDeck, rdr io.Reader) (rpt *RateDeckReport,
+	cacheItems map[string][]string, err error) {
+	if args.ID == utils.EmptyString {
+		return nil, nil, utils.NewErrMandatoryIeMissing(utils.ID)
+	}
+	lytID := utils.FirstNonEmpty(args.Layout, utils.MetaDefault)
+	lyt, has := rdi.cfg.LoaderCgrCfg().RateDecks[lytID]
+	if !has {
+		return nil, nil, fmt.Errorf("unknown rate deck layout <%s>", lytID)
+	}
+	var ents []*RateDeckEntry
+	if ents, err = ParseRateDeck(rdr, lyt, rdi.cfg.GeneralCfg().DefaultTimezone); err != nil {
+		return
+	}
+	tnt := utils.FirstNonEmpty(args.Tenant, rdi.cfg.GeneralCfg().DefaultTenant)
+	rpfID := utils.ConcatenatedKey(utils.MetaOut, tnt,
+		utils.FirstNonEmpty(args.Category, rdi.cfg.GeneralCfg().DefaultCategory),
+		utils.FirstNonEmpty(args.Subject, args.ID))
+	err = guardian.Guardian.Guard(func() (err error) {
+		rpt, cacheItems, err = rdi.apply(args.ID, rpfID, lyt, ents, time.Now(), args.DryRun)
+		return
+	}, rdi.cfg.GeneralCfg().LockingTimeout, utils.RatingProfilePrefix+rpfID)
+	return
+}
+
+// rateDeckStep groups the entries with the same effective date
+type rateDeckStep struct {
+	at   time.Time
+	ents []*RateDeckEntry
+}
+
+func (rdi *RateDeckImporter) apply(deckID, rpfID string, lyt *config.RateDeckCfg,
+	ents []*RateDeckEntry, now time.Time, dryRun bool) (rpt *RateDeckReport, cacheItems map[string][]string, err error) {
+	now = now.Truncate(time.Second)
+	rplPrfx := "RP_" + deckID + "_"
+	dstPrfx := deckID + "_"
+	rpf, err := rdi.dm.GetRatingProfile(rpfID, true, utils.NonTransactional)
+	if err != nil {
+		if err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+		rpf = &RatingProfile{Id: rpfID}
+	}
+	// the state of the deck active now
+	state := make(map[string]*RateDeckEntry)
+	var crrntRpa *RatingPlanActivation
+	for _, rpa := range rpf.RatingPlanActivations {
+		if strings.HasPrefix(rpa.RatingPlanId, rplPrfx) && !rpa.ActivationTime.After(now) &&
+			(crrntRpa == nil || rpa.ActivationTime.After(crrntRpa.ActivationTime)) {
+			crrntRpa = rpa
+		}
+	}
+	if crrntRpa != nil {
+		var rpl *RatingPlan
+		if rpl, err = rdi.dm.GetRatingPlan(crrntRpa.RatingPlanId, true, utils.NonTransactional); err != nil {
+			return nil, nil, fmt.Errorf("rating plan <%s>: %s", crrntRpa.RatingPlanId, err)
+		}
+		for dstID := range rpl.DestinationRates {
+			ris := rpl.RateIntervalList(dstID)
+			if !strings.HasPrefix(dstID, dstPrfx) || len(ris) == 0 ||
+				ris[0].Rating == nil || len(ris[0].Rating.Rates) == 0 {
+				continue
+			}
+			prfx := strings.TrimPrefix(dstID, dstPrfx)
+			state[prfx] = &RateDeckEntry{
+				Prefix:        prfx,
+				Rate:          ris[0].Rating.Rates[0].Value,
+				ConnectFee:    ris[0].Rating.ConnectFee,
+				RateUnit:      ris[0].Rating.Rates[0].RateUnit,
+				RateIncrement: ris[0].Rating.Rates[0].RateIncrement,
+			}
+		}
+	}
+
+	// group the entries by effective date, the ones already effective applying now
+	steps := []*rateDeckStep{{at: now}}
+	sort.SliceStable(ents, func(i, j int) bool { return ents[i].EffectiveDate.Before(ents[j].EffectiveDate) })
+	inDeck := make(utils.StringSet)
+	for _, ent := range ents {
+		inDeck.Add(ent.Prefix)
+		if !ent.EffectiveDate.After(now) {
+			steps[0].ents = append(steps[0].ents, ent)
+			continue
+		}
+		if lstStep := steps[len(steps)-1]; !lstStep.at.Equal(ent.EffectiveDate) {
+			steps = append(steps, &rateDeckStep{at: ent.EffectiveDate})
+		}
+		steps[len(steps)-1].ents = append(steps[len(steps)-1].ents, ent)
+	}
+	if lyt.FullDeck { // the codes missing from the deck are deleted now
+		for prfx := range state {
+			if !inDeck.Has(prfx) {
+				steps[0].ents = append(steps[0].ents, &RateDeckEntry{Prefix: prfx, Deleted: true})
+			}
+		}
+	}
+
+	rpt = &RateDeckReport{
+		DeckID:          deckID,
+		RatingProfileID: rpfID,
+	}
+	rpls := make([]*RatingPlan, 0, len(steps))
+	newDsts := make(utils.StringSet)
+	for i, stp := range steps {
+		var changed bool
+		for _, ent := range stp.ents {
+			chg := &RateDeckChange{
+				Prefix:        ent.Prefix,
+				Destination:   ent.Destination,
+				NewRate:       ent.Rate,
+				EffectiveDate: stp.at,
+				Scheduled:     i != 0,
+			}
+			old, has := state[ent.Prefix]
+			if has {
+				chg.OldRate = old.Rate
+				chg.Destination = utils.FirstNonEmpty(chg.Destination, old.Destination)
+			}
+			switch {
+			case ent.Deleted:
+				if !has {
+					continue
+				}
+				chg.Type = utils.MetaDeleted
+				chg.NewRate = 0
+				delete(state, ent.Prefix)
+			case !has:
+				chg.Type = utils.MetaNew
+				newDsts.Add(ent.Prefix)
+				state[ent.Prefix] = ent
+			case ent.equalRating(old):
+				state[ent.Prefix] = ent // keeps the destination name
+				if i == 0 {
+					rpt.Unchanged++
+				}
+				continue
+			case ent.Rate < old.Rate:
+				chg.Type = utils.MetaDecrease
+				state[ent.Prefix] = ent
+			default:
+				chg.Type = utils.MetaIncrease
+				state[ent.Prefix] = ent
+			}
+			changed = true
+			rpt.Changes = append(rpt.Changes, chg)
+		}
+		if !changed {
+			continue
+		}
+		rpl := rdi.ratingPlan(rplPrfx+stp.at.UTC().Format(rateDeckTimeLayout), dstPrfx, state, lyt)
+		rpls = append(rpls, rpl)
+		rpt.Activations = append(rpt.Activations, &RatingPlanActivation{
+			ActivationTime: stp.at,
+			RatingPlanId:   rpl.Id,
+		})
+	}
+	if dryRun {
+		return
+	}
+
+	// the activations scheduled by the previous decks are replaced
+	rpas := make(RatingPlanActivations, 0, len(rpf.RatingPlanActivations)+len(rpt.Activations))
+	var rmvRplIDs []string
+	for _, rpa := range rpf.RatingPlanActivations {
+		if !strings.HasPrefix(rpa.RatingPlanId, rplPrfx) {
+			rpas = append(rpas, rpa)
+			continue
+		}
+		if slices.ContainsFunc(rpt.Activations, func(nRpa *RatingPlanActivation) bool {
+			return nRpa.RatingPlanId == rpa.RatingPlanId
+		}) {
+			continue // activated again by this deck
+		}
+		if rpa.ActivationTime.After(now) {
+			rmvRplIDs = append(rmvRplIDs, rpa.RatingPlanId)
+			continue
+		}
+		rpas = append(rpas, rpa)
+	}
+	rpf.RatingPlanActivations = append(rpas, rpt.Activations...)
+	cacheItems = map[string][]string{
+		utils.CacheRatingProfiles: {rpfID},
+	}
+	for _, prfx := range newDsts.AsOrderedSlice() {
+		dst := &Destination{Id: dstPrfx + prfx, Prefixes: []string{prfx}}
+		if err = rdi.dm.SetDestination(dst, utils.NonTransactional); err != nil {
+			return
+		}
+		if err = rdi.dm.SetReverseDestination(dst.Id, dst.Prefixes, utils.NonTransactional); err != nil {
+			return
+		}
+		cacheItems[utils.CacheDestinations] = append(cacheItems[utils.CacheDestinations], dst.Id)
+		cacheItems[utils.CacheReverseDestinations] = append(cacheItems[utils.CacheReverseDestinations], prfx)
+	}
+	for _, rpl := range rpls {
+		if err = rdi.dm.SetRatingPlan(rpl); err != nil {
+			return
+		}
+		cacheItems[utils.CacheRatingPlans] = append(cacheItems[utils.CacheRatingPlans], rpl.Id)
+	}
+	if err = rdi.dm.SetRatingProfile(rpf); err != nil {
+		return
+	}
+	for _, rplID := range rmvRplIDs {
+		if err = rdi.dm.RemoveRatingPlan(rplID, utils.NonTransactional); err != nil &&
+			err != utils.ErrNotFound {
+			return
+		}
+		err = nil
+		cacheItems[utils.CacheRatingPlans] = append(cacheItems[utils.CacheRatingPlans], rplID)
+	}
+	loadID := time.Now().UnixNano()
+	err = rdi.dm.SetLoadIDs(map[string]int64{
+		utils.CacheDestinations:        loadID,
+		utils.CacheReverseDestinations: loadID,
+		utils.CacheRatingPlans:         loadID,
+		utils.CacheRatingProfiles:      loadID,
+	})
+	return
+}
+
+// ratingPlan builds the rating plan out of the deck state
+func (rdi *RateDeckImporter) ratingPlan(rplID, dstPrfx string, state map[string]*RateDeckEntry,
+	lyt *config.RateDeckCfg) (rpl *RatingPlan) {
+	rpl = &RatingPlan{Id: rplID}
+	tm := &RITiming{
+		ID:        utils.MetaAny,
+		StartTime: "00:00:00",
+		tag:       utils.MetaAny,
+	}
+	for prfx, ent := range state {
+		rpl.AddRateInterval(dstPrfx+prfx, &RateInterval{
+			Timing: tm,
+			Weight: 10,
+			Rating: &RIRate{
+				ConnectFee:       ent.ConnectFee,
+				RoundingMethod:   lyt.RoundingMethod,
+				RoundingDecimals: lyt.RoundingDecimals,
+				Rates: RateGroups{{
+					Value:         ent.Rate,
+					RateUnit:      ent.RateUnit,
+					RateIncrement: ent.RateIncrement,
+				}},
+			},
+		})
+	}
+	return
+}
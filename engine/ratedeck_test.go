@@ -0,0 +1,195 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestParseRateDeck(t *testing.T) {
+	lyt := config.NewDefaultCGRConfig().LoaderCgrCfg().RateDecks[utils.MetaDefault].Clone()
+	lyt.FieldSeparator = ";"
+	lyt.DeletedValues = []string{"Closed"}
+	lyt.Rate = config.NewRSRParsersMustCompile("~*req.Price", utils.InfieldSep)
+	lyt.Change = config.NewRSRParsersMustCompile("~*req.Status", utils.InfieldSep)
+	deck := `Code;Name;Price;Effective;Status
+49;Germany;0.02;2026-01-01T00:00:00Z;
+4915; Germany Mobile ;0.1;;Increase
+4930;Germany Berlin;;2026-02-01T00:00:00Z;closed
+`
+	ents, err := ParseRateDeck(strings.NewReader(deck), lyt, "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*RateDeckEntry{
+		{Prefix: "49", Destination: "Germany", Rate: 0.02, RateUnit: time.Minute, RateIncrement: time.Minute,
+			EffectiveDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Prefix: "4915", Destination: "Germany Mobile", Rate: 0.1, RateUnit: time.Minute, RateIncrement: time.Minute},
+		{Prefix: "4930", Destination: "Germany Berlin", RateUnit: time.Minute, Deleted: true,
+			EffectiveDate: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(exp, ents) {
+		t.Errorf("expected %s, received %s", utils.ToJSON(exp), utils.ToJSON(ents))
+	}
+	for _, deck := range []string{
+		"Code;Name;Price;Effective;Status\n;Germany;0.02;;\n",
+		"Code;Name;Price;Effective;Status\n49;Germany;;;\n",
+		"Code;Name;Price;Effective;Status\n49;Germany;abc;;\n",
+		"Code;Name;Price;Effective;Status\n49;Germany;-1;;\n",
+		"Code;Name;Price;Effective;Status\n49;Germany;0.02;not_a_date;\n",
+	} {
+		if _, err := ParseRateDeck(strings.NewReader(deck), lyt, "UTC"); err == nil {
+			t.Errorf("expected error for %q", deck)
+		}
+	}
+}
+
+func TestRateDeckImport(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	lyt := cfg.LoaderCgrCfg().RateDecks[utils.MetaDefault]
+	lyt.FullDeck = true
+	rdi := NewRateDeckImporter(cfg, dm)
+	args := &utils.ArgsImportRateDeck{ID: "CARRIER1"}
+	rpfID := "*out:cgrates.org:call:CARRIER1"
+	apply := func(deck string, now time.Time, dryRun bool) (*RateDeckReport, map[string][]string) {
+		t.Helper()
+		ents, err := ParseRateDeck(strings.NewReader(deck), lyt, "UTC")
+		if err != nil {
+			t.Fatal(err)
+		}
+		rpt, cacheItems, err := rdi.apply(args.ID, rpfID, lyt, ents, now, dryRun)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rpt, cacheItems
+	}
+
+	rpt, cacheItems, err := rdi.Import(args, strings.NewReader(`Prefix,Destination,Rate,EffectiveDate
+49,Germany,0.02,2020-01-01T00:00:00Z
+4915,Germany Mobile,0.1,
+4930,Germany Berlin,0.03,
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rpt.Changes) != 3 || rpt.Changes[0].Type != utils.MetaNew ||
+		len(rpt.Activations) != 1 || rpt.RatingProfileID != rpfID {
+		t.Errorf("received report %s", utils.ToJSON(rpt))
+	}
+	if len(cacheItems[utils.CacheDestinations]) != 3 || len(cacheItems[utils.CacheRatingPlans]) != 1 {
+		t.Errorf("received cache items %s", utils.ToJSON(cacheItems))
+	}
+	if rcv, err := dm.GetReverseDestination("4915", true, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual([]string{"CARRIER1_4915"}, rcv) {
+		t.Errorf("received %v", rcv)
+	}
+	crrntRplID := rpt.Activations[0].RatingPlanId
+	if rpl, err := dm.GetRatingPlan(crrntRplID, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if ris := rpl.RateIntervalList("CARRIER1_49"); len(ris) != 1 ||
+		ris[0].Rating.Rates[0].Value != 0.02 || ris[0].Rating.Rates[0].RateUnit != time.Minute {
+		t.Errorf("received rate intervals %s", utils.ToJSON(ris))
+	}
+
+	// the second deck changes the rates in the future, 4930 being missing from it
+	now := time.Now().Add(time.Hour).Truncate(time.Second)
+	future := now.Add(48 * time.Hour).UTC()
+	deck := `Prefix,Destination,Rate,EffectiveDate
+49,Germany,0.02,
+4915,Germany Mobile,0.12,` + future.Format(time.RFC3339) + `
+4917,Germany Mobile,0.08,` + future.Format(time.RFC3339) + `
+`
+	apply(deck, now, true)
+	if rpf, err := dm.GetRatingProfile(rpfID, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if len(rpf.RatingPlanActivations) != 1 {
+		t.Errorf("dry run changed the rating profile: %s", utils.ToJSON(rpf))
+	}
+	rpt, _ = apply(deck, now, false)
+	expChgs := []*RateDeckChange{
+		{Prefix: "4930", Type: utils.MetaDeleted, OldRate: 0.03, EffectiveDate: now},
+		{Prefix: "4915", Destination: "Germany Mobile", Type: utils.MetaIncrease, OldRate: 0.1, NewRate: 0.12,
+			EffectiveDate: future, Scheduled: true},
+		{Prefix: "4917", Destination: "Germany Mobile", Type: utils.MetaNew, NewRate: 0.08,
+			EffectiveDate: future, Scheduled: true},
+	}
+	if !reflect.DeepEqual(expChgs, rpt.Changes) || rpt.Unchanged != 1 || len(rpt.Activations) != 2 {
+		t.Errorf("received report %s", utils.ToJSON(rpt))
+	}
+	rpf, err := dm.GetRatingProfile(rpfID, true, utils.NonTransactional)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rpf.RatingPlanActivations) != 3 ||
+		!rpf.RatingPlanActivations[2].ActivationTime.Equal(future) {
+		t.Errorf("received rating profile %s", utils.ToJSON(rpf))
+	}
+	if rpl, err := dm.GetRatingPlan(rpt.Activations[0].RatingPlanId, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if _, has := rpl.DestinationRates["CARRIER1_4930"]; has || len(rpl.DestinationRates) != 2 {
+		t.Errorf("received rating plan %s", utils.ToJSON(rpl))
+	}
+	if rpl, err := dm.GetRatingPlan(rpt.Activations[1].RatingPlanId, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if ris := rpl.RateIntervalList("CARRIER1_4915"); len(rpl.DestinationRates) != 3 ||
+		len(ris) != 1 || ris[0].Rating.Rates[0].Value != 0.12 {
+		t.Errorf("received rating plan %s", utils.ToJSON(rpl))
+	}
+
+	// a new deck replaces the changes scheduled by the previous one
+	rpt, _ = apply(`Prefix,Destination,Rate,EffectiveDate
+49,Germany,0.02,
+4915,Germany Mobile,0.1,
+4917,Germany Mobile,0.08,
+`, now.Add(time.Hour), false)
+	if len(rpt.Activations) != 1 || len(rpt.Changes) != 1 || rpt.Changes[0].Prefix != "4917" {
+		t.Errorf("received report %s", utils.ToJSON(rpt))
+	}
+	if rpf, err = dm.GetRatingProfile(rpfID, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	} else if len(rpf.RatingPlanActivations) != 3 {
+		t.Errorf("received rating profile %s", utils.ToJSON(rpf))
+	}
+	if _, err = dm.GetRatingPlan("RP_CARRIER1_"+future.Format(rateDeckTimeLayout),
+		true, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("expected the scheduled rating plan to be removed, received %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err = rpt.WriteCSV(&buf, utils.CSVSep); err != nil {
+		t.Fatal(err)
+	}
+	if exp := "Prefix,Destination,Change,OldRate,NewRate,EffectiveDate\n4917,Germany Mobile,*new,0,0.08," +
+		rpt.Changes[0].EffectiveDate.Format(time.RFC3339) + "\n"; buf.String() != exp {
+		t.Errorf("expected %q, received %q", exp, buf.String())
+	}
+}
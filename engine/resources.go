@@ -44,12 +44,21 @@ type ResourceProfile struct {
 	AllocationMessage  string                    // message returned by the winning resource on allocation
 	Blocker            bool                      // blocker flag to stop processing on filters matched
 	Stored             bool
-	Weight             float64  // Weight to sort the resources
-	ThresholdIDs       []string // Thresholds to check after changing Limit
+	Weight             float64              // Weight to sort the resources
+	Weights            utils.DynamicWeights // filter based Weights, overriding Weight
+	ThresholdIDs       []string             // Thresholds to check after changing Limit
 
 	lkID string // holds the reference towards guardian lock key
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (rp *ResourceProfile) GetTenant() string {
+	if rp == nil {
+		return utils.EmptyString
+	}
+	return rp.Tenant
+}
+
 // Clone clones *ResourceProfile (lkID excluded)
 func (rp *ResourceProfile) Clone() *ResourceProfile {
 	if rp == nil {
@@ -64,6 +73,7 @@ func (rp *ResourceProfile) Clone() *ResourceProfile {
 		Blocker:           rp.Blocker,
 		Stored:            rp.Stored,
 		Weight:            rp.Weight,
+		Weights:           rp.Weights.Clone(),
 	}
 	if rp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(rp.FilterIDs))
@@ -631,10 +641,12 @@ func (rS *ResourceService) processThresholds(rs Resources, opts map[string]any)
 // matchingResourcesForEvent returns ordered list of matching resources which are active by the time of the call
 func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREvent,
 	evUUID string, usageTTL *time.Duration) (rs Resources, err error) {
+	dm := rS.dm.withAPIOpts(ev.APIOpts)
 	evNm := utils.MapStorage{
 		utils.MetaReq:  ev.Event,
 		utils.MetaOpts: ev.APIOpts,
 	}
+	weights := make(map[string]float64) // weights of the matching profiles for this event
 	var itemIDs []string
 	if x, ok := Cache.Get(utils.CacheEventResources, evUUID); ok { // The ResourceIDs were cached as utils.StringSet{"resID":bool}
 		if x == nil {
@@ -656,7 +668,7 @@ func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREv
 			rS.cgrcfg.ResourceSCfg().PrefixIndexedFields,
 			rS.cgrcfg.ResourceSCfg().SuffixIndexedFields,
 			rS.cgrcfg.ResourceSCfg().ExistsIndexedFields,
-			rS.dm, utils.CacheResourceFilterIndexes, tnt,
+			dm, utils.CacheResourceFilterIndexes, tnt,
 			rS.cgrcfg.ResourceSCfg().IndexedSelects,
 			rS.cgrcfg.ResourceSCfg().NestedFields,
 		)
@@ -677,7 +689,7 @@ func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREv
 			config.CgrConfig().GeneralCfg().LockingTimeout,
 			resourceProfileLockKey(tnt, id))
 		var rPrf *ResourceProfile
-		if rPrf, err = rS.dm.GetResourceProfile(tnt, id,
+		if rPrf, err = dm.GetResourceProfile(tnt, id,
 			true, true, utils.NonTransactional); err != nil {
 			guardian.Guardian.UnguardIDs(lkPrflID)
 			if err == utils.ErrNotFound {
@@ -702,11 +714,17 @@ func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREv
 			rPrf.unlock()
 			continue
 		}
+		if weights[rPrf.ID], err = weightForEvent(rPrf.Weights, rPrf.Weight,
+			rS.filterS, tnt, evNm); err != nil {
+			rPrf.unlock()
+			rs.unlock()
+			return nil, err
+		}
 		lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
 			config.CgrConfig().GeneralCfg().LockingTimeout,
 			resourceLockKey(rPrf.Tenant, rPrf.ID))
 		var r *Resource
-		if r, err = rS.dm.GetResource(rPrf.Tenant, rPrf.ID, true, true, ""); err != nil {
+		if r, err = dm.GetResource(rPrf.Tenant, rPrf.ID, true, true, ""); err != nil {
 			guardian.Guardian.UnguardIDs(lkID)
 			rPrf.unlock()
 			rs.unlock()
@@ -730,7 +748,7 @@ func (rS *ResourceService) matchingResourcesForEvent(tnt string, ev *utils.CGREv
 	if len(rs) == 0 {
 		return nil, utils.ErrNotFound
 	}
-	rs.Sort()
+	sort.Slice(rs, func(i, j int) bool { return weights[rs[i].ID] > weights[rs[j].ID] })
 	for i, r := range rs {
 		if r.rPrf.Blocker && i != len(rs)-1 { // blocker will stop processing and we are not at last index
 			Resources(rs[i+1:]).unlock()
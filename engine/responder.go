@@ -99,7 +99,16 @@ func (rs *Responder) GetCost(ctx *context.Context, arg *CallDescriptorWithAPIOpt
 	}
 	var r *CallCost
 	guardian.Guardian.Guard(func() (_ error) {
-		r, err = arg.GetCost()
+		if r, err = arg.GetCost(); err != nil || r == nil ||
+			rs.FilterS == nil || !config.CgrConfig().RalsCfg().Discounts {
+			return
+		}
+		// preview the discounts, without consuming them
+		acc, errAcc := dm.GetAccount(arg.GetAccountKey())
+		if errAcc != nil {
+			acc = &Account{ID: arg.GetAccountKey()}
+		}
+		err = applyDiscounts(arg.CallDescriptor, r, acc, rs.FilterS, false)
 		return
 	}, config.CgrConfig().GeneralCfg().LockingTimeout, utils.AccountPrefix+arg.GetAccountKey())
 	if err != nil {
@@ -0,0 +1,673 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/guardian"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// Revision is a previous version of a DataDB object
+type Revision struct {
+	Revision int       // increasing number of the revision, per object
+	Time     time.Time // when the version was replaced
+	LoadID   int64     // the load replacing the version, 0 until the load is known
+	Object   string    // the version as JSON, empty if the object did not exist
+}
+
+// ObjectRevisions are the previous versions of a DataDB object, oldest first
+type ObjectRevisions struct {
+	ObjectType string // the data_db item, ie: *attribute_profiles
+	ID         string // tenant:id or the key for the objects without tenant
+	Revisions  []*Revision
+}
+
+// Clone method for ObjectRevisions
+func (or *ObjectRevisions) Clone() *ObjectRevisions {
+	if or == nil {
+		return nil
+	}
+	cln := &ObjectRevisions{
+		ObjectType: or.ObjectType,
+		ID:         or.ID,
+		Revisions:  make([]*Revision, len(or.Revisions)),
+	}
+	for i, rev := range or.Revisions {
+		cln.Revisions[i] = &Revision{
+			Revision: rev.Revision,
+			Time:     rev.Time,
+			LoadID:   rev.LoadID,
+			Object:   rev.Object,
+		}
+	}
+	return cln
+}
+
+// CacheClone returns a clone of ObjectRevisions used by ltcache CacheCloner
+func (or *ObjectRevisions) CacheClone() any {
+	return or.Clone()
+}
+
+// revision returns the revision with the given number
+func (or *ObjectRevisions) revision(nr int) (*Revision, error) {
+	for _, rev := range or.Revisions {
+		if rev.Revision == nr {
+			return rev, nil
+		}
+	}
+	return nil, utils.ErrNotFound
+}
+
+// hasLoadID checks if one of the revisions was replaced by the load
+func (or *ObjectRevisions) hasLoadID(loadID int64) bool {
+	for _, rev := range or.Revisions {
+		if rev.LoadID == loadID {
+			return true
+		}
+	}
+	return false
+}
+
+// revisionedObject is an object type for which revisions can be kept
+type revisionedObject struct {
+	tenanted bool                                          // the ID of the object is tenant:id
+	newObj   func() any                                    // used to decode the revisions
+	get      func(dm *DataManager, id string) (any, error) // reads the current version from DataDB
+	compile  func(obj any) error                           // rebuilds the fields not kept in the JSON of the revisions, optional
+	set      func(dm *DataManager, obj any) error
+	remove   func(dm *DataManager, id string) error
+}
+
+// revisionedObjects are the object types supporting revisions, indexed by their data_db item
+var revisionedObjects map[string]*revisionedObject
+
+func init() { // populated here as the DataManager setters refer back to it
+	revisionedObjects = map[string]*revisionedObject{
+		utils.MetaFilters: {
+			tenanted: true,
+			newObj:   func() any { return new(Filter) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetFilterDrv(tntID.Tenant, tntID.ID)
+			},
+			compile: func(obj any) error { return obj.(*Filter).Compile() },
+			set:     func(dm *DataManager, obj any) error { return dm.SetFilter(obj.(*Filter), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveFilter(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaAttributeProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(AttributeProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetAttributeProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			compile: func(obj any) error { return obj.(*AttributeProfile).Compile() },
+			set:     func(dm *DataManager, obj any) error { return dm.SetAttributeProfile(obj.(*AttributeProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveAttributeProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaChargerProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(ChargerProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetChargerProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetChargerProfile(obj.(*ChargerProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveChargerProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaRouteProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(RouteProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetRouteProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			compile: func(obj any) error { return obj.(*RouteProfile).Compile() },
+			set:     func(dm *DataManager, obj any) error { return dm.SetRouteProfile(obj.(*RouteProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveRouteProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaResourceProfile: {
+			tenanted: true,
+			newObj:   func() any { return new(ResourceProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetResourceProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetResourceProfile(obj.(*ResourceProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveResourceProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaIPProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(IPProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetIPProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetIPProfile(obj.(*IPProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveIPProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaStatQueueProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(StatQueueProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetStatQueueProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetStatQueueProfile(obj.(*StatQueueProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveStatQueueProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaThresholdProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(ThresholdProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetThresholdProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetThresholdProfile(obj.(*ThresholdProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveThresholdProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaTrendProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(TrendProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetTrendProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetTrendProfile(obj.(*TrendProfile)) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveTrendProfile(tntID.Tenant, tntID.ID)
+			},
+		},
+		utils.MetaRankingProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(RankingProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetRankingProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetRankingProfile(obj.(*RankingProfile)) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveRankingProfile(tntID.Tenant, tntID.ID)
+			},
+		},
+		utils.MetaDispatcherProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(DispatcherProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetDispatcherProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetDispatcherProfile(obj.(*DispatcherProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveDispatcherProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaDispatcherHosts: {
+			tenanted: true,
+			newObj:   func() any { return new(DispatcherHost) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetDispatcherHostDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetDispatcherHost(obj.(*DispatcherHost)) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveDispatcherHost(tntID.Tenant, tntID.ID)
+			},
+		},
+		utils.MetaLookupTables: {
+			tenanted: true,
+			newObj:   func() any { return new(LookupTable) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetLookupTableDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetLookupTable(obj.(*LookupTable)) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveLookupTable(tntID.Tenant, tntID.ID)
+			},
+		},
+		utils.MetaDiscountProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(DiscountProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetDiscountProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetDiscountProfile(obj.(*DiscountProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveDiscountProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaFraudProfiles: {
+			tenanted: true,
+			newObj:   func() any { return new(FraudProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				tntID := utils.NewTenantID(id)
+				return dm.DataDB().GetFraudProfileDrv(tntID.Tenant, tntID.ID)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetFraudProfile(obj.(*FraudProfile), true) },
+			remove: func(dm *DataManager, id string) error {
+				tntID := utils.NewTenantID(id)
+				return dm.RemoveFraudProfile(tntID.Tenant, tntID.ID, true)
+			},
+		},
+		utils.MetaRatingPlans: {
+			newObj: func() any { return new(RatingPlan) },
+			get: func(dm *DataManager, id string) (any, error) {
+				return dm.DataDB().GetRatingPlanDrv(id)
+			},
+			compile: func(obj any) error {
+				for _, rit := range obj.(*RatingPlan).Timings {
+					rit.CronString() // cached by the timing
+				}
+				return nil
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetRatingPlan(obj.(*RatingPlan)) },
+			remove: func(dm *DataManager, id string) error {
+				return dm.RemoveRatingPlan(id, utils.NonTransactional)
+			},
+		},
+		utils.MetaRatingProfiles: {
+			newObj: func() any { return new(RatingProfile) },
+			get: func(dm *DataManager, id string) (any, error) {
+				return dm.DataDB().GetRatingProfileDrv(id)
+			},
+			set: func(dm *DataManager, obj any) error { return dm.SetRatingProfile(obj.(*RatingProfile)) },
+			remove: func(dm *DataManager, id string) error {
+				return dm.RemoveRatingProfile(id)
+			},
+		},
+	}
+}
+
+// RevisionObjectID returns the ID under which the revisions of the object are kept
+func RevisionObjectID(objType, tnt, id string) (string, error) {
+	rvObj, err := getRevisionedObject(objType)
+	if err != nil {
+		return utils.EmptyString, err
+	}
+	if rvObj.tenanted {
+		return utils.ConcatenatedKey(tnt, id), nil
+	}
+	return id, nil
+}
+
+func getRevisionedObject(objType string) (*revisionedObject, error) {
+	rvObj, has := revisionedObjects[objType]
+	if !has {
+		return nil, fmt.Errorf("unsupported object type: <%s>", objType)
+	}
+	return rvObj, nil
+}
+
+// dataChange is a change of a DataDB object, tracked for its revisions and CDC
+type dataChange struct {
+	objType  string
+	id       string
+	before   string // the object as JSON before the change, empty if missing
+	revision bool   // keep the previous version
+	cdc      bool   // export the change
+}
+
+// trackedChanges returns if the revisions and the CDC are enabled for the object type
+func (dm *DataManager) trackedChanges(objType string) (revision, cdc bool) {
+	itm, has := config.CgrConfig().DataDbCfg().Items[objType]
+	if !has {
+		return
+	}
+	_, revisioned := revisionedObjects[objType]
+	return revisioned && itm.Revisions > 0,
+		itm.CDC && dm.cdc != nil && utils.CDCItems.Has(objType)
+}
+
+// tracksChanges returns true if the revisions or the CDC are enabled for the object type,
+// used to read the version before the change only when needed
+func (dm *DataManager) tracksChanges(objType string) bool {
+	revision, cdc := dm.trackedChanges(objType)
+	return revision || cdc
+}
+
+// changedObject reads from DataDB the version of the object before the change, for
+// the setters not reading it already, only if its changes are tracked
+func (dm *DataManager) changedObject(objType, id string) (obj any, err error) {
+	if !dm.tracksChanges(objType) {
+		return
+	}
+	if obj, err = revisionedObjects[objType].get(dm, id); err == utils.ErrNotFound ||
+		err == utils.ErrDSPHostNotFound {
+		return nil, nil
+	}
+	return
+}
+
+// newChange starts tracking the change of the object, before being its current
+// version as already read by the caller, nil if missing. The cdcLiveObjects
+// ignore it as they are compared with their last exported version instead.
+// Returns nil if neither the revisions nor the CDC are enabled for the object type
+func (dm *DataManager) newChange(objType, id string, before any) *dataChange {
+	revision, cdc := dm.trackedChanges(objType)
+	if !revision && !cdc {
+		return nil
+	}
+	chg := &dataChange{
+		objType:  objType,
+		id:       id,
+		revision: revision,
+		cdc:      cdc,
+	}
+	if before != nil {
+		if v := reflect.ValueOf(before); v.Kind() != reflect.Pointer || !v.IsNil() {
+			chg.before = utils.ToJSON(before)
+		}
+	}
+	return chg
+}
+
+// storeChange keeps the revision and exports the change created by newChange
+// once the object was changed, after being nil for the removed objects
+func (dm *DataManager) storeChange(chg *dataChange, after any) {
+	if chg == nil {
+		return
+	}
+	if chg.revision {
+		dm.storeRevision(&ObjectRevisions{
+			ObjectType: chg.objType,
+			ID:         chg.id,
+			Revisions: []*Revision{{
+				Time:   time.Now(),
+				Object: chg.before,
+			}},
+		})
+	}
+	if chg.cdc {
+		var afterJSON string
+		if after != nil {
+			afterJSON = utils.ToJSON(after)
+		}
+		dm.cdc.publish(chg.objType, chg.id, chg.before, afterJSON)
+	}
+}
+
+// storeRevision appends the revision of the replaced object,
+// keeping the configured number of revisions. Its LoadID is set
+// by the SetLoadIDs following the change
+func (dm *DataManager) storeRevision(newRvs *ObjectRevisions) {
+	if newRvs == nil {
+		return
+	}
+	if err := dm.setRevision(newRvs); err != nil {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed storing revision of <%s> with ID <%s>: %s",
+			utils.DataManager, newRvs.ObjectType, newRvs.ID, err))
+		return
+	}
+	root := dm.rootDM() // the views share the pending revisions
+	root.rvsMux.Lock()
+	root.pendingRevisions().add(newRvs.ObjectType, newRvs.ID)
+	root.rvsMux.Unlock()
+}
+
+// pendingRevisions returns the IDs of the objects with revisions waiting for the LoadID.
+// They are read once from DataDB, so the revisions stored before a restart still get
+// the LoadID of the next load. Not thread safe, called under rvsMux
+func (dm *DataManager) pendingRevisions() pendingRevisions {
+	if dm.rvsPending != nil {
+		return dm.rvsPending
+	}
+	dm.rvsPending = make(pendingRevisions)
+	objRvs, err := dm.db().GetLoadRevisionsDrv(0)
+	if err != nil && err != utils.ErrNotFound {
+		utils.Logger.Warning(fmt.Sprintf("<%s> failed reading the revisions waiting for the load ID: %s",
+			utils.DataManager, err))
+	}
+	for _, rvs := range objRvs {
+		dm.rvsPending.add(rvs.ObjectType, rvs.ID)
+	}
+	return dm.rvsPending
+}
+
+// pendingRevisions are the IDs of the objects with revisions waiting for the LoadID, per type
+type pendingRevisions map[string]utils.StringSet
+
+func (pr pendingRevisions) add(objType, id string) {
+	if _, has := pr[objType]; !has {
+		pr[objType] = make(utils.StringSet)
+	}
+	pr[objType].Add(id)
+}
+
+func (dm *DataManager) setRevision(newRvs *ObjectRevisions) error {
+	return guardian.Guardian.Guard(func() (err error) {
+		var rvs *ObjectRevisions
+		if rvs, err = dm.db().GetRevisionsDrv(newRvs.ObjectType, newRvs.ID); err != nil {
+			if err != utils.ErrNotFound {
+				return
+			}
+			rvs = &ObjectRevisions{ObjectType: newRvs.ObjectType, ID: newRvs.ID}
+		}
+		rev := newRvs.Revisions[0]
+		rev.Revision = 1
+		if len(rvs.Revisions) != 0 {
+			rev.Revision = rvs.Revisions[len(rvs.Revisions)-1].Revision + 1
+		}
+		rvs.Revisions = append(rvs.Revisions, rev)
+		if maxRvs := config.CgrConfig().DataDbCfg().Items[newRvs.ObjectType].Revisions; len(rvs.Revisions) > maxRvs {
+			rvs.Revisions = slices.Clone(rvs.Revisions[len(rvs.Revisions)-maxRvs:])
+		}
+		return dm.db().SetRevisionsDrv(rvs)
+	}, config.CgrConfig().GeneralCfg().LockingTimeout,
+		utils.RevisionsPrefix+utils.ConcatenatedKey(newRvs.ObjectType, newRvs.ID))
+}
+
+// tagRevisions sets the LoadID of the revisions waiting for it
+func (dm *DataManager) tagRevisions(loadIDs map[string]int64) {
+	root := dm.rootDM() // the views share the pending revisions
+	root.rvsMux.Lock()
+	pending := make(pendingRevisions)
+	for objType, loadID := range loadIDs {
+		if ids, has := root.pendingRevisions()[objType]; has && loadID != 0 {
+			pending[objType] = ids
+			delete(root.rvsPending, objType)
+		}
+	}
+	root.rvsMux.Unlock()
+	for objType, ids := range pending {
+		for id := range ids {
+			if err := guardian.Guardian.Guard(func() (err error) {
+				var rvs *ObjectRevisions
+				if rvs, err = dm.db().GetRevisionsDrv(objType, id); err != nil {
+					return
+				}
+				for _, rev := range rvs.Revisions {
+					if rev.LoadID == 0 {
+						rev.LoadID = loadIDs[objType]
+					}
+				}
+				return dm.db().SetRevisionsDrv(rvs)
+			}, config.CgrConfig().GeneralCfg().LockingTimeout,
+				utils.RevisionsPrefix+utils.ConcatenatedKey(objType, id)); err != nil {
+				utils.Logger.Warning(fmt.Sprintf("<%s> failed setting the load ID of <%s> revisions with ID <%s>: %s",
+					utils.DataManager, objType, id, err))
+			}
+		}
+	}
+}
+
+// GetRevisions returns the revisions kept for the object
+func (dm *DataManager) GetRevisions(objType, id string) (rvs *ObjectRevisions, err error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	if _, err = getRevisionedObject(objType); err != nil {
+		return
+	}
+	return dm.db().GetRevisionsDrv(objType, id)
+}
+
+// GetRevisionDiff returns the paths, prefixed by the object type, of the fields
+// changed between two versions of the object, revision 0 being the current version
+func (dm *DataManager) GetRevisionDiff(objType, id string, revNr, compareTo int) (diffs []string, err error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	if _, err = getRevisionedObject(objType); err != nil {
+		return
+	}
+	var from, to any
+	if from, err = dm.revisionObject(objType, id, revNr); err != nil {
+		return
+	}
+	if to, err = dm.revisionObject(objType, id, compareTo); err != nil {
+		return
+	}
+	return utils.DiffValues(objType, from, to, nil), nil
+}
+
+// revisionObject returns the generic representation of a version of the object
+func (dm *DataManager) revisionObject(objType, id string, revNr int) (obj any, err error) {
+	var objJSON string
+	if revNr == 0 {
+		var crnt any
+		if crnt, err = revisionedObjects[objType].get(dm, id); err != nil {
+			if err != utils.ErrNotFound {
+				return
+			}
+			err = nil
+		} else {
+			objJSON = utils.ToJSON(crnt)
+		}
+	} else {
+		var rvs *ObjectRevisions
+		if rvs, err = dm.db().GetRevisionsDrv(objType, id); err != nil {
+			return
+		}
+		var rev *Revision
+		if rev, err = rvs.revision(revNr); err != nil {
+			return
+		}
+		objJSON = rev.Object
+	}
+	if objJSON == utils.EmptyString {
+		return
+	}
+	err = json.Unmarshal([]byte(objJSON), &obj)
+	return
+}
+
+// RollbackRevision restores the version of the object from the given revision,
+// removing the object if it did not exist at that time
+func (dm *DataManager) RollbackRevision(objType, id string, revNr int) (err error) {
+	var rvs *ObjectRevisions
+	if rvs, err = dm.GetRevisions(objType, id); err != nil {
+		return
+	}
+	var rev *Revision
+	if rev, err = rvs.revision(revNr); err != nil {
+		return
+	}
+	return dm.restoreRevision(objType, id, rev)
+}
+
+// RollbackLoad restores the objects changed by the load to their versions
+// before it, returning the IDs of the restored objects by type
+func (dm *DataManager) RollbackLoad(loadID int64) (restored map[string][]string, err error) {
+	if dm == nil {
+		return nil, utils.ErrNoDatabaseConn
+	}
+	var objRvs []*ObjectRevisions
+	if objRvs, err = dm.db().GetLoadRevisionsDrv(loadID); err != nil {
+		return
+	}
+	restored = make(map[string][]string)
+	for _, rvs := range objRvs {
+		if _, has := revisionedObjects[rvs.ObjectType]; !has {
+			continue
+		}
+		for _, rev := range rvs.Revisions { // the oldest one is the version before the load
+			if rev.LoadID != loadID {
+				continue
+			}
+			if err = dm.restoreRevision(rvs.ObjectType, rvs.ID, rev); err != nil {
+				return nil, fmt.Errorf("restoring <%s> with ID <%s>: %w", rvs.ObjectType, rvs.ID, err)
+			}
+			restored[rvs.ObjectType] = append(restored[rvs.ObjectType], rvs.ID)
+			break
+		}
+	}
+	if len(restored) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
+func (dm *DataManager) restoreRevision(objType, id string, rev *Revision) (err error) {
+	rvObj := revisionedObjects[objType]
+	if rev.Object == utils.EmptyString {
+		if err = rvObj.remove(dm, id); err == utils.ErrNotFound {
+			err = nil // already missing
+		}
+		return
+	}
+	obj := rvObj.newObj()
+	if err = json.Unmarshal([]byte(rev.Object), obj); err != nil {
+		return
+	}
+	if rvObj.compile != nil {
+		if err = rvObj.compile(obj); err != nil {
+			return
+		}
+	}
+	return rvObj.set(dm, obj)
+}
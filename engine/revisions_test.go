@@ -0,0 +1,187 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestRevisionsObjectTypes(t *testing.T) {
+	for objType := range revisionedObjects {
+		if !utils.RevisionedItems.Has(objType) {
+			t.Errorf("object type <%s> not in RevisionedItems", objType)
+		}
+	}
+	if len(revisionedObjects) != len(utils.RevisionedItems) {
+		t.Errorf("expected %d object types, received %d", len(utils.RevisionedItems), len(revisionedObjects))
+	}
+	if _, err := RevisionObjectID("*accounts", "cgrates.org", "1001"); err == nil {
+		t.Error("expected error for unsupported object type")
+	}
+	if id, err := RevisionObjectID(utils.MetaRatingPlans, "cgrates.org", "RP_1"); err != nil {
+		t.Error(err)
+	} else if id != "RP_1" {
+		t.Errorf("expected RP_1, received %s", id)
+	}
+}
+
+func TestRevisionsRollback(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DataDbCfg().Items[utils.MetaLookupTables].Revisions = 2
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	dmRv := NewDataManager(data, cfg.CacheCfg(), nil)
+	lt := &LookupTable{
+		Tenant:  "cgrates.org",
+		ID:      "TRUNK_CARRIERS",
+		Entries: map[string]string{"10.0.0.1": "CARRIER1"},
+	}
+	id := lt.TenantID()
+	// first load creates the table
+	if err := dmRv.SetLookupTable(lt.Clone()); err != nil {
+		t.Fatal(err)
+	}
+	if err := dmRv.SetLoadIDs(map[string]int64{utils.CacheLookupTables: 1}); err != nil {
+		t.Fatal(err)
+	}
+	// second load changes it twice
+	lt.Entries["10.0.0.2"] = "CARRIER2"
+	if err := dmRv.SetLookupTable(lt.Clone()); err != nil {
+		t.Fatal(err)
+	}
+	lt.Entries["10.0.0.1"] = "CARRIER3"
+	if err := dmRv.SetLookupTable(lt.Clone()); err != nil {
+		t.Fatal(err)
+	}
+	if err := dmRv.SetLoadIDs(map[string]int64{utils.CacheLookupTables: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	rvs, err := dmRv.GetRevisions(utils.MetaLookupTables, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only the last 2 are kept, the first one recording the missing table
+	if len(rvs.Revisions) != 2 ||
+		rvs.Revisions[0].Revision != 2 || rvs.Revisions[0].LoadID != 2 ||
+		rvs.Revisions[1].Revision != 3 || rvs.Revisions[1].LoadID != 2 {
+		t.Fatalf("unexpected revisions: %s", utils.ToJSON(rvs))
+	}
+
+	if diffs, err := dmRv.GetRevisionDiff(utils.MetaLookupTables, id, 2, 0); err != nil {
+		t.Error(err)
+	} else if exp := []string{"*lookup_tables.Entries.10.0.0.1", "*lookup_tables.Entries.10.0.0.2"}; !reflect.DeepEqual(exp, diffs) {
+		t.Errorf("expected %v, received %v", exp, diffs)
+	}
+
+	if err := dmRv.RollbackRevision(utils.MetaLookupTables, id, 3); err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := dmRv.GetLookupTable(lt.Tenant, lt.ID, false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if exp := map[string]string{"10.0.0.1": "CARRIER1", "10.0.0.2": "CARRIER2"}; !reflect.DeepEqual(exp, rcv.Entries) {
+		t.Errorf("expected %v, received %v", exp, rcv.Entries)
+	}
+	if err := dmRv.SetLoadIDs(map[string]int64{utils.CacheLookupTables: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the rollback is reverted by rolling back its own load
+	if restored, err := dmRv.RollbackLoad(3); err != nil {
+		t.Fatal(err)
+	} else if exp := map[string][]string{utils.MetaLookupTables: {id}}; !reflect.DeepEqual(exp, restored) {
+		t.Errorf("expected %v, received %v", exp, restored)
+	}
+	if rcv, err := dmRv.GetLookupTable(lt.Tenant, lt.ID, false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(lt.Entries, rcv.Entries) {
+		t.Errorf("expected %v, received %v", lt.Entries, rcv.Entries)
+	}
+	if _, err := dmRv.RollbackLoad(10); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
+
+func TestRevisionsCompile(t *testing.T) {
+	val, err := config.NewRSRParsers("~*req.Account", utils.InfieldSep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ap := &AttributeProfile{
+		Tenant: "cgrates.org",
+		ID:     "ATTR_1",
+		Attributes: []*Attribute{{
+			Path:  utils.MetaReq + utils.NestingSep + utils.Subject,
+			Type:  utils.MetaVariable,
+			Value: val,
+		}},
+	}
+	rvObj := revisionedObjects[utils.MetaAttributeProfiles]
+	obj := rvObj.newObj()
+	if err = json.Unmarshal([]byte(utils.ToJSON(ap)), obj); err != nil {
+		t.Fatal(err)
+	}
+	if err = rvObj.compile(obj); err != nil {
+		t.Fatal(err)
+	}
+	dp := utils.MapStorage{utils.MetaReq: utils.MapStorage{utils.AccountField: "1001"}}
+	if rcv, err := obj.(*AttributeProfile).Attributes[0].Value.ParseDataProvider(dp); err != nil {
+		t.Error(err)
+	} else if rcv != "1001" {
+		t.Errorf("expected 1001, received %q", rcv)
+	}
+}
+
+func TestRevisionsPendingAfterRestart(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.DataDbCfg().Items[utils.MetaLookupTables].Revisions = 2
+	config.SetCgrConfig(cfg)
+	defer config.SetCgrConfig(config.NewDefaultCGRConfig())
+	data, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Fatal(dErr)
+	}
+	lt := &LookupTable{
+		Tenant:  "cgrates.org",
+		ID:      "TRUNK_CARRIERS",
+		Entries: map[string]string{"10.0.0.1": "CARRIER1"},
+	}
+	if err := NewDataManager(data, cfg.CacheCfg(), nil).SetLookupTable(lt); err != nil {
+		t.Fatal(err)
+	}
+	// the engine restarts before the LoadID is set
+	dmRv := NewDataManager(data, cfg.CacheCfg(), nil)
+	if err := dmRv.SetLoadIDs(map[string]int64{utils.CacheLookupTables: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if rvs, err := dmRv.GetRevisions(utils.MetaLookupTables, lt.TenantID()); err != nil {
+		t.Fatal(err)
+	} else if len(rvs.Revisions) != 1 || rvs.Revisions[0].LoadID != 1 {
+		t.Errorf("unexpected revisions: %s", utils.ToJSON(rvs))
+	}
+}
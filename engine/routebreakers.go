@@ -0,0 +1,252 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// RouteBreaker is the circuit breaker of a route, opened after a number of
+// consecutive failed calls over the route so the route is skipped for a
+// cooldown before letting probe traffic through
+type RouteBreaker struct {
+	Tenant      string
+	RouteID     string
+	State       string // <*closed|*open|*half_open>
+	Failures    int    // consecutive failures reported for the route
+	LastFailure time.Time
+	OpenedAt    time.Time
+	Probes      int // route queries the route was offered to while half-open
+	ProbedAt    time.Time
+}
+
+// TenantID returns the concatenated key between tenant and route ID
+func (brk *RouteBreaker) TenantID() string {
+	return utils.ConcatenatedKey(brk.Tenant, brk.RouteID)
+}
+
+// Clone returns a copy of the RouteBreaker
+func (brk *RouteBreaker) Clone() *RouteBreaker {
+	cln := *brk
+	return &cln
+}
+
+// available checks if the route can be offered to the query at the given time
+// without using any of the half-open probes
+func (brk *RouteBreaker) available(now time.Time, brkCfg *config.RouteBreakerCfg) bool {
+	switch brk.State {
+	case utils.MetaOpen:
+		return now.Sub(brk.OpenedAt) >= brkCfg.Cooldown
+	case utils.MetaHalfOpen:
+		return brk.Probes < brkCfg.HalfOpenProbes ||
+			now.Sub(brk.ProbedAt) >= brkCfg.Cooldown // no outcome for the previous probes
+	}
+	return true
+}
+
+// allow decides if the route can be offered to the query at the given time,
+// half-opening the breaker once the cooldown has passed and using one probe
+func (brk *RouteBreaker) allow(now time.Time, brkCfg *config.RouteBreakerCfg) bool {
+	if !brk.available(now, brkCfg) {
+		return false
+	}
+	switch brk.State {
+	case utils.MetaOpen:
+		brk.State = utils.MetaHalfOpen
+		brk.Probes = 0
+		fallthrough
+	case utils.MetaHalfOpen:
+		if brk.Probes >= brkCfg.HalfOpenProbes {
+			brk.Probes = 0 // let new probes through
+		}
+		brk.Probes++
+		brk.ProbedAt = now
+	}
+	return true
+}
+
+// record updates the breaker with the outcome of a call over the route
+func (brk *RouteBreaker) record(failed bool, now time.Time, brkCfg *config.RouteBreakerCfg) {
+	if !failed {
+		if brk.State == utils.MetaOpen { // outcome of a call routed before opening
+			return
+		}
+		brk.State = utils.MetaClosed
+		brk.Failures = 0
+		brk.Probes = 0
+		return
+	}
+	brk.Failures++
+	brk.LastFailure = now
+	if brk.State == utils.MetaHalfOpen ||
+		(brk.State == utils.MetaClosed && brk.Failures >= brkCfg.FailureThreshold) {
+		brk.State = utils.MetaOpen
+		brk.OpenedAt = now
+		brk.Probes = 0
+	}
+}
+
+// breakerAvailable checks the breaker of the route while selecting the routes for a query
+func (rpS *RouteService) breakerAvailable(tnt, rID string, now time.Time) bool {
+	brkCfg := rpS.cgrcfg.RouteSCfg().Breaker
+	if brkCfg == nil || brkCfg.FailureThreshold == 0 {
+		return true
+	}
+	rpS.brkMux.Lock()
+	defer rpS.brkMux.Unlock()
+	brk, has := rpS.breakers[utils.ConcatenatedKey(tnt, rID)]
+	return !has || brk.available(now, brkCfg)
+}
+
+// breakerAllowRoutes uses the probes of the half-open breakers for the routes
+// returned to a query, dropping the ones whose probes were used in the meantime
+func (rpS *RouteService) breakerAllowRoutes(tnt string, sRoutes *SortedRoutes, now time.Time) {
+	brkCfg := rpS.cgrcfg.RouteSCfg().Breaker
+	if brkCfg == nil || brkCfg.FailureThreshold == 0 {
+		return
+	}
+	rpS.brkMux.Lock()
+	defer rpS.brkMux.Unlock()
+	sRoutes.Routes = slices.DeleteFunc(sRoutes.Routes, func(sr *SortedRoute) bool {
+		brk, has := rpS.breakers[utils.ConcatenatedKey(tnt, sr.RouteID)]
+		return has && !brk.allow(now, brkCfg)
+	})
+}
+
+// V1ProcessEvent records the outcome of a call over the route from the RouteID
+// field of the event, the call failing if the event matches the failure_filters
+func (rpS *RouteService) V1ProcessEvent(ctx *context.Context, args *utils.CGREvent, reply *string) (err error) {
+	if args == nil {
+		return utils.NewErrMandatoryIeMissing(utils.CGREventString)
+	}
+	if args.Event == nil {
+		return utils.NewErrMandatoryIeMissing(utils.Event)
+	}
+	brkCfg := rpS.cgrcfg.RouteSCfg().Breaker
+	if brkCfg == nil || brkCfg.FailureThreshold == 0 {
+		return utils.ErrNotFound // breakers disabled
+	}
+	rID := MapEvent(args.Event).GetStringIgnoreErrors(utils.RouteID)
+	if rID == utils.EmptyString {
+		return utils.ErrNotFound
+	}
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = rpS.cgrcfg.GeneralCfg().DefaultTenant
+	}
+	var failed bool
+	if failed, err = rpS.filterS.Pass(tnt, brkCfg.FailureFilters,
+		utils.MapStorage{
+			utils.MetaReq:  args.Event,
+			utils.MetaOpts: args.APIOpts,
+		}); err != nil {
+		return utils.NewErrServerError(err)
+	}
+	now := time.Now()
+	brkID := utils.ConcatenatedKey(tnt, rID)
+	rpS.brkMux.Lock()
+	brk, has := rpS.breakers[brkID]
+	if !has {
+		if !failed { // nothing to track for healthy routes
+			rpS.brkMux.Unlock()
+			*reply = utils.OK
+			return
+		}
+		if rpS.breakers == nil {
+			rpS.breakers = make(map[string]*RouteBreaker)
+		}
+		brk = &RouteBreaker{Tenant: tnt, RouteID: rID, State: utils.MetaClosed}
+		rpS.breakers[brkID] = brk
+	}
+	prevState := brk.State
+	brk.record(failed, now, brkCfg)
+	state, failures := brk.State, brk.Failures
+	rpS.brkMux.Unlock()
+	if state != prevState {
+		switch state {
+		case utils.MetaOpen:
+			utils.Logger.Warning(
+				fmt.Sprintf("<%s> breaker of route <%s> opened after %d consecutive failures",
+					utils.RouteS, brkID, failures))
+		case utils.MetaClosed:
+			utils.Logger.Info(
+				fmt.Sprintf("<%s> breaker of route <%s> closed", utils.RouteS, brkID))
+		}
+	}
+	*reply = utils.OK
+	return
+}
+
+// V1GetRouteBreakers returns the breakers tracked for the routes of a tenant
+func (rpS *RouteService) V1GetRouteBreakers(ctx *context.Context, args *utils.ArgsRouteBreakers, reply *[]*RouteBreaker) (err error) {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = rpS.cgrcfg.GeneralCfg().DefaultTenant
+	}
+	var cooldown time.Duration
+	if brkCfg := rpS.cgrcfg.RouteSCfg().Breaker; brkCfg != nil {
+		cooldown = brkCfg.Cooldown
+	}
+	now := time.Now()
+	var brks []*RouteBreaker
+	rpS.brkMux.Lock()
+	for _, brk := range rpS.breakers {
+		if brk.Tenant != tnt ||
+			(len(args.RouteIDs) != 0 && !slices.Contains(args.RouteIDs, brk.RouteID)) {
+			continue
+		}
+		cln := brk.Clone()
+		if cln.State == utils.MetaOpen && now.Sub(cln.OpenedAt) >= cooldown {
+			cln.State = utils.MetaHalfOpen // half-opened with the next route query
+		}
+		brks = append(brks, cln)
+	}
+	rpS.brkMux.Unlock()
+	if len(brks) == 0 {
+		return utils.ErrNotFound
+	}
+	sort.Slice(brks, func(i, j int) bool { return brks[i].RouteID < brks[j].RouteID })
+	*reply = brks
+	return
+}
+
+// V1ResetRouteBreakers closes the breakers of the routes, forgetting their failures
+func (rpS *RouteService) V1ResetRouteBreakers(ctx *context.Context, args *utils.ArgsRouteBreakers, reply *string) (err error) {
+	tnt := args.Tenant
+	if tnt == utils.EmptyString {
+		tnt = rpS.cgrcfg.GeneralCfg().DefaultTenant
+	}
+	rpS.brkMux.Lock()
+	for brkID, brk := range rpS.breakers {
+		if brk.Tenant == tnt &&
+			(len(args.RouteIDs) == 0 || slices.Contains(args.RouteIDs, brk.RouteID)) {
+			delete(rpS.breakers, brkID)
+		}
+	}
+	rpS.brkMux.Unlock()
+	*reply = utils.OK
+	return
+}
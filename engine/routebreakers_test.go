@@ -0,0 +1,210 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestRouteBreakerAllowRecord(t *testing.T) {
+	brkCfg := &config.RouteBreakerCfg{
+		FailureThreshold: 2,
+		Cooldown:         time.Minute,
+		HalfOpenProbes:   1,
+	}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	brk := &RouteBreaker{Tenant: "cgrates.org", RouteID: "CARRIER1", State: utils.MetaClosed}
+	brk.record(true, now, brkCfg)
+	if brk.State != utils.MetaClosed || !brk.allow(now, brkCfg) {
+		t.Fatalf("expected closed breaker, received: %s", utils.ToJSON(brk))
+	}
+	brk.record(true, now, brkCfg)
+	if brk.State != utils.MetaOpen || brk.allow(now.Add(time.Second), brkCfg) {
+		t.Fatalf("expected open breaker, received: %s", utils.ToJSON(brk))
+	}
+	brk.record(false, now.Add(time.Second), brkCfg) // routed before opening
+	if brk.State != utils.MetaOpen {
+		t.Errorf("expected open breaker, received: %s", brk.State)
+	}
+	// half-opened after cooldown, letting one probe through
+	if !brk.allow(now.Add(time.Minute), brkCfg) {
+		t.Error("expected probe to be allowed")
+	} else if brk.State != utils.MetaHalfOpen {
+		t.Errorf("expected half-open breaker, received: %s", brk.State)
+	}
+	if brk.allow(now.Add(time.Minute+time.Second), brkCfg) {
+		t.Error("expected probes to be exhausted")
+	}
+	brk.record(true, now.Add(2*time.Minute), brkCfg)
+	if brk.State != utils.MetaOpen || brk.Failures != 3 {
+		t.Fatalf("expected reopened breaker, received: %s", utils.ToJSON(brk))
+	}
+	// probe without outcome is retried after another cooldown
+	if !brk.allow(now.Add(3*time.Minute), brkCfg) {
+		t.Error("expected probe to be allowed")
+	}
+	if !brk.allow(now.Add(4*time.Minute), brkCfg) {
+		t.Error("expected probe to be allowed again")
+	}
+	brk.record(false, now.Add(4*time.Minute), brkCfg)
+	if brk.State != utils.MetaClosed || brk.Failures != 0 {
+		t.Errorf("expected closed breaker, received: %s", utils.ToJSON(brk))
+	}
+}
+
+func TestRouteBreakersProcessEvent(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RouteSCfg().Breaker.FailureThreshold = 2
+	data, _ := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	rpS := NewRouteService(dm, &FilterS{dm: dm, cfg: cfg}, cfg, nil)
+
+	var reply string
+	ev := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "ev1",
+		Event: map[string]any{
+			utils.RouteID:         "CARRIER1",
+			utils.DisconnectCause: "503",
+		},
+	}
+	if err := rpS.V1ProcessEvent(nil, &utils.CGREvent{
+		Event: map[string]any{utils.DisconnectCause: "503"},
+	}, &reply); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received: %v", utils.ErrNotFound, err)
+	}
+	for range 2 {
+		if err := rpS.V1ProcessEvent(nil, ev, &reply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rpS.breakerAvailable("cgrates.org", "CARRIER1", time.Now()) {
+		t.Error("expected CARRIER1 to be skipped")
+	}
+	if !rpS.breakerAvailable("cgrates.org", "CARRIER2", time.Now()) {
+		t.Error("expected CARRIER2 to be allowed")
+	}
+	var brks []*RouteBreaker
+	if err := rpS.V1GetRouteBreakers(nil, &utils.ArgsRouteBreakers{}, &brks); err != nil {
+		t.Fatal(err)
+	} else if len(brks) != 1 || brks[0].RouteID != "CARRIER1" ||
+		brks[0].State != utils.MetaOpen || brks[0].Failures != 2 {
+		t.Errorf("unexpected breakers: %s", utils.ToJSON(brks))
+	}
+	if err := rpS.V1ResetRouteBreakers(nil, &utils.ArgsRouteBreakers{
+		RouteIDs: []string{"CARRIER1"}}, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if err := rpS.V1GetRouteBreakers(nil, &utils.ArgsRouteBreakers{}, &brks); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received: %v", utils.ErrNotFound, err)
+	}
+	// successful calls are not tracked
+	ev.Event[utils.DisconnectCause] = "NORMAL_CLEARING"
+	if err := rpS.V1ProcessEvent(nil, ev, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rpS.breakers, map[string]*RouteBreaker{}) {
+		t.Errorf("unexpected breakers: %s", utils.ToJSON(rpS.breakers))
+	}
+}
+
+func TestRouteBreakersProbeReturnedRoutes(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RouteSCfg().Breaker.FailureThreshold = 1
+	cfg.RouteSCfg().Breaker.Cooldown = time.Minute
+	cfg.RouteSCfg().Breaker.HalfOpenProbes = 1
+	data, _ := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	dm := NewDataManager(data, cfg.CacheCfg(), nil)
+	rpS := NewRouteService(dm, &FilterS{dm: dm, cfg: cfg}, cfg, nil)
+	if err := dm.SetRouteProfile(&RouteProfile{
+		Tenant:  "cgrates.org",
+		ID:      "ROUTE_PRF",
+		Sorting: utils.MetaWeight,
+		Routes: []*Route{
+			{ID: "CARRIER1", Weight: 20},
+			{ID: "CARRIER2", Weight: 10},
+			{ID: "CARRIER2", Weight: 15}, // duplicates use a single probe
+		},
+	}, true); err != nil {
+		t.Fatal(err)
+	}
+	openedAt := time.Now().Add(-2 * time.Minute)
+	for _, rID := range []string{"CARRIER1", "CARRIER2"} {
+		rpS.breakers[utils.ConcatenatedKey("cgrates.org", rID)] = &RouteBreaker{
+			Tenant:   "cgrates.org",
+			RouteID:  rID,
+			State:    utils.MetaOpen,
+			Failures: 1,
+			OpenedAt: openedAt,
+		}
+	}
+	routeIDs := func(opts map[string]any) (ids []string) {
+		t.Helper()
+		srs, err := rpS.sortedRoutesForEvent("cgrates.org", &utils.CGREvent{
+			Tenant:  "cgrates.org",
+			ID:      "ev1",
+			Event:   map[string]any{utils.AccountField: "1001"},
+			APIOpts: opts,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, sr := range srs {
+			for _, r := range sr.Routes {
+				ids = append(ids, r.RouteID)
+			}
+		}
+		return
+	}
+	if rcv := routeIDs(map[string]any{utils.OptsRoutesLimit: 1}); !reflect.DeepEqual(rcv, []string{"CARRIER1"}) {
+		t.Errorf("unexpected routes: %v", rcv)
+	}
+	if brk := rpS.breakers["cgrates.org:CARRIER2"]; brk.State != utils.MetaOpen || brk.Probes != 0 {
+		t.Errorf("expected the probe of CARRIER2 unused, received: %s", utils.ToJSON(brk))
+	}
+	// the probe of CARRIER1 is in progress
+	if rcv := routeIDs(nil); !reflect.DeepEqual(rcv, []string{"CARRIER2"}) {
+		t.Errorf("unexpected routes: %v", rcv)
+	}
+	if brk := rpS.breakers["cgrates.org:CARRIER2"]; brk.State != utils.MetaHalfOpen || brk.Probes != 1 {
+		t.Errorf("expected one probe of CARRIER2 used, received: %s", utils.ToJSON(brk))
+	}
+}
+
+func TestRouteBreakersNoConfig(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.RouteSCfg().Breaker = nil
+	rpS := NewRouteService(nil, nil, cfg, nil)
+	var reply string
+	if err := rpS.V1ProcessEvent(nil, &utils.CGREvent{
+		Event: map[string]any{utils.RouteID: "CARRIER1"},
+	}, &reply); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received: %v", utils.ErrNotFound, err)
+	}
+	var brks []*RouteBreaker
+	if err := rpS.V1GetRouteBreakers(nil, &utils.ArgsRouteBreakers{}, &brks); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received: %v", utils.ErrNotFound, err)
+	}
+}
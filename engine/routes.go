@@ -24,6 +24,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"maps"
@@ -42,7 +43,8 @@ type Route struct {
 	ResourceIDs     []string // queried in some strategies
 	StatIDs         []string // queried in some strategies
 	Weight          float64
-	Blocker         bool // do not process further route after this one
+	Weights         utils.DynamicWeights // Weight used for the events matching their filters
+	Blocker         bool                 // do not process further route after this one
 	RouteParameters string
 
 	cacheRoute     map[string]any // cache["*ratio"]=ratio
@@ -57,6 +59,7 @@ func (r *Route) Clone() *Route {
 	clone := &Route{
 		ID:              r.ID,
 		Weight:          r.Weight,
+		Weights:         r.Weights.Clone(),
 		Blocker:         r.Blocker,
 		RouteParameters: r.RouteParameters,
 	}
@@ -108,6 +111,15 @@ type RouteProfile struct {
 	SortingParameters  []string
 	Routes             []*Route
 	Weight             float64
+	Weights            utils.DynamicWeights
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (rp *RouteProfile) GetTenant() string {
+	if rp == nil {
+		return utils.EmptyString
+	}
+	return rp.Tenant
 }
 
 // Clone method for RouteProfile
@@ -120,6 +132,7 @@ func (rp *RouteProfile) Clone() *RouteProfile {
 		ID:      rp.ID,
 		Sorting: rp.Sorting,
 		Weight:  rp.Weight,
+		Weights: rp.Weights.Clone(),
 	}
 	if rp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(rp.FilterIDs))
@@ -208,6 +221,8 @@ func NewRouteService(dm *DataManager,
 		filterS: filterS,
 		cgrcfg:  cgrcfg,
 		connMgr: connMgr,
+
+		breakers: make(map[string]*RouteBreaker),
 	}
 	rS.sorter = NewRouteSortDispatcher(rS)
 	return
@@ -220,6 +235,9 @@ type RouteService struct {
 	cgrcfg  *config.CGRConfig
 	sorter  RouteSortDispatcher
 	connMgr *ConnManager
+
+	breakers map[string]*RouteBreaker // route circuit breakers indexed on tenant:routeID
+	brkMux   sync.Mutex
 }
 
 // Shutdown is called to shutdown the service
@@ -230,6 +248,7 @@ func (rpS *RouteService) Shutdown() {
 
 // matchingRouteProfilesForEvent returns ordered list of matching resources which are active by the time of the call
 func (rpS *RouteService) matchingRouteProfilesForEvent(tnt string, ev *utils.CGREvent) (matchingRPrf []*RouteProfile, err error) {
+	dm := rpS.dm.withAPIOpts(ev.APIOpts)
 	evNm := utils.MapStorage{
 		utils.MetaReq:  ev.Event,
 		utils.MetaOpts: ev.APIOpts,
@@ -239,7 +258,7 @@ func (rpS *RouteService) matchingRouteProfilesForEvent(tnt string, ev *utils.CGR
 		rpS.cgrcfg.RouteSCfg().PrefixIndexedFields,
 		rpS.cgrcfg.RouteSCfg().SuffixIndexedFields,
 		rpS.cgrcfg.RouteSCfg().ExistsIndexedFields,
-		rpS.dm, utils.CacheRouteFilterIndexes, tnt,
+		dm, utils.CacheRouteFilterIndexes, tnt,
 		rpS.cgrcfg.RouteSCfg().IndexedSelects,
 		rpS.cgrcfg.RouteSCfg().NestedFields,
 	)
@@ -247,8 +266,9 @@ func (rpS *RouteService) matchingRouteProfilesForEvent(tnt string, ev *utils.CGR
 		return nil, err
 	}
 	matchingRPrf = make([]*RouteProfile, 0, len(rPrfIDs))
+	weights := make(map[string]float64) // weights of the matching profiles for this event
 	for lpID := range rPrfIDs {
-		rPrf, err := rpS.dm.GetRouteProfile(tnt, lpID, true, true, utils.NonTransactional)
+		rPrf, err := dm.GetRouteProfile(tnt, lpID, true, true, utils.NonTransactional)
 		if err != nil {
 			if err == utils.ErrNotFound {
 				continue
@@ -265,12 +285,16 @@ func (rpS *RouteService) matchingRouteProfilesForEvent(tnt string, ev *utils.CGR
 		} else if !pass {
 			continue
 		}
+		if weights[rPrf.ID], err = weightForEvent(rPrf.Weights, rPrf.Weight,
+			rpS.filterS, tnt, evNm); err != nil {
+			return nil, err
+		}
 		matchingRPrf = append(matchingRPrf, rPrf)
 	}
 	if len(matchingRPrf) == 0 {
 		return nil, utils.ErrNotFound
 	}
-	sort.Slice(matchingRPrf, func(i, j int) bool { return matchingRPrf[i].Weight > matchingRPrf[j].Weight })
+	sort.Slice(matchingRPrf, func(i, j int) bool { return weights[matchingRPrf[i].ID] > weights[matchingRPrf[j].ID] })
 	return
 }
 
@@ -463,13 +487,17 @@ func (rpS *RouteService) resourceUsage(resIDs []string, tenant string) (tUsage f
 
 func (rpS *RouteService) populateSortingData(ev *utils.CGREvent, route *Route,
 	extraOpts *optsGetRoutes) (srtRoute *SortedRoute, pass bool, err error) {
+	weight := route.Weight
+	if evWeight, has := extraOpts.routeWeights[route.ID]; has {
+		weight = evWeight
+	}
 	sortedSpl := &SortedRoute{
 		RouteID: route.ID,
 		SortingData: map[string]any{
-			utils.Weight: route.Weight,
+			utils.Weight: weight,
 		},
 		sortingDataF64: map[string]float64{
-			utils.Weight: route.Weight,
+			utils.Weight: weight,
 		},
 		RouteParameters: route.RouteParameters,
 	}
@@ -643,6 +671,7 @@ type optsGetRoutes struct {
 	paginator         *utils.Paginator
 	sortingParameters []string //used for QOS strategy
 	sortingStrategy   string
+	routeWeights      map[string]float64 // weights of the routes for the current event
 }
 
 // V1GetRoutes returns the list of valid routes
@@ -715,12 +744,14 @@ func (rpS *RouteService) sortedRoutesForProfile(tnt string, rPrfl *RouteProfile,
 	pag utils.Paginator, extraOpts *optsGetRoutes) (sortedRoutes *SortedRoutes, err error) {
 	extraOpts.sortingParameters = rPrfl.SortingParameters // populate sortingParameters in extraOpts
 	extraOpts.sortingStrategy = rPrfl.Sorting             // populate sortingStrategy in extraOpts
+	extraOpts.routeWeights = make(map[string]float64)
 	//construct the DP and pass it to filterS
 	nM := utils.MapStorage{
 		utils.MetaReq:  ev.Event,
 		utils.MetaOpts: ev.APIOpts,
 	}
 	passedRoutes := make(map[string]*Route)
+	now := time.Now()
 	// apply filters for event
 	for _, route := range rPrfl.Routes {
 		pass, lazyCheckRules, err := rpS.filterS.LazyPass(tnt,
@@ -735,10 +766,18 @@ func (rpS *RouteService) sortedRoutesForProfile(tnt string, rPrfl *RouteProfile,
 			continue
 		}
 		route.lazyCheckRules = lazyCheckRules
-		if prev, has := passedRoutes[route.ID]; has && prev.Weight >= route.Weight {
+		weight, err := weightForEvent(route.Weights, route.Weight, rpS.filterS, tnt, nM)
+		if err != nil {
+			return nil, err
+		}
+		if prevWeight, has := extraOpts.routeWeights[route.ID]; has && prevWeight >= weight {
+			continue
+		}
+		if !rpS.breakerAvailable(tnt, route.ID, now) { // open breaker, skip the route
 			continue
 		}
 		passedRoutes[route.ID] = route
+		extraOpts.routeWeights[route.ID] = weight
 	}
 
 	if sortedRoutes, err = rpS.sorter.SortRoutes(rPrfl.ID, rPrfl.Sorting,
@@ -755,6 +794,7 @@ func (rpS *RouteService) sortedRoutesForProfile(tnt string, rPrfl *RouteProfile,
 			sortedRoutes.Routes = sortedRoutes.Routes[:*pag.Limit]
 		}
 	}
+	rpS.breakerAllowRoutes(tnt, sortedRoutes, now) // only the routes returned use the probes
 	return
 }
 
@@ -21,11 +21,14 @@ package engine
 import (
 	"fmt"
 	"maps"
+	"reflect"
 	"runtime"
 	"slices"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/cgrates/birpc"
 	"github.com/cgrates/birpc/context"
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/guardian"
@@ -43,6 +46,7 @@ func NewStatService(dm *DataManager, cgrcfg *config.CGRConfig,
 		storedStatQueues: make(utils.StringSet),
 		loopStopped:      make(chan struct{}),
 		stopBackup:       make(chan struct{}),
+		subs:             NewSubscriptions(cgrcfg, filterS, utils.StatUpdate),
 	}
 }
 
@@ -56,6 +60,7 @@ type StatService struct {
 	stopBackup       chan struct{}
 	storedStatQueues utils.StringSet // keep a record of stats which need saving, map[statsTenantID]bool
 	ssqMux           sync.RWMutex    // protects storedStatQueues
+	subs             *Subscriptions  // BiRPC clients subscribed to the metric changes
 }
 
 // Reload stops the backupLoop and restarts it
@@ -76,6 +81,7 @@ func (sS *StatService) Shutdown() {
 	utils.Logger.Info("<StatS> service shutdown initialized")
 	close(sS.stopBackup)
 	sS.storeStats()
+	sS.subs.Close()
 	utils.Logger.Info("<StatS> service shutdown complete")
 }
 
@@ -160,6 +166,8 @@ func (sS *StatService) StoreStatQueue(sq *StatQueue) (err error) {
 
 // matchingStatQueuesForEvent returns ordered list of matching statQueues which are active by the time of the call
 func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string, actTime *time.Time, evNm utils.MapStorage, ignoreFilters bool) (sqs StatQueues, err error) {
+	opts, _ := evNm[utils.MetaOpts].(map[string]any)
+	dm := sS.dm.withAPIOpts(opts)
 	sqIDs := utils.NewStringSet(statsIDs)
 	if len(sqIDs) == 0 {
 		ignoreFilters = false
@@ -168,7 +176,7 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 			sS.cgrcfg.StatSCfg().PrefixIndexedFields,
 			sS.cgrcfg.StatSCfg().SuffixIndexedFields,
 			sS.cgrcfg.StatSCfg().ExistsIndexedFields,
-			sS.dm, utils.CacheStatFilterIndexes, tnt,
+			dm, utils.CacheStatFilterIndexes, tnt,
 			sS.cgrcfg.StatSCfg().IndexedSelects,
 			sS.cgrcfg.StatSCfg().NestedFields,
 		)
@@ -181,12 +189,13 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 	itemIDs := slices.Sorted(maps.Keys(sqIDs))
 
 	sqs = make(StatQueues, 0, len(itemIDs))
+	weights := make(map[string]float64) // weights of the matching profiles for this event
 	for _, id := range itemIDs {
 		lkPrflID := guardian.Guardian.GuardIDs("",
 			config.CgrConfig().GeneralCfg().LockingTimeout,
 			statQueueProfileLockKey(tnt, id))
 		var sqPrfl *StatQueueProfile
-		if sqPrfl, err = sS.dm.GetStatQueueProfile(tnt, id, true, true, utils.NonTransactional); err != nil {
+		if sqPrfl, err = dm.GetStatQueueProfile(tnt, id, true, true, utils.NonTransactional); err != nil {
 			guardian.Guardian.UnguardIDs(lkPrflID)
 			if err == utils.ErrNotFound {
 				err = nil
@@ -213,11 +222,17 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 				continue
 			}
 		}
+		if weights[sqPrfl.ID], err = weightForEvent(sqPrfl.Weights, sqPrfl.Weight,
+			sS.filterS, tnt, evNm); err != nil {
+			sqPrfl.unlock()
+			sqs.unlock()
+			return nil, err
+		}
 		lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
 			config.CgrConfig().GeneralCfg().LockingTimeout,
 			statQueueLockKey(sqPrfl.Tenant, sqPrfl.ID))
 		var sq *StatQueue
-		if sq, err = sS.dm.GetStatQueue(sqPrfl.Tenant, sqPrfl.ID, true, true, ""); err != nil {
+		if sq, err = dm.GetStatQueue(sqPrfl.Tenant, sqPrfl.ID, true, true, ""); err != nil {
 			guardian.Guardian.UnguardIDs(lkID)
 			sqPrfl.unlock()
 			sqs.unlock()
@@ -237,7 +252,7 @@ func (sS *StatService) matchingStatQueuesForEvent(tnt string, statsIDs []string,
 		return nil, utils.ErrNotFound
 	}
 	// All good, convert from Map to Slice so we can sort
-	sqs.Sort()
+	sort.Slice(sqs, func(i, j int) bool { return weights[sqs[i].ID] > weights[sqs[j].ID] })
 	for i, s := range sqs {
 		if s.sqPrfl.Blocker && i != len(sqs)-1 { // blocker will stop processing and we are not at last index
 			StatQueues(sqs[i+1:]).unlock()
@@ -331,10 +346,7 @@ func (sS *StatService) processEEs(sQs StatQueues, opts map[string]any) (err erro
 		opts = make(map[string]any)
 	}
 	for _, sq := range sQs {
-		metrics := make(map[string]any)
-		for metricID, metric := range sq.SQMetrics {
-			metrics[metricID] = metric.GetValue(sS.cgrcfg.GeneralCfg().RoundingDecimals)
-		}
+		metrics := sS.metricValues(sq)
 		cgrEv := &utils.CGREvent{
 			Tenant: sq.Tenant,
 			ID:     utils.GenUUID(),
@@ -366,6 +378,33 @@ func (sS *StatService) processEEs(sQs StatQueues, opts map[string]any) (err erro
 	return
 }
 
+// metricValues returns the rounded values of the StatQueue metrics
+func (sS *StatService) metricValues(sq *StatQueue) (metrics map[string]any) {
+	metrics = make(map[string]any)
+	for metricID, metric := range sq.SQMetrics {
+		metrics[metricID] = metric.GetValue(sS.cgrcfg.GeneralCfg().RoundingDecimals)
+	}
+	return
+}
+
+// publishMetrics notifies the subscribers if the metrics of the StatQueue changed
+func (sS *StatService) publishMetrics(sq *StatQueue, prevMetrics map[string]any) {
+	metrics := sS.metricValues(sq)
+	if reflect.DeepEqual(metrics, prevMetrics) {
+		return
+	}
+	sS.subs.Publish(&utils.CGREvent{
+		Tenant: sq.Tenant,
+		ID:     utils.GenUUID(),
+		Time:   utils.TimePointer(time.Now()),
+		Event: map[string]any{
+			utils.EventType: utils.StatUpdate,
+			utils.StatID:    sq.ID,
+			utils.Metrics:   metrics,
+		},
+	})
+}
+
 // processEvent processes a new event, dispatching to matching queues
 // queues matching are also cached to speed up
 func (sS *StatService) processEvent(tnt string, args *utils.CGREvent) (statQueueIDs []string, err error) {
@@ -389,9 +428,17 @@ func (sS *StatService) processEvent(tnt string, args *utils.CGREvent) (statQueue
 	}
 	statQueueIDs = matchSQs.IDs()
 	var withErrors bool
+	hasSubs := sS.subs.HasSubscribers()
 	for _, sq := range matchSQs {
+		var prevMetrics map[string]any
+		if hasSubs {
+			prevMetrics = sS.metricValues(sq)
+		}
 		sq.ProcessEvent(tnt, args.ID, sS.filterS, evNm)
 		sS.storeStatQueue(sq)
+		if hasSubs {
+			sS.publishMetrics(sq, prevMetrics)
+		}
 	}
 	if sS.processThresholds(matchSQs, args.APIOpts) != nil || sS.processEEs(matchSQs, args.APIOpts) != nil ||
 		withErrors {
@@ -593,3 +640,29 @@ func (sS *StatService) V1ResetStatQueue(ctx *context.Context, tntID *utils.Tenan
 	*rply = utils.OK
 	return
 }
+
+// OnBiJSONDisconnect drops the subscriptions of the disconnected client
+func (sS *StatService) OnBiJSONDisconnect(c birpc.ClientConnector) {
+	sS.subs.RemoveClient(c)
+}
+
+// BiRPCv1Subscribe will push the metric changes towards the BiRPC client, replying with the subscription ID
+func (sS *StatService) BiRPCv1Subscribe(ctx *context.Context,
+	args *SubscribeArgs, reply *string) (err error) {
+	var subID string
+	if subID, err = sS.subs.Subscribe(ctx.Client, args); err != nil {
+		return
+	}
+	*reply = subID
+	return
+}
+
+// BiRPCv1Unsubscribe will remove the subscription with the given ID
+func (sS *StatService) BiRPCv1Unsubscribe(ctx *context.Context,
+	subID string, reply *string) (err error) {
+	if err = sS.subs.Unsubscribe(ctx.Client, subID); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
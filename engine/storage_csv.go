@@ -68,6 +68,9 @@ type CSVStorage struct {
 	chargerProfilesFn        []string
 	dispatcherProfilesFn     []string
 	dispatcherHostsFn        []string
+	lookupTablesFn           []string
+
+	locations TPLocations // populated only after TrackLocations
 }
 
 // NewCSVStorage creates a CSV storage that takes the data from the paths specified
@@ -76,7 +79,8 @@ func NewCSVStorage(sep rune,
 	destinationratetimingsFn, ratingprofilesFn, sharedgroupsFn,
 	actionsFn, actiontimingsFn, actiontriggersFn, accountactionsFn,
 	resProfilesFn, ipProfilesFn, statsFn, trendsFn, rankingsFn, thresholdsFn, filterFn, routeProfilesFn,
-	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn []string) *CSVStorage {
+	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn,
+	lookupTablesFn []string) *CSVStorage {
 	return &CSVStorage{
 		sep:                      sep,
 		generator:                NewCsvFile,
@@ -103,6 +107,7 @@ func NewCSVStorage(sep rune,
 		chargerProfilesFn:        chargerProfilesFn,
 		dispatcherProfilesFn:     dispatcherProfilesFn,
 		dispatcherHostsFn:        dispatcherHostsFn,
+		lookupTablesFn:           lookupTablesFn,
 	}
 }
 
@@ -135,6 +140,7 @@ func NewFileCSVStorage(sep rune, dataPath string) (*CSVStorage, error) {
 	chargersPaths := appendName(allFoldersPath, utils.ChargersCsv)
 	dispatcherprofilesPaths := appendName(allFoldersPath, utils.DispatcherProfilesCsv)
 	dispatcherhostsPaths := appendName(allFoldersPath, utils.DispatcherHostsCsv)
+	lookupTablesPaths := appendName(allFoldersPath, utils.LookupTablesCsv)
 	return NewCSVStorage(sep,
 		destinationsPaths,
 		timingsPaths,
@@ -159,6 +165,7 @@ func NewFileCSVStorage(sep rune, dataPath string) (*CSVStorage, error) {
 		chargersPaths,
 		dispatcherprofilesPaths,
 		dispatcherhostsPaths,
+		lookupTablesPaths,
 	), nil
 }
 
@@ -168,14 +175,15 @@ func NewStringCSVStorage(sep rune,
 	destinationratetimingsFn, ratingprofilesFn, sharedgroupsFn,
 	actionsFn, actiontimingsFn, actiontriggersFn, accountactionsFn,
 	resProfilesFn, ipProfilesFn, statsFn, trendsFn, rankingsFn, thresholdsFn, filterFn, routeProfilesFn,
-	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn string) *CSVStorage {
+	attributeProfilesFn, chargerProfilesFn, dispatcherProfilesFn, dispatcherHostsFn,
+	lookupTablesFn string) *CSVStorage {
 	c := NewCSVStorage(sep, []string{destinationsFn}, []string{timingsFn},
 		[]string{ratesFn}, []string{destinationratesFn}, []string{destinationratetimingsFn},
 		[]string{ratingprofilesFn}, []string{sharedgroupsFn}, []string{actionsFn},
 		[]string{actiontimingsFn}, []string{actiontriggersFn}, []string{accountactionsFn},
 		[]string{resProfilesFn}, []string{ipProfilesFn}, []string{statsFn}, []string{trendsFn}, []string{rankingsFn}, []string{thresholdsFn}, []string{filterFn},
 		[]string{routeProfilesFn}, []string{attributeProfilesFn}, []string{chargerProfilesFn},
-		[]string{dispatcherProfilesFn}, []string{dispatcherHostsFn})
+		[]string{dispatcherProfilesFn}, []string{dispatcherHostsFn}, []string{lookupTablesFn})
 	c.generator = NewCsvString
 	return c
 }
@@ -220,6 +228,7 @@ func NewGoogleCSVStorage(sep rune, spreadsheetID string) (*CSVStorage, error) {
 		getIfExist(utils.Chargers),
 		getIfExist(utils.DispatcherProfiles),
 		getIfExist(utils.DispatcherHosts),
+		getIfExist(utils.LookupTables),
 	)
 	c.generator = func() csvReaderCloser {
 		return &csvGoogle{
@@ -255,6 +264,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 	var chargersPaths []string
 	var dispatcherprofilesPaths []string
 	var dispatcherhostsPaths []string
+	var lookupTablesPaths []string
 
 	for _, baseURL := range strings.Split(dataPath, utils.InfieldSep) {
 		if !strings.HasSuffix(baseURL, utils.CSVSuffix) {
@@ -281,6 +291,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 			chargersPaths = append(chargersPaths, joinURL(baseURL, utils.ChargersCsv))
 			dispatcherprofilesPaths = append(dispatcherprofilesPaths, joinURL(baseURL, utils.DispatcherProfilesCsv))
 			dispatcherhostsPaths = append(dispatcherhostsPaths, joinURL(baseURL, utils.DispatcherHostsCsv))
+			lookupTablesPaths = append(lookupTablesPaths, joinURL(baseURL, utils.LookupTablesCsv))
 			continue
 		}
 		switch {
@@ -330,6 +341,8 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 			dispatcherprofilesPaths = append(dispatcherprofilesPaths, baseURL)
 		case strings.HasSuffix(baseURL, utils.DispatcherHostsCsv):
 			dispatcherhostsPaths = append(dispatcherhostsPaths, baseURL)
+		case strings.HasSuffix(baseURL, utils.LookupTablesCsv):
+			lookupTablesPaths = append(lookupTablesPaths, baseURL)
 		}
 	}
 
@@ -357,6 +370,7 @@ func NewURLCSVStorage(sep rune, dataPath string) *CSVStorage {
 		chargersPaths,
 		dispatcherprofilesPaths,
 		dispatcherhostsPaths,
+		lookupTablesPaths,
 	)
 	c.generator = func() csvReaderCloser {
 		return &csvURL{}
@@ -395,10 +409,10 @@ func appendName(paths []string, fileName string) (out []string) {
 }
 
 func (csvs *CSVStorage) proccesData(listType any, fns []string, process func(any)) error {
-	collumnCount := getColumnCount(listType)
+	collumnCount, optCount := getColumnCount(listType)
 	for _, fileName := range fns {
 		csvReader := csvs.generator()
-		err := csvReader.Open(fileName, csvs.sep, collumnCount)
+		err := csvReader.Open(fileName, csvs.sep, collumnCount, optCount)
 		if err != nil {
 			// maybe a log to view if failed to open file
 			continue // try read the rest
@@ -410,6 +424,14 @@ func (csvs *CSVStorage) proccesData(listType any, fns []string, process func(any
 					log.Printf("bad line in %s, %s\n", fileName, err.Error())
 					return err
 				}
+				if optCount != 0 &&
+					(len(record) < collumnCount-optCount || len(record) > collumnCount) {
+					log.Printf("bad line in %s, %s\n", fileName, csv.ErrFieldCount.Error())
+					return csv.ErrFieldCount
+				}
+				if csvs.locations != nil {
+					csvs.locations.add(listType, fileName, csvReader, record)
+				}
 				if item, err := csvLoad(listType, record); err != nil {
 					log.Printf("error loading %s: %v", "", err)
 					return err
@@ -425,6 +447,13 @@ func (csvs *CSVStorage) proccesData(listType any, fns []string, process func(any
 	return nil
 }
 
+// TrackLocations makes the storage remember the file and line where each
+// tariff plan item is first defined, starting with the next read
+func (csvs *CSVStorage) TrackLocations() TPLocations {
+	csvs.locations = make(TPLocations)
+	return csvs.locations
+}
+
 func (csvs *CSVStorage) GetTPTimings(tpid, id string) ([]*utils.ApierTPTiming, error) {
 	var tpTimings TimingMdls
 	if err := csvs.proccesData(TimingMdl{}, csvs.timingsFn, func(tp any) {
@@ -572,7 +601,7 @@ func (csvs *CSVStorage) GetTPResources(tpid, tenant, id string) ([]*utils.TPReso
 	}); err != nil {
 		return nil, err
 	}
-	return tpResLimits.AsTPResources(), nil
+	return tpResLimits.AsTPResources()
 }
 
 func (csvs *CSVStorage) GetTPIPs(tpid, tenant, id string) ([]*utils.TPIPProfile, error) {
@@ -596,7 +625,7 @@ func (csvs *CSVStorage) GetTPStats(tpid, tenant, id string) ([]*utils.TPStatProf
 	}); err != nil {
 		return nil, err
 	}
-	return tpStats.AsTPStats(), nil
+	return tpStats.AsTPStats()
 }
 
 func (csvs *CSVStorage) GetTPTrends(tpid, tenant, id string) ([]*utils.TPTrendsProfile, error) {
@@ -632,7 +661,7 @@ func (csvs *CSVStorage) GetTPThresholds(tpid, tenant, id string) ([]*utils.TPThr
 	}); err != nil {
 		return nil, err
 	}
-	return tpThreshold.AsTPThreshold(), nil
+	return tpThreshold.AsTPThreshold()
 }
 
 func (csvs *CSVStorage) GetTPFilters(tpid, tenant, id string) ([]*utils.TPFilterProfile, error) {
@@ -656,7 +685,7 @@ func (csvs *CSVStorage) GetTPRoutes(tpid, tenant, id string) ([]*utils.TPRoutePr
 	}); err != nil {
 		return nil, err
 	}
-	return tpRoutes.AsTPRouteProfile(), nil
+	return tpRoutes.AsTPRouteProfile()
 }
 
 func (csvs *CSVStorage) GetTPAttributes(tpid, tenant, id string) ([]*utils.TPAttributeProfile, error) {
@@ -668,7 +697,7 @@ func (csvs *CSVStorage) GetTPAttributes(tpid, tenant, id string) ([]*utils.TPAtt
 	}); err != nil {
 		return nil, err
 	}
-	return tpAls.AsTPAttributes(), nil
+	return tpAls.AsTPAttributes()
 }
 
 func (csvs *CSVStorage) GetTPChargers(tpid, tenant, id string) ([]*utils.TPChargerProfile, error) {
@@ -680,7 +709,7 @@ func (csvs *CSVStorage) GetTPChargers(tpid, tenant, id string) ([]*utils.TPCharg
 	}); err != nil {
 		return nil, err
 	}
-	return tpCPPs.AsTPChargers(), nil
+	return tpCPPs.AsTPChargers()
 }
 
 func (csvs *CSVStorage) GetTPDispatcherProfiles(tpid, tenant, id string) ([]*utils.TPDispatcherProfile, error) {
@@ -711,6 +740,18 @@ func (csvs *CSVStorage) GetTPDispatcherHosts(tpid, tenant, id string) ([]*utils.
 	return result, nil
 }
 
+func (csvs *CSVStorage) GetTPLookupTables(tpid, tenant, id string) ([]*utils.TPLookupTable, error) {
+	var tpLTs LookupTableMdls
+	if err := csvs.proccesData(LookupTableMdl{}, csvs.lookupTablesFn, func(tp any) {
+		lt := tp.(LookupTableMdl)
+		lt.Tpid = tpid
+		tpLTs = append(tpLTs, &lt)
+	}); err != nil {
+		return nil, err
+	}
+	return tpLTs.AsTPLookupTables(), nil
+}
+
 func (csvs *CSVStorage) GetTpIds(colName string) ([]string, error) {
 	return nil, utils.ErrNotImplemented
 }
@@ -720,8 +761,17 @@ func (csvs *CSVStorage) GetTpTableIds(tpid, table string,
 	return nil, utils.ErrNotImplemented
 }
 
+// fieldsPerRecord returns the number of fields the csv.Reader enforces,
+// letting the records miss the trailing optional columns
+func fieldsPerRecord(nrFields, optFields int) int {
+	if optFields != 0 {
+		return -1
+	}
+	return nrFields
+}
+
 type csvReaderCloser interface {
-	Open(data string, sep rune, nrFields int) (err error)
+	Open(data string, sep rune, nrFields, optFields int) (err error)
 	Read() (record []string, err error)
 	Close()
 }
@@ -735,7 +785,7 @@ type csvFile struct {
 	fp        *os.File
 }
 
-func (c *csvFile) Open(fn string, sep rune, nrFields int) (err error) {
+func (c *csvFile) Open(fn string, sep rune, nrFields, optFields int) (err error) {
 	c.fp, err = os.Open(fn)
 	if err != nil {
 		return
@@ -743,7 +793,7 @@ func (c *csvFile) Open(fn string, sep rune, nrFields int) (err error) {
 	c.csvReader = csv.NewReader(c.fp)
 	c.csvReader.Comma = sep
 	c.csvReader.Comment = utils.CommentChar
-	c.csvReader.FieldsPerRecord = nrFields
+	c.csvReader.FieldsPerRecord = fieldsPerRecord(nrFields, optFields)
 	return
 }
 
@@ -751,6 +801,11 @@ func (c *csvFile) Read() (record []string, err error) {
 	return c.csvReader.Read()
 }
 
+func (c *csvFile) line() (ln int) {
+	ln, _ = c.csvReader.FieldPos(0)
+	return
+}
+
 func (c *csvFile) Close() {
 	if c.fp != nil {
 		c.fp.Close()
@@ -765,11 +820,11 @@ type csvString struct {
 	csvReader *csv.Reader
 }
 
-func (c *csvString) Open(data string, sep rune, nrFields int) (err error) {
+func (c *csvString) Open(data string, sep rune, nrFields, optFields int) (err error) {
 	c.csvReader = csv.NewReader(strings.NewReader(data))
 	c.csvReader.Comma = sep
 	c.csvReader.Comment = utils.CommentChar
-	c.csvReader.FieldsPerRecord = nrFields
+	c.csvReader.FieldsPerRecord = fieldsPerRecord(nrFields, optFields)
 	return
 }
 
@@ -897,7 +952,7 @@ type csvGoogle struct {
 	nrFields      int
 }
 
-func (c *csvGoogle) Open(data string, sep rune, nrFields int) (err error) {
+func (c *csvGoogle) Open(data string, sep rune, nrFields, optFields int) (err error) {
 	c.response, err = c.srv.Spreadsheets.Values.Get(c.spreadsheetID, data).Do()
 	if err != nil {
 		return
@@ -941,6 +996,10 @@ func (c *csvGoogle) Read() (record []string, err error) {
 	return
 }
 
+func (c *csvGoogle) line() int {
+	return c.indx
+}
+
 func (c *csvGoogle) Close() { // no need for close
 }
 
@@ -949,7 +1008,7 @@ type csvURL struct {
 	page      io.ReadCloser
 }
 
-func (c *csvURL) Open(fn string, sep rune, nrFields int) (err error) {
+func (c *csvURL) Open(fn string, sep rune, nrFields, optFields int) (err error) {
 	if _, err = url.ParseRequestURI(fn); err != nil {
 		return
 	}
@@ -969,7 +1028,7 @@ func (c *csvURL) Open(fn string, sep rune, nrFields int) (err error) {
 	c.csvReader = csv.NewReader(c.page)
 	c.csvReader.Comma = sep
 	c.csvReader.Comment = utils.CommentChar
-	c.csvReader.FieldsPerRecord = nrFields
+	c.csvReader.FieldsPerRecord = fieldsPerRecord(nrFields, optFields)
 	return
 }
 
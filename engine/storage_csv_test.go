@@ -25,6 +25,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -195,7 +196,7 @@ func TestOpen(t *testing.T) {
 		t.Fatalf("Failed to close temporary file: %v", err)
 	}
 	csvFile := &csvFile{}
-	if err := csvFile.Open(tmpFile.Name(), ',', 2); err != nil {
+	if err := csvFile.Open(tmpFile.Name(), ',', 2, 0); err != nil {
 		t.Fatalf("Open() failed: %v", err)
 	}
 	if csvFile.fp == nil {
@@ -311,7 +312,7 @@ func TestCsvURLOpenSuccess(t *testing.T) {
 	}))
 	defer server.Close()
 	c := &csvURL{}
-	err := c.Open(server.URL, ',', 3)
+	err := c.Open(server.URL, ',', 3, 0)
 	if err != nil {
 		t.Fatalf("expected no error, got: %v", err)
 	}
@@ -334,7 +335,7 @@ func TestCsvURLOpenSuccess(t *testing.T) {
 
 func TestCsvURLOpenInvalidURL(t *testing.T) {
 	c := &csvURL{}
-	err := c.Open("invalid-url", ',', 3)
+	err := c.Open("invalid-url", ',', 3, 0)
 	if err == nil {
 		t.Fatalf("expected an error for invalid URL, got none")
 	}
@@ -346,7 +347,7 @@ func TestCsvURLOpenNotFound(t *testing.T) {
 	}))
 	defer server.Close()
 	c := &csvURL{}
-	err := c.Open(server.URL, ',', 3)
+	err := c.Open(server.URL, ',', 3, 0)
 	if err == nil {
 		t.Fatalf("expected ErrNotFound, got none")
 	}
@@ -354,7 +355,7 @@ func TestCsvURLOpenNotFound(t *testing.T) {
 
 func TestCsvURLOpenPathNotReachable(t *testing.T) {
 	c := &csvURL{}
-	err := c.Open("http://invalid.localhost", ',', 3)
+	err := c.Open("http://invalid.localhost", ',', 3, 0)
 	if err == nil {
 		t.Fatalf("expected path not reachable error, got none")
 	}
@@ -495,3 +496,43 @@ func TestGetCfgJSONData(t *testing.T) {
 		}
 	})
 }
+
+func TestCSVStorageOptionalWeights(t *testing.T) {
+	newStorage := func(routes string) *CSVStorage {
+		return NewStringCSVStorage(utils.CSVSep, "", "", "", "", "", "", "", "", "", "", "",
+			"", "", "", "", "", "", "", routes, "", "", "", "", "")
+	}
+	oldRoutes := `#Tenant[0],ID[1],FilterIDs[2],ActivationInterval[3],Sorting[4],SortingParameters[5],RouteID[6],RouteFilterIDs[7],RouteAccountIDs[8],RouteRatingPlanIDs[9],RouteResourceIDs[10],RouteStatIDs[11],RouteWeight[12],RouteBlocker[13],RouteParameters[14],Weight[15]
+cgrates.org,RoutePrf1,,,*weight,,route1,,,,,,10,,,20
+`
+	rcv, err := newStorage(oldRoutes).GetTPRoutes("", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rcv) != 1 || rcv[0].Weight != 20 || rcv[0].Weights != nil ||
+		len(rcv[0].Routes) != 1 || rcv[0].Routes[0].Weights != nil {
+		t.Errorf("unexpected routes loaded from the old format: %s", utils.ToJSON(rcv))
+	}
+
+	newRoutes := `#Tenant[0],ID[1],FilterIDs[2],ActivationInterval[3],Sorting[4],SortingParameters[5],RouteID[6],RouteFilterIDs[7],RouteAccountIDs[8],RouteRatingPlanIDs[9],RouteResourceIDs[10],RouteStatIDs[11],RouteWeight[12],RouteBlocker[13],RouteParameters[14],Weight[15],RouteWeights[16],Weights[17]
+cgrates.org,RoutePrf1,,,*weight,,route1,,,,,,10,,,20,FLTR_1;30,;40
+`
+	expWeights := utils.DynamicWeights{{Weight: 40}}
+	expRouteWeights := utils.DynamicWeights{{FilterIDs: []string{"FLTR_1"}, Weight: 30}}
+	if rcv, err = newStorage(newRoutes).GetTPRoutes("", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(rcv) != 1 || !reflect.DeepEqual(expWeights, rcv[0].Weights) ||
+		len(rcv[0].Routes) != 1 || !reflect.DeepEqual(expRouteWeights, rcv[0].Routes[0].Weights) {
+		t.Errorf("unexpected routes loaded from the new format: %s", utils.ToJSON(rcv))
+	}
+
+	for _, routes := range []string{
+		"cgrates.org,RoutePrf1,,,*weight,,route1,,,,,,10,,\n",
+		"cgrates.org,RoutePrf1,,,*weight,,route1,,,,,,10,,,20,,,extra\n",
+	} {
+		if _, err = newStorage(routes).GetTPRoutes("", "", ""); err != csv.ErrFieldCount {
+			t.Errorf("expected %v, received %v", csv.ErrFieldCount, err)
+		}
+	}
+}
@@ -58,6 +58,28 @@ type DataDB interface {
 	RemoveReverseDestinationDrv(string, string, string) error
 	SetReverseDestinationDrv(string, []string, string) error
 	GetReverseDestinationDrv(string, string) ([]string, error)
+	GetPortedNumberDrv(string) (string, error)
+	SetPortedNumberDrv(string, string) error
+	SetPortedNumbersDrv([]*PortedNumber) error
+	RemovePortedNumberDrv(string) error
+	GetLookupTableDrv(string, string) (*LookupTable, error)
+	SetLookupTableDrv(*LookupTable) error
+	RemoveLookupTableDrv(string, string) error
+	GetDiscountProfileDrv(string, string) (*DiscountProfile, error)
+	SetDiscountProfileDrv(*DiscountProfile) error
+	RemoveDiscountProfileDrv(string, string) error
+	GetFraudProfileDrv(string, string) (*FraudProfile, error)
+	SetFraudProfileDrv(*FraudProfile) error
+	RemoveFraudProfileDrv(string, string) error
+	GetFraudCaseDrv(string, string) (*FraudCase, error)
+	SetFraudCaseDrv(*FraudCase) error
+	RemoveFraudCaseDrv(string, string) error
+	GetRevisionsDrv(string, string) (*ObjectRevisions, error)
+	SetRevisionsDrv(*ObjectRevisions) error
+	GetLoadRevisionsDrv(int64) ([]*ObjectRevisions, error)
+	GetDataDBMigrationDrv() (*StoredDataDBMigration, error)
+	SetDataDBMigrationDrv(*StoredDataDBMigration) error
+	RemoveDataDBMigrationDrv() error
 	GetActionsDrv(string) (Actions, error)
 	SetActionsDrv(string, Actions) error
 	RemoveActionsDrv(string) error
@@ -169,6 +191,8 @@ type CdrStorage interface {
 	RemoveSMCost(*SMCost) error
 	RemoveSMCosts(qryFltr *utils.SMCostFilter) error
 	GetCDRs(*utils.CDRsFilter, bool) ([]*CDR, int64, error)
+	SetAuditRecord(*AuditRecord) error
+	GetAuditRecords(*utils.AuditRecordFilter) ([]*AuditRecord, error)
 }
 
 type LoadStorage interface {
@@ -205,6 +229,7 @@ type LoadReader interface {
 	GetTPChargers(string, string, string) ([]*utils.TPChargerProfile, error)
 	GetTPDispatcherProfiles(string, string, string) ([]*utils.TPDispatcherProfile, error)
 	GetTPDispatcherHosts(string, string, string) ([]*utils.TPDispatcherHost, error)
+	GetTPLookupTables(string, string, string) ([]*utils.TPLookupTable, error)
 }
 
 type LoadWriter interface {
@@ -232,6 +257,7 @@ type LoadWriter interface {
 	SetTPChargers([]*utils.TPChargerProfile) error
 	SetTPDispatcherProfiles([]*utils.TPDispatcherProfile) error
 	SetTPDispatcherHosts([]*utils.TPDispatcherHost) error
+	SetTPLookupTables([]*utils.TPLookupTable) error
 	DumpStorDB() error
 	RewriteStorDB() error
 	BackupStorDB(string, bool) error
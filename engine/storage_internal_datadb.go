@@ -337,6 +337,163 @@ func (iDB *InternalDB) GetReverseDestinationDrv(prefix, transactionID string) (i
 	return nil, utils.ErrNotFound
 }
 
+func (iDB *InternalDB) GetPortedNumberDrv(number string) (rn string, err error) {
+	if x, ok := iDB.db.Get(utils.CachePortedNumbers, number); ok && x != nil {
+		return x.(string), nil
+	}
+	return utils.EmptyString, utils.ErrNotFound
+}
+
+func (iDB *InternalDB) SetPortedNumberDrv(number, rn string) (err error) {
+	iDB.db.Set(utils.CachePortedNumbers, number, rn, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) SetPortedNumbersDrv(pns []*PortedNumber) (err error) {
+	for _, pn := range pns {
+		iDB.db.Set(utils.CachePortedNumbers, pn.Number, pn.RoutingNumber, nil,
+			true, utils.NonTransactional)
+	}
+	return
+}
+
+func (iDB *InternalDB) RemovePortedNumberDrv(number string) (err error) {
+	iDB.db.Remove(utils.CachePortedNumbers, number,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetLookupTableDrv(tenant, id string) (lt *LookupTable, err error) {
+	x, ok := iDB.db.Get(utils.CacheLookupTables, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*LookupTable), nil
+}
+
+func (iDB *InternalDB) SetLookupTableDrv(lt *LookupTable) (err error) {
+	iDB.db.Set(utils.CacheLookupTables, lt.TenantID(), lt, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveLookupTableDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheLookupTables, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetDiscountProfileDrv(tenant, id string) (dp *DiscountProfile, err error) {
+	x, ok := iDB.db.Get(utils.CacheDiscountProfiles, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*DiscountProfile), nil
+}
+
+func (iDB *InternalDB) SetDiscountProfileDrv(dp *DiscountProfile) (err error) {
+	iDB.db.Set(utils.CacheDiscountProfiles, dp.TenantID(), dp, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveDiscountProfileDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheDiscountProfiles, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetFraudProfileDrv(tenant, id string) (fp *FraudProfile, err error) {
+	x, ok := iDB.db.Get(utils.CacheFraudProfiles, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*FraudProfile), nil
+}
+
+func (iDB *InternalDB) SetFraudProfileDrv(fp *FraudProfile) (err error) {
+	iDB.db.Set(utils.CacheFraudProfiles, fp.TenantID(), fp, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveFraudProfileDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheFraudProfiles, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetFraudCaseDrv(tenant, id string) (fc *FraudCase, err error) {
+	x, ok := iDB.db.Get(utils.CacheFraudCases, utils.ConcatenatedKey(tenant, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*FraudCase), nil
+}
+
+func (iDB *InternalDB) SetFraudCaseDrv(fc *FraudCase) (err error) {
+	iDB.db.Set(utils.CacheFraudCases, fc.TenantID(), fc, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveFraudCaseDrv(tenant, id string) (err error) {
+	iDB.db.Remove(utils.CacheFraudCases, utils.ConcatenatedKey(tenant, id),
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetRevisionsDrv(objType, id string) (rvs *ObjectRevisions, err error) {
+	x, ok := iDB.db.Get(utils.MetaRevisions, utils.ConcatenatedKey(objType, id))
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*ObjectRevisions), nil
+}
+
+func (iDB *InternalDB) SetRevisionsDrv(rvs *ObjectRevisions) (err error) {
+	iDB.db.Set(utils.MetaRevisions, utils.ConcatenatedKey(rvs.ObjectType, rvs.ID), rvs, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) GetLoadRevisionsDrv(loadID int64) (objRvs []*ObjectRevisions, err error) {
+	for _, key := range iDB.db.GetItemIDs(utils.MetaRevisions, utils.EmptyString) {
+		x, ok := iDB.db.Get(utils.MetaRevisions, key)
+		if !ok || x == nil {
+			continue
+		}
+		if rvs := x.(*ObjectRevisions); rvs.hasLoadID(loadID) {
+			objRvs = append(objRvs, rvs)
+		}
+	}
+	if len(objRvs) == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+func (iDB *InternalDB) GetDataDBMigrationDrv() (mig *StoredDataDBMigration, err error) {
+	x, ok := iDB.db.Get(utils.MetaDataDBMigration, utils.DataDBMigrationKey)
+	if !ok || x == nil {
+		return nil, utils.ErrNotFound
+	}
+	return x.(*StoredDataDBMigration), nil
+}
+
+func (iDB *InternalDB) SetDataDBMigrationDrv(mig *StoredDataDBMigration) (err error) {
+	iDB.db.Set(utils.MetaDataDBMigration, utils.DataDBMigrationKey, mig, nil,
+		true, utils.NonTransactional)
+	return
+}
+
+func (iDB *InternalDB) RemoveDataDBMigrationDrv() (err error) {
+	iDB.db.Remove(utils.MetaDataDBMigration, utils.DataDBMigrationKey,
+		true, utils.NonTransactional)
+	return
+}
+
 func (iDB *InternalDB) GetActionsDrv(id string) (acts Actions, err error) {
 	if x, ok := iDB.db.Get(utils.CacheActions, id); ok && x != nil {
 		return x.(Actions), err
@@ -633,6 +633,28 @@ func (iDB *InternalDB) GetTPDispatcherHosts(tpid, tenant, id string) (dpps []*ut
 	return
 }
 
+func (iDB *InternalDB) GetTPLookupTables(tpid, tenant, id string) (lts []*utils.TPLookupTable, err error) {
+	key := tpid
+	if tenant != utils.EmptyString {
+		key += utils.ConcatenatedKeySep + tenant
+	}
+	if id != utils.EmptyString {
+		key += utils.ConcatenatedKeySep + id
+	}
+	ids := iDB.db.GetItemIDs(utils.CacheTBLTPLookupTables, key)
+	for _, id := range ids {
+		x, ok := iDB.db.Get(utils.CacheTBLTPLookupTables, id)
+		if !ok || x == nil {
+			return nil, utils.ErrNotFound
+		}
+		lts = append(lts, x.(*utils.TPLookupTable))
+	}
+	if len(lts) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
 // implement LoadWriter interface
 func (iDB *InternalDB) RemTpData(table, tpid string, args map[string]string) (err error) {
 	if table == utils.EmptyString {
@@ -914,6 +936,17 @@ func (iDB *InternalDB) SetTPDispatcherHosts(dpps []*utils.TPDispatcherHost) (err
 	return
 }
 
+func (iDB *InternalDB) SetTPLookupTables(lts []*utils.TPLookupTable) (err error) {
+	if len(lts) == 0 {
+		return nil
+	}
+	for _, lt := range lts {
+		iDB.db.Set(utils.CacheTBLTPLookupTables, utils.ConcatenatedKey(lt.TPid, lt.Tenant, lt.ID), lt, nil,
+			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	}
+	return
+}
+
 // implement CdrStorage interface
 func (iDB *InternalDB) SetCDR(cdr *CDR, allowUpdate bool) (err error) {
 	if cdr.OrderID == 0 {
@@ -989,6 +1022,7 @@ func (iDB *InternalDB) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
 		{utils.OriginID, qryFltr.OriginIDs},
 		{utils.OriginHost, qryFltr.OriginHosts},
 		{utils.CostSource, qryFltr.CostSources},
+		{utils.AccountField, qryFltr.Accounts},
 	} {
 		if len(fltrSlc.ids) == 0 {
 			continue
@@ -1031,12 +1065,13 @@ func (iDB *InternalDB) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
 		{utils.OriginID, qryFltr.NotOriginIDs},
 		{utils.OriginHost, qryFltr.NotOriginHosts},
 		{utils.CostSource, qryFltr.NotCostSources},
+		{utils.AccountField, qryFltr.NotAccounts},
 	} {
 		if len(fltrSlc.ids) == 0 {
 			continue
 		}
 		for _, id := range fltrSlc.ids {
-			grpIDs := iDB.db.GetGroupItemIDs(utils.CacheCDRsTBL, utils.ConcatenatedKey(fltrSlc.key, id))
+			grpIDs := iDB.db.GetGroupItemIDs(utils.CacheSessionCostsTBL, utils.ConcatenatedKey(fltrSlc.key, id))
 			for _, id := range grpIDs {
 				if smMpIDs.HasKey(id) {
 					delete(smMpIDs, id)
@@ -1052,9 +1087,18 @@ func (iDB *InternalDB) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
 		return utils.ErrNotFound
 	}
 
+	var removed bool
 	for key := range smMpIDs {
+		if x, ok := iDB.db.Get(utils.CacheSessionCostsTBL, key); !ok || x == nil ||
+			!x.(*SMCost).passTimeFilters(qryFltr) {
+			continue
+		}
 		iDB.db.Remove(utils.CacheSessionCostsTBL, key,
 			cacheCommit(utils.NonTransactional), utils.NonTransactional)
+		removed = true
+	}
+	if !removed {
+		return utils.ErrNotFound
 	}
 	return nil
 }
@@ -1344,7 +1388,7 @@ func (iDB *InternalDB) GetCDRs(filter *utils.CDRsFilter, remove bool) (cdrs []*C
 			(filter.AnswerTimeStart != nil && !filter.AnswerTimeStart.IsZero() && cdr.AnswerTime.Before(*filter.AnswerTimeStart)) ||
 			(filter.AnswerTimeEnd != nil && !filter.AnswerTimeEnd.IsZero() && cdr.AnswerTime.After(*filter.AnswerTimeEnd)) ||
 			(filter.SetupTimeStart != nil && !filter.SetupTimeStart.IsZero() && cdr.SetupTime.Before(*filter.SetupTimeStart)) ||
-			(filter.SetupTimeEnd != nil && !filter.SetupTimeEnd.IsZero() && cdr.SetupTime.Before(*filter.SetupTimeEnd)) ||
+			(filter.SetupTimeEnd != nil && !filter.SetupTimeEnd.IsZero() && !cdr.SetupTime.Before(*filter.SetupTimeEnd)) ||
 
 			(len(filter.MinUsage) != 0 && cdr.Usage < minUsage) ||
 			(len(filter.MaxUsage) != 0 && cdr.Usage > maxUsage) {
@@ -1576,17 +1620,56 @@ func (iDB *InternalDB) SetSMCost(smCost *SMCost) (err error) {
 	if smCost.CostDetails == nil {
 		return nil
 	}
+	if smCost.CreatedAt.IsZero() {
+		smCost.CreatedAt = time.Now()
+	}
 	idxs := make(utils.StringSet)
 	idxs.Add(utils.ConcatenatedKey(utils.CGRID, smCost.CGRID))
 	idxs.Add(utils.ConcatenatedKey(utils.RunID, smCost.RunID))
 	idxs.Add(utils.ConcatenatedKey(utils.OriginHost, smCost.OriginHost))
 	idxs.Add(utils.ConcatenatedKey(utils.OriginID, smCost.OriginID))
 	idxs.Add(utils.ConcatenatedKey(utils.CostSource, smCost.CostSource))
+	idxs.Add(utils.ConcatenatedKey(utils.AccountField, smCost.Account))
 	iDB.db.Set(utils.CacheSessionCostsTBL, utils.ConcatenatedKey(smCost.CGRID, smCost.RunID, smCost.OriginHost, smCost.OriginID), smCost, idxs.AsSlice(),
 		cacheCommit(utils.NonTransactional), utils.NonTransactional)
 	return err
 }
 
+func (iDB *InternalDB) SetAuditRecord(ar *AuditRecord) (err error) {
+	iDB.db.Set(utils.CacheAuditRecordsTBL, ar.ID, ar, nil,
+		cacheCommit(utils.NonTransactional), utils.NonTransactional)
+	return
+}
+
+// GetAuditRecords returns the AuditRecords matching the filter ordered by time
+func (iDB *InternalDB) GetAuditRecords(fltr *utils.AuditRecordFilter) (ars []*AuditRecord, err error) {
+	for _, id := range iDB.db.GetItemIDs(utils.CacheAuditRecordsTBL, utils.EmptyString) {
+		x, ok := iDB.db.Get(utils.CacheAuditRecordsTBL, id)
+		if !ok || x == nil {
+			continue
+		}
+		if ar := x.(*AuditRecord); ar.passFilter(fltr) {
+			ars = append(ars, ar)
+		}
+	}
+	sort.Slice(ars, func(i, j int) bool {
+		if ars[i].Time.Equal(ars[j].Time) {
+			return ars[i].ID < ars[j].ID
+		}
+		return ars[i].Time.Before(ars[j].Time)
+	})
+	if fltr.Offset != nil && *fltr.Offset > 0 {
+		ars = ars[min(*fltr.Offset, len(ars)):]
+	}
+	if fltr.Limit != nil && *fltr.Limit > 0 {
+		ars = ars[:min(*fltr.Limit, len(ars))]
+	}
+	if len(ars) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	return
+}
+
 // Will dump everything inside stordb to files
 func (iDB *InternalDB) DumpStorDB() (err error) {
 	return iDB.db.DumpAll()
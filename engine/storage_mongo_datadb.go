@@ -50,6 +50,13 @@ import (
 const (
 	ColDst  = "destinations"
 	ColRds  = "reverse_destinations"
+	ColPnr  = "ported_numbers"
+	ColLkt  = "lookup_tables"
+	ColDsc  = "discount_profiles"
+	ColFrp  = "fraud_profiles"
+	ColFrc  = "fraud_cases"
+	ColRev  = "revisions"
+	ColDbm  = "datadb_migration"
 	ColAct  = "actions"
 	ColApl  = "action_plans"
 	ColAAp  = "account_action_plans"
@@ -108,6 +115,12 @@ var (
 	DestinationLow = strings.ToLower(utils.Destination)
 	CostLow        = strings.ToLower(utils.Cost)
 	CostSourceLow  = strings.ToLower(utils.CostSource)
+	TimeLow        = strings.ToLower(utils.Time)
+	ObjectTypeLow  = strings.ToLower(utils.ObjectType)
+	ObjectIDLow    = strings.ToLower(utils.ObjectID)
+	APIKeyLow      = strings.ToLower(utils.APIKey)
+	AddressLow     = strings.ToLower(utils.Address)
+	MethodLow      = strings.ToLower(utils.Method)
 )
 
 func decimalEncoder(ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
@@ -306,12 +319,14 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		return err
 	}
 	switch col {
-	case ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx:
+	case ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColPnr, ColLht, ColIndx:
 		err = ms.enusureIndex(col, true, "key")
-	case ColRsP, ColRes, ColIPp, ColIPs, ColSqs, ColRgp, ColTrp, ColRnk, ColSqp, ColTps, ColThs, ColTrd, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph:
+	case ColRsP, ColRes, ColIPp, ColIPs, ColSqs, ColRgp, ColTrp, ColRnk, ColSqp, ColTps, ColThs, ColTrd, ColRts, ColAttr, ColFlt, ColCpp, ColDpp, ColDph, ColLkt, ColDsc, ColFrp, ColFrc:
 		err = ms.enusureIndex(col, true, "tenant", "id")
 	case ColRpf, ColShg, ColAcc:
 		err = ms.enusureIndex(col, true, "id")
+	case ColRev:
+		err = ms.enusureIndex(col, true, ObjectTypeLow, "id")
 		// StorDB
 	case utils.TBLTPTimings, utils.TBLTPDestinations,
 		utils.TBLTPDestinationRates, utils.TBLTPRatingPlans,
@@ -321,6 +336,8 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts,
 		utils.TBLTPChargers, utils.TBLTPRoutes, utils.TBLTPThresholds:
 		err = ms.enusureIndex(col, true, "tpid", "id")
+	case utils.TBLTPLookupTables:
+		err = ms.enusureIndex(col, true, "tpid", "tenant", "id")
 	case utils.TBLTPRatingProfiles:
 		err = ms.enusureIndex(col, true, "tpid", "tenant",
 			"category", "subject", "loadid")
@@ -332,6 +349,14 @@ func (ms *MongoStorage) ensureIndexesForCol(col string) error { // exported for
 		if err == nil {
 			err = ms.enusureIndex(col, false, RunIDLow, OriginIDLow)
 		}
+		if err == nil {
+			err = ms.enusureIndex(col, false, AccountLow)
+		}
+	case utils.AuditRecordsTBL:
+		err = ms.enusureIndex(col, false, TenantLow, ObjectTypeLow, ObjectIDLow)
+		if err == nil {
+			err = ms.enusureIndex(col, false, TimeLow)
+		}
 	case utils.CDRsTBL:
 		err = ms.enusureIndex(col, true, CGRIDLow, RunIDLow,
 			OriginIDLow)
@@ -352,16 +377,18 @@ func (ms *MongoStorage) EnsureIndexes(cols ...string) error {
 	if len(cols) == 0 {
 		if ms.IsDataDB() {
 			cols = []string{
-				ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColLht, ColIndx,
+				ColAct, ColApl, ColAAp, ColAtr, ColRpl, ColDst, ColRds, ColPnr, ColLht, ColIndx,
 				ColRsP, ColRes, ColIPs, ColSqs, ColSqp, ColTps, ColThs, ColRts, ColAttr,
 				ColFlt, ColCpp, ColDpp, ColRpf, ColShg, ColAcc, ColRgp, ColTrp, ColTrd, ColRnk,
+				ColLkt, ColDsc, ColFrp, ColFrc, ColRev,
 			}
 		} else {
 			cols = []string{
 				utils.TBLTPTimings, utils.TBLTPDestinations, utils.TBLTPDestinationRates,
 				utils.TBLTPRatingPlans, utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionPlans,
 				utils.TBLTPActionTriggers, utils.TBLTPRankings, utils.TBLTPStats, utils.TBLTPResources,
-				utils.TBLTPIPs, utils.TBLTPRatingProfiles, utils.CDRsTBL, utils.SessionCostsTBL,
+				utils.TBLTPIPs, utils.TBLTPRatingProfiles, utils.TBLTPLookupTables,
+				utils.CDRsTBL, utils.SessionCostsTBL, utils.AuditRecordsTBL,
 			}
 		}
 	}
@@ -403,6 +430,16 @@ func (ms *MongoStorage) RemoveKeysForPrefix(prefix string) error {
 		colName = ColDst
 	case utils.ReverseDestinationPrefix:
 		colName = ColRds
+	case utils.PortedNumberPrefix:
+		colName = ColPnr
+	case utils.LookupTablePrefix:
+		colName = ColLkt
+	case utils.DiscountProfilePrefix:
+		colName = ColDsc
+	case utils.FraudProfilePrefix:
+		colName = ColFrp
+	case utils.FraudCasePrefix:
+		colName = ColFrc
 	case utils.ActionPrefix:
 		colName = ColAct
 	case utils.ActionPlanPrefix:
@@ -622,6 +659,16 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix, search string) (keys []string,
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColDst, utils.DestinationPrefix, subject, "key", search)
 		case utils.ReverseDestinationPrefix:
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColRds, utils.ReverseDestinationPrefix, subject, "key", search)
+		case utils.PortedNumberPrefix:
+			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColPnr, utils.PortedNumberPrefix, subject, "key", search)
+		case utils.LookupTablePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColLkt, utils.LookupTablePrefix, subject, search, tntID)
+		case utils.DiscountProfilePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColDsc, utils.DiscountProfilePrefix, subject, search, tntID)
+		case utils.FraudProfilePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColFrp, utils.FraudProfilePrefix, subject, search, tntID)
+		case utils.FraudCasePrefix:
+			keys, qryErr = ms.getAllKeysMatchingTenantID(sctx, ColFrc, utils.FraudCasePrefix, subject, search, tntID)
 		case utils.RatingPlanPrefix:
 			keys, qryErr = ms.getAllKeysMatchingField(sctx, ColRpl, utils.RatingPlanPrefix, subject, "key", search)
 		case utils.RatingProfilePrefix:
@@ -686,6 +733,10 @@ func (ms *MongoStorage) GetKeysForPrefix(prefix, search string) (keys []string,
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.ResourceFilterIndexes)
 		case utils.IPFilterIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.IPFilterIndexes)
+		case utils.FraudFilterIndexes:
+			keys, qryErr = ms.getAllIndexKeys(sctx, utils.FraudFilterIndexes)
+		case utils.DiscountFilterIndexes:
+			keys, qryErr = ms.getAllIndexKeys(sctx, utils.DiscountFilterIndexes)
 		case utils.StatFilterIndexes:
 			keys, qryErr = ms.getAllIndexKeys(sctx, utils.StatFilterIndexes)
 		case utils.ThresholdFilterIndexes:
@@ -992,6 +1043,285 @@ func (ms *MongoStorage) SetReverseDestinationDrv(destID string, prefixes []strin
 	return nil
 }
 
+func (ms *MongoStorage) GetPortedNumberDrv(number string) (string, error) {
+	var result struct {
+		Key   string
+		Value string
+	}
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColPnr).FindOne(sctx, bson.M{"key": number})
+		decodeErr := sr.Decode(&result)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return result.Value, err
+}
+
+func (ms *MongoStorage) SetPortedNumberDrv(number, rn string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColPnr).UpdateOne(sctx, bson.M{"key": number},
+			bson.M{"$set": bson.M{"key": number, "value": rn}},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) SetPortedNumbersDrv(pns []*PortedNumber) error {
+	if len(pns) == 0 {
+		return nil
+	}
+	models := make([]mongo.WriteModel, len(pns))
+	for i, pn := range pns {
+		models[i] = mongo.NewUpdateOneModel().SetFilter(bson.M{"key": pn.Number}).
+			SetUpdate(bson.M{"$set": bson.M{"key": pn.Number, "value": pn.RoutingNumber}}).
+			SetUpsert(true)
+	}
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColPnr).BulkWrite(sctx, models)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemovePortedNumberDrv(number string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColPnr).DeleteOne(sctx, bson.M{"key": number})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+// GetLookupTableDrv returns the LookupTable, the entries are stored marshaled
+// since their keys (ie: IP addresses) are not valid document field names
+func (ms *MongoStorage) GetLookupTableDrv(tenant, id string) (*LookupTable, error) {
+	var result struct {
+		Tenant  string
+		ID      string
+		Entries []byte
+	}
+	if err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColLkt).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(&result)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	}); err != nil {
+		return nil, err
+	}
+	lt := &LookupTable{Tenant: result.Tenant, ID: result.ID}
+	if err := ms.ms.Unmarshal(result.Entries, &lt.Entries); err != nil {
+		return nil, err
+	}
+	return lt, nil
+}
+
+func (ms *MongoStorage) SetLookupTableDrv(lt *LookupTable) error {
+	entries, err := ms.ms.Marshal(lt.Entries)
+	if err != nil {
+		return err
+	}
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColLkt).UpdateOne(sctx, bson.M{"tenant": lt.Tenant, "id": lt.ID},
+			bson.M{"$set": bson.M{"tenant": lt.Tenant, "id": lt.ID, "entries": entries}},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveLookupTableDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColLkt).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetDiscountProfileDrv(tenant, id string) (*DiscountProfile, error) {
+	dp := new(DiscountProfile)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColDsc).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(dp)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return dp, err
+}
+
+func (ms *MongoStorage) SetDiscountProfileDrv(dp *DiscountProfile) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColDsc).UpdateOne(sctx, bson.M{"tenant": dp.Tenant, "id": dp.ID},
+			bson.M{"$set": dp},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveDiscountProfileDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColDsc).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetFraudProfileDrv(tenant, id string) (*FraudProfile, error) {
+	fp := new(FraudProfile)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColFrp).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(fp)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return fp, err
+}
+
+func (ms *MongoStorage) SetFraudProfileDrv(fp *FraudProfile) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColFrp).UpdateOne(sctx, bson.M{"tenant": fp.Tenant, "id": fp.ID},
+			bson.M{"$set": fp},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveFraudProfileDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColFrp).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetFraudCaseDrv(tenant, id string) (*FraudCase, error) {
+	fc := new(FraudCase)
+	err := ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColFrc).FindOne(sctx, bson.M{"tenant": tenant, "id": id})
+		decodeErr := sr.Decode(fc)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	return fc, err
+}
+
+func (ms *MongoStorage) SetFraudCaseDrv(fc *FraudCase) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColFrc).UpdateOne(sctx, bson.M{"tenant": fc.Tenant, "id": fc.ID},
+			bson.M{"$set": fc},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveFraudCaseDrv(tenant, id string) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		dr, err := ms.getCol(ColFrc).DeleteOne(sctx, bson.M{"tenant": tenant, "id": id})
+		if dr.DeletedCount == 0 {
+			return utils.ErrNotFound
+		}
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetRevisionsDrv(objType, id string) (rvs *ObjectRevisions, err error) {
+	rvs = new(ObjectRevisions)
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColRev).FindOne(sctx, bson.M{ObjectTypeLow: objType, "id": id})
+		decodeErr := sr.Decode(rvs)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (ms *MongoStorage) SetRevisionsDrv(rvs *ObjectRevisions) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColRev).UpdateOne(sctx, bson.M{ObjectTypeLow: rvs.ObjectType, "id": rvs.ID},
+			bson.M{"$set": rvs},
+			options.Update().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) GetLoadRevisionsDrv(loadID int64) (objRvs []*ObjectRevisions, err error) {
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		cur, err := ms.getCol(ColRev).Find(sctx, bson.M{"revisions.loadid": loadID})
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			rvs := new(ObjectRevisions)
+			if err := cur.Decode(rvs); err != nil {
+				return errors.Join(err, cur.Close(sctx))
+			}
+			objRvs = append(objRvs, rvs)
+		}
+		return cur.Close(sctx)
+	})
+	if err == nil && len(objRvs) == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+func (ms *MongoStorage) GetDataDBMigrationDrv() (mig *StoredDataDBMigration, err error) {
+	mig = new(StoredDataDBMigration)
+	err = ms.query(func(sctx mongo.SessionContext) error {
+		sr := ms.getCol(ColDbm).FindOne(sctx, bson.D{})
+		decodeErr := sr.Decode(mig)
+		if errors.Is(decodeErr, mongo.ErrNoDocuments) {
+			return utils.ErrNotFound
+		}
+		return decodeErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (ms *MongoStorage) SetDataDBMigrationDrv(mig *StoredDataDBMigration) error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColDbm).ReplaceOne(sctx, bson.D{}, mig,
+			options.Replace().SetUpsert(true),
+		)
+		return err
+	})
+}
+
+func (ms *MongoStorage) RemoveDataDBMigrationDrv() error {
+	return ms.query(func(sctx mongo.SessionContext) error {
+		_, err := ms.getCol(ColDbm).DeleteOne(sctx, bson.D{})
+		return err
+	})
+}
+
 func (ms *MongoStorage) GetActionsDrv(key string) (Actions, error) {
 	var result struct {
 		Key   string
@@ -1088,6 +1418,7 @@ func (ms *MongoStorage) SetAccountDrv(acc *Account) error {
 			ac.UnitCounters = acc.UnitCounters
 			ac.AllowNegative = acc.AllowNegative
 			ac.Disabled = acc.Disabled
+			ac.Discounts = acc.Discounts
 			acc = ac
 		}
 	}
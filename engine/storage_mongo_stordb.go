@@ -978,6 +978,9 @@ func (ms *MongoStorage) SetSMCost(smc *SMCost) error {
 	if smc.CostDetails == nil {
 		return nil
 	}
+	if smc.CreatedAt.IsZero() {
+		smc.CreatedAt = time.Now()
+	}
 	return ms.query(func(sctx mongo.SessionContext) (err error) {
 		_, err = ms.getCol(utils.SessionCostsTBL).InsertOne(sctx, smc)
 		return err
@@ -1041,6 +1044,7 @@ func (ms *MongoStorage) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
 		OriginHostLow: bson.M{"$in": qryFltr.OriginHosts, "$nin": qryFltr.NotOriginHosts},
 		OriginIDLow:   bson.M{"$in": qryFltr.OriginIDs, "$nin": qryFltr.NotOriginIDs},
 		CostSourceLow: bson.M{"$in": qryFltr.CostSources, "$nin": qryFltr.NotCostSources},
+		AccountLow:    bson.M{"$in": qryFltr.Accounts, "$nin": qryFltr.NotAccounts},
 		UsageLow:      bson.M{"$gte": qryFltr.Usage.Min, "$lt": qryFltr.Usage.Max},
 		CreatedAtLow:  bson.M{"$gte": qryFltr.CreatedAt.Begin, "$lt": qryFltr.CreatedAt.End},
 	}
@@ -1718,6 +1722,69 @@ func (ms *MongoStorage) SetTPDispatcherHosts(tpDPPs []*utils.TPDispatcherHost) (
 	})
 }
 
+// GetTPLookupTables returns the TP lookup tables, the entries are stored
+// marshaled in the same way as in the DataDB
+func (ms *MongoStorage) GetTPLookupTables(tpid, tenant, id string) ([]*utils.TPLookupTable, error) {
+	filter := bson.M{"tpid": tpid}
+	if id != "" {
+		filter["id"] = id
+	}
+	if tenant != "" {
+		filter["tenant"] = tenant
+	}
+	var results []*utils.TPLookupTable
+	err := ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.TBLTPLookupTables).Find(sctx, filter)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var tp struct {
+				TPid    string
+				Tenant  string
+				ID      string
+				Entries []byte
+			}
+			if err := cur.Decode(&tp); err != nil {
+				return err
+			}
+			tpLT := &utils.TPLookupTable{TPid: tp.TPid, Tenant: tp.Tenant, ID: tp.ID}
+			if err := ms.ms.Unmarshal(tp.Entries, &tpLT.Entries); err != nil {
+				return err
+			}
+			results = append(results, tpLT)
+		}
+		if len(results) == 0 {
+			return utils.ErrNotFound
+		}
+		return cur.Close(sctx)
+	})
+	return results, err
+}
+
+func (ms *MongoStorage) SetTPLookupTables(tpLTs []*utils.TPLookupTable) (err error) {
+	if len(tpLTs) == 0 {
+		return
+	}
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		for _, tp := range tpLTs {
+			var entries []byte
+			if entries, err = ms.ms.Marshal(tp.Entries); err != nil {
+				return err
+			}
+			_, err = ms.getCol(utils.TBLTPLookupTables).UpdateOne(sctx,
+				bson.M{"tpid": tp.TPid, "tenant": tp.Tenant, "id": tp.ID},
+				bson.M{"$set": bson.M{"tpid": tp.TPid, "tenant": tp.Tenant, "id": tp.ID, "entries": entries}},
+				options.Update().SetUpsert(true),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (ms *MongoStorage) GetVersions(itm string) (Versions, error) {
 	fop := options.FindOne()
 	if itm != "" {
@@ -1816,3 +1883,49 @@ func (ms *MongoStorage) RestoreStorDB(backupFolderPath string) (err error) {
 func (ms *MongoStorage) SnapshotStorDB(backupFolderPath string, zip bool) (err error) {
 	return utils.ErrNotImplemented
 }
+
+func (ms *MongoStorage) SetAuditRecord(ar *AuditRecord) error {
+	return ms.query(func(sctx mongo.SessionContext) (err error) {
+		_, err = ms.getCol(utils.AuditRecordsTBL).InsertOne(sctx, ar)
+		return err
+	})
+}
+
+// GetAuditRecords returns the AuditRecords matching the filter ordered by time
+func (ms *MongoStorage) GetAuditRecords(fltr *utils.AuditRecordFilter) (ars []*AuditRecord, err error) {
+	filters := bson.M{
+		TenantLow:     bson.M{"$in": fltr.Tenants},
+		ObjectTypeLow: bson.M{"$in": fltr.ObjectTypes},
+		ObjectIDLow:   bson.M{"$in": fltr.ObjectIDs},
+		APIKeyLow:     bson.M{"$in": fltr.APIKeys},
+		AddressLow:    bson.M{"$in": fltr.Addresses},
+		MethodLow:     bson.M{"$in": fltr.Methods},
+		TimeLow:       bson.M{"$gte": fltr.Time.Begin, "$lt": fltr.Time.End},
+	}
+	ms.cleanEmptyFilters(filters)
+	fop := options.Find().SetSort(bson.D{{Key: TimeLow, Value: 1}, {Key: "id", Value: 1}})
+	if fltr.Limit != nil {
+		fop = fop.SetLimit(int64(*fltr.Limit))
+	}
+	if fltr.Offset != nil {
+		fop = fop.SetSkip(int64(*fltr.Offset))
+	}
+	err = ms.query(func(sctx mongo.SessionContext) (err error) {
+		cur, err := ms.getCol(utils.AuditRecordsTBL).Find(sctx, filters, fop)
+		if err != nil {
+			return err
+		}
+		for cur.Next(sctx) {
+			var ar AuditRecord
+			if err = cur.Decode(&ar); err != nil {
+				return err
+			}
+			ars = append(ars, &ar)
+		}
+		if len(ars) == 0 {
+			return utils.ErrNotFound
+		}
+		return cur.Close(sctx)
+	})
+	return
+}
@@ -773,6 +773,208 @@ func (rs *RedisStorage) RemoveReverseDestinationDrv(dstID, prfx, transactionID s
 	return rs.Cmd(nil, redis_SREM, utils.ReverseDestinationPrefix+prfx, dstID)
 }
 
+// GetPortedNumberDrv returns the routing number, stored as plain string to keep
+// the footprint low for large portability databases
+func (rs *RedisStorage) GetPortedNumberDrv(number string) (rn string, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.PortedNumberPrefix+number); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	return string(values), nil
+}
+
+func (rs *RedisStorage) SetPortedNumberDrv(number, rn string) (err error) {
+	return rs.Cmd(nil, redis_SET, utils.PortedNumberPrefix+number, rn)
+}
+
+// SetPortedNumbersDrv pipelines the writes of the ported numbers
+func (rs *RedisStorage) SetPortedNumbersDrv(pns []*PortedNumber) (err error) {
+	cmds := make(rueidis.Commands, len(pns))
+	for i, pn := range pns {
+		cmds[i] = rs.client.B().Set().Key(utils.PortedNumberPrefix + pn.Number).Value(pn.RoutingNumber).Build()
+	}
+	for _, resp := range rs.client.DoMulti(context.Background(), cmds...) {
+		if err = resp.Error(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (rs *RedisStorage) RemovePortedNumberDrv(number string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.PortedNumberPrefix+number)
+}
+
+func (rs *RedisStorage) GetLookupTableDrv(tenant, id string) (lt *LookupTable, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.LookupTablePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &lt)
+	return
+}
+
+func (rs *RedisStorage) SetLookupTableDrv(lt *LookupTable) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(lt); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.LookupTablePrefix+lt.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveLookupTableDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.LookupTablePrefix+utils.ConcatenatedKey(tenant, id))
+}
+
+func (rs *RedisStorage) GetDiscountProfileDrv(tenant, id string) (dp *DiscountProfile, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.DiscountProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &dp)
+	return
+}
+
+func (rs *RedisStorage) SetDiscountProfileDrv(dp *DiscountProfile) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(dp); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.DiscountProfilePrefix+dp.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveDiscountProfileDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.DiscountProfilePrefix+utils.ConcatenatedKey(tenant, id))
+}
+
+func (rs *RedisStorage) GetFraudProfileDrv(tenant, id string) (fp *FraudProfile, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.FraudProfilePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &fp)
+	return
+}
+
+func (rs *RedisStorage) SetFraudProfileDrv(fp *FraudProfile) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(fp); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.FraudProfilePrefix+fp.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveFraudProfileDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.FraudProfilePrefix+utils.ConcatenatedKey(tenant, id))
+}
+
+func (rs *RedisStorage) GetFraudCaseDrv(tenant, id string) (fc *FraudCase, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.FraudCasePrefix+utils.ConcatenatedKey(tenant, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &fc)
+	return
+}
+
+func (rs *RedisStorage) SetFraudCaseDrv(fc *FraudCase) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(fc); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.FraudCasePrefix+fc.TenantID(), string(result))
+}
+
+func (rs *RedisStorage) RemoveFraudCaseDrv(tenant, id string) (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.FraudCasePrefix+utils.ConcatenatedKey(tenant, id))
+}
+
+func (rs *RedisStorage) GetRevisionsDrv(objType, id string) (rvs *ObjectRevisions, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.RevisionsPrefix+utils.ConcatenatedKey(objType, id)); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &rvs)
+	return
+}
+
+func (rs *RedisStorage) SetRevisionsDrv(rvs *ObjectRevisions) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(rvs); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.RevisionsPrefix+utils.ConcatenatedKey(rvs.ObjectType, rvs.ID), string(result))
+}
+
+// GetLoadRevisionsDrv returns the revisions of the objects changed by the load
+func (rs *RedisStorage) GetLoadRevisionsDrv(loadID int64) (objRvs []*ObjectRevisions, err error) {
+	var keys []string
+	if keys, err = rs.GetKeysForPrefix(utils.RevisionsPrefix, utils.EmptyString); err != nil {
+		return
+	}
+	for _, key := range keys {
+		var values []byte
+		if err = rs.Cmd(&values, redis_GET, key); err != nil {
+			return nil, err
+		} else if len(values) == 0 {
+			continue
+		}
+		var rvs *ObjectRevisions
+		if err = rs.ms.Unmarshal(values, &rvs); err != nil {
+			return nil, err
+		}
+		if rvs.hasLoadID(loadID) {
+			objRvs = append(objRvs, rvs)
+		}
+	}
+	if len(objRvs) == 0 {
+		err = utils.ErrNotFound
+	}
+	return
+}
+
+func (rs *RedisStorage) GetDataDBMigrationDrv() (mig *StoredDataDBMigration, err error) {
+	var values []byte
+	if err = rs.Cmd(&values, redis_GET, utils.DataDBMigrationKey); err != nil {
+		return
+	} else if len(values) == 0 {
+		err = utils.ErrNotFound
+		return
+	}
+	err = rs.ms.Unmarshal(values, &mig)
+	return
+}
+
+func (rs *RedisStorage) SetDataDBMigrationDrv(mig *StoredDataDBMigration) (err error) {
+	var result []byte
+	if result, err = rs.ms.Marshal(mig); err != nil {
+		return
+	}
+	return rs.Cmd(nil, redis_SET, utils.DataDBMigrationKey, string(result))
+}
+
+func (rs *RedisStorage) RemoveDataDBMigrationDrv() (err error) {
+	return rs.Cmd(nil, redis_DEL, utils.DataDBMigrationKey)
+}
+
 func (rs *RedisStorage) GetActionsDrv(key string) (as Actions, err error) {
 	var values []byte
 	if err = rs.Cmd(&values, redis_GET, utils.ActionPrefix+key); err != nil {
@@ -102,7 +102,8 @@ func (sqls *SQLStorage) IsDBEmpty() (resp bool, err error) {
 		utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 		utils.TBLTPFilters, utils.SessionCostsTBL, utils.CDRsTBL, utils.TBLTPActionPlans,
 		utils.TBLVersions, utils.TBLTPRoutes, utils.TBLTPAttributes, utils.TBLTPChargers,
-		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts,
+		utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts, utils.TBLTPLookupTables,
+		utils.AuditRecordsTBL,
 	}
 	for _, tbl := range tbls {
 		if sqls.db.Migrator().HasTable(tbl) {
@@ -121,7 +122,7 @@ func (sqls *SQLStorage) GetTpIds(colName string) ([]string, error) {
 	qryStr := fmt.Sprintf(" (SELECT tpid FROM %s)", colName)
 	if colName == "" {
 		qryStr = fmt.Sprintf(
-			"(SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s)",
+			"(SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s) UNION (SELECT tpid FROM %s)",
 			utils.TBLTPTimings,
 			utils.TBLTPDestinations,
 			utils.TBLTPRates,
@@ -143,6 +144,7 @@ func (sqls *SQLStorage) GetTpIds(colName string) ([]string, error) {
 			utils.TBLTPChargers,
 			utils.TBLTPDispatchers,
 			utils.TBLTPDispatcherHosts,
+			utils.TBLTPLookupTables,
 		)
 	}
 	rows, err = sqls.Db.Query(qryStr)
@@ -239,7 +241,8 @@ func (sqls *SQLStorage) RemTpData(table, tpid string, args map[string]string) er
 			utils.TBLTPSharedGroups, utils.TBLTPActions, utils.TBLTPActionTriggers, utils.TBLTPRankings,
 			utils.TBLTPAccountActions, utils.TBLTPResources, utils.TBLTPStats, utils.TBLTPThresholds,
 			utils.TBLTPFilters, utils.TBLTPActionPlans, utils.TBLTPRoutes, utils.TBLTPAttributes,
-			utils.TBLTPChargers, utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts} {
+			utils.TBLTPChargers, utils.TBLTPDispatchers, utils.TBLTPDispatcherHosts,
+			utils.TBLTPLookupTables} {
 			if err := tx.Table(tblName).Where("tpid = ?", tpid).Delete(nil).Error; err != nil {
 				tx.Rollback()
 				return err
@@ -790,10 +793,36 @@ func (sqls *SQLStorage) SetTPDispatcherHosts(tpDPPs []*utils.TPDispatcherHost) e
 	return nil
 }
 
+func (sqls *SQLStorage) SetTPLookupTables(tpLTs []*utils.TPLookupTable) error {
+	if len(tpLTs) == 0 {
+		return nil
+	}
+	tx := sqls.db.Begin()
+	for _, lt := range tpLTs {
+		// Remove previous
+		if err := tx.Where(&LookupTableMdl{Tpid: lt.TPid, Tenant: lt.Tenant, ID: lt.ID}).Delete(LookupTableMdl{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		for _, mdl := range APItoModelTPLookupTable(lt) {
+			if err := tx.Create(&mdl).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
 func (sqls *SQLStorage) SetSMCost(smc *SMCost) error {
 	if smc.CostDetails == nil {
 		return nil
 	}
+	createdAt := smc.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
 	tx := sqls.db.Begin()
 	cd := &SessionCostsSQL{
 		Cgrid:       smc.CGRID,
@@ -801,9 +830,10 @@ func (sqls *SQLStorage) SetSMCost(smc *SMCost) error {
 		OriginHost:  smc.OriginHost,
 		OriginID:    smc.OriginID,
 		CostSource:  smc.CostSource,
+		Account:     smc.Account,
 		CostDetails: utils.ToJSON(smc.CostDetails),
 		Usage:       smc.Usage.Nanoseconds(),
-		CreatedAt:   time.Now(),
+		CreatedAt:   createdAt,
 	}
 	if tx.Save(cd).Error != nil { // Check further since error does not properly reflect duplicates here (sql: no rows in result set)
 		tx.Rollback()
@@ -861,6 +891,12 @@ func (sqls *SQLStorage) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
 	if len(qryFltr.NotCostSources) != 0 {
 		q = q.Where("costsource not in (?)", qryFltr.NotCostSources)
 	}
+	if len(qryFltr.Accounts) != 0 {
+		q = q.Where("account in (?)", qryFltr.Accounts)
+	}
+	if len(qryFltr.NotAccounts) != 0 {
+		q = q.Where("account not in (?)", qryFltr.NotAccounts)
+	}
 	if qryFltr.CreatedAt.Begin != nil {
 		q = q.Where("created_at >= ?", qryFltr.CreatedAt.Begin)
 	}
@@ -919,8 +955,10 @@ func (sqls *SQLStorage) GetSMCosts(cgrid, runid, originHost, originIDPrefix stri
 			OriginHost:  result.OriginHost,
 			OriginID:    result.OriginID,
 			CostSource:  result.CostSource,
+			Account:     result.Account,
 			Usage:       time.Duration(result.Usage),
 			CostDetails: new(EventCost),
+			CreatedAt:   result.CreatedAt,
 		}
 		if err := json.Unmarshal([]byte(result.CostDetails), smc.CostDetails); err != nil {
 			return nil, err
@@ -965,6 +1003,83 @@ func (sqls *SQLStorage) SetCDR(cdr *CDR, allowUpdate bool) error {
 
 // GetCDRs has ability to remove the selected CDRs, count them or simply return them
 // qryFltr.Unscoped will ignore soft deletes or delete records permanently
+func (sqls *SQLStorage) SetAuditRecord(ar *AuditRecord) error {
+	return sqls.db.Save(&AuditRecordSQL{
+		RecordID:   ar.ID,
+		Time:       ar.Time,
+		Address:    ar.Address,
+		ApiKey:     ar.APIKey,
+		Method:     ar.Method,
+		Tenant:     ar.Tenant,
+		ObjectType: ar.ObjectType,
+		ObjectID:   ar.ObjectID,
+		Before:     ar.Before,
+		After:      ar.After,
+		Changes:    strings.Join(ar.Changes, utils.InfieldSep),
+		Error:      ar.Error,
+	}).Error
+}
+
+// GetAuditRecords returns the AuditRecords matching the filter ordered by time
+func (sqls *SQLStorage) GetAuditRecords(fltr *utils.AuditRecordFilter) ([]*AuditRecord, error) {
+	q := sqls.db.Table(utils.AuditRecordsTBL).Select("*")
+	for _, fld := range []struct {
+		col  string
+		vals []string
+	}{
+		{"tenant", fltr.Tenants},
+		{"object_type", fltr.ObjectTypes},
+		{"object_id", fltr.ObjectIDs},
+		{"api_key", fltr.APIKeys},
+		{"address", fltr.Addresses},
+		{"method", fltr.Methods},
+	} {
+		if len(fld.vals) != 0 {
+			q = q.Where(fld.col+" in (?)", fld.vals)
+		}
+	}
+	if fltr.Time.Begin != nil {
+		q = q.Where("record_time >= ?", *fltr.Time.Begin)
+	}
+	if fltr.Time.End != nil {
+		q = q.Where("record_time < ?", *fltr.Time.End)
+	}
+	q = q.Order("record_time, id")
+	if fltr.Limit != nil {
+		q = q.Limit(*fltr.Limit)
+		if fltr.Offset != nil {
+			q = q.Offset(*fltr.Offset)
+		}
+	}
+	var results []*AuditRecordSQL
+	if err := q.Find(&results).Error; err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, utils.ErrNotFound
+	}
+	ars := make([]*AuditRecord, len(results))
+	for i, result := range results {
+		ars[i] = &AuditRecord{
+			ID:         result.RecordID,
+			Time:       result.Time,
+			Address:    result.Address,
+			APIKey:     result.ApiKey,
+			Method:     result.Method,
+			Tenant:     result.Tenant,
+			ObjectType: result.ObjectType,
+			ObjectID:   result.ObjectID,
+			Before:     result.Before,
+			After:      result.After,
+			Error:      result.Error,
+		}
+		if result.Changes != utils.EmptyString {
+			ars[i].Changes = strings.Split(result.Changes, utils.InfieldSep)
+		}
+	}
+	return ars, nil
+}
+
 func (sqls *SQLStorage) GetCDRs(qryFltr *utils.CDRsFilter, remove bool) ([]*CDR, int64, error) {
 	var cdrs []*CDR
 	q := sqls.db.Table(utils.CDRsTBL)
@@ -1457,7 +1572,10 @@ func (sqls *SQLStorage) GetTPResources(tpid, tenant, id string) ([]*utils.TPReso
 	if err := q.Find(&rls).Error; err != nil {
 		return nil, err
 	}
-	arls := rls.AsTPResources()
+	arls, err := rls.AsTPResources()
+	if err != nil {
+		return nil, err
+	}
 	if len(arls) == 0 {
 		return arls, utils.ErrNotFound
 	}
@@ -1495,7 +1613,10 @@ func (sqls *SQLStorage) GetTPStats(tpid, tenant, id string) ([]*utils.TPStatProf
 	if err := q.Find(&sts).Error; err != nil {
 		return nil, err
 	}
-	asts := sts.AsTPStats()
+	asts, err := sts.AsTPStats()
+	if err != nil {
+		return nil, err
+	}
 	if len(asts) == 0 {
 		return asts, utils.ErrNotFound
 	}
@@ -1552,7 +1673,10 @@ func (sqls *SQLStorage) GetTPThresholds(tpid, tenant, id string) ([]*utils.TPThr
 	if err := q.Find(&ths).Error; err != nil {
 		return nil, err
 	}
-	aths := ths.AsTPThreshold()
+	aths, err := ths.AsTPThreshold()
+	if err != nil {
+		return nil, err
+	}
 	if len(aths) == 0 {
 		return aths, utils.ErrNotFound
 	}
@@ -1590,7 +1714,10 @@ func (sqls *SQLStorage) GetTPRoutes(tpid, tenant, id string) ([]*utils.TPRoutePr
 	if err := q.Find(&tpRoutes).Error; err != nil {
 		return nil, err
 	}
-	aTpRoutes := tpRoutes.AsTPRouteProfile()
+	aTpRoutes, err := tpRoutes.AsTPRouteProfile()
+	if err != nil {
+		return nil, err
+	}
 	if len(aTpRoutes) == 0 {
 		return aTpRoutes, utils.ErrNotFound
 	}
@@ -1609,7 +1736,10 @@ func (sqls *SQLStorage) GetTPAttributes(tpid, tenant, id string) ([]*utils.TPAtt
 	if err := q.Find(&sps).Error; err != nil {
 		return nil, err
 	}
-	arls := sps.AsTPAttributes()
+	arls, err := sps.AsTPAttributes()
+	if err != nil {
+		return nil, err
+	}
 	if len(arls) == 0 {
 		return arls, utils.ErrNotFound
 	}
@@ -1628,7 +1758,10 @@ func (sqls *SQLStorage) GetTPChargers(tpid, tenant, id string) ([]*utils.TPCharg
 	if err := q.Find(&cpps).Error; err != nil {
 		return nil, err
 	}
-	arls := cpps.AsTPChargers()
+	arls, err := cpps.AsTPChargers()
+	if err != nil {
+		return nil, err
+	}
 	if len(arls) == 0 {
 		return arls, utils.ErrNotFound
 	}
@@ -1676,6 +1809,25 @@ func (sqls *SQLStorage) GetTPDispatcherHosts(tpid, tenant, id string) ([]*utils.
 	return arls, nil
 }
 
+func (sqls *SQLStorage) GetTPLookupTables(tpid, tenant, id string) ([]*utils.TPLookupTable, error) {
+	var lts LookupTableMdls
+	q := sqls.db.Where("tpid = ?", tpid)
+	if len(id) != 0 {
+		q = q.Where("id = ?", id)
+	}
+	if len(tenant) != 0 {
+		q = q.Where("tenant = ?", tenant)
+	}
+	if err := q.Find(&lts).Error; err != nil {
+		return nil, err
+	}
+	arls := lts.AsTPLookupTables()
+	if len(arls) == 0 {
+		return arls, utils.ErrNotFound
+	}
+	return arls, nil
+}
+
 // GetVersions returns slice of all versions or a specific version if tag is specified
 func (sqls *SQLStorage) GetVersions(itm string) (vrs Versions, err error) {
 	q := sqls.db.Model(&TBLVersion{})
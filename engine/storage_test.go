@@ -1670,6 +1670,50 @@ func TestIDBGetCDR(t *testing.T) {
 	}
 }
 
+func TestIDBGetCDRsSetupTimeInterval(t *testing.T) {
+	storDB, err := NewInternalDB(nil, nil, false, nil, config.CgrConfig().StorDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupTime := time.Date(2015, 12, 12, 14, 52, 0, 0, time.UTC)
+	for i, cgrID := range []string{"CGR1", "CGR2", "CGR3"} {
+		if err := storDB.SetCDR(&CDR{
+			CGRID:     cgrID,
+			RunID:     utils.MetaDefault,
+			OrderID:   int64(i),
+			Tenant:    "cgrates.org",
+			SetupTime: setupTime.Add(time.Duration(i) * time.Hour),
+		}, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tc := range []struct {
+		start, end *time.Time
+		exp        []string
+	}{
+		{nil, utils.TimePointer(setupTime.Add(time.Hour)), []string{"CGR1"}}, // end is not included
+		{nil, utils.TimePointer(setupTime.Add(time.Hour + time.Second)), []string{"CGR1", "CGR2"}},
+		{utils.TimePointer(setupTime.Add(time.Hour)), nil, []string{"CGR2", "CGR3"}}, // start is included
+		{utils.TimePointer(setupTime.Add(time.Hour)), utils.TimePointer(setupTime.Add(2 * time.Hour)), []string{"CGR2"}},
+	} {
+		cdrs, _, err := storDB.GetCDRs(&utils.CDRsFilter{
+			SetupTimeStart: tc.start,
+			SetupTimeEnd:   tc.end,
+		}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rcv := make([]string, len(cdrs))
+		for i, cdr := range cdrs {
+			rcv[i] = cdr.CGRID
+		}
+		sort.Strings(rcv)
+		if !reflect.DeepEqual(tc.exp, rcv) {
+			t.Errorf("start: %v, end: %v, expected %v, received %v", tc.start, tc.end, tc.exp, rcv)
+		}
+	}
+}
+
 func TestIDBGeTps(t *testing.T) {
 	storDB, err := NewInternalDB(nil, nil, false, nil, config.CgrConfig().StorDbCfg().Items)
 	if err != nil {
@@ -0,0 +1,1589 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewTracingDataDB returns a DataDB creating one span for each query
+func NewTracingDataDB(dataDB DataDB, dbType string) DataDB {
+	return &tracingDataDB{
+		DataDB: dataDB,
+		dbType: dbType,
+	}
+}
+
+// tracingDataDB records the queries done on the DataDB as spans
+type tracingDataDB struct {
+	DataDB
+	dbType      string
+	traceParent string // of the spans, each query starts a new trace if empty
+}
+
+// Unwrap returns the traced DataDB
+func (db *tracingDataDB) Unwrap() DataDB {
+	return db.DataDB
+}
+
+func (db *tracingDataDB) startSpan(method string) *utils.Span {
+	return startDBSpan(db.traceParent, "DataDB", db.dbType, method)
+}
+
+// NewTracingStorDB returns a StorDB creating one span for each query
+func NewTracingStorDB(storDB StorDB, dbType string) StorDB {
+	return &tracingStorDB{
+		StorDB: storDB,
+		dbType: dbType,
+	}
+}
+
+// tracingStorDB records the queries done on the StorDB as spans
+type tracingStorDB struct {
+	StorDB
+	dbType      string
+	traceParent string // of the spans, each query starts a new trace if empty
+}
+
+// Unwrap returns the traced StorDB
+func (db *tracingStorDB) Unwrap() StorDB {
+	return db.StorDB
+}
+
+func (db *tracingStorDB) startSpan(method string) *utils.Span {
+	return startDBSpan(db.traceParent, "StorDB", db.dbType, method)
+}
+
+// UnwrapDataDB returns the DataDB driver if dataDB is traced
+func UnwrapDataDB(dataDB DataDB) DataDB {
+	if trDB, isTraced := dataDB.(*tracingDataDB); isTraced {
+		return trDB.DataDB
+	}
+	return dataDB
+}
+
+// UnwrapStorDB returns the StorDB driver if storDB is traced
+func UnwrapStorDB(storDB StorDB) StorDB {
+	if trDB, isTraced := storDB.(*tracingStorDB); isTraced {
+		return trDB.StorDB
+	}
+	return storDB
+}
+
+// DataDBWithTraceParent returns the dataDB creating its spans as children of the span
+// identified by traceParent, usually the one of the API call doing the queries
+func DataDBWithTraceParent(dataDB DataDB, traceParent string) DataDB {
+	switch db := dataDB.(type) {
+	case *tracingDataDB:
+		return &tracingDataDB{
+			DataDB:      db.DataDB,
+			dbType:      db.dbType,
+			traceParent: traceParent,
+		}
+	case *DualDataDB:
+		return &DualDataDB{
+			DataDB:    DataDBWithTraceParent(db.DataDB, traceParent),
+			secondary: DataDBWithTraceParent(db.secondary, traceParent),
+			state:     db.state,
+		}
+	}
+	return dataDB
+}
+
+// StorDBWithTraceParent returns the storDB creating its spans as children of the span
+// identified by traceParent, usually the one of the API call doing the queries
+func StorDBWithTraceParent(storDB StorDB, traceParent string) StorDB {
+	if trDB, isTraced := storDB.(*tracingStorDB); isTraced && traceParent != utils.EmptyString {
+		return &tracingStorDB{
+			StorDB:      trDB.StorDB,
+			dbType:      trDB.dbType,
+			traceParent: traceParent,
+		}
+	}
+	return storDB
+}
+
+func startDBSpan(traceParent, db, dbType, method string) (span *utils.Span) {
+	if span = utils.Tracer.StartSpan(traceParent, db+utils.NestingSep+method, utils.MetaClient); span != nil {
+		span.SetAttribute("db.system", dbType)
+	}
+	return
+}
+
+// endDBSpan ends the span of a query, not finding the item is not considered an error
+func endDBSpan(span *utils.Span, err error) {
+	if err == utils.ErrNotFound {
+		span.SetAttribute("db.not_found", true)
+		err = nil
+	}
+	span.End(err)
+}
+
+// DataDB methods
+
+func (db *tracingDataDB) Flush(path string) error {
+	span := db.startSpan("Flush")
+	r0 := db.DataDB.Flush(path)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetKeysForPrefix(prefix, search string) ([]string, error) {
+	span := db.startSpan("GetKeysForPrefix")
+	r0, r1 := db.DataDB.GetKeysForPrefix(prefix, search)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) RemoveKeysForPrefix(prefix string) error {
+	span := db.startSpan("RemoveKeysForPrefix")
+	r0 := db.DataDB.RemoveKeysForPrefix(prefix)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetVersions(itm string) (Versions, error) {
+	span := db.startSpan("GetVersions")
+	r0, r1 := db.DataDB.GetVersions(itm)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetVersions(vrs Versions, overwrite bool) error {
+	span := db.startSpan("SetVersions")
+	r0 := db.DataDB.SetVersions(vrs, overwrite)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveVersions(vrs Versions) error {
+	span := db.startSpan("RemoveVersions")
+	r0 := db.DataDB.RemoveVersions(vrs)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) IsDBEmpty() (bool, error) {
+	span := db.startSpan("IsDBEmpty")
+	r0, r1 := db.DataDB.IsDBEmpty()
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) HasDataDrv(category, subject, tenant string) (bool, error) {
+	span := db.startSpan("HasDataDrv")
+	r0, r1 := db.DataDB.HasDataDrv(category, subject, tenant)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) GetRatingPlanDrv(id string) (*RatingPlan, error) {
+	span := db.startSpan("GetRatingPlanDrv")
+	r0, r1 := db.DataDB.GetRatingPlanDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetRatingPlanDrv(rp *RatingPlan) error {
+	span := db.startSpan("SetRatingPlanDrv")
+	r0 := db.DataDB.SetRatingPlanDrv(rp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveRatingPlanDrv(key string) error {
+	span := db.startSpan("RemoveRatingPlanDrv")
+	r0 := db.DataDB.RemoveRatingPlanDrv(key)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetRatingProfileDrv(id string) (*RatingProfile, error) {
+	span := db.startSpan("GetRatingProfileDrv")
+	r0, r1 := db.DataDB.GetRatingProfileDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetRatingProfileDrv(rp *RatingProfile) error {
+	span := db.startSpan("SetRatingProfileDrv")
+	r0 := db.DataDB.SetRatingProfileDrv(rp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveRatingProfileDrv(id string) error {
+	span := db.startSpan("RemoveRatingProfileDrv")
+	r0 := db.DataDB.RemoveRatingProfileDrv(id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetDestinationDrv(key, transactionID string) (*Destination, error) {
+	span := db.startSpan("GetDestinationDrv")
+	r0, r1 := db.DataDB.GetDestinationDrv(key, transactionID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetDestinationDrv(dest *Destination, transactionID string) error {
+	span := db.startSpan("SetDestinationDrv")
+	r0 := db.DataDB.SetDestinationDrv(dest, transactionID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveDestinationDrv(destID, transactionID string) error {
+	span := db.startSpan("RemoveDestinationDrv")
+	r0 := db.DataDB.RemoveDestinationDrv(destID, transactionID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveReverseDestinationDrv(dstID, prfx, transactionID string) error {
+	span := db.startSpan("RemoveReverseDestinationDrv")
+	r0 := db.DataDB.RemoveReverseDestinationDrv(dstID, prfx, transactionID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) SetReverseDestinationDrv(destID string, prefixes []string, transactionID string) error {
+	span := db.startSpan("SetReverseDestinationDrv")
+	r0 := db.DataDB.SetReverseDestinationDrv(destID, prefixes, transactionID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetReverseDestinationDrv(prefix, transactionID string) ([]string, error) {
+	span := db.startSpan("GetReverseDestinationDrv")
+	r0, r1 := db.DataDB.GetReverseDestinationDrv(prefix, transactionID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) GetPortedNumberDrv(number string) (string, error) {
+	span := db.startSpan("GetPortedNumberDrv")
+	r0, r1 := db.DataDB.GetPortedNumberDrv(number)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetPortedNumberDrv(number, rn string) error {
+	span := db.startSpan("SetPortedNumberDrv")
+	r0 := db.DataDB.SetPortedNumberDrv(number, rn)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) SetPortedNumbersDrv(pns []*PortedNumber) error {
+	span := db.startSpan("SetPortedNumbersDrv")
+	r0 := db.DataDB.SetPortedNumbersDrv(pns)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemovePortedNumberDrv(number string) error {
+	span := db.startSpan("RemovePortedNumberDrv")
+	r0 := db.DataDB.RemovePortedNumberDrv(number)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetLookupTableDrv(tenant, id string) (*LookupTable, error) {
+	span := db.startSpan("GetLookupTableDrv")
+	r0, r1 := db.DataDB.GetLookupTableDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetLookupTableDrv(lt *LookupTable) error {
+	span := db.startSpan("SetLookupTableDrv")
+	r0 := db.DataDB.SetLookupTableDrv(lt)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveLookupTableDrv(tenant, id string) error {
+	span := db.startSpan("RemoveLookupTableDrv")
+	r0 := db.DataDB.RemoveLookupTableDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetDiscountProfileDrv(tenant, id string) (*DiscountProfile, error) {
+	span := db.startSpan("GetDiscountProfileDrv")
+	r0, r1 := db.DataDB.GetDiscountProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetDiscountProfileDrv(dp *DiscountProfile) error {
+	span := db.startSpan("SetDiscountProfileDrv")
+	r0 := db.DataDB.SetDiscountProfileDrv(dp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveDiscountProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveDiscountProfileDrv")
+	r0 := db.DataDB.RemoveDiscountProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetFraudProfileDrv(tenant, id string) (*FraudProfile, error) {
+	span := db.startSpan("GetFraudProfileDrv")
+	r0, r1 := db.DataDB.GetFraudProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetFraudProfileDrv(fp *FraudProfile) error {
+	span := db.startSpan("SetFraudProfileDrv")
+	r0 := db.DataDB.SetFraudProfileDrv(fp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveFraudProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveFraudProfileDrv")
+	r0 := db.DataDB.RemoveFraudProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetFraudCaseDrv(tenant, id string) (*FraudCase, error) {
+	span := db.startSpan("GetFraudCaseDrv")
+	r0, r1 := db.DataDB.GetFraudCaseDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetFraudCaseDrv(fc *FraudCase) error {
+	span := db.startSpan("SetFraudCaseDrv")
+	r0 := db.DataDB.SetFraudCaseDrv(fc)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveFraudCaseDrv(tenant, id string) error {
+	span := db.startSpan("RemoveFraudCaseDrv")
+	r0 := db.DataDB.RemoveFraudCaseDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetRevisionsDrv(objType, id string) (*ObjectRevisions, error) {
+	span := db.startSpan("GetRevisionsDrv")
+	r0, r1 := db.DataDB.GetRevisionsDrv(objType, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetRevisionsDrv(rvs *ObjectRevisions) error {
+	span := db.startSpan("SetRevisionsDrv")
+	r0 := db.DataDB.SetRevisionsDrv(rvs)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetLoadRevisionsDrv(loadID int64) ([]*ObjectRevisions, error) {
+	span := db.startSpan("GetLoadRevisionsDrv")
+	r0, r1 := db.DataDB.GetLoadRevisionsDrv(loadID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) GetDataDBMigrationDrv() (*StoredDataDBMigration, error) {
+	span := db.startSpan("GetDataDBMigrationDrv")
+	r0, r1 := db.DataDB.GetDataDBMigrationDrv()
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetDataDBMigrationDrv(mig *StoredDataDBMigration) error {
+	span := db.startSpan("SetDataDBMigrationDrv")
+	r0 := db.DataDB.SetDataDBMigrationDrv(mig)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveDataDBMigrationDrv() error {
+	span := db.startSpan("RemoveDataDBMigrationDrv")
+	r0 := db.DataDB.RemoveDataDBMigrationDrv()
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetActionsDrv(id string) (Actions, error) {
+	span := db.startSpan("GetActionsDrv")
+	r0, r1 := db.DataDB.GetActionsDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetActionsDrv(id string, acts Actions) error {
+	span := db.startSpan("SetActionsDrv")
+	r0 := db.DataDB.SetActionsDrv(id, acts)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveActionsDrv(id string) error {
+	span := db.startSpan("RemoveActionsDrv")
+	r0 := db.DataDB.RemoveActionsDrv(id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetSharedGroupDrv(id string) (*SharedGroup, error) {
+	span := db.startSpan("GetSharedGroupDrv")
+	r0, r1 := db.DataDB.GetSharedGroupDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetSharedGroupDrv(sh *SharedGroup) error {
+	span := db.startSpan("SetSharedGroupDrv")
+	r0 := db.DataDB.SetSharedGroupDrv(sh)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveSharedGroupDrv(id string) error {
+	span := db.startSpan("RemoveSharedGroupDrv")
+	r0 := db.DataDB.RemoveSharedGroupDrv(id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetActionTriggersDrv(id string) (ActionTriggers, error) {
+	span := db.startSpan("GetActionTriggersDrv")
+	r0, r1 := db.DataDB.GetActionTriggersDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetActionTriggersDrv(id string, at ActionTriggers) error {
+	span := db.startSpan("SetActionTriggersDrv")
+	r0 := db.DataDB.SetActionTriggersDrv(id, at)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveActionTriggersDrv(id string) error {
+	span := db.startSpan("RemoveActionTriggersDrv")
+	r0 := db.DataDB.RemoveActionTriggersDrv(id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetActionPlanDrv(key string) (*ActionPlan, error) {
+	span := db.startSpan("GetActionPlanDrv")
+	r0, r1 := db.DataDB.GetActionPlanDrv(key)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetActionPlanDrv(key string, ats *ActionPlan) error {
+	span := db.startSpan("SetActionPlanDrv")
+	r0 := db.DataDB.SetActionPlanDrv(key, ats)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveActionPlanDrv(key string) error {
+	span := db.startSpan("RemoveActionPlanDrv")
+	r0 := db.DataDB.RemoveActionPlanDrv(key)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetAllActionPlansDrv() (map[string]*ActionPlan, error) {
+	span := db.startSpan("GetAllActionPlansDrv")
+	r0, r1 := db.DataDB.GetAllActionPlansDrv()
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) GetAccountActionPlansDrv(acntID string) ([]string, error) {
+	span := db.startSpan("GetAccountActionPlansDrv")
+	r0, r1 := db.DataDB.GetAccountActionPlansDrv(acntID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetAccountActionPlansDrv(acntID string, apIDs []string) error {
+	span := db.startSpan("SetAccountActionPlansDrv")
+	r0 := db.DataDB.SetAccountActionPlansDrv(acntID, apIDs)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemAccountActionPlansDrv(acntID string) error {
+	span := db.startSpan("RemAccountActionPlansDrv")
+	r0 := db.DataDB.RemAccountActionPlansDrv(acntID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) PushTask(t *Task) error {
+	span := db.startSpan("PushTask")
+	r0 := db.DataDB.PushTask(t)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) PopTask() (*Task, error) {
+	span := db.startSpan("PopTask")
+	r0, r1 := db.DataDB.PopTask()
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) GetAccountDrv(id string) (*Account, error) {
+	span := db.startSpan("GetAccountDrv")
+	r0, r1 := db.DataDB.GetAccountDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetAccountDrv(acc *Account) error {
+	span := db.startSpan("SetAccountDrv")
+	r0 := db.DataDB.SetAccountDrv(acc)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveAccountDrv(id string) error {
+	span := db.startSpan("RemoveAccountDrv")
+	r0 := db.DataDB.RemoveAccountDrv(id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetResourceProfileDrv(tenant, id string) (*ResourceProfile, error) {
+	span := db.startSpan("GetResourceProfileDrv")
+	r0, r1 := db.DataDB.GetResourceProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetResourceProfileDrv(rp *ResourceProfile) error {
+	span := db.startSpan("SetResourceProfileDrv")
+	r0 := db.DataDB.SetResourceProfileDrv(rp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveResourceProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveResourceProfileDrv")
+	r0 := db.DataDB.RemoveResourceProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetResourceDrv(tenant, id string) (*Resource, error) {
+	span := db.startSpan("GetResourceDrv")
+	r0, r1 := db.DataDB.GetResourceDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetResourceDrv(r *Resource) error {
+	span := db.startSpan("SetResourceDrv")
+	r0 := db.DataDB.SetResourceDrv(r)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveResourceDrv(tenant, id string) error {
+	span := db.startSpan("RemoveResourceDrv")
+	r0 := db.DataDB.RemoveResourceDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetIPProfileDrv(tenant, id string) (*IPProfile, error) {
+	span := db.startSpan("GetIPProfileDrv")
+	r0, r1 := db.DataDB.GetIPProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetIPProfileDrv(ipp *IPProfile) error {
+	span := db.startSpan("SetIPProfileDrv")
+	r0 := db.DataDB.SetIPProfileDrv(ipp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveIPProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveIPProfileDrv")
+	r0 := db.DataDB.RemoveIPProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetIPAllocationsDrv(tenant, id string) (*IPAllocations, error) {
+	span := db.startSpan("GetIPAllocationsDrv")
+	r0, r1 := db.DataDB.GetIPAllocationsDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetIPAllocationsDrv(ip *IPAllocations) error {
+	span := db.startSpan("SetIPAllocationsDrv")
+	r0 := db.DataDB.SetIPAllocationsDrv(ip)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveIPAllocationsDrv(tenant, id string) error {
+	span := db.startSpan("RemoveIPAllocationsDrv")
+	r0 := db.DataDB.RemoveIPAllocationsDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetTimingDrv(id string) (*utils.TPTiming, error) {
+	span := db.startSpan("GetTimingDrv")
+	r0, r1 := db.DataDB.GetTimingDrv(id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetTimingDrv(timing *utils.TPTiming) error {
+	span := db.startSpan("SetTimingDrv")
+	r0 := db.DataDB.SetTimingDrv(timing)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveTimingDrv(id string) error {
+	span := db.startSpan("RemoveTimingDrv")
+	r0 := db.DataDB.RemoveTimingDrv(id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetLoadHistory(limit int, skipCache bool, transactionID string) ([]*utils.LoadInstance, error) {
+	span := db.startSpan("GetLoadHistory")
+	r0, r1 := db.DataDB.GetLoadHistory(limit, skipCache, transactionID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) AddLoadHistory(ldInst *utils.LoadInstance, loadHistSize int, transactionID string) error {
+	span := db.startSpan("AddLoadHistory")
+	r0 := db.DataDB.AddLoadHistory(ldInst, loadHistSize, transactionID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetIndexesDrv(idxItmType, tntCtx string, idxKeys ...string) (map[string]utils.StringSet, error) {
+	span := db.startSpan("GetIndexesDrv")
+	r0, r1 := db.DataDB.GetIndexesDrv(idxItmType, tntCtx, idxKeys...)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetIndexesDrv(idxItmType, tntCtx string, indexes map[string]utils.StringSet, commit bool, transactionID string) error {
+	span := db.startSpan("SetIndexesDrv")
+	r0 := db.DataDB.SetIndexesDrv(idxItmType, tntCtx, indexes, commit, transactionID)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveIndexesDrv(idxItmType, tntCtx string, idxKeys ...string) error {
+	span := db.startSpan("RemoveIndexesDrv")
+	r0 := db.DataDB.RemoveIndexesDrv(idxItmType, tntCtx, idxKeys...)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetStatQueueProfileDrv(tenant, ID string) (*StatQueueProfile, error) {
+	span := db.startSpan("GetStatQueueProfileDrv")
+	r0, r1 := db.DataDB.GetStatQueueProfileDrv(tenant, ID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetStatQueueProfileDrv(sq *StatQueueProfile) error {
+	span := db.startSpan("SetStatQueueProfileDrv")
+	r0 := db.DataDB.SetStatQueueProfileDrv(sq)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemStatQueueProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemStatQueueProfileDrv")
+	r0 := db.DataDB.RemStatQueueProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetStatQueueDrv(tenant, id string) (*StatQueue, error) {
+	span := db.startSpan("GetStatQueueDrv")
+	r0, r1 := db.DataDB.GetStatQueueDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetStatQueueDrv(ssq *StoredStatQueue, sq *StatQueue) error {
+	span := db.startSpan("SetStatQueueDrv")
+	r0 := db.DataDB.SetStatQueueDrv(ssq, sq)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemStatQueueDrv(tenant, id string) error {
+	span := db.startSpan("RemStatQueueDrv")
+	r0 := db.DataDB.RemStatQueueDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) SetRankingProfileDrv(sq *RankingProfile) error {
+	span := db.startSpan("SetRankingProfileDrv")
+	r0 := db.DataDB.SetRankingProfileDrv(sq)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetRankingProfileDrv(tenant, id string) (*RankingProfile, error) {
+	span := db.startSpan("GetRankingProfileDrv")
+	r0, r1 := db.DataDB.GetRankingProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) RemRankingProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemRankingProfileDrv")
+	r0 := db.DataDB.RemRankingProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetRankingDrv(tenant, id string) (*Ranking, error) {
+	span := db.startSpan("GetRankingDrv")
+	r0, r1 := db.DataDB.GetRankingDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetRankingDrv(rn *Ranking) error {
+	span := db.startSpan("SetRankingDrv")
+	r0 := db.DataDB.SetRankingDrv(rn)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveRankingDrv(tenant, id string) error {
+	span := db.startSpan("RemoveRankingDrv")
+	r0 := db.DataDB.RemoveRankingDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) SetTrendProfileDrv(tr *TrendProfile) error {
+	span := db.startSpan("SetTrendProfileDrv")
+	r0 := db.DataDB.SetTrendProfileDrv(tr)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetTrendProfileDrv(tenant, id string) (*TrendProfile, error) {
+	span := db.startSpan("GetTrendProfileDrv")
+	r0, r1 := db.DataDB.GetTrendProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) RemTrendProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemTrendProfileDrv")
+	r0 := db.DataDB.RemTrendProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetTrendDrv(tenant, id string) (*Trend, error) {
+	span := db.startSpan("GetTrendDrv")
+	r0, r1 := db.DataDB.GetTrendDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetTrendDrv(tr *Trend) error {
+	span := db.startSpan("SetTrendDrv")
+	r0 := db.DataDB.SetTrendDrv(tr)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveTrendDrv(tenant, id string) error {
+	span := db.startSpan("RemoveTrendDrv")
+	r0 := db.DataDB.RemoveTrendDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetThresholdProfileDrv(tenant, ID string) (*ThresholdProfile, error) {
+	span := db.startSpan("GetThresholdProfileDrv")
+	r0, r1 := db.DataDB.GetThresholdProfileDrv(tenant, ID)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetThresholdProfileDrv(tp *ThresholdProfile) error {
+	span := db.startSpan("SetThresholdProfileDrv")
+	r0 := db.DataDB.SetThresholdProfileDrv(tp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemThresholdProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemThresholdProfileDrv")
+	r0 := db.DataDB.RemThresholdProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetThresholdDrv(tenant, id string) (*Threshold, error) {
+	span := db.startSpan("GetThresholdDrv")
+	r0, r1 := db.DataDB.GetThresholdDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetThresholdDrv(th *Threshold) error {
+	span := db.startSpan("SetThresholdDrv")
+	r0 := db.DataDB.SetThresholdDrv(th)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveThresholdDrv(tenant, id string) error {
+	span := db.startSpan("RemoveThresholdDrv")
+	r0 := db.DataDB.RemoveThresholdDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetFilterDrv(tenant, id string) (*Filter, error) {
+	span := db.startSpan("GetFilterDrv")
+	r0, r1 := db.DataDB.GetFilterDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetFilterDrv(fltr *Filter) error {
+	span := db.startSpan("SetFilterDrv")
+	r0 := db.DataDB.SetFilterDrv(fltr)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveFilterDrv(tenant, id string) error {
+	span := db.startSpan("RemoveFilterDrv")
+	r0 := db.DataDB.RemoveFilterDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetRouteProfileDrv(tenant, id string) (*RouteProfile, error) {
+	span := db.startSpan("GetRouteProfileDrv")
+	r0, r1 := db.DataDB.GetRouteProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetRouteProfileDrv(spp *RouteProfile) error {
+	span := db.startSpan("SetRouteProfileDrv")
+	r0 := db.DataDB.SetRouteProfileDrv(spp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveRouteProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveRouteProfileDrv")
+	r0 := db.DataDB.RemoveRouteProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetAttributeProfileDrv(tenant, id string) (*AttributeProfile, error) {
+	span := db.startSpan("GetAttributeProfileDrv")
+	r0, r1 := db.DataDB.GetAttributeProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetAttributeProfileDrv(attr *AttributeProfile) error {
+	span := db.startSpan("SetAttributeProfileDrv")
+	r0 := db.DataDB.SetAttributeProfileDrv(attr)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveAttributeProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveAttributeProfileDrv")
+	r0 := db.DataDB.RemoveAttributeProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetChargerProfileDrv(tenant, id string) (*ChargerProfile, error) {
+	span := db.startSpan("GetChargerProfileDrv")
+	r0, r1 := db.DataDB.GetChargerProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetChargerProfileDrv(chr *ChargerProfile) error {
+	span := db.startSpan("SetChargerProfileDrv")
+	r0 := db.DataDB.SetChargerProfileDrv(chr)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveChargerProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveChargerProfileDrv")
+	r0 := db.DataDB.RemoveChargerProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetDispatcherProfileDrv(tenant, id string) (*DispatcherProfile, error) {
+	span := db.startSpan("GetDispatcherProfileDrv")
+	r0, r1 := db.DataDB.GetDispatcherProfileDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetDispatcherProfileDrv(dpp *DispatcherProfile) error {
+	span := db.startSpan("SetDispatcherProfileDrv")
+	r0 := db.DataDB.SetDispatcherProfileDrv(dpp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveDispatcherProfileDrv(tenant, id string) error {
+	span := db.startSpan("RemoveDispatcherProfileDrv")
+	r0 := db.DataDB.RemoveDispatcherProfileDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetItemLoadIDsDrv(itemIDPrefix string) (map[string]int64, error) {
+	span := db.startSpan("GetItemLoadIDsDrv")
+	r0, r1 := db.DataDB.GetItemLoadIDsDrv(itemIDPrefix)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetLoadIDsDrv(loadIDs map[string]int64) error {
+	span := db.startSpan("SetLoadIDsDrv")
+	r0 := db.DataDB.SetLoadIDsDrv(loadIDs)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveLoadIDsDrv() error {
+	span := db.startSpan("RemoveLoadIDsDrv")
+	r0 := db.DataDB.RemoveLoadIDsDrv()
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetDispatcherHostDrv(tenant, id string) (*DispatcherHost, error) {
+	span := db.startSpan("GetDispatcherHostDrv")
+	r0, r1 := db.DataDB.GetDispatcherHostDrv(tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) SetDispatcherHostDrv(dpp *DispatcherHost) error {
+	span := db.startSpan("SetDispatcherHostDrv")
+	r0 := db.DataDB.SetDispatcherHostDrv(dpp)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RemoveDispatcherHostDrv(tenant, id string) error {
+	span := db.startSpan("RemoveDispatcherHostDrv")
+	r0 := db.DataDB.RemoveDispatcherHostDrv(tenant, id)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) SetBackupSessionsDrv(nodeID, tenant string, sessions []*StoredSession) error {
+	span := db.startSpan("SetBackupSessionsDrv")
+	r0 := db.DataDB.SetBackupSessionsDrv(nodeID, tenant, sessions)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) GetSessionsBackupDrv(nodeID, tenant string) ([]*StoredSession, error) {
+	span := db.startSpan("GetSessionsBackupDrv")
+	r0, r1 := db.DataDB.GetSessionsBackupDrv(nodeID, tenant)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingDataDB) RemoveSessionsBackupDrv(nodeID, tenant, cgrid string) error {
+	span := db.startSpan("RemoveSessionsBackupDrv")
+	r0 := db.DataDB.RemoveSessionsBackupDrv(nodeID, tenant, cgrid)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) DumpDataDB() error {
+	span := db.startSpan("DumpDataDB")
+	r0 := db.DataDB.DumpDataDB()
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RewriteDataDB() error {
+	span := db.startSpan("RewriteDataDB")
+	r0 := db.DataDB.RewriteDataDB()
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) BackupDataDB(backupFolderPath string, zip bool) error {
+	span := db.startSpan("BackupDataDB")
+	r0 := db.DataDB.BackupDataDB(backupFolderPath, zip)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) RestoreDataDB(backupFolderPath string) error {
+	span := db.startSpan("RestoreDataDB")
+	r0 := db.DataDB.RestoreDataDB(backupFolderPath)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingDataDB) SnapshotDataDB(backupFolderPath string, zip bool) error {
+	span := db.startSpan("SnapshotDataDB")
+	r0 := db.DataDB.SnapshotDataDB(backupFolderPath, zip)
+	endDBSpan(span, r0)
+	return r0
+}
+
+// StorDB methods
+
+func (db *tracingStorDB) Flush(path string) error {
+	span := db.startSpan("Flush")
+	r0 := db.StorDB.Flush(path)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) GetKeysForPrefix(prefix, search string) ([]string, error) {
+	span := db.startSpan("GetKeysForPrefix")
+	r0, r1 := db.StorDB.GetKeysForPrefix(prefix, search)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) RemoveKeysForPrefix(prefix string) error {
+	span := db.startSpan("RemoveKeysForPrefix")
+	r0 := db.StorDB.RemoveKeysForPrefix(prefix)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) GetVersions(itm string) (Versions, error) {
+	span := db.startSpan("GetVersions")
+	r0, r1 := db.StorDB.GetVersions(itm)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) SetVersions(vrs Versions, overwrite bool) error {
+	span := db.startSpan("SetVersions")
+	r0 := db.StorDB.SetVersions(vrs, overwrite)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) RemoveVersions(vrs Versions) error {
+	span := db.startSpan("RemoveVersions")
+	r0 := db.StorDB.RemoveVersions(vrs)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) IsDBEmpty() (bool, error) {
+	span := db.startSpan("IsDBEmpty")
+	r0, r1 := db.StorDB.IsDBEmpty()
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) SetCDR(cdr *CDR, allowUpdate bool) error {
+	span := db.startSpan("SetCDR")
+	r0 := db.StorDB.SetCDR(cdr, allowUpdate)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetAuditRecord(ar *AuditRecord) error {
+	span := db.startSpan("SetAuditRecord")
+	r0 := db.StorDB.SetAuditRecord(ar)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) GetAuditRecords(fltr *utils.AuditRecordFilter) ([]*AuditRecord, error) {
+	span := db.startSpan("GetAuditRecords")
+	r0, r1 := db.StorDB.GetAuditRecords(fltr)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) SetSMCost(smc *SMCost) error {
+	span := db.startSpan("SetSMCost")
+	r0 := db.StorDB.SetSMCost(smc)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) GetSMCosts(cgrid, runid, originHost, originIDPrfx string) ([]*SMCost, error) {
+	span := db.startSpan("GetSMCosts")
+	r0, r1 := db.StorDB.GetSMCosts(cgrid, runid, originHost, originIDPrfx)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) RemoveSMCost(smc *SMCost) error {
+	span := db.startSpan("RemoveSMCost")
+	r0 := db.StorDB.RemoveSMCost(smc)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) RemoveSMCosts(qryFltr *utils.SMCostFilter) error {
+	span := db.startSpan("RemoveSMCosts")
+	r0 := db.StorDB.RemoveSMCosts(qryFltr)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) GetCDRs(filter *utils.CDRsFilter, remove bool) ([]*CDR, int64, error) {
+	span := db.startSpan("GetCDRs")
+	r0, r1, r2 := db.StorDB.GetCDRs(filter, remove)
+	endDBSpan(span, r2)
+	return r0, r1, r2
+}
+
+func (db *tracingStorDB) GetTpIds(colName string) ([]string, error) {
+	span := db.startSpan("GetTpIds")
+	r0, r1 := db.StorDB.GetTpIds(colName)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTpTableIds(tpid, table string, distinct utils.TPDistinctIds, filters map[string]string, paginator *utils.PaginatorWithSearch) ([]string, error) {
+	span := db.startSpan("GetTpTableIds")
+	r0, r1 := db.StorDB.GetTpTableIds(tpid, table, distinct, filters, paginator)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPTimings(tpid, id string) ([]*utils.ApierTPTiming, error) {
+	span := db.startSpan("GetTPTimings")
+	r0, r1 := db.StorDB.GetTPTimings(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPDestinations(tpid, id string) ([]*utils.TPDestination, error) {
+	span := db.startSpan("GetTPDestinations")
+	r0, r1 := db.StorDB.GetTPDestinations(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPRates(tpid, id string) ([]*utils.TPRateRALs, error) {
+	span := db.startSpan("GetTPRates")
+	r0, r1 := db.StorDB.GetTPRates(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPDestinationRates(tpid, id string, paginator *utils.Paginator) ([]*utils.TPDestinationRate, error) {
+	span := db.startSpan("GetTPDestinationRates")
+	r0, r1 := db.StorDB.GetTPDestinationRates(tpid, id, paginator)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPRatingPlans(tpid, id string, paginator *utils.Paginator) ([]*utils.TPRatingPlan, error) {
+	span := db.startSpan("GetTPRatingPlans")
+	r0, r1 := db.StorDB.GetTPRatingPlans(tpid, id, paginator)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPRatingProfiles(filter *utils.TPRatingProfile) ([]*utils.TPRatingProfile, error) {
+	span := db.startSpan("GetTPRatingProfiles")
+	r0, r1 := db.StorDB.GetTPRatingProfiles(filter)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPSharedGroups(tpid, id string) ([]*utils.TPSharedGroups, error) {
+	span := db.startSpan("GetTPSharedGroups")
+	r0, r1 := db.StorDB.GetTPSharedGroups(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPActions(tpid, id string) ([]*utils.TPActions, error) {
+	span := db.startSpan("GetTPActions")
+	r0, r1 := db.StorDB.GetTPActions(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPActionPlans(tpid, id string) ([]*utils.TPActionPlan, error) {
+	span := db.startSpan("GetTPActionPlans")
+	r0, r1 := db.StorDB.GetTPActionPlans(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPActionTriggers(tpid, id string) ([]*utils.TPActionTriggers, error) {
+	span := db.startSpan("GetTPActionTriggers")
+	r0, r1 := db.StorDB.GetTPActionTriggers(tpid, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPAccountActions(filter *utils.TPAccountActions) ([]*utils.TPAccountActions, error) {
+	span := db.startSpan("GetTPAccountActions")
+	r0, r1 := db.StorDB.GetTPAccountActions(filter)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPResources(tpid, tenant, id string) ([]*utils.TPResourceProfile, error) {
+	span := db.startSpan("GetTPResources")
+	r0, r1 := db.StorDB.GetTPResources(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPIPs(tpid, tenant, id string) ([]*utils.TPIPProfile, error) {
+	span := db.startSpan("GetTPIPs")
+	r0, r1 := db.StorDB.GetTPIPs(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPStats(tpid, tenant, id string) ([]*utils.TPStatProfile, error) {
+	span := db.startSpan("GetTPStats")
+	r0, r1 := db.StorDB.GetTPStats(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPTrends(tpid, tenant, id string) ([]*utils.TPTrendsProfile, error) {
+	span := db.startSpan("GetTPTrends")
+	r0, r1 := db.StorDB.GetTPTrends(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPRankings(tpid, tenant, id string) ([]*utils.TPRankingProfile, error) {
+	span := db.startSpan("GetTPRankings")
+	r0, r1 := db.StorDB.GetTPRankings(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPThresholds(tpid, tenant, id string) ([]*utils.TPThresholdProfile, error) {
+	span := db.startSpan("GetTPThresholds")
+	r0, r1 := db.StorDB.GetTPThresholds(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPFilters(tpid, tenant, id string) ([]*utils.TPFilterProfile, error) {
+	span := db.startSpan("GetTPFilters")
+	r0, r1 := db.StorDB.GetTPFilters(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPRoutes(tpid, tenant, id string) ([]*utils.TPRouteProfile, error) {
+	span := db.startSpan("GetTPRoutes")
+	r0, r1 := db.StorDB.GetTPRoutes(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPAttributes(tpid, tenant, id string) ([]*utils.TPAttributeProfile, error) {
+	span := db.startSpan("GetTPAttributes")
+	r0, r1 := db.StorDB.GetTPAttributes(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPChargers(tpid, tenant, id string) ([]*utils.TPChargerProfile, error) {
+	span := db.startSpan("GetTPChargers")
+	r0, r1 := db.StorDB.GetTPChargers(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPDispatcherProfiles(tpid, tenant, id string) ([]*utils.TPDispatcherProfile, error) {
+	span := db.startSpan("GetTPDispatcherProfiles")
+	r0, r1 := db.StorDB.GetTPDispatcherProfiles(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPDispatcherHosts(tpid, tenant, id string) ([]*utils.TPDispatcherHost, error) {
+	span := db.startSpan("GetTPDispatcherHosts")
+	r0, r1 := db.StorDB.GetTPDispatcherHosts(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) GetTPLookupTables(tpid, tenant, id string) ([]*utils.TPLookupTable, error) {
+	span := db.startSpan("GetTPLookupTables")
+	r0, r1 := db.StorDB.GetTPLookupTables(tpid, tenant, id)
+	endDBSpan(span, r1)
+	return r0, r1
+}
+
+func (db *tracingStorDB) RemTpData(table, tpid string, args map[string]string) error {
+	span := db.startSpan("RemTpData")
+	r0 := db.StorDB.RemTpData(table, tpid, args)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPTimings(timings []*utils.ApierTPTiming) error {
+	span := db.startSpan("SetTPTimings")
+	r0 := db.StorDB.SetTPTimings(timings)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPDestinations(dests []*utils.TPDestination) error {
+	span := db.startSpan("SetTPDestinations")
+	r0 := db.StorDB.SetTPDestinations(dests)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPRates(rates []*utils.TPRateRALs) error {
+	span := db.startSpan("SetTPRates")
+	r0 := db.StorDB.SetTPRates(rates)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPDestinationRates(dRates []*utils.TPDestinationRate) error {
+	span := db.startSpan("SetTPDestinationRates")
+	r0 := db.StorDB.SetTPDestinationRates(dRates)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPRatingPlans(ratingPlans []*utils.TPRatingPlan) error {
+	span := db.startSpan("SetTPRatingPlans")
+	r0 := db.StorDB.SetTPRatingPlans(ratingPlans)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPRatingProfiles(ratingProfiles []*utils.TPRatingProfile) error {
+	span := db.startSpan("SetTPRatingProfiles")
+	r0 := db.StorDB.SetTPRatingProfiles(ratingProfiles)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPSharedGroups(groups []*utils.TPSharedGroups) error {
+	span := db.startSpan("SetTPSharedGroups")
+	r0 := db.StorDB.SetTPSharedGroups(groups)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPActions(acts []*utils.TPActions) error {
+	span := db.startSpan("SetTPActions")
+	r0 := db.StorDB.SetTPActions(acts)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPActionPlans(aPlans []*utils.TPActionPlan) error {
+	span := db.startSpan("SetTPActionPlans")
+	r0 := db.StorDB.SetTPActionPlans(aPlans)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPActionTriggers(aTriggers []*utils.TPActionTriggers) error {
+	span := db.startSpan("SetTPActionTriggers")
+	r0 := db.StorDB.SetTPActionTriggers(aTriggers)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPAccountActions(accActions []*utils.TPAccountActions) error {
+	span := db.startSpan("SetTPAccountActions")
+	r0 := db.StorDB.SetTPAccountActions(accActions)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPResources(resources []*utils.TPResourceProfile) error {
+	span := db.startSpan("SetTPResources")
+	r0 := db.StorDB.SetTPResources(resources)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPIPs(ips []*utils.TPIPProfile) error {
+	span := db.startSpan("SetTPIPs")
+	r0 := db.StorDB.SetTPIPs(ips)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPStats(stats []*utils.TPStatProfile) error {
+	span := db.startSpan("SetTPStats")
+	r0 := db.StorDB.SetTPStats(stats)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPTrends(trends []*utils.TPTrendsProfile) error {
+	span := db.startSpan("SetTPTrends")
+	r0 := db.StorDB.SetTPTrends(trends)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPRankings(rankings []*utils.TPRankingProfile) error {
+	span := db.startSpan("SetTPRankings")
+	r0 := db.StorDB.SetTPRankings(rankings)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPThresholds(thresholds []*utils.TPThresholdProfile) error {
+	span := db.startSpan("SetTPThresholds")
+	r0 := db.StorDB.SetTPThresholds(thresholds)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPFilters(filters []*utils.TPFilterProfile) error {
+	span := db.startSpan("SetTPFilters")
+	r0 := db.StorDB.SetTPFilters(filters)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPRoutes(routes []*utils.TPRouteProfile) error {
+	span := db.startSpan("SetTPRoutes")
+	r0 := db.StorDB.SetTPRoutes(routes)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPAttributes(attributes []*utils.TPAttributeProfile) error {
+	span := db.startSpan("SetTPAttributes")
+	r0 := db.StorDB.SetTPAttributes(attributes)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPChargers(cpps []*utils.TPChargerProfile) error {
+	span := db.startSpan("SetTPChargers")
+	r0 := db.StorDB.SetTPChargers(cpps)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPDispatcherProfiles(dpps []*utils.TPDispatcherProfile) error {
+	span := db.startSpan("SetTPDispatcherProfiles")
+	r0 := db.StorDB.SetTPDispatcherProfiles(dpps)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPDispatcherHosts(dpps []*utils.TPDispatcherHost) error {
+	span := db.startSpan("SetTPDispatcherHosts")
+	r0 := db.StorDB.SetTPDispatcherHosts(dpps)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SetTPLookupTables(lts []*utils.TPLookupTable) error {
+	span := db.startSpan("SetTPLookupTables")
+	r0 := db.StorDB.SetTPLookupTables(lts)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) DumpStorDB() error {
+	span := db.startSpan("DumpStorDB")
+	r0 := db.StorDB.DumpStorDB()
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) RewriteStorDB() error {
+	span := db.startSpan("RewriteStorDB")
+	r0 := db.StorDB.RewriteStorDB()
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) BackupStorDB(backupFolderPath string, zip bool) error {
+	span := db.startSpan("BackupStorDB")
+	r0 := db.StorDB.BackupStorDB(backupFolderPath, zip)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) RestoreStorDB(backupFolderPath string) error {
+	span := db.startSpan("RestoreStorDB")
+	r0 := db.StorDB.RestoreStorDB(backupFolderPath)
+	endDBSpan(span, r0)
+	return r0
+}
+
+func (db *tracingStorDB) SnapshotStorDB(backupFolderPath string, zip bool) error {
+	span := db.startSpan("SnapshotStorDB")
+	r0 := db.StorDB.SnapshotStorDB(backupFolderPath, zip)
+	endDBSpan(span, r0)
+	return r0
+}
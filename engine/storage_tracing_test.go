@@ -0,0 +1,117 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTracingDataDB(t *testing.T) {
+	memExp := utils.NewMemorySpanExporter(-1)
+	utils.Tracer.Load(1, true, memExp)
+	defer utils.Tracer.Close()
+	cfg := config.NewDefaultCGRConfig()
+	idb, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataDB := NewTracingDataDB(idb, utils.MetaInternal)
+	if UnwrapDataDB(dataDB) != idb {
+		t.Error("Expected the internal DataDB")
+	}
+	if _, err = dataDB.GetFilterDrv("cgrates.org", "FLTR_1"); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err = dataDB.SetFilterDrv(&Filter{Tenant: "cgrates.org", ID: "FLTR_1"}); err != nil {
+		t.Fatal(err)
+	}
+	spans := memExp.Spans(utils.EmptyString)
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, received %s", utils.ToJSON(spans))
+	}
+	if spans[0].Name != "DataDB.GetFilterDrv" || spans[0].Error != utils.EmptyString ||
+		spans[0].Attributes["db.not_found"] != true ||
+		spans[0].Attributes["db.system"] != utils.MetaInternal {
+		t.Errorf("Unexpected span: %s", utils.ToJSON(spans[0]))
+	}
+	if spans[1].Name != "DataDB.SetFilterDrv" {
+		t.Errorf("Unexpected span: %s", utils.ToJSON(spans[1]))
+	}
+	if dataDB.GetStorageType() != utils.MetaInternal {
+		t.Errorf("Expected %s, received %s", utils.MetaInternal, dataDB.GetStorageType())
+	}
+	if len(memExp.Spans(utils.EmptyString)) != 2 {
+		t.Error("Expected no span for GetStorageType")
+	}
+
+	storDB := NewTracingStorDB(idb, utils.MetaInternal)
+	if UnwrapStorDB(storDB) != idb {
+		t.Error("Expected the internal StorDB")
+	}
+	if _, err = storDB.GetTpIds(utils.EmptyString); err != nil && err != utils.ErrNotFound {
+		t.Error(err)
+	}
+	if spans = memExp.Spans(utils.EmptyString); len(spans) != 3 || spans[2].Name != "StorDB.GetTpIds" {
+		t.Errorf("Unexpected spans: %s", utils.ToJSON(spans))
+	}
+}
+
+func TestTracingDBTraceParent(t *testing.T) {
+	memExp := utils.NewMemorySpanExporter(-1)
+	utils.Tracer.Load(1, true, memExp)
+	defer utils.Tracer.Close()
+	cfg := config.NewDefaultCGRConfig()
+	idb, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiSpan := utils.Tracer.StartSpan(utils.EmptyString, utils.AttributeSv1ProcessEvent, utils.MetaServer)
+	dm := NewDataManager(NewTracingDataDB(idb, utils.MetaInternal), cfg.CacheCfg(), nil)
+	if dm.WithTraceParent(utils.EmptyString) != dm {
+		t.Error("Expected the DataManager without trace parent")
+	}
+	if _, err = dm.withAPIOpts(map[string]any{
+		utils.OptsTraceParent: apiSpan.TraceParent(),
+	}).GetFilter("cgrates.org", "FLTR_1", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	storDB := StorDBWithTraceParent(NewTracingStorDB(idb, utils.MetaInternal), apiSpan.TraceParent())
+	if _, err = storDB.GetTpIds(utils.EmptyString); err != nil && err != utils.ErrNotFound {
+		t.Error(err)
+	}
+	if _, err = dm.GetFilter("cgrates.org", "FLTR_2", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	apiSpan.End(nil)
+	spans := memExp.Spans(apiSpan.TraceID)
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, received %s", utils.ToJSON(spans))
+	}
+	if spans[0].Name != "DataDB.GetFilterDrv" || spans[0].ParentSpanID != apiSpan.SpanID ||
+		spans[1].Name != "StorDB.GetTpIds" || spans[1].ParentSpanID != apiSpan.SpanID {
+		t.Errorf("Unexpected spans: %s", utils.ToJSON(spans))
+	}
+	if spans = memExp.Spans(utils.EmptyString); len(spans) != 4 ||
+		spans[2].Name != "DataDB.GetFilterDrv" || spans[2].TraceID == apiSpan.TraceID {
+		t.Errorf("Unexpected spans: %s", utils.ToJSON(spans))
+	}
+}
@@ -59,6 +59,9 @@ func NewDataDBConn(dbType, host, port, name, user,
 	default:
 		err = fmt.Errorf("unsupported db_type <%s>", dbType)
 	}
+	if err == nil && utils.Tracer.DBSpans() {
+		d = NewTracingDataDB(d, dbType)
+	}
 	return
 }
 
@@ -82,6 +85,9 @@ func NewStorDBConn(dbType, host, port, name, user, pass, marshaler string,
 		err = fmt.Errorf("unknown db '%s' valid options are [%s, %s, %s, %s]",
 			dbType, utils.MetaMySQL, utils.MetaMongo, utils.MetaPostgres, utils.MetaInternal)
 	}
+	if err == nil && utils.Tracer.DBSpans() {
+		db = NewTracingStorDB(db, dbType)
+	}
 	return
 }
 
@@ -114,8 +120,10 @@ type SMCost struct {
 	OriginHost  string
 	OriginID    string
 	CostSource  string
+	Account     string // tenant:account which paid the costs
 	Usage       time.Duration
 	CostDetails *EventCost
+	CreatedAt   time.Time // set by StorDB when empty
 }
 
 // Clone clones SMCost
@@ -129,12 +137,29 @@ func (s *SMCost) Clone() *SMCost {
 		OriginHost:  s.OriginHost,
 		OriginID:    s.OriginID,
 		CostSource:  s.CostSource,
+		Account:     s.Account,
 		Usage:       s.Usage,
 		CostDetails: s.CostDetails.Clone(),
+		CreatedAt:   s.CreatedAt,
 	}
 	return clone
 }
 
+// passTimeFilters checks the Usage and CreatedAt intervals of the filter,
+// an unknown creation time does not pass the CreatedAt interval
+func (s *SMCost) passTimeFilters(fltr *utils.SMCostFilter) bool {
+	if (fltr.Usage.Min != nil && s.Usage < *fltr.Usage.Min) ||
+		(fltr.Usage.Max != nil && s.Usage >= *fltr.Usage.Max) {
+		return false
+	}
+	if fltr.CreatedAt.Begin == nil && fltr.CreatedAt.End == nil {
+		return true
+	}
+	return !s.CreatedAt.IsZero() &&
+		(fltr.CreatedAt.Begin == nil || !s.CreatedAt.Before(*fltr.CreatedAt.Begin)) &&
+		(fltr.CreatedAt.End == nil || s.CreatedAt.Before(*fltr.CreatedAt.End))
+}
+
 // CacheClone returns a clone of SMCost used by ltcache CacheCloner
 func (s *SMCost) CacheClone() any {
 	return s.Clone()
@@ -0,0 +1,242 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cgrates/birpc"
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// SubscribeArgs is used to subscribe a BiRPC client for pushed notifications
+type SubscribeArgs struct {
+	Tenant     string
+	ID         string   // optional, generated if missing
+	EventTypes []string // subscribe to all the event types of the service if empty
+	FilterIDs  []string // checked against the notified event
+	APIOpts    map[string]any
+}
+
+// GetTenant returns the tenant checked when authorizing the API calls
+func (sa *SubscribeArgs) GetTenant() string {
+	if sa == nil {
+		return utils.EmptyString
+	}
+	return sa.Tenant
+}
+
+// SubscriptionNotification is pushed towards the subscriber with SubscriberV1.Notify
+type SubscriptionNotification struct {
+	SubscriptionID string
+	Sequence       uint64 // increased for each matching event, gaps signal dropped notifications
+	Event          *utils.CGREvent
+}
+
+// subscription is a subscribe request of one client
+type subscription struct {
+	tenant     string
+	eventTypes utils.StringSet
+	filterIDs  []string
+	sequence   atomic.Uint64
+}
+
+// subscriber holds the subscriptions of one BiRPC client together with
+// the queue of notifications waiting to be pushed towards it
+type subscriber struct {
+	conn  birpc.ClientConnector
+	subs  map[string]*subscription
+	queue chan *SubscriptionNotification
+	stop  chan struct{}
+}
+
+// NewSubscriptions constructs the Subscriptions for the given event types
+func NewSubscriptions(cfg *config.CGRConfig, fltrS *FilterS, eventTypes ...string) *Subscriptions {
+	return &Subscriptions{
+		cfg:        cfg,
+		fltrS:      fltrS,
+		eventTypes: utils.NewStringSet(eventTypes),
+		clnts:      make(map[birpc.ClientConnector]*subscriber),
+	}
+}
+
+// Subscriptions pushes the events of a service towards the BiRPC clients subscribed to them.
+// Slow clients do not block the service: once their queue is full the notifications are dropped.
+type Subscriptions struct {
+	cfg        *config.CGRConfig
+	fltrS      *FilterS
+	eventTypes utils.StringSet // event types published by the service
+
+	mux   sync.RWMutex // protects clnts
+	clnts map[birpc.ClientConnector]*subscriber
+}
+
+// Subscribe adds a subscription for the client c, returning its ID
+func (s *Subscriptions) Subscribe(c birpc.ClientConnector, args *SubscribeArgs) (subID string, err error) {
+	if c == nil {
+		return utils.EmptyString, utils.ErrNotConnected
+	}
+	for _, evType := range args.EventTypes {
+		if !s.eventTypes.Has(evType) {
+			return utils.EmptyString, fmt.Errorf("unsupported event type: <%s>", evType)
+		}
+	}
+	sub := &subscription{
+		tenant:     utils.FirstNonEmpty(args.Tenant, s.cfg.GeneralCfg().DefaultTenant),
+		eventTypes: utils.NewStringSet(args.EventTypes),
+		filterIDs:  slices.Clone(args.FilterIDs),
+	}
+	if len(sub.eventTypes) == 0 {
+		sub.eventTypes = s.eventTypes
+	}
+	subID = utils.FirstNonEmpty(args.ID, utils.UUIDSha1Prefix())
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	sbr, has := s.clnts[c]
+	if !has {
+		sbr = &subscriber{
+			conn:  c,
+			subs:  make(map[string]*subscription),
+			queue: make(chan *SubscriptionNotification, max(s.cfg.GeneralCfg().SubscriberQueueLen, 0)),
+			stop:  make(chan struct{}),
+		}
+		s.clnts[c] = sbr
+		go s.notifyLoop(sbr)
+	}
+	sbr.subs[subID] = sub
+	return
+}
+
+// Unsubscribe removes the subscription with subID of the client c
+func (s *Subscriptions) Unsubscribe(c birpc.ClientConnector, subID string) (err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	sbr, has := s.clnts[c]
+	if !has {
+		return utils.ErrNotFound
+	}
+	if _, has = sbr.subs[subID]; !has {
+		return utils.ErrNotFound
+	}
+	delete(sbr.subs, subID)
+	if len(sbr.subs) == 0 {
+		s.removeSubscriber(c, sbr)
+	}
+	return
+}
+
+// RemoveClient drops all the subscriptions of the client c, called on disconnect
+func (s *Subscriptions) RemoveClient(c birpc.ClientConnector) {
+	if s == nil {
+		return
+	}
+	s.mux.Lock()
+	if sbr, has := s.clnts[c]; has {
+		s.removeSubscriber(c, sbr)
+	}
+	s.mux.Unlock()
+}
+
+// Close drops all the subscribers
+func (s *Subscriptions) Close() {
+	if s == nil {
+		return
+	}
+	s.mux.Lock()
+	for c, sbr := range s.clnts {
+		s.removeSubscriber(c, sbr)
+	}
+	s.mux.Unlock()
+}
+
+// removeSubscriber stops pushing towards the subscriber, not thread safe
+func (s *Subscriptions) removeSubscriber(c birpc.ClientConnector, sbr *subscriber) {
+	close(sbr.stop)
+	delete(s.clnts, c)
+}
+
+// HasSubscribers is used to avoid building the events when nobody listens
+func (s *Subscriptions) HasSubscribers() bool {
+	if s == nil {
+		return false
+	}
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return len(s.clnts) != 0
+}
+
+// Publish queues the event towards the subscriptions matching it
+func (s *Subscriptions) Publish(ev *utils.CGREvent) {
+	evType := utils.IfaceAsString(ev.Event[utils.EventType])
+	evNm := utils.MapStorage{
+		utils.MetaReq:  ev.Event,
+		utils.MetaOpts: ev.APIOpts,
+	}
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	for _, sbr := range s.clnts {
+		for subID, sub := range sbr.subs {
+			if sub.tenant != ev.Tenant ||
+				!sub.eventTypes.Has(evType) {
+				continue
+			}
+			if pass, err := s.fltrS.Pass(ev.Tenant, sub.filterIDs, evNm); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: %s checking filters of subscription <%s>",
+						utils.CoreS, err.Error(), subID))
+				continue
+			} else if !pass {
+				continue
+			}
+			n := &SubscriptionNotification{
+				SubscriptionID: subID,
+				Sequence:       sub.sequence.Add(1),
+				Event:          ev,
+			}
+			select {
+			case sbr.queue <- n:
+			default: // slow subscriber, the gap in Sequence lets it know to resync
+			}
+		}
+	}
+}
+
+// notifyLoop pushes the queued notifications towards the subscriber until it is removed
+func (s *Subscriptions) notifyLoop(sbr *subscriber) {
+	for {
+		select {
+		case <-sbr.stop:
+			return
+		case n := <-sbr.queue:
+			ctx, cancel := context.WithTimeout(context.Background(), s.cfg.GeneralCfg().ReplyTimeout)
+			var rply string
+			if err := sbr.conn.Call(ctx, utils.SubscriberV1Notify, n, &rply); err != nil {
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: %s notifying subscription <%s>",
+						utils.CoreS, err.Error(), n.SubscriptionID))
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestSubscriptions(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	cfg.GeneralCfg().SubscriberQueueLen = 1
+	subs := NewSubscriptions(cfg, NewFilterS(cfg, nil, nil), utils.StatUpdate, utils.ThresholdHit)
+
+	started := make(chan *SubscriptionNotification, 10)
+	release := make(chan struct{})
+	clnt := &ccMock{
+		calls: map[string]func(ctx *context.Context, args, reply any) error{
+			utils.SubscriberV1Notify: func(ctx *context.Context, args, reply any) error {
+				started <- args.(*SubscriptionNotification)
+				<-release
+				*reply.(*string) = utils.OK
+				return nil
+			},
+		},
+	}
+	if _, err := subs.Subscribe(nil, &SubscribeArgs{}); err != utils.ErrNotConnected {
+		t.Errorf("expected %v, received %v", utils.ErrNotConnected, err)
+	}
+	if _, err := subs.Subscribe(clnt, &SubscribeArgs{EventTypes: []string{utils.SessionStart}}); err == nil {
+		t.Error("expected unsupported event type error")
+	}
+	if subs.HasSubscribers() {
+		t.Error("expected no subscribers")
+	}
+	subID, err := subs.Subscribe(clnt, &SubscribeArgs{
+		EventTypes: []string{utils.StatUpdate},
+		FilterIDs:  []string{"*string:~*req.StatID:SQ1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	statUpdate := func(tnt, sqID string) *utils.CGREvent {
+		return &utils.CGREvent{
+			Tenant: tnt,
+			Event: map[string]any{
+				utils.EventType: utils.StatUpdate,
+				utils.StatID:    sqID,
+			},
+		}
+	}
+
+	subs.Publish(statUpdate("cgrates.org", "SQ1"))
+	var n *SubscriptionNotification
+	select {
+	case n = <-started:
+	case <-time.After(time.Second):
+		t.Fatal("notification not pushed")
+	}
+	if n.SubscriptionID != subID || n.Sequence != 1 {
+		t.Errorf("unexpected notification: %s", utils.ToJSON(n))
+	}
+
+	// not matching the tenant, the filters or the event types
+	subs.Publish(statUpdate("itsyscom.com", "SQ1"))
+	subs.Publish(statUpdate("cgrates.org", "SQ2"))
+	subs.Publish(&utils.CGREvent{
+		Tenant: "cgrates.org",
+		Event: map[string]any{
+			utils.EventType: utils.ThresholdHit,
+			utils.StatID:    "SQ1",
+		},
+	})
+
+	// first one is queued while the client is busy, the second one is dropped
+	subs.Publish(statUpdate("cgrates.org", "SQ1"))
+	subs.Publish(statUpdate("cgrates.org", "SQ1"))
+	release <- struct{}{}
+	select {
+	case n = <-started:
+	case <-time.After(time.Second):
+		t.Fatal("notification not pushed")
+	}
+	if n.Sequence != 2 {
+		t.Errorf("expected sequence 2, received %d", n.Sequence)
+	}
+	release <- struct{}{}
+	subs.Publish(statUpdate("cgrates.org", "SQ1"))
+	select {
+	case n = <-started:
+	case <-time.After(time.Second):
+		t.Fatal("notification not pushed")
+	}
+	if n.Sequence != 4 {
+		t.Errorf("expected sequence 4, received %d", n.Sequence)
+	}
+	release <- struct{}{}
+
+	if err := subs.Unsubscribe(clnt, "unknown"); err != utils.ErrNotFound {
+		t.Errorf("expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if err := subs.Unsubscribe(clnt, subID); err != nil {
+		t.Error(err)
+	}
+	if subs.HasSubscribers() {
+		t.Error("expected no subscribers")
+	}
+
+	if _, err := subs.Subscribe(clnt, &SubscribeArgs{}); err != nil {
+		t.Fatal(err)
+	}
+	subs.RemoveClient(clnt)
+	subs.Publish(statUpdate("cgrates.org", "SQ1"))
+	select {
+	case n = <-started:
+		t.Errorf("unexpected notification after disconnect: %s", utils.ToJSON(n))
+	case <-time.After(50 * time.Millisecond):
+	}
+}
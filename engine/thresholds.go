@@ -49,8 +49,9 @@ type ThresholdProfile struct {
 	MaxHits            int
 	MinHits            int
 	MinSleep           time.Duration
-	Blocker            bool    // blocker flag to stop processing on filters matched
-	Weight             float64 // Weight to sort the thresholds
+	Blocker            bool                 // blocker flag to stop processing on filters matched
+	Weight             float64              // Weight to sort the thresholds
+	Weights            utils.DynamicWeights // first matching one wins over Weight
 	ActionIDs          []string
 	Async              bool
 	EeIDs              []string
@@ -58,6 +59,14 @@ type ThresholdProfile struct {
 	lkID string // holds the reference towards guardian lock key
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (tp *ThresholdProfile) GetTenant() string {
+	if tp == nil {
+		return utils.EmptyString
+	}
+	return tp.Tenant
+}
+
 // Clone clones *ThresholdProfile (lkID excluded)
 func (tp *ThresholdProfile) Clone() *ThresholdProfile {
 	if tp == nil {
@@ -71,6 +80,7 @@ func (tp *ThresholdProfile) Clone() *ThresholdProfile {
 		MinSleep: tp.MinSleep,
 		Blocker:  tp.Blocker,
 		Weight:   tp.Weight,
+		Weights:  tp.Weights.Clone(),
 		Async:    tp.Async,
 	}
 	if tp.FilterIDs != nil {
@@ -330,6 +340,7 @@ func NewThresholdService(dm *DataManager, cgrcfg *config.CGRConfig, filterS *Fil
 		storedTdIDs:   make(utils.StringSet),
 		sBiRPCClients: utils.NewServiceBiRPCClients(),
 		clientConnID:  make(map[string]*utils.SyConnIDs),
+		subs:          NewSubscriptions(cgrcfg, filterS, utils.ThresholdHit),
 	}
 }
 
@@ -346,6 +357,7 @@ type ThresholdService struct {
 	sBiRPCClients *utils.ServiceBiRPCClients  // will hold ThresholdS BiRPC clients and conns
 	ccidMux       sync.RWMutex                // protects clientConnID
 	clientConnID  map[string]*utils.SyConnIDs // holds ClientConnID per threshold profile, used for birpc calls. [ThresholdProfileID]SyConnIds
+	subs          *Subscriptions              // BiRPC clients subscribed to the threshold hits
 }
 
 // Reload stops the backupLoop and restarts it
@@ -366,6 +378,7 @@ func (tS *ThresholdService) Shutdown() {
 	utils.Logger.Info("<ThresholdS> shutdown initialized")
 	close(tS.stopBackup)
 	tS.storeThresholds()
+	tS.subs.Close()
 	utils.Logger.Info("<ThresholdS> shutdown complete")
 }
 
@@ -377,6 +390,7 @@ func (tS *ThresholdService) OnBiJSONConnect(c birpc.ClientConnector) {
 // OnBiJSONDisconnect handles client disconnects
 func (tS *ThresholdService) OnBiJSONDisconnect(c birpc.ClientConnector) {
 	tS.sBiRPCClients.OnBiJSONDisconnect(c)
+	tS.subs.RemoveClient(c)
 }
 
 // backup will regularly store thresholds changed to dataDB
@@ -458,6 +472,7 @@ func (tS *ThresholdService) StoreThreshold(t *Threshold) (err error) {
 
 // matchingThresholdsForEvent returns ordered list of matching thresholds which are active for an Event
 func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.CGREvent) (ts Thresholds, err error) {
+	dm := tS.dm.withAPIOpts(args.APIOpts)
 	evNm := utils.MapStorage{
 		utils.MetaReq:  args.Event,
 		utils.MetaOpts: args.APIOpts,
@@ -480,7 +495,7 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 			tS.cgrcfg.ThresholdSCfg().PrefixIndexedFields,
 			tS.cgrcfg.ThresholdSCfg().SuffixIndexedFields,
 			tS.cgrcfg.ThresholdSCfg().ExistsIndexedFields,
-			tS.dm, utils.CacheThresholdFilterIndexes, tnt,
+			dm, utils.CacheThresholdFilterIndexes, tnt,
 			tS.cgrcfg.ThresholdSCfg().IndexedSelects,
 			tS.cgrcfg.ThresholdSCfg().NestedFields,
 		)
@@ -493,12 +508,13 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 	itemIDs := slices.Sorted(maps.Keys(tIDs))
 
 	ts = make(Thresholds, 0, len(itemIDs))
+	weights := make(map[string]float64) // weights of the matching profiles for this event
 	for _, id := range itemIDs {
 		lkPrflID := guardian.Guardian.GuardIDs("",
 			config.CgrConfig().GeneralCfg().LockingTimeout,
 			thresholdProfileLockKey(tnt, id))
 		var tPrfl *ThresholdProfile
-		if tPrfl, err = tS.dm.GetThresholdProfile(tnt, id, true, true, utils.NonTransactional); err != nil {
+		if tPrfl, err = dm.GetThresholdProfile(tnt, id, true, true, utils.NonTransactional); err != nil {
 			guardian.Guardian.UnguardIDs(lkPrflID)
 			if err == utils.ErrNotFound {
 				err = nil
@@ -525,11 +541,17 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 				continue
 			}
 		}
+		if weights[tPrfl.ID], err = weightForEvent(tPrfl.Weights, tPrfl.Weight,
+			tS.filterS, tnt, evNm); err != nil {
+			tPrfl.unlock()
+			ts.unlock()
+			return nil, err
+		}
 		lkID := guardian.Guardian.GuardIDs(utils.EmptyString,
 			config.CgrConfig().GeneralCfg().LockingTimeout,
 			thresholdLockKey(tPrfl.Tenant, tPrfl.ID))
 		var t *Threshold
-		if t, err = tS.dm.GetThreshold(tPrfl.Tenant, tPrfl.ID, true, true, ""); err != nil {
+		if t, err = dm.GetThreshold(tPrfl.Tenant, tPrfl.ID, true, true, ""); err != nil {
 			guardian.Guardian.UnguardIDs(lkID)
 			tPrfl.unlock()
 			if err == utils.ErrNotFound { // corner case where the threshold was removed due to MaxHits
@@ -550,7 +572,7 @@ func (tS *ThresholdService) matchingThresholdsForEvent(tnt string, args *utils.C
 	if len(ts) == 0 {
 		return nil, utils.ErrNotFound
 	}
-	ts.Sort()
+	sort.Slice(ts, func(i, j int) bool { return weights[ts[i].ID] > weights[ts[j].ID] })
 	for i, t := range ts {
 		if t.tPrfl.Blocker && i != len(ts)-1 { // blocker will stop processing and we are not at last index
 			Thresholds(ts[i+1:]).unlock()
@@ -639,6 +661,19 @@ func (tS *ThresholdService) processEvent(tnt string, args *utils.CGREvent) (thre
 				}
 			}
 			t.Snooze = time.Now().Add(t.tPrfl.MinSleep)
+			if tS.subs.HasSubscribers() {
+				tS.subs.Publish(&utils.CGREvent{
+					Tenant: t.Tenant,
+					ID:     utils.GenUUID(),
+					Time:   utils.TimePointer(time.Now()),
+					Event: map[string]any{
+						utils.EventType: utils.ThresholdHit,
+						utils.ID:        t.ID,
+						utils.Hits:      t.Hits,
+						utils.Snooze:    t.Snooze,
+					},
+				})
+			}
 			if err = tS.processEEs(args.APIOpts, t); err != nil {
 				utils.Logger.Warning(
 					fmt.Sprintf("<ThresholdService> received error: %s when processing with EEs.", err.Error()))
@@ -809,3 +844,24 @@ func (tS *ThresholdService) BiRPCv1RemoveClientConnID(ctx *context.Context,
 	*reply = utils.OK
 	return nil
 }
+
+// BiRPCv1Subscribe will push the threshold hits towards the BiRPC client, replying with the subscription ID
+func (tS *ThresholdService) BiRPCv1Subscribe(ctx *context.Context,
+	args *SubscribeArgs, reply *string) (err error) {
+	var subID string
+	if subID, err = tS.subs.Subscribe(ctx.Client, args); err != nil {
+		return
+	}
+	*reply = subID
+	return
+}
+
+// BiRPCv1Unsubscribe will remove the subscription with the given ID
+func (tS *ThresholdService) BiRPCv1Unsubscribe(ctx *context.Context,
+	subID string, reply *string) (err error) {
+	if err = tS.subs.Unsubscribe(ctx.Client, subID); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
@@ -430,6 +430,21 @@ func (tpExp *TPExporter) Run() error {
 		}
 	}
 
+	storDataLookupTables, err := tpExp.storDb.GetTPLookupTables(tpExp.tpID, "", "")
+	if err != nil && err.Error() != utils.ErrNotFound.Error() {
+		utils.Logger.Warning(fmt.Sprintf("<%s> error: %s, when getting %s from stordb for export", utils.ApierS, err, utils.TpLookupTables))
+		withError = true
+	}
+
+	if len(storDataLookupTables) != 0 {
+		toExportMap[utils.LookupTablesCsv] = make([]any, 0, len(storDataLookupTables))
+		for _, sd := range storDataLookupTables {
+			for _, sdModel := range APItoModelTPLookupTable(sd) {
+				toExportMap[utils.LookupTablesCsv] = append(toExportMap[utils.LookupTablesCsv], sdModel)
+			}
+		}
+	}
+
 	if len(toExportMap) == 0 { // if we don't have anything to export we return not found error
 		return utils.ErrNotFound
 	}
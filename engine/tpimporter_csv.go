@@ -62,6 +62,7 @@ var fileHandlers = map[string]func(*TPCSVImporter, string) error{
 	utils.ChargersCsv:           (*TPCSVImporter).importChargerProfiles,
 	utils.DispatcherProfilesCsv: (*TPCSVImporter).importDispatcherProfiles,
 	utils.DispatcherHostsCsv:    (*TPCSVImporter).importDispatcherHosts,
+	utils.LookupTablesCsv:       (*TPCSVImporter).importLookupTables,
 }
 
 func (tpImp *TPCSVImporter) Run() error {
@@ -396,3 +397,14 @@ func (tpImp *TPCSVImporter) importDispatcherHosts(fn string) error {
 	}
 	return tpImp.StorDb.SetTPDispatcherHosts(dpps)
 }
+
+func (tpImp *TPCSVImporter) importLookupTables(fn string) error {
+	if tpImp.Verbose {
+		log.Printf("Processing file: <%s> ", fn)
+	}
+	lts, err := tpImp.csvr.GetTPLookupTables(tpImp.TPid, "", "")
+	if err != nil {
+		return err
+	}
+	return tpImp.StorDb.SetTPLookupTables(lts)
+}
@@ -0,0 +1,615 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+// Severities of the tariff plan lint issues
+const (
+	TPLintError   = "error"
+	TPLintWarning = "warning"
+)
+
+// lintKnownReqFields are the event fields the lint will not warn about when
+// referenced by filters, on top of the main CDR fields. Next to the session
+// fields they cover the events the subsystems send to ThresholdS and EEs
+var lintKnownReqFields = utils.NewStringSet([]string{utils.LastUsed, utils.PDD,
+	utils.Route, utils.DisconnectCause, utils.EventName, utils.OriginIDPrefix,
+	utils.EventType, utils.EventSource, utils.AccountID, utils.BalanceID,
+	utils.BalanceType, utils.Units, utils.ExpiryTime, utils.AllowNegative,
+	utils.Disabled, utils.ResourceID, utils.TotalUsage, utils.StatID,
+	utils.Metrics, utils.TrendID, utils.RankingID, utils.IndexType})
+
+// TPLocations indexes the file and line where each tariff plan item was first
+// defined, on file type (ie: Destinations.csv) and item key (ie: Tenant:ID)
+type TPLocations map[string]map[string]string
+
+// Get returns the location of the item built out of keyFlds or empty string if unknown
+func (locs TPLocations) Get(fileType string, keyFlds ...string) string {
+	return locs[fileType][utils.ConcatenatedKey(keyFlds...)]
+}
+
+// add records the current line of the reader as the location of the item in record
+func (locs TPLocations) add(listType any, fileName string, rdr csvReaderCloser, record []string) {
+	lnRdr, canLocate := rdr.(interface{ line() int })
+	if !canLocate {
+		return
+	}
+	fileType, nrKeyFlds := tpLocationKey(listType)
+	if fileType == utils.EmptyString || len(record) < nrKeyFlds {
+		return
+	}
+	key := utils.ConcatenatedKey(record[:nrKeyFlds]...)
+	if _, has := locs[fileType]; !has {
+		locs[fileType] = make(map[string]string)
+	}
+	if _, has := locs[fileType][key]; !has {
+		locs[fileType][key] = fmt.Sprintf("%s:%d", fileName, lnRdr.line())
+	}
+}
+
+// tpLocationKey returns the file type of the model together with the number
+// of leading columns identifying the item
+func tpLocationKey(listType any) (string, int) {
+	switch listType.(type) {
+	case TimingMdl:
+		return utils.TimingsCsv, 1
+	case DestinationMdl:
+		return utils.DestinationsCsv, 1
+	case RateMdl:
+		return utils.RatesCsv, 1
+	case DestinationRateMdl:
+		return utils.DestinationRatesCsv, 1
+	case RatingPlanMdl:
+		return utils.RatingPlansCsv, 1
+	case RatingProfileMdl:
+		return utils.RatingProfilesCsv, 3
+	case SharedGroupMdl:
+		return utils.SharedGroupsCsv, 1
+	case ActionMdl:
+		return utils.ActionsCsv, 1
+	case ActionPlanMdl:
+		return utils.ActionPlansCsv, 1
+	case ActionTriggerMdl:
+		return utils.ActionTriggersCsv, 1
+	case AccountActionMdl:
+		return utils.AccountActionsCsv, 2
+	case ResourceMdl:
+		return utils.ResourcesCsv, 2
+	case IPMdl:
+		return utils.IPsCsv, 2
+	case StatMdl:
+		return utils.StatsCsv, 2
+	case TrendsMdl:
+		return utils.TrendsCsv, 2
+	case RankingsMdl:
+		return utils.RankingsCsv, 2
+	case ThresholdMdl:
+		return utils.ThresholdsCsv, 2
+	case FilterMdl:
+		return utils.FiltersCsv, 2
+	case RouteMdl:
+		return utils.RoutesCsv, 2
+	case AttributeMdl:
+		return utils.AttributesCsv, 2
+	case ChargerMdl:
+		return utils.ChargersCsv, 2
+	case DispatcherProfileMdl:
+		return utils.DispatcherProfilesCsv, 2
+	case DispatcherHostMdl:
+		return utils.DispatcherHostsCsv, 2
+	case LookupTableMdl:
+		return utils.LookupTablesCsv, 2
+	}
+	return utils.EmptyString, 0
+}
+
+// TPLintIssue is a problem found while linting the tariff plan
+type TPLintIssue struct {
+	Severity string // TPLintError or TPLintWarning
+	Location string // file:line where the offending item is defined, empty if unknown
+	Message  string
+}
+
+func (li *TPLintIssue) String() string {
+	if li.Location == utils.EmptyString {
+		return fmt.Sprintf("%s: %s", li.Severity, li.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", li.Location, li.Severity, li.Message)
+}
+
+// TPLintIssues is the list of issues found while linting the tariff plan
+type TPLintIssues []*TPLintIssue
+
+// HasErrors returns true if any of the issues is an error
+func (lis TPLintIssues) HasErrors() bool {
+	for _, li := range lis {
+		if li.Severity == TPLintError {
+			return true
+		}
+	}
+	return false
+}
+
+// Lint builds the tariff plan model out of the LoadReader and reports both
+// the loading errors and the semantic problems found in the tariff plan.
+// locs, if not nil, is used to point the issues at the file and line of the offending items
+func (tpr *TpReader) Lint(locs TPLocations) (issues TPLintIssues) {
+	l := &tpLinter{tpr: tpr, locs: locs, reported: make(utils.StringSet)}
+	for _, step := range []struct {
+		fileType string
+		load     func() error
+	}{
+		{utils.DestinationsCsv, tpr.LoadDestinations},
+		{utils.TimingsCsv, tpr.LoadTimings},
+		{utils.RatesCsv, tpr.LoadRates},
+		{utils.DestinationRatesCsv, tpr.LoadDestinationRates},
+		{utils.RatingPlansCsv, tpr.LoadRatingPlans},
+		{utils.RatingProfilesCsv, tpr.LoadRatingProfiles},
+		{utils.SharedGroupsCsv, tpr.LoadSharedGroups},
+		{utils.ActionsCsv, tpr.LoadActions},
+		{utils.ActionPlansCsv, tpr.LoadActionPlans},
+		{utils.ActionTriggersCsv, tpr.LoadActionTriggers},
+		{utils.AccountActionsCsv, tpr.LoadAccountActions},
+		{utils.FiltersCsv, tpr.LoadFilters},
+		{utils.ResourcesCsv, tpr.LoadResourceProfiles},
+		{utils.IPsCsv, tpr.LoadIPProfiles},
+		{utils.StatsCsv, tpr.LoadStats},
+		{utils.TrendsCsv, tpr.LoadTrends},
+		{utils.RankingsCsv, tpr.LoadRankings},
+		{utils.ThresholdsCsv, tpr.LoadThresholds},
+		{utils.RoutesCsv, tpr.LoadRouteProfiles},
+		{utils.AttributesCsv, tpr.LoadAttributeProfiles},
+		{utils.ChargersCsv, tpr.LoadChargerProfiles},
+		{utils.DispatcherProfilesCsv, tpr.LoadDispatcherProfiles},
+		{utils.DispatcherHostsCsv, tpr.LoadDispatcherHosts},
+		{utils.LookupTablesCsv, tpr.LoadLookupTables},
+	} {
+		if err := step.load(); err != nil && err.Error() != utils.NotFoundCaps {
+			l.loadErrs = append(l.loadErrs, &TPLintIssue{Severity: TPLintError,
+				Message: fmt.Sprintf("cannot load %s: %v", step.fileType, err)})
+			l.loadErrFiles = append(l.loadErrFiles, step.fileType)
+		}
+	}
+	l.readTP()
+	l.lintRatingReferences()
+	l.lintRatingPlans()
+	l.lintFilters()
+	l.lintUnused()
+	// the load errors are only kept if the checks did not point out the culprit already
+	for i, li := range l.loadErrs {
+		if !l.reported.Has(l.loadErrFiles[i]) {
+			l.issues = append(l.issues, li)
+		}
+	}
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		if l.issues[i].Location != l.issues[j].Location {
+			return l.issues[i].Location < l.issues[j].Location
+		}
+		return l.issues[i].Message < l.issues[j].Message
+	})
+	return l.issues
+}
+
+// tpLinter holds the raw tariff plan data checked by TpReader.Lint
+type tpLinter struct {
+	tpr          *TpReader
+	locs         TPLocations
+	issues       TPLintIssues
+	loadErrs     TPLintIssues
+	loadErrFiles []string
+	reported     utils.StringSet // file types with errors already reported
+
+	destinations   []*utils.TPDestination
+	destRates      []*utils.TPDestinationRate
+	ratingPlans    []*utils.TPRatingPlan
+	ratingProfiles []*utils.TPRatingProfile
+	actions        []*utils.TPActions
+	actionPlans    []*utils.TPActionPlan
+	actionTriggers []*utils.TPActionTriggers
+	filters        []*utils.TPFilterProfile
+	thresholds     []*utils.TPThresholdProfile
+}
+
+// readTP reads the raw tariff plan, the errors being reported by the load steps
+func (l *tpLinter) readTP() {
+	tpid := l.tpr.tpid
+	lr := l.tpr.lr
+	l.destinations, _ = lr.GetTPDestinations(tpid, utils.EmptyString)
+	l.destRates, _ = lr.GetTPDestinationRates(tpid, utils.EmptyString, nil)
+	l.ratingPlans, _ = lr.GetTPRatingPlans(tpid, utils.EmptyString, nil)
+	l.ratingProfiles, _ = lr.GetTPRatingProfiles(&utils.TPRatingProfile{TPid: tpid})
+	l.actions, _ = lr.GetTPActions(tpid, utils.EmptyString)
+	l.actionPlans, _ = lr.GetTPActionPlans(tpid, utils.EmptyString)
+	l.actionTriggers, _ = lr.GetTPActionTriggers(tpid, utils.EmptyString)
+	l.filters, _ = lr.GetTPFilters(tpid, utils.EmptyString, utils.EmptyString)
+	l.thresholds, _ = lr.GetTPThresholds(tpid, utils.EmptyString, utils.EmptyString)
+}
+
+func (l *tpLinter) report(severity, fileType string, keyFlds []string, format string, args ...any) {
+	if severity == TPLintError {
+		l.reported.Add(fileType)
+	}
+	l.issues = append(l.issues, &TPLintIssue{
+		Severity: severity,
+		Location: l.locs.Get(fileType, keyFlds...),
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// existsInDB checks the DataDB for items not defined by the tariff plan
+func (l *tpLinter) existsInDB(prefix, key string) bool {
+	if l.tpr.dm.db() == nil {
+		return false
+	}
+	has, err := l.tpr.dm.HasData(prefix, key, utils.EmptyString)
+	return err == nil && has
+}
+
+// lintRatingReferences reports the references to undefined rating items
+func (l *tpLinter) lintRatingReferences() {
+	dstIDs := make(utils.StringSet)
+	for _, dst := range l.destinations {
+		dstIDs.Add(dst.ID)
+	}
+	for _, drs := range l.destRates {
+		for _, dr := range drs.DestinationRates {
+			if dr.DestinationId != utils.MetaAny && !dstIDs.Has(dr.DestinationId) &&
+				!l.existsInDB(utils.DestinationPrefix, dr.DestinationId) {
+				l.report(TPLintError, utils.DestinationRatesCsv, []string{drs.ID},
+					"DestinationRate %s references missing Destination %s", drs.ID, dr.DestinationId)
+			}
+			if _, has := l.tpr.rates[dr.RateId]; !has {
+				l.report(TPLintError, utils.DestinationRatesCsv, []string{drs.ID},
+					"DestinationRate %s references missing Rate %s", drs.ID, dr.RateId)
+			}
+		}
+	}
+	drIDs := make(utils.StringSet)
+	for _, drs := range l.destRates {
+		drIDs.Add(drs.ID)
+	}
+	rpIDs := make(utils.StringSet)
+	for _, rp := range l.ratingPlans {
+		rpIDs.Add(rp.ID)
+		for _, rpb := range rp.RatingPlanBindings {
+			if !drIDs.Has(rpb.DestinationRatesId) {
+				l.report(TPLintError, utils.RatingPlansCsv, []string{rp.ID},
+					"RatingPlan %s references missing DestinationRate %s", rp.ID, rpb.DestinationRatesId)
+			}
+			if _, has := l.tpr.timings[rpb.TimingId]; !has {
+				l.report(TPLintError, utils.RatingPlansCsv, []string{rp.ID},
+					"RatingPlan %s references missing Timing %s", rp.ID, rpb.TimingId)
+			}
+		}
+	}
+	for _, rpf := range l.ratingProfiles {
+		keyFlds := []string{rpf.Tenant, rpf.Category, rpf.Subject}
+		for _, ra := range rpf.RatingPlanActivations {
+			if !rpIDs.Has(ra.RatingPlanId) &&
+				!l.existsInDB(utils.RatingPlanPrefix, ra.RatingPlanId) {
+				l.report(TPLintError, utils.RatingProfilesCsv, keyFlds,
+					"RatingProfile %s references missing RatingPlan %s",
+					utils.ConcatenatedKey(keyFlds...), ra.RatingPlanId)
+			}
+		}
+	}
+}
+
+// lintRatingPlans reports the time slots left unrated and the prefixes rated
+// ambiguously by the rating plans
+func (l *tpLinter) lintRatingPlans() {
+	drs := make(map[string]*utils.TPDestinationRate)
+	for _, dr := range l.destRates {
+		drs[dr.ID] = dr
+	}
+	prefixes := make(map[string][]string) // prefixes on destination ID
+	for _, dst := range l.destinations {
+		prefixes[dst.ID] = dst.Prefixes
+	}
+	for _, rp := range l.ratingPlans {
+		// intervals of each destination in the plan
+		dstTimings := make(map[string][]*utils.TPTiming)
+		type prefixRating struct {
+			dstID, rateID string
+		}
+		// ratings of each prefix on the timing and weight they are active on
+		prefixRatings := make(map[string][]prefixRating)
+		for _, rpb := range rp.RatingPlanBindings {
+			dr, has := drs[rpb.DestinationRatesId]
+			tm, hasTm := l.tpr.timings[rpb.TimingId]
+			if !has || !hasTm {
+				continue // reported as missing reference
+			}
+			for _, d := range dr.DestinationRates {
+				dstTimings[d.DestinationId] = append(dstTimings[d.DestinationId], tm)
+				for _, prfx := range prefixes[d.DestinationId] {
+					slot := utils.ConcatenatedKey(prfx, rpb.TimingId, strconv.FormatFloat(rpb.Weight, 'f', -1, 64))
+					prefixRatings[slot] = append(prefixRatings[slot],
+						prefixRating{dstID: d.DestinationId, rateID: d.RateId})
+				}
+			}
+		}
+		for dstID, tms := range dstTimings {
+			for _, gap := range ratingGaps(tms) {
+				l.report(TPLintError, utils.RatingPlansCsv, []string{rp.ID},
+					"RatingPlan %s leaves Destination %s unrated %s", rp.ID, dstID, gap)
+			}
+		}
+		conflicts := make(utils.StringSet)
+		for slot, ratings := range prefixRatings {
+			for _, r := range ratings[1:] {
+				if r.dstID != ratings[0].dstID && r.rateID != ratings[0].rateID {
+					conflicts.Add(fmt.Sprintf("RatingPlan %s rates prefix %s with both %s (Destination %s) and %s (Destination %s) at the same weight",
+						rp.ID, strings.SplitN(slot, utils.ConcatenatedKeySep, 2)[0],
+						ratings[0].rateID, ratings[0].dstID, r.rateID, r.dstID))
+				}
+			}
+		}
+		for _, msg := range conflicts.AsOrderedSlice() {
+			l.report(TPLintError, utils.RatingPlansCsv, []string{rp.ID}, "%s", msg)
+		}
+		if loaded, has := l.tpr.ratingPlans[rp.ID]; has {
+			if rt := loaded.getFirstUnsaneRating(); rt != utils.EmptyString {
+				l.report(TPLintError, utils.RatingPlansCsv, []string{rp.ID},
+					"RatingPlan %s contains rates with invalid rate slots", rp.ID)
+			}
+			if tm := loaded.getFirstUnsaneTiming(); tm != utils.EmptyString {
+				l.report(TPLintError, utils.RatingPlansCsv, []string{rp.ID},
+					"RatingPlan %s contains timings constrained by both dates and week days", rp.ID)
+			}
+		}
+	}
+}
+
+// ratingGaps returns the time slots of the week not covered by the recurrent
+// timings, grouped on the days sharing the same gap. Destinations rated only
+// on special dates are considered intentional and are not reported
+func ratingGaps(tms []*utils.TPTiming) (gaps []string) {
+	const day = 24 * time.Hour
+	if !slices.ContainsFunc(tms, func(tm *utils.TPTiming) bool {
+		return len(tm.Years) == 0 && len(tm.Months) == 0 && len(tm.MonthDays) == 0
+	}) {
+		return
+	}
+	days := make(map[string][]string) // gap on day names, to keep the report compact
+	var gapOrder []string
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		type interval struct{ start, end time.Duration }
+		var intervals []interval
+		for _, tm := range tms {
+			if len(tm.Years) != 0 || len(tm.Months) != 0 || len(tm.MonthDays) != 0 {
+				continue // special dates are not covering the week
+			}
+			if len(tm.WeekDays) != 0 && !slices.Contains(tm.WeekDays, wd) {
+				continue
+			}
+			start, err := lintTimeOfDay(tm.StartTime, 0)
+			if err != nil {
+				continue // not a time of day (ie: *asap)
+			}
+			end, err := lintTimeOfDay(tm.EndTime, day)
+			if err != nil {
+				continue
+			}
+			intervals = append(intervals, interval{start, end})
+		}
+		sort.Slice(intervals, func(i, j int) bool { return intervals[i].start < intervals[j].start })
+		var covered time.Duration
+		for _, iv := range intervals {
+			if iv.start > covered {
+				gap := fmt.Sprintf("between %s and %s", lintClock(covered), lintClock(iv.start))
+				if _, has := days[gap]; !has {
+					gapOrder = append(gapOrder, gap)
+				}
+				days[gap] = append(days[gap], wd.String())
+			}
+			covered = max(covered, iv.end)
+		}
+		if covered < day {
+			gap := fmt.Sprintf("between %s and %s", lintClock(covered), lintClock(day))
+			if _, has := days[gap]; !has {
+				gapOrder = append(gapOrder, gap)
+			}
+			days[gap] = append(days[gap], wd.String())
+		}
+	}
+	for _, gap := range gapOrder {
+		gaps = append(gaps, fmt.Sprintf("on %s %s", strings.Join(days[gap], ", "), gap))
+	}
+	return
+}
+
+// lintTimeOfDay parses the hh:mm:ss time of day, returning dflt for empty string
+func lintTimeOfDay(s string, dflt time.Duration) (time.Duration, error) {
+	if s == utils.EmptyString {
+		return dflt, nil
+	}
+	t, err := time.Parse(time.TimeOnly, s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second, nil
+}
+
+func lintClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+// lintFilters reports the references to undefined filters and the filter
+// rules built on fields the events are not known to carry
+func (l *tpLinter) lintFilters() {
+	fltrIDs := make(utils.StringSet)
+	for _, fltr := range l.filters {
+		fltrIDs.Add(utils.ConcatenatedKey(fltr.Tenant, fltr.ID))
+	}
+	checkRefs := func(fileType, tenant, id string, fltrs []string) {
+		for _, fltrID := range fltrs {
+			if strings.HasPrefix(fltrID, utils.Meta) { // inline filter
+				continue
+			}
+			tntFltrID := utils.ConcatenatedKey(tenant, fltrID)
+			if !fltrIDs.Has(tntFltrID) && !l.existsInDB(utils.FilterPrefix, tntFltrID) {
+				l.report(TPLintError, fileType, []string{tenant, id},
+					"%s %s references missing Filter %s",
+					strings.TrimSuffix(fileType, utils.CSVSuffix), utils.ConcatenatedKey(tenant, id), fltrID)
+			}
+		}
+	}
+	tpr := l.tpr
+	for _, prf := range tpr.resProfiles {
+		checkRefs(utils.ResourcesCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+	}
+	for _, prf := range tpr.ipProfiles {
+		checkRefs(utils.IPsCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+	}
+	for _, prf := range tpr.sqProfiles {
+		checkRefs(utils.StatsCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+	}
+	for _, prf := range tpr.thProfiles {
+		checkRefs(utils.ThresholdsCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+	}
+	for _, prf := range tpr.routeProfiles {
+		checkRefs(utils.RoutesCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+		for _, rt := range prf.Routes {
+			checkRefs(utils.RoutesCsv, prf.Tenant, prf.ID, rt.FilterIDs)
+		}
+	}
+	for _, prf := range tpr.attributeProfiles {
+		checkRefs(utils.AttributesCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+		for _, attr := range prf.Attributes {
+			checkRefs(utils.AttributesCsv, prf.Tenant, prf.ID, attr.FilterIDs)
+		}
+	}
+	for _, prf := range tpr.chargerProfiles {
+		checkRefs(utils.ChargersCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+	}
+	for _, prf := range tpr.dispatcherProfiles {
+		checkRefs(utils.DispatcherProfilesCsv, prf.Tenant, prf.ID, prf.FilterIDs)
+		for _, hst := range prf.Hosts {
+			checkRefs(utils.DispatcherProfilesCsv, prf.Tenant, prf.ID, hst.FilterIDs)
+		}
+	}
+	reqPrfx := utils.DynamicDataPrefix + utils.MetaReq + utils.NestingSep
+	for _, fltr := range l.filters {
+		unknown := make(utils.StringSet)
+		for _, rule := range fltr.Filters {
+			for _, path := range append([]string{rule.Element}, rule.Values...) {
+				if !strings.HasPrefix(path, reqPrfx) {
+					continue
+				}
+				fld := strings.SplitN(strings.TrimPrefix(path, reqPrfx), utils.NestingSep, 2)[0]
+				if idx := strings.Index(fld, utils.IdxStart); idx != -1 {
+					fld = fld[:idx]
+				}
+				if !utils.MainCDRFields.Has(fld) && !lintKnownReqFields.Has(fld) {
+					unknown.Add(fld)
+				}
+			}
+			if rule.Type != utils.MetaDestinations {
+				continue
+			}
+			for _, dstID := range rule.Values {
+				if strings.HasPrefix(dstID, utils.DynamicDataPrefix) || l.hasDestination(dstID) {
+					continue
+				}
+				l.report(TPLintWarning, utils.FiltersCsv, []string{fltr.Tenant, fltr.ID},
+					"Filter %s references missing Destination %s",
+					utils.ConcatenatedKey(fltr.Tenant, fltr.ID), dstID)
+			}
+		}
+		for _, fld := range unknown.AsOrderedSlice() {
+			l.report(TPLintWarning, utils.FiltersCsv, []string{fltr.Tenant, fltr.ID},
+				"Filter %s references unknown event field %s",
+				utils.ConcatenatedKey(fltr.Tenant, fltr.ID), fld)
+		}
+	}
+}
+
+func (l *tpLinter) hasDestination(dstID string) bool {
+	for _, dst := range l.destinations {
+		if dst.ID == dstID {
+			return true
+		}
+	}
+	return l.existsInDB(utils.DestinationPrefix, dstID)
+}
+
+// lintUnused reports the destinations and actions nothing refers to
+func (l *tpLinter) lintUnused() {
+	usedDsts := make(utils.StringSet)
+	for _, drs := range l.destRates {
+		for _, dr := range drs.DestinationRates {
+			usedDsts.Add(dr.DestinationId)
+		}
+	}
+	for _, fltr := range l.filters {
+		for _, rule := range fltr.Filters {
+			if rule.Type == utils.MetaDestinations {
+				usedDsts.AddSlice(rule.Values)
+			}
+		}
+	}
+	for _, acts := range l.actions {
+		for _, act := range acts.Actions {
+			usedDsts.AddSlice(strings.Split(act.DestinationIds, utils.InfieldSep))
+		}
+	}
+	for _, atrs := range l.actionTriggers {
+		for _, atr := range atrs.ActionTriggers {
+			usedDsts.AddSlice(strings.Split(atr.BalanceDestinationIds, utils.InfieldSep))
+		}
+	}
+	for _, dst := range l.destinations {
+		if !usedDsts.Has(dst.ID) {
+			l.report(TPLintWarning, utils.DestinationsCsv, []string{dst.ID},
+				"Destination %s is not used", dst.ID)
+		}
+	}
+	usedActs := make(utils.StringSet)
+	for _, apl := range l.actionPlans {
+		for _, at := range apl.ActionPlan {
+			usedActs.Add(at.ActionsId)
+		}
+	}
+	for _, atrs := range l.actionTriggers {
+		for _, atr := range atrs.ActionTriggers {
+			usedActs.Add(atr.ActionsId)
+		}
+	}
+	for _, th := range l.thresholds {
+		usedActs.AddSlice(th.ActionIDs)
+	}
+	for _, acts := range l.actions {
+		if !usedActs.Has(acts.ID) {
+			l.report(TPLintWarning, utils.ActionsCsv, []string{acts.ID},
+				"Actions %s are not used", acts.ID)
+		}
+	}
+}
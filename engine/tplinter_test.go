@@ -0,0 +1,113 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/cgrates/cgrates/utils"
+)
+
+func TestTPReaderLint(t *testing.T) {
+	tpDir := t.TempDir()
+	for fn, content := range map[string]string{
+		utils.DestinationsCsv: `#Id,Prefix
+DST_UK,44
+DST_UK_MOB,44
+DST_FR,33
+`,
+		utils.TimingsCsv: `#Tag,Years,Months,MonthDays,WeekDays,Time
+PEAK,*any,*any,*any,1;2;3;4;5,08:00:00
+`,
+		utils.RatesCsv: `#Id,ConnectFee,Rate,RateUnit,RateIncrement,GroupIntervalStart
+RT_1,0,0.1,60s,60s,0s
+RT_2,0,0.2,60s,60s,0s
+`,
+		utils.DestinationRatesCsv: `#Id,DestinationId,RatesTag,RoundingMethod,RoundingDecimals,MaxCost,MaxCostStrategy
+DR_UK,DST_UK,RT_1,*up,4,0,
+DR_UK_MOB,DST_UK_MOB,RT_2,*up,4,0,
+`,
+		utils.RatingPlansCsv: `#Id,DestinationRatesId,TimingTag,Weight
+RP_1,DR_UK,*any,10
+RP_1,DR_UK_MOB,*any,10
+RP_2,DR_UK,PEAK,10
+`,
+		utils.RatingProfilesCsv: `#Tenant,Category,Subject,ActivationTime,RatingPlanId,RatesFallbackSubject
+cgrates.org,call,1001,2014-01-14T00:00:00Z,RP_1,
+cgrates.org,call,1002,2014-01-14T00:00:00Z,RP_2,
+cgrates.org,call,1003,2014-01-14T00:00:00Z,RP_3,
+`,
+		utils.ActionsCsv: `#ActionsId[0],Action[1],ExtraParameters[2],Filter[3],BalanceId[4],BalanceType[5],Categories[6],DestinationIds[7],RatingSubject[8],SharedGroup[9],ExpiryTime[10],TimingIds[11],Units[12],BalanceWeight[13],BalanceBlocker[14],BalanceDisabled[15],Weight[16]
+ACT_LOG,*log,,,,,,,,,,,,,false,false,10
+ACT_TOPUP,*topup,,,,*monetary,,,,,,TM_MISSING,10,10,false,false,10
+`,
+		utils.FiltersCsv: `#Tenant[0],ID[1],Type[2],Element[3],Values[4],ActivationInterval[5]
+cgrates.org,FLTR_1,*string,~*req.Acount,1001,
+cgrates.org,FLTR_2,*string,~*req.EventType,AccountUpdate,
+cgrates.org,FLTR_2,*string,~*req.BalanceID,MONEY1,
+`,
+		utils.AttributesCsv: `#Tenant,ID,Contexts,FilterIDs,ActivationInterval,AttributeFilterIDs,Path,Type,Value,Blocker,Weight,Weights
+cgrates.org,ATTR_1,*any,FLTR_1;FLTR_3,,,*req.Password,*constant,pass,false,20,
+`,
+	} {
+		if err := os.WriteFile(filepath.Join(tpDir, fn), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	csvStorage, err := NewFileCSVStorage(utils.CSVSep, tpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	locs := csvStorage.TrackLocations()
+	// the lint runs without a DataDB
+	tpr, err := NewTpReader(nil, csvStorage, utils.EmptyString, utils.EmptyString, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := tpr.Lint(locs)
+	var rcv []string
+	for _, issue := range issues {
+		rcv = append(rcv, issue.String())
+	}
+	loc := func(fn string, line int) string {
+		return filepath.Join(tpDir, fn) + ":" + strconv.Itoa(line)
+	}
+	exp := []string{
+		`error: cannot load Actions.csv: error: NOT_FOUND querying timing with id: "TM_MISSING"`,
+		loc(utils.ActionsCsv, 2) + ": warning: Actions ACT_LOG are not used",
+		loc(utils.ActionsCsv, 3) + ": warning: Actions ACT_TOPUP are not used",
+		loc(utils.AttributesCsv, 2) + ": error: Attributes cgrates.org:ATTR_1 references missing Filter FLTR_3",
+		loc(utils.DestinationsCsv, 4) + ": warning: Destination DST_FR is not used",
+		loc(utils.FiltersCsv, 2) + ": warning: Filter cgrates.org:FLTR_1 references unknown event field Acount",
+		loc(utils.RatingPlansCsv, 2) + ": error: RatingPlan RP_1 rates prefix 44 with both RT_1 (Destination DST_UK) and RT_2 (Destination DST_UK_MOB) at the same weight",
+		loc(utils.RatingPlansCsv, 4) + ": error: RatingPlan RP_2 leaves Destination DST_UK unrated on Monday, Tuesday, Wednesday, Thursday, Friday between 00:00:00 and 08:00:00",
+		loc(utils.RatingPlansCsv, 4) + ": error: RatingPlan RP_2 leaves Destination DST_UK unrated on Sunday, Saturday between 00:00:00 and 24:00:00",
+		loc(utils.RatingProfilesCsv, 4) + ": error: RatingProfile cgrates.org:call:1003 references missing RatingPlan RP_3",
+	}
+	if !reflect.DeepEqual(exp, rcv) {
+		t.Errorf("expected %s,\nreceived %s", utils.ToJSON(exp), utils.ToJSON(rcv))
+	}
+	if !issues.HasErrors() {
+		t.Error("expected errors")
+	}
+}
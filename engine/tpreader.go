@@ -59,6 +59,7 @@ type TpReader struct {
 	chargerProfiles    map[utils.TenantID]*utils.TPChargerProfile
 	dispatcherProfiles map[utils.TenantID]*utils.TPDispatcherProfile
 	dispatcherHosts    map[utils.TenantID]*utils.TPDispatcherHost
+	lookupTables       map[utils.TenantID]*utils.TPLookupTable
 	acntActionPlans    map[string][]string
 	cacheConns         []string
 	schedulerConns     []string
@@ -105,6 +106,7 @@ func (tpr *TpReader) Init() {
 	tpr.chargerProfiles = make(map[utils.TenantID]*utils.TPChargerProfile)
 	tpr.dispatcherProfiles = make(map[utils.TenantID]*utils.TPDispatcherProfile)
 	tpr.dispatcherHosts = make(map[utils.TenantID]*utils.TPDispatcherHost)
+	tpr.lookupTables = make(map[utils.TenantID]*utils.TPLookupTable)
 	tpr.filters = make(map[utils.TenantID]*utils.TPFilterProfile)
 	tpr.acntActionPlans = make(map[string][]string)
 }
@@ -189,7 +191,7 @@ func (tpr *TpReader) LoadDestinationRates() (err error) {
 			if !destinationExists {
 				_, destinationExists = tpr.destinations[dr.DestinationId]
 			}
-			if !destinationExists && tpr.dm.dataDB != nil {
+			if !destinationExists && tpr.dm.db() != nil {
 				if destinationExists, err = tpr.dm.HasData(utils.DestinationPrefix, dr.DestinationId, ""); err != nil {
 					return err
 				}
@@ -257,7 +259,7 @@ func (tpr *TpReader) LoadRatingPlansFiltered(tag string) (bool, error) {
 				tpDests, err := tpr.lr.GetTPDestinations(tpr.tpid, drate.DestinationId)
 				if err != nil {
 					if err.Error() == utils.ErrNotFound.Error() { // if the destination doesn't exists in stordb check it in dataDB
-						if tpr.dm.dataDB != nil {
+						if tpr.dm.db() != nil {
 							if dbExists, err := tpr.dm.HasData(utils.DestinationPrefix, drate.DestinationId, ""); err != nil {
 								return false, err
 							} else if dbExists {
@@ -334,7 +336,7 @@ func (tpr *TpReader) LoadRatingProfilesFiltered(qriedRpf *utils.TPRatingProfile)
 				return fmt.Errorf("cannot parse activation time from %v", tpRa.ActivationTime)
 			}
 			_, exists := tpr.ratingPlans[tpRa.RatingPlanId]
-			if !exists && tpr.dm.dataDB != nil {
+			if !exists && tpr.dm.db() != nil {
 				if exists, err = tpr.dm.HasData(utils.RatingPlanPrefix, tpRa.RatingPlanId, ""); err != nil {
 					return err
 				}
@@ -375,7 +377,7 @@ func (tpr *TpReader) LoadRatingProfiles() (err error) {
 				return fmt.Errorf("cannot parse activation time from %v", tpRa.ActivationTime)
 			}
 			_, exists := tpr.ratingPlans[tpRa.RatingPlanId]
-			if !exists && tpr.dm.dataDB != nil { // Only query if there is a connection, eg on dry run there is none
+			if !exists && tpr.dm.db() != nil { // Only query if there is a connection, eg on dry run there is none
 				if exists, err = tpr.dm.HasData(utils.RatingPlanPrefix, tpRa.RatingPlanId, ""); err != nil {
 					return err
 				}
@@ -520,6 +522,10 @@ func (tpr *TpReader) LoadActions() (err error) {
 					timingID = strings.TrimPrefix(timingID, utils.NegativePrefix)
 					timing, found := tpr.timings[timingID]
 					if !found {
+						if tpr.dm.db() == nil { // no connection to look it up, ie: on lint
+							return fmt.Errorf("error: %v querying timing with id: %q",
+								utils.ErrNotFound, timingID)
+						}
 						if timing, err = tpr.dm.GetTiming(timingID, false,
 							utils.NonTransactional); err != nil {
 							return fmt.Errorf("error: %v querying timing with id: %q",
@@ -553,7 +559,7 @@ func (tpr *TpReader) LoadActionPlans() (err error) {
 		for _, at := range ats {
 
 			_, exists := tpr.actions[at.ActionsId]
-			if !exists && tpr.dm.dataDB != nil {
+			if !exists && tpr.dm.db() != nil {
 				if exists, err = tpr.dm.HasData(utils.ActionPrefix, at.ActionsId, ""); err != nil {
 					return fmt.Errorf("[ActionPlans] Error querying actions: %q - %s", at.ActionsId, err.Error())
 				}
@@ -1072,7 +1078,7 @@ func (tpr *TpReader) LoadAccountActions() (err error) {
 		if aa.ActionPlanId != "" {
 			actionPlan, exists := tpr.actionPlans[aa.ActionPlanId]
 			if !exists {
-				if tpr.dm.dataDB != nil {
+				if tpr.dm.db() != nil {
 					if actionPlan, err = tpr.dm.GetActionPlan(aa.ActionPlanId, false, true, utils.NonTransactional); err != nil {
 						if err.Error() == utils.ErrNotFound.Error() {
 							return fmt.Errorf("could not get action plan for tag %q", aa.ActionPlanId)
@@ -1334,6 +1340,23 @@ func (tpr *TpReader) LoadDispatcherHosts() error {
 	return tpr.LoadDispatcherHostsFiltered("")
 }
 
+func (tpr *TpReader) LoadLookupTablesFiltered(tag string) (err error) {
+	lts, err := tpr.lr.GetTPLookupTables(tpr.tpid, "", tag)
+	if err != nil {
+		return err
+	}
+	mapLookupTables := make(map[utils.TenantID]*utils.TPLookupTable)
+	for _, lt := range lts {
+		mapLookupTables[utils.TenantID{Tenant: lt.Tenant, ID: lt.ID}] = lt
+	}
+	tpr.lookupTables = mapLookupTables
+	return nil
+}
+
+func (tpr *TpReader) LoadLookupTables() error {
+	return tpr.LoadLookupTablesFiltered("")
+}
+
 func (tpr *TpReader) LoadAll() (err error) {
 	if err = tpr.LoadDestinations(); err != nil && err.Error() != utils.NotFoundCaps {
 		return
@@ -1404,6 +1427,9 @@ func (tpr *TpReader) LoadAll() (err error) {
 	if err = tpr.LoadDispatcherHosts(); err != nil && err.Error() != utils.NotFoundCaps {
 		return
 	}
+	if err = tpr.LoadLookupTables(); err != nil && err.Error() != utils.NotFoundCaps {
+		return
+	}
 	return nil
 }
 
@@ -1427,7 +1453,7 @@ func (tpr *TpReader) IsValid() (valid bool) {
 }
 
 func (tpr *TpReader) WriteToDatabase(verbose, disableReverse bool) (err error) {
-	if tpr.dm.dataDB == nil {
+	if tpr.dm.db() == nil {
 		return errors.New("no database connection")
 	}
 	//generate a loadID
@@ -1802,6 +1828,21 @@ func (tpr *TpReader) WriteToDatabase(verbose, disableReverse bool) (err error) {
 	if len(tpr.dispatcherHosts) != 0 {
 		loadIDs[utils.CacheDispatcherHosts] = loadID
 	}
+	if verbose {
+		log.Print("LookupTables:")
+	}
+	for _, tpLT := range tpr.lookupTables {
+		lt := APItoLookupTable(tpLT)
+		if err = tpr.dm.SetLookupTable(lt); err != nil {
+			return
+		}
+		if verbose {
+			log.Print("\t", lt.TenantID())
+		}
+	}
+	if len(tpr.lookupTables) != 0 {
+		loadIDs[utils.CacheLookupTables] = loadID
+	}
 
 	if verbose {
 		log.Print("Timings:")
@@ -1905,6 +1946,8 @@ func (tpr *TpReader) ShowStatistics() {
 	log.Print("DispatcherProfiles: ", len(tpr.dispatcherProfiles))
 	// Dispatcher Hosts
 	log.Print("DispatcherHosts: ", len(tpr.dispatcherHosts))
+	// Lookup tables
+	log.Print("LookupTables: ", len(tpr.lookupTables))
 }
 
 // GetLoadedIds returns the identities loaded for a specific category, useful for cache reloads
@@ -2087,6 +2130,15 @@ func (tpr *TpReader) GetLoadedIds(categ string) ([]string, error) {
 			i++
 		}
 		return keys, nil
+
+	case utils.LookupTablePrefix:
+		keys := make([]string, len(tpr.lookupTables))
+		i := 0
+		for k := range tpr.lookupTables {
+			keys[i] = k.TenantID()
+			i++
+		}
+		return keys, nil
 	}
 	return nil, errors.New("Unsupported load category")
 }
@@ -2304,6 +2356,17 @@ func (tpr *TpReader) RemoveFromDatabase(verbose, disableReverse bool) (err error
 			log.Print("\t", utils.ConcatenatedKey(tpDsh.Tenant, tpDsh.ID))
 		}
 	}
+	if verbose {
+		log.Print("LookupTables:")
+	}
+	for _, tpLT := range tpr.lookupTables {
+		if err = tpr.dm.RemoveLookupTable(tpLT.Tenant, tpLT.ID); err != nil {
+			return
+		}
+		if verbose {
+			log.Print("\t", utils.ConcatenatedKey(tpLT.Tenant, tpLT.ID))
+		}
+	}
 
 	if verbose {
 		log.Print("Timings:")
@@ -2412,6 +2475,9 @@ func (tpr *TpReader) RemoveFromDatabase(verbose, disableReverse bool) (err error
 	if len(tpr.dispatcherHosts) != 0 {
 		loadIDs[utils.CacheDispatcherHosts] = loadID
 	}
+	if len(tpr.lookupTables) != 0 {
+		loadIDs[utils.CacheLookupTables] = loadID
+	}
 	if len(tpr.timings) != 0 {
 		loadIDs[utils.CacheTimings] = loadID
 	}
@@ -2445,6 +2511,7 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 	chargerIDs, _ := tpr.GetLoadedIds(utils.ChargerProfilePrefix)
 	dppIDs, _ := tpr.GetLoadedIds(utils.DispatcherProfilePrefix)
 	dphIDs, _ := tpr.GetLoadedIds(utils.DispatcherHostPrefix)
+	lktIDs, _ := tpr.GetLoadedIds(utils.LookupTablePrefix)
 	aps, _ := tpr.GetLoadedIds(utils.ActionPlanPrefix)
 
 	//compose Reload Cache argument
@@ -2475,6 +2542,7 @@ func (tpr *TpReader) ReloadCache(caching string, verbose bool, opts map[string]a
 		utils.CacheChargerProfiles:     chargerIDs,
 		utils.CacheDispatcherProfiles:  dppIDs,
 		utils.CacheDispatcherHosts:     dphIDs,
+		utils.CacheLookupTables:        lktIDs,
 	}
 
 	// verify if we need to clear indexes
@@ -947,6 +947,7 @@ func TestTPReaderReloadCache(t *testing.T) {
 		ChargerProfileIDs:     []string{"cgrates.org:chargerProfilesID"},
 		DispatcherProfileIDs:  []string{"cgrates.org:dispatcherProfilesID"},
 		DispatcherHostIDs:     []string{"cgrates.org:dispatcherHostsID"},
+		LookupTableIDs:        []string{"cgrates.org:lookupTablesID"},
 		ResourceIDs:           []string{"cgrates.org:resourceProfilesID"},
 		IPIDs:                 []string{"cgrates.org:ipProfilesID"},
 		StatsQueueIDs:         []string{"cgrates.org:statProfilesID"},
@@ -1036,6 +1037,9 @@ func TestTPReaderReloadCache(t *testing.T) {
 		dispatcherHosts: map[utils.TenantID]*utils.TPDispatcherHost{
 			{Tenant: "cgrates.org", ID: "dispatcherHostsID"}: {},
 		},
+		lookupTables: map[utils.TenantID]*utils.TPLookupTable{
+			{Tenant: "cgrates.org", ID: "lookupTablesID"}: {},
+		},
 		acntActionPlans: map[string][]string{
 			"AccountActionPlansID": {},
 		},
@@ -44,6 +44,12 @@ var (
 		utils.CostDetails:   "cgr-migrator -exec=*cost_details",
 		utils.SessionSCosts: "cgr-migrator -exec=*sessions_costs",
 		utils.CDRs:          "cgr-migrator -exec=*cdrs",
+		utils.TpAttributes:  "cgr-migrator -exec=*tp_attributes",
+		utils.TpChargers:    "cgr-migrator -exec=*tp_chargers",
+		utils.TpResources:   "cgr-migrator -exec=*tp_resources",
+		utils.TpRoutes:      "cgr-migrator -exec=*tp_Routes",
+		utils.TpStats:       "cgr-migrator -exec=*tp_stats",
+		utils.TpThresholds:  "cgr-migrator -exec=*tp_thresholds",
 	}
 	allVers map[string]string // init will fill this with a merge of data+stor
 )
@@ -93,6 +99,12 @@ func CheckVersions(storage Storage) error {
 
 // relevant only for mongoDB
 func isDataDB(storage Storage) bool {
+	switch trDB := storage.(type) {
+	case *tracingDataDB:
+		storage = trDB.DataDB
+	case *tracingStorDB:
+		storage = trDB.StorDB
+	}
 	conv, ok := storage.(*MongoStorage)
 	if !ok {
 		return false
@@ -176,7 +188,7 @@ func CurrentDataDBVersions() Versions {
 func CurrentStorDBVersions() Versions {
 	return Versions{
 		utils.CostDetails:        2,
-		utils.SessionSCosts:      3,
+		utils.SessionSCosts:      4,
 		utils.CDRs:               2,
 		utils.TpRatingPlans:      1,
 		utils.TpFilters:          1,
@@ -185,12 +197,12 @@ func CurrentStorDBVersions() Versions {
 		utils.TpAccountActionsV:  1,
 		utils.TpActionPlans:      1,
 		utils.TpActions:          1,
-		utils.TpThresholds:       1,
-		utils.TpRoutes:           1,
-		utils.TpStats:            1,
+		utils.TpThresholds:       2,
+		utils.TpRoutes:           2,
+		utils.TpStats:            2,
 		utils.TpSharedGroups:     1,
 		utils.TpRatingProfiles:   1,
-		utils.TpResources:        1,
+		utils.TpResources:        2,
 		utils.TpIPs:              1,
 		utils.TpRates:            1,
 		utils.TpTiming:           1,
@@ -199,8 +211,9 @@ func CurrentStorDBVersions() Versions {
 		utils.TpDestinations:     1,
 		utils.TpRatingPlan:       1,
 		utils.TpRatingProfile:    1,
-		utils.TpChargers:         1,
+		utils.TpChargers:         2,
 		utils.TpDispatchers:      1,
+		utils.TpAttributes:       2,
 	}
 }
 
@@ -97,15 +97,15 @@ func TestCurrentDBVersions(t *testing.T) {
 		utils.Dispatchers: 2, utils.LoadIDsVrs: 1,
 	}
 	expVersStorDB := Versions{
-		utils.CostDetails: 2, utils.SessionSCosts: 3, utils.CDRs: 2,
+		utils.CostDetails: 2, utils.SessionSCosts: 4, utils.CDRs: 2,
 		utils.TpRatingPlans: 1, utils.TpFilters: 1, utils.TpDestinationRates: 1,
 		utils.TpActionTriggers: 1, utils.TpAccountActionsV: 1, utils.TpActionPlans: 1,
-		utils.TpActions: 1, utils.TpThresholds: 1, utils.TpRoutes: 1,
-		utils.TpStats: 1, utils.TpSharedGroups: 1, utils.TpRatingProfiles: 1,
-		utils.TpResources: 1, utils.TpIPs: 1, utils.TpIP: 1, utils.TpRates: 1,
+		utils.TpActions: 1, utils.TpThresholds: 2, utils.TpRoutes: 2,
+		utils.TpStats: 2, utils.TpSharedGroups: 1, utils.TpRatingProfiles: 1,
+		utils.TpResources: 2, utils.TpIPs: 1, utils.TpIP: 1, utils.TpRates: 1,
 		utils.TpTiming: 1, utils.TpResource: 1, utils.TpDestinations: 1,
-		utils.TpRatingPlan: 1, utils.TpRatingProfile: 1, utils.TpChargers: 1,
-		utils.TpDispatchers: 1,
+		utils.TpRatingPlan: 1, utils.TpRatingProfile: 1, utils.TpChargers: 2,
+		utils.TpDispatchers: 1, utils.TpAttributes: 2,
 	}
 	if vrs := CurrentDBVersions(utils.MetaMongo, true); !reflect.DeepEqual(expVersDataDB, vrs) {
 		t.Errorf("Expectred %+v, received %+v", expVersDataDB, vrs)
@@ -285,3 +285,54 @@ func TestMatchingItemIDsForEventWarningThresholds(t *testing.T) {
 		t.Errorf("Expected warning not found in logs:\n%s", logContent)
 	}
 }
+
+func TestWeightForEvent(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fltrS := NewFilterS(cfg, nil, NewDataManager(data, cfg.CacheCfg(), nil))
+	dWs, err := utils.NewDynamicWeightsFromString(
+		"*string:~*req.Account:1001&*prefix:~*req.Destination:+49;30;*string:~*req.Account:1001;20",
+		utils.InfieldSep, utils.ANDSep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name string
+		ev   utils.MapStorage
+		exp  float64
+	}{
+		{
+			name: "first group matching",
+			ev: utils.MapStorage{utils.MetaReq: utils.MapStorage{
+				utils.AccountField: "1001", utils.Destination: "+4912345"}},
+			exp: 30,
+		},
+		{
+			name: "second group matching",
+			ev: utils.MapStorage{utils.MetaReq: utils.MapStorage{
+				utils.AccountField: "1001", utils.Destination: "+4012345"}},
+			exp: 20,
+		},
+		{
+			name: "static fallback",
+			ev: utils.MapStorage{utils.MetaReq: utils.MapStorage{
+				utils.AccountField: "1002"}},
+			exp: 10,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if rcv, err := weightForEvent(dWs, 10, fltrS, "cgrates.org", tc.ev); err != nil {
+				t.Error(err)
+			} else if rcv != tc.exp {
+				t.Errorf("expected weight %v, received %v", tc.exp, rcv)
+			}
+		})
+	}
+	if _, err := weightForEvent(utils.DynamicWeights{{FilterIDs: []string{"FLTR_MISSING"}}},
+		10, fltrS, "cgrates.org", utils.MapStorage{}); err == nil {
+		t.Error("expected error for missing filter")
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/utils"
+	"github.com/cgrates/ltcache"
+)
+
+func TestRepairAccountActionPlansIndex(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	db, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Error(dErr)
+	}
+	dm := NewDataManager(db, cfg.CacheCfg(), nil)
+
+	if err := dm.SetAccountActionPlans("1001", []string{"AP1", "AP2"}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetActionPlan("AP2", &ActionPlan{
+		Id:            "AP2",
+		AccountIDs:    utils.NewStringMap("1002"),
+		ActionTimings: []*ActionTiming{{}},
+	}, true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &AccountActionPlanIHReply{
+		MissingAccountActionPlans: map[string][]string{"1002": {"AP2"}},
+		BrokenReferences:          map[string][]string{"AP2": {"1001"}, "AP1": nil},
+	}
+	if rply, err := RepairAccountActionPlansIndex(dm, -1, -1, -1, -1, false, false); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+
+	exp = &AccountActionPlanIHReply{
+		MissingAccountActionPlans: map[string][]string{},
+		BrokenReferences:          map[string][]string{},
+	}
+	if rply, err := GetAccountActionPlansIndexHealth(dm, -1, -1, -1, -1, false, false); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+	if _, err := dm.GetAccountActionPlans("1001", false, false, utils.NonTransactional); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+	if apIDs, err := dm.GetAccountActionPlans("1002", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual([]string{"AP2"}, apIDs) {
+		t.Errorf("Expected [AP2], received %v", apIDs)
+	}
+}
+
+func TestRepairReverseDestinationsIndex(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	db, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Error(dErr)
+	}
+	dm := NewDataManager(db, cfg.CacheCfg(), nil)
+
+	if err := dm.SetReverseDestination("DST1", []string{"1001", "1002"}, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetReverseDestination("DST2", []string{"1001"}, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetDestination(&Destination{
+		Id:       "DST2",
+		Prefixes: []string{"1002"},
+	}, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &ReverseDestinationsIHReply{
+		MissingReverseDestinations: map[string][]string{"1002": {"DST2"}},
+		BrokenReferences:           map[string][]string{"DST1": nil, "DST2": {"1001"}},
+	}
+	if rply, err := RepairReverseDestinationsIndex(dm, -1, -1, -1, -1, false, false); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+
+	exp = &ReverseDestinationsIHReply{
+		MissingReverseDestinations: map[string][]string{},
+		BrokenReferences:           map[string][]string{},
+	}
+	if rply, err := GetReverseDestinationsIndexHealth(dm, -1, -1, -1, -1, false, false); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+	if dstIDs, err := dm.GetReverseDestination("1002", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual([]string{"DST2"}, dstIDs) {
+		t.Errorf("Expected [DST2], received %v", dstIDs)
+	}
+}
+
+func TestRepairFltrIdx(t *testing.T) {
+	Cache.Clear(nil)
+	cfg := config.NewDefaultCGRConfig()
+	db, dErr := NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if dErr != nil {
+		t.Error(dErr)
+	}
+	dm := NewDataManager(db, cfg.CacheCfg(), nil)
+
+	if err := dm.SetAttributeProfile(&AttributeProfile{
+		Tenant:    "cgrates.org",
+		ID:        "ATTR1",
+		Contexts:  []string{utils.MetaAny},
+		FilterIDs: []string{"*string:~*req.Account:1001", "*exists:~*req.Destination:", "Fltr1"},
+	}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetIndexes(utils.CacheAttributeFilterIndexes, "cgrates.org:*any",
+		map[string]utils.StringSet{
+			"*string:*req.Account:1002": {
+				"ATTR1": {},
+				"ATTR2": {},
+			},
+			"*exists:*req.Destination": {
+				"ATTR1": {},
+			},
+		},
+		true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.SetIndexes(utils.CacheAttributeFilterIndexes, "cgrates.org:*sessions",
+		map[string]utils.StringSet{"*string:*req.Account:1001": {
+			"ATTR1": {},
+		}},
+		true, utils.NonTransactional); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &FilterIHReply{
+		MissingIndexes: map[string][]string{
+			"cgrates.org:*any:*string:*req.Account:1001":      {"ATTR1"},
+			"cgrates.org:*sessions:*string:*req.Account:1001": {"ATTR1"},
+		},
+		BrokenIndexes: map[string][]string{
+			"cgrates.org:*string:*req.Account:1002": {"ATTR1"},
+		},
+		MissingFilters: map[string][]string{
+			"cgrates.org:Fltr1": {"ATTR1"},
+		},
+		MissingObjects: []string{"cgrates.org:ATTR2"},
+	}
+	if rply, err := RepairFltrIdx(dm,
+		ltcache.NewCache(-1, 0, false, true, nil),
+		ltcache.NewCache(-1, 0, false, true, nil),
+		ltcache.NewCache(-1, 0, false, true, nil),
+		utils.CacheAttributeFilterIndexes); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+
+	exp = &FilterIHReply{ // only the missing filters remain
+		MissingIndexes: map[string][]string{},
+		BrokenIndexes:  map[string][]string{},
+		MissingFilters: map[string][]string{
+			"cgrates.org:Fltr1": {"ATTR1"},
+		},
+		MissingObjects: []string{},
+	}
+	if rply, err := GetFltrIdxHealth(dm,
+		ltcache.NewCache(-1, 0, false, true, nil),
+		ltcache.NewCache(-1, 0, false, true, nil),
+		ltcache.NewCache(-1, 0, false, true, nil),
+		utils.CacheAttributeFilterIndexes); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, rply) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(exp), utils.ToJSON(rply))
+	}
+	expIdx := map[string]utils.StringSet{
+		"*string:*req.Account:1001": {"ATTR1": {}},
+		"*exists:*req.Destination":  {"ATTR1": {}},
+	}
+	if rcvIdx, err := dm.GetIndexes(utils.CacheAttributeFilterIndexes, "cgrates.org:*any",
+		false, false); err != nil {
+		t.Error(err)
+	} else if !reflect.DeepEqual(expIdx, rcvIdx) {
+		t.Errorf("Expecting: %+v, received: %+v", utils.ToJSON(expIdx), utils.ToJSON(rcvIdx))
+	}
+	if _, err := dm.GetIndexes(utils.CacheAttributeFilterIndexes, "cgrates.org:*sessions",
+		false, false); err != utils.ErrNotFound {
+		t.Errorf("Expected %v, received %v", utils.ErrNotFound, err)
+	}
+}
@@ -294,7 +294,8 @@ func (erS *ERService) processEvent(cgrEv *utils.CGREvent,
 		utils.MetaDryRun, utils.MetaAuthorize,
 		utils.MetaInitiate, utils.MetaUpdate,
 		utils.MetaTerminate, utils.MetaMessage,
-		utils.MetaCDRs, utils.MetaEvent, utils.MetaNone} {
+		utils.MetaCDRs, utils.MetaEvent, utils.MetaPortedNumbers,
+		utils.MetaNone} {
 		if rdrCfg.Flags.Has(typ) { // request type is identified through flags
 			reqType = typ
 			break
@@ -441,6 +442,10 @@ func (erS *ERService) processEvent(cgrEv *utils.CGREvent,
 		if err != nil {
 			replyState = utils.ErrReplyStateEvent
 		}
+	case utils.MetaPortedNumbers: // load the ported number into DataDB
+		if err = erS.setPortedNumber(cgrEv); err != nil {
+			replyState = utils.ErrReplyStatePortedNumbers
+		}
 	case utils.MetaCDRs: // allow CDR processing
 	}
 	if err != nil {
@@ -459,6 +464,18 @@ func (erS *ERService) processEvent(cgrEv *utils.CGREvent,
 	return
 }
 
+// setPortedNumber stores the Number and RoutingNumber fields of the event as ported number
+func (erS *ERService) setPortedNumber(cgrEv *utils.CGREvent) (err error) {
+	if missing := utils.MissingMapFields(cgrEv.Event,
+		[]string{utils.Number, utils.RoutingNumber}); len(missing) != 0 {
+		return utils.NewErrMandatoryIeMissing(missing...)
+	}
+	return erS.dataManager.SetPortedNumber(&engine.PortedNumber{
+		Number:        utils.IfaceAsString(cgrEv.Event[utils.Number]),
+		RoutingNumber: utils.IfaceAsString(cgrEv.Event[utils.RoutingNumber]),
+	})
+}
+
 func (erS *ERService) closeAllRdrs() {
 	for _, stopL := range erS.stopLsn {
 		close(stopL)
@@ -345,3 +345,38 @@ func TestErsOnEvictedMetaDumpToFileEmptyPath(t *testing.T) {
 
 	// Verification TBA
 }
+
+func TestERsProcessEventPortedNumbers(t *testing.T) {
+	cfg := config.NewDefaultCGRConfig()
+	data, err := engine.NewInternalDB(nil, nil, true, nil, cfg.DataDbCfg().Items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
+	erS := NewERService(cfg, dm, &engine.FilterS{}, nil)
+	rdrCfg := &config.EventReaderCfg{
+		ID:    "PortedNumbers",
+		Flags: utils.FlagsWithParamsFromSlice([]string{utils.MetaPortedNumbers}),
+	}
+	cgrEv := &utils.CGREvent{
+		Tenant: "cgrates.org",
+		ID:     "PortedNumber",
+		Event: map[string]any{
+			utils.Number:        "40721000001",
+			utils.RoutingNumber: "D123",
+		},
+	}
+	if err := erS.processEvent(cgrEv, rdrCfg); err != nil {
+		t.Fatal(err)
+	}
+	if rn, err := dm.GetPortedNumber("40721000001", false, false, utils.NonTransactional); err != nil {
+		t.Error(err)
+	} else if rn != "D123" {
+		t.Errorf("Expected D123, received %q", rn)
+	}
+	cgrEv.Event = map[string]any{utils.Number: "40721000002"}
+	expErr := utils.NewErrMandatoryIeMissing(utils.RoutingNumber).Error()
+	if err := erS.processEvent(cgrEv, rdrCfg); err == nil || err.Error() != expErr {
+		t.Errorf("Expected error %q, received %v", expErr, err)
+	}
+}
@@ -190,6 +190,8 @@ func (m *Migrator) Migrate(taskIDs []string) (stats map[string]int, err error) {
 			err = m.migrateTPDestinations()
 		case utils.MetaTpChargers:
 			err = m.migrateTPChargers()
+		case utils.MetaTpAttributes:
+			err = m.migrateTPAttributes()
 		case utils.MetaTpDispatchers:
 			err = m.migrateTPDispatchers()
 		case utils.MetaLoadIDs:
@@ -316,6 +318,9 @@ func (m *Migrator) Migrate(taskIDs []string) (stats map[string]int, err error) {
 			if err := m.migrateTPChargers(); err != nil {
 				log.Print("ERROR: ", utils.MetaTpChargers, " ", err)
 			}
+			if err := m.migrateTPAttributes(); err != nil {
+				log.Print("ERROR: ", utils.MetaTpAttributes, " ", err)
+			}
 			if err := m.migrateTPDispatchers(); err != nil {
 				log.Print("ERROR: ", utils.MetaTpDispatchers, " ", err)
 			}
@@ -31,6 +31,8 @@ type MigratorStorDB interface {
 	getV2SMCost() (v2Cost *v2SessionsCost, err error)
 	setV2SMCost(v2Cost *v2SessionsCost) (err error)
 	remV2SMCost(v2Cost *v2SessionsCost) (err error)
+	addV3SMCostsAccount() (err error)
+	addV2TPWeights(tblName string) (err error)
 	StorDB() engine.StorDB
 	close()
 }
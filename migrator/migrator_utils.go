@@ -113,3 +113,23 @@ func (m *Migrator) setVersions(str string) (err error) {
 	}
 	return
 }
+
+// migrateV1TPWeights adds the Weights columns to the tariff plan table we
+// migrate from, moving its data after if we migrate to another StorDB
+func (m *Migrator) migrateV1TPWeights(tblName, vrsKey string, migrateCurrent func() error) (err error) {
+	if m.dryRun {
+		return
+	}
+	if err = m.storDBIn.addV2TPWeights(tblName); err != nil {
+		return utils.NewCGRError(utils.Migrator,
+			utils.ServerErrorCaps,
+			err.Error(),
+			fmt.Sprintf("error: <%s> when adding the Weights to %s", err.Error(), tblName))
+	}
+	if !m.sameStorDB {
+		if err = migrateCurrent(); err != nil {
+			return
+		}
+	}
+	return m.setVersions(vrsKey)
+}
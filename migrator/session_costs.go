@@ -72,6 +72,10 @@ func (m *Migrator) migrateSessionSCosts() (err error) {
 		if err = m.migrateV2SessionSCosts(); err != nil {
 			return err
 		}
+	case 3:
+		if err = m.migrateV3SessionSCosts(); err != nil {
+			return err
+		}
 	case current[utils.SessionSCosts]:
 		if err = m.migrateCurrentSessionSCost(); err != nil {
 			return err
@@ -98,6 +102,9 @@ func (m *Migrator) migrateV1SessionSCosts() (err error) {
 }
 
 func (m *Migrator) migrateV2SessionSCosts() (err error) {
+	if err = m.addSMCostsAccount(); err != nil {
+		return
+	}
 	var v2Cost *v2SessionsCost
 	for {
 		v2Cost, err = m.storDBIn.getV2SMCost()
@@ -129,6 +136,52 @@ func (m *Migrator) migrateV2SessionSCosts() (err error) {
 	return
 }
 
+// addSMCostsAccount adds the Account to the SMCosts migrated in place, the
+// StorDB we migrate to is already created with it
+func (m *Migrator) addSMCostsAccount() (err error) {
+	if !m.sameStorDB || m.dryRun {
+		return
+	}
+	if err = m.storDBIn.addV3SMCostsAccount(); err != nil {
+		return utils.NewCGRError(utils.Migrator,
+			utils.ServerErrorCaps,
+			err.Error(),
+			fmt.Sprintf("error: <%s> when adding the Account to SessionSCosts", err.Error()))
+	}
+	return
+}
+
+// migrateV3SessionSCosts sets the Account of the SMCosts so they can be
+// removed together with the rest of the data of one subscriber
+func (m *Migrator) migrateV3SessionSCosts() (err error) {
+	if err = m.addSMCostsAccount(); err != nil {
+		return
+	}
+	var smCosts []*engine.SMCost
+	if smCosts, err = m.storDBIn.StorDB().GetSMCosts("", "", "", ""); err != nil &&
+		err != utils.ErrNotFound {
+		return
+	}
+	err = nil
+	for _, smCost := range smCosts {
+		if m.dryRun {
+			continue
+		}
+		smCost.Account = smCost.CostDetails.AccountID()
+		if err = m.storDBIn.StorDB().RemoveSMCost(smCost); err != nil {
+			return
+		}
+		if err = m.storDBOut.StorDB().SetSMCost(smCost); err != nil {
+			return
+		}
+		m.stats[utils.SessionSCosts]++
+	}
+	if m.dryRun {
+		return
+	}
+	return m.setVersions(utils.SessionSCosts)
+}
+
 type v2SessionsCost struct {
 	CGRID       string
 	RunID       string
@@ -149,6 +202,7 @@ func (v2Cost *v2SessionsCost) V2toV3Cost() (cost *engine.SMCost) {
 		CostSource:  v2Cost.CostSource,
 		CostDetails: engine.NewEventCostFromCallCost(v2Cost.CostDetails, v2Cost.CGRID, v2Cost.RunID),
 	}
+	cost.Account = cost.CostDetails.AccountID()
 	return
 }
 
@@ -213,7 +213,7 @@ func testSessionCostITMigrate(t *testing.T) {
 	}
 	if vrs, err := sCostMigrator.storDBOut.StorDB().GetVersions(""); err != nil {
 		t.Error(err)
-	} else if vrs[utils.SessionSCosts] != 3 {
+	} else if vrs[utils.SessionSCosts] != 4 {
 		t.Errorf("Unexpected version returned: %d", vrs[utils.SessionSCosts])
 	}
 }
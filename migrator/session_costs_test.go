@@ -86,6 +86,7 @@ func TestV2toV3Cost(t *testing.T) {
 		OriginID:    "Origin1",
 		Usage:       time.Second,
 		CostSource:  utils.MetaSessionS,
+		Account:     "cgrates.org:1001",
 		CostDetails: engine.NewEventCostFromCallCost(cc, "CGRID", utils.MetaDefault),
 	}
 	rply := sv2.V2toV3Cost()
@@ -81,3 +81,13 @@ func (iDBMig *internalStorDBMigrator) setV2SMCost(v2Cost *v2SessionsCost) (err e
 func (iDBMig *internalStorDBMigrator) remV2SMCost(v2Cost *v2SessionsCost) (err error) {
 	return utils.ErrNotImplemented
 }
+
+// addV3SMCostsAccount has nothing to do since the items are indexed when set
+func (iDBMig *internalStorDBMigrator) addV3SMCostsAccount() (err error) {
+	return
+}
+
+// addV2TPWeights has nothing to do since the items are stored without a schema
+func (iDBMig *internalStorDBMigrator) addV2TPWeights(tblName string) (err error) {
+	return
+}
@@ -128,3 +128,13 @@ func (v1ms *mongoStorDBMigrator) remV2SMCost(v2Cost *v2SessionsCost) (err error)
 	_, err = v1ms.mgoDB.DB().Collection(utils.SessionCostsTBL).DeleteMany(v1ms.mgoDB.GetContext(), bson.D{})
 	return
 }
+
+// addV3SMCostsAccount has nothing to do since the index is created out of ensureIndexesStorDB
+func (v1ms *mongoStorDBMigrator) addV3SMCostsAccount() (err error) {
+	return
+}
+
+// addV2TPWeights has nothing to do since the documents without Weights are read with empty ones
+func (v1ms *mongoStorDBMigrator) addV2TPWeights(tblName string) (err error) {
+	return
+}
@@ -183,3 +183,40 @@ func (mgSQL *migratorSQL) remV2SMCost(v2Cost *v2SessionsCost) (err error) {
 	return nil
 
 }
+
+// addV3SMCostsAccount adds the account column, together with its index, to the session_costs table
+func (mgSQL *migratorSQL) addV3SMCostsAccount() (err error) {
+	gormDB := mgSQL.sqlStorage.ExportGormDB()
+	if !gormDB.Migrator().HasColumn(&engine.SessionCostsSQL{}, "account") {
+		if err = gormDB.Exec("ALTER TABLE session_costs ADD COLUMN account VARCHAR(128) NOT NULL DEFAULT ''").Error; err != nil {
+			return
+		}
+	}
+	idxName := "account_idx"
+	if mgSQL.StorDB().GetStorageType() == utils.MetaPostgres {
+		idxName = "account_sessionscost_idx"
+	}
+	if !gormDB.Migrator().HasIndex(&engine.SessionCostsSQL{}, idxName) {
+		err = gormDB.Exec("CREATE INDEX " + idxName + " ON session_costs (account)").Error
+	}
+	return
+}
+
+// addV2TPWeights adds the Weights columns of the dynamic weights to the tariff plan table
+func (mgSQL *migratorSQL) addV2TPWeights(tblName string) (err error) {
+	gormDB := mgSQL.sqlStorage.ExportGormDB()
+	columns := []string{"weights"}
+	if tblName == utils.TBLTPRoutes {
+		columns = append(columns, "route_weights")
+	}
+	for _, column := range columns {
+		if gormDB.Migrator().HasColumn(tblName, column) {
+			continue
+		}
+		if err = gormDB.Exec("ALTER TABLE " + tblName + " ADD COLUMN " + column +
+			" VARCHAR(64) NOT NULL DEFAULT ''").Error; err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,81 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package migrator
+
+import (
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/utils"
+)
+
+func (m *Migrator) migrateCurrentTPAttributes() (err error) {
+	tpids, err := m.storDBIn.StorDB().GetTpIds(utils.TBLTPAttributes)
+	if err != nil {
+		return err
+	}
+
+	for _, tpid := range tpids {
+		ids, err := m.storDBIn.StorDB().GetTpTableIds(tpid, utils.TBLTPAttributes,
+			utils.TPDistinctIds{"id"}, map[string]string{}, nil)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			attributes, err := m.storDBIn.StorDB().GetTPAttributes(tpid, "", id)
+			if err != nil {
+				return err
+			}
+			if attributes == nil || m.dryRun {
+				continue
+			}
+			if err := m.storDBOut.StorDB().SetTPAttributes(attributes); err != nil {
+				return err
+			}
+			for _, attribute := range attributes {
+				if err := m.storDBIn.StorDB().RemTpData(utils.TBLTPAttributes, attribute.TPid,
+					map[string]string{"id": attribute.ID}); err != nil {
+					return err
+				}
+			}
+			m.stats[utils.TpAttributes]++
+		}
+	}
+	return
+}
+
+func (m *Migrator) migrateTPAttributes() (err error) {
+	var vrs engine.Versions
+	current := engine.CurrentStorDBVersions()
+	if vrs, err = m.getVersions(utils.TpAttributes); err != nil {
+		return
+	}
+	switch vrs[utils.TpAttributes] {
+	case current[utils.TpAttributes]:
+		if m.sameStorDB {
+			break
+		}
+		if err := m.migrateCurrentTPAttributes(); err != nil {
+			return err
+		}
+	case 0, 1: // versioned first together with the Weights
+		if err = m.migrateV1TPWeights(utils.TBLTPAttributes, utils.TpAttributes, m.migrateCurrentTPAttributes); err != nil {
+			return
+		}
+	}
+	return m.ensureIndexesStorDB(utils.TBLTPAttributes)
+}
@@ -72,6 +72,10 @@ func (m *Migrator) migrateTPChargers() (err error) {
 		if err := m.migrateCurrentTPChargers(); err != nil {
 			return err
 		}
+	case 1:
+		if err = m.migrateV1TPWeights(utils.TBLTPChargers, utils.TpChargers, m.migrateCurrentTPChargers); err != nil {
+			return
+		}
 	}
 	return m.ensureIndexesStorDB(utils.TBLTPChargers)
 }
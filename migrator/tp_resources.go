@@ -73,6 +73,10 @@ func (m *Migrator) migrateTPresources() (err error) {
 		if err := m.migrateCurrentTPresources(); err != nil {
 			return err
 		}
+	case 1:
+		if err = m.migrateV1TPWeights(utils.TBLTPResources, utils.TpResources, m.migrateCurrentTPresources); err != nil {
+			return
+		}
 	}
 	return m.ensureIndexesStorDB(utils.TBLTPResources)
 }
@@ -73,6 +73,10 @@ func (m *Migrator) migrateTPRoutes() (err error) {
 		if err := m.migrateCurrentTPRoutes(); err != nil {
 			return err
 		}
+	case 1:
+		if err = m.migrateV1TPWeights(utils.TBLTPRoutes, utils.TpRoutes, m.migrateCurrentTPRoutes); err != nil {
+			return
+		}
 	}
 	return m.ensureIndexesStorDB(utils.TBLTPRoutes)
 }
@@ -72,6 +72,10 @@ func (m *Migrator) migrateTPstats() (err error) {
 		if err := m.migrateCurrentTPstats(); err != nil {
 			return err
 		}
+	case 1:
+		if err = m.migrateV1TPWeights(utils.TBLTPStats, utils.TpStats, m.migrateCurrentTPstats); err != nil {
+			return
+		}
 	}
 	return m.ensureIndexesStorDB(utils.TBLTPStats)
 }
@@ -72,6 +72,10 @@ func (m *Migrator) migrateTPthresholds() (err error) {
 		if err := m.migrateCurrentTPthresholds(); err != nil {
 			return err
 		}
+	case 1:
+		if err = m.migrateV1TPWeights(utils.TBLTPThresholds, utils.TpThresholds, m.migrateCurrentTPthresholds); err != nil {
+			return
+		}
 	}
 	return m.ensureIndexesStorDB(utils.TBLTPThresholds)
 }
@@ -34,10 +34,11 @@ import (
 // NewAnalyzerService returns the Analyzer Service
 func NewAnalyzerService(cfg *config.CGRConfig, server *cores.Server,
 	filterSChan chan *engine.FilterS, shdChan *utils.SyncedChan,
-	internalAnalyzerSChan chan birpc.ClientConnector,
+	internalAnalyzerSChan chan birpc.ClientConnector, connMgr *engine.ConnManager,
 	srvDep map[string]*sync.WaitGroup) *AnalyzerService {
 	return &AnalyzerService{
 		connChan:    internalAnalyzerSChan,
+		connMgr:     connMgr,
 		cfg:         cfg,
 		server:      server,
 		filterSChan: filterSChan,
@@ -57,6 +58,7 @@ type AnalyzerService struct {
 
 	anz      *analyzers.AnalyzerService
 	connChan chan birpc.ClientConnector
+	connMgr  *engine.ConnManager
 	srvDep   map[string]*sync.WaitGroup
 }
 
@@ -97,6 +99,7 @@ func (anz *AnalyzerService) start() {
 		defer anz.Unlock()
 		anz.filterSChan <- fS
 		anz.anz.SetFilterS(fS)
+		anz.anz.SetConnManager(anz.connMgr)
 	}
 	srv, err := engine.NewService(v1.NewAnalyzerSv1(anz.anz))
 	if err != nil {
@@ -54,7 +54,7 @@ func TestAnalyzerSReload(t *testing.T) {
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	db := NewDataDBService(cfg, nil, false, srvDep)
 	anzRPC := make(chan birpc.ClientConnector, 1)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, anzRPC, srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, anzRPC, nil, srvDep)
 	engine.NewConnManager(cfg, nil)
 	srvMngr.AddServices(anz, db)
 	if err := srvMngr.StartServices(); err != nil {
@@ -119,7 +119,7 @@ func TestAnalyzerSReload2(t *testing.T) {
 	server := cores.NewServer(nil)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	anzRPC := make(chan birpc.ClientConnector, 1)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, anzRPC, srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, anzRPC, nil, srvDep)
 	anz.stopChan = make(chan struct{})
 	anz.start()
 	close(anz.stopChan)
@@ -149,7 +149,7 @@ func TestAnalyzerSReload3(t *testing.T) {
 	server := cores.NewServer(nil)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	anzRPC := make(chan birpc.ClientConnector, 1)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, anzRPC, srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, anzRPC, nil, srvDep)
 	anz.stopChan = make(chan struct{})
 	anz.Start()
 
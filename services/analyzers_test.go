@@ -40,7 +40,7 @@ func TestAnalyzerCoverage(t *testing.T) {
 	server := cores.NewServer(nil)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	connChan := make(chan birpc.ClientConnector, 1)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, connChan, srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, connChan, nil, srvDep)
 	if anz == nil {
 		t.Errorf("\nExpecting <nil>,\n Received <%+v>", utils.ToJSON(anz))
 	}
@@ -67,8 +67,8 @@ func TestAsteriskAgentReload(t *testing.T) {
 	srvMngr := servmanager.NewServiceManager(cfg, shdChan, shdWg, cm)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	db := NewDataDBService(cfg, cm, false, srvDep)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
-	sS := NewSessionService(cfg, db, server, make(chan birpc.ClientConnector, 1),
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
+	sS := NewSessionService(cfg, db, filterSChan, server, make(chan birpc.ClientConnector, 1),
 		cm, anz, srvDep)
 	astService := NewAsteriskAgent(cfg, shdChan, cm, nil, srvDep)
 	srvMngr.AddServices(astService, sS, db)
@@ -139,8 +139,8 @@ func TestAsteriskAgentReload2(t *testing.T) {
 	srvMngr := servmanager.NewServiceManager(cfg, shdChan, shdWg, cm)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	db := NewDataDBService(cfg, cm, false, srvDep)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
-	sS := NewSessionService(cfg, db, server, make(chan birpc.ClientConnector, 1),
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
+	sS := NewSessionService(cfg, db, filterSChan, server, make(chan birpc.ClientConnector, 1),
 		cm, anz, srvDep)
 	astSrv := NewAsteriskAgent(cfg, shdChan, cm, nil, srvDep)
 	srvMngr.AddServices(astSrv, sS, db)
@@ -0,0 +1,122 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/servmanager"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewAuditService returns the AuditS service
+func NewAuditService(cfg *config.CGRConfig, dm *DataDBService,
+	storDB *StorDBService, server *cores.Server,
+	connMgr *engine.ConnManager, srvDep map[string]*sync.WaitGroup) servmanager.Service {
+	return &AuditService{
+		cfg:     cfg,
+		dm:      dm,
+		storDB:  storDB,
+		server:  server,
+		connMgr: connMgr,
+		srvDep:  srvDep,
+	}
+}
+
+// AuditService implements Service interface
+type AuditService struct {
+	sync.RWMutex
+	cfg     *config.CGRConfig
+	dm      *DataDBService
+	storDB  *StorDBService
+	server  *cores.Server
+	connMgr *engine.ConnManager
+
+	aS       *engine.AuditS
+	stopChan chan struct{}
+	srvDep   map[string]*sync.WaitGroup
+}
+
+// Start should handle the service start
+func (audS *AuditService) Start() error {
+	if audS.IsRunning() {
+		return utils.ErrServiceAlreadyRunning
+	}
+
+	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem", utils.CoreS, utils.AuditS))
+
+	dbchan := audS.dm.GetDMChan()
+	datadb := <-dbchan
+	dbchan <- datadb
+
+	storDBChan := make(chan engine.StorDB, 1)
+	audS.stopChan = make(chan struct{})
+	audS.storDB.RegisterSyncChan(storDBChan)
+
+	audS.Lock()
+	defer audS.Unlock()
+
+	audS.aS = engine.NewAuditS(audS.cfg, storDBChan, datadb, audS.connMgr)
+	go audS.aS.ListenAndServe(audS.stopChan)
+	srv, err := engine.NewService(v1.NewAuditSv1(audS.aS))
+	if err != nil {
+		return err
+	}
+	if !audS.cfg.DispatcherSCfg().Enabled {
+		audS.server.RpcRegister(srv)
+	}
+	audS.server.SetAuditS(audS.aS)
+	return nil
+}
+
+// Reload handles the change of config
+func (audS *AuditService) Reload() (err error) {
+	return // the AuditS reads the config on each request
+}
+
+// Shutdown stops the service
+func (audS *AuditService) Shutdown() (err error) {
+	audS.Lock()
+	audS.server.SetAuditS(nil)
+	close(audS.stopChan)
+	audS.aS = nil
+	audS.Unlock()
+	return
+}
+
+// IsRunning returns if the service is running
+func (audS *AuditService) IsRunning() bool {
+	audS.RLock()
+	defer audS.RUnlock()
+	return audS.aS != nil
+}
+
+// ServiceName returns the service name
+func (audS *AuditService) ServiceName() string {
+	return utils.AuditS
+}
+
+// ShouldRun returns if the service should be running
+func (audS *AuditService) ShouldRun() bool {
+	return audS.cfg.AuditCfg().Enabled
+}
@@ -84,6 +84,9 @@ func (cS *CoreService) Start() error {
 		cS.server.RpcRegister(srv)
 	}
 	cS.connChan <- cS.anz.GetInternalCodec(srv, utils.CoreS)
+	if cS.cfg.CoreSCfg().ConfigWatch {
+		return cS.cS.WatchConfig(cS.stopChan)
+	}
 	return nil
 }
 
@@ -47,6 +47,7 @@ type DataDBService struct {
 	connMgr  *engine.ConnManager
 
 	dm          *engine.DataManager
+	cdc         *engine.CDCStream // exports the DataDB changes, outlives the Reconnect
 	dbchan      chan *engine.DataManager
 	setVersions bool
 
@@ -75,6 +76,8 @@ func (db *DataDBService) Start() (err error) {
 		return
 	}
 	db.dm = engine.NewDataManager(dbConn, db.cfg.CacheCfg(), db.connMgr)
+	db.cdc = engine.NewCDCStream(db.connMgr)
+	db.dm.SetCDCStream(db.cdc)
 	engine.SetDataStorage(db.dm)
 
 	if db.setVersions {
@@ -85,6 +88,11 @@ func (db *DataDBService) Start() (err error) {
 	if err != nil {
 		return err
 	}
+	if errMig := db.dm.ResumeDataDBMigration(db.cfg); errMig == nil {
+		utils.Logger.Info(fmt.Sprintf("<%s> resumed the DataDB migration", utils.DataDB))
+	} else if errMig != utils.ErrNotFound {
+		utils.Logger.Warning(fmt.Sprintf("<%s> could not resume the DataDB migration: %s", utils.DataDB, errMig))
+	}
 
 	db.dbchan <- db.dm
 	return
@@ -103,7 +111,7 @@ func (db *DataDBService) Reload() (err error) {
 		return
 	}
 	if db.cfg.DataDbCfg().Type == utils.MetaMongo {
-		mgo, canCast := db.dm.DataDB().(*engine.MongoStorage)
+		mgo, canCast := engine.UnwrapDataDB(db.dm.DataDB()).(*engine.MongoStorage)
 		if !canCast {
 			return fmt.Errorf("can't conver DataDB of type %s to MongoStorage",
 				db.cfg.DataDbCfg().Type)
@@ -118,7 +126,8 @@ func (db *DataDBService) Shutdown() (err error) {
 	db.srvDep[utils.DataDB].Wait()
 	db.Lock()
 	db.dm.Close()
-	db.dm = nil
+	db.cdc.Close()
+	db.dm, db.cdc = nil, nil
 	db.Unlock()
 	return
 }
@@ -145,7 +154,8 @@ func (db *DataDBService) mandatoryDB() bool {
 	return db.cfg.RalsCfg().Enabled || db.cfg.SchedulerCfg().Enabled || db.cfg.ChargerSCfg().Enabled ||
 		db.cfg.AttributeSCfg().Enabled || db.cfg.ResourceSCfg().Enabled || db.cfg.StatSCfg().Enabled ||
 		db.cfg.ThresholdSCfg().Enabled || db.cfg.RouteSCfg().Enabled || db.cfg.DispatcherSCfg().Enabled ||
-		db.cfg.ApierCfg().Enabled || db.cfg.AnalyzerSCfg().Enabled || db.cfg.ERsCfg().Enabled
+		db.cfg.ApierCfg().Enabled || db.cfg.AnalyzerSCfg().Enabled || db.cfg.ERsCfg().Enabled ||
+		db.cfg.AuditCfg().Enabled
 }
 
 // GetDM returns the DataManager
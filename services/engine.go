@@ -182,9 +182,13 @@ func startRPC(server *cores.Server, internalRaterChan,
 		cfg.HTTPCfg().HTTPAuthUsers,
 		shdChan,
 	)
+	if cfg.ListenCfg().GRPCListen != utils.EmptyString {
+		go server.ServeGRPC(cfg.ListenCfg().GRPCListen, shdChan)
+	}
 	if (len(cfg.ListenCfg().RPCGOBTLSListen) != 0 ||
 		len(cfg.ListenCfg().RPCJSONTLSListen) != 0 ||
-		len(cfg.ListenCfg().HTTPTLSListen) != 0) &&
+		len(cfg.ListenCfg().HTTPTLSListen) != 0 ||
+		len(cfg.ListenCfg().GRPCTLSListen) != 0) &&
 		(len(cfg.TLSCfg().ServerCerificate) == 0 ||
 			len(cfg.TLSCfg().ServerKey) == 0) {
 		utils.Logger.Warning("WARNING: missing TLS certificate/key file!")
@@ -212,6 +216,17 @@ func startRPC(server *cores.Server, internalRaterChan,
 			shdChan,
 		)
 	}
+	if cfg.ListenCfg().GRPCTLSListen != utils.EmptyString {
+		go server.ServeGRPCTLS(
+			cfg.ListenCfg().GRPCTLSListen,
+			cfg.TLSCfg().ServerCerificate,
+			cfg.TLSCfg().ServerKey,
+			cfg.TLSCfg().CaCertificate,
+			cfg.TLSCfg().ServerPolicy,
+			cfg.TLSCfg().ServerName,
+			shdChan,
+		)
+	}
 	if cfg.ListenCfg().HTTPTLSListen != utils.EmptyString {
 		go server.ServeHTTPTLS(
 			cfg.ListenCfg().HTTPTLSListen,
@@ -230,8 +245,8 @@ func startRPC(server *cores.Server, internalRaterChan,
 	}
 }
 
-func startBiRPC(smg *SessionService, tS *ThresholdService, server *cores.Server,
-	shdChan *utils.SyncedChan) {
+func startBiRPC(smg *SessionService, tS *ThresholdService, stS *StatService,
+	server *cores.Server, shdChan *utils.SyncedChan) {
 	onConns := []func(c birpc.ClientConnector){
 		func(c birpc.ClientConnector) {
 			smg.RLock()
@@ -263,6 +278,13 @@ func startBiRPC(smg *SessionService, tS *ThresholdService, server *cores.Server,
 				tS.thrs.OnBiJSONDisconnect(c)
 			}
 		},
+		func(c birpc.ClientConnector) {
+			stS.RLock()
+			defer stS.RUnlock()
+			if stS.sts != nil {
+				stS.sts.OnBiJSONDisconnect(c)
+			}
+		},
 	}
 	go func() {
 		<-shdChan.Done()
@@ -434,6 +456,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	internalStatSChan := make(chan birpc.ClientConnector, 1)
 	internalTrendSChan := make(chan birpc.ClientConnector, 1)
 	internalRankingSChan := make(chan birpc.ClientConnector, 1)
+	internalFraudSChan := make(chan birpc.ClientConnector, 1)
 	internalResourceSChan := make(chan birpc.ClientConnector, 1)
 	internalIPsChan := make(chan birpc.ClientConnector, 1)
 	internalRouteSChan := make(chan birpc.ClientConnector, 1)
@@ -464,6 +487,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRoutes):         internalRouteSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaTrends):         internalTrendSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRankings):       internalRankingSChan,
+		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaFrauds):         internalFraudSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds):     internalThresholdSChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaServiceManager): internalServeManagerChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaConfig):         internalConfigChan,
@@ -478,6 +502,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	})
 	srvDep := map[string]*sync.WaitGroup{
 		utils.AnalyzerS:       new(sync.WaitGroup),
+		utils.AuditS:          new(sync.WaitGroup),
 		utils.APIerSv1:        new(sync.WaitGroup),
 		utils.APIerSv2:        new(sync.WaitGroup),
 		utils.AsteriskAgent:   new(sync.WaitGroup),
@@ -508,6 +533,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 		utils.StatS:           new(sync.WaitGroup),
 		utils.TrendS:          new(sync.WaitGroup),
 		utils.RankingS:        new(sync.WaitGroup),
+		utils.FraudS:          new(sync.WaitGroup),
 		utils.StorDB:          new(sync.WaitGroup),
 		utils.ThresholdS:      new(sync.WaitGroup),
 		utils.AccountS:        new(sync.WaitGroup),
@@ -546,7 +572,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	filterSChan := make(chan *engine.FilterS, 1)
 
 	// init AnalyzerS
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, internalAnalyzerSChan, srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, internalAnalyzerSChan, connManager, srvDep)
 	if anz.ShouldRun() {
 		shdWg.Add(1)
 		if err := anz.Start(); err != nil {
@@ -590,6 +616,8 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 		internalTrendSChan, connManager, anz, srvDep)
 	rnS := NewRankingService(cfg, dmService, cacheS, filterSChan, server,
 		internalRankingSChan, connManager, anz, srvDep)
+	frS := NewFraudService(cfg, dmService, cacheS, filterSChan, server,
+		internalFraudSChan, connManager, anz, srvDep)
 	reS := NewResourceService(cfg, dmService, cacheS, filterSChan, server,
 		internalResourceSChan, connManager, anz, srvDep)
 	ips := NewIPService(cfg, dmService, cacheS, filterSChan, server,
@@ -612,9 +640,9 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	cdrS := NewCDRServer(cfg, dmService, storDBService, filterSChan, server, internalCDRServerChan,
 		connManager, anz, srvDep)
 
-	smg := NewSessionService(cfg, dmService, server, internalSessionSChan, connManager, anz, srvDep)
+	smg := NewSessionService(cfg, dmService, filterSChan, server, internalSessionSChan, connManager, anz, srvDep)
 
-	srvManager.AddServices(gvService, attrS, chrS, tS, stS, trS, rnS, reS, ips, routeS, schS, rals,
+	srvManager.AddServices(gvService, attrS, chrS, tS, stS, trS, rnS, frS, reS, ips, routeS, schS, rals,
 		apiSv1, apiSv2, cdrS, smg, coreS,
 		NewDNSAgent(cfg, filterSChan, shdChan, connManager, caps, srvDep),
 		NewFreeswitchAgent(cfg, shdChan, connManager, caps, srvDep),
@@ -631,6 +659,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 			shdChan, connManager, server, internalERsChan, anz, srvDep),
 		NewSIPAgent(cfg, filterSChan, shdChan, connManager, caps, srvDep),
 		NewJanusAgent(cfg, filterSChan, server, connManager, caps, srvDep),
+		NewAuditService(cfg, dmService, storDBService, server, connManager, srvDep),
 	)
 	srvManager.StartServices()
 	// Start FilterS
@@ -661,6 +690,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	engine.IntRPC.AddInternalRPCClient(utils.StatSv1, internalStatSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.TrendSv1, internalTrendSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.RankingSv1, internalRankingSChan)
+	engine.IntRPC.AddInternalRPCClient(utils.FraudSv1, internalFraudSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.RouteSv1, internalRouteSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.ThresholdSv1, internalThresholdSChan)
 	engine.IntRPC.AddInternalRPCClient(utils.ServiceManagerV1, internalServeManagerChan)
@@ -679,7 +709,7 @@ func RunCGREngine(args []string, hooks ...func(*config.CGRConfig) error) {
 	// Start Serving BiRPC
 	if cfg.ListenCfg().BiJSONListen != utils.EmptyString ||
 		cfg.ListenCfg().BiGobListen != utils.EmptyString {
-		go startBiRPC(smg, tS, server, shdChan)
+		go startBiRPC(smg, tS, stS, server, shdChan)
 	}
 
 	// Serve rpc connections
@@ -0,0 +1,128 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cgrates/birpc"
+	v1 "github.com/cgrates/cgrates/apier/v1"
+	"github.com/cgrates/cgrates/config"
+	"github.com/cgrates/cgrates/cores"
+	"github.com/cgrates/cgrates/engine"
+	"github.com/cgrates/cgrates/servmanager"
+	"github.com/cgrates/cgrates/utils"
+)
+
+// NewFraudService returns the FraudS Service
+func NewFraudService(cfg *config.CGRConfig, dm *DataDBService,
+	cacheS *engine.CacheS, filterSChan chan *engine.FilterS,
+	server *cores.Server, internalFraudSChan chan birpc.ClientConnector,
+	connMgr *engine.ConnManager, anz *AnalyzerService,
+	srvDep map[string]*sync.WaitGroup) servmanager.Service {
+	return &FraudService{
+		connChan:    internalFraudSChan,
+		cfg:         cfg,
+		dm:          dm,
+		cacheS:      cacheS,
+		filterSChan: filterSChan,
+		server:      server,
+		connMgr:     connMgr,
+		anz:         anz,
+		srvDep:      srvDep,
+	}
+}
+
+// FraudService implements Service interface
+type FraudService struct {
+	sync.RWMutex
+	cfg         *config.CGRConfig
+	dm          *DataDBService
+	cacheS      *engine.CacheS
+	filterSChan chan *engine.FilterS
+	server      *cores.Server
+	connMgr     *engine.ConnManager
+	connChan    chan birpc.ClientConnector
+	anz         *AnalyzerService
+	srvDep      map[string]*sync.WaitGroup
+	frS         *engine.FraudS
+}
+
+// Start should handle the service start
+func (fr *FraudService) Start() error {
+	if fr.IsRunning() {
+		return utils.ErrServiceAlreadyRunning
+	}
+	fr.srvDep[utils.DataDB].Add(1)
+	<-fr.cacheS.GetPrecacheChannel(utils.CacheFraudProfiles)
+	<-fr.cacheS.GetPrecacheChannel(utils.CacheFraudCases)
+	<-fr.cacheS.GetPrecacheChannel(utils.CacheFraudFilterIndexes)
+	filterS := <-fr.filterSChan
+	fr.filterSChan <- filterS
+	dbchan := fr.dm.GetDMChan()
+	datadb := <-dbchan
+	dbchan <- datadb
+	utils.Logger.Info(fmt.Sprintf("<%s> starting <%s> subsystem",
+		utils.CoreS, utils.FraudS))
+
+	fr.Lock()
+	defer fr.Unlock()
+	fr.frS = engine.NewFraudS(fr.cfg, datadb, filterS, fr.connMgr)
+	srv, err := engine.NewService(v1.NewFraudSv1(fr.frS))
+	if err != nil {
+		return err
+	}
+	if !fr.cfg.DispatcherSCfg().Enabled {
+		fr.server.RpcRegister(srv)
+	}
+	fr.connChan <- fr.anz.GetInternalCodec(srv, utils.FraudS)
+	return nil
+}
+
+// Reload handles the change of config
+func (fr *FraudService) Reload() (err error) {
+	return // FraudS reads the config on each event
+}
+
+// Shutdown stops the service
+func (fr *FraudService) Shutdown() (err error) {
+	defer fr.srvDep[utils.DataDB].Done()
+	fr.Lock()
+	defer fr.Unlock()
+	fr.frS = nil
+	<-fr.connChan
+	return
+}
+
+// IsRunning returns if the service is running
+func (fr *FraudService) IsRunning() bool {
+	fr.RLock()
+	defer fr.RUnlock()
+	return fr.frS != nil
+}
+
+// ServiceName returns the service name
+func (fr *FraudService) ServiceName() string {
+	return utils.FraudS
+}
+
+// ShouldRun returns if the service should be running
+func (fr *FraudService) ShouldRun() bool {
+	return fr.cfg.FraudSCfg().Enabled
+}
@@ -19,6 +19,7 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>
 package services
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/cgrates/cgrates/ees"
@@ -49,17 +50,53 @@ func (gv *GlobalVarS) Start() (err error) {
 	engine.SetRoundingDecimals(gv.cfg.GeneralCfg().RoundingDecimals)
 	ees.InitFailedPostCache(gv.cfg.EEsCfg().FailedPosts.TTL, gv.cfg.EEsCfg().FailedPosts.StaticTTL)
 	engine.SetHTTPPstrTransport(gv.cfg.HTTPCfg().ClientOpts)
-	return nil
+	if err = loadTracer(gv.cfg.TracingCfg()); err != nil {
+		return
+	}
+	return utils.GeoIP.Load(gv.cfg.GeoIPCfg().CityDBPath, gv.cfg.GeoIPCfg().ASNDBPath)
 }
 
 // Reload handles the change of config
 func (gv *GlobalVarS) Reload() (err error) {
 	engine.SetHTTPPstrTransport(gv.cfg.HTTPCfg().ClientOpts)
-	return nil
+	if err = loadTracer(gv.cfg.TracingCfg()); err != nil {
+		return
+	}
+	return utils.GeoIP.Load(gv.cfg.GeoIPCfg().CityDBPath, gv.cfg.GeoIPCfg().ASNDBPath)
 }
 
 // Shutdown stops the service
 func (gv *GlobalVarS) Shutdown() (err error) {
+	utils.GeoIP.Close()
+	utils.Tracer.Close()
+	return
+}
+
+// loadTracer creates the span exporters based on config
+func loadTracer(trCfg *config.TracingCfg) (err error) {
+	if !trCfg.Enabled {
+		utils.Tracer.Close()
+		return
+	}
+	exps := make([]utils.SpanExporter, 0, len(trCfg.Exporters))
+	for _, expType := range trCfg.Exporters {
+		switch expType {
+		case utils.MetaMemory:
+			exps = append(exps, utils.NewMemorySpanExporter(trCfg.MemoryLimit))
+		case utils.MetaFile:
+			var fileExp *utils.FileSpanExporter
+			if fileExp, err = utils.NewFileSpanExporter(trCfg.FilePath); err != nil {
+				for _, exp := range exps {
+					exp.Close()
+				}
+				return fmt.Errorf("<%s> cannot open <%s>: %s", utils.TracingLog, trCfg.FilePath, err)
+			}
+			exps = append(exps, fileExp)
+		case utils.MetaOTLP:
+			exps = append(exps, utils.NewOTLPSpanExporter(trCfg.OTLPURL, utils.CGRateSLwr, trCfg.ExportInterval))
+		}
+	}
+	utils.Tracer.Load(trCfg.SampleRatio, trCfg.DBSpans, exps...)
 	return
 }
 
@@ -41,7 +41,7 @@ func TestRalsCoverage(t *testing.T) {
 	server := cores.NewServer(nil)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	cfg.StorDbCfg().Type = utils.MetaInternal
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
 	ralS := NewRalService(cfg, chS, server,
 		make(chan birpc.ClientConnector, 1),
 		make(chan birpc.ClientConnector, 1),
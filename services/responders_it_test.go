@@ -43,7 +43,7 @@ func TestResponderSReload(t *testing.T) {
 	shdChan := utils.NewSyncedChan()
 	server := cores.NewServer(nil)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
 	internalChan := make(chan birpc.ClientConnector, 1)
 	srv := NewResponderService(cfg, server, internalChan,
 		shdChan, anz, srvDep, filterSChan)
@@ -100,7 +100,7 @@ func TestResponderSReload2(t *testing.T) {
 	shdChan := utils.NewSyncedChan()
 	server := cores.NewServer(nil)
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
 	internalChan := make(chan birpc.ClientConnector, 1)
 	srv := NewResponderService(cfg, server, internalChan,
 		shdChan, anz, srvDep, filterSChan)
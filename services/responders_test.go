@@ -39,7 +39,7 @@ func TestResponderCoverage(t *testing.T) {
 	filterSChan <- nil
 	srvDep := map[string]*sync.WaitGroup{utils.DataDB: new(sync.WaitGroup)}
 	anz := NewAnalyzerService(cfg, server, filterSChan,
-		shdChan, make(chan birpc.ClientConnector, 1), srvDep)
+		shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
 	srv := NewResponderService(cfg, server, internalChan,
 		shdChan, anz, srvDep, filterSChan)
 	if srv == nil {
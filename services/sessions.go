@@ -34,27 +34,30 @@ import (
 
 // NewSessionService returns the Session Service
 func NewSessionService(cfg *config.CGRConfig, dm *DataDBService,
+	filterSChan chan *engine.FilterS,
 	server *cores.Server, internalChan chan birpc.ClientConnector,
 	connMgr *engine.ConnManager, anz *AnalyzerService,
 	srvDep map[string]*sync.WaitGroup) *SessionService {
 	return &SessionService{
-		connChan: internalChan,
-		cfg:      cfg,
-		dm:       dm,
-		server:   server,
-		connMgr:  connMgr,
-		anz:      anz,
-		srvDep:   srvDep,
+		connChan:    internalChan,
+		cfg:         cfg,
+		dm:          dm,
+		filterSChan: filterSChan,
+		server:      server,
+		connMgr:     connMgr,
+		anz:         anz,
+		srvDep:      srvDep,
 	}
 }
 
 // SessionService implements Service interface
 type SessionService struct {
 	sync.RWMutex
-	cfg      *config.CGRConfig
-	dm       *DataDBService
-	server   *cores.Server
-	stopChan chan struct{}
+	cfg         *config.CGRConfig
+	dm          *DataDBService
+	filterSChan chan *engine.FilterS
+	server      *cores.Server
+	stopChan    chan struct{}
 
 	sm       *sessions.SessionS
 	connChan chan birpc.ClientConnector
@@ -70,6 +73,8 @@ func (smg *SessionService) Start() error {
 		return utils.ErrServiceAlreadyRunning
 	}
 	smg.srvDep[utils.DataDB].Add(1) // DataDB will wait for session service to close before closing
+	filterS := <-smg.filterSChan
+	smg.filterSChan <- filterS
 	var datadb *engine.DataManager
 	if smg.dm.ShouldRun() {
 		dbchan := smg.dm.GetDMChan()
@@ -79,7 +84,7 @@ func (smg *SessionService) Start() error {
 	smg.Lock()
 	defer smg.Unlock()
 
-	smg.sm = sessions.NewSessionS(smg.cfg, datadb, smg.connMgr)
+	smg.sm = sessions.NewSessionS(smg.cfg, datadb, filterS, smg.connMgr)
 	smg.stopChan = make(chan struct{})
 
 	// Pass internal connection
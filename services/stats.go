@@ -27,7 +27,6 @@ import (
 	"github.com/cgrates/cgrates/config"
 	"github.com/cgrates/cgrates/cores"
 	"github.com/cgrates/cgrates/engine"
-	"github.com/cgrates/cgrates/servmanager"
 	"github.com/cgrates/cgrates/utils"
 )
 
@@ -36,7 +35,7 @@ func NewStatService(cfg *config.CGRConfig, dm *DataDBService,
 	cacheS *engine.CacheS, filterSChan chan *engine.FilterS,
 	server *cores.Server, internalStatSChan chan birpc.ClientConnector,
 	connMgr *engine.ConnManager, anz *AnalyzerService,
-	srvDep map[string]*sync.WaitGroup) servmanager.Service {
+	srvDep map[string]*sync.WaitGroup) *StatService {
 	return &StatService{
 		connChan:    internalStatSChan,
 		cfg:         cfg,
@@ -98,6 +97,10 @@ func (sts *StatService) Start() error {
 		sts.server.RpcRegister(srv)
 	}
 	sts.connChan <- sts.anz.GetInternalCodec(srv, utils.StatS)
+	// Register BiRpc handlers
+	if sts.cfg.ListenCfg().BiJSONListen != "" || sts.cfg.ListenCfg().BiGobListen != "" {
+		sts.server.BiRPCRegisterName(utils.StatSv1, srv)
+	}
 	return nil
 }
 
@@ -115,6 +118,9 @@ func (sts *StatService) Shutdown() (err error) {
 	sts.Lock()
 	defer sts.Unlock()
 	sts.sts.Shutdown()
+	if sts.cfg.ListenCfg().BiJSONListen != "" || sts.cfg.ListenCfg().BiGobListen != "" {
+		_ = sts.server.BiRPCUnregisterName(utils.StatSv1)
+	}
 	sts.sts = nil
 	<-sts.connChan
 	return
@@ -103,7 +103,7 @@ func (db *StorDBService) Reload() (err error) {
 		return
 	}
 	if db.cfg.StorDbCfg().Type == utils.MetaMongo {
-		mgo, canCast := db.db.(*engine.MongoStorage)
+		mgo, canCast := engine.UnwrapStorDB(db.db).(*engine.MongoStorage)
 		if !canCast {
 			return fmt.Errorf("can't conver StorDB of type %s to MongoStorage",
 				db.cfg.StorDbCfg().Type)
@@ -111,7 +111,7 @@ func (db *StorDBService) Reload() (err error) {
 		mgo.SetTTL(db.cfg.StorDbCfg().Opts.MongoQueryTimeout)
 	} else if db.cfg.StorDbCfg().Type == utils.MetaPostgres ||
 		db.cfg.StorDbCfg().Type == utils.MetaMySQL {
-		msql, canCast := db.db.(*engine.SQLStorage)
+		msql, canCast := engine.UnwrapStorDB(db.db).(*engine.SQLStorage)
 		if !canCast {
 			return fmt.Errorf("can't conver StorDB of type %s to SQLStorage",
 				db.cfg.StorDbCfg().Type)
@@ -120,7 +120,7 @@ func (db *StorDBService) Reload() (err error) {
 		msql.Db.SetMaxIdleConns(db.cfg.StorDbCfg().Opts.SQLMaxIdleConns)
 		msql.Db.SetConnMaxLifetime(db.cfg.StorDbCfg().Opts.SQLConnMaxLifetime)
 	} else if db.cfg.StorDbCfg().Type == utils.MetaInternal {
-		idb, canCast := db.db.(*engine.InternalDB)
+		idb, canCast := engine.UnwrapStorDB(db.db).(*engine.InternalDB)
 		if !canCast {
 			return fmt.Errorf("can't conver StorDB of type %s to InternalDB",
 				db.cfg.StorDbCfg().Type)
@@ -163,7 +163,8 @@ func (db *StorDBService) ServiceName() string {
 
 // ShouldRun returns if the service should be running
 func (db *StorDBService) ShouldRun() bool {
-	return db.cfg.RalsCfg().Enabled || db.cfg.CdrsCfg().Enabled || db.cfg.ApierCfg().Enabled
+	return db.cfg.RalsCfg().Enabled || db.cfg.CdrsCfg().Enabled || db.cfg.ApierCfg().Enabled ||
+		db.cfg.AuditCfg().Enabled
 }
 
 // RegisterSyncChan used by dependent subsystems to register a chanel to reload only the storDB(thread safe)
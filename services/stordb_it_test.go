@@ -51,7 +51,7 @@ func TestStorDBReload(t *testing.T) {
 	db := NewDataDBService(cfg, nil, false, srvDep)
 	cfg.StorDbCfg().Password = "CGRateS.org"
 	stordb := NewStorDBService(cfg, false, srvDep)
-	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), srvDep)
+	anz := NewAnalyzerService(cfg, server, filterSChan, shdChan, make(chan birpc.ClientConnector, 1), nil, srvDep)
 	chrS := NewChargerService(cfg, db, chS, filterSChan, server, make(chan birpc.ClientConnector, 1), nil, anz, srvDep)
 	schS := NewSchedulerService(cfg, db, chS, filterSChan, server, make(chan birpc.ClientConnector, 1), nil, anz, srvDep)
 	ralS := NewRalService(cfg, chS, server,
@@ -179,7 +179,7 @@ func TestStorDBReloadVersion1(t *testing.T) {
 
 	err = storageDb.SetVersions(engine.Versions{
 		utils.CostDetails:   2,
-		utils.SessionSCosts: 3,
+		utils.SessionSCosts: 4,
 		//old version for CDRs
 		utils.CDRs:               1,
 		utils.TpRatingPlans:      1,
@@ -189,20 +189,21 @@ func TestStorDBReloadVersion1(t *testing.T) {
 		utils.TpAccountActionsV:  1,
 		utils.TpActionPlans:      1,
 		utils.TpActions:          1,
-		utils.TpThresholds:       1,
-		utils.TpRoutes:           1,
-		utils.TpStats:            1,
+		utils.TpThresholds:       2,
+		utils.TpRoutes:           2,
+		utils.TpStats:            2,
 		utils.TpSharedGroups:     1,
 		utils.TpRatingProfiles:   1,
-		utils.TpResources:        1,
+		utils.TpResources:        2,
 		utils.TpRates:            1,
 		utils.TpTiming:           1,
 		utils.TpResource:         1,
 		utils.TpDestinations:     1,
 		utils.TpRatingPlan:       1,
 		utils.TpRatingProfile:    1,
-		utils.TpChargers:         1,
+		utils.TpChargers:         2,
 		utils.TpDispatchers:      1,
+		utils.TpAttributes:       2,
 	}, true)
 
 	utils.Logger, _ = utils.Newlogger(utils.MetaSysLog, cfg.GeneralCfg().NodeID)
@@ -261,7 +262,7 @@ func TestStorDBReloadVersion2(t *testing.T) {
 
 	err = storageDb.SetVersions(engine.Versions{
 		utils.CostDetails:   2,
-		utils.SessionSCosts: 3,
+		utils.SessionSCosts: 4,
 		//old version for CDRs
 		utils.CDRs:               1,
 		utils.TpRatingPlans:      1,
@@ -271,20 +272,21 @@ func TestStorDBReloadVersion2(t *testing.T) {
 		utils.TpAccountActionsV:  1,
 		utils.TpActionPlans:      1,
 		utils.TpActions:          1,
-		utils.TpThresholds:       1,
-		utils.TpRoutes:           1,
-		utils.TpStats:            1,
+		utils.TpThresholds:       2,
+		utils.TpRoutes:           2,
+		utils.TpStats:            2,
 		utils.TpSharedGroups:     1,
 		utils.TpRatingProfiles:   1,
-		utils.TpResources:        1,
+		utils.TpResources:        2,
 		utils.TpRates:            1,
 		utils.TpTiming:           1,
 		utils.TpResource:         1,
 		utils.TpDestinations:     1,
 		utils.TpRatingPlan:       1,
 		utils.TpRatingProfile:    1,
-		utils.TpChargers:         1,
+		utils.TpChargers:         2,
 		utils.TpDispatchers:      1,
+		utils.TpAttributes:       2,
 	}, true)
 
 	utils.Logger, _ = utils.Newlogger(utils.MetaSysLog, cfg.GeneralCfg().NodeID)
@@ -339,7 +341,7 @@ func TestStorDBReloadVersion3(t *testing.T) {
 	}()
 	err = storageDb.SetVersions(engine.Versions{
 		utils.CostDetails:   2,
-		utils.SessionSCosts: 3,
+		utils.SessionSCosts: 4,
 		//old version for CDRs
 		utils.CDRs:               1,
 		utils.TpRatingPlans:      1,
@@ -349,20 +351,21 @@ func TestStorDBReloadVersion3(t *testing.T) {
 		utils.TpAccountActionsV:  1,
 		utils.TpActionPlans:      1,
 		utils.TpActions:          1,
-		utils.TpThresholds:       1,
-		utils.TpRoutes:           1,
-		utils.TpStats:            1,
+		utils.TpThresholds:       2,
+		utils.TpRoutes:           2,
+		utils.TpStats:            2,
 		utils.TpSharedGroups:     1,
 		utils.TpRatingProfiles:   1,
-		utils.TpResources:        1,
+		utils.TpResources:        2,
 		utils.TpRates:            1,
 		utils.TpTiming:           1,
 		utils.TpResource:         1,
 		utils.TpDestinations:     1,
 		utils.TpRatingPlan:       1,
 		utils.TpRatingProfile:    1,
-		utils.TpChargers:         1,
+		utils.TpChargers:         2,
 		utils.TpDispatchers:      1,
+		utils.TpAttributes:       2,
 	}, true)
 
 	utils.Logger, _ = utils.Newlogger(utils.MetaSysLog, cfg.GeneralCfg().NodeID)
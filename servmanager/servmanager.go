@@ -169,6 +169,8 @@ func (srvMngr *ServiceManager) handleReload() {
 			go srvMngr.reloadService(utils.TrendS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.RANKINGS_JSON):
 			go srvMngr.reloadService(utils.RankingS)
+		case <-srvMngr.GetConfig().GetReloadChan(config.FraudSJson):
+			go srvMngr.reloadService(utils.FraudS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.RESOURCES_JSON):
 			go srvMngr.reloadService(utils.ResourceS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.RouteSJson):
@@ -206,6 +208,8 @@ func (srvMngr *ServiceManager) handleReload() {
 			go srvMngr.reloadService(utils.PrometheusAgent)
 		case <-srvMngr.GetConfig().GetReloadChan(config.AnalyzerCfgJson):
 			go srvMngr.reloadService(utils.AnalyzerS)
+		case <-srvMngr.GetConfig().GetReloadChan(config.AuditCfgJson):
+			go srvMngr.reloadService(utils.AuditS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.DispatcherSJson):
 			go srvMngr.reloadService(utils.DispatcherS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.DATADB_JSN):
@@ -222,6 +226,10 @@ func (srvMngr *ServiceManager) handleReload() {
 			go srvMngr.reloadService(utils.RegistrarC)
 		case <-srvMngr.GetConfig().GetReloadChan(config.HTTP_JSN):
 			go srvMngr.reloadService(utils.GlobalVarS)
+		case <-srvMngr.GetConfig().GetReloadChan(config.GeoIPCfgJson):
+			go srvMngr.reloadService(utils.GlobalVarS)
+		case <-srvMngr.GetConfig().GetReloadChan(config.TracingCfgJson):
+			go srvMngr.reloadService(utils.GlobalVarS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.CoreSCfgJson):
 			go srvMngr.reloadService(utils.CoreS)
 		case <-srvMngr.GetConfig().GetReloadChan(config.JanusAgentJson):
@@ -40,6 +40,7 @@ import (
 // NewSessionS constructs  a new SessionS instance
 func NewSessionS(cgrCfg *config.CGRConfig,
 	dm *engine.DataManager,
+	fltrS *engine.FilterS,
 	connMgr *engine.ConnManager) *SessionS {
 	cgrCfg.SessionSCfg().SessionIndexes.Add(utils.OriginID) // Make sure we have indexing for OriginID since it is a requirement on prefix searching
 
@@ -56,6 +57,7 @@ func NewSessionS(cgrCfg *config.CGRConfig,
 		pSessionsRIdx:  make(map[string][]*riFieldNameVal),
 		bkpSessionIDs:  make(utils.StringSet),
 		removeSsCGRIDs: make(utils.StringSet),
+		subs:           engine.NewSubscriptions(cgrCfg, fltrS, utils.SessionStart, utils.SessionEnd),
 	}
 }
 
@@ -72,6 +74,7 @@ func (sS *SessionS) OnBiJSONConnect(c birpc.ClientConnector) {
 // OnBiJSONDisconnect handles client disconnects.
 func (sS *SessionS) OnBiJSONDisconnect(c birpc.ClientConnector) {
 	sS.sBiRPCClients.OnBiJSONDisconnect(c)
+	sS.subs.RemoveClient(c)
 }
 
 // SessionS represents the session service
@@ -102,6 +105,8 @@ type SessionS struct {
 	removeSsCGRIDs    utils.StringSet // keep a record of session cgrids to be removed from dataDB backup
 	removeSsCGRIDsMux sync.RWMutex    // prevent concurrency when adding/deleting CGRIDs from map
 	storeSessMux      sync.RWMutex    // protects storeSessions
+
+	subs *engine.Subscriptions // BiRPC clients subscribed to the session start and end
 }
 
 // SyncSessions starts the service and binds it to the listen loop
@@ -126,6 +131,7 @@ func (sS *SessionS) Shutdown() (err error) {
 			utils.Logger.Err(fmt.Sprintf("Backup Sessions error on shutdown: <%v>", err))
 		}
 	}
+	sS.subs.Close()
 	return
 }
 
@@ -1666,6 +1672,7 @@ func (sS *SessionS) initSession(cgrEv *utils.CGREvent, clntConnID, originID stri
 		s.Lock() // avoid endsession before initialising
 		sS.initSessionDebitLoops(s)
 		sS.registerSession(s, false)
+		sS.publishSession(s, utils.SessionStart)
 		s.Unlock()
 	}
 	return
@@ -1841,6 +1848,9 @@ func (sS *SessionS) endSession(s *Session, tUsage, lastUsage *time.Duration,
 			sr.Event[utils.AnswerTime] = *aTime
 		}
 	}
+	if !isMsg {
+		sS.publishSession(s, utils.SessionEnd)
+	}
 	if errCh := engine.Cache.Set(utils.CacheClosedSessions, s.CGRID, s,
 		nil, true, utils.NonTransactional); errCh != nil {
 		return errCh
@@ -1856,6 +1866,23 @@ func (sS *SessionS) endSession(s *Session, tUsage, lastUsage *time.Duration,
 	return
 }
 
+// publishSession notifies the subscribers about the start or the end of the session
+// this function is not thread safe
+func (sS *SessionS) publishSession(s *Session, evType string) {
+	if !sS.subs.HasSubscribers() {
+		return
+	}
+	ev := s.EventStart.Clone()
+	ev[utils.EventType] = evType
+	ev[utils.CGRID] = s.CGRID
+	sS.subs.Publish(&utils.CGREvent{
+		Tenant: s.Tenant,
+		ID:     utils.GenUUID(),
+		Time:   utils.TimePointer(time.Now()),
+		Event:  ev,
+	})
+}
+
 // chargeEvent will charge a single event (ie: SMS)
 func (sS *SessionS) chargeEvent(cgrEv *utils.CGREvent, forceDuration bool) (maxUsage time.Duration, err error) {
 	var s *Session
@@ -3842,6 +3869,38 @@ func (sS *SessionS) BiRPCv1ProcessEvent(ctx *context.Context,
 		}
 	}
 
+	// score the events with FraudS if required
+	if argsFlagsWithParams.GetBool(utils.MetaFrauds) {
+		for runID, cgrEv := range getDerivedEvents(events, argsFlagsWithParams[utils.MetaFrauds].Has(utils.MetaDerivedReply)) {
+			if _, err := sS.processFrauds(cgrEv); err != nil &&
+				err.Error() != utils.ErrNotFound.Error() {
+				if blockError {
+					return utils.NewErrFraudS(err)
+				}
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: %s processing event %+v for RunID <%s> with FraudS.",
+						utils.SessionS, err.Error(), cgrEv, runID))
+				withErrors = true
+			}
+		}
+	}
+
+	// report the outcome of the calls to the route breakers if required
+	if argsFlagsWithParams.GetBool(utils.MetaRouteBreakers) {
+		for runID, cgrEv := range getDerivedEvents(events, argsFlagsWithParams[utils.MetaRouteBreakers].Has(utils.MetaDerivedReply)) {
+			if err := sS.processRouteBreakers(cgrEv); err != nil &&
+				err.Error() != utils.ErrNotFound.Error() {
+				if blockError {
+					return utils.NewErrRouteS(err)
+				}
+				utils.Logger.Warning(
+					fmt.Sprintf("<%s> error: %s processing event %+v for RunID <%s> with RouteS.",
+						utils.SessionS, err.Error(), cgrEv, runID))
+				withErrors = true
+			}
+		}
+	}
+
 	if argsFlagsWithParams.GetBool(utils.MetaSTIRAuthenticate) {
 		for _, cgrEv := range getDerivedEvents(events, argsFlagsWithParams[utils.MetaSTIRAuthenticate].Has(utils.MetaDerivedReply)) {
 			ev := engine.MapEvent(cgrEv.Event)
@@ -4531,6 +4590,24 @@ func (sS *SessionS) processStats(cgrEv *utils.CGREvent, stsIDs []string, clnb bo
 	return
 }
 
+// processFrauds will receive the event and send it to FraudS to be scored
+func (sS *SessionS) processFrauds(cgrEv *utils.CGREvent) (caseIDs []string, err error) {
+	if len(sS.cgrCfg.SessionSCfg().FraudSConns) == 0 {
+		return caseIDs, utils.NewErrNotConnected(utils.FraudS)
+	}
+	err = sS.connMgr.Call(context.TODO(), sS.cgrCfg.SessionSCfg().FraudSConns, utils.FraudSv1ProcessEvent, cgrEv, &caseIDs)
+	return
+}
+
+// processRouteBreakers will send the event to RouteS to feed the route breakers
+func (sS *SessionS) processRouteBreakers(cgrEv *utils.CGREvent) (err error) {
+	if len(sS.cgrCfg.SessionSCfg().RouteSConns) == 0 {
+		return utils.NewErrNotConnected(utils.RouteS)
+	}
+	var reply string
+	return sS.connMgr.Call(context.TODO(), sS.cgrCfg.SessionSCfg().RouteSConns, utils.RouteSv1ProcessEvent, cgrEv, &reply)
+}
+
 // getRoutes will receive the event and send it to SupplierS to find the suppliers
 func (sS *SessionS) getRoutes(cgrEv *utils.CGREvent, pag utils.Paginator, ignoreErrors bool,
 	maxCost string, clnb bool) (routesReply engine.SortedRoutesList, err error) {
@@ -4943,6 +5020,27 @@ func (sS *SessionS) BiRPCv1BackupActiveSessions(ctx *context.Context,
 	return nil
 }
 
+// BiRPCv1Subscribe will push the session start and end events towards the BiRPC client, replying with the subscription ID
+func (sS *SessionS) BiRPCv1Subscribe(ctx *context.Context,
+	args *engine.SubscribeArgs, reply *string) (err error) {
+	var subID string
+	if subID, err = sS.subs.Subscribe(ctx.Client, args); err != nil {
+		return
+	}
+	*reply = subID
+	return
+}
+
+// BiRPCv1Unsubscribe will remove the subscription with the given ID
+func (sS *SessionS) BiRPCv1Unsubscribe(ctx *context.Context,
+	subID string, reply *string) (err error) {
+	if err = sS.subs.Unsubscribe(ctx.Client, subID); err != nil {
+		return
+	}
+	*reply = utils.OK
+	return
+}
+
 // ThresholdNotify creates and sends SNR to diameter agent
 func (sS *SessionS) BiRPCv1ThresholdNotify(ctx *context.Context,
 	cgrID string, rply *string) (err error) {
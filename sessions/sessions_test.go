@@ -93,7 +93,7 @@ func TestBiRPCv1RegisterInternalBiJSONConn(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	client := &birpc.Service{}
 	ctx := context.WithClient(context.Background(), client)
@@ -114,7 +114,7 @@ func TestSessionSIndexAndUnindexSessions(t *testing.T) {
 		"Extra4":      {},
 		"*req.Extra6": {},
 	}
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:       "TEST_EVENT",
 		utils.ToR:             "*voice",
@@ -471,7 +471,7 @@ func TestSessionSIndexAndUnindexSessions(t *testing.T) {
 
 func TestSessionSRegisterAndUnregisterASessions(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sSEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:    "TEST_EVENT",
 		utils.ToR:          "*voice",
@@ -689,7 +689,7 @@ func TestSessionSRegisterAndUnregisterASessions(t *testing.T) {
 
 func TestSessionSRegisterAndUnregisterPSessions(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sSEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:    "TEST_EVENT",
 		utils.ToR:          "*voice",
@@ -1596,7 +1596,7 @@ func TestV1ProcessEventReplyAsNavigableMap(t *testing.T) {
 
 func TestSessionStransitSState(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sSEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:    "TEST_EVENT",
 		utils.ToR:          "*voice",
@@ -1642,7 +1642,7 @@ func TestSessionStransitSState(t *testing.T) {
 
 func TestSessionSrelocateSessionS(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sSEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:    "TEST_EVENT",
 		utils.ToR:          "*voice",
@@ -1786,7 +1786,7 @@ func TestSessionSGetIndexedFilters(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	sS := NewSessionS(cfg, engine.NewDataManager(mpStr, config.CgrConfig().CacheCfg(), nil), nil)
+	sS := NewSessionS(cfg, engine.NewDataManager(mpStr, config.CgrConfig().CacheCfg(), nil), nil, nil)
 	expIndx := map[string][]string{}
 	expUindx := []*engine.FilterRule{
 		{
@@ -1807,7 +1807,7 @@ func TestSessionSGetIndexedFilters(t *testing.T) {
 	cfg.SessionSCfg().SessionIndexes = utils.StringSet{
 		"ToR": {},
 	}
-	sS = NewSessionS(cfg, engine.NewDataManager(mpStr, config.CgrConfig().CacheCfg(), nil), nil)
+	sS = NewSessionS(cfg, engine.NewDataManager(mpStr, config.CgrConfig().CacheCfg(), nil), nil, nil)
 	expIndx = map[string][]string{(utils.ToR): {utils.MetaVoice}}
 	expUindx = nil
 	if rplyindx, rplyUnindx := sS.getIndexedFilters("", fltrs); !reflect.DeepEqual(expIndx, rplyindx) {
@@ -1824,7 +1824,7 @@ func TestSessionSGetIndexedFilters(t *testing.T) {
 			ExpiryTime:     time.Now().Add(-time.Hour),
 		},
 	})
-	sS = NewSessionS(cfg, engine.NewDataManager(mpStr, config.CgrConfig().CacheCfg(), nil), nil)
+	sS = NewSessionS(cfg, engine.NewDataManager(mpStr, config.CgrConfig().CacheCfg(), nil), nil, nil)
 	expIndx = map[string][]string{}
 	expUindx = nil
 	fltrs = []string{"FLTR1", "FLTR2"}
@@ -1841,7 +1841,7 @@ func TestSessionSgetSessionIDsMatchingIndexes(t *testing.T) {
 	cfg.SessionSCfg().SessionIndexes = utils.StringSet{
 		"ToR": {},
 	}
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:       "TEST_EVENT",
 		utils.ToR:             "*voice",
@@ -1893,7 +1893,7 @@ func TestSessionSgetSessionIDsMatchingIndexes(t *testing.T) {
 		"ToR":    {},
 		"Extra3": {},
 	}
-	sS = NewSessionS(cfg, nil, nil)
+	sS = NewSessionS(cfg, nil, nil, nil)
 	sS.indexSession(session, false)
 	indx = map[string][]string{
 		"ToR":    {utils.MetaVoice, utils.MetaData},
@@ -1927,7 +1927,7 @@ func TestSessionSgetSessionIDsMatchingIndexes(t *testing.T) {
 		"ToR":    {},
 		"Extra2": {},
 	}
-	sS = NewSessionS(cfg, nil, nil)
+	sS = NewSessionS(cfg, nil, nil, nil)
 	sS.indexSession(session, true)
 	indx = map[string][]string{
 		"ToR":    {utils.MetaVoice, utils.MetaData},
@@ -1951,8 +1951,8 @@ func (*testRPCClientConnection) Call(string, any, any) error { return nil }
 
 func TestNewSessionS(t *testing.T) {
 	cgrCGF := config.NewDefaultCGRConfig()
-
-	sS := NewSessionS(cgrCGF, nil, nil)
+	fltrS := engine.NewFilterS(cgrCGF, nil, nil)
+	sS := NewSessionS(cgrCGF, nil, fltrS, nil)
 	eOut := &SessionS{
 		cgrCfg:         cgrCGF,
 		dm:             nil,
@@ -1965,6 +1965,7 @@ func TestNewSessionS(t *testing.T) {
 		pSessionsRIdx:  make(map[string][]*riFieldNameVal),
 		bkpSessionIDs:  make(utils.StringSet),
 		removeSsCGRIDs: make(utils.StringSet),
+		subs:           engine.NewSubscriptions(cgrCGF, fltrS, utils.SessionStart, utils.SessionEnd),
 	}
 	if !reflect.DeepEqual(sS, eOut) {
 		t.Errorf("Expected <%+v> , \nreceived: <%+v>", sS, eOut)
@@ -2149,7 +2150,7 @@ func TestV1ProcessMessageArgsParseFlags(t *testing.T) {
 
 func TestSessionSgetSession(t *testing.T) {
 	cfg := config.NewDefaultCGRConfig()
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sSEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:    "TEST_EVENT",
 		utils.ToR:          "*voice",
@@ -2196,7 +2197,7 @@ func TestSessionSfilterSessions(t *testing.T) {
 	cfg.SessionSCfg().SessionIndexes = utils.StringSet{
 		"ToR": {},
 	}
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:       "TEST_EVENT",
 		utils.ToR:             "*voice",
@@ -2327,7 +2328,7 @@ func TestSessionSfilterSessions(t *testing.T) {
 		"ToR":    {},
 		"Extra3": {},
 	}
-	sS = NewSessionS(cfg, nil, nil)
+	sS = NewSessionS(cfg, nil, nil, nil)
 	sS.registerSession(session, false)
 	fltrs = &utils.SessionFilter{Filters: []string{"*string:~*req.ToR:*voice", "*string:~*req.Subject:subject1"}}
 	if sess := sS.filterSessions(fltrs, false); !reflect.DeepEqual(expSess, sess) {
@@ -2372,7 +2373,7 @@ func TestSessionSfilterSessionsCount(t *testing.T) {
 	cfg.SessionSCfg().SessionIndexes = utils.StringSet{
 		"ToR": {},
 	}
-	sS := NewSessionS(cfg, nil, nil)
+	sS := NewSessionS(cfg, nil, nil, nil)
 	sEv := engine.NewMapEvent(map[string]any{
 		utils.EventName:       "TEST_EVENT",
 		utils.ToR:             "*voice",
@@ -2439,7 +2440,7 @@ func TestSessionSfilterSessionsCount(t *testing.T) {
 		"ToR":    {},
 		"Extra3": {},
 	}
-	sS = NewSessionS(cfg, nil, nil)
+	sS = NewSessionS(cfg, nil, nil, nil)
 	sS.registerSession(session, false)
 	fltrs = &utils.SessionFilter{Filters: []string{"*string:~*req.ToR:*voice", "*string:~*req.Subject:subject1"}}
 	if noSess := sS.filterSessionsCount(fltrs, false); noSess != 1 {
@@ -2457,7 +2458,7 @@ func TestSessionSfilterSessionsCount(t *testing.T) {
 	if noSess := sS.filterSessionsCount(fltrs, false); noSess != 2 {
 		t.Errorf("Expected %v , received: %s", 2, utils.ToJSON(noSess))
 	}
-	sS = NewSessionS(cfg, nil, nil)
+	sS = NewSessionS(cfg, nil, nil, nil)
 	sS.registerSession(session, true)
 	fltrs = &utils.SessionFilter{Filters: []string{fmt.Sprintf("*string:~*req.ToR:%s|%s", utils.MetaVoice, utils.MetaData)}}
 	if noSess := sS.filterSessionsCount(fltrs, true); noSess != 2 {
@@ -2599,7 +2600,7 @@ func TestWarnSession(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	sTestMock := &mockConnWarnDisconnect1{}
 	sessions.sBiRPCClients.RegisterIntBiJConn(sTestMock, "ClientConnIdtest", 0)
@@ -2609,7 +2610,7 @@ func TestWarnSession(t *testing.T) {
 	}
 
 	cfg.GeneralCfg().NodeID = "ClientConnIdtest2"
-	sessions = NewSessionS(cfg, dm, nil)
+	sessions = NewSessionS(cfg, dm, nil, nil)
 	sTestMock2 := &mockConnWarnDisconnect2{}
 	sessions.sBiRPCClients.RegisterIntBiJConn(sTestMock2, "ClientConnIdtest2", 0)
 	if err := sessions.warnSession("ClientConnIdtest2", nil); err == nil || err != utils.ErrNoActiveSession {
@@ -2644,7 +2645,7 @@ func TestInitSession(t *testing.T) {
 	conMng := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): clientConect,
 	})
-	sS := NewSessionS(cfg, nil, conMng)
+	sS := NewSessionS(cfg, nil, nil, conMng)
 	s, err := sS.initSession(&utils.CGREvent{
 		Tenant: "cgrates.org",
 		Event: map[string]any{
@@ -2734,7 +2735,7 @@ func TestBiRPCv1AuthorizeEventNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1AuthorizeArgs{
 		GetAttributes: true,
@@ -2807,7 +2808,7 @@ func TestBiRPCv1AuthorizeEventWithDigestNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1AuthorizeArgs{
 		GetAttributes: true,
@@ -2878,7 +2879,7 @@ func TestBiRPCv1InitiateSessionNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1InitSessionArgs{
 		GetAttributes: true,
@@ -2948,7 +2949,7 @@ func TestBiRPCv1InitiateSessionWithDigestNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1InitSessionArgs{
 		GetAttributes: true,
@@ -3016,7 +3017,7 @@ func TestBiRPCv1UpdateSessionNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1UpdateSessionArgs{
 		GetAttributes: true,
@@ -3069,7 +3070,7 @@ func TestBiRPCv1TerminateSessionNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1TerminateSessionArgs{
 		TerminateSession: true,
@@ -3138,7 +3139,7 @@ func TestBiRPCv1ProcessMessageNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1ProcessMessageArgs{
 		GetAttributes: true,
@@ -3209,7 +3210,7 @@ func TestBiRPCv1ProcessEventNoTenant(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(db, cfg.CacheCfg(), connMngr)
-	ss := NewSessionS(cfg, dm, connMngr)
+	ss := NewSessionS(cfg, dm, nil, connMngr)
 
 	args := &V1ProcessEventArgs{
 		Flags: []string{utils.MetaAttributes},
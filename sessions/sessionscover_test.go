@@ -47,7 +47,7 @@ func TestSetSTerminator(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ss := new(Session)
 
@@ -119,7 +119,7 @@ func TestSetSTerminatorError(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ss := &Session{}
 
@@ -195,7 +195,7 @@ func TestSetSTerminatorError(t *testing.T) {
 	log.SetOutput(buff)
 
 	cfg.SessionSCfg().SessionTTLMaxDelay = utils.DurationPointer(time.Second)
-	sessions = NewSessionS(cfg, dm, nil)
+	sessions = NewSessionS(cfg, dm, nil, nil)
 	ss.OptsStart = engine.MapEvent{
 		utils.OptsSessionsTTLLastUsed:  "1s",
 		utils.OptsSessionsTTLLastUsage: "5s",
@@ -220,7 +220,7 @@ func TestSetSTerminatorAutomaticTermination(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	sessionTTL := 3 * time.Millisecond
 	opts := engine.MapEvent{
@@ -255,7 +255,7 @@ func TestSetSTerminatorManualTermination(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	opts := engine.MapEvent{
 		utils.OptsSessionsTTL: "1s",
@@ -293,7 +293,7 @@ func TestForceSTerminatorManualTermination(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	expected := "MANDATORY_IE_MISSING: [connIDs]"
 	if err := sessions.forceSTerminate(ss, time.Second, nil, nil, nil,
@@ -314,7 +314,7 @@ func TestForceSTerminatorPostCDRs(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaCDRs): nil,
 	})
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -355,7 +355,7 @@ func TestForceSTerminatorReleaseSession(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): nil,
 	})
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -408,7 +408,7 @@ func TestForceSTerminatorClientCall(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): nil,
 	})
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 	sessions.sBiRPCClients.RegisterIntBiJConn(sTestMock, "ClientConnID", 0)
 
 	ss := &Session{
@@ -449,7 +449,7 @@ func TestDebitSession(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -538,7 +538,7 @@ func TestDebitSessionResponderMaxDebit(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs): sMock,
 	})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -609,7 +609,7 @@ func TestDebitSessionResponderMaxDebitError(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):      internalRpcChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler): internalRpcChan})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -648,7 +648,7 @@ func TestDebitSessionResponderMaxDebitError(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):      internalRpcChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler): internalRpcChan})
 	dm = engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions = NewSessionS(cfg, dm, connMgr)
+	sessions = NewSessionS(cfg, dm, nil, connMgr)
 
 	if maxDur, err := sessions.debitSession(ss, 0, 5*time.Minute,
 		utils.DurationPointer(time.Second)); err == nil || err != utils.ErrNotImplemented {
@@ -666,7 +666,7 @@ func TestInitSessionDebitLoops(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ss := &Session{
 		CGRID:         "CGRID",
@@ -716,7 +716,7 @@ func TestDebitLoopSessionErrorDebiting(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ss := &Session{
 		CGRID:         "CGRID",
@@ -764,7 +764,7 @@ func TestDebitLoopSessionErrorDebiting(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):      internalRpcChan,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaScheduler): internalRpcChan})
 	dm = engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions = NewSessionS(cfg, dm, connMgr)
+	sessions = NewSessionS(cfg, dm, nil, connMgr)
 
 	sTestMock := &testMockClientConnDiscSess{}
 	sessions.sBiRPCClients.RegisterIntBiJConn(sTestMock, "ClientConnIdtest", 0)
@@ -806,7 +806,7 @@ func TestDebitLoopSession(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs): sMock,
 	})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -872,7 +872,7 @@ func TestDebitLoopSessionFrcDiscLowerDbtInterval(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs): sMock,
 	})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -926,7 +926,7 @@ func TestDebitLoopSessionLowBalance(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs): sMock,
 	})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:      "CGRID",
@@ -992,7 +992,7 @@ func TestDebitLoopSessionWarningSessions(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):      sMock,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): sMock})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:         "CGRID",
@@ -1053,7 +1053,7 @@ func TestDebitLoopSessionDisconnectSession(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):      sMock,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): sMock})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	sTestMock := &testMockClientConnDiscSess{}
 	sessions.sBiRPCClients.RegisterIntBiJConn(sTestMock, "ClientConnID", 2.0)
@@ -1142,7 +1142,7 @@ func TestStoreSCost(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaCDRs): sMock})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:  "CGRID",
@@ -1200,7 +1200,7 @@ func TestRefundSession(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs): sMock})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:  "CGRID",
@@ -1308,7 +1308,7 @@ func TestRoundCost(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs): sMock})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		CGRID:  "CGRID",
@@ -1374,7 +1374,7 @@ func TestDisconnectSession(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ss := &Session{
 		ClientConnID: "test",
@@ -1426,7 +1426,7 @@ func TestReplicateSessions(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaReplicator): sMock})
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	sessions.replicateSessions("test_session", false,
 		[]string{utils.ConcatenatedKey(utils.MetaInternal, utils.MetaReplicator)})
@@ -1467,7 +1467,7 @@ func TestNewSession(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): sMock})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEv := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -1564,7 +1564,7 @@ func TestProcessChargerS(t *testing.T) {
 	}
 	sMock <- testMock1
 
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEv := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -1610,7 +1610,7 @@ func TestTransitSState(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	rcv := sessions.transitSState("test", true)
 	if rcv != nil {
@@ -1642,7 +1642,7 @@ func TestRelocateSession(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	if rcv := sessions.relocateSession(utils.EmptyString, "222", "127.0.0.1"); rcv != nil {
 		t.Errorf("Expected to be nil")
@@ -1700,7 +1700,7 @@ func TestGetRelocateSession(t *testing.T) {
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
 
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	rcv := sessions.getRelocateSession("test", "111", "222", "127.0.0.1")
 	if rcv != nil {
@@ -1821,7 +1821,7 @@ func TestSyncSessions(t *testing.T) {
 	connMgr := engine.NewConnManager(cfg, map[string]chan birpc.ClientConnector{
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): chanInternal})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	sTestMock1 := &testMockClientSyncSessions{}
 	sessions.sBiRPCClients.RegisterIntBiJConn(sTestMock1, utils.EmptyString, 0)
@@ -1895,7 +1895,7 @@ func TestAuthEvent(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaRALs):     chanInternal,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): chanInternal})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEv := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -1971,7 +1971,7 @@ func TestAuthEventMockCall(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEv := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -2052,7 +2052,7 @@ func TestChargeEvent(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEv := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -2108,7 +2108,7 @@ func TestUpdateSession(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	updatedEv := map[string]any{
 		utils.Usage:       time.Second,
@@ -2173,7 +2173,7 @@ func TestEndSession(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	ss := &Session{
 		EventStart: map[string]any{},
@@ -2264,7 +2264,7 @@ func TestBiRPCv1GetActivePassiveSessions(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	ctx := context.WithClient(context.Background(), clnt)
 	var reply []*ExternalSession
@@ -2425,7 +2425,7 @@ func TestBiRPCv1SetPassiveSession(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	var reply string
 	ss := &Session{
@@ -2488,7 +2488,7 @@ func TestBiRPCv1ReplicateSessions(t *testing.T) {
 		"conn1": chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	args := ArgsReplicateSessions{
 		CGRID:   "CGRID_TEST",
@@ -2539,7 +2539,7 @@ func TestBiRPCv1AuthorizeEvent(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "TestID",
@@ -2662,7 +2662,7 @@ func TestBiRPCv1AuthorizeEvent2(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -2825,7 +2825,7 @@ func TestBiRPCv1AuthorizeEventWithDigest(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats):      chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -2933,7 +2933,7 @@ func TestBiRPCv1InitiateSession1(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		Event: map[string]any{
@@ -3075,7 +3075,7 @@ func TestBiRPCv1InitiateSession2(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID:     "Test_id",
@@ -3110,7 +3110,7 @@ func TestBiRPCv1InitiateSession2(t *testing.T) {
 		t.Errorf("Expected %+v, received %+v", expected, err)
 	}
 
-	sessions = NewSessionS(cfg, dm, connMgr)
+	sessions = NewSessionS(cfg, dm, nil, connMgr)
 	args.CGREvent.Event[utils.Usage] = "10s"
 	if err := sessions.BiRPCv1InitiateSession(context.Background(), args, rply); err != nil {
 		t.Error(err)
@@ -3120,7 +3120,7 @@ func TestBiRPCv1InitiateSession2(t *testing.T) {
 	args = NewV1InitSessionArgs(false, []string{},
 		true, []string{}, true, []string{}, false, false, true,
 		cgrEvent, true)
-	sessions = NewSessionS(cfg, dm, connMgr)
+	sessions = NewSessionS(cfg, dm, nil, connMgr)
 	if err := sessions.BiRPCv1InitiateSession(context.Background(), args, rply); err == nil || err != utils.ErrPartiallyExecuted {
 		t.Errorf("Expected %+v, received %+v", utils.ErrPartiallyExecuted, err)
 	}
@@ -3135,7 +3135,7 @@ func TestBiRPCv1InitiateSession2(t *testing.T) {
 			utils.OptsDebitInterval: "10s",
 		},
 	}
-	sessions = NewSessionS(cfg, dm, connMgr)
+	sessions = NewSessionS(cfg, dm, nil, connMgr)
 	args = NewV1InitSessionArgs(false, []string{},
 		true, []string{}, true, []string{}, false, false, true,
 		cgrEvent, true)
@@ -3222,7 +3222,7 @@ func TestBiRPCv1InitiateSessionWithDigest(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaStats):      chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		Tenant: "cgrates.org",
@@ -3288,7 +3288,7 @@ func TestBiRPCv1UpdateSession1(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		Event: map[string]any{
@@ -3378,7 +3378,7 @@ func TestBiRPCv1UpdateSession2(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -3461,7 +3461,7 @@ func TestBiRPCv1TerminateSession1(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -3562,7 +3562,7 @@ func TestBiRPCv1TerminateSession1(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers):   chanInternal,
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaReplicator): chanInternal,
 	})
-	sessions = NewSessionS(cfg, dm, connMgr)
+	sessions = NewSessionS(cfg, dm, nil, connMgr)
 	caches = engine.NewCacheS(cfg, dm, nil)
 	engine.Cache = caches
 	args = NewV1TerminateSessionArgs(true, false, false, false, nil, false, nil, cgrEvent, true)
@@ -3598,7 +3598,7 @@ func TestBiRPCv1TerminateSession2(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaResources): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -3649,7 +3649,7 @@ func TestBiRPCv1ProcessCDR(t *testing.T) {
 		t.Error(err)
 	}
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), nil)
-	sessions := NewSessionS(cfg, dm, nil)
+	sessions := NewSessionS(cfg, dm, nil, nil)
 
 	cgrEvent := &utils.CGREvent{
 		Event: map[string]any{
@@ -3708,7 +3708,7 @@ func TestBiRPCv1ProcessMessage1(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -3817,7 +3817,7 @@ func TestBiRPCv1ProcessMessage2(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers):  chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -3942,7 +3942,7 @@ func TestBiRPCv1ProcessEvent(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaThresholds): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -4056,7 +4056,7 @@ func TestBiRPCv1ProcessEventStats(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	cgrEvent := &utils.CGREvent{
 		ID: "test_id",
@@ -4129,7 +4129,7 @@ func TestBiRPCv1ProcessEventResources(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaChargers):  chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	args := &V1ProcessEventArgs{
 		Flags: []string{
@@ -4253,7 +4253,7 @@ func TestBiRPCv1ProcessEventRals1(t *testing.T) {
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
 	engine.Cache = engine.NewCacheS(cfg, dm, nil)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	args := &V1ProcessEventArgs{
 		Flags: []string{utils.MetaRALs,
@@ -4369,7 +4369,7 @@ func TestBiRPCv1ProcessEventRals2(t *testing.T) {
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
 	engine.Cache = engine.NewCacheS(cfg, dm, nil)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	args := &V1ProcessEventArgs{
 		Flags: []string{utils.MetaRALs,
@@ -4504,7 +4504,7 @@ func TestBiRPCv1ProcessEventCDRs11(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaCDRs):     chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	args := &V1ProcessEventArgs{
 		Flags: []string{utils.MetaCDRs,
@@ -4616,7 +4616,7 @@ func TestBiRPCv1GetCost(t *testing.T) {
 		utils.ConcatenatedKey(utils.MetaInternal, utils.MetaAttributes): chanInternal,
 	})
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 
 	args := &V1ProcessEventArgs{
 		Flags: []string{utils.MetaAttributes,
@@ -4722,7 +4722,7 @@ func TestSyncSessionsSync(t *testing.T) {
 	}
 	connMgr := engine.NewConnManager(cfg, nil)
 	dm := engine.NewDataManager(data, cfg.CacheCfg(), connMgr)
-	sessions := NewSessionS(cfg, dm, connMgr)
+	sessions := NewSessionS(cfg, dm, nil, connMgr)
 	sessions.aSessions = map[string]*Session{}
 	sessions.cgrCfg.GeneralCfg().ReplyTimeout = 1
 	cacheS := engine.NewCacheS(cfg, nil, nil)
@@ -561,6 +561,19 @@ type AttrSetRatingProfile struct {
 	APIOpts               map[string]any
 }
 
+// ArgsImportRateDeck imports a carrier rate deck into the rating profile of the deck
+type ArgsImportRateDeck struct {
+	Tenant   string
+	ID       string // Deck identifier, prefixing the IDs of the generated objects
+	Layout   string // Rate deck layout out of the loader config, *default if empty
+	Category string // Category of the rating profile, default_category if empty
+	Subject  string // Subject of the rating profile, the deck ID if empty
+	Path     string // Path of the deck file on the engine host
+	Content  string // Content of the deck, used instead of the Path
+	DryRun   bool   // Only report the changes
+	APIOpts  map[string]any
+}
+
 type AttrGetRatingProfile struct {
 	Tenant   string // Tenant's Id
 	Category string // TypeOfRecord
@@ -926,6 +939,14 @@ type AttrGetAccount struct {
 	Account string
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ag *AttrGetAccount) GetTenant() string {
+	if ag == nil {
+		return EmptyString
+	}
+	return ag.Tenant
+}
+
 type AttrGetAccounts struct {
 	Tenant     string
 	AccountIDs []string
@@ -934,6 +955,14 @@ type AttrGetAccounts struct {
 	Filter     map[string]bool
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ag *AttrGetAccounts) GetTenant() string {
+	if ag == nil {
+		return EmptyString
+	}
+	return ag.Tenant
+}
+
 type AttrGetAccountsCount struct {
 	Tenant string
 }
@@ -1315,6 +1344,14 @@ type AttrExecuteAction struct {
 	ActionsId string
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ae *AttrExecuteAction) GetTenant() string {
+	if ae == nil {
+		return EmptyString
+	}
+	return ae.Tenant
+}
+
 type AttrSetAccount struct {
 	Tenant           string
 	Account          string
@@ -1324,12 +1361,28 @@ type AttrSetAccount struct {
 	ReloadScheduler  bool
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (as *AttrSetAccount) GetTenant() string {
+	if as == nil {
+		return EmptyString
+	}
+	return as.Tenant
+}
+
 type AttrRemoveAccount struct {
 	Tenant          string
 	Account         string
 	ReloadScheduler bool
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ar *AttrRemoveAccount) GetTenant() string {
+	if ar == nil {
+		return EmptyString
+	}
+	return ar.Tenant
+}
+
 type AttrGetCallCost struct {
 	CgrId string // Unique id of the CDR
 	RunId string // Run Id
@@ -1345,12 +1398,28 @@ type AttrSetBalance struct {
 	Cdrlog          bool
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (as *AttrSetBalance) GetTenant() string {
+	if as == nil {
+		return EmptyString
+	}
+	return as.Tenant
+}
+
 type AttrSetBalances struct {
 	Tenant   string
 	Account  string
 	Balances []*AttrBalance
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (as *AttrSetBalances) GetTenant() string {
+	if as == nil {
+		return EmptyString
+	}
+	return as.Tenant
+}
+
 type AttrBalance struct {
 	BalanceType     string
 	Value           float64
@@ -1383,8 +1452,9 @@ type TPResourceProfile struct {
 	AllocationMessage  string
 	Blocker            bool // blocker flag to stop processing on filters matched
 	Stored             bool
-	Weight             float64  // Weight to sort the ResourceLimits
-	ThresholdIDs       []string // Thresholds to check after changing Limit
+	Weight             float64        // Weight to sort the ResourceLimits
+	Weights            DynamicWeights // the first one with matching FilterIDs overrides Weight
+	ThresholdIDs       []string       // Thresholds to check after changing Limit
 }
 
 // Clone method for TPResourceProfile
@@ -1402,6 +1472,7 @@ func (trp *TPResourceProfile) Clone() *TPResourceProfile {
 		Blocker:           trp.Blocker,
 		Stored:            trp.Stored,
 		Weight:            trp.Weight,
+		Weights:           trp.Weights.Clone(),
 	}
 	if trp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(trp.FilterIDs))
@@ -1600,6 +1671,7 @@ type TPStatProfile struct {
 	Blocker            bool // blocker flag to stop processing on filters matched
 	Stored             bool
 	Weight             float64
+	Weights            DynamicWeights
 	MinItems           int
 	ThresholdIDs       []string
 }
@@ -1618,6 +1690,7 @@ func (tsp *TPStatProfile) Clone() *TPStatProfile {
 		Blocker:     tsp.Blocker,
 		Stored:      tsp.Stored,
 		Weight:      tsp.Weight,
+		Weights:     tsp.Weights.Clone(),
 		MinItems:    tsp.MinItems,
 	}
 	if tsp.FilterIDs != nil {
@@ -1696,6 +1769,38 @@ func (trp *TPRankingProfile) CacheClone() any {
 	return trp.Clone()
 }
 
+// TPLookupTable is used in APIs to manage remotely offline LookupTable
+type TPLookupTable struct {
+	TPid    string
+	Tenant  string
+	ID      string
+	Entries map[string]string
+}
+
+// Clone method for TPLookupTable
+func (tlt *TPLookupTable) Clone() *TPLookupTable {
+	if tlt == nil {
+		return nil
+	}
+	clone := &TPLookupTable{
+		TPid:   tlt.TPid,
+		Tenant: tlt.Tenant,
+		ID:     tlt.ID,
+	}
+	if tlt.Entries != nil {
+		clone.Entries = make(map[string]string, len(tlt.Entries))
+		for k, v := range tlt.Entries {
+			clone.Entries[k] = v
+		}
+	}
+	return clone
+}
+
+// CacheClone returns a clone of TPLookupTable used by ltcache CacheCloner
+func (tlt *TPLookupTable) CacheClone() any {
+	return tlt.Clone()
+}
+
 // MetricWithSettings adds specific settings to the Metric
 type MetricWithSettings struct {
 	MetricID         string
@@ -1765,6 +1870,7 @@ type TPThresholdProfile struct {
 	MinSleep           string
 	Blocker            bool    // blocker flag to stop processing on filters matched
 	Weight             float64 // Weight to sort the thresholds
+	Weights            DynamicWeights
 	ActionIDs          []string
 	EeIDs              []string
 	Async              bool
@@ -1784,6 +1890,7 @@ func (ttp *TPThresholdProfile) Clone() *TPThresholdProfile {
 		MinSleep: ttp.MinSleep,
 		Blocker:  ttp.Blocker,
 		Weight:   ttp.Weight,
+		Weights:  ttp.Weights.Clone(),
 		Async:    ttp.Async,
 	}
 	if ttp.FilterIDs != nil {
@@ -1873,6 +1980,7 @@ type TPRoute struct {
 	ResourceIDs     []string // queried in some strategies
 	StatIDs         []string // queried in some strategies
 	Weight          float64
+	Weights         DynamicWeights
 	Blocker         bool
 	RouteParameters string
 }
@@ -1885,6 +1993,7 @@ func (r *TPRoute) Clone() *TPRoute {
 	return &TPRoute{
 		ID:              r.ID,
 		Weight:          r.Weight,
+		Weights:         r.Weights.Clone(),
 		Blocker:         r.Blocker,
 		RouteParameters: r.RouteParameters,
 		FilterIDs:       slices.Clone(r.FilterIDs),
@@ -1906,6 +2015,7 @@ type TPRouteProfile struct {
 	SortingParameters  []string
 	Routes             []*TPRoute
 	Weight             float64
+	Weights            DynamicWeights
 }
 
 // Clone method for TPRouteProfile
@@ -1919,6 +2029,7 @@ func (tprp *TPRouteProfile) Clone() *TPRouteProfile {
 		ID:      tprp.ID,
 		Sorting: tprp.Sorting,
 		Weight:  tprp.Weight,
+		Weights: tprp.Weights.Clone(),
 	}
 	if tprp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(tprp.FilterIDs))
@@ -1981,6 +2092,7 @@ type TPAttributeProfile struct {
 	Attributes         []*TPAttribute
 	Blocker            bool
 	Weight             float64
+	Weights            DynamicWeights
 }
 
 // Clone clones TPAttributeProfile
@@ -1994,6 +2106,7 @@ func (tpap *TPAttributeProfile) Clone() *TPAttributeProfile {
 		ID:      tpap.ID,
 		Blocker: tpap.Blocker,
 		Weight:  tpap.Weight,
+		Weights: tpap.Weights.Clone(),
 	}
 	if tpap.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(tpap.FilterIDs))
@@ -2030,6 +2143,7 @@ type TPChargerProfile struct {
 	RunID              string
 	AttributeIDs       []string
 	Weight             float64
+	Weights            DynamicWeights
 }
 
 // Clone clones TPChargerProfile
@@ -2038,11 +2152,12 @@ func (tpcp *TPChargerProfile) Clone() *TPChargerProfile {
 		return nil
 	}
 	clone := &TPChargerProfile{
-		TPid:   tpcp.TPid,
-		Tenant: tpcp.Tenant,
-		ID:     tpcp.ID,
-		RunID:  tpcp.RunID,
-		Weight: tpcp.Weight,
+		TPid:    tpcp.TPid,
+		Tenant:  tpcp.Tenant,
+		ID:      tpcp.ID,
+		RunID:   tpcp.RunID,
+		Weight:  tpcp.Weight,
+		Weights: tpcp.Weights.Clone(),
 	}
 	if tpcp.FilterIDs != nil {
 		clone.FilterIDs = make([]string, len(tpcp.FilterIDs))
@@ -2245,6 +2360,8 @@ type SMCostFilter struct { //id cu litere mare
 	NotOriginIDs   []string
 	CostSources    []string
 	NotCostSources []string
+	Accounts       []string // tenant:account which paid the costs
+	NotAccounts    []string
 	Usage          UsageInterval
 	CreatedAt      TimeInterval
 }
@@ -2372,6 +2489,11 @@ func NewAttrReloadCacheWithOpts() *AttrReloadCacheWithAPIOpts {
 	return &AttrReloadCacheWithAPIOpts{
 		DestinationIDs:           []string{MetaAny},
 		ReverseDestinationIDs:    []string{MetaAny},
+		PortedNumbers:            []string{MetaAny},
+		LookupTableIDs:           []string{MetaAny},
+		DiscountProfileIDs:       []string{MetaAny},
+		FraudProfileIDs:          []string{MetaAny},
+		FraudCaseIDs:             []string{MetaAny},
 		RatingPlanIDs:            []string{MetaAny},
 		RatingProfileIDs:         []string{MetaAny},
 		ActionIDs:                []string{MetaAny},
@@ -2406,6 +2528,8 @@ func NewAttrReloadCacheWithOpts() *AttrReloadCacheWithAPIOpts {
 		RouteFilterIndexIDs:      []string{MetaAny},
 		ChargerFilterIndexIDs:    []string{MetaAny},
 		DispatcherFilterIndexIDs: []string{MetaAny},
+		FraudFilterIndexIDs:      []string{MetaAny},
+		DiscountFilterIndexIDs:   []string{MetaAny},
 		FilterIndexIDs:           []string{MetaAny},
 		Dispatchers:              []string{MetaAny},
 	}
@@ -2418,6 +2542,11 @@ func NewAttrReloadCacheWithOptsFromMap(arg map[string][]string, tnt string, opts
 
 		DestinationIDs:           arg[CacheDestinations],
 		ReverseDestinationIDs:    arg[CacheReverseDestinations],
+		PortedNumbers:            arg[CachePortedNumbers],
+		LookupTableIDs:           arg[CacheLookupTables],
+		DiscountProfileIDs:       arg[CacheDiscountProfiles],
+		FraudProfileIDs:          arg[CacheFraudProfiles],
+		FraudCaseIDs:             arg[CacheFraudCases],
 		RatingPlanIDs:            arg[CacheRatingPlans],
 		RatingProfileIDs:         arg[CacheRatingProfiles],
 		ActionIDs:                arg[CacheActions],
@@ -2453,6 +2582,8 @@ func NewAttrReloadCacheWithOptsFromMap(arg map[string][]string, tnt string, opts
 		RouteFilterIndexIDs:      arg[CacheRouteFilterIndexes],
 		ChargerFilterIndexIDs:    arg[CacheChargerFilterIndexes],
 		DispatcherFilterIndexIDs: arg[CacheDispatcherFilterIndexes],
+		FraudFilterIndexIDs:      arg[CacheFraudFilterIndexes],
+		DiscountFilterIndexIDs:   arg[CacheDiscountFilterIndexes],
 		FilterIndexIDs:           arg[CacheReverseFilterIndexes],
 	}
 }
@@ -2462,6 +2593,11 @@ type AttrReloadCacheWithAPIOpts struct {
 	Tenant                   string         `json:",omitempty"`
 	DestinationIDs           []string       `json:",omitempty"`
 	ReverseDestinationIDs    []string       `json:",omitempty"`
+	PortedNumbers            []string       `json:",omitempty"`
+	LookupTableIDs           []string       `json:",omitempty"`
+	DiscountProfileIDs       []string       `json:",omitempty"`
+	FraudProfileIDs          []string       `json:",omitempty"`
+	FraudCaseIDs             []string       `json:",omitempty"`
 	RatingPlanIDs            []string       `json:",omitempty"`
 	RatingProfileIDs         []string       `json:",omitempty"`
 	ActionIDs                []string       `json:",omitempty"`
@@ -2497,6 +2633,8 @@ type AttrReloadCacheWithAPIOpts struct {
 	RouteFilterIndexIDs      []string       `json:",omitempty"`
 	ChargerFilterIndexIDs    []string       `json:",omitempty"`
 	DispatcherFilterIndexIDs []string       `json:",omitempty"`
+	FraudFilterIndexIDs      []string       `json:",omitempty"`
+	DiscountFilterIndexIDs   []string       `json:",omitempty"`
 	FilterIndexIDs           []string       `json:",omitempty"`
 }
 
@@ -2504,6 +2642,11 @@ func (a *AttrReloadCacheWithAPIOpts) Map() map[string][]string {
 	return map[string][]string{
 		CacheDestinations:            a.DestinationIDs,
 		CacheReverseDestinations:     a.ReverseDestinationIDs,
+		CachePortedNumbers:           a.PortedNumbers,
+		CacheLookupTables:            a.LookupTableIDs,
+		CacheDiscountProfiles:        a.DiscountProfileIDs,
+		CacheFraudProfiles:           a.FraudProfileIDs,
+		CacheFraudCases:              a.FraudCaseIDs,
 		CacheRatingPlans:             a.RatingPlanIDs,
 		CacheRatingProfiles:          a.RatingProfileIDs,
 		CacheActions:                 a.ActionIDs,
@@ -2539,6 +2682,8 @@ func (a *AttrReloadCacheWithAPIOpts) Map() map[string][]string {
 		CacheRouteFilterIndexes:      a.RouteFilterIndexIDs,
 		CacheChargerFilterIndexes:    a.ChargerFilterIndexIDs,
 		CacheDispatcherFilterIndexes: a.DispatcherFilterIndexIDs,
+		CacheFraudFilterIndexes:      a.FraudFilterIndexIDs,
+		CacheDiscountFilterIndexes:   a.DiscountFilterIndexIDs,
 		CacheReverseFilterIndexes:    a.FilterIndexIDs,
 	}
 }
@@ -2577,6 +2722,14 @@ type SessionFilter struct {
 	APIOpts map[string]any
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (sf *SessionFilter) GetTenant() string {
+	if sf == nil {
+		return EmptyString
+	}
+	return sf.Tenant
+}
+
 type SessionFilterWithEvent struct {
 	*SessionFilter
 	Event map[string]any
@@ -2710,3 +2863,54 @@ type ScheduledRanking struct {
 	Next      time.Time
 	Previous  time.Time
 }
+
+// AuditRecordFilter selects the audit records
+type AuditRecordFilter struct {
+	Tenants     []string
+	ObjectTypes []string
+	ObjectIDs   []string
+	APIKeys     []string // the users identified by their API key
+	Addresses   []string // the remote addresses of the callers
+	Methods     []string
+	Time        TimeInterval
+	Paginator
+}
+
+type AuditRecordFilterWithAPIOpts struct {
+	*AuditRecordFilter
+	APIOpts map[string]any
+	Tenant  string
+}
+
+// ArgsRevision selects the revisions of a DataDB object
+type ArgsRevision struct {
+	ObjectType string // the data_db item, ie: *attribute_profiles
+	Tenant     string // ignored for the objects without tenant
+	ID         string // the key for the objects without tenant
+	Revision   int    // 0 for the current version
+	CompareTo  int    // used by the diff, 0 for the current version
+	APIOpts    map[string]any
+}
+
+// ArgsRollbackLoad selects the load which is rolled back
+type ArgsRollbackLoad struct {
+	LoadID  int64
+	Tenant  string
+	APIOpts map[string]any
+}
+
+// ArgsRouteBreakers selects the route breakers of a tenant
+type ArgsRouteBreakers struct {
+	Tenant   string
+	RouteIDs []string // all the breakers of the tenant if empty
+	APIOpts  map[string]any
+}
+
+// ArgsEraseSubscriber selects the subscriber whose data is erased
+type ArgsEraseSubscriber struct {
+	Tenant  string
+	Account string   // matched against both Account and Subject of the CDRs
+	Mode    string   // <*remove|*pseudonymise>, defaults to *remove
+	NodeIDs []string // nodes of the sessions backups, the local node if empty
+	APIOpts map[string]any
+}
@@ -1089,6 +1089,11 @@ func TestNewAttrReloadCacheWithOpts(t *testing.T) {
 	newAttrReloadCache := &AttrReloadCacheWithAPIOpts{
 		DestinationIDs:           []string{MetaAny},
 		ReverseDestinationIDs:    []string{MetaAny},
+		PortedNumbers:            []string{MetaAny},
+		LookupTableIDs:           []string{MetaAny},
+		DiscountProfileIDs:       []string{MetaAny},
+		FraudProfileIDs:          []string{MetaAny},
+		FraudCaseIDs:             []string{MetaAny},
 		RatingPlanIDs:            []string{MetaAny},
 		RatingProfileIDs:         []string{MetaAny},
 		ActionIDs:                []string{MetaAny},
@@ -1122,6 +1127,8 @@ func TestNewAttrReloadCacheWithOpts(t *testing.T) {
 		RouteFilterIndexIDs:      []string{MetaAny},
 		ChargerFilterIndexIDs:    []string{MetaAny},
 		DispatcherFilterIndexIDs: []string{MetaAny},
+		FraudFilterIndexIDs:      []string{MetaAny},
+		DiscountFilterIndexIDs:   []string{MetaAny},
 		FilterIndexIDs:           []string{MetaAny},
 		RankingIDs:               []string{MetaAny},
 		RankingProfileIDs:        []string{MetaAny},
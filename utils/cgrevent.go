@@ -32,6 +32,14 @@ type CGREvent struct {
 	clnb    bool //rpcclonable
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (ev *CGREvent) GetTenant() string {
+	if ev == nil {
+		return EmptyString
+	}
+	return ev.Tenant
+}
+
 func (ev *CGREvent) HasField(fldName string) (has bool) {
 	_, has = ev.Event[fldName]
 	return
@@ -45,7 +45,9 @@ var (
 		CacheRouteFilterIndexes, CacheAttributeFilterIndexes,
 		CacheChargerFilterIndexes, CacheDispatcherFilterIndexes, CacheLoadIDs,
 		CacheReverseFilterIndexes, CacheActionPlans, CacheAccountActionPlans,
-		CacheAccounts, CacheVersions,
+		CacheAccounts, CacheVersions, CachePortedNumbers, CacheLookupTables,
+		CacheDiscountProfiles, CacheFraudProfiles, CacheFraudCases,
+		CacheFraudFilterIndexes, CacheDiscountFilterIndexes,
 	})
 
 	DataDBPartitions = NewStringSet([]string{
@@ -61,6 +63,9 @@ var (
 		CacheAttributeFilterIndexes, CacheChargerFilterIndexes,
 		CacheDispatcherFilterIndexes, CacheLoadIDs, CacheReverseFilterIndexes,
 		CacheActionPlans, CacheAccountActionPlans, CacheAccounts, CacheVersions,
+		CachePortedNumbers, CacheLookupTables, CacheDiscountProfiles,
+		CacheFraudProfiles, CacheFraudCases, CacheFraudFilterIndexes,
+		CacheDiscountFilterIndexes,
 	})
 
 	StorDBPartitions = NewStringSet([]string{
@@ -71,7 +76,8 @@ var (
 		CacheTBLTPIPs, CacheTBLTPStats, CacheTBLTPThresholds, CacheTBLTPRankings,
 		CacheTBLTPFilters, CacheSessionCostsTBL, CacheCDRsTBL, CacheTBLTPRoutes,
 		CacheTBLTPAttributes, CacheTBLTPChargers, CacheTBLTPDispatchers,
-		CacheTBLTPDispatcherHosts, CacheVersions,
+		CacheTBLTPDispatcherHosts, CacheTBLTPLookupTables, CacheVersions,
+		CacheAuditRecordsTBL,
 	})
 
 	// CachePartitions enables creation of cache partitions
@@ -114,6 +120,13 @@ var (
 		CacheAttributeFilterIndexes:  AttributeFilterIndexes,
 		CacheChargerFilterIndexes:    ChargerFilterIndexes,
 		CacheDispatcherFilterIndexes: DispatcherFilterIndexes,
+		CachePortedNumbers:           PortedNumberPrefix,
+		CacheLookupTables:            LookupTablePrefix,
+		CacheDiscountProfiles:        DiscountProfilePrefix,
+		CacheFraudProfiles:           FraudProfilePrefix,
+		CacheFraudCases:              FraudCasePrefix,
+		CacheFraudFilterIndexes:      FraudFilterIndexes,
+		CacheDiscountFilterIndexes:   DiscountFilterIndexes,
 
 		CacheLoadIDs:              LoadIDPrefix,
 		CacheAccounts:             AccountPrefix,
@@ -132,6 +145,8 @@ var (
 		CacheAttributeFilterIndexes:  AttributeProfilePrefix,
 		CacheChargerFilterIndexes:    ChargerProfilePrefix,
 		CacheDispatcherFilterIndexes: DispatcherProfilePrefix,
+		CacheFraudFilterIndexes:      FraudProfilePrefix,
+		CacheDiscountFilterIndexes:   DiscountProfilePrefix,
 		CacheReverseFilterIndexes:    FilterPrefix,
 	}
 
@@ -144,9 +159,24 @@ var (
 		CacheAttributeProfiles:  CacheAttributeFilterIndexes,
 		CacheChargerProfiles:    CacheChargerFilterIndexes,
 		CacheDispatcherProfiles: CacheDispatcherFilterIndexes,
+		CacheFraudProfiles:      CacheFraudFilterIndexes,
+		CacheDiscountProfiles:   CacheDiscountFilterIndexes,
 		CacheFilters:            CacheReverseFilterIndexes,
 	}
 
+	// RevisionedItems are the data_db items which can keep previous versions for rollback
+	RevisionedItems = NewStringSet([]string{
+		MetaFilters, MetaAttributeProfiles, MetaChargerProfiles, MetaRouteProfiles,
+		MetaResourceProfile, MetaIPProfiles, MetaStatQueueProfiles, MetaThresholdProfiles,
+		MetaTrendProfiles, MetaRankingProfiles, MetaDispatcherProfiles, MetaDispatcherHosts,
+		MetaLookupTables, MetaRatingPlans, MetaRatingProfiles, MetaDiscountProfiles,
+		MetaFraudProfiles,
+	})
+
+	// CDCItems are the data_db items which can export their changes to EEs
+	CDCItems = NewStringSet(append(RevisionedItems.AsSlice(),
+		MetaAccounts, MetaResources, MetaIPAllocations, MetaThresholds))
+
 	// NonMonetaryBalances are types of balances which are not handled as monetary
 	NonMonetaryBalances = NewStringSet([]string{MetaVoice, MetaSMS, MetaData, MetaGeneric})
 
@@ -170,10 +200,12 @@ var (
 		TBLTPStats:            CacheTBLTPStats,
 		TBLTPTrends:           CacheTBLTPTrends,
 		TBLTPRankings:         CacheTBLTPRankings,
+		TBLTPLookupTables:     CacheTBLTPLookupTables,
 		TBLTPThresholds:       CacheTBLTPThresholds,
 		TBLTPFilters:          CacheTBLTPFilters,
 		SessionCostsTBL:       CacheSessionCostsTBL,
 		CDRsTBL:               CacheCDRsTBL,
+		AuditRecordsTBL:       CacheAuditRecordsTBL,
 		TBLTPRoutes:           CacheTBLTPRoutes,
 		TBLTPAttributes:       CacheTBLTPAttributes,
 		TBLTPChargers:         CacheTBLTPChargers,
@@ -235,6 +267,7 @@ const (
 	JSON                     = "json"
 	JSONCaps                 = "JSON"
 	GOBCaps                  = "GOB"
+	GRPCCaps                 = "gRPC"
 	MsgPack                  = "msgpack"
 	CSVLoad                  = "CSVLOAD"
 	CGRID                    = "CGRID"
@@ -254,6 +287,8 @@ const (
 	BalancesFld              = "Balances"
 	Subject                  = "Subject"
 	Destination              = "Destination"
+	Number                   = "Number"
+	RoutingNumber            = "RoutingNumber"
 	SetupTime                = "SetupTime"
 	AnswerTime               = "AnswerTime"
 	Usage                    = "Usage"
@@ -267,6 +302,7 @@ const (
 	DryRun                   = "DryRun"
 
 	CustomValue             = "CustomValue"
+	Key                     = "Key"
 	Value                   = "Value"
 	Filter                  = "Filter"
 	LastUsed                = "LastUsed"
@@ -343,13 +379,20 @@ const (
 	ChargerProfilePrefix      = "cpp_"
 	DispatcherProfilePrefix   = "dpp_"
 	DispatcherHostPrefix      = "dph_"
+	PortedNumberPrefix        = "pnr_"
+	LookupTablePrefix         = "lkt_"
+	DiscountProfilePrefix     = "dsc_"
+	FraudProfilePrefix        = "frp_"
+	FraudCasePrefix           = "frc_"
 	ThresholdProfilePrefix    = "thp_"
 	StatQueuePrefix           = "stq_"
 	RankingsProfilePrefix     = "rgp_"
 	TrendsProfilePrefix       = "trp_"
 	LoadIDPrefix              = "lid_"
+	RevisionsPrefix           = "rev_"
 	SessionsBackupPrefix      = "sbk_"
 	LoadInstKey               = "load_history"
+	DataDBMigrationKey        = "datadb_migration"
 	CreateCDRsTablesSQL       = "create_cdrs_tables.sql"
 	CreateTariffPlanTablesSQL = "create_tariffplan_tables.sql"
 	TestSQL                   = "TEST_SQL"
@@ -402,6 +445,8 @@ const (
 	MetaCCUsage              = "*cc_usage"
 	MetaSIPCID               = "*sipcid"
 	MetaValueExponent        = "*value_exponent"
+	MetaPortedNumber         = "*ported_number"
+	MetaLookup               = "*lookup"
 	NegativePrefix           = "!"
 	MatchStartPrefix         = "^"
 	MatchGreaterThanOrEqual  = ">="
@@ -416,6 +461,10 @@ const (
 	XML                      = "xml"
 	MetaGOB                  = "*gob"
 	MetaJSON                 = "*json"
+	MetaGRPC                 = "*grpc"
+	MetaYAML                 = "*yaml"
+	MetaCSV                  = "*csv"
+	MetaTable                = "*table"
 	MetaMSGPACK              = "*msgpack"
 	MetaDateTime             = "*datetime"
 	MetaMaskedDestination    = "*masked_destination"
@@ -447,6 +496,9 @@ const (
 	MetaStats                = "*stats"
 	MetaTrends               = "*trends"
 	MetaRankings             = "*rankings"
+	MetaFrauds               = "*frauds"
+	MetaDiscounts            = "*discounts"
+	MetaRouteBreakers        = "*route_breakers"
 	MetaResponder            = "*responder"
 	MetaCore                 = "*core"
 	MetaServiceManager       = "*servicemanager"
@@ -486,6 +538,10 @@ const (
 	MetaElastic              = "*els"
 	MetaFileFWV              = "*file_fwv"
 	MetaFile                 = "*file"
+	MetaMemory               = "*memory"
+	MetaOTLP                 = "*otlp"
+	MetaServer               = "*server"
+	MetaClient               = "*client"
 	Accounts                 = "Accounts"
 	AccountService           = "AccountS"
 	AccountS                 = "AccountS"
@@ -510,11 +566,20 @@ const (
 	Filters                  = "Filters"
 	DispatcherProfiles       = "DispatcherProfiles"
 	DispatcherHosts          = "DispatcherHosts"
+	LookupTables             = "LookupTables"
+	DiscountProfiles         = "DiscountProfiles"
 	MetaEveryMinute          = "*every_minute"
 	MetaHourly               = "*hourly"
 	ID                       = "ID"
 	UniqueID                 = "UniqueID"
 	Address                  = "Address"
+	ObjectType               = "ObjectType"
+	ObjectID                 = "ObjectID"
+	Before                   = "Before"
+	After                    = "After"
+	Changes                  = "Changes"
+	Revision                 = "Revision"
+	LoadID                   = "LoadID"
 	Transport                = "Transport"
 	TLS                      = "TLS"
 	Subsystems               = "Subsystems"
@@ -555,6 +620,12 @@ const (
 	LastUpdate            = "LastUpdate"
 	TrendID               = "TrendID"
 	RankingID             = "RankingID"
+	IndexType             = "IndexType"
+	MissingIndexes        = "MissingIndexes"
+	BrokenIndexes         = "BrokenIndexes"
+	MissingObjects        = "MissingObjects"
+	MissingFilters        = "MissingFilters"
+	Repaired              = "Repaired"
 	BalanceType           = "BalanceType"
 	BalanceID             = "BalanceID"
 	BalanceDestinationIds = "BalanceDestinationIds"
@@ -602,6 +673,7 @@ const (
 	MetaReds             = "*reds"
 	Weight               = "Weight"
 	Limit                = "Limit"
+	Offset               = "Offset"
 	UsageTTL             = "UsageTTL"
 	Message              = "Message"
 	AllocationMessage    = "AllocationMessage"
@@ -624,10 +696,15 @@ const (
 	MetaEventType               = "*eventType"
 	CDR                         = "CDR"
 	ThresholdHit                = "ThresholdHit"
+	AuditRecord                 = "AuditRecord"
+	DataChange                  = "DataChange"
 	AccountUpdate               = "AccountUpdate"
 	RankingUpdate               = "RankingUpdate"
+	IndexHealthUpdate           = "IndexHealthUpdate"
 	ResourceUpdate              = "ResourceUpdate"
 	StatUpdate                  = "StatUpdate"
+	SessionStart                = "SessionStart"
+	SessionEnd                  = "SessionEnd"
 	TrendUpdate                 = "TrendUpdate"
 	EventPerformanceReport      = "PerformanceReport"
 	EventConnectionStatusReport = "ConnectionStatusReport"
@@ -640,15 +717,16 @@ const (
 	ConnStatusDown = "DOWN"
 
 	// ReplyState error constants
-	ErrReplyStateAuthorize = "ERR_AUTHORIZE"
-	ErrReplyStateInitiate  = "ERR_INITIATE"
-	ErrReplyStateUpdate    = "ERR_UPDATE"
-	ErrReplyStateTerminate = "ERR_TERMINATE"
-	ErrReplyStateMessage   = "ERR_MESSAGE"
-	ErrReplyStateEvent     = "ERR_EVENT"
-	ErrReplyStateCDRs      = "ERR_CDRS"
-	ErrReplyStateExport    = "ERR_EXPORT"
-	ErrReplyStateRadauth   = "ERR_RADAUTH"
+	ErrReplyStateAuthorize     = "ERR_AUTHORIZE"
+	ErrReplyStateInitiate      = "ERR_INITIATE"
+	ErrReplyStateUpdate        = "ERR_UPDATE"
+	ErrReplyStateTerminate     = "ERR_TERMINATE"
+	ErrReplyStateMessage       = "ERR_MESSAGE"
+	ErrReplyStateEvent         = "ERR_EVENT"
+	ErrReplyStateCDRs          = "ERR_CDRS"
+	ErrReplyStatePortedNumbers = "ERR_PORTED_NUMBERS"
+	ErrReplyStateExport        = "ERR_EXPORT"
+	ErrReplyStateRadauth       = "ERR_RADAUTH"
 
 	AccountSummary           = "AccountSummary"
 	RatingFilters            = "RatingFilters"
@@ -708,6 +786,7 @@ const (
 	RouteRatingplanIDs       = "RouteRatingplanIDs"
 	RouteStatIDs             = "RouteStatIDs"
 	RouteWeight              = "RouteWeight"
+	RouteWeights             = "RouteWeights"
 	RouteParameters          = "RouteParameters"
 	RouteBlocker             = "RouteBlocker"
 	RouteResourceIDs         = "RouteResourceIDs"
@@ -866,6 +945,7 @@ const (
 	ApierV                  = "ApierV"
 	MetaApier               = "*apier"
 	MetaAnalyzer            = "*analyzer"
+	MetaAudit               = "*audit"
 	CGREventString          = "CGREvent"
 	MetaTextPlain           = "*text_plain"
 	MetaIgnoreErrors        = "*ignore_errors"
@@ -917,6 +997,9 @@ const (
 	MetaRadCoATemplate      = "*radCoATemplate"
 	MetaRadDMRTemplate      = "*radDMRTemplate"
 	MetaCost                = "*cost"
+	MetaDiameter            = "*diameter"
+	MetaRadius              = "*radius"
+	MetaSIP                 = "*sip"
 	MetaGroup               = "*group"
 	InternalRPCSet          = "InternalRPCSet"
 	MetaFileName            = "*fileName"
@@ -1083,6 +1166,7 @@ const (
 	FieldGCDurationStats = "gc_duration_stats"
 	FieldProcStats       = "proc_stats"
 	FieldCapsStats       = "caps_stats"
+	FieldConfigReload    = "config_reload"
 
 	MetricRuntimeGoroutines = "goroutines"
 	MetricRuntimeThreads    = "threads"
@@ -1154,6 +1238,11 @@ const (
 	MetaStatQueues          = "*statqueues"
 	MetaRankingProfiles     = "*ranking_profiles"
 	MetaTrendProfiles       = "*trend_profiles"
+	MetaPortedNumbers       = "*ported_numbers"
+	MetaLookupTables        = "*lookup_tables"
+	MetaDiscountProfiles    = "*discount_profiles"
+	MetaFraudProfiles       = "*fraud_profiles"
+	MetaFraudCases          = "*fraud_cases"
 	MetaThresholdProfiles   = "*threshold_profiles"
 	MetaRouteProfiles       = "*route_profiles"
 	MetaAttributeProfiles   = "*attribute_profiles"
@@ -1166,6 +1255,8 @@ const (
 	MetaIPs                 = "*ips"
 	MetaResources           = "*resources"
 	MetaSessionsBackup      = "*sessions_backup"
+	MetaRevisions           = "*revisions"
+	MetaDataDBMigration     = "*datadb_migration"
 	MetaSy                  = "*sy"
 	MetaLoadIDs             = "*load_ids"
 	MetaNodeID              = "*node_id"
@@ -1206,6 +1297,7 @@ const (
 // Services
 const (
 	AnalyzerS   = "AnalyzerS"
+	AuditS      = "AuditS"
 	ApierS      = "ApierS"
 	AttributeS  = "AttributeS"
 	CacheS      = "CacheS"
@@ -1216,6 +1308,9 @@ const (
 	EeS         = "EeS"
 	ErS         = "ErS"
 	FilterS     = "FilterS"
+	GeoIPLog    = "GeoIP"
+	TracingLog  = "Tracing"
+	RBACLog     = "RBAC"
 	GuardianS   = "GuardianS"
 	RALs        = "RALs"
 	RegistrarC  = "RegistrarC"
@@ -1227,6 +1322,7 @@ const (
 	StatService = "StatS"
 	TrendS      = "TrendS"
 	RankingS    = "RankingS"
+	FraudS      = "FraudS"
 	ThresholdS  = "ThresholdS"
 	IPs         = "IPs"
 )
@@ -1262,6 +1358,7 @@ const (
 	MetaRemoveBalance             = "*remove_balance"
 	MetaTopUpReset                = "*topup_reset"
 	MetaTopUp                     = "*topup"
+	MetaRollover                  = "*rollover"
 	MetaDebitReset                = "*debit_reset"
 	MetaDebit                     = "*debit"
 	MetaTransferBalance           = "*transfer_balance"
@@ -1337,6 +1434,7 @@ const (
 	MetaTpDestinations      = "*tp_destinations"
 	MetaTpChargers          = "*tp_chargers"
 	MetaTpDispatchers       = "*tp_dispatchers"
+	MetaTpAttributes        = "*tp_attributes"
 	MetaDurationSeconds     = "*duration_seconds"
 	MetaDurationNanoseconds = "*duration_nanoseconds"
 	MetaDurationMinutes     = "*duration_minutes"
@@ -1371,6 +1469,7 @@ const (
 	TpStats              = "TpStats"
 	TpTrends             = "TpTrends"
 	TpRankings           = "TpRankings"
+	TpLookupTables       = "TpLookupTables"
 	TpSharedGroups       = "TpSharedGroups"
 	TpRatingProfiles     = "TpRatingProfiles"
 	TpResources          = "TpResources"
@@ -1399,6 +1498,7 @@ const (
 	StatSv1            = "StatSv1"
 	TrendSv1           = "TrendSv1"
 	RankingSv1         = "RankingSv1"
+	FraudSv1           = "FraudSv1"
 	ResourceSv1        = "ResourceSv1"
 	RouteSv1           = "RouteSv1"
 	AttributeSv1       = "AttributeSv1"
@@ -1438,6 +1538,12 @@ const (
 	MetaRegex              = "*regex"
 	MetaContains           = "*contains"
 	MetaHTTP               = "*http"
+	MetaOr                 = "*or"
+	MetaNor                = "*nor"
+	MetaGeoIPCountry       = "*geoip_country"
+	MetaGeoIPASN           = "*geoip_asn"
+	MetaGeoIPCity          = "*geoip_city"
+	MetaPorted             = "*ported"
 
 	MetaNotString             = "*notstring"
 	MetaNotPrefix             = "*notprefix"
@@ -1456,6 +1562,12 @@ const (
 	MetaNotActivationInterval = "*notai"
 	MetaNotRegex              = "*notregex"
 	MetaNotContains           = "*notcontains"
+	MetaNotGeoIPCountry       = "*notgeoip_country"
+	MetaNotGeoIPASN           = "*notgeoip_asn"
+	MetaNotGeoIPCity          = "*notgeoip_city"
+	MetaNotPorted             = "*notported"
+	MetaNotOr                 = "*notor"
+	MetaNotNor                = "*notnor"
 
 	MetaEC = "*ec"
 	// not indexed
@@ -1500,6 +1612,11 @@ const (
 	ReplicatorSv1GetDispatcherProfile    = "ReplicatorSv1.GetDispatcherProfile"
 	ReplicatorSv1GetDispatcherHost       = "ReplicatorSv1.GetDispatcherHost"
 	ReplicatorSv1GetItemLoadIDs          = "ReplicatorSv1.GetItemLoadIDs"
+	ReplicatorSv1GetPortedNumber         = "ReplicatorSv1.GetPortedNumber"
+	ReplicatorSv1GetLookupTable          = "ReplicatorSv1.GetLookupTable"
+	ReplicatorSv1GetDiscountProfile      = "ReplicatorSv1.GetDiscountProfile"
+	ReplicatorSv1GetFraudProfile         = "ReplicatorSv1.GetFraudProfile"
+	ReplicatorSv1GetFraudCase            = "ReplicatorSv1.GetFraudCase"
 	ReplicatorSv1SetThresholdProfile     = "ReplicatorSv1.SetThresholdProfile"
 	ReplicatorSv1SetThreshold            = "ReplicatorSv1.SetThreshold"
 	ReplicatorSv1SetAccount              = "ReplicatorSv1.SetAccount"
@@ -1530,6 +1647,12 @@ const (
 	ReplicatorSv1SetDispatcherProfile    = "ReplicatorSv1.SetDispatcherProfile"
 	ReplicatorSv1SetDispatcherHost       = "ReplicatorSv1.SetDispatcherHost"
 	ReplicatorSv1SetLoadIDs              = "ReplicatorSv1.SetLoadIDs"
+	ReplicatorSv1SetPortedNumber         = "ReplicatorSv1.SetPortedNumber"
+	ReplicatorSv1SetPortedNumbers        = "ReplicatorSv1.SetPortedNumbers"
+	ReplicatorSv1SetLookupTable          = "ReplicatorSv1.SetLookupTable"
+	ReplicatorSv1SetDiscountProfile      = "ReplicatorSv1.SetDiscountProfile"
+	ReplicatorSv1SetFraudProfile         = "ReplicatorSv1.SetFraudProfile"
+	ReplicatorSv1SetFraudCase            = "ReplicatorSv1.SetFraudCase"
 	ReplicatorSv1SetBackupSessions       = "ReplicatorSv1.SetBackupSessions"
 	ReplicatorSv1RemoveSessionBackup     = "ReplicatorSv1.RemoveSessionBackup"
 	ReplicatorSv1RemoveThreshold         = "ReplicatorSv1.RemoveThreshold"
@@ -1550,6 +1673,11 @@ const (
 	ReplicatorSv1RemoveIPProfile         = "ReplicatorSv1.RemoveIPProfile"
 	ReplicatorSv1RemoveActionTriggers    = "ReplicatorSv1.RemoveActionTriggers"
 	ReplicatorSv1RemoveSharedGroup       = "ReplicatorSv1.RemoveSharedGroup"
+	ReplicatorSv1RemovePortedNumber      = "ReplicatorSv1.RemovePortedNumber"
+	ReplicatorSv1RemoveLookupTable       = "ReplicatorSv1.RemoveLookupTable"
+	ReplicatorSv1RemoveDiscountProfile   = "ReplicatorSv1.RemoveDiscountProfile"
+	ReplicatorSv1RemoveFraudProfile      = "ReplicatorSv1.RemoveFraudProfile"
+	ReplicatorSv1RemoveFraudCase         = "ReplicatorSv1.RemoveFraudCase"
 	ReplicatorSv1RemoveActions           = "ReplicatorSv1.RemoveActions"
 	ReplicatorSv1RemoveActionPlan        = "ReplicatorSv1.RemoveActionPlan"
 	ReplicatorSv1RemAccountActionPlans   = "ReplicatorSv1.RemAccountActionPlans"
@@ -1583,6 +1711,9 @@ const (
 	APIerSv1GetChargersIndexesHealth          = "APIerSv1.GetChargersIndexesHealth"
 	APIerSv1GetAttributesIndexesHealth        = "APIerSv1.GetAttributesIndexesHealth"
 	APIerSv1GetDispatchersIndexesHealth       = "APIerSv1.GetDispatchersIndexesHealth"
+	APIerSv1RepairFilterIndexes               = "APIerSv1.RepairFilterIndexes"
+	APIerSv1RepairReverseDestinationsIndex    = "APIerSv1.RepairReverseDestinationsIndex"
+	APIerSv1RepairAccountActionPlansIndex     = "APIerSv1.RepairAccountActionPlansIndex"
 	APIerSv1Ping                              = "APIerSv1.Ping"
 	APIerSv1SetDispatcherProfile              = "APIerSv1.SetDispatcherProfile"
 	APIerSv1GetDispatcherProfile              = "APIerSv1.GetDispatcherProfile"
@@ -1595,6 +1726,7 @@ const (
 	APIerSv1RemoveDispatcherHost              = "APIerSv1.RemoveDispatcherHost"
 	APIerSv1GetEventCost                      = "APIerSv1.GetEventCost"
 	APIerSv1LoadTariffPlanFromFolder          = "APIerSv1.LoadTariffPlanFromFolder"
+	APIerSv1ImportRateDeck                    = "APIerSv1.ImportRateDeck"
 	APIerSv1ExportToFolder                    = "APIerSv1.ExportToFolder"
 	APIerSv1GetCost                           = "APIerSv1.GetCost"
 	APIerSv1SetBalance                        = "APIerSv1.SetBalance"
@@ -1622,6 +1754,22 @@ const (
 	APIerSv1GetDestination                    = "APIerSv1.GetDestination"
 	APIerSv1RemoveDestination                 = "APIerSv1.RemoveDestination"
 	APIerSv1GetReverseDestination             = "APIerSv1.GetReverseDestination"
+	APIerSv1GetPortedNumber                   = "APIerSv1.GetPortedNumber"
+	APIerSv1SetPortedNumbers                  = "APIerSv1.SetPortedNumbers"
+	APIerSv1RemovePortedNumbers               = "APIerSv1.RemovePortedNumbers"
+	APIerSv1LoadPortedNumbersFromFile         = "APIerSv1.LoadPortedNumbersFromFile"
+	APIerSv1GetLookupTable                    = "APIerSv1.GetLookupTable"
+	APIerSv1GetLookupTableIDs                 = "APIerSv1.GetLookupTableIDs"
+	APIerSv1SetLookupTable                    = "APIerSv1.SetLookupTable"
+	APIerSv1RemoveLookupTable                 = "APIerSv1.RemoveLookupTable"
+	APIerSv1GetDiscountProfile                = "APIerSv1.GetDiscountProfile"
+	APIerSv1GetDiscountProfileIDs             = "APIerSv1.GetDiscountProfileIDs"
+	APIerSv1SetDiscountProfile                = "APIerSv1.SetDiscountProfile"
+	APIerSv1RemoveDiscountProfile             = "APIerSv1.RemoveDiscountProfile"
+	APIerSv1GetFraudProfile                   = "APIerSv1.GetFraudProfile"
+	APIerSv1GetFraudProfileIDs                = "APIerSv1.GetFraudProfileIDs"
+	APIerSv1SetFraudProfile                   = "APIerSv1.SetFraudProfile"
+	APIerSv1RemoveFraudProfile                = "APIerSv1.RemoveFraudProfile"
 	APIerSv1AddBalance                        = "APIerSv1.AddBalance"
 	APIerSv1DebitBalance                      = "APIerSv1.DebitBalance"
 	APIerSv1SetAccount                        = "APIerSv1.SetAccount"
@@ -1629,6 +1777,12 @@ const (
 	APIerSv1GetAccountIDs                     = "APIerSv1.GetAccountIDs"
 	APIerSv1GetDataDBVersions                 = "APIerSv1.GetDataDBVersions"
 	APIerSv1GetStorDBVersions                 = "APIerSv1.GetStorDBVersions"
+	APIerSv1StartDataDBMigration              = "APIerSv1.StartDataDBMigration"
+	APIerSv1GetDataDBMigrationStatus          = "APIerSv1.GetDataDBMigrationStatus"
+	APIerSv1VerifyDataDBMigration             = "APIerSv1.VerifyDataDBMigration"
+	APIerSv1SwitchDataDBMigrationReads        = "APIerSv1.SwitchDataDBMigrationReads"
+	APIerSv1FinishDataDBMigration             = "APIerSv1.FinishDataDBMigration"
+	APIerSv1AbortDataDBMigration              = "APIerSv1.AbortDataDBMigration"
 	APIerSv1GetCDRs                           = "APIerSv1.GetCDRs"
 	APIerSv1RemoveCDRs                        = "APIerSv1.RemoveCDRs"
 	APIerSv1GetTPAccountActions               = "APIerSv1.GetTPAccountActions"
@@ -1682,6 +1836,10 @@ const (
 	APIerSv1SetTPRanking                      = "APIerSv1.SetTPRanking"
 	APIerSv1GetTPRanking                      = "APIerSv1.GetTPRanking"
 	APIerSv1RemoveTPRanking                   = "APIerSv1.RemoveTPRanking"
+	APIerSv1SetTPLookupTable                  = "APIerSv1.SetTPLookupTable"
+	APIerSv1GetTPLookupTable                  = "APIerSv1.GetTPLookupTable"
+	APIerSv1GetTPLookupTableIDs               = "APIerSv1.GetTPLookupTableIDs"
+	APIerSv1RemoveTPLookupTable               = "APIerSv1.RemoveTPLookupTable"
 	APIerSv1SetTPTrend                        = "APIerSv1.SetTPTrend"
 	APIerSv1GetTPTrend                        = "APIerSv1.GetTPTrend"
 	APIerSv1RemoveTPTrend                     = "APIerSv1.RemoveTPTrend"
@@ -1750,6 +1908,10 @@ const (
 	APIerSv1GetLoadHistory                    = "APIerSv1.GetLoadHistory"
 	APIerSv1GetLoadIDs                        = "APIerSv1.GetLoadIDs"
 	APIerSv1GetLoadTimes                      = "APIerSv1.GetLoadTimes"
+	APIerSv1GetRevisions                      = "APIerSv1.GetRevisions"
+	APIerSv1GetRevisionDiff                   = "APIerSv1.GetRevisionDiff"
+	APIerSv1RollbackRevision                  = "APIerSv1.RollbackRevision"
+	APIerSv1RollbackLoad                      = "APIerSv1.RollbackLoad"
 	APIerSv1ExecuteScheduledActions           = "APIerSv1.ExecuteScheduledActions"
 	APIerSv1GetSharedGroup                    = "APIerSv1.GetSharedGroup"
 	APIerSv1RemoveActionTrigger               = "APIerSv1.RemoveActionTrigger"
@@ -1850,6 +2012,7 @@ const (
 	CoreSv1StopCPUProfiling     = "CoreSv1.StopCPUProfiling"
 	CoreSv1StartMemoryProfiling = "CoreSv1.StartMemoryProfiling"
 	CoreSv1StopMemoryProfiling  = "CoreSv1.StopMemoryProfiling"
+	CoreSv1GetTraceSpans        = "CoreSv1.GetTraceSpans"
 )
 
 // RouteS APIs
@@ -1858,6 +2021,9 @@ const (
 	RouteSv1GetRoutesList            = "RouteSv1.GetRoutesList"
 	RouteSv1GetRouteProfilesForEvent = "RouteSv1.GetRouteProfilesForEvent"
 	RouteSv1Ping                     = "RouteSv1.Ping"
+	RouteSv1ProcessEvent             = "RouteSv1.ProcessEvent"
+	RouteSv1GetRouteBreakers         = "RouteSv1.GetRouteBreakers"
+	RouteSv1ResetRouteBreakers       = "RouteSv1.ResetRouteBreakers"
 	APIerSv1GetRouteProfile          = "APIerSv1.GetRouteProfile"
 	APIerSv1GetRouteProfileIDs       = "APIerSv1.GetRouteProfileIDs"
 	APIerSv1RemoveRouteProfile       = "APIerSv1.RemoveRouteProfile"
@@ -1898,6 +2064,8 @@ const (
 	ThresholdSv1RegisterInternalBiJSONConn = "ThresholdSv1.RegisterInternalBiJSONConn"
 	ThresholdSv1StoreClientConnID          = "ThresholdSv1.StoreClientConnID"
 	ThresholdSv1RemoveClientConnID         = "ThresholdSv1.RemoveClientConnID"
+	ThresholdSv1Subscribe                  = "ThresholdSv1.Subscribe"
+	ThresholdSv1Unsubscribe                = "ThresholdSv1.Unsubscribe"
 	APIerSv1GetThresholdProfileIDs         = "APIerSv1.GetThresholdProfileIDs"
 	APIerSv1GetThresholdProfileCount       = "APIerSv1.GetThresholdProfileCount"
 	APIerSv1GetThresholdProfile            = "APIerSv1.GetThresholdProfile"
@@ -1916,6 +2084,8 @@ const (
 	StatSv1GetStatQueue            = "StatSv1.GetStatQueue"
 	StatSv1V1GetQueueIDs           = "StatSv1.GetQueueIDs"
 	StatSv1ResetStatQueue          = "StatSv1.ResetStatQueue"
+	StatSv1Subscribe               = "StatSv1.Subscribe"
+	StatSv1Unsubscribe             = "StatSv1.Unsubscribe"
 	APIerSv1GetStatQueueProfile    = "APIerSv1.GetStatQueueProfile"
 	APIerSv1RemoveStatQueueProfile = "APIerSv1.RemoveStatQueueProfile"
 	APIerSv1SetStatQueueProfile    = "APIerSv1.SetStatQueueProfile"
@@ -1948,6 +2118,17 @@ const (
 	RankingSv1GetRankingSummary  = "RankingSv1.GetRankingSummary"
 )
 
+// FraudS APIs
+const (
+	FraudSv1Ping               = "FraudSv1.Ping"
+	FraudSv1ProcessEvent       = "FraudSv1.ProcessEvent"
+	FraudSv1GetFraudCase       = "FraudSv1.GetFraudCase"
+	FraudSv1GetFraudCaseIDs    = "FraudSv1.GetFraudCaseIDs"
+	FraudSv1ReviewFraudCase    = "FraudSv1.ReviewFraudCase"
+	FraudSv1WhitelistFraudCase = "FraudSv1.WhitelistFraudCase"
+	FraudSv1CloseFraudCase     = "FraudSv1.CloseFraudCase"
+)
+
 // ResourceS APIs
 const (
 	ResourceSv1Ping                  = "ResourceSv1.Ping"
@@ -2008,6 +2189,8 @@ const (
 	SessionSv1STIRAuthenticate           = "SessionSv1.STIRAuthenticate"
 	SessionSv1STIRIdentity               = "SessionSv1.STIRIdentity"
 	SessionSv1ThresholdNotify            = "SessionSv1.ThresholdNotify"
+	SessionSv1Subscribe                  = "SessionSv1.Subscribe"
+	SessionSv1Unsubscribe                = "SessionSv1.Unsubscribe"
 	SessionSv1Sleep                      = "SessionSv1.Sleep"
 	SessionSv1CapsError                  = "SessionSv1.CapsError"
 	SessionSv1BackupActiveSessions       = "SessionSv1.BackupActiveSessions"
@@ -2024,6 +2207,12 @@ const (
 	AgentV1SpendingStatusNotification = "AgentV1.SpendingStatusNotification"
 )
 
+// Subscriber APIs, served by the BiRPC clients subscribed for notifications
+const (
+	SubscriberV1       = "SubscriberV1"
+	SubscriberV1Notify = "SubscriberV1.Notify"
+)
+
 // Responder APIs
 const (
 	Responder                            = "Responder"
@@ -2063,9 +2252,19 @@ const (
 
 // AnalyzerS APIs
 const (
-	AnalyzerSv1            = "AnalyzerSv1"
-	AnalyzerSv1Ping        = "AnalyzerSv1.Ping"
-	AnalyzerSv1StringQuery = "AnalyzerSv1.StringQuery"
+	AnalyzerSv1                = "AnalyzerSv1"
+	AnalyzerSv1Ping            = "AnalyzerSv1.Ping"
+	AnalyzerSv1StringQuery     = "AnalyzerSv1.StringQuery"
+	AnalyzerSv1Replay          = "AnalyzerSv1.Replay"
+	AnalyzerSv1GetReplayStatus = "AnalyzerSv1.GetReplayStatus"
+	AnalyzerSv1StopReplay      = "AnalyzerSv1.StopReplay"
+)
+
+// AuditS APIs
+const (
+	AuditSv1                = "AuditSv1"
+	AuditSv1Ping            = "AuditSv1.Ping"
+	AuditSv1GetAuditRecords = "AuditSv1.GetAuditRecords"
 )
 
 // CacheS APIs
@@ -2113,6 +2312,8 @@ const (
 	CDRsV1ProcessEvent       = "CDRsV1.ProcessEvent"
 	CDRsV1ProcessEvents      = "CDRsV1.ProcessEvents"
 	CDRsV1Ping               = "CDRsV1.Ping"
+	CDRsV1PurgeCDRs          = "CDRsV1.PurgeCDRs"
+	CDRsV1EraseSubscriber    = "CDRsV1.EraseSubscriber"
 	CDRsV2                   = "CDRsV2"
 	CDRsV2StoreSessionCost   = "CDRsV2.StoreSessionCost"
 	CDRsV2ProcessEvent       = "CDRsV2.ProcessEvent"
@@ -2177,6 +2378,7 @@ const (
 	StatsCsv              = "Stats.csv"
 	TrendsCsv             = "Trends.csv"
 	RankingsCsv           = "Rankings.csv"
+	LookupTablesCsv       = "LookupTables.csv"
 	ThresholdsCsv         = "Thresholds.csv"
 	FiltersCsv            = "Filters.csv"
 	RoutesCsv             = "Routes.csv"
@@ -2203,11 +2405,13 @@ const (
 	TBLTPIPs              = "tp_ips"
 	TBLTPStats            = "tp_stats"
 	TBLTPRankings         = "tp_rankings"
+	TBLTPLookupTables     = "tp_lookup_tables"
 	TBLTPTrends           = "tp_trends"
 	TBLTPThresholds       = "tp_thresholds"
 	TBLTPFilters          = "tp_filters"
 	SessionCostsTBL       = "session_costs"
 	CDRsTBL               = "cdrs"
+	AuditRecordsTBL       = "audit_records"
 	TBLTPRoutes           = "tp_routes"
 	TBLTPAttributes       = "tp_attributes"
 	TBLTPChargers         = "tp_chargers"
@@ -2240,6 +2444,13 @@ const (
 	CacheRankingProfiles         = "*ranking_profiles"
 	CacheTrendProfiles           = "*trend_profiles"
 	CacheTrends                  = "*trends"
+	CachePortedNumbers           = "*ported_numbers"
+	CacheLookupTables            = "*lookup_tables"
+	CacheDiscountProfiles        = "*discount_profiles"
+	CacheFraudProfiles           = "*fraud_profiles"
+	CacheFraudCases              = "*fraud_cases"
+	CacheFraudFilterIndexes      = "*fraud_filter_indexes"
+	CacheDiscountFilterIndexes   = "*discount_filter_indexes"
 	CacheRankings                = "*rankings"
 	CacheThresholdProfiles       = "*threshold_profiles"
 	CacheThresholds              = "*thresholds"
@@ -2298,10 +2509,12 @@ const (
 	CacheTBLTPStats            = "*tp_stats"
 	CacheTBLTPTrends           = "*tp_trends"
 	CacheTBLTPRankings         = "*tp_rankings"
+	CacheTBLTPLookupTables     = "*tp_lookup_tables"
 	CacheTBLTPThresholds       = "*tp_thresholds"
 	CacheTBLTPFilters          = "*tp_filters"
 	CacheSessionCostsTBL       = "*session_costs"
 	CacheCDRsTBL               = "*cdrs"
+	CacheAuditRecordsTBL       = "*audit_records"
 	CacheTBLTPRoutes           = "*tp_routes"
 	CacheTBLTPAttributes       = "*tp_attributes"
 	CacheTBLTPChargers         = "*tp_chargers"
@@ -2318,6 +2531,8 @@ const (
 	AttributeFilterIndexes  = "afi_"
 	ChargerFilterIndexes    = "cfi_"
 	DispatcherFilterIndexes = "dfi_"
+	FraudFilterIndexes      = "ffi_"
+	DiscountFilterIndexes   = "dci_"
 	ActionPlanIndexes       = "api_"
 	RouteFilterIndexes      = "rti_"
 	FilterIndexPrfx         = "fii_"
@@ -2380,6 +2595,7 @@ const (
 	DigestEqualCfg          = "digest_equal"
 	RSRSepCfg               = "rsr_separator"
 	MaxParallelConnsCfg     = "max_parallel_conns"
+	SubscriberQueueLenCfg   = "subscriber_queue_len"
 	EEsConnsCfg             = "ees_conns"
 )
 
@@ -2441,6 +2657,11 @@ const (
 	RemoteConnIDCfg              = "remote_conn_id"
 	ReplicationFailedDirCfg      = "replication_failed_dir"
 	ReplicationIntervalCfg       = "replication_interval"
+	CDCEEsConnsCfg               = "cdc_ees_conns"
+	CDCEEsExporterIDsCfg         = "cdc_ees_exporter_ids"
+	CDCRetryIntervalCfg          = "cdc_retry_interval"
+	CDCQueueLenCfg               = "cdc_queue_len"
+	CDCFailedDirCfg              = "cdc_failed_dir"
 )
 
 // ItemOpt
@@ -2452,6 +2673,8 @@ const (
 	TTLCfg       = "ttl"
 	LimitCfg     = "limit"
 	StaticTTLCfg = "static_ttl"
+	RevisionsCfg = "revisions"
+	CDCCfg       = "cdc"
 )
 
 // Tls
@@ -2473,6 +2696,8 @@ const (
 	RPCJSONTLSListenCfg = "rpc_json_tls"
 	RPCGOBTLSListenCfg  = "rpc_gob_tls"
 	HTTPTLSListenCfg    = "http_tls"
+	GRPCListenCfg       = "grpc"
+	GRPCTLSListenCfg    = "grpc_tls"
 )
 
 // HTTPCfg
@@ -2511,6 +2736,7 @@ const (
 	ApierSConnsCfg    = "apiers_conns"
 	TrendSConnsCfg    = "trends_conns"
 	RankingSConnsCfg  = "rankings_conns"
+	FraudSConnsCfg    = "frauds_conns"
 )
 
 // RalsCfg
@@ -2524,6 +2750,22 @@ const (
 	BalanceRatingSubjectCfg    = "balance_rating_subject"
 	MaxIncrementsCfg           = "max_increments"
 	FallbackDepthCfg           = "fallback_depth"
+	DiscountsCfg               = "discounts"
+
+	DiscountsIndexedSelectsCfg      = "discounts_indexed_selects"
+	DiscountsNestedFieldsCfg        = "discounts_nested_fields"
+	DiscountsStringIndexedFieldsCfg = "discounts_string_indexed_fields"
+	DiscountsPrefixIndexedFieldsCfg = "discounts_prefix_indexed_fields"
+	DiscountsSuffixIndexedFieldsCfg = "discounts_suffix_indexed_fields"
+	DiscountsExistsIndexedFieldsCfg = "discounts_exists_indexed_fields"
+)
+
+// DiscountProfile types
+const (
+	MetaPercent     = "*percent"
+	MetaFixed       = "*fixed"
+	MetaFreeEvents  = "*free_events"
+	MetaVolumeTiers = "*volume_tiers"
 )
 
 // SchedulerCfg
@@ -2544,6 +2786,24 @@ const (
 	OnlineCDRExportsCfg    = "online_cdr_exports"
 	SessionCostRetires     = "session_cost_retries"
 	RateSConnsCfg          = "rates_conns"
+	RetentionCfg           = "retention"
+	PurgeIntervalCfg       = "purge_interval"
+	PoliciesCfg            = "policies"
+	TorsCfg                = "tors"
+	MaxAgeCfg              = "max_age"
+	PseudonymiseAfterCfg   = "pseudonymise_after"
+	PseudonymiseMethodCfg  = "pseudonymise_method"
+	PseudonymiseFieldsCfg  = "pseudonymise_fields"
+	PseudonymiseSaltCfg    = "pseudonymise_salt"
+	MaskKeepPrefixCfg      = "mask_keep_prefix"
+)
+
+// CDR pseudonymisation
+const (
+	MetaHash         = "*hash"
+	MetaMask         = "*mask"
+	MetaPseudonymise = "*pseudonymise"
+	Pseudonymised    = "Pseudonymised"
 )
 
 // SessionSCfg
@@ -2682,6 +2942,10 @@ const (
 	// ChargerSCfg
 	StoreIntervalCfg = "store_interval"
 
+	// ApierCfg
+	IndexHealthIntervalCfg = "index_health_interval"
+	IndexHealthRepairCfg   = "index_health_repair"
+
 	// StatSCfg
 	StoreUncompressedLimitCfg = "store_uncompressed_limit"
 	EEsExporterIDsCfg         = "ees_exporter_ids"
@@ -2713,6 +2977,11 @@ const (
 	DataCfg         = "data"
 
 	DefaultRatioCfg           = "default_ratio"
+	BreakerCfg                = "breaker"
+	FailureThresholdCfg       = "failure_threshold"
+	CooldownCfg               = "cooldown"
+	HalfOpenProbesCfg         = "half_open_probes"
+	FailureFiltersCfg         = "failure_filters"
 	ReadersCfg                = "readers"
 	ExportersCfg              = "exporters"
 	PoolSize                  = "poolSize"
@@ -2758,6 +3027,8 @@ const (
 	CapsStrategyCfg      = "caps_strategy"
 	CapsStatsIntervalCfg = "caps_stats_interval"
 	ShutdownTimeoutCfg   = "shutdown_timeout"
+	ConfigWatchCfg       = "config_watch"
+	ConfigWatchDelayCfg  = "config_watch_delay"
 
 	// AccountSCfg
 	MaxIterations = "max_iterations"
@@ -2829,6 +3100,58 @@ const (
 	GapiCredentialsCfg = "gapi_credentials"
 	GapiTokenCfg       = "gapi_token"
 	ScheduledIDsCfg    = "scheduled_ids"
+	RateDecksCfg       = "rate_decks"
+)
+
+// RateDeckCfg
+const (
+	HeaderLinesCfg    = "header_lines"
+	FullDeckCfg       = "full_deck"
+	DeletedValuesCfg  = "deleted_values"
+	RoundingMethodCfg = "rounding_method"
+	PrefixCfg         = "prefix"
+	DestinationCfg    = "destination"
+	RateCfg           = "rate"
+	ConnectFeeCfg     = "connect_fee"
+	RateUnitCfg       = "rate_unit"
+	RateIncrementCfg  = "rate_increment"
+	EffectiveDateCfg  = "effective_date"
+	ChangeCfg         = "change"
+)
+
+// FraudSCfg
+const (
+	MaxEvidenceCfg = "max_evidence"
+	TrackersTTLCfg = "trackers_ttl"
+)
+
+// FraudProfile signals, actions and case statuses
+const (
+	MetaDestinationRisk   = "*destination_risk"
+	MetaVelocity          = "*velocity"
+	MetaConcurrentCalls   = "*concurrent_calls"
+	MetaCostSpike         = "*cost_spike"
+	MetaGeoMismatch       = "*geo_mismatch"
+	MetaThrottle          = "*throttle"
+	MetaBlockAccount      = "*block_account"
+	MetaTerminateSessions = "*terminate_sessions"
+	MetaOpen              = "*open"
+	MetaUnderReview       = "*under_review"
+	MetaWhitelisted       = "*whitelisted"
+	MetaClosed            = "*closed"
+)
+
+// RouteBreaker states
+const (
+	MetaHalfOpen = "*half_open"
+)
+
+// RateDeck change types
+const (
+	MetaNew      = "*new"
+	MetaIncrease = "*increase"
+	MetaDecrease = "*decrease"
+	MetaDeleted  = "*deleted"
 )
 
 // MigratorCgrCfg
@@ -2894,6 +3217,31 @@ const (
 	KeysCfg = "keys"
 )
 
+// GeoIPCfg
+const (
+	CityDBPathCfg = "city_db_path"
+	ASNDBPathCfg  = "asn_db_path"
+
+	// TracingCfg
+	SampleRatioCfg    = "sample_ratio"
+	MemoryLimitCfg    = "memory_limit"
+	FilePathCfg       = "file_path"
+	OTLPURLCfg        = "otlp_url"
+	ExportIntervalCfg = "export_interval"
+	DBSpansCfg        = "db_spans"
+
+	// RBACCfg
+	DefaultRoleCfg = "default_role"
+	RolesCfg       = "roles"
+	APIKeysCfg     = "api_keys"
+	MethodsCfg     = "methods"
+	RoleCfg        = "role"
+	RBACKeyCfg     = "rbac_key"
+
+	// AuditCfg
+	StoreCfg = "store"
+)
+
 // SentryPeerCfg
 const (
 	ClientIdCfg      = "client_id"
@@ -2944,7 +3292,7 @@ var CGROptionsSet = NewStringSet([]string{OptsSessionsTTL,
 	OptsAttributesProfileIgnoreFilters, OptsStatsProfileIDs, OptsStatsProfileIgnoreFilters,
 	OptsThresholdsProfileIDs, OptsThresholdsProfileIgnoreFilters, OptsResourcesUsageID, OptsResourcesUsageTTL,
 	OptsResourcesUnits, OptsIPsAllocationID, OptsIPsTTL, OptsAttributeS, OptsThresholdS, OptsChargerS,
-	OptsStatS, OptsRALs, OptsRerate, OptsRefund, MetaAccountID})
+	OptsStatS, OptsRALs, OptsRerate, OptsRefund, MetaAccountID, OptsTraceParent, OptsRBACKey})
 
 // EventExporter metrics
 const (
@@ -2989,6 +3337,10 @@ const (
 	OptsDispatchersProfilesCount = "*dispatchersProfilesCount"
 	// EEs
 	OptsEEsVerbose = "*eesVerbose"
+	// Tracing
+	OptsTraceParent = "*traceParent"
+	// RBAC
+	OptsRBACKey = "*rbacKey"
 
 	// Resources
 	OptsResourcesUsageID  = "*rsUsageID"
@@ -3020,6 +3372,7 @@ const (
 	OptsChargerS   = "*chargerS"
 	OptsStatS      = "*statS"
 	OptsThresholdS = "*thresholdS"
+	OptsRouteS     = "*routeS"
 	OptsRALs       = "*ralS"
 	OptsRerate     = "*rerate"
 	OptsRefund     = "*refund"
@@ -3209,6 +3562,8 @@ const (
 
 	RequestStartTime = "RequestStartTime"
 	RequestDuration  = "RequestDuration"
+	RequestID        = "RequestID"
+	RequestMethod    = "RequestMethod"
 	RequestParams    = "RequestParams"
 	Reply            = "Reply"
 	ReplyError       = "ReplyError"
@@ -3242,6 +3597,12 @@ const (
 	CAPathCgr      = "ca_path"
 	HelpCgr        = "help"
 	SepCgr         = " "
+	ScriptCgr      = "script"
+	VarCgr         = "var"
+	StopOnErrorCgr = "stop_on_error"
+	OutputCgr      = "output"
+	FieldsCgr      = "fields"
+	PageSizeCgr    = "page_size"
 	//Cgr engine
 	CgrEngine            = "cgr-engine"
 	PrintCfgCgr          = "print_config"
@@ -3259,26 +3620,35 @@ const (
 	MemProfFinalFile     = "mem_final.prof"
 	CpuPathCgr           = "cpu.prof"
 	//Cgr loader
-	CgrLoader         = "cgr-loader"
-	StorDBTypeCgr     = "stordb_type"
-	StorDBHostCgr     = "stordb_host"
-	StorDBPortCgr     = "stordb_port"
-	StorDBNameCgr     = "stordb_name"
-	StorDBUserCgr     = "stordb_user"
-	StorDBPasswdCgr   = "stordb_passwd"
-	CachingArgCgr     = "caching"
-	FieldSepCgr       = "field_sep"
-	ImportIDCgr       = "import_id"
-	DisableReverseCgr = "disable_reverse_mappings"
-	FlushStorDB       = "flush_stordb"
-	RemoveCgr         = "remove"
-	FromStorDBCgr     = "from_stordb"
-	ToStorDBcgr       = "to_stordb"
-	CacheSAddress     = "caches_address"
-	SchedulerAddress  = "scheduler_address"
+	CgrLoader           = "cgr-loader"
+	StorDBTypeCgr       = "stordb_type"
+	StorDBHostCgr       = "stordb_host"
+	StorDBPortCgr       = "stordb_port"
+	StorDBNameCgr       = "stordb_name"
+	StorDBUserCgr       = "stordb_user"
+	StorDBPasswdCgr     = "stordb_passwd"
+	CachingArgCgr       = "caching"
+	FieldSepCgr         = "field_sep"
+	ImportIDCgr         = "import_id"
+	DisableReverseCgr   = "disable_reverse_mappings"
+	FlushStorDB         = "flush_stordb"
+	RemoveCgr           = "remove"
+	FromStorDBCgr       = "from_stordb"
+	ToStorDBcgr         = "to_stordb"
+	CacheSAddress       = "caches_address"
+	SchedulerAddress    = "scheduler_address"
+	LintCgr             = "lint"
+	RateDeckCgr         = "rate_deck"
+	RateDeckIDCgr       = "rate_deck_id"
+	RateDeckLayoutCgr   = "rate_deck_layout"
+	RateDeckCategoryCgr = "rate_deck_category"
+	RateDeckSubjectCgr  = "rate_deck_subject"
+	RateDeckReportCgr   = "rate_deck_report"
 	//Cgr migrator
 	CgrMigrator = "cgr-migrator"
 	ExecCgr     = "exec"
+	//Cgr tester
+	ScenarioCgr = "scenario"
 )
 
 // SessionS disconnect causes
@@ -3305,6 +3675,7 @@ var StringTmplType = StringSet{
 	MetaPrefix:          struct{}{},
 	MetaSuffix:          struct{}{},
 	MetaSIPCID:          struct{}{},
+	MetaPortedNumber:    struct{}{},
 }
 
 func buildCacheInstRevPrefixes() {
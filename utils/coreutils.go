@@ -811,22 +811,51 @@ func NewTenantID(input string) *TenantID {
 	return &TenantID{Tenant: tenant, ID: id}
 }
 
+// TenantGetter is implemented by the API arguments scoped to one tenant
+type TenantGetter interface {
+	GetTenant() string
+}
+
 type PaginatorWithTenant struct {
 	Tenant string
 	Search string // Global matching pattern in items returned, partially used in some APIs
 	Paginator
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (pw *PaginatorWithTenant) GetTenant() string {
+	if pw == nil {
+		return EmptyString
+	}
+	return pw.Tenant
+}
+
 type TenantWithAPIOpts struct {
 	Tenant  string
 	APIOpts map[string]any
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (tw *TenantWithAPIOpts) GetTenant() string {
+	if tw == nil {
+		return EmptyString
+	}
+	return tw.Tenant
+}
+
 type TenantID struct {
 	Tenant string
 	ID     string
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (tID *TenantID) GetTenant() string {
+	if tID == nil {
+		return EmptyString
+	}
+	return tID.Tenant
+}
+
 type TenantIDWithAPIOpts struct {
 	*TenantID
 	APIOpts map[string]any
@@ -905,6 +934,14 @@ type StringWithAPIOpts struct {
 	Arg     string
 }
 
+// GetTenant returns the tenant checked when authorizing the API calls
+func (sw *StringWithAPIOpts) GetTenant() string {
+	if sw == nil {
+		return EmptyString
+	}
+	return sw.Tenant
+}
+
 func CastRPCErr(err error) error {
 	if err != nil {
 		if _, has := ErrMap[err.Error()]; has {
@@ -140,6 +140,12 @@ func NewDataConverter(params string) (conv DataConverter, err error) {
 		return new(GigawordsConverter), nil
 	case strings.HasPrefix(params, Meta3GPPULI):
 		return NewULIConverter(params)
+	case params == MetaGeoIPCountry:
+		return GeoIPCountryConverter{}, nil
+	case params == MetaGeoIPASN:
+		return GeoIPASNConverter{}, nil
+	case params == MetaGeoIPCity:
+		return GeoIPCityConverter{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported converter definition: <%s>", params)
 	}
@@ -918,3 +924,27 @@ func (dt *DateTimeConverter) Convert(in any) (any, error) {
 	}
 	return tm, nil
 }
+
+// GeoIPCountryConverter returns the ISO country code of the IP using the GeoIP databases
+type GeoIPCountryConverter struct{}
+
+// Convert implements DataConverter interface
+func (GeoIPCountryConverter) Convert(in any) (any, error) {
+	return GeoIP.Country(IfaceAsString(in))
+}
+
+// GeoIPASNConverter returns the autonomous system number of the IP using the GeoIP databases
+type GeoIPASNConverter struct{}
+
+// Convert implements DataConverter interface
+func (GeoIPASNConverter) Convert(in any) (any, error) {
+	return GeoIP.ASN(IfaceAsString(in))
+}
+
+// GeoIPCityConverter returns the city name of the IP using the GeoIP databases
+type GeoIPCityConverter struct{}
+
+// Convert implements DataConverter interface
+func (GeoIPCityConverter) Convert(in any) (any, error) {
+	return GeoIP.City(IfaceAsString(in))
+}
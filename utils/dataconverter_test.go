@@ -2226,3 +2226,39 @@ func TestNewDateTimeConverterNew(t *testing.T) {
 		t.Fatal("expected parse error for empty input")
 	}
 }
+
+func TestGeoIPConverters(t *testing.T) {
+	tmpGeoIP := GeoIP
+	GeoIP = new(GeoIPDBs)
+	defer func() { GeoIP = tmpGeoIP }()
+	if err := GeoIP.Load(geoIPTestCityPath, geoIPTestASNPath); err != nil {
+		t.Fatal(err)
+	}
+	defer GeoIP.Close()
+	for params, exp := range map[string]any{
+		MetaGeoIPCountry: "IE",
+		MetaGeoIPCity:    "Dublin",
+	} {
+		conv, err := NewDataConverter(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rcv, err := conv.Convert("2a00:1450:4001:82a::200e"); err != nil {
+			t.Error(err)
+		} else if rcv != exp {
+			t.Errorf("%s: expected %v, received: %v", params, exp, rcv)
+		}
+	}
+	conv, err := NewDataConverter(MetaGeoIPASN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rcv, err := conv.Convert("8.8.8.8"); err != nil {
+		t.Error(err)
+	} else if rcv != int64(15169) {
+		t.Errorf("Expected 15169, received: %v", rcv)
+	}
+	if _, err := conv.Convert("2a00:1450:4001:82a::200e"); err != ErrNotFound {
+		t.Errorf("Expected %v, received: %v", ErrNotFound, err)
+	}
+}
@@ -87,6 +87,7 @@ var (
 	ErrNoBackupFound                    = errors.New("NO_BACKUP_FOUND")
 	ErrCorrelationUndefined             = errors.New("CORRELATION_UNDEFINED")
 	ErrWithErrors                       = errors.New("WITH_ERRORS")
+	ErrNotConsistent                    = errors.New("NOT_CONSISTENT")
 
 	ErrMap = map[string]error{
 		ErrNoMoreData.Error():                       ErrNoMoreData,
@@ -133,6 +134,7 @@ var (
 		ErrWrongPath.Error():                        ErrWrongPath,
 		ErrDSPHostNotFound.Error():                  ErrDSPHostNotFound,
 		ErrWithErrors.Error():                       ErrWithErrors,
+		ErrNotConsistent.Error():                    ErrNotConsistent,
 	}
 )
 
@@ -224,6 +226,10 @@ func NewErrThresholdS(err error) error {
 	return fmt.Errorf("THRESHOLDS_ERROR:%s", err)
 }
 
+func NewErrFraudS(err error) error {
+	return fmt.Errorf("FRAUDS_ERROR:%s", err)
+}
+
 func NewErrDispatcherS(err error) error {
 	return fmt.Errorf("%s:%s", DispatcherErrorPrefix, err.Error())
 }
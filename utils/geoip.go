@@ -0,0 +1,230 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// GeoIP is the database handler used by the *geoip filters and data converters
+var GeoIP = new(GeoIPDBs)
+
+// GeoIPDBs keeps the MaxMind databases in memory, reloading them when the files change on disk
+type GeoIPDBs struct {
+	sync.RWMutex
+	cityDB     *maxminddb.Reader // Country or City database
+	asnDB      *maxminddb.Reader // ASN database
+	cityDBPath string
+	asnDBPath  string
+	stopChan   chan struct{}
+}
+
+// openMMDB reads the whole database in memory so the file can be replaced on disk
+// without affecting the lookups in progress
+func openMMDB(dbPath string) (*maxminddb.Reader, error) {
+	buf, err := os.ReadFile(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	return maxminddb.FromBytes(buf)
+}
+
+// Load opens the databases found at the given paths(empty path disables the database)
+// and starts watching the files for changes
+func (g *GeoIPDBs) Load(cityDBPath, asnDBPath string) (err error) {
+	var cityDB, asnDB *maxminddb.Reader
+	if cityDBPath != EmptyString {
+		if cityDB, err = openMMDB(cityDBPath); err != nil {
+			return fmt.Errorf("<%s> cannot load database <%s>: %s", GeoIPLog, cityDBPath, err.Error())
+		}
+	}
+	if asnDBPath != EmptyString {
+		if asnDB, err = openMMDB(asnDBPath); err != nil {
+			return fmt.Errorf("<%s> cannot load database <%s>: %s", GeoIPLog, asnDBPath, err.Error())
+		}
+	}
+	g.Lock()
+	defer g.Unlock()
+	if g.stopChan != nil {
+		close(g.stopChan)
+		g.stopChan = nil
+	}
+	g.cityDB, g.asnDB = cityDB, asnDB
+	g.cityDBPath, g.asnDBPath = cityDBPath, asnDBPath
+	if cityDB == nil && asnDB == nil {
+		return
+	}
+	g.stopChan = make(chan struct{})
+	for _, dbPath := range []string{cityDBPath, asnDBPath} {
+		if dbPath == EmptyString {
+			continue
+		}
+		if err = g.watchDB(dbPath, g.stopChan); err != nil {
+			close(g.stopChan)
+			g.stopChan = nil
+			return
+		}
+	}
+	return
+}
+
+// Close stops watching the database files and releases the databases
+func (g *GeoIPDBs) Close() {
+	g.Lock()
+	if g.stopChan != nil {
+		close(g.stopChan)
+		g.stopChan = nil
+	}
+	g.cityDB, g.asnDB = nil, nil
+	g.cityDBPath, g.asnDBPath = EmptyString, EmptyString
+	g.Unlock()
+}
+
+// watchDB monitors the folder of the database since the updates are usually done by moving a new file over the old one
+func (g *GeoIPDBs) watchDB(dbPath string, stopChan chan struct{}) (err error) {
+	var watcher *fsnotify.Watcher
+	if watcher, err = fsnotify.NewWatcher(); err != nil {
+		return
+	}
+	if err = watcher.Add(filepath.Dir(dbPath)); err != nil {
+		watcher.Close()
+		return
+	}
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-stopChan:
+				return
+			case ev := <-watcher.Events:
+				if filepath.Clean(ev.Name) != filepath.Clean(dbPath) ||
+					ev.Op&(fsnotify.Create|fsnotify.Write) == 0 {
+					continue
+				}
+				g.reloadDB(dbPath, stopChan)
+			case err := <-watcher.Errors:
+				Logger.Err(fmt.Sprintf("<%s> watching database <%s>, error: <%s>, exiting!",
+					GeoIPLog, dbPath, err.Error()))
+				return
+			}
+		}
+	}()
+	return
+}
+
+// reloadDB replaces the database configured with dbPath, keeping the old one in case of errors
+func (g *GeoIPDBs) reloadDB(dbPath string, stopChan chan struct{}) {
+	db, err := openMMDB(dbPath)
+	if err != nil { // a partial write will be followed by another event
+		Logger.Warning(fmt.Sprintf("<%s> cannot reload database <%s>: %s",
+			GeoIPLog, dbPath, err.Error()))
+		return
+	}
+	g.Lock()
+	defer g.Unlock()
+	if g.stopChan != stopChan { // the databases were changed in the meantime
+		return
+	}
+	if dbPath == g.cityDBPath {
+		g.cityDB = db
+	}
+	if dbPath == g.asnDBPath {
+		g.asnDB = db
+	}
+	Logger.Info(fmt.Sprintf("<%s> reloaded database <%s>", GeoIPLog, dbPath))
+}
+
+// lookup decodes into rec the record stored for the IP, returning ErrNotFound if there is none
+func (g *GeoIPDBs) lookup(asn bool, ipStr string, rec any) (err error) {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return fmt.Errorf("invalid IP address: <%s>", ipStr)
+	}
+	g.RLock()
+	db := g.cityDB
+	if asn {
+		db = g.asnDB
+	}
+	g.RUnlock()
+	if db == nil {
+		return ErrNotConnected
+	}
+	var found bool
+	if _, found, err = db.LookupNetwork(ip, rec); err != nil {
+		return
+	}
+	if !found {
+		return ErrNotFound
+	}
+	return
+}
+
+// Country returns the ISO 3166-1 code of the country where the IP is located
+func (g *GeoIPDBs) Country(ip string) (string, error) {
+	var rec struct {
+		Country struct {
+			ISOCode string `maxminddb:"iso_code"`
+		} `maxminddb:"country"`
+	}
+	if err := g.lookup(false, ip, &rec); err != nil {
+		return EmptyString, err
+	}
+	if rec.Country.ISOCode == EmptyString {
+		return EmptyString, ErrNotFound
+	}
+	return rec.Country.ISOCode, nil
+}
+
+// City returns the english name of the city where the IP is located
+func (g *GeoIPDBs) City(ip string) (string, error) {
+	var rec struct {
+		City struct {
+			Names map[string]string `maxminddb:"names"`
+		} `maxminddb:"city"`
+	}
+	if err := g.lookup(false, ip, &rec); err != nil {
+		return EmptyString, err
+	}
+	name, has := rec.City.Names["en"]
+	if !has {
+		return EmptyString, ErrNotFound
+	}
+	return name, nil
+}
+
+// ASN returns the number of the autonomous system owning the IP
+func (g *GeoIPDBs) ASN(ip string) (int64, error) {
+	var rec struct {
+		ASN *uint `maxminddb:"autonomous_system_number"`
+	}
+	if err := g.lookup(true, ip, &rec); err != nil {
+		return 0, err
+	}
+	if rec.ASN == nil {
+		return 0, ErrNotFound
+	}
+	return int64(*rec.ASN), nil
+}
@@ -0,0 +1,351 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+var (
+	geoIPTestCityPath = "../data/geoip/GeoLite2-City-Test.mmdb"
+	geoIPTestASNPath  = "../data/geoip/GeoLite2-ASN-Test.mmdb"
+)
+
+// MaxMind DB format details needed to build the test databases
+var mmdbMetaStart = []byte("\xAB\xCD\xEFMaxMind.com")
+
+const (
+	mmdbDataSectionSep = 16 // null bytes between the search tree and the data section
+
+	mmdbString = 2
+	mmdbDouble = 3
+	mmdbUint16 = 5
+	mmdbUint32 = 6
+	mmdbMap    = 7
+	mmdbInt32  = 8
+	mmdbSlice  = 11
+	mmdbBool   = 14
+)
+
+type mmdbTestNode struct {
+	children [2]*mmdbTestNode
+	data     any
+}
+
+// newTestMMDB builds a minimal MaxMind DB with the records mapped on networks
+func newTestMMDB(t *testing.T, dbType string, recordSize uint, records map[string]any) []byte {
+	t.Helper()
+	root := new(mmdbTestNode)
+	for cidr, rec := range records {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ip := ipNet.IP.To16()
+		ones, _ := ipNet.Mask.Size()
+		if ip4 := ipNet.IP.To4(); ip4 != nil { // IPv4 networks are stored under ::/96
+			ip = append(make(net.IP, 12), ip4...)
+			ones += 96
+		}
+		nd := root
+		for i := 0; i < ones; i++ {
+			bit := ip[i>>3] >> (7 - uint(i&7)) & 1
+			if nd.children[bit] == nil {
+				nd.children[bit] = new(mmdbTestNode)
+			}
+			nd = nd.children[bit]
+		}
+		nd.data = rec
+	}
+	// number the internal nodes in BFS order
+	var nodes []*mmdbTestNode
+	idx := make(map[*mmdbTestNode]uint)
+	for queue := []*mmdbTestNode{root}; len(queue) != 0; queue = queue[1:] {
+		nd := queue[0]
+		if nd.data != nil {
+			continue
+		}
+		idx[nd] = uint(len(nodes))
+		nodes = append(nodes, nd)
+		for _, child := range nd.children {
+			if child != nil {
+				queue = append(queue, child)
+			}
+		}
+	}
+	nodeCount := uint(len(nodes))
+	var data bytes.Buffer
+	tree := make([]byte, nodeCount*recordSize/4)
+	for i, nd := range nodes {
+		for bit, child := range nd.children {
+			rec := nodeCount // empty
+			if child != nil && child.data == nil {
+				rec = idx[child]
+			} else if child != nil {
+				rec = nodeCount + mmdbDataSectionSep + uint(data.Len())
+				data.Write(mmdbTestEncode(child.data))
+			}
+			b := tree[uint(i)*recordSize/4:]
+			switch recordSize {
+			case 24:
+				b[bit*3], b[bit*3+1], b[bit*3+2] = byte(rec>>16), byte(rec>>8), byte(rec)
+			case 28:
+				if bit == 0 {
+					b[0], b[1], b[2] = byte(rec>>16), byte(rec>>8), byte(rec)
+					b[3] |= byte(rec>>20) & 0xF0
+				} else {
+					b[4], b[5], b[6] = byte(rec>>16), byte(rec>>8), byte(rec)
+					b[3] |= byte(rec>>24) & 0x0F
+				}
+			default:
+				binary.BigEndian.PutUint32(b[bit*4:], uint32(rec))
+			}
+		}
+	}
+	var db bytes.Buffer
+	db.Write(tree)
+	db.Write(make([]byte, mmdbDataSectionSep))
+	db.Write(data.Bytes())
+	db.Write(mmdbMetaStart)
+	db.Write(mmdbTestEncode(map[string]any{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"node_count":                  uint32(nodeCount),
+		"record_size":                 uint16(recordSize),
+		"ip_version":                  uint16(6),
+		"database_type":               dbType,
+	}))
+	return db.Bytes()
+}
+
+func mmdbTestCtrl(typ int, size int) (ctrl []byte) {
+	var extSize []byte
+	if size >= 29 { // only sizes up to 284 are needed in tests
+		extSize = []byte{byte(size - 29)}
+		size = 29
+	}
+	if typ <= 7 {
+		ctrl = []byte{byte(typ<<5 | size)}
+	} else {
+		ctrl = []byte{byte(size), byte(typ - 7)}
+	}
+	return append(ctrl, extSize...)
+}
+
+func mmdbTestEncode(val any) []byte {
+	var b bytes.Buffer
+	switch v := val.(type) {
+	case string:
+		b.Write(mmdbTestCtrl(mmdbString, len(v)))
+		b.WriteString(v)
+	case uint16:
+		b.Write(mmdbTestCtrl(mmdbUint16, 2))
+		binary.Write(&b, binary.BigEndian, v)
+	case uint32:
+		b.Write(mmdbTestCtrl(mmdbUint32, 4))
+		binary.Write(&b, binary.BigEndian, v)
+	case int32:
+		b.Write(mmdbTestCtrl(mmdbInt32, 4))
+		binary.Write(&b, binary.BigEndian, v)
+	case float64:
+		b.Write(mmdbTestCtrl(mmdbDouble, 8))
+		binary.Write(&b, binary.BigEndian, v)
+	case bool:
+		var size int
+		if v {
+			size = 1
+		}
+		b.Write(mmdbTestCtrl(mmdbBool, size))
+	case []any:
+		b.Write(mmdbTestCtrl(mmdbSlice, len(v)))
+		for _, itm := range v {
+			b.Write(mmdbTestEncode(itm))
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.Write(mmdbTestCtrl(mmdbMap, len(v)))
+		for _, k := range keys {
+			b.Write(mmdbTestEncode(k))
+			b.Write(mmdbTestEncode(v[k]))
+		}
+	}
+	return b.Bytes()
+}
+
+var mmdbTestCityRecords = map[string]any{
+	"8.8.8.0/24": map[string]any{
+		"country": map[string]any{"iso_code": "US"},
+		"city":    map[string]any{"names": map[string]any{"en": "Mountain View"}},
+	},
+	"81.196.0.0/16": map[string]any{
+		"country": map[string]any{"iso_code": "RO"},
+	},
+	"2a00:1450::/32": map[string]any{
+		"country": map[string]any{"iso_code": "IE"},
+		"city":    map[string]any{"names": map[string]any{"en": "Dublin"}},
+	},
+}
+
+var mmdbTestASNRecords = map[string]any{
+	"8.8.8.0/24": map[string]any{
+		"autonomous_system_number":       uint32(15169),
+		"autonomous_system_organization": "GOOGLE",
+	},
+}
+
+func TestGeoIPDBsRecordSizes(t *testing.T) {
+	for _, recordSize := range []uint{24, 28, 32} {
+		db, err := maxminddb.FromBytes(newTestMMDB(t, "GeoLite2-City", recordSize, mmdbTestCityRecords))
+		if err != nil {
+			t.Fatal(err)
+		}
+		geo := &GeoIPDBs{cityDB: db}
+		if rcv, err := geo.Country("8.8.8.8"); err != nil {
+			t.Error(err)
+		} else if rcv != "US" {
+			t.Errorf("record size %d: expected US, received: %q", recordSize, rcv)
+		}
+		if rcv, err := geo.Country("81.196.10.1"); err != nil {
+			t.Error(err)
+		} else if rcv != "RO" {
+			t.Errorf("record size %d: expected RO, received: %q", recordSize, rcv)
+		}
+		if rcv, err := geo.City("2a00:1450:4001::1"); err != nil {
+			t.Error(err)
+		} else if rcv != "Dublin" {
+			t.Errorf("record size %d: expected Dublin, received: %q", recordSize, rcv)
+		}
+		if _, err := geo.City("81.196.10.1"); err != ErrNotFound {
+			t.Errorf("Expected %v, received: %v", ErrNotFound, err)
+		}
+		if _, err := geo.Country("9.9.9.9"); err != ErrNotFound {
+			t.Errorf("Expected %v, received: %v", ErrNotFound, err)
+		}
+		if _, err := geo.Country("2001:db8::1"); err != ErrNotFound {
+			t.Errorf("Expected %v, received: %v", ErrNotFound, err)
+		}
+	}
+}
+
+// TestGeoIPTestData makes sure the databases used by the tests of the other packages
+// are the ones built from the records above
+func TestGeoIPTestData(t *testing.T) {
+	if os.Getenv("CGR_GEOIP_TESTDATA") != EmptyString { // regenerate the files
+		if err := os.WriteFile(geoIPTestCityPath, newTestMMDB(t, "GeoLite2-City", 28, mmdbTestCityRecords), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(geoIPTestASNPath, newTestMMDB(t, "GeoLite2-ASN", 28, mmdbTestASNRecords), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for dbPath, db := range map[string][]byte{
+		geoIPTestCityPath: newTestMMDB(t, "GeoLite2-City", 28, mmdbTestCityRecords),
+		geoIPTestASNPath:  newTestMMDB(t, "GeoLite2-ASN", 28, mmdbTestASNRecords),
+	} {
+		if rcv, err := os.ReadFile(dbPath); err != nil {
+			t.Error(err)
+		} else if !bytes.Equal(rcv, db) {
+			t.Errorf("%s is outdated, run the test with CGR_GEOIP_TESTDATA=1 to regenerate it", dbPath)
+		}
+	}
+}
+
+func TestGeoIPDBs(t *testing.T) {
+	geo := new(GeoIPDBs)
+	if _, err := geo.Country("8.8.8.8"); err != ErrNotConnected {
+		t.Errorf("Expected %v, received: %v", ErrNotConnected, err)
+	}
+	dir := t.TempDir()
+	cityPath := filepath.Join(dir, "city.mmdb")
+	asnPath := filepath.Join(dir, "asn.mmdb")
+	if err := os.WriteFile(cityPath, newTestMMDB(t, "GeoLite2-City", 24, mmdbTestCityRecords), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(asnPath, newTestMMDB(t, "GeoLite2-ASN", 24, mmdbTestASNRecords), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := geo.Load(cityPath, filepath.Join(dir, "missing.mmdb")); err == nil {
+		t.Error("Expected error for missing database")
+	}
+	if err := geo.Load(cityPath, asnPath); err != nil {
+		t.Fatal(err)
+	}
+	defer geo.Close()
+	if rcv, err := geo.Country("8.8.8.8"); err != nil {
+		t.Error(err)
+	} else if rcv != "US" {
+		t.Errorf("Expected US, received: %q", rcv)
+	}
+	if rcv, err := geo.City("8.8.8.8"); err != nil {
+		t.Error(err)
+	} else if rcv != "Mountain View" {
+		t.Errorf("Expected Mountain View, received: %q", rcv)
+	}
+	if rcv, err := geo.ASN("8.8.8.8"); err != nil {
+		t.Error(err)
+	} else if rcv != 15169 {
+		t.Errorf("Expected 15169, received: %d", rcv)
+	}
+	if _, err := geo.ASN("81.196.10.1"); err != ErrNotFound {
+		t.Errorf("Expected %v, received: %v", ErrNotFound, err)
+	}
+	if _, err := geo.Country("not_an_ip"); err == nil ||
+		err.Error() != "invalid IP address: <not_an_ip>" {
+		t.Errorf("Expected invalid IP error, received: %v", err)
+	}
+	// the database type in metadata does not matter, the configured path decides the slot
+	if err := os.WriteFile(cityPath, newTestMMDB(t, "GeoLite2-ASN", 24, map[string]any{
+		"81.196.0.0/16": map[string]any{"country": map[string]any{"iso_code": "FR"}},
+	}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	geo.reloadDB(cityPath, geo.stopChan)
+	if rcv, err := geo.Country("81.196.10.1"); err != nil {
+		t.Error(err)
+	} else if rcv != "FR" {
+		t.Errorf("Expected FR, received: %q", rcv)
+	}
+	if rcv, err := geo.ASN("8.8.8.8"); err != nil {
+		t.Error(err)
+	} else if rcv != 15169 {
+		t.Errorf("Expected 15169, received: %d", rcv)
+	}
+	geo.reloadDB(filepath.Join(dir, "other.mmdb"), geo.stopChan) // not configured
+	if rcv, err := geo.Country("81.196.10.1"); err != nil {
+		t.Error(err)
+	} else if rcv != "FR" {
+		t.Errorf("Expected FR, received: %q", rcv)
+	}
+	geo.Close()
+	if _, err := geo.City("8.8.8.8"); err != ErrNotConnected {
+		t.Errorf("Expected %v, received: %v", ErrNotConnected, err)
+	}
+}
@@ -873,3 +873,122 @@ func ReflectFieldMethodInterface(obj any, fldName string) (retIf any, err error)
 	}
 	return field.Interface(), nil
 }
+
+// argsField returns the field of the RPC arguments with the given name,
+// promoted fields of the nil embedded structs being considered missing
+func argsField(args any, fldName string) (v reflect.Value, has bool) {
+	v = reflect.ValueOf(args)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	sf, has := v.Type().FieldByName(fldName)
+	if !has {
+		return
+	}
+	var err error
+	if v, err = v.FieldByIndexErr(sf.Index); err != nil {
+		return v, false
+	}
+	return
+}
+
+// argsAPIOpts returns the APIOpts field of the RPC arguments
+func argsAPIOpts(args any) (v reflect.Value, has bool) {
+	if v, has = argsField(args, "APIOpts"); !has ||
+		v.Type() != reflect.TypeFor[map[string]any]() {
+		return v, false
+	}
+	return
+}
+
+// APIOptFromArgs returns the option received in the APIOpts of the RPC arguments, nil if missing
+func APIOptFromArgs(args any, opt string) any {
+	opts, has := argsAPIOpts(args)
+	if !has || opts.IsNil() {
+		return nil
+	}
+	return opts.Interface().(map[string]any)[opt]
+}
+
+// SetArgsAPIOpt sets the option in the APIOpts of the RPC arguments.
+// The APIOpts are replaced with a copy so the maps shared with other calls are not modified.
+// The returned function puts back the original APIOpts
+func SetArgsAPIOpt(args any, opt string, val any) (restore func()) {
+	restore = func() {}
+	opts, has := argsAPIOpts(args)
+	if !has || !opts.CanSet() {
+		return
+	}
+	orig := opts.Interface().(map[string]any)
+	newOpts := make(map[string]any, len(orig)+1)
+	for k, v := range orig {
+		newOpts[k] = v
+	}
+	newOpts[opt] = val
+	opts.Set(reflect.ValueOf(newOpts))
+	return func() { opts.Set(reflect.ValueOf(orig)) }
+}
+
+// TenantFromArgs returns the Tenant field of the RPC arguments, empty if missing
+func TenantFromArgs(args any) string {
+	return StringFieldFromArgs(args, "Tenant")
+}
+
+// StringFieldFromArgs returns the string field of the RPC arguments, empty if missing
+func StringFieldFromArgs(args any, fldName string) string {
+	fld, has := argsField(args, fldName)
+	if !has || fld.Kind() != reflect.String {
+		return EmptyString
+	}
+	return fld.String()
+}
+
+// DiffValues returns the paths of the fields which differ between the two values
+// decoded from JSON, the keys in ignore are skipped at any depth
+func DiffValues(path string, exp, rcv any, ignore StringSet) (diffs []string) {
+	diffValues(path, exp, rcv, ignore, &diffs)
+	return
+}
+
+func diffValues(path string, exp, rcv any, ignore StringSet, diffs *[]string) {
+	switch expVal := exp.(type) {
+	case map[string]any:
+		rcvVal, canCast := rcv.(map[string]any)
+		if !canCast {
+			*diffs = append(*diffs, path)
+			return
+		}
+		keys := NewStringSet(nil)
+		for k := range expVal {
+			keys.Add(k)
+		}
+		for k := range rcvVal {
+			keys.Add(k)
+		}
+		for _, k := range keys.AsOrderedSlice() {
+			if ignore.Has(k) {
+				continue
+			}
+			diffValues(path+NestingSep+k, expVal[k], rcvVal[k], ignore, diffs)
+		}
+	case []any:
+		rcvVal, canCast := rcv.([]any)
+		if !canCast || len(expVal) != len(rcvVal) {
+			*diffs = append(*diffs, path)
+			return
+		}
+		for i := range expVal {
+			diffValues(path+IdxStart+strconv.Itoa(i)+IdxEnd, expVal[i], rcvVal[i], ignore, diffs)
+		}
+	default:
+		if !reflect.DeepEqual(exp, rcv) {
+			*diffs = append(*diffs, path)
+		}
+	}
+}
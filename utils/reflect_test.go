@@ -2065,3 +2065,67 @@ func TestDifferences(t *testing.T) {
 		})
 	}
 }
+
+func TestArgsAPIOptsAndTenant(t *testing.T) {
+	type argsWithEmbeddedEv struct {
+		IDs []string
+		*CGREvent
+	}
+	ev := &CGREvent{
+		Tenant:  "cgrates.org",
+		APIOpts: map[string]any{OptsAPIKey: "key1"},
+	}
+	if rcv := TenantFromArgs(ev); rcv != "cgrates.org" {
+		t.Errorf("Expected %q, received %q", "cgrates.org", rcv)
+	}
+	if rcv := APIOptFromArgs(&argsWithEmbeddedEv{CGREvent: ev}, OptsAPIKey); rcv != "key1" {
+		t.Errorf("Expected %q, received %v", "key1", rcv)
+	}
+	// the fields promoted from nil embedded structs are missing
+	if rcv := TenantFromArgs(&argsWithEmbeddedEv{}); rcv != EmptyString {
+		t.Errorf("Expected empty tenant, received %q", rcv)
+	}
+	if rcv := APIOptFromArgs(&argsWithEmbeddedEv{}, OptsAPIKey); rcv != nil {
+		t.Errorf("Expected nil, received %v", rcv)
+	}
+	if rcv := APIOptFromArgs(StringPointer("args"), OptsAPIKey); rcv != nil {
+		t.Errorf("Expected nil, received %v", rcv)
+	}
+	orig := ev.APIOpts
+	restore := SetArgsAPIOpt(ev, OptsAPIKey, "key2")
+	if rcv := APIOptFromArgs(ev, OptsAPIKey); rcv != "key2" {
+		t.Errorf("Expected %q, received %v", "key2", rcv)
+	}
+	if orig[OptsAPIKey] != "key1" {
+		t.Errorf("Expected the original APIOpts to not be modified, received %v", orig)
+	}
+	restore()
+	if rcv := APIOptFromArgs(ev, OptsAPIKey); rcv != "key1" {
+		t.Errorf("Expected %q, received %v", "key1", rcv)
+	}
+}
+
+func TestStringFieldFromArgs(t *testing.T) {
+	args := &TenantIDWithAPIOpts{TenantID: &TenantID{Tenant: "cgrates.org", ID: "ATTR_1"}}
+	if rcv := StringFieldFromArgs(args, ID); rcv != "ATTR_1" {
+		t.Errorf("Expected %q, received %q", "ATTR_1", rcv)
+	}
+	if rcv := StringFieldFromArgs(args, "APIOpts"); rcv != EmptyString {
+		t.Errorf("Expected empty, received %q", rcv)
+	}
+	if rcv := StringFieldFromArgs(&TenantIDWithAPIOpts{}, ID); rcv != EmptyString {
+		t.Errorf("Expected empty, received %q", rcv)
+	}
+}
+
+func TestDiffValues(t *testing.T) {
+	exp := map[string]any{"ID": "ATTR_1", "Weight": 10., "FilterIDs": []any{"f1"}}
+	rcv := map[string]any{"ID": "ATTR_1", "Weight": 20., "FilterIDs": []any{"f2"}, "Blocker": true}
+	eDiffs := []string{"Attr.Blocker", "Attr.FilterIDs[0]", "Attr.Weight"}
+	if diffs := DiffValues("Attr", exp, rcv, nil); !reflect.DeepEqual(eDiffs, diffs) {
+		t.Errorf("Expected %v, received %v", eDiffs, diffs)
+	}
+	if diffs := DiffValues("Attr", exp, rcv, NewStringSet([]string{"Weight", "Blocker"})); !reflect.DeepEqual([]string{"Attr.FilterIDs[0]"}, diffs) {
+		t.Errorf("Unexpected diffs: %v", diffs)
+	}
+}
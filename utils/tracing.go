@@ -0,0 +1,305 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+)
+
+// Tracer creates the spans of the distributed tracing, disabled until loaded with exporters
+var Tracer = new(SpanTracer)
+
+// SpanContext identifies a span across processes
+type SpanContext struct {
+	TraceID string // 32 lowercase hex characters
+	SpanID  string // 16 lowercase hex characters
+	Sampled bool
+}
+
+// ParseTraceParent parses the W3C traceparent format: 00-<trace-id>-<span-id>-<flags>
+func ParseTraceParent(traceParent string) (sc SpanContext, err error) {
+	flds := strings.Split(traceParent, MinusChar)
+	if len(flds) != 4 ||
+		len(flds[0]) != 2 || flds[0] == "ff" ||
+		len(flds[1]) != 32 || !isLowerHex(flds[1]) || flds[1] == strings.Repeat("0", 32) ||
+		len(flds[2]) != 16 || !isLowerHex(flds[2]) || flds[2] == strings.Repeat("0", 16) ||
+		len(flds[3]) != 2 || !isLowerHex(flds[3]) {
+		return sc, fmt.Errorf("invalid traceparent: <%s>", traceParent)
+	}
+	var flags []byte
+	if flags, err = hex.DecodeString(flds[3]); err != nil {
+		return
+	}
+	return SpanContext{
+		TraceID: flds[1],
+		SpanID:  flds[2],
+		Sampled: flags[0]&1 == 1,
+	}, nil
+}
+
+func isLowerHex(s string) bool {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// TraceParent returns the W3C traceparent representation of the SpanContext
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return "00-" + sc.TraceID + MinusChar + sc.SpanID + MinusChar + flags
+}
+
+// Span is a timed operation which is part of a trace
+type Span struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Name         string
+	Kind         string // <*server|*client|*internal>
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   map[string]any
+	Error        string
+
+	mux    sync.Mutex
+	tracer *SpanTracer
+}
+
+// SetAttribute adds information to the span, safe to be called on nil spans
+func (s *Span) SetAttribute(key string, val any) {
+	if s == nil {
+		return
+	}
+	s.mux.Lock()
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]any)
+	}
+	s.Attributes[key] = val
+	s.mux.Unlock()
+}
+
+// TraceParent returns the traceparent which makes the remote spans children of this one
+func (s *Span) TraceParent() string {
+	if s == nil {
+		return EmptyString
+	}
+	return SpanContext{TraceID: s.TraceID, SpanID: s.SpanID, Sampled: true}.TraceParent()
+}
+
+// End closes the span recording the error(if any) and sends it to the exporters
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.mux.Lock()
+	if !s.EndTime.IsZero() { // already ended
+		s.mux.Unlock()
+		return
+	}
+	s.EndTime = time.Now()
+	if err != nil {
+		s.Error = err.Error()
+	}
+	s.mux.Unlock()
+	s.tracer.export(s)
+}
+
+// Duration returns the time spent in the span
+func (s *Span) Duration() time.Duration {
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// SpanExporter sends the ended spans outside of the tracer
+type SpanExporter interface {
+	ExportSpan(*Span)
+	Close() error
+}
+
+// SpanTracer creates the spans and passes them to the exporters once ended
+type SpanTracer struct {
+	sync.RWMutex
+	sampleRatio float64
+	dbSpans     bool
+	exporters   []SpanExporter
+}
+
+// Load replaces the tracing settings, closing the previous exporters.
+// Without exporters the tracing is disabled
+func (t *SpanTracer) Load(sampleRatio float64, dbSpans bool, exporters ...SpanExporter) {
+	t.Lock()
+	oldExps := t.exporters
+	t.sampleRatio = sampleRatio
+	t.dbSpans = dbSpans
+	t.exporters = exporters
+	t.Unlock()
+	for _, exp := range oldExps {
+		if err := exp.Close(); err != nil {
+			Logger.Warning(fmt.Sprintf("<%s> failed closing span exporter: %s", TracingLog, err))
+		}
+	}
+}
+
+// Close disables the tracing and closes the exporters
+func (t *SpanTracer) Close() {
+	t.Load(0, false)
+}
+
+// Enabled returns true if the spans are recorded
+func (t *SpanTracer) Enabled() bool {
+	t.RLock()
+	defer t.RUnlock()
+	return len(t.exporters) != 0
+}
+
+// DBSpans returns true if the DataDB and StorDB queries should be traced
+func (t *SpanTracer) DBSpans() bool {
+	t.RLock()
+	defer t.RUnlock()
+	return len(t.exporters) != 0 && t.dbSpans
+}
+
+// StartSpan creates a new span, child of the span identified by traceParent
+// or the root of a new trace if traceParent is empty. A nil span is returned
+// if the tracing is disabled or the trace is not sampled
+func (t *SpanTracer) StartSpan(traceParent, name, kind string) *Span {
+	t.RLock()
+	enabled := len(t.exporters) != 0
+	sampleRatio := t.sampleRatio
+	t.RUnlock()
+	if !enabled {
+		return nil
+	}
+	s := &Span{
+		SpanID:    newTraceID(8),
+		Name:      name,
+		Kind:      kind,
+		StartTime: time.Now(),
+		tracer:    t,
+	}
+	if traceParent != EmptyString {
+		if sc, err := ParseTraceParent(traceParent); err == nil {
+			if !sc.Sampled { // the decision was taken by the caller
+				return nil
+			}
+			s.TraceID = sc.TraceID
+			s.ParentSpanID = sc.SpanID
+			return s
+		}
+	}
+	if sampleRatio < 1 && rand.Float64() >= sampleRatio {
+		return nil
+	}
+	s.TraceID = newTraceID(16)
+	return s
+}
+
+func (t *SpanTracer) export(s *Span) {
+	t.RLock()
+	defer t.RUnlock()
+	for _, exp := range t.exporters {
+		exp.ExportSpan(s)
+	}
+}
+
+// TraceSpansArgs are the arguments used to query the spans of a trace
+type TraceSpansArgs struct {
+	TraceID string // all the traces if empty
+	Tenant  string
+	APIOpts map[string]any
+}
+
+// Spans returns the spans of the trace(all traces if traceID is empty) kept by the *memory exporter
+func (t *SpanTracer) Spans(traceID string) (spans []*Span, err error) {
+	t.RLock()
+	defer t.RUnlock()
+	for _, exp := range t.exporters {
+		if memExp, canCast := exp.(*MemorySpanExporter); canCast {
+			if spans = memExp.Spans(traceID); len(spans) == 0 {
+				return nil, ErrNotFound
+			}
+			return
+		}
+	}
+	return nil, fmt.Errorf("%s exporter not enabled", MetaMemory)
+}
+
+// newTraceID returns a random non zero id of n bytes encoded as hex
+func newTraceID(n int) string {
+	b := make([]byte, n)
+	for {
+		for i := 0; i < n; i += 8 {
+			v := rand.Uint64()
+			for j := 0; j < 8 && i+j < n; j++ {
+				b[i+j] = byte(v >> (8 * j))
+			}
+		}
+		for _, c := range b {
+			if c != 0 {
+				return hex.EncodeToString(b)
+			}
+		}
+	}
+}
+
+type spanCtxKey struct{}
+
+// ContextWithSpan returns a copy of ctx carrying the span
+func ContextWithSpan(ctx *context.Context, s *Span) *context.Context {
+	if s == nil {
+		return ctx
+	}
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	return context.WithValue(ctx, spanCtxKey{}, s)
+}
+
+// SpanFromContext returns the span carried by ctx, nil if none
+func SpanFromContext(ctx *context.Context) *Span {
+	if ctx == nil || ctx.Context == nil {
+		return nil
+	}
+	s, _ := ctx.Value(spanCtxKey{}).(*Span)
+	return s
+}
+
+// TraceParentFromArgs returns the traceparent propagated through the APIOpts of the RPC arguments
+func TraceParentFromArgs(args any) string {
+	tp, _ := APIOptFromArgs(args, OptsTraceParent).(string)
+	return tp
+}
+
+// SetArgsTraceParent propagates the traceparent through the APIOpts of the RPC arguments.
+// The returned function puts back the original APIOpts
+func SetArgsTraceParent(args any, traceParent string) (restore func()) {
+	return SetArgsAPIOpt(args, OptsTraceParent, traceParent)
+}
@@ -0,0 +1,310 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NewMemorySpanExporter returns a SpanExporter keeping the last limit spans in memory
+func NewMemorySpanExporter(limit int) *MemorySpanExporter {
+	return &MemorySpanExporter{
+		limit: limit,
+		spans: make([]*Span, 0, min(max(limit, 0), 1024)),
+	}
+}
+
+// MemorySpanExporter keeps the spans in a ring buffer, useful for testing and debugging
+type MemorySpanExporter struct {
+	sync.RWMutex
+	limit int // <=0 for no limit
+	spans []*Span
+	next  int // position of the oldest span once the limit is reached
+}
+
+// ExportSpan implements SpanExporter
+func (m *MemorySpanExporter) ExportSpan(s *Span) {
+	m.Lock()
+	defer m.Unlock()
+	if m.limit <= 0 || len(m.spans) < m.limit {
+		m.spans = append(m.spans, s)
+		return
+	}
+	m.spans[m.next] = s
+	m.next = (m.next + 1) % m.limit
+}
+
+// Spans returns the spans of the trace, all of them if traceID is empty, oldest first
+func (m *MemorySpanExporter) Spans(traceID string) (spans []*Span) {
+	m.RLock()
+	defer m.RUnlock()
+	for i := range m.spans {
+		s := m.spans[(m.next+i)%len(m.spans)]
+		if traceID == EmptyString || s.TraceID == traceID {
+			spans = append(spans, s)
+		}
+	}
+	return
+}
+
+// Close implements SpanExporter
+func (m *MemorySpanExporter) Close() error { return nil }
+
+// NewFileSpanExporter returns a SpanExporter appending the spans as JSON lines to the file at path
+func NewFileSpanExporter(path string) (*FileSpanExporter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSpanExporter{
+		f:   f,
+		enc: json.NewEncoder(f),
+	}, nil
+}
+
+// FileSpanExporter writes one JSON encoded span per line, to be analyzed offline
+type FileSpanExporter struct {
+	sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// ExportSpan implements SpanExporter
+func (fe *FileSpanExporter) ExportSpan(s *Span) {
+	fe.Lock()
+	defer fe.Unlock()
+	if err := fe.enc.Encode(s); err != nil {
+		Logger.Warning(fmt.Sprintf("<%s> failed writing span to <%s>: %s",
+			TracingLog, fe.f.Name(), err))
+	}
+}
+
+// Close implements SpanExporter
+func (fe *FileSpanExporter) Close() error {
+	fe.Lock()
+	defer fe.Unlock()
+	return fe.f.Close()
+}
+
+// otlpMaxQueue limits the spans kept in memory when the collector is not reachable
+const otlpMaxQueue = 100000
+
+// NewOTLPSpanExporter returns a SpanExporter sending batches of spans, at interval,
+// to an OpenTelemetry collector using the OTLP/HTTP JSON encoding
+func NewOTLPSpanExporter(url, serviceName string, interval time.Duration) *OTLPSpanExporter {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	exp := &OTLPSpanExporter{
+		url:         url,
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		stopChan:    make(chan struct{}),
+	}
+	exp.wg.Add(1)
+	go exp.run(interval)
+	return exp
+}
+
+// OTLPSpanExporter sends the spans to an OpenTelemetry collector
+type OTLPSpanExporter struct {
+	sync.Mutex
+	url         string
+	serviceName string
+	client      *http.Client
+	queue       []*Span
+	stopChan    chan struct{}
+	wg          sync.WaitGroup
+}
+
+// ExportSpan implements SpanExporter
+func (o *OTLPSpanExporter) ExportSpan(s *Span) {
+	o.Lock()
+	if len(o.queue) < otlpMaxQueue {
+		o.queue = append(o.queue, s)
+	}
+	o.Unlock()
+}
+
+func (o *OTLPSpanExporter) run(interval time.Duration) {
+	defer o.wg.Done()
+	tm := time.NewTicker(interval)
+	defer tm.Stop()
+	for {
+		select {
+		case <-o.stopChan:
+			o.flush()
+			return
+		case <-tm.C:
+			o.flush()
+		}
+	}
+}
+
+// flush sends the queued spans, keeping them for the next try on failure
+func (o *OTLPSpanExporter) flush() {
+	o.Lock()
+	spans := o.queue
+	o.queue = nil
+	o.Unlock()
+	if len(spans) == 0 {
+		return
+	}
+	if err := o.post(spans); err != nil {
+		Logger.Warning(fmt.Sprintf("<%s> failed sending %d spans to <%s>: %s",
+			TracingLog, len(spans), o.url, err))
+		o.Lock()
+		if len(o.queue)+len(spans) <= otlpMaxQueue {
+			o.queue = append(spans, o.queue...)
+		}
+		o.Unlock()
+	}
+}
+
+func (o *OTLPSpanExporter) post(spans []*Span) (err error) {
+	var body []byte
+	if body, err = json.Marshal(newOTLPTraces(o.serviceName, spans)); err != nil {
+		return
+	}
+	var resp *http.Response
+	if resp, err = o.client.Post(o.url, JsonBody, bytes.NewReader(body)); err != nil {
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return
+}
+
+// Close implements SpanExporter, sending the queued spans
+func (o *OTLPSpanExporter) Close() error {
+	close(o.stopChan)
+	o.wg.Wait()
+	return nil
+}
+
+// OTLP/HTTP JSON messages, see opentelemetry-proto/trace/v1
+type otlpTraces struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource      `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []*otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string         `json:"key"`
+	Value map[string]any `json:"value"`
+}
+
+// otlpSpanKinds converts the span kinds to the OTLP SpanKind enum
+var otlpSpanKinds = map[string]int{
+	MetaInternal: 1,
+	MetaServer:   2,
+	MetaClient:   3,
+}
+
+func newOTLPKeyValue(key string, val any) *otlpKeyValue {
+	kv := &otlpKeyValue{Key: key}
+	switch v := val.(type) {
+	case bool:
+		kv.Value = map[string]any{"boolValue": v}
+	case int:
+		kv.Value = map[string]any{"intValue": strconv.Itoa(v)}
+	case int64:
+		kv.Value = map[string]any{"intValue": strconv.FormatInt(v, 10)}
+	case float64:
+		kv.Value = map[string]any{"doubleValue": v}
+	default:
+		kv.Value = map[string]any{"stringValue": IfaceAsString(v)}
+	}
+	return kv
+}
+
+func newOTLPTraces(serviceName string, spans []*Span) *otlpTraces {
+	scopeSpans := &otlpScopeSpans{
+		Scope: otlpScope{Name: CGRateSLwr},
+		Spans: make([]*otlpSpan, len(spans)),
+	}
+	for i, s := range spans {
+		oSpan := &otlpSpan{
+			TraceID:           s.TraceID,
+			SpanID:            s.SpanID,
+			ParentSpanID:      s.ParentSpanID,
+			Name:              s.Name,
+			Kind:              otlpSpanKinds[s.Kind],
+			StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+		}
+		for key, val := range s.Attributes {
+			oSpan.Attributes = append(oSpan.Attributes, newOTLPKeyValue(key, val))
+		}
+		if s.Error != EmptyString {
+			oSpan.Status = otlpStatus{Code: 2, Message: s.Error}
+		}
+		scopeSpans.Spans[i] = oSpan
+	}
+	return &otlpTraces{
+		ResourceSpans: []*otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []*otlpKeyValue{newOTLPKeyValue("service.name", serviceName)},
+			},
+			ScopeSpans: []*otlpScopeSpans{scopeSpans},
+		}},
+	}
+}
@@ -0,0 +1,274 @@
+/*
+Real-time Online/Offline Charging System (OCS) for Telecom & ISP environments
+Copyright (C) ITsysCOM GmbH
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>
+*/
+
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cgrates/birpc/context"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	tp := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	exp := SpanContext{
+		TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:  "00f067aa0ba902b7",
+		Sampled: true,
+	}
+	if sc, err := ParseTraceParent(tp); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(exp, sc) {
+		t.Errorf("Expected %+v, received %+v", exp, sc)
+	} else if sc.TraceParent() != tp {
+		t.Errorf("Expected %q, received %q", tp, sc.TraceParent())
+	}
+	if sc, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"); err != nil {
+		t.Fatal(err)
+	} else if sc.Sampled {
+		t.Error("Expected not sampled")
+	}
+	for _, tp := range []string{
+		EmptyString,
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceParent(tp); err == nil {
+			t.Errorf("Expected error for %q", tp)
+		}
+	}
+}
+
+func TestSpanTracerStartSpan(t *testing.T) {
+	tr := new(SpanTracer)
+	if tr.Enabled() {
+		t.Error("Expected disabled tracer")
+	}
+	if span := tr.StartSpan(EmptyString, "CoreSv1.Status", MetaServer); span != nil {
+		t.Errorf("Expected no span, received %+v", span)
+	}
+	memExp := NewMemorySpanExporter(10)
+	tr.Load(1, true, memExp)
+	if !tr.Enabled() || !tr.DBSpans() {
+		t.Error("Expected enabled tracer")
+	}
+	root := tr.StartSpan(EmptyString, "SessionSv1.AuthorizeEvent", MetaServer)
+	if root == nil {
+		t.Fatal("Expected span")
+	}
+	if root.ParentSpanID != EmptyString || len(root.TraceID) != 32 || len(root.SpanID) != 16 {
+		t.Errorf("Unexpected root span: %s", ToJSON(root))
+	}
+	child := tr.StartSpan(root.TraceParent(), "AttributeSv1.ProcessEvent", MetaClient)
+	if child.TraceID != root.TraceID || child.ParentSpanID != root.SpanID {
+		t.Errorf("Expected child of %s, received %s", ToJSON(root), ToJSON(child))
+	}
+	child.SetAttribute("rpc.conns", "*internal")
+	child.End(errors.New("NOT_FOUND"))
+	child.End(nil) // ended only once
+	root.End(nil)
+	spans := memExp.Spans(root.TraceID)
+	if len(spans) != 2 || spans[0] != child || spans[1] != root {
+		t.Fatalf("Unexpected spans: %s", ToJSON(spans))
+	}
+	if child.Error != "NOT_FOUND" || child.Attributes["rpc.conns"] != "*internal" {
+		t.Errorf("Unexpected span: %s", ToJSON(child))
+	}
+	if rcv, err := tr.Spans(root.TraceID); err != nil {
+		t.Error(err)
+	} else if len(rcv) != 2 {
+		t.Errorf("Unexpected spans: %s", ToJSON(rcv))
+	}
+	if _, err := tr.Spans("4bf92f3577b34da6a3ce929d0e0e4736"); err != ErrNotFound {
+		t.Errorf("Expected %v, received %v", ErrNotFound, err)
+	}
+
+	// the sampling decision of the caller is respected
+	if span := tr.StartSpan("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		"CoreSv1.Status", MetaServer); span != nil {
+		t.Errorf("Expected no span, received %s", ToJSON(span))
+	}
+	tr.Load(0, false, memExp)
+	if span := tr.StartSpan(EmptyString, "CoreSv1.Status", MetaServer); span != nil {
+		t.Errorf("Expected no span, received %s", ToJSON(span))
+	}
+	if span := tr.StartSpan(root.TraceParent(), "CoreSv1.Status", MetaServer); span == nil {
+		t.Error("Expected span for sampled parent")
+	}
+	tr.Close()
+	if tr.Enabled() {
+		t.Error("Expected disabled tracer")
+	}
+	if _, err := tr.Spans(EmptyString); err == nil {
+		t.Error("Expected error without *memory exporter")
+	}
+}
+
+func TestSpanNil(t *testing.T) {
+	var span *Span
+	span.SetAttribute("key", "val")
+	span.End(nil)
+	if tp := span.TraceParent(); tp != EmptyString {
+		t.Errorf("Expected empty traceparent, received %q", tp)
+	}
+	if span = SpanFromContext(context.TODO()); span != nil {
+		t.Errorf("Expected no span, received %s", ToJSON(span))
+	}
+}
+
+func TestSpanContext(t *testing.T) {
+	span := &Span{TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7"}
+	ctx := ContextWithSpan(context.Background(), span)
+	if rcv := SpanFromContext(ctx); rcv != span {
+		t.Errorf("Expected %s, received %s", ToJSON(span), ToJSON(rcv))
+	}
+	if rcv := ContextWithSpan(ctx, nil); rcv != ctx {
+		t.Error("Expected the same context")
+	}
+}
+
+func TestArgsTraceParent(t *testing.T) {
+	tp := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	opts := map[string]any{OptsAPIKey: "attr12345"}
+	ev := &CGREvent{
+		Tenant:  "cgrates.org",
+		APIOpts: opts,
+	}
+	if rcv := TraceParentFromArgs(ev); rcv != EmptyString {
+		t.Errorf("Expected empty traceparent, received %q", rcv)
+	}
+	restore := SetArgsTraceParent(ev, tp)
+	if rcv := TraceParentFromArgs(ev); rcv != tp {
+		t.Errorf("Expected %q, received %q", tp, rcv)
+	}
+	if _, has := opts[OptsTraceParent]; has {
+		t.Error("Expected the original APIOpts to not be modified")
+	}
+	restore()
+	if !reflect.DeepEqual(ev.APIOpts, map[string]any{OptsAPIKey: "attr12345"}) {
+		t.Errorf("Unexpected APIOpts: %s", ToJSON(ev.APIOpts))
+	}
+
+	// nil APIOpts are created
+	args := &DurationArgs{}
+	SetArgsTraceParent(args, tp)
+	if rcv := TraceParentFromArgs(args); rcv != tp {
+		t.Errorf("Expected %q, received %q", tp, rcv)
+	}
+	// arguments without APIOpts are ignored
+	for _, args := range []any{nil, "string", new(string), (*CGREvent)(nil), struct{ APIOpts string }{}} {
+		SetArgsTraceParent(args, tp)()
+		if rcv := TraceParentFromArgs(args); rcv != EmptyString {
+			t.Errorf("Expected empty traceparent, received %q", rcv)
+		}
+	}
+}
+
+func TestMemorySpanExporterLimit(t *testing.T) {
+	memExp := NewMemorySpanExporter(2)
+	spans := []*Span{{TraceID: "1"}, {TraceID: "2"}, {TraceID: "1"}}
+	for _, span := range spans {
+		memExp.ExportSpan(span)
+	}
+	if rcv := memExp.Spans(EmptyString); !reflect.DeepEqual(rcv, spans[1:]) {
+		t.Errorf("Expected %s, received %s", ToJSON(spans[1:]), ToJSON(rcv))
+	}
+	if rcv := memExp.Spans("1"); !reflect.DeepEqual(rcv, spans[2:]) {
+		t.Errorf("Expected %s, received %s", ToJSON(spans[2:]), ToJSON(rcv))
+	}
+	if err := memExp.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFileSpanExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	fileExp, err := NewFileSpanExporter(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := new(SpanTracer)
+	tr.Load(1, false, fileExp)
+	span := tr.StartSpan(EmptyString, "CDRsV1.ProcessEvent", MetaServer)
+	span.End(nil)
+	tr.Close()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	if !sc.Scan() {
+		t.Fatal("Expected one line")
+	}
+	var rcv Span
+	if err = json.Unmarshal(sc.Bytes(), &rcv); err != nil {
+		t.Fatal(err)
+	}
+	if rcv.TraceID != span.TraceID || rcv.SpanID != span.SpanID || rcv.Name != span.Name {
+		t.Errorf("Expected %s, received %s", ToJSON(span), ToJSON(&rcv))
+	}
+}
+
+func TestOTLPSpanExporter(t *testing.T) {
+	rcvChan := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rcvChan <- body
+	}))
+	defer srv.Close()
+	otlpExp := NewOTLPSpanExporter(srv.URL, CGRateSLwr, time.Hour)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	otlpExp.ExportSpan(&Span{
+		TraceID:      "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:       "00f067aa0ba902b7",
+		ParentSpanID: "00f067aa0ba902b6",
+		Name:         "SessionSv1.InitiateSession",
+		Kind:         MetaServer,
+		StartTime:    start,
+		EndTime:      start.Add(time.Millisecond),
+		Attributes:   map[string]any{"rpc.system": MetaJSON},
+		Error:        "SERVER_ERROR",
+	})
+	if err := otlpExp.Close(); err != nil { // flushes the queued spans
+		t.Fatal(err)
+	}
+	exp := `{"resourceSpans":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"cgrates"}}]},"scopeSpans":[{"scope":{"name":"cgrates"},"spans":[{"traceId":"4bf92f3577b34da6a3ce929d0e0e4736","spanId":"00f067aa0ba902b7","parentSpanId":"00f067aa0ba902b6","name":"SessionSv1.InitiateSession","kind":2,"startTimeUnixNano":"1767225600000000000","endTimeUnixNano":"1767225600001000000","attributes":[{"key":"rpc.system","value":{"stringValue":"*json"}}],"status":{"code":2,"message":"SERVER_ERROR"}}]}]}]}`
+	select {
+	case rcv := <-rcvChan:
+		if string(rcv) != exp {
+			t.Errorf("Expected %s\nreceived %s", exp, rcv)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("spans not received")
+	}
+}